@@ -5,11 +5,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/apis/core/v1beta1"
 )
 
 // AddToSchemes may be used to add all resources defined in the project to a Scheme.
 var AddToSchemes runtime.SchemeBuilder = runtime.SchemeBuilder{
 	v1alpha1.SchemeBuilder.AddToScheme,
+	v1beta1.SchemeBuilder.AddToScheme,
 }
 
 // AddToScheme adds all core Resources to the Scheme.
@@ -7,12 +7,27 @@ import (
 // ClusterObjectDeploymentSpec defines the desired state of a ClusterObjectDeployment.
 type ClusterObjectDeploymentSpec struct {
 	// Number of old revisions in the form of archived ObjectSets to keep.
+	// Defaults to DefaultRevisionHistoryLimit.
 	// +kubebuilder:default=10
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 	// Selector targets ObjectSets managed by this Deployment.
+	// Matching ObjectSets without controller are adopted and become part of the revision history.
 	Selector metav1.LabelSelector `json:"selector"`
 	// Template to create new ObjectSets from.
 	Template ObjectSetTemplate `json:"template"`
+	// Priority of this deployment relative to other deployments.
+	// Deployments with a higher priority are reconciled first,
+	// e.g. after a manager restart or a mass resync.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// Restricts new revisions to be activated within maintenance windows.
+	// The initial revision is always activated right away.
+	// +optional
+	RolloutSchedule *RolloutSchedule `json:"rolloutSchedule,omitempty"`
+	// Blocks the activation of new revisions, e.g. during an incident change freeze.
+	// The active revision keeps being reconciled and the pending revision is reported in the Progressing condition.
+	// +optional
+	Freeze *RolloutFreeze `json:"freeze,omitempty"`
 }
 
 // ClusterObjectDeploymentStatus defines the observed state of a ClusterObjectDeployment.
@@ -34,6 +49,8 @@ type ClusterObjectDeploymentStatus struct {
 }
 
 // ClusterObjectDeployment is the Schema for the ClusterObjectDeployments API
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName={"clobjdeploy","cod"}
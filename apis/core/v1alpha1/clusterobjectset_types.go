@@ -11,6 +11,8 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // Archived ClusterObjectSets may stay on the cluster, to store information about previous revisions.
 //
 // A Namespace-scoped version of this API is available as ObjectSet.
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName={"clobjset","cos"}
@@ -38,12 +40,14 @@ type ClusterObjectSetList struct {
 // +kubebuilder:validation:XValidation:rule="(has(self.phases) == has(oldSelf.phases)) && (!has(self.phases) || (self.phases == oldSelf.phases))", message="phases is immutable"
 // +kubebuilder:validation:XValidation:rule="(has(self.availabilityProbes) == has(oldSelf.availabilityProbes)) && (!has(self.availabilityProbes) || (self.availabilityProbes == oldSelf.availabilityProbes))", message="availabilityProbes is immutable"
 // +kubebuilder:validation:XValidation:rule="(has(self.successDelaySeconds) == has(oldSelf.successDelaySeconds)) && (!has(self.successDelaySeconds) || (self.successDelaySeconds == oldSelf.successDelaySeconds))", message="successDelaySeconds is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.clusterScopedKinds) == has(oldSelf.clusterScopedKinds)) && (!has(self.clusterScopedKinds) || (self.clusterScopedKinds == oldSelf.clusterScopedKinds))", message="clusterScopedKinds is immutable"
 //
 //nolint:lll
 type ClusterObjectSetSpec struct {
 	// Specifies the lifecycle state of the ClusterObjectSet.
 	// +kubebuilder:default="Active"
-	// +kubebuilder:validation:Enum=Active;Paused;Archived
+	// +kubebuilder:validation:Enum=Active;Paused;Archived;Preview
+	// +kubebuilder:validation:XValidation:rule="self != 'Preview' || oldSelf == 'Preview'", message="Preview is only allowed on creation"
 	LifecycleState ObjectSetLifecycleState `json:"lifecycleState,omitempty"`
 
 	// Immutable fields below
@@ -68,6 +72,25 @@ type ClusterObjectSetStatus struct {
 	RemotePhases []RemotePhaseReference `json:"remotePhases,omitempty"`
 	// References all objects controlled by this instance.
 	ControllerOf []ControlledObjectReference `json:"controllerOf,omitempty"`
+	// Last changes applied to objects of this instance, newest first.
+	// Lists up to 32 objects. Changes to objects of phases reconciled by other phase classes are not listed.
+	ObjectChanges []ObjectChange `json:"objectChanges,omitempty"`
+	// State of the objects of this instance, objects failing to apply or failing probes first.
+	// Lists up to 128 objects. Objects of phases reconciled by other phase classes are not listed.
+	// Only reported when enabled in the Package Operator configuration.
+	// +optional
+	Objects []ObjectStatus `json:"objects,omitempty"`
+	// Objects that were not applied, because fields are owned by other field managers.
+	// Only reported for objects with the Report field conflict policy, lists up to 32 objects.
+	// +optional
+	FieldConflicts []ObjectFieldConflict `json:"fieldConflicts,omitempty"`
+	// Time each phase took to roll out, in the order phases were started.
+	// +optional
+	PhaseTimings []ObjectSetPhaseTiming `json:"phaseTimings,omitempty"`
+	// Objects that took longest to pass their probes while their phase was rolling out, slowest first.
+	// Lists up to 10 objects. Objects of phases reconciled by other phase classes are not listed.
+	// +optional
+	SlowestProbes []ObjectProbeTiming `json:"slowestProbes,omitempty"`
 }
 
 func init() { register(&ClusterObjectSet{}, &ClusterObjectSetList{}) }
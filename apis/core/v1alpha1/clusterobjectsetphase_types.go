@@ -5,6 +5,8 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // ClusterObjectSetPhase is an internal API, allowing a ClusterObjectSet to delegate a
 // single phase to another custom controller. ClusterObjectSets will create subordinate
 // ClusterObjectSetPhases when `.class` is set within the phase specification.
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName={"clobjsetphase","cosp"}
 // +kubebuilder:subresource:status
@@ -70,6 +72,9 @@ type ClusterObjectSetPhaseStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// References all objects controlled by this instance.
 	ControllerOf []ControlledObjectReference `json:"controllerOf,omitempty"`
+	// Last time the phase controller reconciling this object reported to be alive.
+	// The parent ObjectSet reports PhaseControllerUnavailable, when no heartbeat is received.
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
 }
 
 func init() { register(&ClusterObjectSetPhase{}, &ClusterObjectSetPhaseList{}) }
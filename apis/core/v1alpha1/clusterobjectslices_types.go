@@ -5,6 +5,9 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // ClusterObjectSlice is referenced by ObjectSets or ObjectDeployments and contain objects to
 // limit the size of ObjectSet and ObjectDeployments when big packages are installed.
 // This is necessary to work around the etcd object size limit of ~1.5MiB and to reduce load on the kube-apiserver.
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName={"clobjslice","cosl"}
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -16,6 +19,11 @@ type ClusterObjectSlice struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="objects is immutable"
 	// +kubebuilder:MaxItems=32
 	Objects []ObjectSetObject `json:"objects"`
+	// SHA-256 checksum of the objects, hex encoded.
+	// Verified when the objects are loaded, to detect truncated or otherwise corrupted slices.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="checksum is immutable"
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // ClusterObjectSliceList contains a list of ClusterObjectSlices.
@@ -5,6 +5,8 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // ClusterObjectTemplate contain a go template of a Kubernetes manifest. The manifest is then templated with the
 // sources provided in the .Spec.Sources. The sources can come from objects from any namespace or cluster scoped
 // objects.
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName={"clobjtmpl","cot"}
 // +kubebuilder:subresource:status
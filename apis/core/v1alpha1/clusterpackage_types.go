@@ -4,10 +4,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// HostedClusterTargetAnnotation makes a ClusterPackage install its objects into the workload cluster
+// of a HyperShift HostedCluster, instead of the cluster the ClusterPackage lives in.
+// The value references the HostedCluster as "<namespace>/<name>".
+// The annotation is propagated to the ClusterObjectDeployment and new ClusterObjectSet revisions.
+const HostedClusterTargetAnnotation = "package-operator.run/hosted-cluster"
+
 // ClusterPackage defines a cluster scoped package installation.
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=clpkg
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type ClusterPackage struct {
@@ -0,0 +1,172 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PackageRolloutLabel is set on all Packages and ClusterPackages created by a ClusterPackageRollout,
+// referencing the rollout by name.
+const PackageRolloutLabel = "package-operator.run/package-rollout"
+
+// PackageRolloutHashAnnotation records the hash of the template a package was last updated from.
+const PackageRolloutHashAnnotation = "package-operator.run/package-rollout-hash"
+
+// ClusterPackageRollout rolls out a package across many targets in waves.
+// Namespaces receive a Package, ClusterTargets a ClusterPackage installing into the remote cluster.
+// A wave only starts once all targets of the previous wave report Available=True.
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=pkgrollout
+// +kubebuilder:printcolumn:name="Wave",type="string",JSONPath=".status.currentWave"
+// +kubebuilder:printcolumn:name="Updated",type="integer",JSONPath=".status.updatedTargets"
+// +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.availableTargets"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedTargets"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ClusterPackageRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterPackageRolloutSpec `json:"spec,omitempty"`
+	// +kubebuilder:default={phase: Pending}
+	Status ClusterPackageRolloutStatus `json:"status,omitempty"`
+}
+
+// ClusterPackageRolloutList contains a list of ClusterPackageRollouts.
+// +kubebuilder:object:root=true
+type ClusterPackageRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterPackageRollout `json:"items"`
+}
+
+// ClusterPackageRolloutSpec defines the package and the waves of targets to roll it out to.
+type ClusterPackageRolloutSpec struct {
+	// Package to install into all targets.
+	Template PackageRolloutTemplate `json:"template"`
+	// Waves of targets, rolled out one after the other.
+	// Packages of targets removed from all waves are deleted.
+	// +kubebuilder:validation:MinItems=1
+	Waves []PackageRolloutWave `json:"waves"`
+	// Maximum number of targets of a wave that are updated, but not available yet.
+	// Defaults to 1.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+	// Number of failed targets tolerated before the rollout halts.
+	// Targets fail when their package is invalid,
+	// or when it finished progressing without becoming available.
+	// Tolerated failures don't block the next wave.
+	// A halted rollout does not update any more targets, until failures are resolved.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+	// Stops updating targets, without affecting targets already updated.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// PackageRolloutTemplate describes the package installed into each target.
+type PackageRolloutTemplate struct {
+	// Common Object Metadata.
+	// +optional
+	Metadata PackageRolloutTemplateMetadata `json:"metadata,omitempty"`
+	// Spec of the Package or ClusterPackage.
+	Spec PackageSpec `json:"spec"`
+}
+
+// PackageRolloutTemplateMetadata is the metadata of packages created by a rollout.
+type PackageRolloutTemplateMetadata struct {
+	// Labels of the package.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations of the package.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// PackageRolloutWave is a group of targets updated together.
+type PackageRolloutWave struct {
+	// Name of the wave.
+	Name string `json:"name"`
+	// Targets of the wave.
+	// +kubebuilder:validation:MinItems=1
+	Targets []PackageRolloutTarget `json:"targets"`
+}
+
+// PackageRolloutTarget references a single target of a rollout.
+// Exactly one of namespace or clusterTarget must be set.
+// Existing packages are only adopted, when they are labeled with package-operator.run/package-rollout=<rollout name>,
+// other packages are reported as failed target with reason Conflict.
+// +kubebuilder:validation:XValidation:rule="has(self.__namespace__) != has(self.clusterTarget)",message="exactly one of namespace or clusterTarget must be set"
+type PackageRolloutTarget struct {
+	// Namespace to install a Package into, named like the rollout.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// ClusterTarget to install a ClusterPackage into,
+	// named "<rollout name>-<cluster target name>".
+	// +optional
+	ClusterTarget string `json:"clusterTarget,omitempty"`
+}
+
+// ClusterPackageRolloutStatus defines the observed state of a ClusterPackageRollout.
+type ClusterPackageRolloutStatus struct {
+	// Conditions is a list of status conditions ths object is in.
+	// +example=[{type: "Available", status: "True"}]
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// This field is not part of any API contract
+	// it will go away as soon as kubectl can print conditions!
+	// When evaluating object state in code, use .Conditions instead.
+	Phase ClusterPackageRolloutStatusPhase `json:"phase,omitempty"`
+	// Name of the wave currently rolled out, empty when all waves are done.
+	CurrentWave string `json:"currentWave,omitempty"`
+	// Hash of the template targets are updated to.
+	TemplateHash string `json:"templateHash,omitempty"`
+	// Number of targets updated to the current template.
+	UpdatedTargets int32 `json:"updatedTargets"`
+	// Number of updated targets reporting Available=True.
+	AvailableTargets int32 `json:"availableTargets"`
+	// Number of updated targets that failed.
+	FailedTargets int32 `json:"failedTargets"`
+	// Targets that failed, limited to the first 100 targets.
+	// +optional
+	Failures []PackageRolloutTargetFailure `json:"failures,omitempty"`
+}
+
+// PackageRolloutTargetFailure reports why a target failed.
+type PackageRolloutTargetFailure struct {
+	PackageRolloutTarget `json:",inline"`
+	// Reason of the failing package condition.
+	Reason string `json:"reason,omitempty"`
+	// Message of the failing package condition.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterPackageRollout Condition Types.
+const (
+	// Available indicates that all targets are updated and available,
+	// apart from failed targets tolerated by the failure threshold.
+	ClusterPackageRolloutAvailable = "Available"
+	// Progressing indicates that targets are being updated.
+	ClusterPackageRolloutProgressing = "Progressing"
+	// Halted indicates that more targets failed than tolerated by the failure threshold.
+	ClusterPackageRolloutHalted = "Halted"
+)
+
+// ClusterPackageRolloutStatusPhase defines the status phase of a ClusterPackageRollout.
+type ClusterPackageRolloutStatusPhase string
+
+// Well-known ClusterPackageRollout Phases for printing a Status in kubectl,
+// see deprecation notice in ClusterPackageRolloutStatus for details.
+const (
+	ClusterPackageRolloutPhasePending     ClusterPackageRolloutStatusPhase = "Pending"
+	ClusterPackageRolloutPhaseProgressing ClusterPackageRolloutStatusPhase = "Progressing"
+	ClusterPackageRolloutPhasePaused      ClusterPackageRolloutStatusPhase = "Paused"
+	ClusterPackageRolloutPhaseHalted      ClusterPackageRolloutStatusPhase = "Halted"
+	ClusterPackageRolloutPhaseAvailable   ClusterPackageRolloutStatusPhase = "Available"
+)
+
+func init() { register(&ClusterPackageRollout{}, &ClusterPackageRolloutList{}) }
@@ -0,0 +1,93 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterTargetAnnotation makes a ClusterPackage or ClusterObjectDeployment install its objects
+// into the cluster referenced by the named ClusterTarget,
+// instead of the cluster the object lives in.
+// The annotation is propagated to the ClusterObjectDeployment and new ClusterObjectSet revisions.
+// Only cluster-scoped objects may target other clusters,
+// as ClusterTarget kubeconfigs are usually highly privileged.
+const ClusterTargetAnnotation = "package-operator.run/cluster-target"
+
+// ClusterTarget references a remote cluster via a kubeconfig Secret,
+// so packages can be installed into clusters not managed by HyperShift, e.g. by Cluster API or Rancher.
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=ct
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.serverVersion"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ClusterTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterTargetSpec `json:"spec,omitempty"`
+	// +kubebuilder:default={phase: Pending}
+	Status ClusterTargetStatus `json:"status,omitempty"`
+}
+
+// ClusterTargetList contains a list of ClusterTargets.
+// +kubebuilder:object:root=true
+type ClusterTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTarget `json:"items"`
+}
+
+// DefaultKubeconfigSecretKey is the Secret key read, when no key is specified in a KubeconfigSecretReference.
+const DefaultKubeconfigSecretKey = "kubeconfig"
+
+// ClusterTargetSpec defines how to access a remote cluster.
+type ClusterTargetSpec struct {
+	// Secret containing a kubeconfig to access the remote cluster.
+	KubeconfigSecretRef KubeconfigSecretReference `json:"kubeconfigSecretRef"`
+}
+
+// KubeconfigSecretReference references a kubeconfig stored in a Secret.
+type KubeconfigSecretReference struct {
+	// Name of the Secret.
+	Name string `json:"name"`
+	// Namespace of the Secret.
+	Namespace string `json:"namespace"`
+	// Key of the kubeconfig in the Secret.
+	// Defaults to DefaultKubeconfigSecretKey.
+	// +kubebuilder:default=kubeconfig
+	Key string `json:"key,omitempty"`
+}
+
+// ClusterTargetStatus defines the observed state of a ClusterTarget.
+type ClusterTargetStatus struct {
+	// Conditions is a list of status conditions ths object is in.
+	// +example=[{type: "Available", status: "True"}]
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// This field is not part of any API contract
+	// it will go away as soon as kubectl can print conditions!
+	// When evaluating object state in code, use .Conditions instead.
+	Phase ClusterTargetStatusPhase `json:"phase,omitempty"`
+	// Kubernetes version of the remote cluster, as reported by its discovery API.
+	ServerVersion string `json:"serverVersion,omitempty"`
+}
+
+// ClusterTarget Condition Types.
+const (
+	// Available indicates that the remote cluster is reachable with the referenced kubeconfig.
+	ClusterTargetAvailable = "Available"
+)
+
+// ClusterTargetStatusPhase defines the status phase of a ClusterTarget.
+type ClusterTargetStatusPhase string
+
+// Well-known ClusterTarget Phases for printing a Status in kubectl,
+// see deprecation notice in ClusterTargetStatus for details.
+const (
+	ClusterTargetPhasePending     ClusterTargetStatusPhase = "Pending"
+	ClusterTargetPhaseAvailable   ClusterTargetStatusPhase = "Available"
+	ClusterTargetPhaseUnavailable ClusterTargetStatusPhase = "Unavailable"
+)
+
+func init() { register(&ClusterTarget{}, &ClusterTargetList{}) }
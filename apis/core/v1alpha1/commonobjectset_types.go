@@ -11,6 +11,11 @@ import (
 // ObjectSetRevisionAnnotation annotations holds a revision generation number to order ObjectSets.
 const ObjectSetRevisionAnnotation = "package-operator.run/revision"
 
+// ReconcileRequestedAnnotation requests an immediate reconciliation of Packages,
+// ObjectDeployments and ObjectSets when its value changes, e.g. to the current timestamp.
+// Packages are unpacked again when the annotation changes.
+const ReconcileRequestedAnnotation = "package-operator.run/reconcile-requested"
+
 // ObjectSetLifecycleState specifies the lifecycle state of the ObjectSet.
 type ObjectSetLifecycleState string
 
@@ -24,6 +29,10 @@ const (
 	// which deletes all objects that are not excluded via the pausedFor property and
 	// removes itself from the owner list of all other objects previously under management.
 	ObjectSetLifecycleStateArchived ObjectSetLifecycleState = "Archived"
+	// ObjectSetLifecycleStatePreview / "Preview" runs all preflight checks and dry-runs
+	// of the ObjectSet without applying any object. Only allowed on creation,
+	// switching to "Active" activates the previewed revision.
+	ObjectSetLifecycleStatePreview ObjectSetLifecycleState = "Preview"
 )
 
 // ObjectSetTemplateSpec defines an object set.
@@ -44,8 +53,61 @@ type ObjectSetTemplateSpec struct {
 	// the underlying objects may initially satisfy the availability
 	// probes, but are ultimately unstable.
 	SuccessDelaySeconds int32 `json:"successDelaySeconds,omitempty"`
+	// Cluster-scoped kinds a namespaced ObjectSet may contain.
+	// Each kind has to be allowed by the cluster admin in the Package Operator manager and webhook configuration.
+	// Ignored for cluster-scoped ObjectSets.
+	ClusterScopedKinds []metav1.GroupKind `json:"clusterScopedKinds,omitempty"`
+	// Controls how drift of objects from their desired state is detected and remediated.
+	// Phases reconciled by other phase classes always correct drift.
+	// +optional
+	DriftDetection *ObjectSetDriftDetection `json:"driftDetection,omitempty"`
+	// Name of the field manager used to server-side apply objects, defaults to "package-operator".
+	// Allows to tell multiple Package Operator instances apart and to share ownership of fields deliberately.
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Whether fields owned by other field managers are taken over when applying objects.
+	// Objects may override the policy, defaults to Force.
+	// +optional
+	FieldConflictPolicy FieldConflictPolicy `json:"fieldConflictPolicy,omitempty"`
+}
+
+// FieldConflictPolicy specifies what happens to fields of an object owned by other field managers.
+// +kubebuilder:validation:Enum=Force;Report
+type FieldConflictPolicy string
+
+const (
+	// FieldConflictPolicyForce takes over fields owned by other field managers.
+	FieldConflictPolicyForce FieldConflictPolicy = "Force"
+	// FieldConflictPolicyReport leaves objects with fields owned by other field managers unchanged
+	// and lists the conflicting field managers in the status.
+	FieldConflictPolicyReport FieldConflictPolicy = "Report"
+)
+
+// ObjectSetDriftDetection configures the detection of objects drifting from their desired state.
+type ObjectSetDriftDetection struct {
+	// Interval in which objects are compared to their desired state,
+	// in addition to comparing them on every change.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// What to do with objects that drifted from their desired state.
+	// +kubebuilder:default=Correct
+	// +optional
+	Remediation DriftRemediation `json:"remediation,omitempty"`
 }
 
+// DriftRemediation specifies what happens to objects that drifted from their desired state.
+// +kubebuilder:validation:Enum=Correct;DetectOnly
+type DriftRemediation string
+
+const (
+	// DriftRemediationCorrect patches drifted objects back to their desired state.
+	DriftRemediationCorrect DriftRemediation = "Correct"
+	// DriftRemediationDetectOnly leaves drifted objects untouched,
+	// only reporting the drift via the Drifted condition, a metric and an Event.
+	DriftRemediationDetectOnly DriftRemediation = "DetectOnly"
+)
+
 // ObjectSetTemplatePhase configures the reconcile phase of ObjectSets.
 type ObjectSetTemplatePhase struct {
 	// Name of the reconcile phase. Must be unique within a ObjectSet.
@@ -76,6 +138,24 @@ type ObjectSetObject struct {
 	CollisionProtection CollisionProtection `json:"collisionProtection,omitempty"`
 	// Maps conditions from this object into the Package Operator APIs.
 	ConditionMappings []ConditionMapping `json:"conditionMappings,omitempty"`
+	// External marks objects that are created outside of Package Operator.
+	// External objects are observed and probed, but never created, updated or deleted.
+	// +optional
+	External *ObjectSetObjectExternal `json:"external,omitempty"`
+	// Deletion policy decides whether the object is deleted or left in the cluster,
+	// when it is no longer part of the ObjectSet or the ObjectSet is deleted.
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy ObjectDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// Whether fields owned by other field managers are taken over when applying the object,
+	// overriding the policy of the ObjectSet.
+	// +optional
+	FieldConflictPolicy FieldConflictPolicy `json:"fieldConflictPolicy,omitempty"`
+	// Objects with a lower weight are applied first within their phase.
+	// Objects of the same weight are applied in the order of their kind,
+	// Namespaces, CustomResourceDefinitions and RBAC first, workloads last.
+	// +optional
+	ApplyWeight int32 `json:"applyWeight,omitempty"`
 }
 
 func (o ObjectSetObject) String() string {
@@ -84,6 +164,44 @@ func (o ObjectSetObject) String() string {
 	return fmt.Sprintf("object %s/%s kind:%s", obj.GetNamespace(), obj.GetName(), obj.GetKind())
 }
 
+// ObjectSetObjectExternal configures how an external object is observed.
+type ObjectSetObjectExternal struct {
+	// How long to wait for the object to appear, counted from the creation of the ObjectSet.
+	// Waits indefinitely when unset.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Probes that have to succeed on the object for the phase to complete.
+	// +optional
+	Probes []Probe `json:"probes,omitempty"`
+	// What to do when the object is still missing after the timeout.
+	// +kubebuilder:default=Block
+	// +optional
+	MissingPolicy ExternalObjectMissingPolicy `json:"missingPolicy,omitempty"`
+}
+
+// ExternalObjectMissingPolicy specifies how a missing external object affects its phase.
+// +kubebuilder:validation:Enum=Block;Warn
+type ExternalObjectMissingPolicy string
+
+const (
+	// ExternalObjectMissingPolicyBlock prevents the phase from completing until the object exists.
+	ExternalObjectMissingPolicyBlock ExternalObjectMissingPolicy = "Block"
+	// ExternalObjectMissingPolicyWarn completes the phase without the object after the timeout,
+	// only reporting the ExternalObjectMissing condition.
+	ExternalObjectMissingPolicyWarn ExternalObjectMissingPolicy = "Warn"
+)
+
+// ObjectDeletionPolicy specifies what happens to an object during teardown.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type ObjectDeletionPolicy string
+
+const (
+	// ObjectDeletionPolicyDelete deletes the object.
+	ObjectDeletionPolicyDelete ObjectDeletionPolicy = "Delete"
+	// ObjectDeletionPolicyOrphan removes Package Operator as owner and leaves the object in the cluster.
+	ObjectDeletionPolicyOrphan ObjectDeletionPolicy = "Orphan"
+)
+
 // CollisionProtection specifies if and how PKO prevent ownership collisions.
 type CollisionProtection string
 
@@ -99,6 +217,8 @@ const (
 	// Be careful! This setting may cause multiple controllers to fight over a resource,
 	// causing load on the API server and etcd.
 	CollisionProtectionNone CollisionProtection = "None"
+	// DefaultCollisionProtection is used for objects not specifying collision protection.
+	DefaultCollisionProtection = CollisionProtectionPrevent
 )
 
 // ObjectSet Condition Types.
@@ -117,6 +237,31 @@ const (
 	// InTransition condition is True when the ObjectSet is not in control of all objects defined in spec.
 	// This holds true during rollout of the first instance or while handing over objects between two ObjectSets.
 	ObjectSetInTransition = "InTransition"
+	// PhaseControllerUnavailable is True when the controller of a phase class
+	// did not report a heartbeat on the ObjectSetPhase it is responsible for.
+	ObjectSetPhaseControllerUnavailable = "PhaseControllerUnavailable"
+	// RetryBackoff is True while the ObjectSet keeps failing with the same error
+	// and retries are delayed with an exponential backoff.
+	// The reason classifies the error, e.g. "ConfigError" or "InfrastructureError".
+	ObjectSetRetryBackoff = "RetryBackoff"
+	// ExternalObjectMissing is True while external objects are missing,
+	// either while waiting for them or after their timeout has passed.
+	ObjectSetExternalObjectMissing = "ExternalObjectMissing"
+	// Drifted is True while objects differ from their desired state,
+	// but are not corrected due to the DetectOnly drift remediation.
+	ObjectSetDrifted = "Drifted"
+	// SliceCorrupted is True when the objects of an ObjectSlice do not match its checksum.
+	// No objects are reconciled until the slice is replaced.
+	ObjectSetSliceCorrupted = "SliceCorrupted"
+	// Previewed is True when all objects of an ObjectSet in the Preview lifecycle state
+	// passed preflight checks and dry-runs, so the revision is ready to be activated.
+	ObjectSetPreviewed = "Previewed"
+	// CRDNamesConflict is True while CustomResourceDefinitions of the ObjectSet
+	// are not served, because their names conflict with other CustomResourceDefinitions.
+	ObjectSetCRDNamesConflict = "CRDNamesConflict"
+	// NamespaceTerminating is True while objects of the ObjectSet are not created or updated,
+	// because their namespace is being deleted.
+	ObjectSetNamespaceTerminating = "NamespaceTerminating"
 )
 
 // ObjectSetStatusPhase defines the status phase of an object set.
@@ -143,6 +288,22 @@ type ObjectSetProbe struct {
 	Probes []Probe `json:"probes"`
 	// Selector specifies which objects this probe should target.
 	Selector ProbeSelector `json:"selector"`
+	// Number of consecutive failed evaluations after which an object is considered failing the probe.
+	// Probes are evaluated whenever the ObjectSet is reconciled.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+	// Number of consecutive successful evaluations after which an object failing the probe
+	// is considered passing again.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+	// Minimum time an object has to fail the probe continuously, before it is considered failing.
+	// Dampens transient failures, e.g. pods briefly becoming unready while nodes are drained.
+	// +optional
+	DampingWindow *metav1.Duration `json:"dampingWindow,omitempty"`
 }
 
 // ConditionMapping maps one condition type to another.
@@ -153,6 +314,11 @@ type ConditionMapping struct {
 	//nolint:lll
 	// +kubebuilder:validation:Pattern=`[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\/([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]`
 	DestinationType string `json:"destinationType"`
+	// Go template rendering the message of the destination condition.
+	// Available fields are .object (the full object) and
+	// .condition (type, status, reason and message of the source condition).
+	// Defaults to the message of the source condition.
+	Message string `json:"message,omitempty"`
 }
 
 // ProbeSelector selects a subset of objects to apply probes to.
@@ -178,9 +344,10 @@ type PackageProbeKindSpec struct {
 
 // Probe defines probe parameters. Only one can be filled.
 type Probe struct {
-	Condition   *ProbeConditionSpec   `json:"condition,omitempty"`
-	FieldsEqual *ProbeFieldsEqualSpec `json:"fieldsEqual,omitempty"`
-	CEL         *ProbeCELSpec         `json:"cel,omitempty"`
+	Condition     *ProbeConditionSpec     `json:"condition,omitempty"`
+	FieldsEqual   *ProbeFieldsEqualSpec   `json:"fieldsEqual,omitempty"`
+	CEL           *ProbeCELSpec           `json:"cel,omitempty"`
+	JobCompletion *ProbeJobCompletionSpec `json:"jobCompletion,omitempty"`
 }
 
 // ProbeConditionSpec checks whether or not the object reports a condition with given type and status.
@@ -217,6 +384,10 @@ type ProbeCELSpec struct {
 	Message string `json:"message"`
 }
 
+// ProbeJobCompletionSpec checks that a batch/v1 Job completed successfully.
+// Failed Jobs report the message of their Failed condition, objects that are not Jobs pass.
+type ProbeJobCompletionSpec struct{}
+
 // PreviousRevisionReference references a previous revision of an ObjectSet or ClusterObjectSet.
 type PreviousRevisionReference struct {
 	// Name of a previous revision.
@@ -241,3 +412,92 @@ type ControlledObjectReference struct {
 	// Object Namespace.
 	Namespace string `json:"namespace,omitempty"`
 }
+
+// ObjectChange summarizes the last change Package Operator applied to an object.
+type ObjectChange struct {
+	// Object the change was applied to.
+	Object ControlledObjectReference `json:"object"`
+	// Why the object was changed.
+	Reason ObjectChangeReason `json:"reason"`
+	// Number of fields that were changed.
+	FieldsChanged int32 `json:"fieldsChanged"`
+	// Time the change was applied.
+	LastChangeTime metav1.Time `json:"lastChangeTime"`
+	// Field manager that modified the object last, before the drift was corrected.
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+}
+
+// ObjectFieldConflict reports an object that was not applied,
+// because some of its fields are owned by other field managers.
+type ObjectFieldConflict struct {
+	// Object with conflicting fields.
+	Object ControlledObjectReference `json:"object"`
+	// Field managers owning the conflicting fields.
+	FieldManagers []string `json:"fieldManagers"`
+	// Conflicting fields.
+	Fields []string `json:"fields"`
+}
+
+// ObjectSetPhaseTiming records how long a phase took to roll out.
+type ObjectSetPhaseTiming struct {
+	// Name of the phase.
+	Name string `json:"name"`
+	// Time the phase was reconciled for the first time.
+	Started metav1.Time `json:"started"`
+	// Time all objects of the phase passed their probes for the first time.
+	// +optional
+	Completed *metav1.Time `json:"completed,omitempty"`
+	// Time from the start until the completion of the phase.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// ObjectProbeTiming records how long an object failed its probes while its phase was rolling out.
+type ObjectProbeTiming struct {
+	// Object that was probed.
+	Object ControlledObjectReference `json:"object"`
+	// Phase the object belongs to.
+	Phase string `json:"phase"`
+	// Time from the start of the phase until the object was last seen failing its probes.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ObjectChangeReason specifies why Package Operator changed an object.
+type ObjectChangeReason string
+
+const (
+	// ObjectChangeReasonNewRevision is used when a new revision took over the object.
+	ObjectChangeReasonNewRevision ObjectChangeReason = "NewRevision"
+	// ObjectChangeReasonDriftCorrected is used when the object was reverted to its desired state.
+	ObjectChangeReasonDriftCorrected ObjectChangeReason = "DriftCorrected"
+)
+
+// ObjectStatus reports the state of an object of an ObjectSet.
+type ObjectStatus struct {
+	// Object API Version.
+	APIVersion string `json:"apiVersion"`
+	// Object Kind.
+	Kind string `json:"kind"`
+	// Object Name.
+	Name string `json:"name"`
+	// Object Namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// State of the object.
+	State ObjectState `json:"state"`
+	// Error applying the object or failed probes.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ObjectState specifies the state of an object of an ObjectSet.
+type ObjectState string
+
+const (
+	// ObjectStateApplied is used for objects that were applied and pass all probes.
+	ObjectStateApplied ObjectState = "Applied"
+	// ObjectStateProbing is used for objects that were applied, but fail probes.
+	ObjectStateProbing ObjectState = "Probing"
+	// ObjectStateFailed is used for objects that could not be applied.
+	ObjectStateFailed ObjectState = "Failed"
+)
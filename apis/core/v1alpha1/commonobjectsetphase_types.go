@@ -10,3 +10,8 @@ const (
 
 // ObjectSetPhaseClassLabel is the label key for the phase class.
 const ObjectSetPhaseClassLabel = "package-operator.run/phase-class"
+
+// ObjectSetPhaseFailoverAnnotation may be set on ObjectSets to hand phases over to another phase class,
+// when the controller of their class stopped sending heartbeats. The value is the class to fail over to,
+// e.g. "default" to let Package Operator reconcile the phase in the cluster the ObjectSet lives in.
+const ObjectSetPhaseFailoverAnnotation = "package-operator.run/phase-failover"
@@ -1,6 +1,14 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterObjectTemplateSourcesAnnotation is set on namespaces to allow ClusterObjectTemplates
+// to read sources from them. Contains comma separated ClusterObjectTemplate names or "*" to allow all.
+// Only enforced when Package Operator restricts ClusterObjectTemplate sources.
+const ClusterObjectTemplateSourcesAnnotation = "package-operator.run/cluster-object-template-sources"
 
 // ObjectTemplateSpec specification.
 type ObjectTemplateSpec struct {
@@ -9,15 +17,34 @@ type ObjectTemplateSpec struct {
 
 	// Objects in which configuration parameters are fetched
 	Sources []ObjectTemplateSource `json:"sources"`
+
+	// Patches set fields of the templated object to the result of CEL expressions.
+	// Patches are applied in order after the template is rendered and have access
+	// to the same data as the template, without the full power of Go templates.
+	// +optional
+	Patches []ObjectTemplatePatch `json:"patches,omitempty"`
+}
+
+// ObjectTemplatePatch sets a field of the templated object to the result of a CEL expression.
+type ObjectTemplatePatch struct {
+	// JSONPath to the field of the templated object to set, e.g. ".spec.replicas".
+	// +example=.spec.replicas
+	Path string `json:"path"`
+	// CEL expression computing the value of the field.
+	// The variables "config" and "environment" hold the template context.
+	// +example=config.replicas * 2
+	Expression string `json:"expression"`
 }
 
 // ObjectTemplateSource defines a source for a template.
 type ObjectTemplateSource struct {
-	APIVersion string                     `json:"apiVersion"`
-	Kind       string                     `json:"kind"`
-	Namespace  string                     `json:"namespace,omitempty"`
-	Name       string                     `json:"name"`
-	Items      []ObjectTemplateSourceItem `json:"items"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Namespace of the source object.
+	// Defaults to the namespace of the ObjectTemplate.
+	Namespace string                     `json:"namespace,omitempty"`
+	Name      string                     `json:"name"`
+	Items     []ObjectTemplateSourceItem `json:"items"`
 	// Marks this source as optional.
 	// The templated object will still be applied if optional sources are not found.
 	// If the source object is created later on, it will be eventually picked up.
@@ -30,6 +57,11 @@ type ObjectTemplateSourceItem struct {
 	Key string `json:"key"`
 	// JSONPath to destination in which to store copy of the source value.
 	Destination string `json:"destination"`
+	// Value stored at the destination when the key does not exist in the source object
+	// or when the source object is optional and not found.
+	// Without default, missing keys fail the template.
+	// +optional
+	Default *apiextensionsv1.JSON `json:"default,omitempty"`
 }
 
 // ObjectTemplateStatus defines the observed state of a ObjectTemplate ie the status of the templated object.
@@ -42,6 +74,34 @@ type ObjectTemplateStatus struct {
 	// it will go away as soon as kubectl can print conditions!
 	// When evaluating object state in code, use .Conditions instead.
 	Phase ObjectTemplateStatusPhase `json:"phase,omitempty"`
+	// Sources reports the resolution state of each source in the order they are resolved.
+	// Resolution stops at the first source that fails.
+	Sources []ObjectTemplateSourceStatus `json:"sources,omitempty"`
+	// TemplateError is the last error encountered while rendering the template.
+	// Cleared as soon as the template renders successfully.
+	TemplateError *ObjectTemplateRenderError `json:"templateError,omitempty"`
+}
+
+// ObjectTemplateSourceStatus reports the resolution state of a source.
+type ObjectTemplateSourceStatus struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Found is true when the source object exists.
+	Found bool `json:"found"`
+	// ResourceVersion of the source object values were last copied from.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// ObjectTemplateRenderError describes an error encountered while rendering the template.
+type ObjectTemplateRenderError struct {
+	// Error message of the template engine.
+	Message string `json:"message"`
+	// Line of the template the error occurred in, if known.
+	Line int32 `json:"line,omitempty"`
+	// Column of the template the error occurred in, if known.
+	Column int32 `json:"column,omitempty"`
 }
 
 // ObjectTemplate condition types.
@@ -17,6 +17,56 @@ type PackageStatus struct {
 	UnpackedHash string `json:"unpackedHash,omitempty"`
 	// Package revision as reported by the ObjectDeployment.
 	Revision int64 `json:"revision,omitempty"`
+	// Image found by the update policy of the package.
+	// +optional
+	Update *PackageUpdateStatus `json:"update,omitempty"`
+	// Objects deleted when the package is uninstalled.
+	// +optional
+	Teardown *PackageTeardownStatus `json:"teardown,omitempty"`
+	// Time the package is deleted at, as set by its TTLs.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+	// OpenAPI v3 schema of the package configuration, as declared in the PackageManifest.
+	// Allows tooling to render configuration forms without pulling the package image.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ConfigSchema *runtime.RawExtension `json:"configSchema,omitempty"`
+	// Compares the active revision of the package with its current image and configuration.
+	// +optional
+	Sync *PackageSyncStatus `json:"sync,omitempty"`
+}
+
+// PackageSyncStatus compares the active revision of a package with its current image and configuration,
+// telling a rollout that is still pending apart from one that is stuck.
+type PackageSyncStatus struct {
+	// Whether the active revision was rendered from the current image and configuration of the package
+	// and finished rolling out.
+	InSync bool `json:"inSync"`
+	// Revision of the newest ObjectSet that is available.
+	// +optional
+	ActiveRevision int64 `json:"activeRevision,omitempty"`
+	// Image the active revision was rendered from.
+	// +optional
+	ActiveImage string `json:"activeImage,omitempty"`
+	// Hash of the configuration the active revision was rendered from.
+	// +optional
+	ActiveConfigHash string `json:"activeConfigHash,omitempty"`
+	// Image the package is currently rendered from, including images found by the update policy.
+	DesiredImage string `json:"desiredImage"`
+	// Hash of the configuration the package was last rendered with.
+	// +optional
+	DesiredConfigHash string `json:"desiredConfigHash,omitempty"`
+	// Time the active revision has been out of date since.
+	// +optional
+	OutOfSyncSince *metav1.Time `json:"outOfSyncSince,omitempty"`
+	// Why the current image and configuration are not activated, empty while the rollout is progressing.
+	// One of Unpacking, Invalid, RolloutFrozen, RolloutPending, PreflightError or Paused,
+	// or the reason of the failing Unpacked condition.
+	// +optional
+	BlockedReason string `json:"blockedReason,omitempty"`
+	// Details on why the current image and configuration are not activated.
+	// +optional
+	BlockedMessage string `json:"blockedMessage,omitempty"`
 }
 
 // Package condition types.
@@ -36,6 +86,19 @@ const (
 	// - Malformed Yaml
 	// - Issues resulting from the template process.
 	PackageInvalid = "Invalid"
+	// SBOMAttached reports whether a software bill of materials is attached to the package image
+	// as OCI referrer. Lookup failures are reported as "Unknown" and do not block unpacking.
+	PackageSBOMAttached = "SBOMAttached"
+	// RetryBackoff is True while the Package keeps failing with the same error
+	// and retries are delayed with an exponential backoff.
+	// The reason classifies the error, e.g. "ConfigError" or "InfrastructureError".
+	PackageRetryBackoff = "RetryBackoff"
+	// Expiring is True while the package is scheduled for deletion by its TTLs,
+	// reporting the time the package is deleted at.
+	PackageExpiring = "Expiring"
+	// DeletionBlocked is True while the deletion of the package waits
+	// for the packages depending on it to be deleted.
+	PackageDeletionBlocked = "DeletionBlocked"
 )
 
 // PackageStatusPhase defines a status phase of a package.
@@ -62,7 +125,217 @@ type PackageSpec struct {
 	// Package configuration parameters.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Config *runtime.RawExtension `json:"config,omitempty"`
+	// Sources the package configuration is assembled from, in ascending priority.
+	// Objects are merged deeply, later sources override values of earlier sources
+	// and .spec.config is merged last, taking precedence over all sources.
+	// The merged configuration is validated against the OpenAPIV3Schema of the PackageManifest.
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	ConfigSources []PackageConfigSource `json:"configSources,omitempty"`
 	// Desired component to deploy from multi-component packages.
 	// +optional
 	Component string `json:"component,omitempty"`
+	// Secrets of type kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg
+	// holding the credentials to pull the package image from a private registry.
+	// +optional
+	ImagePullSecrets []PackageImagePullSecret `json:"imagePullSecrets,omitempty"`
+	// Overrides for images declared in the PackageManifest,
+	// e.g. to roll out an image hotfix without rebuilding the package.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ImageOverrides []PackageImageOverride `json:"imageOverrides,omitempty"`
+	// Priority of this package relative to other packages.
+	// Packages with a higher priority are reconciled first, e.g. after a manager restart or a mass resync,
+	// so infrastructure-critical packages come up before application packages.
+	// Propagated to the ObjectDeployment of the package.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// Restricts new revisions of the package to be activated within maintenance windows.
+	// The initial revision is always activated right away.
+	// Propagated to the ObjectDeployment of the package.
+	// +optional
+	RolloutSchedule *RolloutSchedule `json:"rolloutSchedule,omitempty"`
+	// Blocks the activation of new revisions of the package, e.g. during an incident change freeze.
+	// The active revision keeps being reconciled and the pending revision is reported in the Progressing condition.
+	// Propagated to the ObjectDeployment of the package.
+	// +optional
+	Freeze *RolloutFreeze `json:"freeze,omitempty"`
+	// Rolls the package forward automatically when new images are published.
+	// +optional
+	UpdatePolicy *PackageUpdatePolicy `json:"updatePolicy,omitempty"`
+	// Denies the deletion of the package while set, guarding critical packages against accidental uninstalls.
+	// Check .status.teardown for the objects the uninstall deletes, before lifting the protection.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+	// Packages this package depends on.
+	// Packages depended upon are only deleted after all packages depending on them are gone,
+	// reporting the remaining dependents in their DeletionBlocked condition.
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	Dependencies []PackageDependency `json:"dependencies,omitempty"`
+	// Deletes the package the given number of seconds after it was created,
+	// e.g. to tear down ephemeral preview environments.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterCreation *int32 `json:"ttlSecondsAfterCreation,omitempty"`
+	// Deletes the package the given number of seconds after it last became Available.
+	// When both TTLs are set, the package is deleted with the earlier expiry.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterAvailable *int32 `json:"ttlSecondsAfterAvailable,omitempty"`
+	// Name of the field manager used to server-side apply objects of the package,
+	// defaults to "package-operator".
+	// Propagated to the ObjectDeployment of the package.
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Whether fields owned by other field managers are taken over when applying objects of the package.
+	// Objects may override the policy with the package-operator.run/field-conflict-policy annotation.
+	// Propagated to the ObjectDeployment of the package.
+	// +optional
+	FieldConflictPolicy FieldConflictPolicy `json:"fieldConflictPolicy,omitempty"`
+}
+
+// PackageUpdatePolicy tracks new images of the package in the registry.
+// New images are only picked up while the package is Available,
+// so a rollout failing its availability probes is never superseded automatically.
+type PackageUpdatePolicy struct {
+	// Digest tracks the digest of the floating tag in .spec.image.
+	// SemVer picks the highest tag of the repository in .spec.image within semVerRange.
+	// +kubebuilder:validation:Enum=Digest;SemVer
+	Strategy PackageUpdateStrategy `json:"strategy"`
+	// Range of versions considered by the SemVer strategy.
+	// Tags that are no semantic versions are ignored.
+	// +example=>=1.2.0 <2.0.0
+	// +optional
+	SemVerRange string `json:"semVerRange,omitempty"`
+	// Interval between checks for new images, at least 1m.
+	// Defaults to 1h.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// PackageUpdateStrategy defines how new images of a package are found.
+type PackageUpdateStrategy string
+
+const (
+	// Tracks the digest of a floating tag.
+	PackageUpdateStrategyDigest PackageUpdateStrategy = "Digest"
+	// Picks the highest semantic version tag within a range.
+	PackageUpdateStrategySemVer PackageUpdateStrategy = "SemVer"
+)
+
+// PackageUpdateStatus reports the image found by the update policy.
+type PackageUpdateStatus struct {
+	// Image found by the update policy, pulled instead of .spec.image.
+	Image string `json:"image"`
+	// Time of the last check for new images.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+	// Generation of the package the image was found for.
+	ObservedGeneration int64 `json:"observedGeneration"`
+}
+
+// PackageDependency references a Package or ClusterPackage depended upon.
+type PackageDependency struct {
+	// Name of the package.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Namespace of the Package depended upon.
+	// Leave empty to depend on a ClusterPackage.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PackageImageOverride replaces the repository or digest of an image declared in the PackageManifest.
+type PackageImageOverride struct {
+	// Name of the image in the PackageManifest.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Repository replacing the repository of the image, e.g. quay.io/mirror/app.
+	// +optional
+	Repository string `json:"repository,omitempty"`
+	// Digest pinning the image, e.g. sha256:9f86d08...
+	// Defaults to the digest recorded in the PackageManifestLock.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]{32,}$`
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// PackageConfigSource is a source of package configuration.
+// +kubebuilder:validation:XValidation:rule="[has(self.inline), has(self.configMap), has(self.secret), has(self.clusterPackage)].filter(x, x).size() == 1",message="exactly one of inline, configMap, secret and clusterPackage must be set"
+type PackageConfigSource struct {
+	// Inline configuration.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Inline *runtime.RawExtension `json:"inline,omitempty"`
+	// Key of a ConfigMap holding YAML or JSON configuration.
+	// +optional
+	ConfigMap *PackageConfigKeyReference `json:"configMap,omitempty"`
+	// Key of a Secret holding YAML or JSON configuration.
+	// +optional
+	Secret *PackageConfigKeyReference `json:"secret,omitempty"`
+	// Configuration of a ClusterPackage, e.g. defaults shared by the instances of a package.
+	// Only .spec.config of the ClusterPackage is used, its own config sources are ignored.
+	// +optional
+	ClusterPackage *PackageConfigClusterPackageReference `json:"clusterPackage,omitempty"`
+	// How lists of this source are merged with lists of earlier sources.
+	// Replace overrides earlier lists and Append adds to them.
+	// Defaults to Replace.
+	// +kubebuilder:validation:Enum=Replace;Append
+	// +optional
+	ListStrategy PackageConfigListStrategy `json:"listStrategy,omitempty"`
+}
+
+// PackageConfigListStrategy defines how lists of config sources are merged.
+type PackageConfigListStrategy string
+
+const (
+	// Lists override lists of earlier sources.
+	PackageConfigListStrategyReplace PackageConfigListStrategy = "Replace"
+	// Lists are appended to lists of earlier sources.
+	PackageConfigListStrategyAppend PackageConfigListStrategy = "Append"
+)
+
+// PackageConfigKeyReference references a key of a ConfigMap or Secret.
+type PackageConfigKeyReference struct {
+	// Name of the object.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Namespace of the object, required for ClusterPackages.
+	// Packages may only reference objects in their own namespace, so this field is ignored for them.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Key holding the configuration.
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
+// PackageConfigClusterPackageReference references a ClusterPackage.
+type PackageConfigClusterPackageReference struct {
+	// Name of the ClusterPackage.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// PackageImagePullSecret references a Secret holding registry credentials.
+type PackageImagePullSecret struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Namespace of the Secret, required for ClusterPackages.
+	// Packages may only reference Secrets in their own namespace, so this field is ignored for them.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PackageTeardownStatus summarizes the objects deleted when the package is uninstalled.
+type PackageTeardownStatus struct {
+	// Number of objects controlled by the package.
+	ObjectCount int32 `json:"objectCount"`
+	// Objects controlled by the package, limited to the first 100 objects.
+	// Objects with the Orphan deletion policy are listed, but kept on uninstall.
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	Objects []ControlledObjectReference `json:"objects,omitempty"`
 }
@@ -2,6 +2,7 @@
 // containing basic building blocks that other auxiliary APIs can build on top of.
 // +kubebuilder:object:generate=true
 // +groupName=package-operator.run
+// +groupGoName=Core
 package v1alpha1
 
 import (
@@ -19,8 +20,16 @@ var (
 
 	// AddToScheme adds the types in this group-version to the given scheme.
 	AddToScheme = SchemeBuilder.AddToScheme
+
+	// SchemeGroupVersion is an alias of GroupVersion, as expected by the generated clients.
+	SchemeGroupVersion = GroupVersion
 )
 
+// Resource takes an unqualified resource and returns a Group qualified GroupResource.
+func Resource(resource string) schema.GroupResource {
+	return GroupVersion.WithResource(resource).GroupResource()
+}
+
 func register(objs ...runtime.Object) {
 	SchemeBuilder.Register(func(scheme *runtime.Scheme) error {
 		scheme.AddKnownTypes(GroupVersion, objs...)
@@ -0,0 +1,14 @@
+package v1alpha1
+
+// Condition types following the kstatus conventions.
+// They are reported by all objects in addition to their own condition types,
+// so generic tooling can interpret the state of objects without knowing them.
+// Both are only present while True.
+const (
+	// Reconciling is True while the controller works towards the desired state of the object,
+	// e.g. while unpacking, rolling out, handing over or tearing down objects.
+	ConditionReconciling = "Reconciling"
+	// Stalled is True while the controller cannot make progress without intervention,
+	// e.g. for invalid packages or reconciles failing repeatedly.
+	ConditionStalled = "Stalled"
+)
@@ -4,15 +4,66 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultRevisionHistoryLimit is the number of archived ObjectSets kept,
+// when revisionHistoryLimit is not set on an (Cluster)ObjectDeployment.
+const DefaultRevisionHistoryLimit int32 = 10
+
 // ObjectDeploymentSpec defines the desired state of a ObjectDeployment.
 type ObjectDeploymentSpec struct {
 	// Number of old revisions in the form of archived ObjectSets to keep.
+	// Defaults to DefaultRevisionHistoryLimit.
 	// +kubebuilder:default=10
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 	// Selector targets ObjectSets managed by this Deployment.
+	// Matching ObjectSets without controller are adopted and become part of the revision history.
 	Selector metav1.LabelSelector `json:"selector"`
 	// Template to create new ObjectSets from.
 	Template ObjectSetTemplate `json:"template"`
+	// Priority of this deployment relative to other deployments.
+	// Deployments with a higher priority are reconciled first,
+	// e.g. after a manager restart or a mass resync.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// Restricts new revisions to be activated within maintenance windows.
+	// The initial revision is always activated right away.
+	// +optional
+	RolloutSchedule *RolloutSchedule `json:"rolloutSchedule,omitempty"`
+	// Blocks the activation of new revisions, e.g. during an incident change freeze.
+	// The active revision keeps being reconciled and the pending revision is reported in the Progressing condition.
+	// +optional
+	Freeze *RolloutFreeze `json:"freeze,omitempty"`
+}
+
+// RolloutFreeze blocks the activation of new revisions.
+type RolloutFreeze struct {
+	// Why new revisions are held back, reported in the Progressing condition.
+	Reason string `json:"reason"`
+	// Lifts the freeze automatically at the given time.
+	// Without expiry the freeze stays until it is removed.
+	// +optional
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// RolloutSchedule restricts the activation of new revisions to recurring time windows.
+// A new revision detected outside of all windows is staged until the next window opens.
+type RolloutSchedule struct {
+	// Time zone the windows are evaluated in, e.g. Europe/Berlin.
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Windows in which new revisions are activated.
+	// +kubebuilder:validation:MinItems=1
+	Windows []RolloutWindow `json:"windows"`
+}
+
+// RolloutWindow is a recurring time window.
+type RolloutWindow struct {
+	// Cron expression matching the start of the window,
+	// with the fields minute, hour, day of month, month and day of week.
+	// +example=0 22 * * 1-5
+	Schedule string `json:"schedule"`
+	// How long the window stays open after it started, e.g. 2h.
+	Duration metav1.Duration `json:"duration"`
 }
 
 // ObjectSetTemplate describes the template to create new ObjectSets from.
@@ -60,6 +111,7 @@ const (
 )
 
 // ObjectDeployment is the Schema for the ObjectDeployments API
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName={"objdeploy","od"}
@@ -13,6 +13,7 @@ import (
 // Archived ObjectSets may stay on the cluster, to store information about previous revisions.
 //
 // A Cluster-scoped version of this API is available as ClusterObjectSet.
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName={"objset","os"}
@@ -40,12 +41,14 @@ type ObjectSetList struct {
 // +kubebuilder:validation:XValidation:rule="(has(self.phases) == has(oldSelf.phases)) && (!has(self.phases) || (self.phases == oldSelf.phases))", message="phases is immutable"
 // +kubebuilder:validation:XValidation:rule="(has(self.availabilityProbes) == has(oldSelf.availabilityProbes)) && (!has(self.availabilityProbes) || (self.availabilityProbes == oldSelf.availabilityProbes))", message="availabilityProbes is immutable"
 // +kubebuilder:validation:XValidation:rule="(has(self.successDelaySeconds) == has(oldSelf.successDelaySeconds)) && (!has(self.successDelaySeconds) || (self.successDelaySeconds == oldSelf.successDelaySeconds))", message="successDelaySeconds is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.clusterScopedKinds) == has(oldSelf.clusterScopedKinds)) && (!has(self.clusterScopedKinds) || (self.clusterScopedKinds == oldSelf.clusterScopedKinds))", message="clusterScopedKinds is immutable"
 //
 //nolint:lll
 type ObjectSetSpec struct {
 	// Specifies the lifecycle state of the ObjectSet.
 	// +kubebuilder:default="Active"
-	// +kubebuilder:validation:Enum=Active;Paused;Archived
+	// +kubebuilder:validation:Enum=Active;Paused;Archived;Preview
+	// +kubebuilder:validation:XValidation:rule="self != 'Preview' || oldSelf == 'Preview'", message="Preview is only allowed on creation"
 	LifecycleState ObjectSetLifecycleState `json:"lifecycleState,omitempty"`
 
 	// Immutable fields below
@@ -70,6 +73,25 @@ type ObjectSetStatus struct {
 	RemotePhases []RemotePhaseReference `json:"remotePhases,omitempty"`
 	// References all objects controlled by this instance.
 	ControllerOf []ControlledObjectReference `json:"controllerOf,omitempty"`
+	// Last changes applied to objects of this instance, newest first.
+	// Lists up to 32 objects. Changes to objects of phases reconciled by other phase classes are not listed.
+	ObjectChanges []ObjectChange `json:"objectChanges,omitempty"`
+	// State of the objects of this instance, objects failing to apply or failing probes first.
+	// Lists up to 128 objects. Objects of phases reconciled by other phase classes are not listed.
+	// Only reported when enabled in the Package Operator configuration.
+	// +optional
+	Objects []ObjectStatus `json:"objects,omitempty"`
+	// Objects that were not applied, because fields are owned by other field managers.
+	// Only reported for objects with the Report field conflict policy, lists up to 32 objects.
+	// +optional
+	FieldConflicts []ObjectFieldConflict `json:"fieldConflicts,omitempty"`
+	// Time each phase took to roll out, in the order phases were started.
+	// +optional
+	PhaseTimings []ObjectSetPhaseTiming `json:"phaseTimings,omitempty"`
+	// Objects that took longest to pass their probes while their phase was rolling out, slowest first.
+	// Lists up to 10 objects. Objects of phases reconciled by other phase classes are not listed.
+	// +optional
+	SlowestProbes []ObjectProbeTiming `json:"slowestProbes,omitempty"`
 }
 
 func init() { register(&ObjectSet{}, &ObjectSetList{}) }
@@ -4,6 +4,7 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 // ObjectSetPhase is an internal API, allowing an ObjectSet to delegate a single phase to another custom controller.
 // ObjectSets will create subordinate ObjectSetPhases when `.class` within the phase specification is set.
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName={"objsetphase","osp"}
@@ -69,6 +70,9 @@ type ObjectSetPhaseStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// References all objects controlled by this instance.
 	ControllerOf []ControlledObjectReference `json:"controllerOf,omitempty"`
+	// Last time the phase controller reconciling this object reported to be alive.
+	// The parent ObjectSet reports PhaseControllerUnavailable, when no heartbeat is received.
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
 }
 
 func init() { register(&ObjectSetPhase{}, &ObjectSetPhaseList{}) }
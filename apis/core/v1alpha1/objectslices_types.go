@@ -5,6 +5,8 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // ObjectSlice is referenced by ObjectSets or ObjectDeployments and contain objects to
 // limit the size of ObjectSets and ObjectDeployments when big packages are installed.
 // This is necessary to work around the etcd object size limit of ~1.5MiB and to reduce load on the kube-apiserver.
+// +genclient
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:shortName{"objslice","osl"}
@@ -16,6 +18,11 @@ type ObjectSlice struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="objects is immutable"
 	// +kubebuilder:MaxItems=32
 	Objects []ObjectSetObject `json:"objects"`
+	// SHA-256 checksum of the objects, hex encoded.
+	// Verified when the objects are loaded, to detect truncated or otherwise corrupted slices.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="checksum is immutable"
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // ObjectSliceList contains a list of ObjectSlices.
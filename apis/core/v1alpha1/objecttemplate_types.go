@@ -5,6 +5,7 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // ObjectTemplate contain a go template of a Kubernetes manifest. This manifest is then templated with the
 // sources provided in the .Spec.Sources. The sources can only come from objects within the same nampespace
 // as the ObjectTemplate.
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName={"objtmpl","ot"}
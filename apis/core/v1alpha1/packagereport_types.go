@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PackageReportName is the name of the PackageReport maintained by Package Operator.
+const PackageReportName = "cluster"
+
+// PackageReport summarizes the status of all Packages and ClusterPackages in the cluster.
+// It is maintained by Package Operator, so dashboards and fleet tooling can observe
+// all package installations by reading a single object.
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=pkgreport
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.summary.total"
+// +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.summary.available"
+// +kubebuilder:printcolumn:name="Unavailable",type="integer",JSONPath=".status.summary.unavailable"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type PackageReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status PackageReportStatus `json:"status,omitempty"`
+}
+
+// PackageReportList contains a list of PackageReports.
+// +kubebuilder:object:root=true
+type PackageReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PackageReport `json:"items"`
+}
+
+// PackageReportStatus defines the observed state of all Packages and ClusterPackages.
+type PackageReportStatus struct {
+	// Counts of packages by state.
+	Summary PackageReportSummary `json:"summary,omitempty"`
+	// Packages and ClusterPackages sorted by kind, namespace and name.
+	Packages []PackageReportEntry `json:"packages,omitempty"`
+	// Last time the report was updated.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// PackageReportSummary counts packages by state.
+type PackageReportSummary struct {
+	// Number of Packages and ClusterPackages.
+	Total int32 `json:"total"`
+	// Number of packages reporting Available=True.
+	Available int32 `json:"available"`
+	// Number of packages not reporting Available=True.
+	Unavailable int32 `json:"unavailable"`
+	// Number of packages rolling out a new revision.
+	Progressing int32 `json:"progressing"`
+	// Number of packages that are invalid.
+	Invalid int32 `json:"invalid"`
+}
+
+// PackageReportEntry reports the status of a single Package or ClusterPackage.
+type PackageReportEntry struct {
+	// Kind of the package, either Package or ClusterPackage.
+	Kind string `json:"kind"`
+	// Namespace of the package, empty for ClusterPackages.
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the package.
+	Name string `json:"name"`
+	// Image the package is installed from.
+	Image string `json:"image"`
+	// Package revision as reported by the ObjectDeployment.
+	Revision int64 `json:"revision,omitempty"`
+	// Phase of the package, see PackageStatus.
+	Phase PackageStatusPhase `json:"phase,omitempty"`
+	// True if the package reports Available=True.
+	Available bool `json:"available"`
+	// True if the package reports Progressing=True.
+	Progressing bool `json:"progressing,omitempty"`
+	// Reason the package is not available, taken from the most significant failing condition.
+	Reason string `json:"reason,omitempty"`
+	// Message of the most significant failing condition.
+	Message string `json:"message,omitempty"`
+}
+
+func init() { register(&PackageReport{}, &PackageReportList{}) }
@@ -0,0 +1,9 @@
+package v1alpha1
+
+import "embed"
+
+// Source contains the Go source of the core API types.
+// It allows tooling to present the documentation of the type definitions without drifting from them.
+//
+//go:embed *_types.go
+var Source embed.FS
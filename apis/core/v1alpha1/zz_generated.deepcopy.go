@@ -78,6 +78,16 @@ func (in *ClusterObjectDeploymentSpec) DeepCopyInto(out *ClusterObjectDeployment
 	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Template.DeepCopyInto(&out.Template)
+	if in.RolloutSchedule != nil {
+		in, out := &in.RolloutSchedule, &out.RolloutSchedule
+		*out = new(RolloutSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(RolloutFreeze)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObjectDeploymentSpec.
@@ -289,6 +299,10 @@ func (in *ClusterObjectSetPhaseStatus) DeepCopyInto(out *ClusterObjectSetPhaseSt
 		*out = make([]ControlledObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObjectSetPhaseStatus.
@@ -342,6 +356,37 @@ func (in *ClusterObjectSetStatus) DeepCopyInto(out *ClusterObjectSetStatus) {
 		*out = make([]ControlledObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.ObjectChanges != nil {
+		in, out := &in.ObjectChanges, &out.ObjectChanges
+		*out = make([]ObjectChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ObjectStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FieldConflicts != nil {
+		in, out := &in.FieldConflicts, &out.FieldConflicts
+		*out = make([]ObjectFieldConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PhaseTimings != nil {
+		in, out := &in.PhaseTimings, &out.PhaseTimings
+		*out = make([]ObjectSetPhaseTiming, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SlowestProbes != nil {
+		in, out := &in.SlowestProbes, &out.SlowestProbes
+		*out = make([]ObjectProbeTiming, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObjectSetStatus.
@@ -537,37 +582,7 @@ func (in *ClusterPackageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConditionMapping) DeepCopyInto(out *ConditionMapping) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionMapping.
-func (in *ConditionMapping) DeepCopy() *ConditionMapping {
-	if in == nil {
-		return nil
-	}
-	out := new(ConditionMapping)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlledObjectReference) DeepCopyInto(out *ControlledObjectReference) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlledObjectReference.
-func (in *ControlledObjectReference) DeepCopy() *ControlledObjectReference {
-	if in == nil {
-		return nil
-	}
-	out := new(ControlledObjectReference)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectDeployment) DeepCopyInto(out *ObjectDeployment) {
+func (in *ClusterPackageRollout) DeepCopyInto(out *ClusterPackageRollout) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -575,18 +590,18 @@ func (in *ObjectDeployment) DeepCopyInto(out *ObjectDeployment) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeployment.
-func (in *ObjectDeployment) DeepCopy() *ObjectDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPackageRollout.
+func (in *ClusterPackageRollout) DeepCopy() *ClusterPackageRollout {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectDeployment)
+	out := new(ClusterPackageRollout)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectDeployment) DeepCopyObject() runtime.Object {
+func (in *ClusterPackageRollout) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -594,31 +609,31 @@ func (in *ObjectDeployment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectDeploymentList) DeepCopyInto(out *ObjectDeploymentList) {
+func (in *ClusterPackageRolloutList) DeepCopyInto(out *ClusterPackageRolloutList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObjectDeployment, len(*in))
+		*out = make([]ClusterPackageRollout, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentList.
-func (in *ObjectDeploymentList) DeepCopy() *ObjectDeploymentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPackageRolloutList.
+func (in *ClusterPackageRolloutList) DeepCopy() *ClusterPackageRolloutList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectDeploymentList)
+	out := new(ClusterPackageRolloutList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectDeploymentList) DeepCopyObject() runtime.Object {
+func (in *ClusterPackageRolloutList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -626,29 +641,35 @@ func (in *ObjectDeploymentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectDeploymentSpec) DeepCopyInto(out *ObjectDeploymentSpec) {
+func (in *ClusterPackageRolloutSpec) DeepCopyInto(out *ClusterPackageRolloutSpec) {
 	*out = *in
-	if in.RevisionHistoryLimit != nil {
-		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]PackageRolloutWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
 		*out = new(int32)
 		**out = **in
 	}
-	in.Selector.DeepCopyInto(&out.Selector)
-	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentSpec.
-func (in *ObjectDeploymentSpec) DeepCopy() *ObjectDeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPackageRolloutSpec.
+func (in *ClusterPackageRolloutSpec) DeepCopy() *ClusterPackageRolloutSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectDeploymentSpec)
+	out := new(ClusterPackageRolloutSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectDeploymentStatus) DeepCopyInto(out *ObjectDeploymentStatus) {
+func (in *ClusterPackageRolloutStatus) DeepCopyInto(out *ClusterPackageRolloutStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -657,49 +678,44 @@ func (in *ObjectDeploymentStatus) DeepCopyInto(out *ObjectDeploymentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.CollisionCount != nil {
-		in, out := &in.CollisionCount, &out.CollisionCount
-		*out = new(int32)
-		**out = **in
-	}
-	if in.ControllerOf != nil {
-		in, out := &in.ControllerOf, &out.ControllerOf
-		*out = make([]ControlledObjectReference, len(*in))
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]PackageRolloutTargetFailure, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentStatus.
-func (in *ObjectDeploymentStatus) DeepCopy() *ObjectDeploymentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPackageRolloutStatus.
+func (in *ClusterPackageRolloutStatus) DeepCopy() *ClusterPackageRolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectDeploymentStatus)
+	out := new(ClusterPackageRolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSet) DeepCopyInto(out *ObjectSet) {
+func (in *ClusterTarget) DeepCopyInto(out *ClusterTarget) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSet.
-func (in *ObjectSet) DeepCopy() *ObjectSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTarget.
+func (in *ClusterTarget) DeepCopy() *ClusterTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSet)
+	out := new(ClusterTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectSet) DeepCopyObject() runtime.Object {
+func (in *ClusterTarget) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -707,31 +723,31 @@ func (in *ObjectSet) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetList) DeepCopyInto(out *ObjectSetList) {
+func (in *ClusterTargetList) DeepCopyInto(out *ClusterTargetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObjectSet, len(*in))
+		*out = make([]ClusterTarget, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetList.
-func (in *ObjectSetList) DeepCopy() *ObjectSetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTargetList.
+func (in *ClusterTargetList) DeepCopy() *ClusterTargetList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetList)
+	out := new(ClusterTargetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectSetList) DeepCopyObject() runtime.Object {
+func (in *ClusterTargetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -739,28 +755,107 @@ func (in *ObjectSetList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetObject) DeepCopyInto(out *ObjectSetObject) {
+func (in *ClusterTargetSpec) DeepCopyInto(out *ClusterTargetSpec) {
 	*out = *in
-	in.Object.DeepCopyInto(&out.Object)
-	if in.ConditionMappings != nil {
-		in, out := &in.ConditionMappings, &out.ConditionMappings
-		*out = make([]ConditionMapping, len(*in))
-		copy(*out, *in)
+	out.KubeconfigSecretRef = in.KubeconfigSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTargetSpec.
+func (in *ClusterTargetSpec) DeepCopy() *ClusterTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTargetStatus) DeepCopyInto(out *ClusterTargetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetObject.
-func (in *ObjectSetObject) DeepCopy() *ObjectSetObject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTargetStatus.
+func (in *ClusterTargetStatus) DeepCopy() *ClusterTargetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetObject)
+	out := new(ClusterTargetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetPhase) DeepCopyInto(out *ObjectSetPhase) {
+func (in *ConditionMapping) DeepCopyInto(out *ConditionMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionMapping.
+func (in *ConditionMapping) DeepCopy() *ConditionMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlledObjectReference) DeepCopyInto(out *ControlledObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlledObjectReference.
+func (in *ControlledObjectReference) DeepCopy() *ControlledObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlledObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigSecretReference) DeepCopyInto(out *KubeconfigSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSecretReference.
+func (in *KubeconfigSecretReference) DeepCopy() *KubeconfigSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectChange) DeepCopyInto(out *ObjectChange) {
+	*out = *in
+	out.Object = in.Object
+	in.LastChangeTime.DeepCopyInto(&out.LastChangeTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectChange.
+func (in *ObjectChange) DeepCopy() *ObjectChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDeployment) DeepCopyInto(out *ObjectDeployment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -768,18 +863,18 @@ func (in *ObjectSetPhase) DeepCopyInto(out *ObjectSetPhase) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhase.
-func (in *ObjectSetPhase) DeepCopy() *ObjectSetPhase {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeployment.
+func (in *ObjectDeployment) DeepCopy() *ObjectDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetPhase)
+	out := new(ObjectDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectSetPhase) DeepCopyObject() runtime.Object {
+func (in *ObjectDeployment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -787,31 +882,31 @@ func (in *ObjectSetPhase) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetPhaseList) DeepCopyInto(out *ObjectSetPhaseList) {
+func (in *ObjectDeploymentList) DeepCopyInto(out *ObjectDeploymentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObjectSetPhase, len(*in))
+		*out = make([]ObjectDeployment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseList.
-func (in *ObjectSetPhaseList) DeepCopy() *ObjectSetPhaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentList.
+func (in *ObjectDeploymentList) DeepCopy() *ObjectDeploymentList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetPhaseList)
+	out := new(ObjectDeploymentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectSetPhaseList) DeepCopyObject() runtime.Object {
+func (in *ObjectDeploymentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -819,41 +914,39 @@ func (in *ObjectSetPhaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetPhaseSpec) DeepCopyInto(out *ObjectSetPhaseSpec) {
+func (in *ObjectDeploymentSpec) DeepCopyInto(out *ObjectDeploymentSpec) {
 	*out = *in
-	if in.Previous != nil {
-		in, out := &in.Previous, &out.Previous
-		*out = make([]PreviousRevisionReference, len(*in))
-		copy(*out, *in)
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
 	}
-	if in.AvailabilityProbes != nil {
-		in, out := &in.AvailabilityProbes, &out.AvailabilityProbes
-		*out = make([]ObjectSetProbe, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.Template.DeepCopyInto(&out.Template)
+	if in.RolloutSchedule != nil {
+		in, out := &in.RolloutSchedule, &out.RolloutSchedule
+		*out = new(RolloutSchedule)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Objects != nil {
-		in, out := &in.Objects, &out.Objects
-		*out = make([]ObjectSetObject, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(RolloutFreeze)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseSpec.
-func (in *ObjectSetPhaseSpec) DeepCopy() *ObjectSetPhaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentSpec.
+func (in *ObjectDeploymentSpec) DeepCopy() *ObjectDeploymentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetPhaseSpec)
+	out := new(ObjectDeploymentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetPhaseStatus) DeepCopyInto(out *ObjectSetPhaseStatus) {
+func (in *ObjectDeploymentStatus) DeepCopyInto(out *ObjectDeploymentStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -862,6 +955,11 @@ func (in *ObjectSetPhaseStatus) DeepCopyInto(out *ObjectSetPhaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CollisionCount != nil {
+		in, out := &in.CollisionCount, &out.CollisionCount
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ControllerOf != nil {
 		in, out := &in.ControllerOf, &out.ControllerOf
 		*out = make([]ControlledObjectReference, len(*in))
@@ -869,191 +967,840 @@ func (in *ObjectSetPhaseStatus) DeepCopyInto(out *ObjectSetPhaseStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseStatus.
-func (in *ObjectSetPhaseStatus) DeepCopy() *ObjectSetPhaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDeploymentStatus.
+func (in *ObjectDeploymentStatus) DeepCopy() *ObjectDeploymentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetPhaseStatus)
+	out := new(ObjectDeploymentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetProbe) DeepCopyInto(out *ObjectSetProbe) {
+func (in *ObjectFieldConflict) DeepCopyInto(out *ObjectFieldConflict) {
 	*out = *in
-	if in.Probes != nil {
-		in, out := &in.Probes, &out.Probes
-		*out = make([]Probe, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.Object = in.Object
+	if in.FieldManagers != nil {
+		in, out := &in.FieldManagers, &out.FieldManagers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectFieldConflict.
+func (in *ObjectFieldConflict) DeepCopy() *ObjectFieldConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectFieldConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectProbeTiming) DeepCopyInto(out *ObjectProbeTiming) {
+	*out = *in
+	out.Object = in.Object
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectProbeTiming.
+func (in *ObjectProbeTiming) DeepCopy() *ObjectProbeTiming {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectProbeTiming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSet) DeepCopyInto(out *ObjectSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSet.
+func (in *ObjectSet) DeepCopy() *ObjectSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetDriftDetection) DeepCopyInto(out *ObjectSetDriftDetection) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetDriftDetection.
+func (in *ObjectSetDriftDetection) DeepCopy() *ObjectSetDriftDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetDriftDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetList) DeepCopyInto(out *ObjectSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetList.
+func (in *ObjectSetList) DeepCopy() *ObjectSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetObject) DeepCopyInto(out *ObjectSetObject) {
+	*out = *in
+	in.Object.DeepCopyInto(&out.Object)
+	if in.ConditionMappings != nil {
+		in, out := &in.ConditionMappings, &out.ConditionMappings
+		*out = make([]ConditionMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ObjectSetObjectExternal)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetObject.
+func (in *ObjectSetObject) DeepCopy() *ObjectSetObject {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetObjectExternal) DeepCopyInto(out *ObjectSetObjectExternal) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]Probe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetObjectExternal.
+func (in *ObjectSetObjectExternal) DeepCopy() *ObjectSetObjectExternal {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetObjectExternal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetPhase) DeepCopyInto(out *ObjectSetPhase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhase.
+func (in *ObjectSetPhase) DeepCopy() *ObjectSetPhase {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetPhase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectSetPhase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetPhaseList) DeepCopyInto(out *ObjectSetPhaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectSetPhase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseList.
+func (in *ObjectSetPhaseList) DeepCopy() *ObjectSetPhaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetPhaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectSetPhaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetPhaseSpec) DeepCopyInto(out *ObjectSetPhaseSpec) {
+	*out = *in
+	if in.Previous != nil {
+		in, out := &in.Previous, &out.Previous
+		*out = make([]PreviousRevisionReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailabilityProbes != nil {
+		in, out := &in.AvailabilityProbes, &out.AvailabilityProbes
+		*out = make([]ObjectSetProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ObjectSetObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseSpec.
+func (in *ObjectSetPhaseSpec) DeepCopy() *ObjectSetPhaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetPhaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetPhaseStatus) DeepCopyInto(out *ObjectSetPhaseStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ControllerOf != nil {
+		in, out := &in.ControllerOf, &out.ControllerOf
+		*out = make([]ControlledObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseStatus.
+func (in *ObjectSetPhaseStatus) DeepCopy() *ObjectSetPhaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetPhaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetPhaseTiming) DeepCopyInto(out *ObjectSetPhaseTiming) {
+	*out = *in
+	in.Started.DeepCopyInto(&out.Started)
+	if in.Completed != nil {
+		in, out := &in.Completed, &out.Completed
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetPhaseTiming.
+func (in *ObjectSetPhaseTiming) DeepCopy() *ObjectSetPhaseTiming {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetPhaseTiming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetProbe) DeepCopyInto(out *ObjectSetProbe) {
+	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]Probe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.DampingWindow != nil {
+		in, out := &in.DampingWindow, &out.DampingWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetProbe.
+func (in *ObjectSetProbe) DeepCopy() *ObjectSetProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetSpec) DeepCopyInto(out *ObjectSetSpec) {
+	*out = *in
+	if in.Previous != nil {
+		in, out := &in.Previous, &out.Previous
+		*out = make([]PreviousRevisionReference, len(*in))
+		copy(*out, *in)
+	}
+	in.ObjectSetTemplateSpec.DeepCopyInto(&out.ObjectSetTemplateSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetSpec.
+func (in *ObjectSetSpec) DeepCopy() *ObjectSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetStatus) DeepCopyInto(out *ObjectSetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemotePhases != nil {
+		in, out := &in.RemotePhases, &out.RemotePhases
+		*out = make([]RemotePhaseReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControllerOf != nil {
+		in, out := &in.ControllerOf, &out.ControllerOf
+		*out = make([]ControlledObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectChanges != nil {
+		in, out := &in.ObjectChanges, &out.ObjectChanges
+		*out = make([]ObjectChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ObjectStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FieldConflicts != nil {
+		in, out := &in.FieldConflicts, &out.FieldConflicts
+		*out = make([]ObjectFieldConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PhaseTimings != nil {
+		in, out := &in.PhaseTimings, &out.PhaseTimings
+		*out = make([]ObjectSetPhaseTiming, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SlowestProbes != nil {
+		in, out := &in.SlowestProbes, &out.SlowestProbes
+		*out = make([]ObjectProbeTiming, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetStatus.
+func (in *ObjectSetStatus) DeepCopy() *ObjectSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetTemplate) DeepCopyInto(out *ObjectSetTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetTemplate.
+func (in *ObjectSetTemplate) DeepCopy() *ObjectSetTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetTemplatePhase) DeepCopyInto(out *ObjectSetTemplatePhase) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ObjectSetObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Slices != nil {
+		in, out := &in.Slices, &out.Slices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetTemplatePhase.
+func (in *ObjectSetTemplatePhase) DeepCopy() *ObjectSetTemplatePhase {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetTemplatePhase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSetTemplateSpec) DeepCopyInto(out *ObjectSetTemplateSpec) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]ObjectSetTemplatePhase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AvailabilityProbes != nil {
+		in, out := &in.AvailabilityProbes, &out.AvailabilityProbes
+		*out = make([]ObjectSetProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterScopedKinds != nil {
+		in, out := &in.ClusterScopedKinds, &out.ClusterScopedKinds
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(ObjectSetDriftDetection)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetTemplateSpec.
+func (in *ObjectSetTemplateSpec) DeepCopy() *ObjectSetTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSetTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSlice) DeepCopyInto(out *ObjectSlice) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ObjectSetObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSlice.
+func (in *ObjectSlice) DeepCopy() *ObjectSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSlice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectSlice) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSliceList) DeepCopyInto(out *ObjectSliceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectSlice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSliceList.
+func (in *ObjectSliceList) DeepCopy() *ObjectSliceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSliceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectSliceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStatus) DeepCopyInto(out *ObjectStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStatus.
+func (in *ObjectStatus) DeepCopy() *ObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectTemplate) DeepCopyInto(out *ObjectTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetProbe.
-func (in *ObjectSetProbe) DeepCopy() *ObjectSetProbe {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplate.
+func (in *ObjectTemplate) DeepCopy() *ObjectTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetProbe)
+	out := new(ObjectTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetSpec) DeepCopyInto(out *ObjectSetSpec) {
+func (in *ObjectTemplateList) DeepCopyInto(out *ObjectTemplateList) {
 	*out = *in
-	if in.Previous != nil {
-		in, out := &in.Previous, &out.Previous
-		*out = make([]PreviousRevisionReference, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	in.ObjectSetTemplateSpec.DeepCopyInto(&out.ObjectSetTemplateSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetSpec.
-func (in *ObjectSetSpec) DeepCopy() *ObjectSetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateList.
+func (in *ObjectTemplateList) DeepCopy() *ObjectTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetSpec)
+	out := new(ObjectTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectTemplatePatch) DeepCopyInto(out *ObjectTemplatePatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplatePatch.
+func (in *ObjectTemplatePatch) DeepCopy() *ObjectTemplatePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectTemplatePatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetStatus) DeepCopyInto(out *ObjectSetStatus) {
+func (in *ObjectTemplateRenderError) DeepCopyInto(out *ObjectTemplateRenderError) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateRenderError.
+func (in *ObjectTemplateRenderError) DeepCopy() *ObjectTemplateRenderError {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectTemplateRenderError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectTemplateSource) DeepCopyInto(out *ObjectTemplateSource) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectTemplateSourceItem, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.RemotePhases != nil {
-		in, out := &in.RemotePhases, &out.RemotePhases
-		*out = make([]RemotePhaseReference, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateSource.
+func (in *ObjectTemplateSource) DeepCopy() *ObjectTemplateSource {
+	if in == nil {
+		return nil
 	}
-	if in.ControllerOf != nil {
-		in, out := &in.ControllerOf, &out.ControllerOf
-		*out = make([]ControlledObjectReference, len(*in))
-		copy(*out, *in)
+	out := new(ObjectTemplateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectTemplateSourceItem) DeepCopyInto(out *ObjectTemplateSourceItem) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetStatus.
-func (in *ObjectSetStatus) DeepCopy() *ObjectSetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateSourceItem.
+func (in *ObjectTemplateSourceItem) DeepCopy() *ObjectTemplateSourceItem {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetStatus)
+	out := new(ObjectTemplateSourceItem)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetTemplate) DeepCopyInto(out *ObjectSetTemplate) {
+func (in *ObjectTemplateSourceStatus) DeepCopyInto(out *ObjectTemplateSourceStatus) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetTemplate.
-func (in *ObjectSetTemplate) DeepCopy() *ObjectSetTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateSourceStatus.
+func (in *ObjectTemplateSourceStatus) DeepCopy() *ObjectTemplateSourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetTemplate)
+	out := new(ObjectTemplateSourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetTemplatePhase) DeepCopyInto(out *ObjectSetTemplatePhase) {
+func (in *ObjectTemplateSpec) DeepCopyInto(out *ObjectTemplateSpec) {
 	*out = *in
-	if in.Objects != nil {
-		in, out := &in.Objects, &out.Objects
-		*out = make([]ObjectSetObject, len(*in))
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]ObjectTemplateSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Slices != nil {
-		in, out := &in.Slices, &out.Slices
-		*out = make([]string, len(*in))
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectTemplatePatch, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetTemplatePhase.
-func (in *ObjectSetTemplatePhase) DeepCopy() *ObjectSetTemplatePhase {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateSpec.
+func (in *ObjectTemplateSpec) DeepCopy() *ObjectTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetTemplatePhase)
+	out := new(ObjectTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSetTemplateSpec) DeepCopyInto(out *ObjectSetTemplateSpec) {
+func (in *ObjectTemplateStatus) DeepCopyInto(out *ObjectTemplateStatus) {
 	*out = *in
-	if in.Phases != nil {
-		in, out := &in.Phases, &out.Phases
-		*out = make([]ObjectSetTemplatePhase, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.AvailabilityProbes != nil {
-		in, out := &in.AvailabilityProbes, &out.AvailabilityProbes
-		*out = make([]ObjectSetProbe, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.ControllerOf = in.ControllerOf
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]ObjectTemplateSourceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateError != nil {
+		in, out := &in.TemplateError, &out.TemplateError
+		*out = new(ObjectTemplateRenderError)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSetTemplateSpec.
-func (in *ObjectSetTemplateSpec) DeepCopy() *ObjectSetTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateStatus.
+func (in *ObjectTemplateStatus) DeepCopy() *ObjectTemplateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSetTemplateSpec)
+	out := new(ObjectTemplateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSlice) DeepCopyInto(out *ObjectSlice) {
+func (in *Package) DeepCopyInto(out *Package) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Objects != nil {
-		in, out := &in.Objects, &out.Objects
-		*out = make([]ObjectSetObject, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSlice.
-func (in *ObjectSlice) DeepCopy() *ObjectSlice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Package.
+func (in *Package) DeepCopy() *Package {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSlice)
+	out := new(Package)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectSlice) DeepCopyObject() runtime.Object {
+func (in *Package) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1061,31 +1808,141 @@ func (in *ObjectSlice) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSliceList) DeepCopyInto(out *ObjectSliceList) {
+func (in *PackageConfigClusterPackageReference) DeepCopyInto(out *PackageConfigClusterPackageReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageConfigClusterPackageReference.
+func (in *PackageConfigClusterPackageReference) DeepCopy() *PackageConfigClusterPackageReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageConfigClusterPackageReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageConfigKeyReference) DeepCopyInto(out *PackageConfigKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageConfigKeyReference.
+func (in *PackageConfigKeyReference) DeepCopy() *PackageConfigKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageConfigKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageConfigSource) DeepCopyInto(out *PackageConfigSource) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(PackageConfigKeyReference)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(PackageConfigKeyReference)
+		**out = **in
+	}
+	if in.ClusterPackage != nil {
+		in, out := &in.ClusterPackage, &out.ClusterPackage
+		*out = new(PackageConfigClusterPackageReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageConfigSource.
+func (in *PackageConfigSource) DeepCopy() *PackageConfigSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageConfigSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageDependency) DeepCopyInto(out *PackageDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageDependency.
+func (in *PackageDependency) DeepCopy() *PackageDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageImageOverride) DeepCopyInto(out *PackageImageOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageImageOverride.
+func (in *PackageImageOverride) DeepCopy() *PackageImageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageImageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageImagePullSecret) DeepCopyInto(out *PackageImagePullSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageImagePullSecret.
+func (in *PackageImagePullSecret) DeepCopy() *PackageImagePullSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageImagePullSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageList) DeepCopyInto(out *PackageList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObjectSlice, len(*in))
+		*out = make([]Package, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSliceList.
-func (in *ObjectSliceList) DeepCopy() *ObjectSliceList {
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageList.
+func (in *PackageList) DeepCopy() *PackageList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSliceList)
+	out := new(PackageList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectSliceList) DeepCopyObject() runtime.Object {
+func (in *PackageList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1093,26 +1950,40 @@ func (in *ObjectSliceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectTemplate) DeepCopyInto(out *ObjectTemplate) {
+func (in *PackageProbeKindSpec) DeepCopyInto(out *PackageProbeKindSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageProbeKindSpec.
+func (in *PackageProbeKindSpec) DeepCopy() *PackageProbeKindSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageProbeKindSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageReport) DeepCopyInto(out *PackageReport) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplate.
-func (in *ObjectTemplate) DeepCopy() *ObjectTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageReport.
+func (in *PackageReport) DeepCopy() *PackageReport {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectTemplate)
+	out := new(PackageReport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectTemplate) DeepCopyObject() runtime.Object {
+func (in *PackageReport) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1120,31 +1991,46 @@ func (in *ObjectTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectTemplateList) DeepCopyInto(out *ObjectTemplateList) {
+func (in *PackageReportEntry) DeepCopyInto(out *PackageReportEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageReportEntry.
+func (in *PackageReportEntry) DeepCopy() *PackageReportEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageReportEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageReportList) DeepCopyInto(out *PackageReportList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObjectTemplate, len(*in))
+		*out = make([]PackageReport, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateList.
-func (in *ObjectTemplateList) DeepCopy() *ObjectTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageReportList.
+func (in *PackageReportList) DeepCopy() *PackageReportList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectTemplateList)
+	out := new(PackageReportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectTemplateList) DeepCopyObject() runtime.Object {
+func (in *PackageReportList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1152,155 +2038,138 @@ func (in *ObjectTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectTemplateSource) DeepCopyInto(out *ObjectTemplateSource) {
+func (in *PackageReportStatus) DeepCopyInto(out *PackageReportStatus) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ObjectTemplateSourceItem, len(*in))
+	out.Summary = in.Summary
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]PackageReportEntry, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateSource.
-func (in *ObjectTemplateSource) DeepCopy() *ObjectTemplateSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageReportStatus.
+func (in *PackageReportStatus) DeepCopy() *PackageReportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectTemplateSource)
+	out := new(PackageReportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectTemplateSourceItem) DeepCopyInto(out *ObjectTemplateSourceItem) {
+func (in *PackageReportSummary) DeepCopyInto(out *PackageReportSummary) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateSourceItem.
-func (in *ObjectTemplateSourceItem) DeepCopy() *ObjectTemplateSourceItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageReportSummary.
+func (in *PackageReportSummary) DeepCopy() *PackageReportSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectTemplateSourceItem)
+	out := new(PackageReportSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectTemplateSpec) DeepCopyInto(out *ObjectTemplateSpec) {
+func (in *PackageRolloutTarget) DeepCopyInto(out *PackageRolloutTarget) {
 	*out = *in
-	if in.Sources != nil {
-		in, out := &in.Sources, &out.Sources
-		*out = make([]ObjectTemplateSource, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateSpec.
-func (in *ObjectTemplateSpec) DeepCopy() *ObjectTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageRolloutTarget.
+func (in *PackageRolloutTarget) DeepCopy() *PackageRolloutTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectTemplateSpec)
+	out := new(PackageRolloutTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectTemplateStatus) DeepCopyInto(out *ObjectTemplateStatus) {
+func (in *PackageRolloutTargetFailure) DeepCopyInto(out *PackageRolloutTargetFailure) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.ControllerOf = in.ControllerOf
+	out.PackageRolloutTarget = in.PackageRolloutTarget
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectTemplateStatus.
-func (in *ObjectTemplateStatus) DeepCopy() *ObjectTemplateStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageRolloutTargetFailure.
+func (in *PackageRolloutTargetFailure) DeepCopy() *PackageRolloutTargetFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectTemplateStatus)
+	out := new(PackageRolloutTargetFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Package) DeepCopyInto(out *Package) {
+func (in *PackageRolloutTemplate) DeepCopyInto(out *PackageRolloutTemplate) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Metadata.DeepCopyInto(&out.Metadata)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Package.
-func (in *Package) DeepCopy() *Package {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageRolloutTemplate.
+func (in *PackageRolloutTemplate) DeepCopy() *PackageRolloutTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(Package)
+	out := new(PackageRolloutTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Package) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PackageList) DeepCopyInto(out *PackageList) {
+func (in *PackageRolloutTemplateMetadata) DeepCopyInto(out *PackageRolloutTemplateMetadata) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Package, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageList.
-func (in *PackageList) DeepCopy() *PackageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageRolloutTemplateMetadata.
+func (in *PackageRolloutTemplateMetadata) DeepCopy() *PackageRolloutTemplateMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(PackageList)
+	out := new(PackageRolloutTemplateMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PackageList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PackageProbeKindSpec) DeepCopyInto(out *PackageProbeKindSpec) {
+func (in *PackageRolloutWave) DeepCopyInto(out *PackageRolloutWave) {
 	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]PackageRolloutTarget, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageProbeKindSpec.
-func (in *PackageProbeKindSpec) DeepCopy() *PackageProbeKindSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageRolloutWave.
+func (in *PackageRolloutWave) DeepCopy() *PackageRolloutWave {
 	if in == nil {
 		return nil
 	}
-	out := new(PackageProbeKindSpec)
+	out := new(PackageRolloutWave)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1313,6 +2182,53 @@ func (in *PackageSpec) DeepCopyInto(out *PackageSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConfigSources != nil {
+		in, out := &in.ConfigSources, &out.ConfigSources
+		*out = make([]PackageConfigSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]PackageImagePullSecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageOverrides != nil {
+		in, out := &in.ImageOverrides, &out.ImageOverrides
+		*out = make([]PackageImageOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.RolloutSchedule != nil {
+		in, out := &in.RolloutSchedule, &out.RolloutSchedule
+		*out = new(RolloutSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(RolloutFreeze)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(PackageUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]PackageDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTLSecondsAfterCreation != nil {
+		in, out := &in.TTLSecondsAfterCreation, &out.TTLSecondsAfterCreation
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSecondsAfterAvailable != nil {
+		in, out := &in.TTLSecondsAfterAvailable, &out.TTLSecondsAfterAvailable
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageSpec.
@@ -1335,6 +2251,30 @@ func (in *PackageStatus) DeepCopyInto(out *PackageStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Update != nil {
+		in, out := &in.Update, &out.Update
+		*out = new(PackageUpdateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Teardown != nil {
+		in, out := &in.Teardown, &out.Teardown
+		*out = new(PackageTeardownStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ConfigSchema != nil {
+		in, out := &in.ConfigSchema, &out.ConfigSchema
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(PackageSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageStatus.
@@ -1347,6 +2287,81 @@ func (in *PackageStatus) DeepCopy() *PackageStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageSyncStatus) DeepCopyInto(out *PackageSyncStatus) {
+	*out = *in
+	if in.OutOfSyncSince != nil {
+		in, out := &in.OutOfSyncSince, &out.OutOfSyncSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageSyncStatus.
+func (in *PackageSyncStatus) DeepCopy() *PackageSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageTeardownStatus) DeepCopyInto(out *PackageTeardownStatus) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ControlledObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageTeardownStatus.
+func (in *PackageTeardownStatus) DeepCopy() *PackageTeardownStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageTeardownStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageUpdatePolicy) DeepCopyInto(out *PackageUpdatePolicy) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageUpdatePolicy.
+func (in *PackageUpdatePolicy) DeepCopy() *PackageUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageUpdateStatus) DeepCopyInto(out *PackageUpdateStatus) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageUpdateStatus.
+func (in *PackageUpdateStatus) DeepCopy() *PackageUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreviousRevisionReference) DeepCopyInto(out *PreviousRevisionReference) {
 	*out = *in
@@ -1380,6 +2395,11 @@ func (in *Probe) DeepCopyInto(out *Probe) {
 		*out = new(ProbeCELSpec)
 		**out = **in
 	}
+	if in.JobCompletion != nil {
+		in, out := &in.JobCompletion, &out.JobCompletion
+		*out = new(ProbeJobCompletionSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
@@ -1437,6 +2457,21 @@ func (in *ProbeFieldsEqualSpec) DeepCopy() *ProbeFieldsEqualSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeJobCompletionSpec) DeepCopyInto(out *ProbeJobCompletionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeJobCompletionSpec.
+func (in *ProbeJobCompletionSpec) DeepCopy() *ProbeJobCompletionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeJobCompletionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeSelector) DeepCopyInto(out *ProbeSelector) {
 	*out = *in
@@ -1476,3 +2511,58 @@ func (in *RemotePhaseReference) DeepCopy() *RemotePhaseReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutFreeze) DeepCopyInto(out *RolloutFreeze) {
+	*out = *in
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutFreeze.
+func (in *RolloutFreeze) DeepCopy() *RolloutFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutSchedule) DeepCopyInto(out *RolloutSchedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]RolloutWindow, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutSchedule.
+func (in *RolloutSchedule) DeepCopy() *RolloutSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutWindow) DeepCopyInto(out *RolloutWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutWindow.
+func (in *RolloutWindow) DeepCopy() *RolloutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
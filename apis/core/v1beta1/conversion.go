@@ -0,0 +1,217 @@
+package v1beta1
+
+import (
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+
+	"package-operator.run/apis/core/v1alpha1"
+)
+
+func init() {
+	SchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions from and to v1alpha1 to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddConversionFunc((*v1alpha1.Package)(nil), (*Package)(nil), func(a, b any, scope conversion.Scope) error {
+		return Convert_v1alpha1_Package_To_v1beta1_Package(a.(*v1alpha1.Package), b.(*Package), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Package)(nil), (*v1alpha1.Package)(nil), func(a, b any, scope conversion.Scope) error {
+		return Convert_v1beta1_Package_To_v1alpha1_Package(a.(*Package), b.(*v1alpha1.Package), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc(
+		(*v1alpha1.ClusterPackage)(nil), (*ClusterPackage)(nil), func(a, b any, scope conversion.Scope) error {
+			return Convert_v1alpha1_ClusterPackage_To_v1beta1_ClusterPackage(
+				a.(*v1alpha1.ClusterPackage), b.(*ClusterPackage), scope)
+		}); err != nil {
+		return err
+	}
+	return s.AddConversionFunc(
+		(*ClusterPackage)(nil), (*v1alpha1.ClusterPackage)(nil), func(a, b any, scope conversion.Scope) error {
+			return Convert_v1beta1_ClusterPackage_To_v1alpha1_ClusterPackage(
+				a.(*ClusterPackage), b.(*v1alpha1.ClusterPackage), scope)
+		})
+}
+
+//nolint:revive,stylecheck // Naming follows conversion-gen.
+func Convert_v1alpha1_Package_To_v1beta1_Package(in *v1alpha1.Package, out *Package, _ conversion.Scope) error {
+	out.ObjectMeta = *in.ObjectMeta.DeepCopy()
+	convertV1alpha1PackageSpec(&in.Spec, &out.Spec)
+	convertV1alpha1PackageStatus(&in.Status, &out.Status)
+	return nil
+}
+
+//nolint:revive,stylecheck // Naming follows conversion-gen.
+func Convert_v1beta1_Package_To_v1alpha1_Package(in *Package, out *v1alpha1.Package, _ conversion.Scope) error {
+	out.ObjectMeta = *in.ObjectMeta.DeepCopy()
+	convertV1beta1PackageSpec(&in.Spec, &out.Spec)
+	convertV1beta1PackageStatus(&in.Status, &out.Status)
+	return nil
+}
+
+//nolint:revive,stylecheck // Naming follows conversion-gen.
+func Convert_v1alpha1_ClusterPackage_To_v1beta1_ClusterPackage(
+	in *v1alpha1.ClusterPackage, out *ClusterPackage, _ conversion.Scope,
+) error {
+	out.ObjectMeta = *in.ObjectMeta.DeepCopy()
+	convertV1alpha1PackageSpec(&in.Spec, &out.Spec)
+	convertV1alpha1PackageStatus(&in.Status, &out.Status)
+	return nil
+}
+
+//nolint:revive,stylecheck // Naming follows conversion-gen.
+func Convert_v1beta1_ClusterPackage_To_v1alpha1_ClusterPackage(
+	in *ClusterPackage, out *v1alpha1.ClusterPackage, _ conversion.Scope,
+) error {
+	out.ObjectMeta = *in.ObjectMeta.DeepCopy()
+	convertV1beta1PackageSpec(&in.Spec, &out.Spec)
+	convertV1beta1PackageStatus(&in.Status, &out.Status)
+	return nil
+}
+
+func convertV1alpha1PackageSpec(in *v1alpha1.PackageSpec, out *PackageSpec) {
+	out.Image = in.Image
+	out.Config = in.Config.DeepCopy()
+	for _, source := range in.ConfigSources {
+		out.ConfigSources = append(out.ConfigSources, PackageConfigSource{
+			Inline:       source.Inline.DeepCopy(),
+			ConfigMap:    (*PackageConfigKeyReference)(source.ConfigMap.DeepCopy()),
+			Secret:       (*PackageConfigKeyReference)(source.Secret.DeepCopy()),
+			ListStrategy: PackageConfigListStrategy(source.ListStrategy),
+			ClusterPackage: (*PackageConfigClusterPackageReference)(
+				source.ClusterPackage.DeepCopy()),
+		})
+	}
+	out.Component = in.Component
+	for _, secret := range in.ImagePullSecrets {
+		out.ImagePullSecrets = append(out.ImagePullSecrets, PackageImagePullSecret(secret))
+	}
+	for _, override := range in.ImageOverrides {
+		out.ImageOverrides = append(out.ImageOverrides, PackageImageOverride(override))
+	}
+	out.Priority = in.Priority
+	if in.RolloutSchedule != nil {
+		out.RolloutSchedule = &RolloutSchedule{TimeZone: in.RolloutSchedule.TimeZone}
+		for _, window := range in.RolloutSchedule.Windows {
+			out.RolloutSchedule.Windows = append(out.RolloutSchedule.Windows, RolloutWindow(window))
+		}
+	}
+	if in.Freeze != nil {
+		out.Freeze = &RolloutFreeze{Reason: in.Freeze.Reason, Until: in.Freeze.Until}
+	}
+	if in.UpdatePolicy != nil {
+		out.UpdatePolicy = &PackageUpdatePolicy{
+			Strategy:    PackageUpdateStrategy(in.UpdatePolicy.Strategy),
+			SemVerRange: in.UpdatePolicy.SemVerRange,
+			Interval:    in.UpdatePolicy.Interval,
+		}
+	}
+	out.DeletionProtection = in.DeletionProtection
+	for _, dep := range in.Dependencies {
+		out.Dependencies = append(out.Dependencies, PackageDependency(dep))
+	}
+	out.TTLSecondsAfterCreation = in.TTLSecondsAfterCreation
+	out.TTLSecondsAfterAvailable = in.TTLSecondsAfterAvailable
+	out.FieldManager = in.FieldManager
+	out.FieldConflictPolicy = FieldConflictPolicy(in.FieldConflictPolicy)
+}
+
+func convertV1beta1PackageSpec(in *PackageSpec, out *v1alpha1.PackageSpec) {
+	out.Image = in.Image
+	out.Config = in.Config.DeepCopy()
+	for _, source := range in.ConfigSources {
+		out.ConfigSources = append(out.ConfigSources, v1alpha1.PackageConfigSource{
+			Inline:       source.Inline.DeepCopy(),
+			ConfigMap:    (*v1alpha1.PackageConfigKeyReference)(source.ConfigMap.DeepCopy()),
+			Secret:       (*v1alpha1.PackageConfigKeyReference)(source.Secret.DeepCopy()),
+			ListStrategy: v1alpha1.PackageConfigListStrategy(source.ListStrategy),
+			ClusterPackage: (*v1alpha1.PackageConfigClusterPackageReference)(
+				source.ClusterPackage.DeepCopy()),
+		})
+	}
+	out.Component = in.Component
+	for _, secret := range in.ImagePullSecrets {
+		out.ImagePullSecrets = append(out.ImagePullSecrets, v1alpha1.PackageImagePullSecret(secret))
+	}
+	for _, override := range in.ImageOverrides {
+		out.ImageOverrides = append(out.ImageOverrides, v1alpha1.PackageImageOverride(override))
+	}
+	out.Priority = in.Priority
+	if in.RolloutSchedule != nil {
+		out.RolloutSchedule = &v1alpha1.RolloutSchedule{TimeZone: in.RolloutSchedule.TimeZone}
+		for _, window := range in.RolloutSchedule.Windows {
+			out.RolloutSchedule.Windows = append(out.RolloutSchedule.Windows, v1alpha1.RolloutWindow(window))
+		}
+	}
+	if in.Freeze != nil {
+		out.Freeze = &v1alpha1.RolloutFreeze{Reason: in.Freeze.Reason, Until: in.Freeze.Until}
+	}
+	if in.UpdatePolicy != nil {
+		out.UpdatePolicy = &v1alpha1.PackageUpdatePolicy{
+			Strategy:    v1alpha1.PackageUpdateStrategy(in.UpdatePolicy.Strategy),
+			SemVerRange: in.UpdatePolicy.SemVerRange,
+			Interval:    in.UpdatePolicy.Interval,
+		}
+	}
+	out.DeletionProtection = in.DeletionProtection
+	for _, dep := range in.Dependencies {
+		out.Dependencies = append(out.Dependencies, v1alpha1.PackageDependency(dep))
+	}
+	out.TTLSecondsAfterCreation = in.TTLSecondsAfterCreation
+	out.TTLSecondsAfterAvailable = in.TTLSecondsAfterAvailable
+	out.FieldManager = in.FieldManager
+	out.FieldConflictPolicy = v1alpha1.FieldConflictPolicy(in.FieldConflictPolicy)
+}
+
+// The deprecated status phase is dropped.
+func convertV1alpha1PackageStatus(in *v1alpha1.PackageStatus, out *PackageStatus) {
+	cp := in.DeepCopy()
+	out.Conditions = cp.Conditions
+	out.UnpackedHash = cp.UnpackedHash
+	out.Revision = cp.Revision
+	if cp.Update != nil {
+		update := PackageUpdateStatus(*cp.Update)
+		out.Update = &update
+	}
+	if cp.Teardown != nil {
+		out.Teardown = &PackageTeardownStatus{ObjectCount: cp.Teardown.ObjectCount}
+		for _, obj := range cp.Teardown.Objects {
+			out.Teardown.Objects = append(out.Teardown.Objects, ControlledObjectReference(obj))
+		}
+	}
+	out.ExpiryTime = cp.ExpiryTime
+	out.ConfigSchema = cp.ConfigSchema
+	if cp.Sync != nil {
+		sync := PackageSyncStatus(*cp.Sync)
+		out.Sync = &sync
+	}
+}
+
+// The status phase is left empty, it is filled in again by the next status update of Package Operator.
+func convertV1beta1PackageStatus(in *PackageStatus, out *v1alpha1.PackageStatus) {
+	cp := in.DeepCopy()
+	out.Conditions = cp.Conditions
+	out.UnpackedHash = cp.UnpackedHash
+	out.Revision = cp.Revision
+	if cp.Update != nil {
+		update := v1alpha1.PackageUpdateStatus(*cp.Update)
+		out.Update = &update
+	}
+	if cp.Teardown != nil {
+		out.Teardown = &v1alpha1.PackageTeardownStatus{ObjectCount: cp.Teardown.ObjectCount}
+		for _, obj := range cp.Teardown.Objects {
+			out.Teardown.Objects = append(out.Teardown.Objects, v1alpha1.ControlledObjectReference(obj))
+		}
+	}
+	out.ExpiryTime = cp.ExpiryTime
+	out.ConfigSchema = cp.ConfigSchema
+	if cp.Sync != nil {
+		sync := v1alpha1.PackageSyncStatus(*cp.Sync)
+		out.Sync = &sync
+	}
+}
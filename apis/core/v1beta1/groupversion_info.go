@@ -0,0 +1,39 @@
+// Package v1beta1 contains API Schema definitions for the v1beta1 version of the core Package Operator API group.
+// Objects are still stored as v1alpha1 and converted when read or written as v1beta1.
+// +kubebuilder:object:generate=true
+// +groupName=package-operator.run
+// +groupGoName=Core
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "package-operator.run", Version: "v1beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder runtime.SchemeBuilder
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+
+	// SchemeGroupVersion is an alias of GroupVersion, as expected by the generated clients.
+	SchemeGroupVersion = GroupVersion
+)
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource.
+func Resource(resource string) schema.GroupResource {
+	return GroupVersion.WithResource(resource).GroupResource()
+}
+
+func register(objs ...runtime.Object) {
+	SchemeBuilder.Register(func(scheme *runtime.Scheme) error {
+		scheme.AddKnownTypes(GroupVersion, objs...)
+		metav1.AddToGroupVersion(scheme, GroupVersion)
+		return nil
+	})
+}
@@ -21,8 +21,33 @@ const (
 	// PackageCollisionProtectionAnnotation prevents Package Operator from working
 	// on objects already under management by a different operator.
 	PackageCollisionProtectionAnnotation = "package-operator.run/collision-protection"
+	// PackageDeletionPolicyAnnotation set to "Orphan" leaves the object in the cluster,
+	// when it is removed from the package or the package is uninstalled.
+	PackageDeletionPolicyAnnotation = "package-operator.run/deletion-policy"
+	// PackageFieldConflictPolicyAnnotation set to "Force" takes over fields owned by other field managers,
+	// "Report" leaves the object unchanged and lists the conflicting field managers in the status.
+	PackageFieldConflictPolicyAnnotation = "package-operator.run/field-conflict-policy"
+	// PackageExternalAnnotation set to "True" marks objects that are created outside of Package Operator.
+	// Package Operator observes these objects, but never creates, updates or deletes them.
+	PackageExternalAnnotation = "package-operator.run/external"
+	// PackageExternalTimeoutAnnotation contains a duration, e.g. "10m", for how long to wait
+	// for an external object to be created, before applying the missing policy.
+	PackageExternalTimeoutAnnotation = "package-operator.run/external-timeout"
+	// PackageExternalMissingPolicyAnnotation decides what happens when an external object
+	// is not created within the timeout, either "Block" or "Warn".
+	PackageExternalMissingPolicyAnnotation = "package-operator.run/external-missing-policy"
+	// PackageExternalProbesAnnotation contains a YAML list of probes
+	// that an external object has to pass, before its phase becomes available.
+	PackageExternalProbesAnnotation = "package-operator.run/external-probes"
+	// PackageApplyWeightAnnotation contains an integer ordering the object within its phase.
+	// Objects with a lower weight are applied first, objects without the annotation have a weight of 0.
+	PackageApplyWeightAnnotation = "package-operator.run/apply-weight"
 )
 
+// PackageNamespacesPhase is the phase reserved for namespaces declared in the PackageManifest.
+// It is reconciled before all phases of the package.
+const PackageNamespacesPhase = "namespaces"
+
 const (
 	// PackageLabel contains the name of the Package from the PackageManifest.
 	PackageLabel = "package-operator.run/package"
@@ -32,6 +57,9 @@ const (
 	PackageConfigAnnotation = "package-operator.run/package-config"
 	// PackageInstanceLabel contains the name of the Package instance.
 	PackageInstanceLabel = "package-operator.run/instance"
+	// PackageMetricsServiceLabel contains the name of a Service exposing metrics,
+	// selected by the ServiceMonitor generated for the Service.
+	PackageMetricsServiceLabel = "package-operator.run/metrics-service"
 )
 
 // PackageManifest defines the manifest of a package.
@@ -62,15 +90,38 @@ type PackageManifestSpec struct {
 	// Either Cluster, Namespaced, or both.
 	// +example=['Cluster','Namespaced']
 	Scopes []PackageManifestScope `json:"scopes"`
+	// Cluster-scoped kinds the package may contain when installed into the Namespaced scope.
+	// Each kind also has to be allowed by the cluster admin in the Package Operator manager and webhook configuration.
+	// +optional
+	// +example=[{group: rbac.authorization.k8s.io, kind: ClusterRole}]
+	ClusterScopedKinds []metav1.GroupKind `json:"clusterScopedKinds,omitempty"`
 	// Phases correspond to the references to the phases which are going to be the
 	// part of the ObjectDeployment/ClusterObjectDeployment.
 	Phases []PackageManifestPhase `json:"phases"`
+	// Phase of objects without a phase annotation,
+	// when no entry of directoryPhases matches the directory of their file.
+	// +optional
+	// +example=deploy
+	DefaultPhase string `json:"defaultPhase,omitempty"`
+	// Phases of objects without a phase annotation, by the directory of their file.
+	// Entries also apply to subdirectories, the entry with the longest matching directory wins.
+	// +optional
+	// +example=[{directory: crds, phase: crds}]
+	DirectoryPhases []PackageManifestDirectoryPhase `json:"directoryPhases,omitempty"`
+	// Namespaces created by the package when installed into the Cluster scope.
+	// Namespaces are reconciled in the reserved "namespaces" phase before all other phases.
+	// +optional
+	Namespaces []PackageManifestNamespace `json:"namespaces,omitempty"`
 	// Availability Probes check objects that are part of the package.
 	// All probes need to succeed for a package to be considered Available.
 	// Failing probes will prevent the reconciliation of objects in later phases.
 	// +optional
 	// +example=[]
 	AvailabilityProbes []corev1alpha1.ObjectSetProbe `json:"availabilityProbes,omitempty"`
+	// Condition Mappings report conditions of objects that are part of the package
+	// as conditions of the Package. Replaces the condition-map annotation.
+	// +optional
+	ConditionMappings []PackageManifestConditionMapping `json:"conditionMappings,omitempty"`
 	// Configuration specification.
 	Config PackageManifestSpecConfig `json:"config,omitempty"`
 	// List of images to be resolved
@@ -91,6 +142,89 @@ type PackageManifestSpec struct {
 	Repositories []PackageManifestRepository `json:"repositories,omitempty"`
 	// Dependency references to resolve and use within this package.
 	Dependencies []PackageManifestDependency `json:"dependencies,omitempty"`
+	// Rendering configures how the package templates are rendered.
+	// +optional
+	Rendering PackageManifestRendering `json:"rendering,omitempty"`
+	// Values read from objects of the currently deployed revision before rendering the next one,
+	// e.g. to keep generated passwords. Available in templates under .previous by their name.
+	// +optional
+	CarryOver []PackageManifestCarryOver `json:"carryOver,omitempty"`
+	// Monitoring standardizes Prometheus scraping of the package workloads.
+	// +optional
+	Monitoring *PackageManifestMonitoring `json:"monitoring,omitempty"`
+}
+
+// PackageManifestMonitoring standardizes Prometheus scraping of the Deployments and Services of the package.
+type PackageManifestMonitoring struct {
+	// Name of the container and Service ports serving metrics.
+	// Pod templates of Deployments with a container port of this name get prometheus.io/scrape,
+	// prometheus.io/port and prometheus.io/path annotations,
+	// Services with a port of this name additionally get the package-operator.run/metrics-service label.
+	// +example=metrics
+	Port string `json:"port"`
+	// HTTP path metrics are served at.
+	// Defaults to /metrics.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Generates a ServiceMonitor for every Service exposing the metrics port,
+	// when the Prometheus Operator API is present in the cluster.
+	// +optional
+	ServiceMonitors bool `json:"serviceMonitors,omitempty"`
+}
+
+// PackageManifestConditionMapping maps conditions of objects within the package into Package Operator APIs.
+type PackageManifestConditionMapping struct {
+	// Selects the objects to map conditions from.
+	// Conditions of all selected objects within a phase are aggregated into one destination condition,
+	// reporting the worst status: False before Unknown before True.
+	Selector corev1alpha1.ProbeSelector `json:"selector"`
+	// Source condition type.
+	// +example=Available
+	SourceType string `json:"sourceType"`
+	// Destination condition type to report into Package Operator APIs.
+	// +example=my-package.example.com/DatabaseAvailable
+	DestinationType string `json:"destinationType"`
+	// Go template rendering the message of the destination condition.
+	// Available fields are .object (the full object) and
+	// .condition (type, status, reason and message of the source condition).
+	// Defaults to the message of the source condition.
+	// +optional
+	// +example={{.object.metadata.name}}: {{.condition.message}}
+	Message string `json:"message,omitempty"`
+}
+
+// PackageManifestCarryOver reads a value from an object deployed by the current revision of the package.
+// The value is missing from the template context when the object does not exist
+// or is not part of the package.
+type PackageManifestCarryOver struct {
+	// Name of the value in the template context.
+	// +example=dbPassword
+	Name string `json:"name"`
+	// APIVersion of the object.
+	// +example=v1
+	APIVersion string `json:"apiVersion"`
+	// Kind of the object.
+	// +example=Secret
+	Kind string `json:"kind"`
+	// Name of the object.
+	// +example=db-credentials
+	ObjectName string `json:"objectName"`
+	// Namespace of the object, defaults to the namespace of the package.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// JSONPath of the value within the object.
+	// +example={.data.password}
+	Path string `json:"path"`
+}
+
+// PackageManifestRendering configures how the package templates are rendered.
+type PackageManifestRendering struct {
+	// Strict rendering fails on template values that resolve to nothing,
+	// e.g. map entries accessed via index or null values, and on configuration
+	// fields unknown to the config schema, instead of rendering "<no value>"
+	// or silently pruning the fields.
+	// +optional
+	Strict bool `json:"strict,omitempty"`
 }
 
 // PackageManifestFilter is used to conditionally render objects based on CEL expressions.
@@ -225,6 +359,36 @@ type PackageManifestPhase struct {
 	Class string `json:"class,omitempty"`
 }
 
+// PackageManifestDirectoryPhase assigns objects of files within a directory to a phase.
+type PackageManifestDirectoryPhase struct {
+	// Directory relative to the package root.
+	// +example=crds
+	Directory string `json:"directory"`
+	// Name of the phase.
+	// +example=crds
+	Phase string `json:"phase"`
+}
+
+// PackageManifestNamespace declares a namespace created by the package.
+// Name, label and annotation values are templated with the package template context.
+type PackageManifestNamespace struct {
+	// Name of the namespace.
+	// +example={{.package.metadata.name}}-system
+	Name string `json:"name"`
+	// Labels to set on the namespace.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations to set on the namespace.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// CleanupPolicy decides what happens to the namespace when the package is uninstalled.
+	// "Delete" removes the namespace and everything in it, "Orphan" leaves it in the cluster.
+	// +kubebuilder:default=Delete
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +optional
+	CleanupPolicy corev1alpha1.ObjectDeletionPolicy `json:"cleanupPolicy,omitempty"`
+}
+
 // PackageManifestImage specifies an image tag to be resolved.
 type PackageManifestImage struct {
 	// Image name to be use to reference it in the templates
@@ -288,6 +452,12 @@ type PackageEnvironment struct {
 	// HyperShift specific information. Only available when installed alongside HyperShift.
 	// https://github.com/openshift/hypershift
 	HyperShift *PackageEnvironmentHyperShift `json:"hyperShift,omitempty"`
+	// Metadata identifying the cluster within a fleet of clusters.
+	// Only set when ClusterClaims or Node labels shared by all Nodes are found.
+	Cluster *PackageEnvironmentCluster `json:"cluster,omitempty"`
+	// Prometheus Operator information.
+	// Only set when the monitoring.coreos.com/v1 ServiceMonitor API is present.
+	PrometheusOperator *PackageEnvironmentPrometheusOperator `json:"prometheusOperator,omitempty"`
 }
 
 // PackageEnvironmentKubernetes configures kubernetes environments.
@@ -295,6 +465,17 @@ type PackageEnvironmentKubernetes struct {
 	// Kubernetes server version.
 	// +example=v1.29.5
 	Version string `json:"version"`
+	// DNS domain of the cluster, used in Service and Pod DNS names.
+	// +example=cluster.local
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+	// Cloud provider hosting the cluster nodes, taken from the scheme of their provider IDs.
+	// Empty when nodes report no provider ID, e.g. on bare metal.
+	// +example=aws
+	CloudProvider string `json:"cloudProvider,omitempty"`
+	// Topology zones the cluster nodes are spread across,
+	// taken from their topology.kubernetes.io/zone label.
+	// +example=[us-east-1a, us-east-1b]
+	Zones []string `json:"zones,omitempty"`
 }
 
 // PackageEnvironmentOpenShift configures openshift environments.
@@ -350,11 +531,35 @@ type PackageEnvironmentHyperShiftHostedCluster struct {
 	HostedClusterNamespace string `json:"hostedClusterNamespace"`
 }
 
+// PackageEnvironmentCluster contains metadata identifying the cluster within a fleet of clusters,
+// so a single package can configure itself per cluster.
+type PackageEnvironmentCluster struct {
+	// Claims about the cluster, taken from Open Cluster Management ClusterClaims.
+	// https://open-cluster-management.io/concepts/clusterclaim/
+	// +example={id.k8s.io: 4c8f1d2e, region.open-cluster-management.io: us-east-1}
+	Claims map[string]string `json:"claims,omitempty"`
+	// Labels set to the same value on all Nodes of the cluster.
+	// +example={node.kubernetes.io/instance-type: m5.xlarge, topology.kubernetes.io/region: us-east-1}
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+}
+
+// PackageEnvironmentPrometheusOperator contains Prometheus Operator specific information.
+// Only available when the Prometheus Operator API is present in the cluster.
+// https://prometheus-operator.dev
+type PackageEnvironmentPrometheusOperator struct{}
+
 // TemplateContextPackage represents the (Cluster)Package object requesting this package content.
 type TemplateContextPackage struct {
 	TemplateContextObjectMeta `json:"metadata"`
 	// Image as presented via the (Cluster)Package API after admission.
 	Image string `json:"image"`
+	// Short hash of the configuration after admission, which only changes with the configuration.
+	// Combine it with the package name via truncateName to derive collision-free object names.
+	ConfigHash string `json:"configHash,omitempty"`
+	// SHA256 checksums of the top-level configuration keys after admission.
+	// Embed them as annotations into pod templates, to roll pods when the configuration they consume changes,
+	// e.g. checksum/database: {{ index .package.configChecksums "database" }}.
+	ConfigChecksums map[string]string `json:"configChecksums,omitempty"`
 }
 
 // TemplateContextObjectMeta represents a simplified version of metav1.ObjectMeta for use in templates.
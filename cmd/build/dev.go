@@ -33,12 +33,9 @@ func (dev *Dev) PreCommit(ctx context.Context, args []string) error {
 func (dev *Dev) Generate(ctx context.Context, args []string) error {
 	self := run.Meth1(dev, dev.Generate, args)
 
-	return mgr.ParallelDeps(
+	return mgr.SerialDeps(
 		ctx, self,
-		run.Meth(generate, generate.code),
-		run.Meth(generate, generate.docs),
-		run.Meth(generate, generate.selfBootstrapJob),
-		run.Meth(generate, generate.selfBootstrapJobLocal),
+		run.Meth(generate, generate.All),
 	)
 }
 
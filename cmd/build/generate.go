@@ -5,11 +5,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go/format"
+	"go/scanner"
+	"go/token"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"golang.org/x/tools/imports"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -31,6 +35,7 @@ func (g Generate) All(ctx context.Context) error {
 	if err := mgr.SerialDeps(
 		ctx, self,
 		run.Meth(g, g.code),
+		run.Meth(g, g.clients),
 	); err != nil {
 		return err
 	}
@@ -100,11 +105,139 @@ func (Generate) code() error {
 	return nil
 }
 
+// Go packages of the APIs typed clients are generated for.
+var clientInputPackages = strings.Join([]string{
+	"package-operator.run/apis/core/v1alpha1",
+	"package-operator.run/apis/core/v1beta1",
+}, ",")
+
+// Generates typed clientsets, listers, informers and apply configurations into apis/client,
+// for consumers of the APIs that are not using controller-runtime.
+func (Generate) clients() error {
+	const (
+		pkg           = "package-operator.run/apis/client"
+		clientset     = pkg + "/clientset"
+		listers       = pkg + "/listers"
+		informers     = pkg + "/informers"
+		applyConfigs  = pkg + "/applyconfiguration"
+		trimPrefix    = "package-operator.run/apis"
+		goHeaderFile  = "/dev/null"
+		clientsetName = "versioned"
+	)
+	// Start from scratch, so files of an earlier generator run don't linger next to the new ones.
+	if err := os.RemoveAll(filepath.Join("apis", "client")); err != nil {
+		return fmt.Errorf("removing generated clients: %w", err)
+	}
+	apisSh := shr.New(sh.WithWorkDir("apis"))
+	commonArgs := []string{"--output-base=./", "--trim-path-prefix=" + trimPrefix, "-h", goHeaderFile}
+
+	if err := apisSh.Run("applyconfiguration-gen", append([]string{
+		"--input-dirs=" + clientInputPackages,
+		"--output-package=" + applyConfigs,
+	}, commonArgs...)...); err != nil {
+		return fmt.Errorf("generating apply configurations: %w", err)
+	}
+	clientsetErr := apisSh.Run("client-gen", append([]string{
+		"--input-base=",
+		"--input=" + clientInputPackages,
+		"--clientset-name=" + clientsetName,
+		"--output-package=" + clientset,
+		"--apply-configuration-package=" + applyConfigs,
+	}, commonArgs...)...)
+	// client-gen names parameters after the lower-cased kind, which is the "package" keyword for Package objects.
+	// It still writes the unformatted files before failing, so the parameters are renamed here.
+	fixed, err := fixKeywordParams(filepath.Join("apis", "client", "clientset"))
+	if err != nil {
+		return fmt.Errorf("fixing clientset parameter names: %w", err)
+	}
+	if clientsetErr != nil && fixed == 0 {
+		return fmt.Errorf("generating clientset: %w", clientsetErr)
+	}
+	if err := apisSh.Run("lister-gen", append([]string{
+		"--input-dirs=" + clientInputPackages,
+		"--output-package=" + listers,
+	}, commonArgs...)...); err != nil {
+		return fmt.Errorf("generating listers: %w", err)
+	}
+	if err := apisSh.Run("informer-gen", append([]string{
+		"--input-dirs=" + clientInputPackages,
+		"--versioned-clientset-package=" + clientset + "/" + clientsetName,
+		"--listers-package=" + listers,
+		"--output-package=" + informers,
+	}, commonArgs...)...); err != nil {
+		return fmt.Errorf("generating informers: %w", err)
+	}
+
+	// Generated clients depend on client-go, which the apis module does not require otherwise.
+	if err := apisSh.Run("go", "mod", "tidy"); err != nil {
+		return fmt.Errorf("tidying apis module: %w", err)
+	}
+	return nil
+}
+
+// Renames identifiers named "package" to "pkg" in all Go files below root that don't format,
+// returning the number of fixed files.
+func fixKeywordParams(root string) (int, error) {
+	var fixed int
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".go" {
+			return err
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if _, err := format.Source(src); err == nil {
+			return nil
+		}
+
+		fset := token.NewFileSet()
+		file := fset.AddFile(path, -1, len(src))
+		var s scanner.Scanner
+		s.Init(file, src, nil, scanner.ScanComments)
+
+		var (
+			out           bytes.Buffer
+			last          int
+			seenPkgClause bool
+		)
+		for {
+			pos, tok, _ := s.Scan()
+			if tok == token.EOF {
+				break
+			}
+			if tok != token.PACKAGE {
+				continue
+			}
+			if !seenPkgClause {
+				seenPkgClause = true
+				continue
+			}
+			offset := file.Offset(pos)
+			out.Write(src[last:offset])
+			out.WriteString("pkg")
+			last = offset + len("package")
+		}
+		out.Write(src[last:])
+
+		// Same post-processing as client-gen, which skips it for files that don't parse.
+		formatted, err := imports.Process("", out.Bytes(), nil)
+		if err != nil {
+			return fmt.Errorf("formatting %s: %w", path, err)
+		}
+		fixed++
+		return os.WriteFile(path, formatted, os.ModePerm)
+	})
+	return fixed, err
+}
+
 func (Generate) docs() error {
 	refPath := filepath.Join("docs", "api-reference.md")
 	return shr.Bash(
 		"k8s-docgen apis/core/v1alpha1 > "+refPath,
 		"echo >> "+refPath,
+		"k8s-docgen apis/core/v1beta1 >> "+refPath,
+		"echo >> "+refPath,
 		"k8s-docgen apis/manifests/v1alpha1 >> "+refPath,
 		"echo >> "+refPath,
 	)
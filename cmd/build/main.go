@@ -32,6 +32,10 @@ var (
 	source embed.FS
 )
 
+// All code-generator tools have to come from the same release,
+// the generated clients in apis/client are only consistent when generated in one run.
+const codeGeneratorVersion = "0.29.4"
+
 func main() {
 	ctx := context.Background()
 
@@ -41,7 +45,11 @@ func main() {
 		// Our deps
 		mgr.RegisterGoTool("gotestfmt", "github.com/gotesttools/gotestfmt/v2/cmd/gotestfmt", "2.5.0"),
 		mgr.RegisterGoTool("controller-gen", "sigs.k8s.io/controller-tools/cmd/controller-gen", "0.15.0"),
-		mgr.RegisterGoTool("conversion-gen", "k8s.io/code-generator/cmd/conversion-gen", "0.29.4"),
+		mgr.RegisterGoTool("conversion-gen", "k8s.io/code-generator/cmd/conversion-gen", codeGeneratorVersion),
+		mgr.RegisterGoTool("client-gen", "k8s.io/code-generator/cmd/client-gen", codeGeneratorVersion),
+		mgr.RegisterGoTool("lister-gen", "k8s.io/code-generator/cmd/lister-gen", codeGeneratorVersion),
+		mgr.RegisterGoTool("informer-gen", "k8s.io/code-generator/cmd/informer-gen", codeGeneratorVersion),
+		mgr.RegisterGoTool("applyconfiguration-gen", "k8s.io/code-generator/cmd/applyconfiguration-gen", codeGeneratorVersion),
 		mgr.RegisterGoTool("golangci-lint", "github.com/golangci/golangci-lint/cmd/golangci-lint", "1.60.1"),
 		mgr.RegisterGoTool("k8s-docgen", "github.com/thetechnick/k8s-docgen", "0.6.2"),
 		mgr.RegisterGoTool("helm", "helm.sh/helm/v3/cmd/helm", "3.15.3"),
@@ -0,0 +1,237 @@
+// Reference implementation of a phase controller living outside of Package Operator.
+// Only depends on the public apis and pkg modules and reconciles ObjectSetPhases of a single class
+// in the same cluster, skipping features like adoption from previous revisions and external objects.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	apis "package-operator.run/apis"
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/pkg/phasecontroller"
+	"package-operator.run/pkg/probing"
+)
+
+const (
+	fieldOwner = "example-phase-controller"
+	// Blocks deletion of ObjectSetPhases until their objects are torn down.
+	teardownFinalizer = "package-operator.run/example-phase-controller"
+)
+
+func main() {
+	var class string
+	flag.StringVar(&class, "class", "example", "class of the ObjectSetPhase to work on.")
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+	log := ctrl.Log.WithName("setup")
+
+	s := runtime.NewScheme()
+	if err := scheme.AddToScheme(s); err != nil {
+		panic(err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		panic(err)
+	}
+
+	if err := run(s, class); err != nil {
+		log.Error(err, "unable to run manager")
+		os.Exit(1)
+	}
+}
+
+func run(s *runtime.Scheme, class string) error {
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{Scheme: s})
+	if err != nil {
+		return fmt.Errorf("creating manager: %w", err)
+	}
+
+	r := &reconciler{client: mgr.GetClient()}
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&corev1alpha1.ObjectSetPhase{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetLabels()[corev1alpha1.ObjectSetPhaseClassLabel] == class
+			}),
+		)).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("creating controller: %w", err)
+	}
+
+	return mgr.Start(ctrl.SetupSignalHandler())
+}
+
+type reconciler struct {
+	client client.Client
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	phase := &corev1alpha1.ObjectSetPhase{}
+	if err := r.client.Get(ctx, req.NamespacedName, phase); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	owner := phasecontroller.NewOwnerReference(phase, corev1alpha1.GroupVersion.WithKind("ObjectSetPhase"))
+
+	if !phase.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.teardown(ctx, phase, owner)
+	}
+	if controllerutil.AddFinalizer(phase, teardownFinalizer) {
+		if err := r.client.Update(ctx, phase); err != nil {
+			return ctrl.Result{}, fmt.Errorf("adding finalizer: %w", err)
+		}
+	}
+
+	if !phase.Spec.Paused {
+		if err := r.reconcileObjects(ctx, phase, owner); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	now := metav1.Now()
+	phase.Status.LastHeartbeatTime = &now
+	if err := r.client.Status().Update(ctx, phase); err != nil {
+		return ctrl.Result{}, fmt.Errorf("updating status: %w", err)
+	}
+	// Report a heartbeat, even when nothing changes.
+	return ctrl.Result{RequeueAfter: phasecontroller.HeartbeatInterval}, nil
+}
+
+// Applies all objects of the phase, probes them and reports the results in the phase status.
+func (r *reconciler) reconcileObjects(
+	ctx context.Context, phase *corev1alpha1.ObjectSetPhase, owner phasecontroller.OwnerReference,
+) error {
+	prober, err := probing.Parse(ctx, phase.Spec.AvailabilityProbes)
+	if err != nil {
+		return fmt.Errorf("parsing probes: %w", err)
+	}
+
+	var (
+		controllerOf []corev1alpha1.ControlledObjectReference
+		failures     []string
+	)
+	for _, phaseObject := range phase.Spec.Objects {
+		if phaseObject.External != nil {
+			continue
+		}
+		obj, err := r.apply(ctx, phase, owner, phaseObject.Object)
+		if err != nil {
+			return err
+		}
+
+		gvk := obj.GroupVersionKind()
+		controllerOf = append(controllerOf, corev1alpha1.ControlledObjectReference{
+			Kind:      gvk.Kind,
+			Group:     gvk.Group,
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+		})
+		if success, message := prober.Probe(obj); !success {
+			failures = append(failures, fmt.Sprintf("%s %s: %s", gvk.Kind, obj.GetName(), message))
+		}
+	}
+
+	phase.Status.ControllerOf = controllerOf
+	if len(failures) > 0 {
+		phasecontroller.SetProbeFailure(&phase.Status.Conditions, phase.Generation, strings.Join(failures, "\n"))
+	} else {
+		phasecontroller.SetAvailable(&phase.Status.Conditions, phase.Generation)
+	}
+	return nil
+}
+
+// Creates or updates the object with the phase as controller, keeping owners added by others.
+func (r *reconciler) apply(
+	ctx context.Context, phase *corev1alpha1.ObjectSetPhase,
+	owner phasecontroller.OwnerReference, desired unstructured.Unstructured,
+) (*unstructured.Unstructured, error) {
+	obj := desired.DeepCopy()
+	if len(obj.GetNamespace()) == 0 {
+		obj.SetNamespace(phase.Namespace)
+	}
+
+	actual := &unstructured.Unstructured{}
+	actual.SetGroupVersionKind(obj.GroupVersionKind())
+	err := r.client.Get(ctx, client.ObjectKeyFromObject(obj), actual)
+	switch {
+	case apimachineryerrors.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("getting object: %w", err)
+	default:
+		ownerRefs, err := phasecontroller.GetOwnerReferences(actual)
+		if err != nil {
+			return nil, err
+		}
+		phasecontroller.SetOwnerReferences(obj, ownerRefs)
+	}
+
+	if err := phasecontroller.SetControllerReference(owner, obj); err != nil {
+		return nil, err
+	}
+	if err := phasecontroller.Apply(ctx, r.client, fieldOwner, obj, client.Apply, client.ForceOwnership); err != nil {
+		return nil, fmt.Errorf("applying object: %w", err)
+	}
+	return obj, nil
+}
+
+// Deletes all objects controlled by the phase, unless the phase is orphaning its objects.
+func (r *reconciler) teardown(
+	ctx context.Context, phase *corev1alpha1.ObjectSetPhase, owner phasecontroller.OwnerReference,
+) error {
+	if !controllerutil.ContainsFinalizer(phase, phasecontroller.OrphanFinalizer) {
+		for _, phaseObject := range phase.Spec.Objects {
+			if phaseObject.External != nil ||
+				phaseObject.DeletionPolicy == corev1alpha1.ObjectDeletionPolicyOrphan {
+				continue
+			}
+			if err := r.deleteObject(ctx, phase, owner, phaseObject.Object); err != nil {
+				return err
+			}
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(phase, teardownFinalizer) {
+		if err := r.client.Update(ctx, phase); err != nil {
+			return fmt.Errorf("removing finalizer: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) deleteObject(
+	ctx context.Context, phase *corev1alpha1.ObjectSetPhase,
+	owner phasecontroller.OwnerReference, desired unstructured.Unstructured,
+) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(desired.GroupVersionKind())
+	key := client.ObjectKeyFromObject(&desired)
+	if len(key.Namespace) == 0 {
+		key.Namespace = phase.Namespace
+	}
+	if err := r.client.Get(ctx, key, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !phasecontroller.IsController(owner, obj) {
+		// Taken over by another phase, e.g. of a newer revision.
+		return nil
+	}
+	if err := r.client.Delete(ctx, obj); err != nil && !apimachineryerrors.IsNotFound(err) {
+		return fmt.Errorf("deleting object: %w", err)
+	}
+	return nil
+}
@@ -22,10 +22,12 @@ type Builder interface {
 
 func NewCmd(builderFactory BuilderFactory) *cobra.Command {
 	const (
-		buildUse   = "build source_path [--tag tag]... [--output output_path] [--push]"
+		buildUse = "build source_path [--tag tag]... [--output output_path] [--push] " +
+			"[--platform os/arch]... [--sbom format] [--strict]"
 		buildShort = "build an PKO package image using manifests at the given path"
 		buildLong  = "builds and optionally pushes an OCI image in the Package Operator" +
-			" package format from the specified build context directory."
+			" package format from the specified build context directory." +
+			" When platforms are given, a multi-arch OCI image index is built instead of a single image."
 		buildSuccessMessage = "Package built successfully!"
 	)
 
@@ -60,6 +62,10 @@ func NewCmd(builderFactory BuilderFactory) *cobra.Command {
 			internalcmd.WithOutputPath(opts.OutputPath),
 			internalcmd.WithPush(opts.Push),
 			internalcmd.WithTags(opts.Tags),
+			internalcmd.WithPlatforms(opts.Platforms),
+			internalcmd.WithSBOM(opts.SBOM),
+			internalcmd.WithStrict(opts.Strict),
+			internalcmd.WithArtifact(opts.Artifact),
 		); err != nil {
 			return fmt.Errorf("building from source: %w", err)
 		}
@@ -78,6 +84,10 @@ type options struct {
 	OutputPath string
 	Push       bool
 	Tags       []string
+	Platforms  []string
+	SBOM       string
+	Strict     bool
+	Artifact   bool
 }
 
 func (o *options) AddFlags(flags *pflag.FlagSet) {
@@ -112,4 +122,42 @@ func (o *options) AddFlags(flags *pflag.FlagSet) {
 			"Defaults to none.",
 		}, " "),
 	)
+	flags.StringSliceVar(
+		&o.Platforms,
+		"platform",
+		o.Platforms,
+		strings.Join([]string{
+			"Platforms to build an OCI image index for, e.g. linux/amd64,linux/arm64.",
+			"May be specified multiple times.",
+			"Defaults to a single linux/amd64 image.",
+		}, " "),
+	)
+	flags.StringVar(
+		&o.SBOM,
+		"sbom",
+		o.SBOM,
+		strings.Join([]string{
+			"Generate an SBOM in the given format (spdx|cyclonedx).",
+			"The SBOM is attached to pushed images as OCI referrer",
+			"and written next to the output file with a .sbom.json suffix.",
+		}, " "),
+	)
+	flags.BoolVar(
+		&o.Strict,
+		"strict",
+		o.Strict,
+		strings.Join([]string{
+			"Fail on template values that resolve to nothing and on config fields unknown to the config schema,",
+			"even if strict rendering is not enabled in the PackageManifest.",
+		}, " "),
+	)
+	flags.BoolVar(
+		&o.Artifact,
+		"artifact",
+		o.Artifact,
+		strings.Join([]string{
+			"Build an OCI artifact of type application/vnd.package-operator.package instead of a container image.",
+			"Artifacts are platform agnostic and can not be combined with --platform.",
+		}, " "),
+	)
 }
@@ -146,6 +146,99 @@ func TestBuildWithPath(t *testing.T) {
 	require.Empty(t, stderr.String())
 }
 
+func TestBuildOutputMultiPlatform(t *testing.T) {
+	t.Parallel()
+
+	dst := filepath.Join(t.TempDir(), "pkg.tar")
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	packagePath := filepath.Join(wd, "testdata")
+
+	factory := &builderFactoryMock{}
+	factory.On("Builder").Return(internalcmd.NewBuild())
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{
+		packagePath, "--tag", "chicken:oldest", "--output", dst,
+		"--platform", "linux/amd64,linux/arm64",
+	})
+
+	require.NoError(t, cmd.Execute())
+	require.EqualValues(t, "Package built successfully!", stdout.String())
+	require.Empty(t, stderr.String())
+
+	// Image indexes are not representable in the docker tarball format.
+	_, err = tarball.ImageFromPath(dst, nil)
+	require.Error(t, err)
+}
+
+func TestBuildOutputSBOM(t *testing.T) {
+	t.Parallel()
+
+	dst := filepath.Join(t.TempDir(), "pkg.tar")
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	packagePath := filepath.Join(wd, "testdata")
+
+	factory := &builderFactoryMock{}
+	factory.On("Builder").Return(internalcmd.NewBuild())
+
+	cmd := NewCmd(factory)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{packagePath, "--tag", "chicken:oldest", "--output", dst, "--sbom", "spdx"})
+
+	require.NoError(t, cmd.Execute())
+
+	sbom, err := os.ReadFile(internalcmd.SBOMOutputPath(dst))
+	require.NoError(t, err)
+	require.Contains(t, string(sbom), `"spdxVersion": "SPDX-2.3"`)
+}
+
+func TestBuildInvalidSBOMFormat(t *testing.T) {
+	t.Parallel()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	packagePath := filepath.Join(wd, "testdata")
+
+	factory := &builderFactoryMock{}
+	factory.On("Builder").Return(internalcmd.NewBuild())
+
+	cmd := NewCmd(factory)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{packagePath, "--sbom", "swid"})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidOptions)
+}
+
+func TestBuildInvalidPlatform(t *testing.T) {
+	t.Parallel()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	packagePath := filepath.Join(wd, "testdata")
+
+	factory := &builderFactoryMock{}
+	factory.On("Builder").Return(internalcmd.NewBuild())
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{packagePath, "--platform", "linux/amd64/v1/extra"})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidOptions)
+}
+
 type builderFactoryMock struct {
 	mock.Mock
 }
@@ -0,0 +1,26 @@
+package convertcmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type HelmConverter interface {
+	ConvertHelm(ctx context.Context, chart string, opts internalcmd.ConvertHelmOptions) (msg string, err error)
+	AdoptHelmRelease(ctx context.Context, release string, opts internalcmd.AdoptHelmReleaseOptions) (msg string, err error)
+}
+
+func NewCmd(helmConverter HelmConverter, clientFactory internalcmd.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "converts packages from other formats",
+	}
+
+	cmd.AddCommand(newHelmCmd(helmConverter))
+	cmd.AddCommand(newHelmReleaseCmd(helmConverter, clientFactory))
+
+	return cmd
+}
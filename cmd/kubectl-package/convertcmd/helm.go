@@ -0,0 +1,111 @@
+package convertcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func newHelmCmd(helmConverter HelmConverter) *cobra.Command {
+	const (
+		cmdUse   = "helm chart (experimental)"
+		cmdShort = "converts a Helm chart into a new package"
+		cmdLong  = "renders the Helm chart via `helm template` using the given values and converts the output " +
+			"into a new package in folder <name>. Install and upgrade hooks are mapped to the hooks-pre and " +
+			"hooks-post phases, all other hooks are dropped. Requires the helm binary."
+	)
+
+	var opts helmOptions
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if args[0] == "" {
+			return fmt.Errorf("%w: chart empty", internalcmd.ErrInvalidArgs)
+		}
+
+		msg, err := helmConverter.ConvertHelm(cmd.Context(), args[0], internalcmd.ConvertHelmOptions{
+			PkgName:     opts.Name,
+			Version:     opts.Version,
+			Namespace:   opts.Namespace,
+			ValuesFiles: opts.ValuesFiles,
+			SetValues:   opts.SetValues,
+			ParamOpts:   opts.ParamOpts,
+			HelmBinary:  opts.HelmBinary,
+		})
+		if err != nil {
+			return fmt.Errorf("converting helm chart: %w", err)
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), msg)
+		return err
+	}
+
+	return cmd
+}
+
+type helmOptions struct {
+	Name        string
+	Version     string
+	Namespace   string
+	ValuesFiles []string
+	SetValues   []string
+	ParamOpts   []string
+	HelmBinary  string
+}
+
+func (o *helmOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Name,
+		"name",
+		"",
+		"Name of the package and output folder. Defaults to the chart name.",
+	)
+	flags.StringVar(
+		&o.Version,
+		"version",
+		"",
+		"Chart version to use when pulling from a repository.",
+	)
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		"",
+		"Namespace to render the chart for.",
+	)
+	flags.StringSliceVarP(
+		&o.ValuesFiles,
+		"values",
+		"f",
+		nil,
+		"Values files to render the chart with. Can be supplied multiple times.",
+	)
+	flags.StringArrayVar(
+		&o.SetValues,
+		"set",
+		nil,
+		"Set values on the command line, e.g. key1=val1. Can be supplied multiple times.",
+	)
+	flags.StringSliceVarP(
+		&o.ParamOpts,
+		"parametrize",
+		"p",
+		nil,
+		"Parametrize flags: e.g. replicas.",
+	)
+	flags.StringVar(
+		&o.HelmBinary,
+		"helm-binary",
+		"helm",
+		"Path to the helm binary.",
+	)
+}
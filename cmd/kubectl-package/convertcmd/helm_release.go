@@ -0,0 +1,111 @@
+package convertcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func newHelmReleaseCmd(helmConverter HelmConverter, clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "helm-release release (experimental)"
+		cmdShort = "converts a deployed Helm release into a new package adopting its objects"
+		cmdLong  = "loads the manifest of the deployed Helm release via `helm get manifest` and converts it " +
+			"into a new package in folder <name>, that adopts the existing objects of the release without " +
+			"recreating them. With --supersede, the objects are kept when the release is uninstalled " +
+			"and the release is marked as superseded, so Helm no longer manages it. Requires the helm binary."
+	)
+
+	var opts helmReleaseOptions
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if args[0] == "" {
+			return fmt.Errorf("%w: release empty", internalcmd.ErrInvalidArgs)
+		}
+		if opts.Supersede && opts.Namespace == "" {
+			return fmt.Errorf("%w: --namespace is required with --supersede", internalcmd.ErrInvalidArgs)
+		}
+
+		msg, err := helmConverter.AdoptHelmRelease(cmd.Context(), args[0], internalcmd.AdoptHelmReleaseOptions{
+			PkgName:    opts.Name,
+			Namespace:  opts.Namespace,
+			ParamOpts:  opts.ParamOpts,
+			HelmBinary: opts.HelmBinary,
+		})
+		if err != nil {
+			return fmt.Errorf("converting helm release: %w", err)
+		}
+
+		if opts.Supersede {
+			client, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			n, err := client.SupersedeHelmRelease(cmd.Context(), args[0], opts.Namespace)
+			if err != nil {
+				return fmt.Errorf("superseding helm release: %w", err)
+			}
+			msg += fmt.Sprintf("\nMarked the %q Helm release as superseded and kept its %d objects on uninstall.\n",
+				args[0], n)
+		}
+
+		_, err = fmt.Fprint(cmd.OutOrStdout(), msg)
+		return err
+	}
+
+	return cmd
+}
+
+type helmReleaseOptions struct {
+	Name       string
+	Namespace  string
+	ParamOpts  []string
+	HelmBinary string
+	Supersede  bool
+}
+
+func (o *helmReleaseOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Name,
+		"name",
+		"",
+		"Name of the package and output folder. Defaults to the release name.",
+	)
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		"",
+		"Namespace of the Helm release.",
+	)
+	flags.StringSliceVarP(
+		&o.ParamOpts,
+		"parametrize",
+		"p",
+		nil,
+		"Parametrize flags: e.g. replicas.",
+	)
+	flags.StringVar(
+		&o.HelmBinary,
+		"helm-binary",
+		"helm",
+		"Path to the helm binary.",
+	)
+	flags.BoolVar(
+		&o.Supersede,
+		"supersede",
+		false,
+		"Keep the objects of the release on uninstall and mark the release as superseded.",
+	)
+}
@@ -0,0 +1,100 @@
+package convertcmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestHelm(t *testing.T) {
+	t.Parallel()
+
+	converter := &helmConverterMock{}
+	converter.
+		On("ConvertHelm", mock.Anything, "bitnami/nginx", internalcmd.ConvertHelmOptions{
+			PkgName:     "web",
+			Namespace:   "web",
+			ValuesFiles: []string{"a.yaml", "b.yaml"},
+			SetValues:   []string{"replicas=2,image.tag=1"},
+			HelmBinary:  "helm",
+		}).
+		Return("converted", nil)
+
+	stdout := &bytes.Buffer{}
+
+	cmd := NewCmd(converter, nil)
+	cmd.SetOut(stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{
+		"helm", "bitnami/nginx", "--name", "web", "-n", "web",
+		"-f", "a.yaml", "-f", "b.yaml", "--set", "replicas=2,image.tag=1",
+	})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "converted", stdout.String())
+	converter.AssertExpectations(t)
+}
+
+func TestHelmRelease(t *testing.T) {
+	t.Parallel()
+
+	converter := &helmConverterMock{}
+	converter.
+		On("AdoptHelmRelease", mock.Anything, "web", internalcmd.AdoptHelmReleaseOptions{
+			PkgName:    "my-web",
+			Namespace:  "web",
+			HelmBinary: "helm",
+		}).
+		Return("adopted", nil)
+
+	stdout := &bytes.Buffer{}
+
+	cmd := NewCmd(converter, nil)
+	cmd.SetOut(stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"helm-release", "web", "--name", "my-web", "-n", "web"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "adopted", stdout.String())
+	converter.AssertExpectations(t)
+}
+
+func TestHelmRelease_supersedeWithoutNamespace(t *testing.T) {
+	t.Parallel()
+
+	converter := &helmConverterMock{}
+
+	cmd := NewCmd(converter, nil)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"helm-release", "web", "--supersede"})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidArgs)
+	converter.AssertNotCalled(t, "AdoptHelmRelease", mock.Anything, mock.Anything, mock.Anything)
+}
+
+type helmConverterMock struct {
+	mock.Mock
+}
+
+func (m *helmConverterMock) ConvertHelm(
+	ctx context.Context, chart string, opts internalcmd.ConvertHelmOptions,
+) (string, error) {
+	args := m.Called(ctx, chart, opts)
+
+	return args.String(0), args.Error(1)
+}
+
+func (m *helmConverterMock) AdoptHelmRelease(
+	ctx context.Context, release string, opts internalcmd.AdoptHelmReleaseOptions,
+) (string, error) {
+	args := m.Called(ctx, release, opts)
+
+	return args.String(0), args.Error(1)
+}
@@ -9,13 +9,26 @@ import (
 
 	"package-operator.run/cmd/kubectl-package/buildcmd"
 	clustertreecmd "package-operator.run/cmd/kubectl-package/clustertreecmd"
+	"package-operator.run/cmd/kubectl-package/convertcmd"
+	"package-operator.run/cmd/kubectl-package/explaincmd"
+	"package-operator.run/cmd/kubectl-package/initcmd"
+	"package-operator.run/cmd/kubectl-package/inspectcmd"
+	"package-operator.run/cmd/kubectl-package/installcmd"
 	"package-operator.run/cmd/kubectl-package/kickstartcmd"
+	"package-operator.run/cmd/kubectl-package/pushcmd"
+	"package-operator.run/cmd/kubectl-package/rbaccmd"
+	"package-operator.run/cmd/kubectl-package/rendercmd"
 	"package-operator.run/cmd/kubectl-package/repocmd"
 	"package-operator.run/cmd/kubectl-package/rolloutcmd"
 	"package-operator.run/cmd/kubectl-package/rootcmd"
+	"package-operator.run/cmd/kubectl-package/runcmd"
+	"package-operator.run/cmd/kubectl-package/signcmd"
+	"package-operator.run/cmd/kubectl-package/statuscmd"
 	"package-operator.run/cmd/kubectl-package/treecmd"
+	"package-operator.run/cmd/kubectl-package/triggercmd"
 	"package-operator.run/cmd/kubectl-package/updatecmd"
 	"package-operator.run/cmd/kubectl-package/validatecmd"
+	"package-operator.run/cmd/kubectl-package/verifycmd"
 	"package-operator.run/cmd/kubectl-package/versioncmd"
 	internalcmd "package-operator.run/internal/cmd"
 )
@@ -52,6 +65,30 @@ func ProvideClusterTreeCmd(clientFactory internalcmd.ClientFactory) RootSubComma
 	}
 }
 
+func ProvideInstallCmd(clientFactory internalcmd.ClientFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: installcmd.NewInstallCmd(clientFactory),
+	}
+}
+
+func ProvideUpgradeCmd(clientFactory internalcmd.ClientFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: installcmd.NewUpgradeCmd(clientFactory),
+	}
+}
+
+func ProvideStatusCmd(clientFactory internalcmd.ClientFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: statuscmd.NewCmd(clientFactory),
+	}
+}
+
+func ProvideTriggerCmd(clientFactory internalcmd.ClientFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: triggercmd.NewCmd(clientFactory),
+	}
+}
+
 func ProvideRendererFactory(scheme *runtime.Scheme, f LogFactory) treecmd.RendererFactory {
 	return &defaultRendererFactory{
 		logFactory: f,
@@ -73,6 +110,43 @@ func (f *defaultRendererFactory) Renderer() treecmd.Renderer {
 	)
 }
 
+func ProvideRenderCmd(rendererFactory rendercmd.RendererFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: rendercmd.NewCmd(
+			rendererFactory,
+		),
+	}
+}
+
+func ProvideRBACCmd(rendererFactory rendercmd.RendererFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: rbaccmd.NewCmd(
+			rendererFactory,
+		),
+	}
+}
+
+func ProvideManifestRendererFactory(scheme *runtime.Scheme, f LogFactory) rendercmd.RendererFactory {
+	return &defaultManifestRendererFactory{
+		logFactory: f,
+		scheme:     scheme,
+	}
+}
+
+type defaultManifestRendererFactory struct {
+	logFactory LogFactory
+	scheme     *runtime.Scheme
+}
+
+func (f *defaultManifestRendererFactory) Renderer() rendercmd.Renderer {
+	return internalcmd.NewRender(
+		f.scheme,
+		internalcmd.WithLog{
+			Log: f.logFactory.Logger(),
+		},
+	)
+}
+
 func ProvideUpdateCmd(updater updatecmd.Updater) RootSubCommandResult {
 	return RootSubCommandResult{
 		SubCommand: updatecmd.NewCmd(
@@ -127,6 +201,137 @@ func (f *defaultBuilderFactory) Builder() buildcmd.Builder {
 	)
 }
 
+func ProvideInspectCmd(inspectorFactory inspectcmd.InspectorFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: inspectcmd.NewCmd(
+			inspectorFactory,
+		),
+	}
+}
+
+func ProvideInspectorFactory(f LogFactory) inspectcmd.InspectorFactory {
+	return &defaultInspectorFactory{
+		logFactory: f,
+	}
+}
+
+type defaultInspectorFactory struct {
+	logFactory LogFactory
+}
+
+func (f *defaultInspectorFactory) Inspector() inspectcmd.Inspector {
+	return internalcmd.NewInspect(
+		internalcmd.WithLog{
+			Log: f.logFactory.Logger(),
+		},
+	)
+}
+
+func ProvidePushCmd(pusherFactory pushcmd.PusherFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: pushcmd.NewCmd(
+			pusherFactory,
+		),
+	}
+}
+
+func ProvidePusherFactory(f LogFactory) pushcmd.PusherFactory {
+	return &defaultPusherFactory{
+		logFactory: f,
+	}
+}
+
+type defaultPusherFactory struct {
+	logFactory LogFactory
+}
+
+func (f *defaultPusherFactory) Pusher() pushcmd.Pusher {
+	return internalcmd.NewPush(
+		internalcmd.WithLog{
+			Log: f.logFactory.Logger(),
+		},
+	)
+}
+
+func ProvideRunCmd(clientFactory internalcmd.ClientFactory, runnerFactory runcmd.RunnerFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: runcmd.NewCmd(
+			clientFactory,
+			runnerFactory,
+		),
+	}
+}
+
+func ProvideRunnerFactory(f LogFactory) runcmd.RunnerFactory {
+	return &defaultRunnerFactory{
+		logFactory: f,
+	}
+}
+
+type defaultRunnerFactory struct {
+	logFactory LogFactory
+}
+
+func (f *defaultRunnerFactory) Runner() runcmd.Runner {
+	return internalcmd.NewRun(
+		internalcmd.WithLog{
+			Log: f.logFactory.Logger(),
+		},
+	)
+}
+
+func ProvideSignCmd(signerFactory signcmd.SignerFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: signcmd.NewCmd(
+			signerFactory,
+		),
+	}
+}
+
+func ProvideSignerFactory(f LogFactory) signcmd.SignerFactory {
+	return &defaultSignerFactory{
+		logFactory: f,
+	}
+}
+
+type defaultSignerFactory struct {
+	logFactory LogFactory
+}
+
+func (f *defaultSignerFactory) Signer() signcmd.Signer {
+	return internalcmd.NewSignature(
+		internalcmd.WithLog{
+			Log: f.logFactory.Logger(),
+		},
+	)
+}
+
+func ProvideVerifyCmd(verifierFactory verifycmd.VerifierFactory) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: verifycmd.NewCmd(
+			verifierFactory,
+		),
+	}
+}
+
+func ProvideVerifierFactory(f LogFactory) verifycmd.VerifierFactory {
+	return &defaultVerifierFactory{
+		logFactory: f,
+	}
+}
+
+type defaultVerifierFactory struct {
+	logFactory LogFactory
+}
+
+func (f *defaultVerifierFactory) Verifier() verifycmd.Verifier {
+	return internalcmd.NewSignature(
+		internalcmd.WithLog{
+			Log: f.logFactory.Logger(),
+		},
+	)
+}
+
 func ProvideVersionCmd() RootSubCommandResult {
 	return RootSubCommandResult{
 		SubCommand: versioncmd.NewCmd(),
@@ -163,6 +368,28 @@ func ProvideRolloutHistoryCmd(clientFactory internalcmd.ClientFactory) RolloutSu
 	}
 }
 
+func ProvideConvertCmd(
+	helmConverter convertcmd.HelmConverter, clientFactory internalcmd.ClientFactory,
+) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: convertcmd.NewCmd(helmConverter, clientFactory),
+	}
+}
+
+func ProvideHelmConverter() convertcmd.HelmConverter {
+	return internalcmd.NewKickstarter(os.Stdin)
+}
+
+func ProvideInitCmd(scaffolder initcmd.Scaffolder) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: initcmd.NewCmd(scaffolder),
+	}
+}
+
+func ProvideScaffolder() initcmd.Scaffolder {
+	return internalcmd.NewScaffolder()
+}
+
 func ProvideClientFactory(kcliFactory internalcmd.KubeClientFactory) internalcmd.ClientFactory {
 	return internalcmd.NewDefaultClientFactory(kcliFactory)
 }
@@ -170,3 +397,13 @@ func ProvideClientFactory(kcliFactory internalcmd.KubeClientFactory) internalcmd
 func ProvideKickstarter() kickstartcmd.Kickstarter {
 	return internalcmd.NewKickstarter(os.Stdin)
 }
+
+func ProvideExplainCmd(explainer explaincmd.Explainer) RootSubCommandResult {
+	return RootSubCommandResult{
+		SubCommand: explaincmd.NewCmd(explainer),
+	}
+}
+
+func ProvideExplainer() explaincmd.Explainer {
+	return internalcmd.NewExplain()
+}
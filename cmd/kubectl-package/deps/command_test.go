@@ -22,6 +22,71 @@ func TestDefaultBuilderFactory(t *testing.T) {
 	require.NotNil(t, factory.Builder())
 }
 
+func TestDefaultInspectorFactory(t *testing.T) {
+	t.Parallel()
+
+	logFactoryMock := &logFactoryMock{}
+	logFactoryMock.On("Logger").Return(logr.Discard())
+
+	factory := &defaultInspectorFactory{
+		logFactory: logFactoryMock,
+	}
+
+	require.NotNil(t, factory.Inspector())
+}
+
+func TestDefaultPusherFactory(t *testing.T) {
+	t.Parallel()
+
+	logFactoryMock := &logFactoryMock{}
+	logFactoryMock.On("Logger").Return(logr.Discard())
+
+	factory := &defaultPusherFactory{
+		logFactory: logFactoryMock,
+	}
+
+	require.NotNil(t, factory.Pusher())
+}
+
+func TestDefaultRunnerFactory(t *testing.T) {
+	t.Parallel()
+
+	logFactoryMock := &logFactoryMock{}
+	logFactoryMock.On("Logger").Return(logr.Discard())
+
+	factory := &defaultRunnerFactory{
+		logFactory: logFactoryMock,
+	}
+
+	require.NotNil(t, factory.Runner())
+}
+
+func TestDefaultSignerFactory(t *testing.T) {
+	t.Parallel()
+
+	logFactoryMock := &logFactoryMock{}
+	logFactoryMock.On("Logger").Return(logr.Discard())
+
+	factory := &defaultSignerFactory{
+		logFactory: logFactoryMock,
+	}
+
+	require.NotNil(t, factory.Signer())
+}
+
+func TestDefaultVerifierFactory(t *testing.T) {
+	t.Parallel()
+
+	logFactoryMock := &logFactoryMock{}
+	logFactoryMock.On("Logger").Return(logr.Discard())
+
+	factory := &defaultVerifierFactory{
+		logFactory: logFactoryMock,
+	}
+
+	require.NotNil(t, factory.Verifier())
+}
+
 func TestDefaultRendererFactory(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +101,20 @@ func TestDefaultRendererFactory(t *testing.T) {
 	require.NotNil(t, factory.Renderer())
 }
 
+func TestDefaultManifestRendererFactory(t *testing.T) {
+	t.Parallel()
+
+	logFactoryMock := &logFactoryMock{}
+	logFactoryMock.On("Logger").Return(logr.Discard())
+
+	factory := &defaultManifestRendererFactory{
+		scheme:     runtime.NewScheme(),
+		logFactory: logFactoryMock,
+	}
+
+	require.NotNil(t, factory.Renderer())
+}
+
 type logFactoryMock struct {
 	mock.Mock
 }
@@ -25,6 +25,10 @@ func constructors() []any {
 		ProvideArgs,
 		ProvideTreeCmd,
 		ProvideClusterTreeCmd,
+		ProvideInstallCmd,
+		ProvideUpgradeCmd,
+		ProvideStatusCmd,
+		ProvideTriggerCmd,
 		ProvideUpdateCmd,
 		ProvideValidateCmd,
 		ProvideBuildCmd,
@@ -35,13 +39,32 @@ func constructors() []any {
 		ProvideRestConfigFactory,
 		ProvideUpdater,
 		ProvideBuilderFactory,
+		ProvideInspectCmd,
+		ProvideInspectorFactory,
+		ProvidePushCmd,
+		ProvidePusherFactory,
+		ProvideRunCmd,
+		ProvideRunnerFactory,
+		ProvideSignCmd,
+		ProvideSignerFactory,
+		ProvideVerifyCmd,
+		ProvideVerifierFactory,
 		ProvideValidator,
 		ProvideRendererFactory,
+		ProvideRenderCmd,
+		ProvideRBACCmd,
+		ProvideManifestRendererFactory,
 		ProvideRolloutCmd,
 		ProvideClientFactory,
 		ProvideRolloutHistoryCmd,
 		ProvideRepoCmd,
 		ProvideKickstartCmd,
 		ProvideKickstarter,
+		ProvideConvertCmd,
+		ProvideHelmConverter,
+		ProvideInitCmd,
+		ProvideScaffolder,
+		ProvideExplainCmd,
+		ProvideExplainer,
 	}
 }
@@ -0,0 +1,95 @@
+package explaincmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"package-operator.run/internal/cli"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type Explainer interface {
+	Explain(ctx context.Context, path string) (*internalcmd.Explanation, error)
+}
+
+func NewCmd(explainer Explainer) *cobra.Command {
+	const (
+		cmdUse   = "explain [path] [--output format]"
+		cmdShort = "documents package manifests, template context, annotations and template functions"
+		cmdLong  = "documents the fields of the PackageManifest and PackageManifestLock, " +
+			"the template context available to templates and CEL expressions, " +
+			"the annotations interpreted on package objects and the available template functions. " +
+			"Paths start with a topic and may continue with field names, e.g. manifest.spec.phases. " +
+			"Documentation is generated from the API types of this kubectl-package version. " +
+			"Run without path to list all topics."
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.MaximumNArgs(1),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var path string
+		if len(args) == 1 {
+			path = args[0]
+		}
+
+		exp, err := explainer.Explain(cmd.Context(), path)
+		if err != nil {
+			return fmt.Errorf("explaining %q: %w", path, err)
+		}
+
+		printer := cli.NewPrinter(cli.WithOut{Out: cmd.OutOrStdout()})
+
+		switch strings.ToLower(opts.Output) {
+		case "json":
+			data, err := exp.RenderJSON()
+			if err != nil {
+				return fmt.Errorf("rendering explanation to json: %w", err)
+			}
+
+			return printer.PrintfOut("%s\n", string(data))
+		case "yaml":
+			data, err := exp.RenderYAML()
+			if err != nil {
+				return fmt.Errorf("rendering explanation to yaml: %w", err)
+			}
+
+			return printer.PrintfOut("%s", string(data))
+		case "":
+			return printer.PrintfOut("%s", exp.RenderText())
+		default:
+			return fmt.Errorf("%w: %q", errInvalidOutputFormat, opts.Output)
+		}
+	}
+
+	return cmd
+}
+
+var errInvalidOutputFormat = errors.New("invalid output format")
+
+type options struct {
+	Output string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Output,
+		"output",
+		"o",
+		o.Output,
+		"Output format. One of: json|yaml",
+	)
+}
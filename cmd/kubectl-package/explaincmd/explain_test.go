@@ -0,0 +1,116 @@
+package explaincmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	exp := &internalcmd.Explanation{
+		Path:        "manifest.spec.phases",
+		Type:        "[]PackageManifestPhase",
+		Description: "Phases of the package.",
+		Fields: []internalcmd.ExplanationField{
+			{Name: "name", Type: "string", Description: "Name of the phase."},
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		assert func(t *testing.T, out string)
+	}{
+		{
+			name: "text",
+			assert: func(t *testing.T, out string) {
+				t.Helper()
+				assert.Contains(t, out, "manifest.spec.phases")
+				assert.Contains(t, out, "name <string>")
+			},
+		},
+		{
+			name: "json",
+			args: []string{"-o", "json"},
+			assert: func(t *testing.T, out string) {
+				t.Helper()
+				var got internalcmd.Explanation
+				require.NoError(t, json.Unmarshal([]byte(out), &got))
+				assert.Equal(t, *exp, got)
+			},
+		},
+		{
+			name: "yaml",
+			args: []string{"-o", "yaml"},
+			assert: func(t *testing.T, out string) {
+				t.Helper()
+				assert.Contains(t, out, "path: manifest.spec.phases\n")
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			explainer := &explainerMock{}
+			explainer.On("Explain", mock.Anything, "manifest.spec.phases").Return(exp, nil)
+
+			cmd := NewCmd(explainer)
+			stdout := &bytes.Buffer{}
+			cmd.SetOut(stdout)
+			cmd.SetArgs(append([]string{"manifest.spec.phases"}, tc.args...))
+
+			require.NoError(t, cmd.Execute())
+			tc.assert(t, stdout.String())
+		})
+	}
+}
+
+func TestExplainTopics(t *testing.T) {
+	t.Parallel()
+
+	explainer := &explainerMock{}
+	explainer.On("Explain", mock.Anything, "").Return(&internalcmd.Explanation{
+		Values: []internalcmd.ExplanationValue{{Name: "manifest"}},
+	}, nil)
+
+	cmd := NewCmd(explainer)
+	stdout := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetArgs([]string{})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "manifest")
+}
+
+func TestExplainInvalidOutput(t *testing.T) {
+	t.Parallel()
+
+	explainer := &explainerMock{}
+	explainer.On("Explain", mock.Anything, "manifest").Return(&internalcmd.Explanation{}, nil)
+
+	cmd := NewCmd(explainer)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"manifest", "-o", "banana"})
+
+	require.ErrorIs(t, cmd.Execute(), errInvalidOutputFormat)
+}
+
+type explainerMock struct {
+	mock.Mock
+}
+
+func (m *explainerMock) Explain(ctx context.Context, path string) (*internalcmd.Explanation, error) {
+	args := m.Called(ctx, path)
+
+	return args.Get(0).(*internalcmd.Explanation), args.Error(1)
+}
@@ -0,0 +1,174 @@
+package initcmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type Scaffolder interface {
+	ScaffoldPackage(
+		ctx context.Context, path string, opts ...internalcmd.ScaffoldPackageOption,
+	) ([]string, error)
+}
+
+func NewCmd(scaffolder Scaffolder) *cobra.Command {
+	const (
+		cmdUse   = "init [path] [--name name] [--scopes scopes] [--phases phases] [--interactive]"
+		cmdShort = "scaffolds a new package"
+		cmdLong  = "scaffolds a new package in the given directory (defaults to the current directory), " +
+			"containing a PackageManifest with the chosen scopes and phases, an example object, " +
+			"test templates and a .gitignore."
+	)
+
+	var opts options
+
+	cmd := &cobra.Command{
+		Args:  cobra.MaximumNArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		path := "."
+		if len(args) == 1 {
+			path = args[0]
+		}
+		if path == "" {
+			return fmt.Errorf("%w: target path empty", internalcmd.ErrInvalidArgs)
+		}
+
+		if opts.Interactive {
+			if err := opts.Prompt(cmd.InOrStdin(), cmd.OutOrStdout(), path); err != nil {
+				return fmt.Errorf("prompting: %w", err)
+			}
+		}
+
+		files, err := scaffolder.ScaffoldPackage(
+			cmd.Context(), path,
+			internalcmd.WithPackageName(opts.Name),
+			internalcmd.WithScopes(opts.Scopes),
+			internalcmd.WithPhases(opts.Phases),
+		)
+		if err != nil {
+			return fmt.Errorf("scaffolding package: %w", err)
+		}
+
+		out := cmd.OutOrStdout()
+		if _, err := fmt.Fprintf(out, "Scaffolded a new package in %q:\n", path); err != nil {
+			return err
+		}
+		for _, file := range files {
+			if _, err := fmt.Fprintf(out, "  %s\n", file); err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintf(out, "Run \"kubectl package validate %s\" to generate test fixtures.\n", path)
+
+		return err
+	}
+
+	return cmd
+}
+
+type options struct {
+	Name        string
+	Scopes      []string
+	Phases      []string
+	Interactive bool
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Name,
+		"name",
+		o.Name,
+		"Name of the package. Defaults to the name of the target directory.",
+	)
+	flags.StringSliceVar(
+		&o.Scopes,
+		"scopes",
+		internalcmd.DefaultScaffoldScopes,
+		"Scopes the package can be installed in. One or more of: Cluster,Namespaced",
+	)
+	flags.StringSliceVar(
+		&o.Phases,
+		"phases",
+		internalcmd.DefaultScaffoldPhases,
+		"Ordered list of phases. The example object is placed into the last phase.",
+	)
+	flags.BoolVarP(
+		&o.Interactive,
+		"interactive",
+		"i",
+		o.Interactive,
+		"Prompt for all values, using the flag values as defaults.",
+	)
+}
+
+// Prompt asks for every option on out and reads answers line-by-line from in.
+// Empty answers keep the current value.
+func (o *options) Prompt(in io.Reader, out io.Writer, path string) error {
+	scanner := bufio.NewScanner(in)
+
+	ask := func(question, current string) (string, error) {
+		if _, err := fmt.Fprintf(out, "%s [%s]: ", question, current); err != nil {
+			return "", err
+		}
+		if !scanner.Scan() {
+			return current, scanner.Err()
+		}
+		if answer := strings.TrimSpace(scanner.Text()); answer != "" {
+			return answer, nil
+		}
+
+		return current, nil
+	}
+
+	name := o.Name
+	if name == "" {
+		if abs, err := filepath.Abs(path); err == nil {
+			name = filepath.Base(abs)
+		}
+	}
+
+	var err error
+	if o.Name, err = ask("Package name", name); err != nil {
+		return err
+	}
+
+	scopes, err := ask("Scopes (Cluster,Namespaced)", strings.Join(o.Scopes, ","))
+	if err != nil {
+		return err
+	}
+	o.Scopes = splitList(scopes)
+
+	phases, err := ask("Phases in order", strings.Join(o.Phases, ","))
+	if err != nil {
+		return err
+	}
+	o.Phases = splitList(phases)
+
+	return nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+
+	return out
+}
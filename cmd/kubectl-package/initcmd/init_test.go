@@ -0,0 +1,70 @@
+package initcmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestInit(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "my-pkg")
+
+	stdout := &bytes.Buffer{}
+
+	cmd := NewCmd(internalcmd.NewScaffolder())
+	cmd.SetOut(stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{dir, "--scopes", "Namespaced", "--phases", "rbac,deploy"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "deploy/example.configmap.yaml.gotmpl")
+
+	manifest, err := os.ReadFile(filepath.Join(dir, "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(manifest), "name: my-pkg")
+	assert.Contains(t, string(manifest), "- Namespaced")
+	assert.FileExists(t, filepath.Join(dir, ".gitignore"))
+}
+
+func TestInit_Interactive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cmd := NewCmd(internalcmd.NewScaffolder())
+	cmd.SetIn(strings.NewReader("fancy\n\nnamespaces, deploy\n"))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{dir, "-i"})
+
+	require.NoError(t, cmd.Execute())
+
+	manifest, err := os.ReadFile(filepath.Join(dir, "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(manifest), "name: fancy")
+	assert.Contains(t, string(manifest), "- Cluster")
+	assert.FileExists(t, filepath.Join(dir, "deploy", "example.configmap.yaml.gotmpl"))
+}
+
+func TestInit_NotEmpty(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "existing"), nil, os.ModePerm))
+
+	cmd := NewCmd(internalcmd.NewScaffolder())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{dir})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrDirectoryNotEmpty)
+}
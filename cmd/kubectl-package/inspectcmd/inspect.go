@@ -0,0 +1,115 @@
+package inspectcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"package-operator.run/internal/cli"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type InspectorFactory interface {
+	Inspector() Inspector
+}
+
+type Inspector interface {
+	InspectPackage(
+		ctx context.Context, ref string, opts ...internalcmd.InspectPackageOption,
+	) (*internalcmd.PackageInspection, error)
+}
+
+func NewCmd(inspectorFactory InspectorFactory) *cobra.Command {
+	const (
+		cmdUse   = "inspect image_reference [--insecure] [--ca ca_file] [--output format]"
+		cmdShort = "inspect a published package image"
+		cmdLong  = "pulls a package image and shows its manifest, declared images, " +
+			"config schema, phases and availability probes without installing anything."
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ref := args[0]
+		if ref == "" {
+			return fmt.Errorf("%w: image reference empty", internalcmd.ErrInvalidArgs)
+		}
+
+		insp, err := inspectorFactory.Inspector().InspectPackage(
+			cmd.Context(), ref,
+			internalcmd.WithInsecure(opts.Insecure),
+			internalcmd.WithCAFile(opts.CAFile),
+		)
+		if err != nil {
+			return fmt.Errorf("inspecting package: %w", err)
+		}
+
+		printer := cli.NewPrinter(cli.WithOut{Out: cmd.OutOrStdout()})
+
+		switch strings.ToLower(opts.Output) {
+		case "json":
+			data, err := insp.RenderJSON()
+			if err != nil {
+				return fmt.Errorf("rendering inspection to json: %w", err)
+			}
+
+			return printer.PrintfOut("%s\n", string(data))
+		case "yaml":
+			data, err := insp.RenderYAML()
+			if err != nil {
+				return fmt.Errorf("rendering inspection to yaml: %w", err)
+			}
+
+			return printer.PrintfOut("%s", string(data))
+		case "":
+			return printer.PrintfOut("%s", insp.RenderText())
+		default:
+			return fmt.Errorf("%w: %q", errInvalidOutputFormat, opts.Output)
+		}
+	}
+
+	return cmd
+}
+
+var errInvalidOutputFormat = errors.New("invalid output format")
+
+type options struct {
+	Insecure bool
+	CAFile   string
+	Output   string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Insecure,
+		"insecure",
+		o.Insecure,
+		"Allows pulling images without TLS or using TLS with unverified certificates.",
+	)
+	flags.StringVar(
+		&o.CAFile,
+		"ca",
+		o.CAFile,
+		"Path to a PEM encoded CA bundle to verify the registry certificate with, in addition to the system CAs.",
+	)
+	flags.StringVarP(
+		&o.Output,
+		"output",
+		"o",
+		o.Output,
+		"Output format. One of: json|yaml",
+	)
+}
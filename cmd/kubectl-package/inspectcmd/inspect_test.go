@@ -0,0 +1,114 @@
+package inspectcmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestInspect(t *testing.T) {
+	t.Parallel()
+
+	insp := &internalcmd.PackageInspection{
+		Reference: "quay.io/test/package:v1",
+		Name:      "test",
+		Scopes:    []string{"Cluster"},
+		Phases:    []internalcmd.InspectedPhase{{Name: "deploy"}},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		assert func(t *testing.T, out string)
+	}{
+		{
+			name: "text",
+			assert: func(t *testing.T, out string) {
+				t.Helper()
+				assert.Contains(t, out, "Package test")
+				assert.Contains(t, out, "deploy")
+			},
+		},
+		{
+			name: "json",
+			args: []string{"-o", "json"},
+			assert: func(t *testing.T, out string) {
+				t.Helper()
+				var got internalcmd.PackageInspection
+				require.NoError(t, json.Unmarshal([]byte(out), &got))
+				assert.Equal(t, *insp, got)
+			},
+		},
+		{
+			name: "yaml",
+			args: []string{"-o", "yaml"},
+			assert: func(t *testing.T, out string) {
+				t.Helper()
+				assert.Contains(t, out, "name: test\n")
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			inspector := &inspectorMock{}
+			inspector.On("InspectPackage", mock.Anything, "quay.io/test/package:v1", mock.Anything).Return(insp, nil)
+			factory := &inspectorFactoryMock{}
+			factory.On("Inspector").Return(inspector)
+
+			cmd := NewCmd(factory)
+			stdout := &bytes.Buffer{}
+			cmd.SetOut(stdout)
+			cmd.SetArgs(append([]string{"quay.io/test/package:v1"}, tc.args...))
+
+			require.NoError(t, cmd.Execute())
+			tc.assert(t, stdout.String())
+		})
+	}
+}
+
+func TestInspectInvalidOutput(t *testing.T) {
+	t.Parallel()
+
+	inspector := &inspectorMock{}
+	inspector.On("InspectPackage", mock.Anything, mock.Anything, mock.Anything).
+		Return(&internalcmd.PackageInspection{}, nil)
+	factory := &inspectorFactoryMock{}
+	factory.On("Inspector").Return(inspector)
+
+	cmd := NewCmd(factory)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"quay.io/test/package:v1", "-o", "table"})
+
+	require.ErrorIs(t, cmd.Execute(), errInvalidOutputFormat)
+}
+
+type inspectorFactoryMock struct {
+	mock.Mock
+}
+
+func (m *inspectorFactoryMock) Inspector() Inspector {
+	args := m.Called()
+
+	return args.Get(0).(Inspector)
+}
+
+type inspectorMock struct {
+	mock.Mock
+}
+
+func (m *inspectorMock) InspectPackage(
+	ctx context.Context, ref string, opts ...internalcmd.InspectPackageOption,
+) (*internalcmd.PackageInspection, error) {
+	args := m.Called(ctx, ref, opts)
+
+	return args.Get(0).(*internalcmd.PackageInspection), args.Error(1)
+}
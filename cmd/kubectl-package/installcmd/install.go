@@ -0,0 +1,177 @@
+package installcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"package-operator.run/internal/cli"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func NewInstallCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "install name image [--namespace namespace] [--config-file file]"
+		cmdShort = "install a package from an image reference"
+		cmdLong  = "creates a Package, or a ClusterPackage if no namespace is given, " +
+			"from an image reference and waits for it to become available."
+	)
+
+	return newCmd(cmdUse, cmdShort, cmdLong, func(
+		ctx context.Context, client *internalcmd.Client, args arguments, opts ...internalcmd.InstallPackageOption,
+	) (*internalcmd.Package, error) {
+		return client.InstallPackage(ctx, args.Name, args.Image, opts...)
+	}, clientFactory)
+}
+
+func NewUpgradeCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "upgrade name image [--namespace namespace] [--config-file file]"
+		cmdShort = "upgrade a package to a new image reference"
+		cmdLong  = "patches the image and optionally config of an existing Package, or a ClusterPackage " +
+			"if no namespace is given, and waits for the new revision to become available."
+	)
+
+	return newCmd(cmdUse, cmdShort, cmdLong, func(
+		ctx context.Context, client *internalcmd.Client, args arguments, opts ...internalcmd.InstallPackageOption,
+	) (*internalcmd.Package, error) {
+		return client.UpgradePackage(ctx, args.Name, args.Image, opts...)
+	}, clientFactory)
+}
+
+type applyFn func(
+	ctx context.Context, client *internalcmd.Client, args arguments, opts ...internalcmd.InstallPackageOption,
+) (*internalcmd.Package, error)
+
+func newCmd(use, short, long string, apply applyFn, clientFactory internalcmd.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(2),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, rawArgs []string) error {
+		args, err := getArgs(rawArgs)
+		if err != nil {
+			return err
+		}
+
+		installOpts := []internalcmd.InstallPackageOption{
+			internalcmd.WithNamespace(opts.Namespace),
+			internalcmd.WithComponent(opts.Component),
+		}
+
+		if opts.ConfigPath != "" {
+			config, err := internalcmd.LoadPackageConfigFile(opts.ConfigPath)
+			if err != nil {
+				return err
+			}
+
+			installOpts = append(installOpts, internalcmd.WithPackageConfig{Config: config})
+		}
+
+		client, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		pkg, err := apply(cmd.Context(), client, *args, installOpts...)
+		if err != nil {
+			return err
+		}
+
+		if !opts.Wait {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), opts.Timeout)
+		defer cancel()
+
+		spinner := cli.NewSpinner(cli.WithOut{Out: cmd.ErrOrStderr()})
+		spinner.Start(fmt.Sprintf("waiting for %s to become available", args.Name))
+
+		if err := pkg.WaitForAvailable(ctx, internalcmd.WithProgress(spinner.UpdateText)); err != nil {
+			spinner.Stop(fmt.Sprintf("%s is not available", args.Name))
+
+			return err
+		}
+
+		spinner.Stop(fmt.Sprintf("%s is available", args.Name))
+
+		return nil
+	}
+
+	return cmd
+}
+
+func getArgs(args []string) (*arguments, error) {
+	if args[0] == "" {
+		return nil, fmt.Errorf("%w: name must not be empty", internalcmd.ErrInvalidArgs)
+	}
+	if _, err := name.ParseReference(args[1]); err != nil {
+		return nil, fmt.Errorf("%w: invalid image reference %q: %w", internalcmd.ErrInvalidArgs, args[1], err)
+	}
+
+	return &arguments{
+		Name:  args[0],
+		Image: args[1],
+	}, nil
+}
+
+type arguments struct {
+	Name  string
+	Image string
+}
+
+const defaultTimeout = 5 * time.Minute
+
+type options struct {
+	Namespace  string
+	ConfigPath string
+	Component  string
+	Wait       bool
+	Timeout    time.Duration
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, a namespaced Package is created in this namespace instead of a ClusterPackage",
+	)
+	flags.StringVar(
+		&o.ConfigPath,
+		"config-file",
+		o.ConfigPath,
+		"file containing the package config",
+	)
+	flags.StringVar(
+		&o.Component,
+		"component",
+		o.Component,
+		"component to deploy from a multi-component package",
+	)
+	flags.BoolVar(
+		&o.Wait,
+		"wait",
+		true,
+		"wait for the package to become available",
+	)
+	flags.DurationVar(
+		&o.Timeout,
+		"timeout",
+		defaultTimeout,
+		"how long to wait for the package to become available",
+	)
+}
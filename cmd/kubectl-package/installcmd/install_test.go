@@ -0,0 +1,144 @@
+package installcmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestInstall(t *testing.T) {
+	t.Parallel()
+
+	c := newFakeClient(t)
+
+	cmd := NewInstallCmd(newClientFactoryMock(c))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"test", "quay.io/package-operator/test-stub:v1", "-n", "default", "--wait=false"})
+
+	require.NoError(t, cmd.Execute())
+
+	var pkg corev1alpha1.Package
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "test", Namespace: "default"}, &pkg))
+	assert.Equal(t, "quay.io/package-operator/test-stub:v1", pkg.Spec.Image)
+}
+
+func TestUpgrade(t *testing.T) {
+	t.Parallel()
+
+	c := newFakeClient(t, &corev1alpha1.ClusterPackage{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: corev1alpha1.PackageSpec{
+			Image: "quay.io/package-operator/test-stub:v1",
+		},
+		Status: corev1alpha1.PackageStatus{
+			Conditions: []metav1.Condition{{
+				Type:   corev1alpha1.PackageAvailable,
+				Status: metav1.ConditionTrue,
+			}},
+		},
+	})
+
+	stderr := &bytes.Buffer{}
+
+	cmd := NewUpgradeCmd(newClientFactoryMock(c))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"test", "quay.io/package-operator/test-stub:v2"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stderr.String(), "test is available")
+
+	var pkg corev1alpha1.ClusterPackage
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "test"}, &pkg))
+	assert.Equal(t, "quay.io/package-operator/test-stub:v2", pkg.Spec.Image)
+}
+
+func TestUpgrade_Timeout(t *testing.T) {
+	t.Parallel()
+
+	c := newFakeClient(t, &corev1alpha1.ClusterPackage{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: corev1alpha1.PackageSpec{
+			Image: "quay.io/package-operator/test-stub:v1",
+		},
+		Status: corev1alpha1.PackageStatus{
+			Conditions: []metav1.Condition{{
+				Type:    corev1alpha1.PackageUnpacked,
+				Status:  metav1.ConditionFalse,
+				Reason:  "UnpackFailed",
+				Message: "image not found",
+			}},
+		},
+	})
+
+	cmd := NewUpgradeCmd(newClientFactoryMock(c))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"test", "quay.io/package-operator/test-stub:v2", "--timeout", "10ms"})
+
+	err := cmd.Execute()
+	require.ErrorIs(t, err, internalcmd.ErrPackageNotAvailable)
+	assert.ErrorContains(t, err, "image not found")
+}
+
+func TestInstall_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	for name, args := range map[string][]string{
+		"no args":        {},
+		"empty name":     {"", "quay.io/package-operator/test-stub:v1"},
+		"invalid image":  {"test", "in valid"},
+		"missing config": {"test", "quay.io/package-operator/test-stub:v1", "--config-file", "dne"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := NewInstallCmd(newClientFactoryMock(newFakeClient(t)))
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(args)
+
+			require.Error(t, cmd.Execute())
+		})
+	}
+}
+
+func newFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+}
+
+func newClientFactoryMock(c client.Client) *clientFactoryMock {
+	factory := &clientFactoryMock{}
+	factory.On("Client").Return(internalcmd.NewClient(c), nil)
+
+	return factory
+}
+
+type clientFactoryMock struct {
+	mock.Mock
+}
+
+func (m *clientFactoryMock) Client() (*internalcmd.Client, error) {
+	args := m.Called()
+
+	return args.Get(0).(*internalcmd.Client), args.Error(1)
+}
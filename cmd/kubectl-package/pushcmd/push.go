@@ -0,0 +1,99 @@
+package pushcmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type PusherFactory interface {
+	Pusher() Pusher
+}
+
+type Pusher interface {
+	PushFromFile(ctx context.Context, srcPath string, opts ...internalcmd.PushFromFileOption) ([]string, error)
+}
+
+func NewCmd(pusherFactory PusherFactory) *cobra.Command {
+	const (
+		pushUse   = "push image_path [--tag tag]... [--insecure] [--ca ca_file]"
+		pushShort = "push a PKO package image file to an OCI registry"
+		pushLong  = "pushes a package image file created via \"kubectl package build --output\"" +
+			" to an OCI registry. Multi-arch image indexes are pushed as a whole." +
+			" Registry credentials are read from the docker config," +
+			" including configured docker credential helpers."
+	)
+
+	cmd := &cobra.Command{
+		Use:   pushUse,
+		Short: pushShort,
+		Long:  pushLong,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		src := args[0]
+		if src == "" {
+			return fmt.Errorf("%w: image path empty", internalcmd.ErrInvalidArgs)
+		}
+		for _, ref := range opts.Tags {
+			if _, err := name.ParseReference(ref); err != nil {
+				return fmt.Errorf("invalid tag specified as parameter %s: %w", ref, err)
+			}
+		}
+
+		refs, err := pusherFactory.Pusher().PushFromFile(
+			cmd.Context(), src,
+			internalcmd.WithInsecure(opts.Insecure),
+			internalcmd.WithCAFile(opts.CAFile),
+			internalcmd.WithTags(opts.Tags),
+		)
+		if err != nil {
+			return fmt.Errorf("pushing image: %w", err)
+		}
+
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Pushed %s\n", strings.Join(refs, ", "))
+
+		return err
+	}
+
+	return cmd
+}
+
+type options struct {
+	Insecure bool
+	CAFile   string
+	Tags     []string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Insecure,
+		"insecure",
+		o.Insecure,
+		"Allows pushing images without TLS or using TLS with unverified certificates.",
+	)
+	flags.StringVar(
+		&o.CAFile,
+		"ca",
+		o.CAFile,
+		"Path to a PEM encoded CA bundle to verify the registry certificate with, in addition to the system CAs.",
+	)
+	flags.StringSliceVarP(
+		&o.Tags,
+		"tag",
+		"t",
+		o.Tags,
+		"References to push the image to. May be specified multiple times. Defaults to the tags stored in the image.",
+	)
+}
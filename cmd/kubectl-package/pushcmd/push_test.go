@@ -0,0 +1,87 @@
+package pushcmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestPush(t *testing.T) {
+	t.Parallel()
+
+	pusher := &pusherMock{}
+	pusher.
+		On("PushFromFile", mock.Anything, "image.tar", mock.Anything).
+		Return([]string{"quay.io/chickens:oldest"}, nil)
+	factory := &pusherFactoryMock{}
+	factory.On("Pusher").Return(pusher)
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetArgs([]string{"image.tar", "--tag", "quay.io/chickens:oldest", "--ca", "ca.pem", "--insecure"})
+
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "Pushed quay.io/chickens:oldest\n", stdout.String())
+
+	var cfg internalcmd.PushFromFileConfig
+	cfg.Option(pusher.Calls[0].Arguments.Get(2).([]internalcmd.PushFromFileOption)...)
+	require.Equal(t, internalcmd.PushFromFileConfig{
+		Insecure: true,
+		CAFile:   "ca.pem",
+		Tags:     []string{"quay.io/chickens:oldest"},
+	}, cfg)
+}
+
+func TestPushInvalidTag(t *testing.T) {
+	t.Parallel()
+
+	factory := &pusherFactoryMock{}
+
+	cmd := NewCmd(factory)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"image.tar", "--tag", "bread:a:b"})
+
+	require.Error(t, cmd.Execute())
+}
+
+func TestPushEmptySource(t *testing.T) {
+	t.Parallel()
+
+	factory := &pusherFactoryMock{}
+
+	cmd := NewCmd(factory)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{""})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidArgs)
+}
+
+type pusherFactoryMock struct {
+	mock.Mock
+}
+
+func (m *pusherFactoryMock) Pusher() Pusher {
+	args := m.Called()
+
+	return args.Get(0).(Pusher)
+}
+
+type pusherMock struct {
+	mock.Mock
+}
+
+func (m *pusherMock) PushFromFile(
+	ctx context.Context, srcPath string, opts ...internalcmd.PushFromFileOption,
+) ([]string, error) {
+	args := m.Called(ctx, srcPath, opts)
+
+	return args.Get(0).([]string), args.Error(1)
+}
@@ -0,0 +1,115 @@
+package rbaccmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"package-operator.run/cmd/kubectl-package/rendercmd"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func NewCmd(rendererFactory rendercmd.RendererFactory) *cobra.Command {
+	const (
+		cmdUse   = "rbac source_path [--name name] [--namespace namespace] [--config-file file]"
+		cmdShort = "generates the minimal RBAC rules needed to install the package"
+		cmdLong  = "renders the package using the given configuration and outputs a ClusterRole and Roles " +
+			"granting only the permissions needed to apply its objects, " +
+			"e.g. for the ServiceAccount used to install the package."
+	)
+
+	var opts options
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.MarkFlagsMutuallyExclusive("config-file", "config-testcase")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		src := args[0]
+		if src == "" {
+			return fmt.Errorf("%w: source path empty", internalcmd.ErrInvalidArgs)
+		}
+
+		rendered, err := rendererFactory.Renderer().RenderManifests(
+			cmd.Context(), src,
+			internalcmd.WithClusterScope(opts.ClusterScope),
+			internalcmd.WithConfigPath(opts.ConfigPath),
+			internalcmd.WithConfigTestcase(opts.ConfigTestcase),
+			internalcmd.WithComponent(opts.Component),
+		)
+		if err != nil {
+			return fmt.Errorf("rendering package: %w", err)
+		}
+
+		name := opts.Name
+		if name == "" {
+			name = rendered.PackageName
+		}
+
+		data, err := rendered.RBAC(name, opts.Namespace).YAML()
+		if err != nil {
+			return err
+		}
+
+		_, err = cmd.OutOrStdout().Write(data)
+
+		return err
+	}
+
+	return cmd
+}
+
+type options struct {
+	ClusterScope   bool
+	ConfigPath     string
+	ConfigTestcase string
+	Component      string
+	Name           string
+	Namespace      string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.ClusterScope,
+		"cluster",
+		o.ClusterScope,
+		"render package in cluster scope",
+	)
+	flags.StringVar(
+		&o.ConfigPath,
+		"config-file",
+		o.ConfigPath,
+		"file containing config which is used for templating.",
+	)
+	flags.StringVar(
+		&o.ConfigTestcase,
+		"config-testcase",
+		o.ConfigTestcase,
+		"name of the testcase which config is for templating",
+	)
+	flags.StringVar(
+		&o.Component,
+		"component",
+		o.Component,
+		"select which component to render",
+	)
+	flags.StringVar(
+		&o.Name,
+		"name",
+		o.Name,
+		"name of the generated ClusterRole and Roles. Defaults to the package name.",
+	)
+	flags.StringVar(
+		&o.Namespace,
+		"namespace",
+		o.Namespace,
+		"namespace of the Role covering objects without namespace. "+
+			"Defaults to the namespace the Role is applied in.",
+	)
+}
@@ -0,0 +1,95 @@
+package rbaccmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/cmd/kubectl-package/rendercmd"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestRBAC(t *testing.T) {
+	t.Parallel()
+
+	t.Run("namespace scoped", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, err := executeRBAC(t, "--config-testcase", "namespace-scope", "testdata")
+		require.NoError(t, err)
+
+		out := stdout.String()
+		assert.Equal(t, 1, strings.Count(out, "---\n"))
+		assert.Contains(t, out, "kind: Role\n")
+		assert.Contains(t, out, "name: test-stub\n")
+		assert.Contains(t, out, "- deployments\n")
+		assert.Contains(t, out, "- test-stub-name\n")
+	})
+
+	t.Run("cluster scoped", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, err := executeRBAC(t,
+			"--config-testcase", "cluster-scope", "--cluster", "--name", "installer", "testdata")
+		require.NoError(t, err)
+
+		out := stdout.String()
+		require.Equal(t, 2, strings.Count(out, "---\n"))
+		assert.Contains(t, out, "kind: ClusterRole\n")
+		assert.Contains(t, out, "- namespaces\n")
+		assert.Contains(t, out, "name: installer\n")
+		// Deployment is placed into the namespace of the package.
+		assert.Contains(t, out, "namespace: test\n")
+	})
+}
+
+func TestRBAC_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	for name, args := range map[string][]string{
+		"no args":           {},
+		"empty source path": {""},
+		"missing source":    {"invisible_chicken"},
+		"missing config":    {"--config-file", "nonexistent", "testdata"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := executeRBAC(t, args...)
+			require.Error(t, err)
+		})
+	}
+}
+
+func executeRBAC(t *testing.T, args ...string) (*bytes.Buffer, error) {
+	t.Helper()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	factory := &rendererFactoryMock{}
+	factory.On("Renderer").Return(internalcmd.NewRender(scheme))
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs(args)
+
+	return stdout, cmd.Execute()
+}
+
+type rendererFactoryMock struct {
+	mock.Mock
+}
+
+func (m *rendererFactoryMock) Renderer() rendercmd.Renderer {
+	args := m.Called()
+
+	return args.Get(0).(rendercmd.Renderer)
+}
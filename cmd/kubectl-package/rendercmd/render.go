@@ -0,0 +1,158 @@
+package rendercmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type RendererFactory interface {
+	Renderer() Renderer
+}
+
+type Renderer interface {
+	RenderManifests(
+		ctx context.Context, srcPath string, opts ...internalcmd.RenderPackageOption,
+	) (*internalcmd.RenderedManifests, error)
+}
+
+func NewCmd(rendererFactory RendererFactory) *cobra.Command {
+	const (
+		cmdUse   = "render source_path [--config-file file] [--output-dir dir [--split none|phase|file]]"
+		cmdShort = "renders the package into plain Kubernetes manifests"
+		cmdLong  = "renders all templates of the package using the given configuration and a simulated " +
+			"environment and outputs the phase-annotated manifests to stdout or a directory."
+	)
+
+	var opts options
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+	opts.AddFlags(cmd.Flags())
+
+	cmd.MarkFlagsMutuallyExclusive("config-file", "config-testcase")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		src := args[0]
+		if src == "" {
+			return fmt.Errorf("%w: source path empty", internalcmd.ErrInvalidArgs)
+		}
+		if opts.OutputDir == "" && cmd.Flags().Changed("split") {
+			return fmt.Errorf("%w: --split requires --output-dir", internalcmd.ErrInvalidArgs)
+		}
+
+		rendered, err := rendererFactory.Renderer().RenderManifests(
+			cmd.Context(), src,
+			internalcmd.WithClusterScope(opts.ClusterScope),
+			internalcmd.WithConfigPath(opts.ConfigPath),
+			internalcmd.WithConfigTestcase(opts.ConfigTestcase),
+			internalcmd.WithComponent(opts.Component),
+			internalcmd.WithEnvironmentPath(opts.EnvironmentPath),
+			internalcmd.WithKubernetesVersion(opts.KubernetesVersion),
+			internalcmd.WithOpenShiftVersion(opts.OpenShiftVersion),
+		)
+		if err != nil {
+			return fmt.Errorf("rendering package: %w", err)
+		}
+
+		if opts.OutputDir != "" {
+			if err := rendered.WriteToDir(opts.OutputDir, internalcmd.RenderSplit(opts.Split)); err != nil {
+				return fmt.Errorf("writing manifests: %w", err)
+			}
+
+			return nil
+		}
+
+		data, err := rendered.YAML()
+		if err != nil {
+			return fmt.Errorf("marshalling manifests: %w", err)
+		}
+
+		_, err = cmd.OutOrStdout().Write(data)
+
+		return err
+	}
+
+	return cmd
+}
+
+type options struct {
+	ClusterScope      bool
+	ConfigPath        string
+	ConfigTestcase    string
+	Component         string
+	EnvironmentPath   string
+	KubernetesVersion string
+	OpenShiftVersion  string
+	OutputDir         string
+	Split             string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.ClusterScope,
+		"cluster",
+		o.ClusterScope,
+		"render package in cluster scope",
+	)
+	flags.StringVar(
+		&o.ConfigPath,
+		"config-file",
+		o.ConfigPath,
+		"file containing config which is used for templating.",
+	)
+	flags.StringVar(
+		&o.ConfigTestcase,
+		"config-testcase",
+		o.ConfigTestcase,
+		"name of the testcase which config is for templating",
+	)
+	flags.StringVar(
+		&o.Component,
+		"component",
+		o.Component,
+		"select which component to render",
+	)
+	flags.StringVar(
+		&o.EnvironmentPath,
+		"environment-file",
+		o.EnvironmentPath,
+		"file containing the simulated environment which is used for templating.",
+	)
+	flags.StringVar(
+		&o.KubernetesVersion,
+		"kubernetes-version",
+		o.KubernetesVersion,
+		"Kubernetes version to simulate, takes precedence over --environment-file.",
+	)
+	flags.StringVar(
+		&o.OpenShiftVersion,
+		"openshift-version",
+		o.OpenShiftVersion,
+		"OpenShift version to simulate, takes precedence over --environment-file.",
+	)
+	flags.StringVarP(
+		&o.OutputDir,
+		"output-dir",
+		"o",
+		o.OutputDir,
+		"directory to write the rendered manifests to. Defaults to stdout.",
+	)
+	flags.StringVar(
+		&o.Split,
+		"split",
+		string(internalcmd.RenderSplitNone),
+		strings.Join([]string{
+			"how to split manifests into files when writing to --output-dir.",
+			"One of: none, phase, file.",
+		}, " "),
+	)
+}
@@ -0,0 +1,141 @@
+package rendercmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestRender_Stdout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("namespace scoped", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, err := executeRender(t, "--config-testcase", "namespace-scope", "testdata")
+		require.NoError(t, err)
+
+		out := stdout.String()
+		assert.Equal(t, 1, strings.Count(out, "---\n"))
+		assert.Contains(t, out, "kind: Deployment")
+		assert.Contains(t, out, "package-operator.run/phase: deploy")
+		assert.NotContains(t, out, "kind: Namespace")
+	})
+
+	t.Run("cluster scoped", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, err := executeRender(t, "--config-testcase", "namespace-scope", "--cluster", "testdata")
+		require.NoError(t, err)
+
+		out := stdout.String()
+		require.Equal(t, 2, strings.Count(out, "---\n"))
+		// namespace phase is ordered before the deploy phase.
+		assert.Less(t, strings.Index(out, "kind: Namespace"), strings.Index(out, "kind: Deployment"))
+	})
+}
+
+func TestRender_OutputDir(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Split         string
+		ExpectedFiles []string
+	}{
+		"none": {
+			Split:         "none",
+			ExpectedFiles: []string{"manifests.yaml"},
+		},
+		"phase": {
+			Split:         "phase",
+			ExpectedFiles: []string{"00-namespace.yaml", "01-deploy.yaml"},
+		},
+		"file": {
+			Split:         "file",
+			ExpectedFiles: []string{"deployment.yaml", "namespace.template.yaml"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			_, err := executeRender(t,
+				"--config-testcase", "cluster-scope", "--output-dir", dir, "--split", tc.Split, "testdata")
+			require.NoError(t, err)
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+
+			files := make([]string, 0, len(entries))
+			for _, e := range entries {
+				files = append(files, e.Name())
+			}
+			assert.ElementsMatch(t, tc.ExpectedFiles, files)
+
+			for _, f := range files {
+				data, err := os.ReadFile(filepath.Join(dir, f))
+				require.NoError(t, err)
+				assert.Contains(t, string(data), "package-operator.run/phase:")
+			}
+		})
+	}
+}
+
+func TestRender_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	for name, args := range map[string][]string{
+		"no args":            {},
+		"empty source path":  {""},
+		"missing source":     {"invisible_chicken"},
+		"split without dir":  {"--split", "phase", "testdata"},
+		"unknown split mode": {"--output-dir", "dne", "--split", "chicken", "testdata"},
+		"missing config":     {"--config-file", "nonexistent", "testdata"},
+		"exclusive config":   {"--config-file", "testdata/.config.yaml", "--config-testcase", "namespace-scope", "testdata"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := executeRender(t, args...)
+			require.Error(t, err)
+		})
+	}
+}
+
+func executeRender(t *testing.T, args ...string) (*bytes.Buffer, error) {
+	t.Helper()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	factory := &rendererFactoryMock{}
+	factory.On("Renderer").Return(internalcmd.NewRender(scheme))
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs(args)
+
+	return stdout, cmd.Execute()
+}
+
+type rendererFactoryMock struct {
+	mock.Mock
+}
+
+func (m *rendererFactoryMock) Renderer() Renderer {
+	args := m.Called()
+
+	return args.Get(0).(Renderer)
+}
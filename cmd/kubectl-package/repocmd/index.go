@@ -0,0 +1,268 @@
+package repocmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"pkg.package-operator.run/semver"
+
+	"package-operator.run/internal/apis/manifests"
+	internalcmd "package-operator.run/internal/cmd"
+	"package-operator.run/internal/packages"
+)
+
+func newIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index file name [image...] [--from-dir dir] [--signing-key key] [--push tag]",
+		Short: "generate a repository at file from package images",
+		Long: "generates a repository named name at file from package images " +
+			"or a directory of image files created via \"kubectl package build --output\". " +
+			"Versions are taken from the semver tags of each image. " +
+			"When a signing key is given, a signature is written to file" + packages.RepositorySignatureFileSuffix +
+			" and pushed alongside the repository.",
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	var (
+		fromDir    string
+		signingKey string
+		pushTag    string
+		insecure   bool
+	)
+
+	cmd.Flags().StringVar(&fromDir, "from-dir", "", "Directory containing package image files (*.tar).")
+	cmd.Flags().StringVar(&signingKey, "signing-key", "",
+		"PEM encoded ECDSA, ED25519 or RSA private key to sign the repository with.")
+	cmd.Flags().StringVar(&pushTag, "push", "", "Tag to push the repository image to.")
+	cmd.Flags().BoolVar(&insecure, "insecure", false,
+		"Allows pulling and pushing images without TLS or using TLS with unverified certificates.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		filePath := args[0]
+		repoName := args[1]
+		images := args[2:]
+
+		switch {
+		case repoName == "":
+			return fmt.Errorf("%w: name must be not empty", internalcmd.ErrInvalidArgs)
+		case filePath == "":
+			return fmt.Errorf("%w: file must be not empty", internalcmd.ErrInvalidArgs)
+		case len(images) == 0 && fromDir == "":
+			return fmt.Errorf("%w: either images or --from-dir must be given", internalcmd.ErrInvalidArgs)
+		}
+
+		var craneOpts []crane.Option
+		if insecure {
+			craneOpts = append(craneOpts, crane.Insecure)
+		}
+
+		entries := repositoryEntries{}
+		for _, ref := range images {
+			if err := entries.addFromRegistry(ctx, ref, craneOpts...); err != nil {
+				return fmt.Errorf("indexing %s: %w", ref, err)
+			}
+		}
+		if fromDir != "" {
+			if err := entries.addFromDir(ctx, fromDir); err != nil {
+				return err
+			}
+		}
+
+		idx := packages.NewRepositoryIndex(metav1.ObjectMeta{Name: repoName})
+		for _, entry := range entries.sorted() {
+			if err := idx.Add(ctx, entry); err != nil {
+				return fmt.Errorf("add entry for %s: %w", entry.Data.Image, err)
+			}
+		}
+
+		data := &bytes.Buffer{}
+		if err := idx.Export(ctx, data); err != nil {
+			return fmt.Errorf("export repository: %w", err)
+		}
+		if err := os.WriteFile(filePath, data.Bytes(), 0o600); err != nil {
+			return fmt.Errorf("write to file: %w", err)
+		}
+
+		var signature []byte
+		if signingKey != "" {
+			key, err := packages.LoadRepositorySigningKey(signingKey)
+			if err != nil {
+				return err
+			}
+			if signature, err = packages.SignRepository(data.Bytes(), key); err != nil {
+				return err
+			}
+
+			sigPath := filePath + packages.RepositorySignatureFileSuffix
+			if err := os.WriteFile(sigPath, signature, 0o600); err != nil {
+				return fmt.Errorf("write signature to file: %w", err)
+			}
+		}
+
+		if pushTag == "" {
+			return nil
+		}
+
+		image, err := packages.SaveSignedRepositoryToOCI(ctx, data.Bytes(), signature)
+		if err != nil {
+			return err
+		}
+
+		if err := crane.Push(image, pushTag, append(craneOpts, crane.WithContext(ctx))...); err != nil {
+			return fmt.Errorf("push repository image: %w", err)
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+// repositoryEntries merges all versions of the same image digest into a single entry.
+type repositoryEntries map[string]*manifests.RepositoryEntry
+
+func (e repositoryEntries) addFromRegistry(ctx context.Context, ref string, opts ...crane.Option) error {
+	tag, err := name.NewTag(ref, name.StrictValidation)
+	if err != nil {
+		return fmt.Errorf("package reference must be a tag: %w", err)
+	}
+
+	pkgImg, err := crane.Pull(ref, opts...)
+	if err != nil {
+		return fmt.Errorf("pull package image: %w", err)
+	}
+
+	digest, err := pkgImg.Digest()
+	if err != nil {
+		return fmt.Errorf("pulled package: %w", err)
+	}
+
+	return e.add(ctx, pkgImg, digest, []name.Tag{tag})
+}
+
+func (e repositoryEntries) addFromDir(ctx context.Context, dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tar"))
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("%w: no image files found in %s", internalcmd.ErrInvalidArgs, dir)
+	}
+
+	for _, path := range paths {
+		if err := e.addFromFile(ctx, path); err != nil {
+			return fmt.Errorf("indexing %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (e repositoryEntries) addFromFile(ctx context.Context, path string) (err error) {
+	file, err := packages.FromOCIFile(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := file.Close(); err == nil {
+			err = cErr
+		}
+	}()
+
+	tags := make([]name.Tag, 0, len(file.Tags))
+	for _, t := range file.Tags {
+		tag, err := name.NewTag(t)
+		if err != nil {
+			return fmt.Errorf("image file tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	pkgImg, err := file.PackageImage()
+	if err != nil {
+		return err
+	}
+
+	digest, err := file.Digest()
+	if err != nil {
+		return err
+	}
+
+	return e.add(ctx, pkgImg, digest, tags)
+}
+
+func (e repositoryEntries) add(
+	ctx context.Context, pkgImg containerregistrypkgv1.Image,
+	digest containerregistrypkgv1.Hash, tags []name.Tag,
+) error {
+	rawPkg, err := packages.FromOCI(ctx, pkgImg)
+	if err != nil {
+		return fmt.Errorf("raw package from package image: %w", err)
+	}
+
+	pkg, err := packages.DefaultStructuralLoader.Load(ctx, rawPkg)
+	if err != nil {
+		return fmt.Errorf("package from raw package: %w", err)
+	}
+
+	var added bool
+	for _, tag := range tags {
+		version := tag.TagStr()
+		if _, err := semver.NewVersion(strings.TrimPrefix(version, "v")); err != nil {
+			// e.g. "latest"
+			continue
+		}
+
+		image := tag.Context().Name()
+		key := image + "@" + digest.Hex
+		entry, ok := e[key]
+		if !ok {
+			entry = &manifests.RepositoryEntry{
+				Data: manifests.RepositoryEntryData{
+					Image:       image,
+					Digest:      digest.Hex,
+					Constraints: pkg.Manifest.Spec.Constraints,
+					Name:        pkg.Manifest.Name,
+				},
+			}
+			e[key] = entry
+		}
+
+		entry.Data.Versions = append(entry.Data.Versions, version)
+		added = true
+	}
+
+	if !added {
+		return fmt.Errorf("%w: image has no semver tag", internalcmd.ErrInvalidArgs)
+	}
+
+	return nil
+}
+
+func (e repositoryEntries) sorted() []*manifests.RepositoryEntry {
+	keys := make([]string, 0, len(e))
+	for key := range e {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	out := make([]*manifests.RepositoryEntry, len(keys))
+	for i, key := range keys {
+		out[i] = e[key]
+	}
+
+	return out
+}
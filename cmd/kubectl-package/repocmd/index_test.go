@@ -0,0 +1,76 @@
+package repocmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages"
+)
+
+const indexTestManifest = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Cluster
+  phases:
+  - name: deploy
+`
+
+func TestIndexCmdFromDir(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	imageDir := filepath.Join(dir, "images")
+	require.NoError(t, os.Mkdir(imageDir, os.ModePerm))
+
+	rawPkg := &packages.RawPackage{Files: packages.Files{"manifest.yaml": []byte(indexTestManifest)}}
+	require.NoError(t, packages.ToOCIFile(
+		filepath.Join(imageDir, "v1.tar"),
+		[]string{"quay.io/test/test-stub:v1.0.0", "quay.io/test/test-stub:latest"}, rawPkg))
+	require.NoError(t, packages.ToOCIIndexFile(
+		filepath.Join(imageDir, "v2.tar"),
+		[]string{"quay.io/test/test-stub:v2.0.0"}, rawPkg,
+		[]containerregistrypkgv1.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}))
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	keyPath := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}), 0o600))
+
+	repoFile := filepath.Join(dir, "repo.yaml")
+	cmd := newCmd("index", repoFile, "test-repo", "--from-dir", imageDir, "--signing-key", keyPath)
+	require.NoError(t, cmd.Execute())
+
+	idx := assertIdx(ctx, t, repoFile, 2)
+	versions, err := idx.ListVersions("test-stub")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v2.0.0", "v1.0.0"}, versions)
+
+	data, err := os.ReadFile(repoFile)
+	require.NoError(t, err)
+	sig, err := os.ReadFile(repoFile + packages.RepositorySignatureFileSuffix)
+	require.NoError(t, err)
+	require.NoError(t, packages.VerifyRepository(data, sig, pub))
+}
+
+func TestIndexCmdNoSources(t *testing.T) {
+	t.Parallel()
+
+	cmd := newCmd("index", filepath.Join(t.TempDir(), "repo.yaml"), "test-repo")
+	require.Error(t, cmd.Execute())
+}
@@ -11,7 +11,7 @@ func NewCmd() *cobra.Command {
 		Aliases: []string{"repo"},
 	}
 
-	cmd.AddCommand(newInitCmd(), newPullCmd(), newAddCmd(), newRemoveCmd(), newPushCmd())
+	cmd.AddCommand(newInitCmd(), newPullCmd(), newAddCmd(), newRemoveCmd(), newPushCmd(), newIndexCmd())
 
 	return cmd
 }
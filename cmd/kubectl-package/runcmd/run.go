@@ -0,0 +1,224 @@
+package runcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"package-operator.run/internal/cli"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type RunnerFactory interface {
+	Runner() Runner
+}
+
+type Runner interface {
+	RunPackage(
+		ctx context.Context, client *internalcmd.Client, name, srcPath string, opts ...internalcmd.RunPackageOption,
+	) (*internalcmd.Package, error)
+}
+
+func NewCmd(clientFactory internalcmd.ClientFactory, runnerFactory RunnerFactory) *cobra.Command {
+	const (
+		cmdUse = "run name source_path --registry registry [--cluster-registry-host host] " +
+			"[--namespace namespace] [--config-file file] [--watch]"
+		cmdShort = "build a package from source and roll it out to a development cluster"
+		cmdLong  = "builds the package from a local directory, pushes it to a development registry, " +
+			"creates or updates the Package, or ClusterPackage if no namespace is given, " +
+			"and waits for it to become available while printing failing probes. " +
+			"Package images are pulled by package-operator and not by the kubelet, so loading images " +
+			"into kind or k3d nodes is not sufficient: use a registry reachable from the cluster and " +
+			"--cluster-registry-host if the cluster reaches it under a different host. " +
+			"With --watch, the package is rebuilt and rolled out again whenever a source file changes."
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.ExactArgs(2),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		name, srcPath := args[0], args[1]
+		if name == "" {
+			return fmt.Errorf("%w: name must not be empty", internalcmd.ErrInvalidArgs)
+		}
+		if srcPath == "" {
+			return fmt.Errorf("%w: source path must not be empty", internalcmd.ErrInvalidArgs)
+		}
+
+		runOpts := []internalcmd.RunPackageOption{
+			internalcmd.WithNamespace(opts.Namespace),
+			internalcmd.WithComponent(opts.Component),
+			internalcmd.WithRegistry(opts.Registry),
+			internalcmd.WithClusterRegistryHost(opts.ClusterRegistryHost),
+			internalcmd.WithInsecure(opts.Insecure),
+		}
+
+		client, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		it := iteration{
+			name:    name,
+			srcPath: srcPath,
+			opts:    opts,
+			client:  client,
+			runner:  runnerFactory.Runner(),
+			spinner: cli.NewSpinner(cli.WithOut{Out: cmd.ErrOrStderr()}),
+		}
+
+		if !opts.Watch {
+			return it.Run(cmd.Context(), runOpts...)
+		}
+
+		watcher := internalcmd.NewSourceWatcher(srcPath, 0)
+		for {
+			if err := watcher.Snapshot(); err != nil {
+				return err
+			}
+
+			if err := it.Run(cmd.Context(), runOpts...); err != nil {
+				it.spinner.Println(fmt.Sprintf("error: %s", err))
+			}
+			it.spinner.Println(fmt.Sprintf("watching %s for changes", srcPath))
+
+			err := watcher.WaitForChange(cmd.Context())
+			switch {
+			case errors.Is(err, context.Canceled):
+				return nil
+			case err != nil:
+				return err
+			}
+		}
+	}
+
+	return cmd
+}
+
+// iteration of the development loop.
+type iteration struct {
+	name    string
+	srcPath string
+	opts    options
+	client  *internalcmd.Client
+	runner  Runner
+	spinner *cli.Spinner
+}
+
+func (i *iteration) Run(ctx context.Context, runOpts ...internalcmd.RunPackageOption) error {
+	if i.opts.ConfigPath != "" {
+		// Re-read on every iteration, so config changes are picked up in watch mode.
+		config, err := internalcmd.LoadPackageConfigFile(i.opts.ConfigPath)
+		if err != nil {
+			return err
+		}
+
+		runOpts = append(runOpts, internalcmd.WithPackageConfig{Config: config})
+	}
+
+	i.spinner.Start(fmt.Sprintf("building and pushing %s", i.srcPath))
+
+	pkg, err := i.runner.RunPackage(ctx, i.client, i.name, i.srcPath, runOpts...)
+	if err != nil {
+		i.spinner.Stop(fmt.Sprintf("%s could not be rolled out", i.name))
+
+		return err
+	}
+
+	i.spinner.UpdateText(fmt.Sprintf("waiting for %s to become available", i.name))
+
+	ctx, cancel := context.WithTimeout(ctx, i.opts.Timeout)
+	defer cancel()
+
+	if err := pkg.WaitForAvailable(ctx,
+		internalcmd.WithProgress(i.spinner.UpdateText),
+		internalcmd.WithProbeFailure(func(phase, message string) {
+			i.spinner.Println(fmt.Sprintf("phase %q: probe failed: %s", phase, message))
+		}),
+	); err != nil {
+		i.spinner.Stop(fmt.Sprintf("%s is not available", i.name))
+
+		return err
+	}
+
+	i.spinner.Stop(fmt.Sprintf("%s is available", i.name))
+
+	return nil
+}
+
+const defaultTimeout = 5 * time.Minute
+
+type options struct {
+	Namespace           string
+	ConfigPath          string
+	Component           string
+	Registry            string
+	ClusterRegistryHost string
+	Insecure            bool
+	Watch               bool
+	Timeout             time.Duration
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, a namespaced Package is created in this namespace instead of a ClusterPackage",
+	)
+	flags.StringVar(
+		&o.ConfigPath,
+		"config-file",
+		o.ConfigPath,
+		"file containing the package config",
+	)
+	flags.StringVar(
+		&o.Component,
+		"component",
+		o.Component,
+		"component to deploy from a multi-component package",
+	)
+	flags.StringVar(
+		&o.Registry,
+		"registry",
+		o.Registry,
+		"repository prefix to push development images to, e.g. localhost:5001/dev",
+	)
+	flags.StringVar(
+		&o.ClusterRegistryHost,
+		"cluster-registry-host",
+		o.ClusterRegistryHost,
+		"registry host the cluster pulls development images from, if it differs from --registry",
+	)
+	flags.BoolVar(
+		&o.Insecure,
+		"insecure",
+		o.Insecure,
+		"allows pushing images without TLS or using TLS with unverified certificates",
+	)
+	flags.BoolVar(
+		&o.Watch,
+		"watch",
+		o.Watch,
+		"rebuild and roll out the package again whenever a source file changes",
+	)
+	flags.DurationVar(
+		&o.Timeout,
+		"timeout",
+		defaultTimeout,
+		"how long to wait for each rollout to become available",
+	)
+}
@@ -0,0 +1,133 @@
+package runcmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	c := newFakeClient(t, &corev1alpha1.ClusterPackage{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Status: corev1alpha1.PackageStatus{
+			Conditions: []metav1.Condition{{
+				Type:   corev1alpha1.PackageAvailable,
+				Status: metav1.ConditionTrue,
+			}},
+		},
+	})
+	kcli := internalcmd.NewClient(c)
+
+	pkg, err := kcli.GetPackage(context.Background(), "test")
+	require.NoError(t, err)
+
+	runner := &runnerMock{}
+	runner.
+		On("RunPackage", mock.Anything, kcli, "test", "src", mock.Anything).
+		Return(pkg, nil)
+	runnerFactory := &runnerFactoryMock{}
+	runnerFactory.On("Runner").Return(runner)
+	clientFactory := &clientFactoryMock{}
+	clientFactory.On("Client").Return(kcli, nil)
+
+	stderr := &bytes.Buffer{}
+
+	cmd := NewCmd(clientFactory, runnerFactory)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{
+		"test", "src",
+		"--registry", "localhost:5001/dev",
+		"--cluster-registry-host", "dev-registry:5001",
+		"--insecure",
+	})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stderr.String(), "test is available")
+
+	var cfg internalcmd.RunPackageConfig
+	cfg.Option(runner.Calls[0].Arguments.Get(4).([]internalcmd.RunPackageOption)...)
+	assert.Equal(t, internalcmd.RunPackageConfig{
+		Registry:            "localhost:5001/dev",
+		ClusterRegistryHost: "dev-registry:5001",
+		Insecure:            true,
+	}, cfg)
+}
+
+func TestRun_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	for name, args := range map[string][]string{
+		"no args":     {},
+		"empty name":  {"", "src"},
+		"empty path":  {"test", ""},
+		"single args": {"test"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := NewCmd(&clientFactoryMock{}, &runnerFactoryMock{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(args)
+
+			require.Error(t, cmd.Execute())
+		})
+	}
+}
+
+func newFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+}
+
+type clientFactoryMock struct {
+	mock.Mock
+}
+
+func (m *clientFactoryMock) Client() (*internalcmd.Client, error) {
+	args := m.Called()
+
+	return args.Get(0).(*internalcmd.Client), args.Error(1)
+}
+
+type runnerFactoryMock struct {
+	mock.Mock
+}
+
+func (m *runnerFactoryMock) Runner() Runner {
+	args := m.Called()
+
+	return args.Get(0).(Runner)
+}
+
+type runnerMock struct {
+	mock.Mock
+}
+
+func (m *runnerMock) RunPackage(
+	ctx context.Context, client *internalcmd.Client, name, srcPath string, opts ...internalcmd.RunPackageOption,
+) (*internalcmd.Package, error) {
+	args := m.Called(ctx, client, name, srcPath, opts)
+
+	return args.Get(0).(*internalcmd.Package), args.Error(1)
+}
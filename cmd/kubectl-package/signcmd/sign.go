@@ -0,0 +1,82 @@
+package signcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+type SignerFactory interface {
+	Signer() Signer
+}
+
+type Signer interface {
+	SignImage(ctx context.Context, ref string, opts ...internalcmd.SignImageOption) (string, error)
+}
+
+func NewCmd(signerFactory SignerFactory) *cobra.Command {
+	const (
+		cmdUse   = "sign image_reference [--key key] [--insecure]"
+		cmdShort = "sign a published package image with cosign"
+		cmdLong  = "resolves the digest of a package image and signs it using cosign." +
+			" Without --key, cosign signs keyless using the OIDC identity of the user." +
+			" Requires the cosign binary in $PATH."
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ref := args[0]
+		if ref == "" {
+			return fmt.Errorf("%w: image reference empty", internalcmd.ErrInvalidArgs)
+		}
+
+		signed, err := signerFactory.Signer().SignImage(
+			cmd.Context(), ref,
+			internalcmd.WithKey(opts.Key),
+			internalcmd.WithInsecure(opts.Insecure),
+		)
+		if err != nil {
+			return fmt.Errorf("signing image: %w", err)
+		}
+
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Signed %s\n", signed)
+
+		return err
+	}
+
+	return cmd
+}
+
+type options struct {
+	Key      string
+	Insecure bool
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Key,
+		"key",
+		o.Key,
+		"Key reference passed to cosign, e.g. a path to a cosign.key file or a KMS URI. Signs keyless if unset.",
+	)
+	flags.BoolVar(
+		&o.Insecure,
+		"insecure",
+		o.Insecure,
+		"Allows signing images in registries without TLS or using TLS with unverified certificates.",
+	)
+}
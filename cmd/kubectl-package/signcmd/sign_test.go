@@ -0,0 +1,71 @@
+package signcmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	signer := &signerMock{}
+	signer.
+		On("SignImage", mock.Anything, "quay.io/pkg:v1", mock.Anything).
+		Return("quay.io/pkg@sha256:123", nil)
+	factory := &signerFactoryMock{}
+	factory.On("Signer").Return(signer)
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetArgs([]string{"quay.io/pkg:v1", "--key", "cosign.key", "--insecure"})
+
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "Signed quay.io/pkg@sha256:123\n", stdout.String())
+
+	var cfg internalcmd.SignImageConfig
+	cfg.Option(signer.Calls[0].Arguments.Get(2).([]internalcmd.SignImageOption)...)
+	require.Equal(t, internalcmd.SignImageConfig{
+		Insecure: true,
+		Key:      "cosign.key",
+	}, cfg)
+}
+
+func TestSignEmptyReference(t *testing.T) {
+	t.Parallel()
+
+	cmd := NewCmd(&signerFactoryMock{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{""})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidArgs)
+}
+
+type signerFactoryMock struct {
+	mock.Mock
+}
+
+func (m *signerFactoryMock) Signer() Signer {
+	args := m.Called()
+
+	return args.Get(0).(Signer)
+}
+
+type signerMock struct {
+	mock.Mock
+}
+
+func (m *signerMock) SignImage(
+	ctx context.Context, ref string, opts ...internalcmd.SignImageOption,
+) (string, error) {
+	args := m.Called(ctx, ref, opts)
+
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,149 @@
+package statuscmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"package-operator.run/internal/cli"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func NewCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "status (package|clusterpackage)/name | (package|clusterpackage) name"
+		cmdShort = "view the aggregated status of a package"
+		cmdLong  = "view the aggregated status of a package including its object deployment, " +
+			"active and archived revisions, per-phase progress, failing probes and mapped conditions"
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, rawArgs []string) error {
+		args, err := getArgs(rawArgs)
+		if err != nil {
+			return err
+		}
+
+		var getOpts []internalcmd.GetPackageOption
+
+		switch strings.ToLower(args.Resource) {
+		case "clusterpackage":
+		case "package", "pkg":
+			if opts.Namespace == "" {
+				return fmt.Errorf("%w: --namespace is required for namespaced packages", internalcmd.ErrInvalidArgs)
+			}
+
+			getOpts = append(getOpts, internalcmd.WithNamespace(opts.Namespace))
+		default:
+			return fmt.Errorf("%w: %q", errInvalidResourceType, args.Resource)
+		}
+
+		client, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		status, err := client.GetPackageStatus(cmd.Context(), args.Name, getOpts...)
+		if err != nil {
+			return err
+		}
+
+		printer := cli.NewPrinter(cli.WithOut{Out: cmd.OutOrStdout()})
+
+		switch strings.ToLower(opts.Output) {
+		case "json":
+			data, err := status.RenderJSON()
+			if err != nil {
+				return fmt.Errorf("rendering status to json: %w", err)
+			}
+
+			return printer.PrintfOut("%s\n", string(data))
+		case "yaml":
+			data, err := status.RenderYAML()
+			if err != nil {
+				return fmt.Errorf("rendering status to yaml: %w", err)
+			}
+
+			return printer.PrintfOut("%s", string(data))
+		case "":
+			return printer.PrintfOut("%s", status.RenderText())
+		default:
+			return fmt.Errorf("%w: %q", errInvalidOutputFormat, opts.Output)
+		}
+	}
+
+	return cmd
+}
+
+var (
+	errInvalidResourceType = errors.New("invalid resource type")
+	errInvalidOutputFormat = errors.New("invalid output format")
+)
+
+func getArgs(args []string) (*arguments, error) {
+	switch len(args) {
+	case 1:
+		parts := strings.SplitN(args[0], "/", 2)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf(
+				"%w: arguments in resource/name form must have a single resource and name",
+				internalcmd.ErrInvalidArgs,
+			)
+		}
+
+		return &arguments{
+			Resource: parts[0],
+			Name:     parts[1],
+		}, nil
+	case 2:
+		return &arguments{
+			Resource: args[0],
+			Name:     args[1],
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"%w: no less than 1 and no more than 2 arguments may be provided",
+			internalcmd.ErrInvalidArgs,
+		)
+	}
+}
+
+type arguments struct {
+	Resource string
+	Name     string
+}
+
+type options struct {
+	Namespace string
+	Output    string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, the namespace scope for this CLI request",
+	)
+	flags.StringVarP(
+		&o.Output,
+		"output",
+		"o",
+		o.Output,
+		"Output format. One of: json|yaml",
+	)
+}
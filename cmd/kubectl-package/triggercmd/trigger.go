@@ -0,0 +1,151 @@
+package triggercmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/cli"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func NewCmd(clientFactory internalcmd.ClientFactory) *cobra.Command {
+	const (
+		cmdUse   = "trigger TYPE/NAME | TYPE NAME"
+		cmdShort = "request an immediate reconcile of a package, object deployment or object set"
+		cmdLong  = "request an immediate reconcile of a (cluster)package, (cluster)objectdeployment " +
+			"or (cluster)objectset by setting its " + corev1alpha1.ReconcileRequestedAnnotation +
+			" annotation to the current time. Packages are unpacked and deployed again"
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, rawArgs []string) error {
+		args, err := getArgs(rawArgs)
+		if err != nil {
+			return err
+		}
+
+		obj, err := newObject(args.Resource, args.Name, opts.Namespace)
+		if err != nil {
+			return err
+		}
+
+		c, err := clientFactory.Client()
+		if err != nil {
+			return err
+		}
+
+		if err := c.RequestReconcile(cmd.Context(), obj, time.Now()); err != nil {
+			return err
+		}
+
+		printer := cli.NewPrinter(cli.WithOut{Out: cmd.OutOrStdout()})
+
+		return printer.PrintfOut("%s/%s reconcile requested\n", strings.ToLower(args.Resource), args.Name)
+	}
+
+	return cmd
+}
+
+var errInvalidResourceType = errors.New("invalid resource type")
+
+func newObject(resource, name, namespace string) (client.Object, error) {
+	var (
+		obj        client.Object
+		namespaced = true
+	)
+
+	switch strings.ToLower(resource) {
+	case "package", "pkg":
+		obj = &corev1alpha1.Package{}
+	case "clusterpackage":
+		obj, namespaced = &corev1alpha1.ClusterPackage{}, false
+	case "objectdeployment":
+		obj = &corev1alpha1.ObjectDeployment{}
+	case "clusterobjectdeployment":
+		obj, namespaced = &corev1alpha1.ClusterObjectDeployment{}, false
+	case "objectset":
+		obj = &corev1alpha1.ObjectSet{}
+	case "clusterobjectset":
+		obj, namespaced = &corev1alpha1.ClusterObjectSet{}, false
+	default:
+		return nil, fmt.Errorf("%w: %q", errInvalidResourceType, resource)
+	}
+
+	obj.SetName(name)
+
+	if !namespaced {
+		return obj, nil
+	}
+
+	if namespace == "" {
+		return nil, fmt.Errorf("%w: --namespace is required for namespaced resources", internalcmd.ErrInvalidArgs)
+	}
+
+	obj.SetNamespace(namespace)
+
+	return obj, nil
+}
+
+func getArgs(args []string) (*arguments, error) {
+	switch len(args) {
+	case 1:
+		parts := strings.SplitN(args[0], "/", 2)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf(
+				"%w: arguments in resource/name form must have a single resource and name",
+				internalcmd.ErrInvalidArgs,
+			)
+		}
+
+		return &arguments{
+			Resource: parts[0],
+			Name:     parts[1],
+		}, nil
+	case 2:
+		return &arguments{
+			Resource: args[0],
+			Name:     args[1],
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"%w: no less than 1 and no more than 2 arguments may be provided",
+			internalcmd.ErrInvalidArgs,
+		)
+	}
+}
+
+type arguments struct {
+	Resource string
+	Name     string
+}
+
+type options struct {
+	Namespace string
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		o.Namespace,
+		"If present, the namespace scope for this CLI request",
+	)
+}
@@ -0,0 +1,106 @@
+package triggercmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	internalcmd "package-operator.run/internal/cmd"
+)
+
+func TestTrigger(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Args     []string
+		Expected string
+	}{
+		"clusterpackage":   {Args: []string{"clusterpackage/test"}, Expected: "clusterpackage/test reconcile requested"},
+		"package":          {Args: []string{"package", "test", "-n", "default"}, Expected: "package/test"},
+		"objectdeployment": {Args: []string{"objectdeployment/test", "-n", "default"}, Expected: "objectdeployment/test"},
+		"clusterobjectset": {Args: []string{"ClusterObjectSet/test"}, Expected: "clusterobjectset/test"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stdout := &bytes.Buffer{}
+
+			cmd := NewCmd(newClientFactoryMock(t))
+			cmd.SetOut(stdout)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.Args)
+
+			require.NoError(t, cmd.Execute())
+			assert.Contains(t, stdout.String(), tc.Expected)
+		})
+	}
+}
+
+func TestTrigger_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	for name, args := range map[string][]string{
+		"no args":           {},
+		"no name":           {"clusterpackage"},
+		"invalid resource":  {"deployment/test"},
+		"missing namespace": {"package/test"},
+		"not found":         {"clusterobjectdeployment/test"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := NewCmd(newClientFactoryMock(t))
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(args)
+
+			require.Error(t, cmd.Execute())
+		})
+	}
+}
+
+func newClientFactoryMock(t *testing.T) *clientFactoryMock {
+	t.Helper()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1alpha1.ClusterPackage{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			},
+			&corev1alpha1.Package{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			},
+			&corev1alpha1.ObjectDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			},
+			&corev1alpha1.ClusterObjectSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			},
+		).
+		Build()
+
+	factory := &clientFactoryMock{}
+	factory.On("Client").Return(internalcmd.NewClient(c), nil)
+
+	return factory
+}
+
+type clientFactoryMock struct {
+	mock.Mock
+}
+
+func (m *clientFactoryMock) Client() (*internalcmd.Client, error) {
+	args := m.Called()
+
+	return args.Get(0).(*internalcmd.Client), args.Error(1)
+}
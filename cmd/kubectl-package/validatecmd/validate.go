@@ -16,10 +16,12 @@ type Validator interface {
 
 func NewCmd(validator Validator) *cobra.Command {
 	const (
-		validateUse   = "validate [--pull] target"
+		validateUse   = "validate [--pull] [--lint-config file] [--update-fixtures] [--strict] target"
 		validateShort = "validate a package."
 		validateLong  = "validate a package. Target may be a source directory, " +
-			"a package in a tar[.gz] or a fully qualified tag if --pull is set."
+			"a package in a tar[.gz] or a fully qualified tag if --pull is set. " +
+			"Rendered template test cases are compared against the fixtures in .test-fixtures, " +
+			"use --update-fixtures to regenerate them."
 		validationSuccessMessage = "Package validated successfully!"
 	)
 
@@ -34,6 +36,7 @@ func NewCmd(validator Validator) *cobra.Command {
 
 	opts.AddFlags(cmd.Flags())
 
+	cmd.MarkFlagsMutuallyExclusive("pull", "update-fixtures")
 	cmd.RunE = func(cmd *cobra.Command, args []string) (err error) {
 		src := args[0]
 		if src == "" {
@@ -42,6 +45,14 @@ func NewCmd(validator Validator) *cobra.Command {
 
 		validateOptions := []internalcmd.ValidatePackageOption{
 			internalcmd.WithInsecure(opts.Insecure),
+			internalcmd.WithLintConfigPath(opts.LintConfigPath),
+			internalcmd.WithUpdateFixtures(opts.UpdateFixtures),
+			internalcmd.WithStrict(opts.Strict),
+			internalcmd.WithLintWarning(func(warning error) {
+				if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning); err != nil {
+					panic(err)
+				}
+			}),
 		}
 
 		if opts.Pull {
@@ -65,8 +76,11 @@ func NewCmd(validator Validator) *cobra.Command {
 }
 
 type options struct {
-	Insecure bool
-	Pull     bool
+	Insecure       bool
+	Pull           bool
+	LintConfigPath string
+	UpdateFixtures bool
+	Strict         bool
 }
 
 func (o *options) AddFlags(flags *pflag.FlagSet) {
@@ -82,4 +96,23 @@ func (o *options) AddFlags(flags *pflag.FlagSet) {
 		o.Pull,
 		"treat target as image reference and pull it instead of looking on the filesystem",
 	)
+	flags.StringVar(
+		&o.LintConfigPath,
+		"lint-config",
+		o.LintConfigPath,
+		"path to the lint config file. Defaults to .package-lint.yaml in the target directory.",
+	)
+	flags.BoolVar(
+		&o.UpdateFixtures,
+		"update-fixtures",
+		o.UpdateFixtures,
+		"regenerate the test fixtures of all template test cases instead of comparing against them",
+	)
+	flags.BoolVar(
+		&o.Strict,
+		"strict",
+		o.Strict,
+		"fail on template values that resolve to nothing and on config fields unknown to the config schema, "+
+			"even if strict rendering is not enabled in the PackageManifest",
+	)
 }
@@ -59,3 +59,17 @@ func TestValidate_InvalidPath(t *testing.T) {
 	require.Error(t, cmd.Execute())
 	require.NotEmpty(t, stderr.String())
 }
+
+func TestValidate_UpdateFixturesWithPull(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := internalcmd.NewScheme()
+	require.NoError(t, err)
+
+	cmd := NewCmd(internalcmd.NewValidate(scheme))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--pull", "--update-fixtures", "quay.io/package-operator/test-stub:v1"})
+
+	require.Error(t, cmd.Execute())
+}
@@ -0,0 +1,132 @@
+package verifycmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	internalcmd "package-operator.run/internal/cmd"
+	"package-operator.run/internal/packages"
+)
+
+type VerifierFactory interface {
+	Verifier() Verifier
+}
+
+type Verifier interface {
+	VerifyImage(
+		ctx context.Context, ref string, opts ...internalcmd.VerifyImageOption,
+	) (*packages.VerifiedSignature, error)
+}
+
+func NewCmd(verifierFactory VerifierFactory) *cobra.Command {
+	const (
+		cmdUse = "verify image_reference [--key key]... " +
+			"[--certificate-identity identity --certificate-oidc-issuer issuer --certificate-roots roots]"
+		cmdShort = "verify the cosign signatures of a package image"
+		cmdLong  = "verifies that a package image is signed by one of the given keys or keyless identities." +
+			" Uses the same verification as package-operator, when configured with the" +
+			" --package-verification-* flags." +
+			" Signing times of keyless signatures are not verified, so package-operator only accepts keys."
+	)
+
+	cmd := &cobra.Command{
+		Use:   cmdUse,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	var opts options
+
+	opts.AddFlags(cmd.Flags())
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ref := args[0]
+		if ref == "" {
+			return fmt.Errorf("%w: image reference empty", internalcmd.ErrInvalidArgs)
+		}
+
+		verified, err := verifierFactory.Verifier().VerifyImage(
+			cmd.Context(), ref,
+			internalcmd.WithInsecure(opts.Insecure),
+			internalcmd.WithCAFile(opts.CAFile),
+			internalcmd.WithVerificationPolicy{Config: opts.PolicyConfig()},
+		)
+		if err != nil {
+			return fmt.Errorf("verifying image: %w", err)
+		}
+
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Verified %s signed by %s\n", verified.Digest, verified.Subject)
+
+		return err
+	}
+
+	return cmd
+}
+
+type options struct {
+	Keys       []string
+	Identity   string
+	OIDCIssuer string
+	RootsFile  string
+	Insecure   bool
+	CAFile     string
+}
+
+// PolicyConfig mirrors how package-operator builds its verification policy from flags.
+func (o *options) PolicyConfig() packages.VerificationPolicyConfig {
+	cfg := packages.VerificationPolicyConfig{
+		KeyFiles:  o.Keys,
+		RootsFile: o.RootsFile,
+	}
+	if o.Identity != "" {
+		cfg.Identities = []packages.VerificationIdentity{{
+			Issuer:  o.OIDCIssuer,
+			Subject: o.Identity,
+		}}
+	}
+
+	return cfg
+}
+
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVar(
+		&o.Keys,
+		"key",
+		o.Keys,
+		"Path to a PEM encoded public key trusted to sign the image. May be specified multiple times.",
+	)
+	flags.StringVar(
+		&o.Identity,
+		"certificate-identity",
+		o.Identity,
+		"Certificate identity trusted to sign the image keyless, e.g. an email address.",
+	)
+	flags.StringVar(
+		&o.OIDCIssuer,
+		"certificate-oidc-issuer",
+		o.OIDCIssuer,
+		"OIDC issuer of the certificate identity.",
+	)
+	flags.StringVar(
+		&o.RootsFile,
+		"certificate-roots",
+		o.RootsFile,
+		"Path to PEM encoded certificate roots used to verify keyless signatures.",
+	)
+	flags.BoolVar(
+		&o.Insecure,
+		"insecure",
+		o.Insecure,
+		"Allows pulling from registries without TLS or using TLS with unverified certificates.",
+	)
+	flags.StringVar(
+		&o.CAFile,
+		"ca",
+		o.CAFile,
+		"Path to a PEM encoded CA bundle to verify the registry certificate with, in addition to the system CAs.",
+	)
+}
@@ -0,0 +1,86 @@
+package verifycmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	internalcmd "package-operator.run/internal/cmd"
+	"package-operator.run/internal/packages"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	verifier := &verifierMock{}
+	verifier.
+		On("VerifyImage", mock.Anything, "quay.io/pkg:v1", mock.Anything).
+		Return(&packages.VerifiedSignature{Digest: "sha256:123", Subject: "me@example.com"}, nil)
+	factory := &verifierFactoryMock{}
+	factory.On("Verifier").Return(verifier)
+
+	cmd := NewCmd(factory)
+	stdout := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetArgs([]string{
+		"quay.io/pkg:v1",
+		"--key", "a.pub", "--key", "b.pub",
+		"--certificate-identity", "me@example.com",
+		"--certificate-oidc-issuer", "https://issuer.example.com",
+		"--certificate-roots", "roots.pem",
+		"--ca", "ca.pem",
+	})
+
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "Verified sha256:123 signed by me@example.com\n", stdout.String())
+
+	var cfg internalcmd.VerifyImageConfig
+	cfg.Option(verifier.Calls[0].Arguments.Get(2).([]internalcmd.VerifyImageOption)...)
+	require.Equal(t, internalcmd.VerifyImageConfig{
+		CAFile: "ca.pem",
+		Policy: packages.VerificationPolicyConfig{
+			KeyFiles:  []string{"a.pub", "b.pub"},
+			RootsFile: "roots.pem",
+			Identities: []packages.VerificationIdentity{{
+				Issuer:  "https://issuer.example.com",
+				Subject: "me@example.com",
+			}},
+		},
+	}, cfg)
+}
+
+func TestVerifyEmptyReference(t *testing.T) {
+	t.Parallel()
+
+	cmd := NewCmd(&verifierFactoryMock{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{""})
+
+	require.ErrorIs(t, cmd.Execute(), internalcmd.ErrInvalidArgs)
+}
+
+type verifierFactoryMock struct {
+	mock.Mock
+}
+
+func (m *verifierFactoryMock) Verifier() Verifier {
+	args := m.Called()
+
+	return args.Get(0).(Verifier)
+}
+
+type verifierMock struct {
+	mock.Mock
+}
+
+func (m *verifierMock) VerifyImage(
+	ctx context.Context, ref string, opts ...internalcmd.VerifyImageOption,
+) (*packages.VerifiedSignature, error) {
+	args := m.Called(ctx, ref, opts)
+
+	return args.Get(0).(*packages.VerifiedSignature), args.Error(1)
+}
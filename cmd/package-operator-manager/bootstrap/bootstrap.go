@@ -42,6 +42,7 @@ func NewBootstrapper(
 	init := newInitializer(
 		c, scheme, &packageObjectLoad{},
 		registry.Pull, opts.Namespace, opts.SelfBootstrap, opts.SelfBootstrapConfig,
+		opts.SelfBootstrapImagePullSecrets,
 	)
 	fixer := newFixer(c, log, opts.Namespace)
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +21,7 @@ import (
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/controllers/objectdeployments"
+	"package-operator.run/internal/packages"
 )
 
 const (
@@ -42,6 +44,8 @@ type initializer struct {
 	packageOperatorNamespace string
 	selfBootstrapImage       string
 	selfConfig               string
+	// Names of Secrets in the Package Operator namespace used to pull the self-bootstrap image.
+	imagePullSecrets []string
 }
 
 func newInitializer(
@@ -54,6 +58,7 @@ func newInitializer(
 	packageOperatorNamespace string,
 	selfBootstrapImage string,
 	selfConfig string,
+	imagePullSecrets []string,
 ) *initializer {
 	return &initializer{
 		client:    client,
@@ -64,6 +69,7 @@ func newInitializer(
 		packageOperatorNamespace: packageOperatorNamespace,
 		selfBootstrapImage:       selfBootstrapImage,
 		selfConfig:               selfConfig,
+		imagePullSecrets:         imagePullSecrets,
 	}
 }
 
@@ -85,7 +91,7 @@ func (init *initializer) Init(ctx context.Context) (needsBootstrap bool, err err
 }
 
 func (init *initializer) newPKOClusterPackage() *corev1alpha1.ClusterPackage {
-	return &corev1alpha1.ClusterPackage{
+	pkg := &corev1alpha1.ClusterPackage{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: packageOperatorClusterPackageName,
 		},
@@ -94,6 +100,13 @@ func (init *initializer) newPKOClusterPackage() *corev1alpha1.ClusterPackage {
 			Config: init.config(),
 		},
 	}
+	for _, name := range init.imagePullSecrets {
+		pkg.Spec.ImagePullSecrets = append(pkg.Spec.ImagePullSecrets, corev1alpha1.PackageImagePullSecret{
+			Name:      name,
+			Namespace: init.packageOperatorNamespace,
+		})
+	}
+	return pkg
 }
 
 // ensureUpdatedPKO compares new and old PKO ClusterPackages, looks at PKO availability,
@@ -227,12 +240,23 @@ func (init *initializer) config() *runtime.RawExtension {
 func (init *initializer) crdsFromPackage(ctx context.Context) (
 	crds []unstructured.Unstructured, err error,
 ) {
-	rawPkg, err := init.pullImage(ctx, init.selfBootstrapImage)
+	pullSecrets := make(packages.WithPullSecrets, 0, len(init.imagePullSecrets))
+	for _, name := range init.imagePullSecrets {
+		secret := corev1.Secret{}
+		if err := init.client.Get(ctx, client.ObjectKey{
+			Namespace: init.packageOperatorNamespace, Name: name,
+		}, &secret); err != nil {
+			return nil, fmt.Errorf("getting image pull secret: %w", err)
+		}
+		pullSecrets = append(pullSecrets, secret)
+	}
+
+	rawPkg, err := init.pullImage(ctx, init.selfBootstrapImage, pullSecrets)
 	if err != nil {
 		return nil, err
 	}
 
-	objs, err := init.loader.FromPkg(ctx, rawPkg)
+	objs, err := init.loader.FromPkg(ctx, rawPkg, init.config())
 	if err != nil {
 		return nil, err
 	}
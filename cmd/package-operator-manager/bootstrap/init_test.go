@@ -16,12 +16,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
 	"package-operator.run/internal/constants"
+	"package-operator.run/internal/packages"
 	"package-operator.run/internal/testutil"
 )
 
@@ -424,6 +426,45 @@ func Test_initializer_ensureCRDs(t *testing.T) {
 	c.AssertExpectations(t)
 }
 
+func Test_initializer_imagePullSecrets(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+
+	var pullOpts []packages.PullOption
+	init := newInitializer(
+		c, nil, &packageObjectLoaderStub{},
+		func(_ context.Context, _ string, opts ...packages.PullOption) (*packages.RawPackage, error) {
+			pullOpts = opts
+			return &packages.RawPackage{}, nil
+		},
+		"pko-ns", "pko:test", "", []string{"pull-secret"},
+	)
+
+	c.On("Get", mock.Anything, client.ObjectKey{Namespace: "pko-ns", Name: "pull-secret"},
+		mock.AnythingOfType("*v1.Secret"), mock.Anything).
+		Return(nil)
+
+	_, err := init.crdsFromPackage(ctx)
+	require.NoError(t, err)
+	if assert.Len(t, pullOpts, 1) {
+		assert.Len(t, pullOpts[0], 1)
+	}
+
+	pkg := init.newPKOClusterPackage()
+	assert.Equal(t, []corev1alpha1.PackageImagePullSecret{
+		{Name: "pull-secret", Namespace: "pko-ns"},
+	}, pkg.Spec.ImagePullSecrets)
+}
+
+type packageObjectLoaderStub struct{}
+
+func (packageObjectLoaderStub) FromPkg(
+	context.Context, *packages.RawPackage, *runtime.RawExtension,
+) ([]unstructured.Unstructured, error) {
+	return nil, nil
+}
+
 func Test_crdsFromObjects(t *testing.T) {
 	t.Parallel()
 	crd := unstructured.Unstructured{}
@@ -2,29 +2,60 @@ package bootstrap
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"package-operator.run/internal/packages"
 )
 
 type packageObjectLoader interface {
 	FromPkg(
-		ctx context.Context, rawPkg *packages.RawPackage,
+		ctx context.Context, rawPkg *packages.RawPackage, config *runtime.RawExtension,
 	) ([]unstructured.Unstructured, error)
 }
 
 type bootstrapperPullImageFn func(
-	ctx context.Context, image string) (*packages.RawPackage, error)
+	ctx context.Context, image string, opts ...packages.PullOption) (*packages.RawPackage, error)
 
 type packageObjectLoad struct{}
 
 func (pol *packageObjectLoad) FromPkg(
-	ctx context.Context, rawPkg *packages.RawPackage,
+	ctx context.Context, rawPkg *packages.RawPackage, config *runtime.RawExtension,
 ) ([]unstructured.Unstructured, error) {
 	pkg, err := packages.DefaultStructuralLoader.Load(ctx, rawPkg)
 	if err != nil {
 		return nil, err
 	}
+
+	// Fail early on invalid config, instead of installing a ClusterPackage
+	// that can never become available and leaves the cluster without PKO.
+	if err := validateConfig(ctx, pkg, config); err != nil {
+		return nil, err
+	}
 	return packages.RenderObjectsWithFilter(ctx, pkg, packages.PackageRenderContext{}, nil)
 }
+
+// Validates config against the OpenAPI config schema of the package.
+func validateConfig(
+	ctx context.Context, pkg *packages.Package, config *runtime.RawExtension,
+) error {
+	configuration := map[string]any{}
+	if config != nil {
+		if err := json.Unmarshal(config.Raw, &configuration); err != nil {
+			return fmt.Errorf("unmarshal self-bootstrap config: %w", err)
+		}
+	}
+	validationErrors, err := packages.AdmitPackageConfiguration(
+		ctx, configuration, pkg.Manifest, field.NewPath("spec", "config"))
+	if err != nil {
+		return fmt.Errorf("validate self-bootstrap config: %w", err)
+	}
+	if len(validationErrors) > 0 {
+		return fmt.Errorf("invalid self-bootstrap config: %w", validationErrors.ToAggregate())
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"package-operator.run/internal/audit"
+)
+
+func ProvideAuditSink(
+	mgr ctrl.Manager, log logr.Logger, opts Options,
+) (audit.Sink, error) {
+	var sinks audit.Multi
+
+	if len(opts.AuditLogFile) > 0 {
+		fileSink, err := audit.NewFileSink(opts.AuditLogFile)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+
+	if len(opts.AuditWebhookURL) > 0 {
+		webhookSink := audit.NewWebhookSink(log.WithName("audit"), opts.AuditWebhookURL)
+		if err := mgr.Add(webhookSink); err != nil {
+			return nil, fmt.Errorf("unable to register audit webhook: %w", err)
+		}
+		sinks = append(sinks, webhookSink)
+	}
+
+	if len(sinks) == 0 {
+		return audit.Discard{}, nil
+	}
+	return sinks, nil
+}
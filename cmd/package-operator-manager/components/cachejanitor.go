@@ -0,0 +1,21 @@
+package components
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/discovery"
+
+	"package-operator.run/internal/cachejanitor"
+	"package-operator.run/internal/metrics"
+)
+
+func ProvideDynamicCacheJanitor(
+	log logr.Logger, uncachedClient UncachedClient,
+	discoveryClient discovery.DiscoveryInterface,
+	recorder *metrics.Recorder, opts Options,
+) *cachejanitor.Janitor {
+	return cachejanitor.NewJanitor(
+		log.WithName("dynamic-cache-janitor"),
+		uncachedClient, discoveryClient, recorder,
+		opts.DynamicCacheJanitorInterval, opts.ListPageSize,
+	)
+}
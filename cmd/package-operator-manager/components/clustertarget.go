@@ -0,0 +1,34 @@
+package components
+
+import (
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"package-operator.run/internal/audit"
+	"package-operator.run/internal/controllers/clustertargets"
+	"package-operator.run/internal/metrics"
+)
+
+// Type alias for dependency injector.
+type ClusterTargetController struct{ controller }
+
+func ProvideClusterTargetController(
+	mgr ctrl.Manager, log logr.Logger,
+	uncachedClient UncachedClient,
+	recorder *metrics.Recorder,
+) ClusterTargetController {
+	return ClusterTargetController{
+		clustertargets.NewClusterTargetController(
+			mgr.GetClient(), uncachedClient,
+			log.WithName("controllers").WithName("ClusterTarget"),
+			recorder,
+		),
+	}
+}
+
+func ProvideTargetClusters(
+	mgr ctrl.Manager, uncachedClient UncachedClient,
+	recorder *metrics.Recorder, auditSink audit.Sink,
+) *clustertargets.TargetClusters {
+	return clustertargets.NewTargetClusters(uncachedClient, mgr.GetScheme(), recorder, auditSink)
+}
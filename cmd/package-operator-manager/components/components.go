@@ -29,6 +29,7 @@ import (
 	"package-operator.run/internal/dynamiccache"
 	"package-operator.run/internal/environment"
 	"package-operator.run/internal/metrics"
+	"package-operator.run/internal/sharding"
 )
 
 // Returns a new pre-configured DI container.
@@ -37,8 +38,9 @@ func NewComponents() (*dig.Container, error) {
 	providers := []any{
 		ProvideScheme, ProvideRestConfig, ProvideManager,
 		ProvideMetricsRecorder, ProvideDynamicCache,
-		ProvideUncachedClient, ProvideOptions, ProvideLogger,
+		ProvideUncachedClient, ProvideOptions, ProvideLogger, ProvideShard,
 		ProvideRegistry, ProvideDiscoveryClient, ProvideEnvironmentManager,
+		ProvideAuditSink, ProvideUnpackJobRunner, ProvideDynamicCacheJanitor,
 
 		// -----------
 		// Controllers
@@ -54,6 +56,12 @@ func NewComponents() (*dig.Container, error) {
 		ProvidePackageController, ProvideClusterPackageController,
 		// ObjectTemplate
 		ProvideObjectTemplateController, ProvideClusterObjectTemplateController,
+		// PackageReport
+		ProvidePackageReportController,
+		// ClusterTarget
+		ProvideClusterTargetController, ProvideTargetClusters,
+		// ClusterPackageRollout
+		ProvideClusterPackageRolloutController,
 
 		// HostedCluster
 		ProvideHostedClusterController,
@@ -90,10 +98,29 @@ func ProvideRestConfig() (*rest.Config, error) {
 	return ctrl.GetConfig()
 }
 
+func ProvideShard(opts Options) (sharding.Shard, error) {
+	shard := sharding.Shard{
+		Index: opts.ShardIndex,
+		Count: opts.ShardCount,
+	}
+	return shard, shard.Validate()
+}
+
+// Every shard elects its own leader, so shards are reconciled actively in parallel.
+// Shard 0 keeps the lock used without sharding.
+func leaderElectionID(shard sharding.Shard) string {
+	const id = "8a4hp84a6s.package-operator-lock"
+	if shard.IsPrimary() {
+		return id
+	}
+	return fmt.Sprintf("%s-shard-%d", id, shard.Index)
+}
+
 func ProvideManager(
 	scheme *runtime.Scheme,
 	restConfig *rest.Config,
 	opts Options,
+	shard sharding.Shard,
 ) (ctrl.Manager, error) {
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme:                     scheme,
@@ -103,7 +130,7 @@ func ProvideManager(
 		LeaderElectionResourceLock: "leases",
 		LeaderElection:             opts.EnableLeaderElection,
 		LeaderElectionNamespace:    opts.Namespace,
-		LeaderElectionID:           "8a4hp84a6s.package-operator-lock",
+		LeaderElectionID:           leaderElectionID(shard),
 		MapperProvider:             apiutil.NewDynamicRESTMapper,
 		Cache: cache.Options{
 			ByObject: map[client.Object]cache.ByObject{
@@ -128,11 +155,19 @@ func ProvideManager(
 	if err := mgr.AddReadyzCheck("check", healthz.Ping); err != nil {
 		return nil, fmt.Errorf("unable to set up ready check: %w", err)
 	}
+	if err := addWarmupCheck(mgr, "cache-sync", cacheSyncCheck(mgr)); err != nil {
+		return nil, err
+	}
 
 	// PPROF
 	if err := registerPPROF(mgr, opts.PPROFAddr); err != nil {
 		return nil, err
 	}
+
+	// Tracing
+	if err := registerTracing(mgr, opts.TracingOTLPEndpoint, opts.TracingOTLPInsecure); err != nil {
+		return nil, err
+	}
 	return mgr, nil
 }
 
@@ -145,6 +180,7 @@ func ProvideMetricsRecorder() *metrics.Recorder {
 func ProvideDynamicCache(
 	mgr ctrl.Manager,
 	recorder *metrics.Recorder,
+	opts Options,
 ) (*dynamiccache.Cache, error) {
 	dc := dynamiccache.NewCache(
 		mgr.GetConfig(), mgr.GetScheme(), mgr.GetRESTMapper(), recorder,
@@ -156,7 +192,12 @@ func ProvideDynamicCache(
 					constants.DynamicCacheLabel: "True",
 				}),
 			},
-		})
+		},
+		dynamiccache.ListPageSize(opts.ListPageSize),
+	)
+	if err := addWarmupCheck(mgr, "dynamic-cache-sync", dc.ReadyCheck); err != nil {
+		return nil, err
+	}
 	return dc, nil
 }
 
@@ -3,7 +3,10 @@ package components
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/sharding"
 )
 
 func TestNewComponents(t *testing.T) {
@@ -46,6 +49,14 @@ func TestProvideRestConfig(t *testing.T) {
 func TestProvideManager(t *testing.T) {
 	t.Parallel()
 
-	_, err := ProvideManager(nil, nil, Options{})
+	_, err := ProvideManager(nil, nil, Options{}, sharding.Shard{})
 	require.EqualError(t, err, "must specify Config")
 }
+
+func TestLeaderElectionID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "8a4hp84a6s.package-operator-lock", leaderElectionID(sharding.Shard{}))
+	assert.Equal(t, "8a4hp84a6s.package-operator-lock", leaderElectionID(sharding.Shard{Index: 0, Count: 2}))
+	assert.Equal(t, "8a4hp84a6s.package-operator-lock-shard-1", leaderElectionID(sharding.Shard{Index: 1, Count: 2}))
+}
@@ -1,6 +1,8 @@
 package components
 
 import (
+	"text/template"
+
 	"github.com/go-logr/logr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -14,16 +16,37 @@ type HostedClusterController struct{ controller }
 func ProvideHostedClusterController(
 	mgr ctrl.Manager, log logr.Logger,
 	opts Options,
-) HostedClusterController {
+) (HostedClusterController, error) {
+	image := opts.HostedClusterPackageImage
+	if len(image) == 0 {
+		image = opts.PackageOperatorPackageImage
+	}
+
+	var configTemplate *template.Template
+	if len(opts.HostedClusterPackageConfigTemplate) > 0 {
+		var err error
+		configTemplate, err = hostedclusters.ParsePackageConfigTemplate(opts.HostedClusterPackageConfigTemplate)
+		if err != nil {
+			return HostedClusterController{}, err
+		}
+	}
+
 	return HostedClusterController{
 		hostedclusters.NewHostedClusterController(
 			mgr.GetClient(),
 			log.WithName("controllers").WithName("HostedCluster"),
 			mgr.GetScheme(),
-			opts.PackageOperatorPackageImage,
-			// use the same affinity and tolerations for remote-phase and hosted-cluster
+			image,
+			// use the same scheduling settings and resources for remote-phase and hosted-cluster
 			opts.SubComponentAffinity,
 			opts.SubComponentTolerations,
+			opts.SubComponentNodeSelector,
+			opts.SubComponentPriorityClassName,
+			opts.SubComponentResources,
+			opts.HostedClusterPackageReplicas,
+			opts.HostedClusterPackageColocateWithControlPlane,
+			opts.HostedClusterPackageResourceQuota,
+			configTemplate,
 		),
-	}
+	}, nil
 }
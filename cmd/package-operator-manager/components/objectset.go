@@ -4,9 +4,12 @@ import (
 	"github.com/go-logr/logr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"package-operator.run/internal/audit"
+	"package-operator.run/internal/controllers/clustertargets"
 	"package-operator.run/internal/controllers/objectsets"
 	"package-operator.run/internal/dynamiccache"
 	"package-operator.run/internal/metrics"
+	"package-operator.run/internal/sharding"
 )
 
 // Type alias for dependency injector to differentiate
@@ -21,15 +24,21 @@ func ProvideObjectSetController(
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
 	recorder *metrics.Recorder,
-) ObjectSetController {
-	return ObjectSetController{
-		objectsets.NewObjectSetController(
-			mgr.GetClient(),
-			log.WithName("controllers").WithName("ObjectSet"),
-			mgr.GetScheme(), dc, uncachedClient, recorder,
-			mgr.GetRESTMapper(),
-		),
+	auditSink audit.Sink,
+	shard sharding.Shard,
+	opts Options,
+) (ObjectSetController, error) {
+	c := objectsets.NewObjectSetController(
+		mgr.GetClient(),
+		log.WithName("controllers").WithName("ObjectSet"),
+		mgr.GetScheme(), dc, uncachedClient, recorder,
+		mgr.GetRESTMapper(), auditSink, mgr.GetEventRecorderFor("package-operator"),
+		shard, opts.AvailabilityProbeInterval, opts.ObjectStatus, opts.NamespacedClusterScopedKinds,
+	)
+	if err := addWarmupCheck(mgr, "objectsets-reconciled", c.ReadyCheck); err != nil {
+		return ObjectSetController{}, err
 	}
+	return ObjectSetController{c}, nil
 }
 
 func ProvideClusterObjectSetController(
@@ -37,13 +46,20 @@ func ProvideClusterObjectSetController(
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
 	recorder *metrics.Recorder,
-) ClusterObjectSetController {
-	return ClusterObjectSetController{
-		objectsets.NewClusterObjectSetController(
-			mgr.GetClient(),
-			log.WithName("controllers").WithName("ObjectSet"),
-			mgr.GetScheme(), dc, uncachedClient, recorder,
-			mgr.GetRESTMapper(),
-		),
+	auditSink audit.Sink,
+	targets *clustertargets.TargetClusters,
+	shard sharding.Shard,
+	opts Options,
+) (ClusterObjectSetController, error) {
+	c := objectsets.NewClusterObjectSetController(
+		mgr.GetClient(),
+		log.WithName("controllers").WithName("ObjectSet"),
+		mgr.GetScheme(), dc, uncachedClient, recorder,
+		mgr.GetRESTMapper(), auditSink, mgr.GetEventRecorderFor("package-operator"),
+		targets, shard, opts.AvailabilityProbeInterval, opts.ObjectStatus,
+	)
+	if err := addWarmupCheck(mgr, "clusterobjectsets-reconciled", c.ReadyCheck); err != nil {
+		return ClusterObjectSetController{}, err
 	}
+	return ClusterObjectSetController{c}, nil
 }
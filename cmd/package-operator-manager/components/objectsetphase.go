@@ -4,6 +4,7 @@ import (
 	"github.com/go-logr/logr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"package-operator.run/internal/audit"
 	"package-operator.run/internal/controllers/objectsetphases"
 	"package-operator.run/internal/dynamiccache"
 )
@@ -21,13 +22,14 @@ func ProvideObjectSetPhaseController(
 	mgr ctrl.Manager, log logr.Logger,
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
+	auditSink audit.Sink,
 ) ObjectSetPhaseController {
 	return ObjectSetPhaseController{
 		objectsetphases.NewSameClusterObjectSetPhaseController(
 			log.WithName("controllers").WithName("ObjectSetPhase"),
 			mgr.GetScheme(), dc, uncachedClient,
 			defaultObjectSetPhaseClass, mgr.GetClient(),
-			mgr.GetRESTMapper(),
+			mgr.GetRESTMapper(), auditSink,
 		),
 	}
 }
@@ -36,13 +38,14 @@ func ProvideClusterObjectSetPhaseController(
 	mgr ctrl.Manager, log logr.Logger,
 	dc *dynamiccache.Cache,
 	uncachedClient UncachedClient,
+	auditSink audit.Sink,
 ) ClusterObjectSetPhaseController {
 	return ClusterObjectSetPhaseController{
 		objectsetphases.NewSameClusterClusterObjectSetPhaseController(
 			log.WithName("controllers").WithName("ClusterObjectSetPhase"),
 			mgr.GetScheme(), dc, uncachedClient,
 			defaultObjectSetPhaseClass, mgr.GetClient(),
-			mgr.GetRESTMapper(),
+			mgr.GetRESTMapper(), auditSink,
 		),
 	}
 }
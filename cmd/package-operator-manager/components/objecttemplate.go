@@ -32,6 +32,7 @@ func ProvideObjectTemplateController(
 			objecttemplate.ControllerConfig{
 				OptionalResourceRetryInterval: options.ObjectTemplateOptionalResourceRetryInterval,
 				ResourceRetryInterval:         options.ObjectTemplateResourceRetryInterval,
+				RestrictClusterSources:        options.ObjectTemplateRestrictClusterSources,
 			},
 		),
 	}
@@ -51,6 +52,7 @@ func ProvideClusterObjectTemplateController(
 			objecttemplate.ControllerConfig{
 				OptionalResourceRetryInterval: options.ObjectTemplateOptionalResourceRetryInterval,
 				ResourceRetryInterval:         options.ObjectTemplateResourceRetryInterval,
+				RestrictClusterSources:        options.ObjectTemplateRestrictClusterSources,
 			},
 		),
 	}
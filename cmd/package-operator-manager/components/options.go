@@ -7,27 +7,44 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/packages"
+	"package-operator.run/internal/utils"
 )
 
 // Flags.
 const (
 	metricsAddrFlagDescription    = "The address the metric endpoint binds to."
-	pprofAddrFlagDescription      = "The address the pprof web endpoint binds to."
 	namespaceFlagDescription      = "The namespace the operator is deployed into."
 	leaderElectionFlagDescription = "Enable leader election for controller manager. " +
 		"Enabling this will ensure there is only one active controller manager."
-	probeAddrFlagDescription   = "The address the probe endpoint binds to."
-	versionFlagDescription     = "print version information and exit."
-	copyToFlagDescription      = "(internal) copy this binary to a new location"
-	loadPackageFlagDescription = "(internal) runs the package-loader sub-component" +
-		" to load a package mounted at /package"
+	probeAddrFlagDescription     = "The address the probe endpoint binds to."
+	versionFlagDescription       = "print version information and exit."
+	copyToFlagDescription        = "(internal) copy this binary to a new location"
+	unpackPackageFlagDescription = "(internal) unpacks the Package <namespace>/<name> " +
+		"or ClusterPackage <name> and exits, used by unpack Jobs"
 	selfBootstrapFlagDescription = "(internal) bootstraps Package Operator" +
 		" with Package Operator using the given Package Operator Package Image"
+	selfBootstrapImagePullSecretsFlagDescription = "Comma separated names of Secrets in the Package Operator " +
+		"namespace holding the credentials to pull the self-bootstrap image."
 	registryHostOverrides = "List of registry host overrides to change during image pulling. " +
 		"e.g. quay.io=localhost:123,<original-host>=<new-host>"
+	packageVerificationKeysFlagDescription = "Comma separated list of PEM encoded public keys. " +
+		"If set, package images must be signed by one of the keys."
+	packageVerificationIdentityFlagDescription = "Certificate identity trusted to sign package images " +
+		"without a key. Requires --package-verification-oidc-issuer and --package-verification-roots. " +
+		"Not supported yet, the manager refuses to start while set."
+	packageVerificationOIDCIssuerFlagDescription = "OIDC issuer of the certificate identity " +
+		"trusted to sign package images without a key."
+	packageVerificationRootsFlagDescription = "PEM encoded certificate roots used to verify " +
+		"keyless package image signatures."
 	packageOperatorPackageImage = "Image pointing to a package operator package. " +
 		"This image is currently used with the HyperShift integration to spin up the remote-phase-manager " +
 		"and hosted-cluster-manager for every HostedCluster"
@@ -36,15 +53,88 @@ const (
 		"like remote-phase-manager."
 	subCmpntTolerationsFlagDescription = "Pod tolerations settings used in PKO deployed subcomponents, " +
 		"like remote-phase-manager."
+	subCmpntNodeSelectorFlagDescription = "Pod node selector used in PKO deployed subcomponents, " +
+		"like remote-phase-manager."
+	subCmpntPriorityClassNameFlagDescription = "Pod priority class name used in PKO deployed subcomponents, " +
+		"like remote-phase-manager."
+	subCmpntResourcesFlagDescription = "Container resource requirements used in PKO deployed subcomponents, " +
+		"like remote-phase-manager."
 	objectTemplateOptionalResourceRetryIntervalFlagDescription = "The interval at which the controller will retry " +
 		"getting optional source resource for an ObjectTemplate."
 	objectTemplateResourceRetryIntervalFlagDescription = "The interval at which the controller will retry " +
 		"getting source resource for an ObjectTemplate."
+	objectTemplateRestrictClusterSourcesFlagDescription = "Only allow ClusterObjectTemplates to read sources " +
+		"from namespaces annotated with " + corev1alpha1.ClusterObjectTemplateSourcesAnnotation + "."
+	tracingOTLPEndpointFlagDescription = "The OTLP/gRPC endpoint traces are exported to, e.g. otel-collector:4317. " +
+		"Tracing is disabled when empty."
+	tracingOTLPInsecureFlagDescription = "Export traces without TLS."
+	auditLogFileFlagDescription        = "File to append a JSON line to for every object created, " +
+		"patched or deleted by Package Operator. Disabled when empty."
+	auditWebhookURLFlagDescription = "URL to post a JSON document to for every object created, " +
+		"patched or deleted by Package Operator. Disabled when empty."
+	pprofAddrFlagDescription = "The address the pprof and expvar web endpoint binds to, " +
+		"localhost if no host is given. Also exposes Go runtime metrics. Disabled when empty."
+	hostedClusterPackageImageFlagDescription = "Package image installed for every HostedCluster. " +
+		"Defaults to the package operator package image."
+	hostedClusterPackageConfigTemplateFlagDescription = "Go template rendering the YAML config of the Package " +
+		"installed for every HostedCluster. The HostedCluster is available as .HostedCluster " +
+		"with Name, Namespace, Labels, Annotations, Platform, ReleaseImage and ReleaseVersion."
+	hostedClusterPackageReplicasFlagDescription = "Number of remote-phase-manager replicas " +
+		"installed for every HostedCluster. Defaults to the package default when 0."
+	hostedClusterPackageColocateFlagDescription = "Prefer scheduling the remote-phase-manager " +
+		"onto the nodes running the hosted control plane of its HostedCluster."
+	hostedClusterPackageResourceQuotaFlagDescription = "ResourceQuota spec created in the hosted control plane " +
+		"namespace of every HostedCluster."
+	availabilityProbeIntervalFlagDescription = "Interval availability probes of ObjectSets are re-evaluated in, " +
+		"independent of full reconciles. Disabled when 0."
+	dynamicCacheJanitorIntervalFlagDescription = "Interval the dynamic cache label is removed in from objects, " +
+		"whose Package Operator owners are gone. Disabled when 0."
+	namespacedClusterScopedKindsFlagDescription = "Comma separated list of cluster-scoped kinds " +
+		"namespaced packages may contain, e.g. ClusterRole.rbac.authorization.k8s.io. " +
+		"Must match the list configured on the webhook server."
+	objectStatusFlagDescription = "Report the state of every object in the status of ObjectSets and ClusterObjectSets."
+	listPageSizeFlagDescription = "Number of objects requested per page, " +
+		"when caches for managed objects are filled from the API server."
+	shardCountFlagDescription = "Number of shards ObjectSet reconciliation is spread across. " +
+		"Every shard needs at least one replica. Sharding is disabled for values <= 1."
+	shardIndexFlagDescription = "Index of the shard reconciled by this replica, starting at 0. " +
+		"Shard 0 also runs all controllers that are not sharded."
+	unpackJobImageFlagDescription = "Package Operator manager image used to unpack packages in Jobs, " +
+		"instead of pulling package images within the manager process. Disabled when empty."
+	unpackJobServiceAccountFlagDescription = "ServiceAccount unpack Jobs run as. " +
+		"Needs the same permissions as Package Operator."
+	unpackJobResourcesFlagDescription = "Container resource requirements of unpack Jobs."
+	unpackJobTimeoutFlagDescription   = "Unpack Jobs running longer than this are failed."
+	imageCacheDirFlagDescription      = "Directory to cache the contents of pulled package images in by digest, " +
+		"e.g. on an emptyDir or PersistentVolume. Disabled when empty."
+	imageCacheMaxSizeFlagDescription = "Size limit of the package image cache, " +
+		"least recently used images are evicted first."
+	imagePullBackendFlagDescription = "Backend fetching package images: " +
+		"registry pulls from the image registry, " +
+		"containerd reads images already downloaded by the node from its containerd content store, " +
+		"file reads images from an OCI image layout directory."
+	imagePullBackendPathFlagDescription = "Directory read by the image pull backend. " +
+		"The containerd content store mounted from the node, defaults to " +
+		packages.DefaultContainerdContentStore + ". " +
+		"The OCI image layout directory for the file backend."
+	renderMaxObjectsFlagDescription = "Maximum number of objects rendered by a package. " +
+		"Packages exceeding it are reported Invalid. Disabled when 0."
+	renderMaxObjectSizeFlagDescription = "Maximum size of a single object rendered by a package. " +
+		"Packages exceeding it are reported Invalid. Disabled when 0."
+	renderMaxTotalSizeFlagDescription = "Maximum size of all objects rendered by a package. " +
+		"Packages exceeding it are reported Invalid. Disabled when 0."
 )
 
+// Guards against templates looping over the wrong input, while leaving room for large packages.
+const defaultRenderMaxObjects = 10000
+
 type Options struct {
 	MetricsAddr                 string
 	PPROFAddr                   string
+	TracingOTLPEndpoint         string
+	TracingOTLPInsecure         bool
+	AuditLogFile                string
+	AuditWebhookURL             string
 	Namespace                   string
 	EnableLeaderElection        bool
 	ProbeAddr                   string
@@ -52,19 +142,67 @@ type Options struct {
 	PackageHashModifier         *int32
 	PackageOperatorPackageImage string
 
+	// Package image signature verification
+	PackageVerificationKeys       string
+	PackageVerificationIdentity   string
+	PackageVerificationOIDCIssuer string
+	PackageVerificationRoots      string
+
 	// sub commands
 	SelfBootstrap       string
 	SelfBootstrapConfig string
-	PrintVersion        io.Writer
-	CopyTo              string
+	// Names of image pull secrets for the self-bootstrap image.
+	SelfBootstrapImagePullSecrets []string
+	PrintVersion                  io.Writer
+	CopyTo                        string
+	UnpackPackage                 string
 
 	// Sub component Settings
-	SubComponentAffinity    *corev1.Affinity
-	SubComponentTolerations []corev1.Toleration
+	SubComponentAffinity          *corev1.Affinity
+	SubComponentTolerations       []corev1.Toleration
+	SubComponentNodeSelector      map[string]string
+	SubComponentPriorityClassName string
+	SubComponentResources         *corev1.ResourceRequirements
+
+	// HostedCluster Package settings
+	HostedClusterPackageImage                    string
+	HostedClusterPackageConfigTemplate           string
+	HostedClusterPackageReplicas                 int
+	HostedClusterPackageColocateWithControlPlane bool
+	HostedClusterPackageResourceQuota            *corev1.ResourceQuotaSpec
 
 	// Controller configuration
 	ObjectTemplateOptionalResourceRetryInterval time.Duration
 	ObjectTemplateResourceRetryInterval         time.Duration
+	ObjectTemplateRestrictClusterSources        bool
+	ListPageSize                                int64
+	AvailabilityProbeInterval                   time.Duration
+	DynamicCacheJanitorInterval                 time.Duration
+	ObjectStatus                                bool
+	NamespacedClusterScopedKinds                []schema.GroupKind
+
+	// Sharding of ObjectSet reconciliation
+	ShardCount int
+	ShardIndex int
+
+	// Unpacking packages in Jobs
+	UnpackJobImage              string
+	UnpackJobServiceAccountName string
+	UnpackJobResources          *corev1.ResourceRequirements
+	UnpackJobTimeout            time.Duration
+
+	// Caching package images on disk
+	ImageCacheDir     string
+	ImageCacheMaxSize resource.Quantity
+
+	// Fetching package images
+	ImagePullBackend     string
+	ImagePullBackendPath string
+
+	// Limits of objects rendered by packages
+	RenderMaxObjects    int
+	RenderMaxObjectSize resource.Quantity
+	RenderMaxTotalSize  resource.Quantity
 }
 
 func ProvideOptions() (opts Options, err error) {
@@ -78,6 +216,22 @@ func ProvideOptions() (opts Options, err error) {
 		&opts.PPROFAddr, "pprof-addr",
 		"",
 		pprofAddrFlagDescription)
+	flag.StringVar(
+		&opts.TracingOTLPEndpoint, "tracing-otlp-endpoint",
+		os.Getenv("PKO_TRACING_OTLP_ENDPOINT"),
+		tracingOTLPEndpointFlagDescription)
+	flag.BoolVar(
+		&opts.TracingOTLPInsecure, "tracing-otlp-insecure",
+		false,
+		tracingOTLPInsecureFlagDescription)
+	flag.StringVar(
+		&opts.AuditLogFile, "audit-log-file",
+		os.Getenv("PKO_AUDIT_LOG_FILE"),
+		auditLogFileFlagDescription)
+	flag.StringVar(
+		&opts.AuditWebhookURL, "audit-webhook-url",
+		os.Getenv("PKO_AUDIT_WEBHOOK_URL"),
+		auditWebhookURLFlagDescription)
 	flag.StringVar(
 		&opts.Namespace, "namespace",
 		os.Getenv("PKO_NAMESPACE"),
@@ -98,14 +252,79 @@ func ProvideOptions() (opts Options, err error) {
 		&opts.PackageOperatorPackageImage, "package-operator-package-image",
 		os.Getenv("PKO_PACKAGE_OPERATOR_PACKAGE_IMAGE"),
 		packageOperatorPackageImage)
+	flag.StringVar(
+		&opts.HostedClusterPackageImage, "hosted-cluster-package-image",
+		os.Getenv("PKO_HOSTED_CLUSTER_PACKAGE_IMAGE"),
+		hostedClusterPackageImageFlagDescription)
+	flag.StringVar(
+		&opts.HostedClusterPackageConfigTemplate, "hosted-cluster-package-config-template",
+		os.Getenv("PKO_HOSTED_CLUSTER_PACKAGE_CONFIG_TEMPLATE"),
+		hostedClusterPackageConfigTemplateFlagDescription)
+	flag.BoolVar(
+		&opts.HostedClusterPackageColocateWithControlPlane, "hosted-cluster-package-colocate-with-control-plane",
+		os.Getenv("PKO_HOSTED_CLUSTER_PACKAGE_COLOCATE_WITH_CONTROL_PLANE") == "true",
+		hostedClusterPackageColocateFlagDescription)
 	flag.StringVar(
 		&opts.SelfBootstrap, "self-bootstrap", "", selfBootstrapFlagDescription)
 	flag.StringVar(
 		&opts.SelfBootstrapConfig, "self-bootstrap-config", os.Getenv("PKO_CONFIG"), "")
+	selfBootstrapImagePullSecrets := flag.String(
+		"self-bootstrap-image-pull-secrets", os.Getenv("PKO_IMAGE_PULL_SECRETS"),
+		selfBootstrapImagePullSecretsFlagDescription)
+	flag.StringVar(
+		&opts.UnpackPackage, "unpack-package", "", unpackPackageFlagDescription)
+	flag.StringVar(
+		&opts.UnpackJobImage, "unpack-job-image",
+		os.Getenv("PKO_UNPACK_JOB_IMAGE"),
+		unpackJobImageFlagDescription)
+	flag.StringVar(
+		&opts.UnpackJobServiceAccountName, "unpack-job-service-account",
+		"package-operator",
+		unpackJobServiceAccountFlagDescription)
+	flag.DurationVar(
+		&opts.UnpackJobTimeout, "unpack-job-timeout",
+		10*time.Minute, unpackJobTimeoutFlagDescription)
+	flag.StringVar(
+		&opts.ImageCacheDir, "image-cache-dir",
+		os.Getenv("PKO_IMAGE_CACHE_DIR"),
+		imageCacheDirFlagDescription)
+	imageCacheMaxSize := flag.String(
+		"image-cache-max-size", envOrDefault("PKO_IMAGE_CACHE_MAX_SIZE", "1Gi"),
+		imageCacheMaxSizeFlagDescription)
+	flag.StringVar(
+		&opts.ImagePullBackend, "image-pull-backend",
+		envOrDefault("PKO_IMAGE_PULL_BACKEND", "registry"),
+		imagePullBackendFlagDescription)
+	flag.StringVar(
+		&opts.ImagePullBackendPath, "image-pull-backend-path",
+		os.Getenv("PKO_IMAGE_PULL_BACKEND_PATH"),
+		imagePullBackendPathFlagDescription)
+	renderMaxObjectSize := flag.String(
+		"render-max-object-size", envOrDefault("PKO_RENDER_MAX_OBJECT_SIZE", "1536Ki"),
+		renderMaxObjectSizeFlagDescription)
+	renderMaxTotalSize := flag.String(
+		"render-max-total-size", envOrDefault("PKO_RENDER_MAX_TOTAL_SIZE", "0"),
+		renderMaxTotalSizeFlagDescription)
 	flag.StringVar(
 		&opts.RegistryHostOverrides, "registry-host-overrides",
 		os.Getenv("PKO_REGISTRY_HOST_OVERRIDES"),
 		registryHostOverrides)
+	flag.StringVar(
+		&opts.PackageVerificationKeys, "package-verification-keys",
+		os.Getenv("PKO_PACKAGE_VERIFICATION_KEYS"),
+		packageVerificationKeysFlagDescription)
+	flag.StringVar(
+		&opts.PackageVerificationIdentity, "package-verification-identity",
+		os.Getenv("PKO_PACKAGE_VERIFICATION_IDENTITY"),
+		packageVerificationIdentityFlagDescription)
+	flag.StringVar(
+		&opts.PackageVerificationOIDCIssuer, "package-verification-oidc-issuer",
+		os.Getenv("PKO_PACKAGE_VERIFICATION_OIDC_ISSUER"),
+		packageVerificationOIDCIssuerFlagDescription)
+	flag.StringVar(
+		&opts.PackageVerificationRoots, "package-verification-roots",
+		os.Getenv("PKO_PACKAGE_VERIFICATION_ROOTS"),
+		packageVerificationRootsFlagDescription)
 
 	flag.DurationVar(
 		&opts.ObjectTemplateResourceRetryInterval,
@@ -115,10 +334,36 @@ func ProvideOptions() (opts Options, err error) {
 		&opts.ObjectTemplateOptionalResourceRetryInterval,
 		"object-template-optional-resource-retry-interval",
 		time.Second*60, objectTemplateOptionalResourceRetryIntervalFlagDescription)
+	flag.BoolVar(
+		&opts.ObjectTemplateRestrictClusterSources,
+		"object-template-restrict-cluster-sources",
+		false, objectTemplateRestrictClusterSourcesFlagDescription)
+	flag.DurationVar(
+		&opts.AvailabilityProbeInterval,
+		"availability-probe-interval",
+		time.Second*15, availabilityProbeIntervalFlagDescription)
+	flag.DurationVar(
+		&opts.DynamicCacheJanitorInterval,
+		"dynamic-cache-janitor-interval",
+		time.Hour, dynamicCacheJanitorIntervalFlagDescription)
+	flag.BoolVar(
+		&opts.ObjectStatus, "object-status",
+		os.Getenv("PKO_OBJECT_STATUS") == "true", objectStatusFlagDescription)
+	flag.Int64Var(
+		&opts.ListPageSize, "list-page-size",
+		utils.DefaultListPageSize, listPageSizeFlagDescription)
+	namespacedClusterScopedKinds := flag.String(
+		"namespaced-cluster-scoped-kinds", os.Getenv("PKO_NAMESPACED_CLUSTER_SCOPED_KINDS"),
+		namespacedClusterScopedKindsFlagDescription)
 
 	var (
-		subComponentAffinityJSON    string
-		subComponentTolerationsJSON string
+		subComponentAffinityJSON     string
+		subComponentTolerationsJSON  string
+		subComponentNodeSelectorJSON string
+		subComponentResourcesJSON    string
+		unpackJobResourcesJSON       string
+
+		hostedClusterPackageResourceQuotaJSON string
 	)
 	flag.StringVar(
 		&subComponentAffinityJSON, "sub-component-affinity",
@@ -130,6 +375,31 @@ func ProvideOptions() (opts Options, err error) {
 		os.Getenv("PKO_SUB_COMPONENT_TOLERATIONS"),
 		subCmpntAffinityFlagDescription,
 	)
+	flag.StringVar(
+		&subComponentNodeSelectorJSON, "sub-component-node-selector",
+		os.Getenv("PKO_SUB_COMPONENT_NODE_SELECTOR"),
+		subCmpntNodeSelectorFlagDescription,
+	)
+	flag.StringVar(
+		&opts.SubComponentPriorityClassName, "sub-component-priority-class-name",
+		os.Getenv("PKO_SUB_COMPONENT_PRIORITY_CLASS_NAME"),
+		subCmpntPriorityClassNameFlagDescription,
+	)
+	flag.StringVar(
+		&subComponentResourcesJSON, "sub-component-resources",
+		os.Getenv("PKO_SUB_COMPONENT_RESOURCES"),
+		subCmpntResourcesFlagDescription,
+	)
+	flag.StringVar(
+		&unpackJobResourcesJSON, "unpack-job-resources",
+		os.Getenv("PKO_UNPACK_JOB_RESOURCES"),
+		unpackJobResourcesFlagDescription,
+	)
+	flag.StringVar(
+		&hostedClusterPackageResourceQuotaJSON, "hosted-cluster-package-resource-quota",
+		os.Getenv("PKO_HOSTED_CLUSTER_PACKAGE_RESOURCE_QUOTA"),
+		hostedClusterPackageResourceQuotaFlagDescription,
+	)
 	if len(subComponentAffinityJSON) > 0 {
 		if err := json.Unmarshal([]byte(subComponentAffinityJSON), &opts.SubComponentAffinity); err != nil {
 			return Options{}, err
@@ -140,11 +410,62 @@ func ProvideOptions() (opts Options, err error) {
 			return Options{}, err
 		}
 	}
+	if len(subComponentNodeSelectorJSON) > 0 {
+		if err := json.Unmarshal([]byte(subComponentNodeSelectorJSON), &opts.SubComponentNodeSelector); err != nil {
+			return Options{}, err
+		}
+	}
+	if len(subComponentResourcesJSON) > 0 {
+		if err := json.Unmarshal([]byte(subComponentResourcesJSON), &opts.SubComponentResources); err != nil {
+			return Options{}, err
+		}
+	}
+	if len(unpackJobResourcesJSON) > 0 {
+		if err := json.Unmarshal([]byte(unpackJobResourcesJSON), &opts.UnpackJobResources); err != nil {
+			return Options{}, err
+		}
+	}
+	if len(hostedClusterPackageResourceQuotaJSON) > 0 {
+		if err := json.Unmarshal(
+			[]byte(hostedClusterPackageResourceQuotaJSON), &opts.HostedClusterPackageResourceQuota,
+		); err != nil {
+			return Options{}, err
+		}
+	}
 
 	packageHashModifierInt, err := envToInt("PKO_PACKAGE_HASH_MODIFIER")
 	if err != nil {
 		return Options{}, err
 	}
+	shardCount, err := envToInt("PKO_SHARD_COUNT")
+	if err != nil {
+		return Options{}, err
+	}
+	shardIndex, err := envToInt("PKO_SHARD_INDEX")
+	if err != nil {
+		return Options{}, err
+	}
+	hostedClusterPackageReplicas, err := envToInt("PKO_HOSTED_CLUSTER_PACKAGE_REPLICAS")
+	if err != nil {
+		return Options{}, err
+	}
+	renderMaxObjects, err := strconv.Atoi(
+		envOrDefault("PKO_RENDER_MAX_OBJECTS", strconv.Itoa(defaultRenderMaxObjects)))
+	if err != nil {
+		return Options{}, fmt.Errorf("unable to parse environment variable 'PKO_RENDER_MAX_OBJECTS' as integer: %w", err)
+	}
+	flag.IntVar(
+		&opts.RenderMaxObjects, "render-max-objects", renderMaxObjects,
+		renderMaxObjectsFlagDescription)
+	flag.IntVar(
+		&opts.ShardCount, "shard-count", shardCount,
+		shardCountFlagDescription)
+	flag.IntVar(
+		&opts.ShardIndex, "shard-index", shardIndex,
+		shardIndexFlagDescription)
+	flag.IntVar(
+		&opts.HostedClusterPackageReplicas, "hosted-cluster-package-replicas", hostedClusterPackageReplicas,
+		hostedClusterPackageReplicasFlagDescription)
 
 	tmpPackageHashModifier := flag.Int(
 		"package-hash-modifier", packageHashModifierInt,
@@ -156,6 +477,29 @@ func ProvideOptions() (opts Options, err error) {
 		opts.PackageHashModifier = &packageHashModifierInt32
 	}
 
+	opts.ImageCacheMaxSize, err = resource.ParseQuantity(*imageCacheMaxSize)
+	if err != nil {
+		return Options{}, fmt.Errorf("parsing image cache max size: %w", err)
+	}
+	opts.RenderMaxObjectSize, err = resource.ParseQuantity(*renderMaxObjectSize)
+	if err != nil {
+		return Options{}, fmt.Errorf("parsing render max object size: %w", err)
+	}
+	opts.RenderMaxTotalSize, err = resource.ParseQuantity(*renderMaxTotalSize)
+	if err != nil {
+		return Options{}, fmt.Errorf("parsing render max total size: %w", err)
+	}
+
+	for _, kind := range strings.Split(*namespacedClusterScopedKinds, ",") {
+		if kind = strings.TrimSpace(kind); len(kind) > 0 {
+			opts.NamespacedClusterScopedKinds = append(opts.NamespacedClusterScopedKinds, schema.ParseGroupKind(kind))
+		}
+	}
+
+	if len(*selfBootstrapImagePullSecrets) > 0 {
+		opts.SelfBootstrapImagePullSecrets = strings.Split(*selfBootstrapImagePullSecrets, ",")
+	}
+
 	if printVersion {
 		opts.PrintVersion = os.Stderr
 	}
@@ -163,6 +507,14 @@ func ProvideOptions() (opts Options, err error) {
 	return opts, nil
 }
 
+// Returns the value of an environment variable or the given default, if it is unset.
+func envOrDefault(env, def string) string {
+	if v := os.Getenv(env); len(v) > 0 {
+		return v
+	}
+	return def
+}
+
 // Parses an environment variable string value to integer value.
 // Returns 0 in case the environment variable is unset.
 func envToInt(env string) (int, error) {
@@ -7,6 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"package-operator.run/internal/utils"
 )
 
 //nolint:paralleltest
@@ -25,6 +28,9 @@ func TestProvideOptions(t *testing.T) {
 			`{ "matchExpressions": [ { "key": "hypershift.openshift.io/hosted-control-plane", "operator": "Exists" }`+
 			` ] } ] } } }`,
 	)
+	t.Setenv("PKO_SUB_COMPONENT_NODE_SELECTOR", `{"node-role.kubernetes.io/infra":""}`)
+	t.Setenv("PKO_SUB_COMPONENT_PRIORITY_CLASS_NAME", "system-cluster-critical")
+	t.Setenv("PKO_SUB_COMPONENT_RESOURCES", `{"requests":{"cpu":"10m","memory":"50Mi"}}`)
 	opts, err := ProvideOptions()
 	require.NoError(t, err)
 
@@ -66,8 +72,28 @@ func TestProvideOptions(t *testing.T) {
 				},
 			},
 		},
+		SubComponentNodeSelector: map[string]string{
+			"node-role.kubernetes.io/infra": "",
+		},
+		SubComponentPriorityClassName: "system-cluster-critical",
+		SubComponentResources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("50Mi"),
+			},
+		},
 		ObjectTemplateOptionalResourceRetryInterval: time.Second * 60,
 		ObjectTemplateResourceRetryInterval:         time.Second * 30,
+		ListPageSize:                                utils.DefaultListPageSize,
+		UnpackJobServiceAccountName:                 "package-operator",
+		UnpackJobTimeout:                            10 * time.Minute,
+		ImageCacheMaxSize:                           resource.MustParse("1Gi"),
+		RenderMaxObjects:                            defaultRenderMaxObjects,
+		RenderMaxObjectSize:                         resource.MustParse("1536Ki"),
+		RenderMaxTotalSize:                          resource.MustParse("0"),
+		AvailabilityProbeInterval:                   15 * time.Second,
+		DynamicCacheJanitorInterval:                 time.Hour,
+		ImagePullBackend:                            "registry",
 	}, opts)
 }
 
@@ -1,9 +1,14 @@
 package components
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	controllerspackages "package-operator.run/internal/controllers/packages"
@@ -22,9 +27,92 @@ type (
 	}
 )
 
-func ProvideRegistry(log logr.Logger, opts Options) *packages.Registry {
+func ProvideRegistry(
+	log logr.Logger, opts Options, recorder *metrics.Recorder,
+) (*packages.Registry, error) {
+	policy, err := packages.LoadVerificationPolicy(prepareVerificationPolicyConfig(opts))
+	if err != nil {
+		return nil, fmt.Errorf("loading package verification policy: %w", err)
+	}
+	if policy.Keyless() {
+		return nil, fmt.Errorf("loading package verification policy: %w", packages.ErrKeylessUnsupported)
+	}
+	if !policy.Empty() {
+		log.WithName("Registry").Info("package image signature verification active")
+	}
+
+	var imageCache *packages.ImageCache
+	if len(opts.ImageCacheDir) > 0 {
+		imageCache, err = packages.NewImageCache(opts.ImageCacheDir, opts.ImageCacheMaxSize.Value(), recorder)
+		if err != nil {
+			return nil, fmt.Errorf("loading package image cache: %w", err)
+		}
+		log.WithName("Registry").Info("package image cache active",
+			"dir", opts.ImageCacheDir, "maxSize", opts.ImageCacheMaxSize.String())
+	}
+
+	pullBackend, err := preparePullBackend(opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ImagePullBackend != imagePullBackendRegistry {
+		log.WithName("Registry").Info("package image pull backend active",
+			"backend", opts.ImagePullBackend, "path", opts.ImagePullBackendPath)
+	}
+
 	return packages.NewRegistry(
-		prepareRegistryHostOverrides(log, opts.RegistryHostOverrides))
+		prepareRegistryHostOverrides(log, opts.RegistryHostOverrides),
+		packages.WithVerificationPolicy{Policy: policy},
+		packages.WithImageCache{Cache: imageCache},
+		packages.WithPullBackend{Backend: pullBackend},
+	), nil
+}
+
+const (
+	imagePullBackendRegistry   = "registry"
+	imagePullBackendContainerd = "containerd"
+	imagePullBackendFile       = "file"
+)
+
+// ErrUnknownImagePullBackend is returned for image pull backends other than registry, containerd and file.
+var ErrUnknownImagePullBackend = errors.New("unknown image pull backend")
+
+// ErrImagePullBackendPathRequired is returned when the file image pull backend is configured without a path.
+var ErrImagePullBackendPathRequired = errors.New("the file image pull backend requires a path")
+
+func preparePullBackend(opts Options) (packages.PullBackend, error) {
+	switch opts.ImagePullBackend {
+	case imagePullBackendRegistry, "":
+		return packages.RegistryPullBackend{}, nil
+	case imagePullBackendContainerd:
+		contentStore := opts.ImagePullBackendPath
+		if len(contentStore) == 0 {
+			contentStore = packages.DefaultContainerdContentStore
+		}
+		return packages.NewContainerdPullBackend(contentStore), nil
+	case imagePullBackendFile:
+		if len(opts.ImagePullBackendPath) == 0 {
+			return nil, ErrImagePullBackendPathRequired
+		}
+		return packages.FilePullBackend{Dir: opts.ImagePullBackendPath}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownImagePullBackend, opts.ImagePullBackend)
+}
+
+func prepareVerificationPolicyConfig(opts Options) packages.VerificationPolicyConfig {
+	cfg := packages.VerificationPolicyConfig{
+		RootsFile: opts.PackageVerificationRoots,
+	}
+	if len(opts.PackageVerificationKeys) > 0 {
+		cfg.KeyFiles = strings.Split(opts.PackageVerificationKeys, ",")
+	}
+	if len(opts.PackageVerificationIdentity) > 0 {
+		cfg.Identities = []packages.VerificationIdentity{{
+			Issuer:  opts.PackageVerificationOIDCIssuer,
+			Subject: opts.PackageVerificationIdentity,
+		}}
+	}
+	return cfg
 }
 
 func prepareRegistryHostOverrides(log logr.Logger, flag string) map[string]string {
@@ -45,12 +133,69 @@ func prepareRegistryHostOverrides(log logr.Logger, flag string) map[string]strin
 	return out
 }
 
+// ErrUnpackJobsWithVerification is returned when unpack Jobs are enabled together with signature verification.
+var ErrUnpackJobsWithVerification = errors.New(
+	"unpacking packages in Jobs can not be combined with package image signature verification")
+
+// ErrUnpackJobsWithPullBackend is returned when unpack Jobs are enabled together with an image pull backend.
+var ErrUnpackJobsWithPullBackend = errors.New(
+	"unpacking packages in Jobs can only be combined with the registry image pull backend")
+
+// Returns the configuration of unpack Jobs or nil, if packages are unpacked within the manager.
+func unpackJobConfig(opts Options) (*controllerspackages.UnpackJobConfig, error) {
+	if len(opts.UnpackJobImage) == 0 {
+		return nil, nil
+	}
+	if len(opts.PackageVerificationKeys) > 0 || len(opts.PackageVerificationIdentity) > 0 {
+		// Key and root files are not available within unpack Jobs.
+		return nil, ErrUnpackJobsWithVerification
+	}
+	if opts.ImagePullBackend != imagePullBackendRegistry && len(opts.ImagePullBackend) > 0 {
+		// Node directories are not mounted into unpack Jobs.
+		return nil, ErrUnpackJobsWithPullBackend
+	}
+
+	cfg := &controllerspackages.UnpackJobConfig{
+		Image:              opts.UnpackJobImage,
+		Namespace:          opts.Namespace,
+		ServiceAccountName: opts.UnpackJobServiceAccountName,
+		Timeout:            opts.UnpackJobTimeout,
+	}
+	if opts.UnpackJobResources != nil {
+		cfg.Resources = *opts.UnpackJobResources
+	}
+	// Packages are rendered within the Job, so it has to enforce the same limits.
+	cfg.Env = append(cfg.Env,
+		corev1.EnvVar{Name: "PKO_RENDER_MAX_OBJECTS", Value: strconv.Itoa(opts.RenderMaxObjects)},
+		corev1.EnvVar{Name: "PKO_RENDER_MAX_OBJECT_SIZE", Value: opts.RenderMaxObjectSize.String()},
+		corev1.EnvVar{Name: "PKO_RENDER_MAX_TOTAL_SIZE", Value: opts.RenderMaxTotalSize.String()},
+	)
+	if len(opts.RegistryHostOverrides) > 0 {
+		cfg.Env = append(cfg.Env, corev1.EnvVar{
+			Name: "PKO_REGISTRY_HOST_OVERRIDES", Value: opts.RegistryHostOverrides,
+		})
+	}
+	return cfg, nil
+}
+
+func renderLimits(opts Options) packages.RenderLimits {
+	return packages.RenderLimits{
+		MaxObjects:    opts.RenderMaxObjects,
+		MaxObjectSize: opts.RenderMaxObjectSize.Value(),
+		MaxTotalSize:  opts.RenderMaxTotalSize.Value(),
+	}
+}
+
 func ProvidePackageController(
 	mgr ctrl.Manager, log logr.Logger, uncachedClient UncachedClient,
 	registry *packages.Registry,
 	recorder *metrics.Recorder,
 	opts Options,
-) PackageController {
+) (PackageController, error) {
+	unpackJobs, err := unpackJobConfig(opts)
+	if err != nil {
+		return PackageController{}, err
+	}
 	return PackageController{
 		controllerspackages.NewPackageController(
 			mgr.GetClient(),
@@ -58,8 +203,9 @@ func ProvidePackageController(
 			log.WithName("controllers").WithName("Package"),
 			mgr.GetScheme(),
 			registry, recorder, opts.PackageHashModifier,
+			unpackJobs, renderLimits(opts),
 		),
-	}
+	}, nil
 }
 
 func ProvideClusterPackageController(
@@ -68,13 +214,26 @@ func ProvideClusterPackageController(
 	registry *packages.Registry,
 	recorder *metrics.Recorder,
 	opts Options,
-) ClusterPackageController {
+) (ClusterPackageController, error) {
+	unpackJobs, err := unpackJobConfig(opts)
+	if err != nil {
+		return ClusterPackageController{}, err
+	}
 	return ClusterPackageController{
 		controllerspackages.NewClusterPackageController(
 			mgr.GetClient(), uncachedClient.Client,
 			log.WithName("controllers").WithName("ClusterPackage"),
 			mgr.GetScheme(),
 			registry, recorder, opts.PackageHashModifier,
+			unpackJobs, renderLimits(opts),
 		),
-	}
+	}, nil
+}
+
+// Runs within unpack Jobs, see --unpack-package.
+func ProvideUnpackJobRunner(
+	scheme *runtime.Scheme, uncachedClient UncachedClient,
+	registry *packages.Registry, opts Options,
+) *controllerspackages.UnpackJobRunner {
+	return controllerspackages.NewUnpackJobRunner(uncachedClient, scheme, registry, renderLimits(opts))
 }
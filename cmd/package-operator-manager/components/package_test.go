@@ -2,9 +2,16 @@ package components
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	controllerspackages "package-operator.run/internal/controllers/packages"
+	"package-operator.run/internal/packages"
 )
 
 func Test_prepareRegistryHostOverrides(t *testing.T) {
@@ -13,3 +20,101 @@ func Test_prepareRegistryHostOverrides(t *testing.T) {
 	or := prepareRegistryHostOverrides(log, "quay.io=dev-registry.dev-registry.svc.cluster.local:5001")
 	assert.Equal(t, map[string]string{"quay.io": "dev-registry.dev-registry.svc.cluster.local:5001"}, or)
 }
+
+func Test_prepareVerificationPolicyConfig(t *testing.T) {
+	t.Parallel()
+	cfg := prepareVerificationPolicyConfig(Options{
+		PackageVerificationKeys:       "a.pub,b.pub",
+		PackageVerificationIdentity:   "release@example.com",
+		PackageVerificationOIDCIssuer: "https://issuer.example.com",
+		PackageVerificationRoots:      "roots.pem",
+	})
+	assert.Equal(t, packages.VerificationPolicyConfig{
+		KeyFiles:  []string{"a.pub", "b.pub"},
+		RootsFile: "roots.pem",
+		Identities: []packages.VerificationIdentity{{
+			Issuer:  "https://issuer.example.com",
+			Subject: "release@example.com",
+		}},
+	}, cfg)
+
+	assert.Empty(t, prepareVerificationPolicyConfig(Options{}).KeyFiles)
+}
+
+func Test_renderLimits(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, packages.RenderLimits{
+		MaxObjects:    100,
+		MaxObjectSize: 1024 * 1024,
+		MaxTotalSize:  0,
+	}, renderLimits(Options{
+		RenderMaxObjects:    100,
+		RenderMaxObjectSize: resource.MustParse("1Mi"),
+	}))
+}
+
+func Test_unpackJobConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := unpackJobConfig(Options{})
+	require.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	cfg, err = unpackJobConfig(Options{
+		Namespace:                   "pko",
+		UnpackJobImage:              "quay.io/package-operator/package-operator-manager:test",
+		UnpackJobServiceAccountName: "package-operator",
+		UnpackJobTimeout:            time.Minute,
+		RegistryHostOverrides:       "quay.io=localhost:5001",
+		RenderMaxObjects:            100,
+		RenderMaxObjectSize:         resource.MustParse("1Mi"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &controllerspackages.UnpackJobConfig{
+		Image:              "quay.io/package-operator/package-operator-manager:test",
+		Namespace:          "pko",
+		ServiceAccountName: "package-operator",
+		Timeout:            time.Minute,
+		Env: []corev1.EnvVar{
+			{Name: "PKO_RENDER_MAX_OBJECTS", Value: "100"},
+			{Name: "PKO_RENDER_MAX_OBJECT_SIZE", Value: "1Mi"},
+			{Name: "PKO_RENDER_MAX_TOTAL_SIZE", Value: "0"},
+			{Name: "PKO_REGISTRY_HOST_OVERRIDES", Value: "quay.io=localhost:5001"},
+		},
+	}, cfg)
+
+	_, err = unpackJobConfig(Options{
+		UnpackJobImage:          "quay.io/package-operator/package-operator-manager:test",
+		PackageVerificationKeys: "a.pub",
+	})
+	require.ErrorIs(t, err, ErrUnpackJobsWithVerification)
+
+	_, err = unpackJobConfig(Options{
+		UnpackJobImage:   "quay.io/package-operator/package-operator-manager:test",
+		ImagePullBackend: "containerd",
+	})
+	require.ErrorIs(t, err, ErrUnpackJobsWithPullBackend)
+}
+
+func Test_preparePullBackend(t *testing.T) {
+	t.Parallel()
+
+	backend, err := preparePullBackend(Options{ImagePullBackend: "registry"})
+	require.NoError(t, err)
+	assert.Equal(t, packages.RegistryPullBackend{}, backend)
+
+	backend, err = preparePullBackend(Options{ImagePullBackend: "containerd"})
+	require.NoError(t, err)
+	assert.IsType(t, &packages.ContainerdPullBackend{}, backend)
+
+	backend, err = preparePullBackend(Options{ImagePullBackend: "file", ImagePullBackendPath: "/images"})
+	require.NoError(t, err)
+	assert.Equal(t, packages.FilePullBackend{Dir: "/images"}, backend)
+
+	_, err = preparePullBackend(Options{ImagePullBackend: "file"})
+	require.ErrorIs(t, err, ErrImagePullBackendPathRequired)
+
+	_, err = preparePullBackend(Options{ImagePullBackend: "banana"})
+	require.ErrorIs(t, err, ErrUnknownImagePullBackend)
+}
@@ -0,0 +1,22 @@
+package components
+
+import (
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"package-operator.run/internal/controllers/packagereport"
+)
+
+// Type alias for dependency injector.
+type PackageReportController struct{ controller }
+
+func ProvidePackageReportController(
+	mgr ctrl.Manager, log logr.Logger,
+) PackageReportController {
+	return PackageReportController{
+		packagereport.NewPackageReportController(
+			mgr.GetClient(),
+			log.WithName("controllers").WithName("PackageReport"),
+		),
+	}
+}
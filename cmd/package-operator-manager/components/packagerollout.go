@@ -0,0 +1,23 @@
+package components
+
+import (
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"package-operator.run/internal/controllers/packagerollouts"
+)
+
+// Type alias for dependency injector.
+type ClusterPackageRolloutController struct{ controller }
+
+func ProvideClusterPackageRolloutController(
+	mgr ctrl.Manager, log logr.Logger,
+) ClusterPackageRolloutController {
+	return ClusterPackageRolloutController{
+		packagerollouts.NewClusterPackageRolloutController(
+			mgr.GetClient(),
+			log.WithName("controllers").WithName("ClusterPackageRollout"),
+			mgr.GetScheme(),
+		),
+	}
+}
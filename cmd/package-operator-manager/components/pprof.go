@@ -2,12 +2,16 @@ package components
 
 import (
 	"context"
+	"expvar"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/pprof"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 type pprofServer struct {
@@ -21,9 +25,10 @@ func newPPROFServer(pprofAddr string) *pprofServer {
 	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
 
 	s := &http.Server{
-		Addr:              pprofAddr,
+		Addr:              pprofListenAddr(pprofAddr),
 		Handler:           mux,
 		ReadHeaderTimeout: 1 * time.Second,
 	}
@@ -41,11 +46,42 @@ func (s *pprofServer) Start(ctx context.Context) error {
 	return s.server.ListenAndServe()
 }
 
+// Profiles expose process internals, so addresses without a host, e.g. ":6060",
+// only listen on localhost. Use "0.0.0.0:6060" to listen on all interfaces.
+func pprofListenAddr(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || len(host) > 0 {
+		return addr
+	}
+	return net.JoinHostPort("localhost", port)
+}
+
+// The Go collector registered by controller-runtime only exposes memstats.
+// While profiling is enabled, replace it to also expose GC, memory and scheduler
+// metrics from runtime/metrics on the regular metrics endpoint.
+func registerRuntimeMetrics() error {
+	metrics.Registry.Unregister(collectors.NewGoCollector())
+
+	err := metrics.Registry.Register(collectors.NewGoCollector(
+		collectors.WithGoCollectorRuntimeMetrics(
+			collectors.MetricsGC, collectors.MetricsMemory, collectors.MetricsScheduler,
+		),
+	))
+	if err != nil {
+		return fmt.Errorf("unable to register runtime metrics: %w", err)
+	}
+	return nil
+}
+
 func registerPPROF(mgr ctrl.Manager, pprofAddr string) error {
 	if len(pprofAddr) == 0 {
 		return nil
 	}
 
+	if err := registerRuntimeMetrics(); err != nil {
+		return err
+	}
+
 	s := newPPROFServer(pprofAddr)
 	err := mgr.Add(s)
 	if err != nil {
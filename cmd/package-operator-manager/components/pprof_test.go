@@ -1,8 +1,25 @@
 package components
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestSetupPPROF(t *testing.T) {
 	t.Parallel()
 	_ = newPPROFServer(":9999")
 }
+
+func TestPPROFListenAddr(t *testing.T) {
+	t.Parallel()
+
+	for addr, expected := range map[string]string{
+		":6060":          "localhost:6060",
+		"0.0.0.0:6060":   "0.0.0.0:6060",
+		"127.0.0.1:6060": "127.0.0.1:6060",
+		"invalid":        "invalid",
+	} {
+		assert.Equal(t, expected, pprofListenAddr(addr), addr)
+	}
+}
@@ -0,0 +1,68 @@
+package components
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// Upper bound for a single readiness check to wait for the manager cache.
+const cacheSyncCheckTimeout = 100 * time.Millisecond
+
+var errCacheNotSynced = errors.New("waiting for manager cache to sync")
+
+// warmupCheck only gates readiness while the manager warms up after being elected leader.
+// Once passed, it stays passed, so new watches or slow reconciles
+// later on do not take the pod out of service.
+type warmupCheck struct {
+	elected <-chan struct{}
+	check   healthz.Checker
+	passed  atomic.Bool
+}
+
+func (w *warmupCheck) Check(req *http.Request) error {
+	if w.passed.Load() {
+		return nil
+	}
+
+	select {
+	case <-w.elected:
+	default:
+		// Standby replicas don't run controllers and have nothing to warm up.
+		return nil
+	}
+
+	if err := w.check(req); err != nil {
+		return err
+	}
+	w.passed.Store(true)
+	return nil
+}
+
+// Adds a readiness check that fails until the given component has warmed up.
+// The name of the check is reported in the body of /readyz?verbose.
+func addWarmupCheck(mgr ctrl.Manager, name string, check healthz.Checker) error {
+	w := &warmupCheck{elected: mgr.Elected(), check: check}
+	if err := mgr.AddReadyzCheck(name, w.Check); err != nil {
+		return fmt.Errorf("unable to set up %s ready check: %w", name, err)
+	}
+	return nil
+}
+
+func cacheSyncCheck(mgr ctrl.Manager) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), cacheSyncCheckTimeout)
+		defer cancel()
+
+		if !mgr.GetCache().WaitForCacheSync(ctx) {
+			return errCacheNotSynced
+		}
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+package components
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotWarm = errors.New("not warm")
+
+func TestWarmupCheck(t *testing.T) {
+	t.Parallel()
+
+	elected := make(chan struct{})
+	checkErr := errNotWarm
+	var calls int
+	w := &warmupCheck{
+		elected: elected,
+		check: func(*http.Request) error {
+			calls++
+			return checkErr
+		},
+	}
+
+	// Standby replicas are ready.
+	require.NoError(t, w.Check(nil))
+	assert.Equal(t, 0, calls)
+
+	close(elected)
+	require.ErrorIs(t, w.Check(nil), errNotWarm)
+
+	checkErr = nil
+	require.NoError(t, w.Check(nil))
+
+	// Stays ready without checking again.
+	checkErr = errNotWarm
+	require.NoError(t, w.Check(nil))
+	assert.Equal(t, 2, calls)
+}
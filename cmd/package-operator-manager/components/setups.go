@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"package-operator.run/internal/environment"
+	"package-operator.run/internal/sharding"
 
 	"go.uber.org/dig"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -52,6 +53,14 @@ type AllControllers struct {
 
 	ObjectTemplate        ObjectTemplateController
 	ClusterObjectTemplate ClusterObjectTemplateController
+
+	PackageReport PackageReportController
+
+	ClusterTarget ClusterTargetController
+
+	ClusterPackageRollout ClusterPackageRolloutController
+
+	Shard sharding.Shard
 }
 
 func (ac AllControllers) List() []any {
@@ -61,10 +70,27 @@ func (ac AllControllers) List() []any {
 		ac.ObjectDeployment, ac.ClusterObjectDeployment,
 		ac.Package, ac.ClusterPackage,
 		ac.ObjectTemplate, ac.ClusterObjectTemplate,
+		ac.PackageReport,
+		ac.ClusterTarget,
+		ac.ClusterPackageRollout,
 	}
 }
 
 func (ac AllControllers) SetupWithManager(mgr ctrl.Manager) error {
+	if !ac.Shard.IsPrimary() {
+		// All other shards only take over their part of the ObjectSets.
+		return setupAll(mgr, []controllerSetup{
+			{
+				name:       "ObjectSet",
+				controller: ac.ObjectSet,
+			},
+			{
+				name:       "ClusterObjectSet",
+				controller: ac.ClusterObjectSet,
+			},
+		})
+	}
+
 	return setupAll(mgr, []controllerSetup{
 		{
 			name:       "ObjectSet",
@@ -106,6 +132,18 @@ func (ac AllControllers) SetupWithManager(mgr ctrl.Manager) error {
 			name:       "ClusterObjectTemplate",
 			controller: ac.ClusterObjectTemplate,
 		},
+		{
+			name:       "PackageReport",
+			controller: ac.PackageReport,
+		},
+		{
+			name:       "ClusterTarget",
+			controller: ac.ClusterTarget,
+		},
+		{
+			name:       "ClusterPackageRollout",
+			controller: ac.ClusterPackageRollout,
+		},
 	})
 }
 
@@ -10,6 +10,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/sharding"
 )
 
 var errTest = errors.New("test")
@@ -65,6 +66,9 @@ func TestAllControllers(t *testing.T) {
 		cpkg   = newMock()
 		otmpl  = newMock()
 		cotmpl = newMock()
+		pkgrep = newMock()
+		ct     = newMock()
+		pkgro  = newMock()
 	)
 	all := AllControllers{
 		ObjectSet:        ObjectSetController{os},
@@ -81,6 +85,12 @@ func TestAllControllers(t *testing.T) {
 
 		ObjectTemplate:        ObjectTemplateController{otmpl},
 		ClusterObjectTemplate: ClusterObjectTemplateController{cotmpl},
+
+		PackageReport: PackageReportController{pkgrep},
+
+		ClusterTarget: ClusterTargetController{ct},
+
+		ClusterPackageRollout: ClusterPackageRolloutController{pkgro},
 	}
 	err := all.SetupWithManager(nil)
 	require.NoError(t, err)
@@ -88,7 +98,31 @@ func TestAllControllers(t *testing.T) {
 	for _, m := range mocks {
 		m.AssertExpectations(t)
 	}
-	assert.Len(t, all.List(), 10)
+	assert.Len(t, all.List(), 13)
+}
+
+func TestAllControllers_Shard(t *testing.T) {
+	t.Parallel()
+
+	os := &controllerMock{}
+	os.On("SetupWithManager", mock.Anything).Return(nil)
+	cos := &controllerMock{}
+	cos.On("SetupWithManager", mock.Anything).Return(nil)
+	pkg := &controllerMock{}
+
+	all := AllControllers{
+		ObjectSet:        ObjectSetController{os},
+		ClusterObjectSet: ClusterObjectSetController{cos},
+		Package:          PackageController{pkg},
+
+		Shard: sharding.Shard{Index: 1, Count: 2},
+	}
+	err := all.SetupWithManager(nil)
+	require.NoError(t, err)
+
+	os.AssertExpectations(t)
+	cos.AssertExpectations(t)
+	pkg.AssertNotCalled(t, "SetupWithManager", mock.Anything)
 }
 
 func TestBootstrapControllers(t *testing.T) {
@@ -0,0 +1,48 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"package-operator.run/internal/tracing"
+)
+
+const tracingShutdownTimeout = 5 * time.Second
+
+type tracingExporter struct {
+	endpoint string
+	insecure bool
+}
+
+// Traces have to be exported on all replicas, not just the leader.
+func (t *tracingExporter) NeedLeaderElection() bool {
+	return false
+}
+
+func (t *tracingExporter) Start(ctx context.Context) error {
+	shutdown, err := tracing.Setup(ctx, "package-operator-manager", t.endpoint, t.insecure)
+	if err != nil {
+		return err
+	}
+	<-ctx.Done()
+
+	// ctx is already canceled, flush remaining spans with a fresh one.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	return shutdown(shutdownCtx)
+}
+
+func registerTracing(mgr ctrl.Manager, endpoint string, insecure bool) error {
+	if len(endpoint) == 0 {
+		return nil
+	}
+
+	err := mgr.Add(&tracingExporter{endpoint: endpoint, insecure: insecure})
+	if err != nil {
+		return fmt.Errorf("unable to register tracing exporter: %w", err)
+	}
+	return nil
+}
@@ -18,7 +18,9 @@ import (
 
 	"package-operator.run/cmd/package-operator-manager/bootstrap"
 	"package-operator.run/cmd/package-operator-manager/components"
+	"package-operator.run/internal/cachejanitor"
 	hypershiftv1beta1 "package-operator.run/internal/controllers/hostedclusters/hypershift/v1beta1"
+	controllerspackages "package-operator.run/internal/controllers/packages"
 	"package-operator.run/internal/environment"
 	"package-operator.run/internal/version"
 )
@@ -58,6 +60,20 @@ func run(opts components.Options) error {
 	}
 
 	ctx := logr.NewContext(ctrl.SetupSignalHandler(), ctrl.Log)
+	if len(opts.UnpackPackage) > 0 {
+		return di.Invoke(func(
+			runner *controllerspackages.UnpackJobRunner, envMgr *environment.Manager,
+		) error {
+			if err := envMgr.Init(ctx, []environment.Sinker{runner}); err != nil {
+				return err
+			}
+			if err := runner.Run(ctx, opts.UnpackPackage); err != nil {
+				return fmt.Errorf("unpacking %s: %w", opts.UnpackPackage, err)
+			}
+			return nil
+		})
+	}
+
 	if len(opts.SelfBootstrap) > 0 {
 		if err := di.Provide(bootstrap.NewBootstrapper); err != nil {
 			return err
@@ -125,6 +141,7 @@ func newPackageOperatorManager(
 	hostedClusterController components.HostedClusterController,
 	envMgr *environment.Manager,
 	allControllers components.AllControllers,
+	cacheJanitor *cachejanitor.Janitor,
 ) (*packageOperatorManager, error) {
 	if err := allControllers.SetupWithManager(mgr); err != nil {
 		return nil, err
@@ -132,6 +149,12 @@ func newPackageOperatorManager(
 	if err := mgr.Add(envMgr); err != nil {
 		return nil, err
 	}
+	// Objects of all shards are checked at once.
+	if allControllers.Shard.IsPrimary() {
+		if err := mgr.Add(cacheJanitor); err != nil {
+			return nil, err
+		}
+	}
 
 	pkoMgr := &packageOperatorManager{
 		log: log.WithName("package-operator-manager"),
@@ -150,8 +173,11 @@ func (pkoMgr *packageOperatorManager) Start(ctx context.Context) error {
 	ctx = logr.NewContext(ctx, log)
 	log.Info("starting manager")
 
-	if err := pkoMgr.probeHyperShiftIntegration(ctx); err != nil {
-		return fmt.Errorf("setting up HyperShift integration: %w", err)
+	// The HyperShift integration is not sharded and only runs with the other non-sharded controllers.
+	if pkoMgr.allControllers.Shard.IsPrimary() {
+		if err := pkoMgr.probeHyperShiftIntegration(ctx); err != nil {
+			return fmt.Errorf("setting up HyperShift integration: %w", err)
+		}
 	}
 
 	if err := pkoMgr.environmentManager.Init(
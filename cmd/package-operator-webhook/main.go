@@ -3,9 +3,12 @@ package main
 import (
 	"flag"
 	"os"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -13,12 +16,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	corev1beta1 "package-operator.run/apis/core/v1beta1"
 	"package-operator.run/internal/version"
 	"package-operator.run/internal/webhooks"
 )
 
 const (
-	logName = "validating webhooks"
+	logName = "webhooks"
 )
 
 var (
@@ -28,14 +32,16 @@ var (
 
 func init() {
 	_ = corev1alpha1.AddToScheme(scheme)
+	_ = corev1beta1.AddToScheme(scheme)
 }
 
 func main() {
 	var (
-		port         int
-		certDir      string
-		probeAddr    string
-		printVersion bool
+		port                     int
+		certDir                  string
+		probeAddr                string
+		printVersion             bool
+		namespacedClusterKindsFl string
 	)
 
 	flag.IntVar(&port, "port", 8080, "The port the webhook server binds to")
@@ -44,6 +50,9 @@ func main() {
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081",
 		"The address the probe endpoint binds to")
 	flag.BoolVar(&printVersion, "version", false, "print version information and exit")
+	flag.StringVar(&namespacedClusterKindsFl, "namespaced-cluster-scoped-kinds", "",
+		"Comma separated list of cluster-scoped kinds namespaced packages may contain, "+
+			"e.g. ClusterRole.rbac.authorization.k8s.io")
 	flag.Parse()
 
 	if printVersion {
@@ -54,11 +63,28 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
+	var namespacedClusterScopedKinds []schema.GroupKind
+	for _, kind := range strings.Split(namespacedClusterKindsFl, ",") {
+		if kind = strings.TrimSpace(kind); len(kind) > 0 {
+			namespacedClusterScopedKinds = append(namespacedClusterScopedKinds, schema.ParseGroupKind(kind))
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                server.Options{BindAddress: "0"},
 		WebhookServer:          webhook.NewServer(webhook.Options{Port: port, CertDir: certDir}),
 		HealthProbeBindAddress: probeAddr,
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// ObjectSlices are created right before the objects referencing them,
+				// so lookups have to go to the API server directly.
+				DisableFor: []client.Object{
+					&corev1alpha1.ObjectSlice{},
+					&corev1alpha1.ClusterObjectSlice{},
+				},
+			},
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -82,6 +108,7 @@ func main() {
 		Handler: webhooks.NewObjectSetWebhookHandler(
 			log.Log.WithName(logName).WithName("ObjectSets"),
 			mgr.GetClient(),
+			namespacedClusterScopedKinds,
 		),
 	},
 	)
@@ -105,6 +132,55 @@ func main() {
 		),
 	})
 
+	wbh.Register("/validate-object-deployment", &webhook.Admission{
+		Handler: webhooks.NewObjectDeploymentWebhookHandler(
+			log.Log.WithName(logName).WithName("ObjectDeployments"),
+			mgr.GetClient(),
+			namespacedClusterScopedKinds,
+		),
+	})
+	wbh.Register("/validate-cluster-object-deployment", &webhook.Admission{
+		Handler: webhooks.NewClusterObjectDeploymentWebhookHandler(
+			log.Log.WithName(logName).WithName("ClusterObjectDeployments"),
+			mgr.GetClient(),
+		),
+	})
+	wbh.Register("/validate-object-slice", &webhook.Admission{
+		Handler: webhooks.NewObjectSliceWebhookHandler(
+			log.Log.WithName(logName).WithName("ObjectSlices"),
+			mgr.GetClient(),
+		),
+	})
+	wbh.Register("/validate-cluster-object-slice", &webhook.Admission{
+		Handler: webhooks.NewClusterObjectSliceWebhookHandler(
+			log.Log.WithName(logName).WithName("ClusterObjectSlices"),
+			mgr.GetClient(),
+		),
+	})
+	wbh.Register("/validate-package", &webhook.Admission{
+		Handler: webhooks.NewPackageWebhookHandler(
+			log.Log.WithName(logName).WithName("Packages"),
+			mgr.GetClient(),
+		),
+	})
+	wbh.Register("/validate-cluster-package", &webhook.Admission{
+		Handler: webhooks.NewClusterPackageWebhookHandler(
+			log.Log.WithName(logName).WithName("ClusterPackages"),
+			mgr.GetClient(),
+		),
+	})
+
+	wbh.Register("/convert", webhooks.NewConversionWebhookHandler(
+		log.Log.WithName(logName).WithName("Conversion"),
+		scheme,
+	))
+	wbh.Register("/default-object-template", &webhook.Admission{
+		Handler: webhooks.NewObjectTemplateDefaultingWebhookHandler(
+			log.Log.WithName(logName).WithName("ObjectTemplates"),
+			mgr.GetClient(),
+		),
+	})
+
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	apis "package-operator.run/apis"
+	"package-operator.run/internal/audit"
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/controllers/objectsetphases"
 	"package-operator.run/internal/dynamiccache"
@@ -42,6 +43,7 @@ type opts struct {
 	probeAddr                   string
 	class                       string
 	targetClusterKubeconfigFile string
+	auditLogFile                string
 	printVersion                bool
 }
 
@@ -55,6 +57,8 @@ const (
 	versionFlagDescription       = "print version information and exit."
 	classFlagDescription         = "class of the ObjectSetPhase to work on."
 	targetClusterFlagDescription = "Filepath for a kubeconfig for the target cluster."
+	auditLogFileFlagDescription  = "File to append a JSON line to for every object created, " +
+		"patched or deleted in the target cluster. Disabled when empty."
 )
 
 func main() {
@@ -66,6 +70,7 @@ func main() {
 	flag.StringVar(&opts.probeAddr, "health-probe-bind-address", ":8081", probeAddrFlagDescription)
 	flag.StringVar(&opts.targetClusterKubeconfigFile, "target-cluster-kubeconfig-file", "", targetClusterFlagDescription)
 	flag.StringVar(&opts.class, "class", "hosted-cluster", classFlagDescription)
+	flag.StringVar(&opts.auditLogFile, "audit-log-file", "", auditLogFileFlagDescription)
 	flag.BoolVar(&opts.printVersion, "version", false, versionFlagDescription)
 	flag.Parse()
 
@@ -199,11 +204,18 @@ func run(log logr.Logger, scheme *runtime.Scheme, opts opts) error {
 
 	managementClusterClient := mgr.GetClient()
 
+	var auditSink audit.Sink = audit.Discard{}
+	if len(opts.auditLogFile) > 0 {
+		if auditSink, err = audit.NewFileSink(opts.auditLogFile); err != nil {
+			return err
+		}
+	}
+
 	if err = objectsetphases.NewMultiClusterObjectSetPhaseController(
 		ctrl.Log.WithName("controllers").WithName("ObjectSetPhase"),
 		mgr.GetScheme(), dc, uncachedTargetClient,
 		opts.class, managementClusterClient,
-		targetClient, targetMapper,
+		targetClient, targetMapper, auditSink,
 	).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("unable to create controller for ObjectSetPhase: %w", err)
 	}
@@ -214,7 +226,7 @@ func run(log logr.Logger, scheme *runtime.Scheme, opts opts) error {
 			ctrl.Log.WithName("controllers").WithName("ClusterObjectSetPhase"),
 			mgr.GetScheme(), dc, uncachedTargetClient,
 			opts.class, managementClusterClient,
-			targetClient, targetMapper,
+			targetClient, targetMapper, auditSink,
 		).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller for ClusterObjectSetPhase: %w", err)
 		}
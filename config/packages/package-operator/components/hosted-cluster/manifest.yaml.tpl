@@ -43,6 +43,15 @@ spec:
           description: Hosted cluster namespace for leader election
           type: string
           default: package-operator-system
+        nodeSelector:
+          description: NodeSelector is a selector which must match a node's labels
+            for Package Operator to be scheduled on that node.
+          additionalProperties:
+            type: string
+          type: object
+        priorityClassName:
+          description: PriorityClassName of Package Operator pods.
+          type: string
         affinity:
           description: Affinity is a group of affinity scheduling rules.
           properties:
@@ -19,6 +19,15 @@ spec:
   config:
     openAPIV3Schema:
       properties:
+        nodeSelector:
+          description: NodeSelector is a selector which must match a node's labels
+            for Package Operator to be scheduled on that node.
+          additionalProperties:
+            type: string
+          type: object
+        priorityClassName:
+          description: PriorityClassName of Package Operator pods.
+          type: string
         affinity:
           description: Affinity is a group of affinity scheduling rules.
           properties:
@@ -798,6 +807,52 @@ spec:
                   type: array
               type: object
           type: object
+        resources:
+          description: ResourceRequirements describes the compute resource requirements.
+          properties:
+            claims:
+              description: "Claims lists the names of resources, defined in spec.resourceClaims,
+                that are used by this container. \n This is an alpha field and requires
+                enabling the DynamicResourceAllocation feature gate. \n This field
+                is immutable."
+              items:
+                description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                properties:
+                  name:
+                    description: Name must match the name of one entry in pod.spec.resourceClaims
+                      of the Pod where this field is used. It makes that resource
+                      available inside a container.
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            limits:
+              additionalProperties:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              description: 'Limits describes the maximum amount of compute resources
+                allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+              type: object
+            requests:
+              additionalProperties:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              description: 'Requests describes the minimum amount of compute resources
+                required. If Requests is omitted for a container, it defaults to Limits
+                if that is explicitly specified, otherwise to an implementation-defined
+                value. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+              type: object
+          type: object
         tolerations:
           description: Tolerations allow the scheduler to schedule Package Operator
             on nodes with matching taints.
@@ -838,8 +893,69 @@ spec:
                 type: string
             type: object
           type: array
+        replicas:
+          description: Number of remote phase manager replicas. Leader election
+            keeps a single replica active, additional replicas take over faster.
+          default: 1
+          minimum: 1
+          type: integer
+        colocateWithControlPlane:
+          description: Prefers scheduling the remote phase manager onto the nodes
+            running the hosted control plane it belongs to, following the topology
+            of the hosted control plane namespace. Added to the configured affinity.
+          type: boolean
+        resourceQuota:
+          description: ResourceQuota created in the hosted control plane namespace,
+            when set. It applies to all pods in the namespace, unless narrowed down
+            by a scope selector.
+          properties:
+            hard:
+              additionalProperties:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              description: Hard is the set of enforced hard limits for each named resource.
+              type: object
+            scopeSelector:
+              description: ScopeSelector limits the quota to the resources matching
+                all of its expressions, e.g. a priority class.
+              properties:
+                matchExpressions:
+                  description: A list of scope selector requirements by scope of
+                    the resources.
+                  items:
+                    description: A scoped-resource selector requirement is a selector
+                      that contains values, a scope name, and an operator that relates
+                      the scope name and values.
+                    properties:
+                      operator:
+                        description: Represents a scope's relationship to a set
+                          of values. Valid operators are In, NotIn, Exists, DoesNotExist.
+                        type: string
+                      scopeName:
+                        description: The name of the scope that the selector applies
+                          to.
+                        type: string
+                      values:
+                        description: An array of string values. If the operator
+                          is In or NotIn, the values array must be non-empty. If
+                          the operator is Exists or DoesNotExist, the values array
+                          must be empty.
+                        items:
+                          type: string
+                        type: array
+                    required:
+                    - operator
+                    - scopeName
+                    type: object
+                  type: array
+              type: object
+          type: object
       type: object
   phases:
+  - name: quota
   - name: rbac
   - name: deploy
   scopes:
@@ -874,4 +990,38 @@ test:
         tolerations:
         - effect: NoSchedule
           key: node-role.kubernetes.io/infra
+        nodeSelector:
+          node-role.kubernetes.io/infra: ""
+        priorityClassName: system-cluster-critical
+        resources:
+          requests:
+            cpu: 10m
+            memory: 50Mi
     name: affinity-tolerations-resources
+  - context:
+      package:
+        metadata:
+          annotations: null
+          labels: null
+          name: test
+          namespace: clusters-test
+      config:
+        replicas: 2
+        colocateWithControlPlane: true
+        affinity:
+          nodeAffinity:
+            requiredDuringSchedulingIgnoredDuringExecution:
+              nodeSelectorTerms:
+              - matchExpressions:
+                - key: hypershift.openshift.io/control-plane
+                  operator: Exists
+        resourceQuota:
+          hard:
+            pods: "10"
+          scopeSelector:
+            matchExpressions:
+            - operator: In
+              scopeName: PriorityClass
+              values:
+              - hypershift-control-plane
+    name: hosted-control-plane-scheduling
@@ -40,10 +40,133 @@ spec:
           type: string
         objectTemplateOptionalResourceRetryInterval:
           type: string
+        objectTemplateRestrictClusterSources:
+          description: Only allow ClusterObjectTemplates to read sources from namespaces
+            annotated with package-operator.run/cluster-object-template-sources.
+          type: boolean
+        tracingOTLPEndpoint:
+          description: OTLP/gRPC endpoint to export traces to, e.g. otel-collector:4317.
+          type: string
+        tracingOTLPInsecure:
+          description: Export traces without TLS.
+          type: boolean
+        auditWebhookURL:
+          description: URL to post a JSON audit entry to for every object created, patched or deleted.
+          type: string
+        imageCache:
+          description: Caches the contents of pulled package images by digest,
+            so packages using the same image are only pulled once.
+          properties:
+            maxSize:
+              description: Size limit of the cache, defaults to 1Gi.
+              type: string
+            persistentVolumeClaim:
+              description: Name of a PersistentVolumeClaim to keep the cache on.
+                Uses an emptyDir when empty.
+              type: string
+          type: object
+        objectStatus:
+          description: Report the state of every object in the status of ObjectSets and ClusterObjectSets.
+          type: boolean
+        renderLimits:
+          description: Limits of the objects rendered by packages.
+            Packages exceeding them are reported Invalid.
+          properties:
+            maxObjects:
+              description: Maximum number of objects rendered by a package, defaults to 10000.
+                Disabled when 0.
+              type: integer
+            maxObjectSize:
+              description: Maximum size of a single object rendered by a package, defaults to 1536Ki.
+                Disabled when 0.
+              type: string
+            maxTotalSize:
+              description: Maximum size of all objects rendered by a package.
+                Disabled when 0, the default.
+              type: string
+          type: object
+        hostedClusterPackage:
+          description: Package installed for every HyperShift HostedCluster.
+          properties:
+            image:
+              description: Package image, defaults to the package operator package image.
+              type: string
+            configTemplate:
+              description: Go template rendering the YAML config of the package.
+                The HostedCluster is available as .HostedCluster with Name, Namespace,
+                Labels, Annotations, Platform, ReleaseImage and ReleaseVersion.
+              type: string
+            replicas:
+              description: Number of remote phase manager replicas per HostedCluster.
+              minimum: 1
+              type: integer
+            colocateWithControlPlane:
+              description: Prefers scheduling the remote phase manager onto the nodes
+                running the hosted control plane it belongs to.
+              type: boolean
+            resourceQuota:
+              description: ResourceQuota created in the hosted control plane namespace,
+                when set. It applies to all pods in the namespace, unless narrowed down
+                by a scope selector.
+              properties:
+                hard:
+                  additionalProperties:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  description: Hard is the set of enforced hard limits for each named resource.
+                  type: object
+                scopeSelector:
+                  description: ScopeSelector limits the quota to the resources matching
+                    all of its expressions, e.g. a priority class.
+                  properties:
+                    matchExpressions:
+                      description: A list of scope selector requirements by scope of
+                        the resources.
+                      items:
+                        description: A scoped-resource selector requirement is a selector
+                          that contains values, a scope name, and an operator that relates
+                          the scope name and values.
+                        properties:
+                          operator:
+                            description: Represents a scope's relationship to a set
+                              of values. Valid operators are In, NotIn, Exists, DoesNotExist.
+                            type: string
+                          scopeName:
+                            description: The name of the scope that the selector applies
+                              to.
+                            type: string
+                          values:
+                            description: An array of string values. If the operator
+                              is In or NotIn, the values array must be non-empty. If
+                              the operator is Exists or DoesNotExist, the values array
+                              must be empty.
+                            items:
+                              type: string
+                            type: array
+                        required:
+                        - operator
+                        - scopeName
+                        type: object
+                      type: array
+                  type: object
+              type: object
+          type: object
         namespace:
           description: Namespace to install package operator into.
           type: string
           default: package-operator-system
+        nodeSelector:
+          description: NodeSelector is a selector which must match a node's labels
+            for Package Operator to be scheduled on that node.
+          additionalProperties:
+            type: string
+          type: object
+        priorityClassName:
+          description: PriorityClassName of Package Operator pods.
+          type: string
         affinity:
           description: Affinity is a group of affinity scheduling rules.
           properties:
@@ -869,6 +992,53 @@ spec:
                 value. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
               type: object
           type: object
+        subComponentResources:
+          description: Compute resource requirements of PKO deployed subcomponents,
+            like remote-phase-manager.
+          properties:
+            claims:
+              description: "Claims lists the names of resources, defined in spec.resourceClaims,
+                that are used by this container. \n This is an alpha field and requires
+                enabling the DynamicResourceAllocation feature gate. \n This field
+                is immutable."
+              items:
+                description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                properties:
+                  name:
+                    description: Name must match the name of one entry in pod.spec.resourceClaims
+                      of the Pod where this field is used. It makes that resource
+                      available inside a container.
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            limits:
+              additionalProperties:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              description: 'Limits describes the maximum amount of compute resources
+                allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+              type: object
+            requests:
+              additionalProperties:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              description: 'Requests describes the minimum amount of compute resources
+                required. If Requests is omitted for a container, it defaults to Limits
+                if that is explicitly specified, otherwise to an implementation-defined
+                value. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+              type: object
+          type: object
         tolerations:
           description: Tolerations allow the scheduler to schedule Package Operator
             on nodes with matching taints.
@@ -956,6 +1126,13 @@ test:
         tolerations:
         - effect: NoSchedule
           key: node-role.kubernetes.io/infra
+        nodeSelector:
+          node-role.kubernetes.io/infra: ""
+        priorityClassName: system-cluster-critical
+        subComponentResources:
+          requests:
+            cpu: 10m
+            memory: 50Mi
     name: affinity-tolerations-resources
   - context:
       package:
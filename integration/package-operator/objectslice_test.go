@@ -0,0 +1,62 @@
+//go:build integration
+
+package packageoperator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/utils"
+)
+
+// The checksum is taken before the slice is created,
+// so it has to match the objects after the kube-apiserver applied CRD defaults and coerced metadata.
+func TestObjectSliceChecksumRoundTrip(t *testing.T) {
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+
+	objects := []corev1alpha1.ObjectSetObject{
+		{Object: unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1", "kind": "ConfigMap",
+			"metadata": map[string]any{"name": "test-slice-checksum-cm", "labels": map[string]any{"app": "test"}},
+			"data":     map[string]any{"banana": "bread"},
+		}}},
+		{
+			Object: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1", "kind": "Secret",
+				"metadata": map[string]any{"name": "test-slice-checksum-secret"},
+			}},
+			External: &corev1alpha1.ObjectSetObjectExternal{},
+		},
+	}
+	checksum, err := utils.ComputeObjectsChecksum(objects)
+	require.NoError(t, err)
+
+	slice := &corev1alpha1.ObjectSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-slice-checksum",
+			Namespace: "default",
+		},
+		Objects:  objects,
+		Checksum: checksum,
+	}
+	require.NoError(t, Client.Create(ctx, slice))
+	cleanupOnSuccess(ctx, t, slice)
+
+	stored := &corev1alpha1.ObjectSlice{}
+	require.NoError(t, Client.Get(ctx, client.ObjectKeyFromObject(slice), stored))
+	assert.Equal(t, corev1alpha1.CollisionProtectionPrevent, stored.Objects[0].CollisionProtection,
+		"CRD defaults must be applied for this test to be meaningful")
+
+	storedChecksum, err := utils.ComputeObjectsChecksum(stored.Objects)
+	require.NoError(t, err)
+	assert.Equal(t, stored.Checksum, storedChecksum)
+}
@@ -7,6 +7,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/kstatus"
 )
 
 type ObjectDeploymentAccessor interface {
@@ -18,6 +19,12 @@ type ObjectDeploymentAccessor interface {
 	SetTemplateSpec(corev1alpha1.ObjectSetTemplateSpec)
 	GetTemplateSpec() corev1alpha1.ObjectSetTemplateSpec
 	GetRevisionHistoryLimit() *int32
+	GetPriority() int32
+	SetPriority(priority int32)
+	GetRolloutSchedule() *corev1alpha1.RolloutSchedule
+	SetRolloutSchedule(schedule *corev1alpha1.RolloutSchedule)
+	GetFreeze() *corev1alpha1.RolloutFreeze
+	SetFreeze(freeze *corev1alpha1.RolloutFreeze)
 	SetStatusConditions(...metav1.Condition)
 	SetStatusCollisionCount(*int32)
 	GetStatusCollisionCount() *int32
@@ -74,6 +81,30 @@ func (a *ObjectDeployment) GetRevisionHistoryLimit() *int32 {
 	return a.Spec.RevisionHistoryLimit
 }
 
+func (a *ObjectDeployment) GetPriority() int32 {
+	return a.Spec.Priority
+}
+
+func (a *ObjectDeployment) SetPriority(priority int32) {
+	a.Spec.Priority = priority
+}
+
+func (a *ObjectDeployment) GetRolloutSchedule() *corev1alpha1.RolloutSchedule {
+	return a.Spec.RolloutSchedule
+}
+
+func (a *ObjectDeployment) SetRolloutSchedule(schedule *corev1alpha1.RolloutSchedule) {
+	a.Spec.RolloutSchedule = schedule
+}
+
+func (a *ObjectDeployment) GetFreeze() *corev1alpha1.RolloutFreeze {
+	return a.Spec.Freeze
+}
+
+func (a *ObjectDeployment) SetFreeze(freeze *corev1alpha1.RolloutFreeze) {
+	a.Spec.Freeze = freeze
+}
+
 func (a *ObjectDeployment) SetStatusCollisionCount(cc *int32) {
 	a.Status.CollisionCount = cc
 }
@@ -88,6 +119,7 @@ func (a *ObjectDeployment) ClientObject() client.Object {
 
 func (a *ObjectDeployment) UpdatePhase() {
 	a.Status.Phase = objectDeploymentPhase(a.Status.Conditions)
+	objectDeploymentKStatus(a.ClientObject(), a.Status.Conditions).Apply(&a.Status.Conditions, a.Generation)
 }
 
 func (a *ObjectDeployment) GetConditions() *[]metav1.Condition {
@@ -157,6 +189,30 @@ func (a *ClusterObjectDeployment) GetRevisionHistoryLimit() *int32 {
 	return a.Spec.RevisionHistoryLimit
 }
 
+func (a *ClusterObjectDeployment) GetPriority() int32 {
+	return a.Spec.Priority
+}
+
+func (a *ClusterObjectDeployment) SetPriority(priority int32) {
+	a.Spec.Priority = priority
+}
+
+func (a *ClusterObjectDeployment) GetRolloutSchedule() *corev1alpha1.RolloutSchedule {
+	return a.Spec.RolloutSchedule
+}
+
+func (a *ClusterObjectDeployment) SetRolloutSchedule(schedule *corev1alpha1.RolloutSchedule) {
+	a.Spec.RolloutSchedule = schedule
+}
+
+func (a *ClusterObjectDeployment) GetFreeze() *corev1alpha1.RolloutFreeze {
+	return a.Spec.Freeze
+}
+
+func (a *ClusterObjectDeployment) SetFreeze(freeze *corev1alpha1.RolloutFreeze) {
+	a.Spec.Freeze = freeze
+}
+
 func (a *ClusterObjectDeployment) SetStatusCollisionCount(cc *int32) {
 	a.Status.CollisionCount = cc
 }
@@ -167,6 +223,7 @@ func (a *ClusterObjectDeployment) ClientObject() client.Object {
 
 func (a *ClusterObjectDeployment) UpdatePhase() {
 	a.Status.Phase = objectDeploymentPhase(a.Status.Conditions)
+	objectDeploymentKStatus(a.ClientObject(), a.Status.Conditions).Apply(&a.Status.Conditions, a.Generation)
 }
 
 func (a *ClusterObjectDeployment) GetConditions() *[]metav1.Condition {
@@ -245,3 +302,20 @@ func objectDeploymentPhase(conditions []metav1.Condition) corev1alpha1.ObjectDep
 	}
 	return corev1alpha1.ObjectDeploymentPhaseProgressing
 }
+
+// ObjectDeployments are reconciling until their current revision is available.
+func objectDeploymentKStatus(obj client.Object, conditions []metav1.Condition) kstatus.Status {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return kstatus.Status{Reconciling: &kstatus.Reason{
+			Reason: "Terminating", Message: "Tearing down revisions."}}
+	}
+	if meta.IsStatusConditionTrue(conditions, corev1alpha1.ObjectDeploymentProgressing) {
+		return kstatus.Status{Reconciling: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conditions, corev1alpha1.ObjectDeploymentProgressing), "Progressing")}
+	}
+	if !meta.IsStatusConditionTrue(conditions, corev1alpha1.ObjectDeploymentAvailable) {
+		return kstatus.Status{Reconciling: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conditions, corev1alpha1.ObjectDeploymentAvailable), "Pending")}
+	}
+	return kstatus.Status{}
+}
@@ -21,6 +21,17 @@ func TestObjectDeployment(t *testing.T) {
 	deploy.ObjectDeployment.Spec.RevisionHistoryLimit = &revisionHistoryLimit
 	assert.Equal(t, &revisionHistoryLimit, deploy.GetRevisionHistoryLimit())
 
+	deploy.SetPriority(100)
+	assert.Equal(t, int32(100), deploy.GetPriority())
+
+	schedule := &corev1alpha1.RolloutSchedule{TimeZone: "Europe/Berlin"}
+	deploy.SetRolloutSchedule(schedule)
+	assert.Same(t, schedule, deploy.GetRolloutSchedule())
+
+	freeze := &corev1alpha1.RolloutFreeze{Reason: "incident"}
+	deploy.SetFreeze(freeze)
+	assert.Same(t, freeze, deploy.GetFreeze())
+
 	var collisionCount int32 = 4
 	deploy.SetStatusCollisionCount(&collisionCount)
 	assert.Equal(t, &collisionCount, deploy.GetStatusCollisionCount())
@@ -65,6 +76,17 @@ func TestClusterObjectDeployment(t *testing.T) {
 	deploy.ClusterObjectDeployment.Spec.RevisionHistoryLimit = &revisionHistoryLimit
 	assert.Equal(t, &revisionHistoryLimit, deploy.GetRevisionHistoryLimit())
 
+	deploy.SetPriority(100)
+	assert.Equal(t, int32(100), deploy.GetPriority())
+
+	schedule := &corev1alpha1.RolloutSchedule{TimeZone: "Europe/Berlin"}
+	deploy.SetRolloutSchedule(schedule)
+	assert.Same(t, schedule, deploy.GetRolloutSchedule())
+
+	freeze := &corev1alpha1.RolloutFreeze{Reason: "incident"}
+	deploy.SetFreeze(freeze)
+	assert.Same(t, freeze, deploy.GetFreeze())
+
 	var collisionCount int32 = 4
 	deploy.SetStatusCollisionCount(&collisionCount)
 	assert.Equal(t, &collisionCount, deploy.GetStatusCollisionCount())
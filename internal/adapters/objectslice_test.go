@@ -18,6 +18,9 @@ func TestObjectSlice(t *testing.T) {
 	object := []corev1alpha1.ObjectSetObject{}
 	slice.SetObjects(object)
 	assert.Equal(t, slice.Objects, slice.GetObjects())
+
+	slice.SetChecksum("1234")
+	assert.Equal(t, "1234", slice.GetChecksum())
 }
 
 func TestClusterObjectSlice(t *testing.T) {
@@ -30,4 +33,7 @@ func TestClusterObjectSlice(t *testing.T) {
 	object := []corev1alpha1.ObjectSetObject{}
 	slice.SetObjects(object)
 	assert.Equal(t, slice.Objects, slice.GetObjects())
+
+	slice.SetChecksum("1234")
+	assert.Equal(t, "1234", slice.GetChecksum())
 }
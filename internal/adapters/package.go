@@ -8,6 +8,7 @@ import (
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/kstatus"
 	"package-operator.run/internal/utils"
 )
 
@@ -16,6 +17,28 @@ type GenericPackageAccessor interface {
 	UpdatePhase()
 	GetConditions() *[]metav1.Condition
 	GetImage() string
+	GetSpecImage() string
+	GetImagePullSecrets() []client.ObjectKey
+	GetConfigSources() []corev1alpha1.PackageConfigSource
+	GetImageOverrides() []corev1alpha1.PackageImageOverride
+	GetPriority() int32
+	GetRolloutSchedule() *corev1alpha1.RolloutSchedule
+	GetFreeze() *corev1alpha1.RolloutFreeze
+	GetUpdatePolicy() *corev1alpha1.PackageUpdatePolicy
+	GetStatusUpdate() *corev1alpha1.PackageUpdateStatus
+	SetStatusUpdate(update *corev1alpha1.PackageUpdateStatus)
+	GetStatusTeardown() *corev1alpha1.PackageTeardownStatus
+	SetStatusTeardown(teardown *corev1alpha1.PackageTeardownStatus)
+	GetDeletionProtection() bool
+	GetTTLSecondsAfterCreation() *int32
+	GetTTLSecondsAfterAvailable() *int32
+	GetFieldManager() string
+	GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy
+	GetStatusExpiryTime() *metav1.Time
+	SetStatusExpiryTime(expiry *metav1.Time)
+	SetStatusConfigSchema(schema *runtime.RawExtension)
+	GetStatusSync() *corev1alpha1.PackageSyncStatus
+	SetStatusSync(sync *corev1alpha1.PackageSyncStatus)
 	GetSpecHash(packageHashModifier *int32) string
 	GetUnpackedHash() string
 	SetUnpackedHash(hash string)
@@ -78,14 +101,120 @@ func (a *GenericPackage) GetConditions() *[]metav1.Condition {
 
 func (a *GenericPackage) UpdatePhase() {
 	updatePackagePhase(a)
+	packageKStatus(a, a.Status.Phase).Apply(&a.Status.Conditions, a.Generation)
 }
 
 func (a *GenericPackage) GetImage() string {
+	return packageImage(a.Spec, a.Status)
+}
+
+// Returns the image as given in the spec, ignoring images found by the update policy.
+func (a *GenericPackage) GetSpecImage() string {
 	return a.Spec.Image
 }
 
+// Packages may only reference Secrets in their own namespace.
+func (a *GenericPackage) GetImagePullSecrets() []client.ObjectKey {
+	keys := make([]client.ObjectKey, 0, len(a.Spec.ImagePullSecrets))
+	for _, secret := range a.Spec.ImagePullSecrets {
+		keys = append(keys, client.ObjectKey{Namespace: a.Namespace, Name: secret.Name})
+	}
+	return keys
+}
+
+// Packages may only reference ConfigMaps and Secrets in their own namespace.
+func (a *GenericPackage) GetConfigSources() []corev1alpha1.PackageConfigSource {
+	sources := make([]corev1alpha1.PackageConfigSource, 0, len(a.Spec.ConfigSources))
+	for _, source := range a.Spec.ConfigSources {
+		source := *source.DeepCopy()
+		for _, ref := range []*corev1alpha1.PackageConfigKeyReference{source.ConfigMap, source.Secret} {
+			if ref != nil {
+				ref.Namespace = a.Namespace
+			}
+		}
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+func (a *GenericPackage) GetImageOverrides() []corev1alpha1.PackageImageOverride {
+	return a.Spec.ImageOverrides
+}
+
+func (a *GenericPackage) GetPriority() int32 {
+	return a.Spec.Priority
+}
+
+func (a *GenericPackage) GetRolloutSchedule() *corev1alpha1.RolloutSchedule {
+	return a.Spec.RolloutSchedule
+}
+
+func (a *GenericPackage) GetFreeze() *corev1alpha1.RolloutFreeze {
+	return a.Spec.Freeze
+}
+
+func (a *GenericPackage) GetUpdatePolicy() *corev1alpha1.PackageUpdatePolicy {
+	return a.Spec.UpdatePolicy
+}
+
+func (a *GenericPackage) GetStatusUpdate() *corev1alpha1.PackageUpdateStatus {
+	return a.Status.Update
+}
+
+func (a *GenericPackage) SetStatusUpdate(update *corev1alpha1.PackageUpdateStatus) {
+	a.Status.Update = update
+}
+
+func (a *GenericPackage) GetStatusTeardown() *corev1alpha1.PackageTeardownStatus {
+	return a.Status.Teardown
+}
+
+func (a *GenericPackage) SetStatusTeardown(teardown *corev1alpha1.PackageTeardownStatus) {
+	a.Status.Teardown = teardown
+}
+
+func (a *GenericPackage) GetDeletionProtection() bool {
+	return a.Spec.DeletionProtection
+}
+
+func (a *GenericPackage) GetTTLSecondsAfterCreation() *int32 {
+	return a.Spec.TTLSecondsAfterCreation
+}
+
+func (a *GenericPackage) GetTTLSecondsAfterAvailable() *int32 {
+	return a.Spec.TTLSecondsAfterAvailable
+}
+
+func (a *GenericPackage) GetFieldManager() string {
+	return a.Spec.FieldManager
+}
+
+func (a *GenericPackage) GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy {
+	return a.Spec.FieldConflictPolicy
+}
+
+func (a *GenericPackage) GetStatusExpiryTime() *metav1.Time {
+	return a.Status.ExpiryTime
+}
+
+func (a *GenericPackage) SetStatusExpiryTime(expiry *metav1.Time) {
+	a.Status.ExpiryTime = expiry
+}
+
+func (a *GenericPackage) SetStatusConfigSchema(schema *runtime.RawExtension) {
+	a.Status.ConfigSchema = schema
+}
+
+func (a *GenericPackage) GetStatusSync() *corev1alpha1.PackageSyncStatus {
+	return a.Status.Sync
+}
+
+func (a *GenericPackage) SetStatusSync(sync *corev1alpha1.PackageSyncStatus) {
+	a.Status.Sync = sync
+}
+
 func (a *GenericPackage) GetSpecHash(packageHashModifier *int32) string {
-	return utils.ComputeSHA256Hash(a.Spec, packageHashModifier)
+	return packageSpecHash(a.Spec, a.Status, a.GetAnnotations(), packageHashModifier)
 }
 
 func (a *GenericPackage) SetUnpackedHash(hash string) {
@@ -136,14 +265,108 @@ func (a *GenericClusterPackage) GetConditions() *[]metav1.Condition {
 
 func (a *GenericClusterPackage) UpdatePhase() {
 	updatePackagePhase(a)
+	packageKStatus(a, a.Status.Phase).Apply(&a.Status.Conditions, a.Generation)
 }
 
 func (a *GenericClusterPackage) GetImage() string {
+	return packageImage(a.Spec, a.Status)
+}
+
+// Returns the image as given in the spec, ignoring images found by the update policy.
+func (a *GenericClusterPackage) GetSpecImage() string {
 	return a.Spec.Image
 }
 
+func (a *GenericClusterPackage) GetImagePullSecrets() []client.ObjectKey {
+	keys := make([]client.ObjectKey, 0, len(a.Spec.ImagePullSecrets))
+	for _, secret := range a.Spec.ImagePullSecrets {
+		keys = append(keys, client.ObjectKey{Namespace: secret.Namespace, Name: secret.Name})
+	}
+	return keys
+}
+
+func (a *GenericClusterPackage) GetConfigSources() []corev1alpha1.PackageConfigSource {
+	return a.Spec.ConfigSources
+}
+
+func (a *GenericClusterPackage) GetImageOverrides() []corev1alpha1.PackageImageOverride {
+	return a.Spec.ImageOverrides
+}
+
+func (a *GenericClusterPackage) GetPriority() int32 {
+	return a.Spec.Priority
+}
+
+func (a *GenericClusterPackage) GetRolloutSchedule() *corev1alpha1.RolloutSchedule {
+	return a.Spec.RolloutSchedule
+}
+
+func (a *GenericClusterPackage) GetFreeze() *corev1alpha1.RolloutFreeze {
+	return a.Spec.Freeze
+}
+
+func (a *GenericClusterPackage) GetUpdatePolicy() *corev1alpha1.PackageUpdatePolicy {
+	return a.Spec.UpdatePolicy
+}
+
+func (a *GenericClusterPackage) GetStatusUpdate() *corev1alpha1.PackageUpdateStatus {
+	return a.Status.Update
+}
+
+func (a *GenericClusterPackage) SetStatusUpdate(update *corev1alpha1.PackageUpdateStatus) {
+	a.Status.Update = update
+}
+
+func (a *GenericClusterPackage) GetStatusTeardown() *corev1alpha1.PackageTeardownStatus {
+	return a.Status.Teardown
+}
+
+func (a *GenericClusterPackage) SetStatusTeardown(teardown *corev1alpha1.PackageTeardownStatus) {
+	a.Status.Teardown = teardown
+}
+
+func (a *GenericClusterPackage) GetDeletionProtection() bool {
+	return a.Spec.DeletionProtection
+}
+
+func (a *GenericClusterPackage) GetTTLSecondsAfterCreation() *int32 {
+	return a.Spec.TTLSecondsAfterCreation
+}
+
+func (a *GenericClusterPackage) GetTTLSecondsAfterAvailable() *int32 {
+	return a.Spec.TTLSecondsAfterAvailable
+}
+
+func (a *GenericClusterPackage) GetFieldManager() string {
+	return a.Spec.FieldManager
+}
+
+func (a *GenericClusterPackage) GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy {
+	return a.Spec.FieldConflictPolicy
+}
+
+func (a *GenericClusterPackage) GetStatusExpiryTime() *metav1.Time {
+	return a.Status.ExpiryTime
+}
+
+func (a *GenericClusterPackage) SetStatusExpiryTime(expiry *metav1.Time) {
+	a.Status.ExpiryTime = expiry
+}
+
+func (a *GenericClusterPackage) SetStatusConfigSchema(schema *runtime.RawExtension) {
+	a.Status.ConfigSchema = schema
+}
+
+func (a *GenericClusterPackage) GetStatusSync() *corev1alpha1.PackageSyncStatus {
+	return a.Status.Sync
+}
+
+func (a *GenericClusterPackage) SetStatusSync(sync *corev1alpha1.PackageSyncStatus) {
+	a.Status.Sync = sync
+}
+
 func (a *GenericClusterPackage) GetSpecHash(packageHashModifier *int32) string {
-	return utils.ComputeSHA256Hash(a.Spec, packageHashModifier)
+	return packageSpecHash(a.Spec, a.Status, a.GetAnnotations(), packageHashModifier)
 }
 
 func (a *GenericClusterPackage) SetStatusRevision(rev int64) {
@@ -176,6 +399,38 @@ func (a *GenericClusterPackage) GetUnpackedHash() string {
 	return a.Status.UnpackedHash
 }
 
+// The image found by the update policy replaces the image of the spec.
+func packageImage(spec corev1alpha1.PackageSpec, status corev1alpha1.PackageStatus) string {
+	if spec.UpdatePolicy != nil && status.Update != nil && len(status.Update.Image) > 0 {
+		return status.Update.Image
+	}
+	return spec.Image
+}
+
+// Images found by the update policy are part of the hash, so they get unpacked.
+// Requested reconciles are part of the hash too, so they unpack the package again.
+func packageSpecHash(
+	spec corev1alpha1.PackageSpec, status corev1alpha1.PackageStatus,
+	annotations map[string]string, packageHashModifier *int32,
+) string {
+	image := packageImage(spec, status)
+	reconcileRequested := annotations[corev1alpha1.ReconcileRequestedAnnotation]
+	switch {
+	case image == spec.Image && len(reconcileRequested) == 0:
+		return utils.ComputeSHA256Hash(spec, packageHashModifier)
+	case len(reconcileRequested) == 0:
+		return utils.ComputeSHA256Hash(struct {
+			Spec  corev1alpha1.PackageSpec
+			Image string
+		}{Spec: spec, Image: image}, packageHashModifier)
+	}
+	return utils.ComputeSHA256Hash(struct {
+		Spec               corev1alpha1.PackageSpec
+		Image              string
+		ReconcileRequested string
+	}{Spec: spec, Image: image, ReconcileRequested: reconcileRequested}, packageHashModifier)
+}
+
 func updatePackagePhase(pkg GenericPackageAccessor) {
 	if meta.IsStatusConditionTrue(*pkg.GetConditions(), corev1alpha1.PackageInvalid) {
 		pkg.setStatusPhase(corev1alpha1.PackagePhaseInvalid)
@@ -207,6 +462,35 @@ func updatePackagePhase(pkg GenericPackageAccessor) {
 	pkg.setStatusPhase(corev1alpha1.PackagePhaseNotReady)
 }
 
+// Invalid packages and repeatedly failing reconciles stall,
+// everything short of available packages is still reconciling.
+func packageKStatus(pkg GenericPackageAccessor, phase corev1alpha1.PackageStatusPhase) kstatus.Status {
+	conds := *pkg.GetConditions()
+	switch {
+	case meta.IsStatusConditionTrue(conds, corev1alpha1.PackageInvalid):
+		return kstatus.Status{Stalled: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conds, corev1alpha1.PackageInvalid), "Invalid")}
+	case meta.IsStatusConditionTrue(conds, corev1alpha1.PackageRetryBackoff):
+		return kstatus.Status{Stalled: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conds, corev1alpha1.PackageRetryBackoff), "RetryBackoff")}
+	case !pkg.ClientObject().GetDeletionTimestamp().IsZero():
+		return kstatus.Status{Reconciling: &kstatus.Reason{
+			Reason: "Terminating", Message: "Tearing down package objects."}}
+	}
+
+	switch phase {
+	case corev1alpha1.PackagePhaseUnpacking:
+		return kstatus.Status{Reconciling: &kstatus.Reason{Reason: "Unpacking"}}
+	case corev1alpha1.PackagePhaseProgressing:
+		return kstatus.Status{Reconciling: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conds, corev1alpha1.PackageProgressing), "Progressing")}
+	case corev1alpha1.PackagePhaseNotReady:
+		return kstatus.Status{Reconciling: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conds, corev1alpha1.PackageAvailable), "NotReady")}
+	}
+	return kstatus.Status{}
+}
+
 func templateContextObjectMetaFromObjectMeta(om metav1.ObjectMeta) manifests.TemplateContextObjectMeta {
 	return manifests.TemplateContextObjectMeta{
 		Name:        om.Name,
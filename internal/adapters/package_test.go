@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 )
@@ -19,10 +21,60 @@ func TestGenericPackage(t *testing.T) {
 	p := pkg.ClientObject().(*corev1alpha1.Package)
 	assert.Equal(
 		t, corev1alpha1.PackagePhaseUnpacking, p.Status.Phase)
+	reconciling := meta.FindStatusCondition(p.Status.Conditions, corev1alpha1.ConditionReconciling)
+	if assert.NotNil(t, reconciling) {
+		assert.Equal(t, "Unpacking", reconciling.Reason)
+	}
 
 	p.Spec.Image = "test"
 	assert.Equal(t, p.Spec.Image, pkg.GetImage())
 
+	// Images found by the update policy replace the image of the spec.
+	p.Spec.UpdatePolicy = &corev1alpha1.PackageUpdatePolicy{Strategy: corev1alpha1.PackageUpdateStrategyDigest}
+	specHash := pkg.GetSpecHash(nil)
+	pkg.SetStatusUpdate(&corev1alpha1.PackageUpdateStatus{Image: "test@sha256:1234"})
+	assert.Equal(t, "test@sha256:1234", pkg.GetImage())
+	assert.Equal(t, "test", pkg.GetSpecImage())
+	assert.NotEqual(t, specHash, pkg.GetSpecHash(nil))
+	p.Spec.UpdatePolicy = nil
+	assert.Equal(t, "test", pkg.GetImage())
+	pkg.SetStatusUpdate(nil)
+
+	// Requested reconciles change the hash, so the package gets unpacked again.
+	specHash = pkg.GetSpecHash(nil)
+	p.SetAnnotations(map[string]string{corev1alpha1.ReconcileRequestedAnnotation: "2024-01-01T00:00:00Z"})
+	assert.NotEqual(t, specHash, pkg.GetSpecHash(nil))
+	p.SetAnnotations(nil)
+	assert.Equal(t, specHash, pkg.GetSpecHash(nil))
+
+	teardown := &corev1alpha1.PackageTeardownStatus{ObjectCount: 1}
+	pkg.SetStatusTeardown(teardown)
+	assert.Same(t, teardown, p.Status.Teardown)
+	assert.Same(t, teardown, pkg.GetStatusTeardown())
+
+	ttl := int32(60)
+	p.Spec.DeletionProtection = true
+	p.Spec.TTLSecondsAfterCreation = &ttl
+	p.Spec.TTLSecondsAfterAvailable = &ttl
+	assert.True(t, pkg.GetDeletionProtection())
+	assert.Same(t, &ttl, pkg.GetTTLSecondsAfterCreation())
+	assert.Same(t, &ttl, pkg.GetTTLSecondsAfterAvailable())
+	p.Spec.FieldManager = "platform-team"
+	assert.Equal(t, "platform-team", pkg.GetFieldManager())
+	p.Spec.FieldConflictPolicy = corev1alpha1.FieldConflictPolicyReport
+	assert.Equal(t, corev1alpha1.FieldConflictPolicyReport, pkg.GetFieldConflictPolicy())
+	expiry := &metav1.Time{}
+	pkg.SetStatusExpiryTime(expiry)
+	assert.Same(t, expiry, p.Status.ExpiryTime)
+	assert.Same(t, expiry, pkg.GetStatusExpiryTime())
+	schema := &runtime.RawExtension{}
+	pkg.SetStatusConfigSchema(schema)
+	assert.Same(t, schema, p.Status.ConfigSchema)
+	sync := &corev1alpha1.PackageSyncStatus{}
+	pkg.SetStatusSync(sync)
+	assert.Same(t, sync, p.Status.Sync)
+	assert.Same(t, sync, pkg.GetStatusSync())
+
 	pkg.SetUnpackedHash("123")
 	assert.Equal(t, "123", p.Status.UnpackedHash)
 	assert.Equal(t, "123", pkg.GetUnpackedHash())
@@ -35,7 +87,20 @@ func TestGenericPackage(t *testing.T) {
 	p.Spec.Component = "test_component"
 	assert.Equal(t, p.Spec.Component, pkg.GetComponent())
 
-	assert.Empty(t, pkg.GetConditions())
+	p.Namespace = "test-ns"
+	p.Spec.ImagePullSecrets = []corev1alpha1.PackageImagePullSecret{{Name: "pull", Namespace: "other"}}
+	assert.Equal(t, []client.ObjectKey{{Namespace: "test-ns", Name: "pull"}}, pkg.GetImagePullSecrets())
+
+	p.Spec.ConfigSources = []corev1alpha1.PackageConfigSource{
+		{ConfigMap: &corev1alpha1.PackageConfigKeyReference{Name: "cfg", Namespace: "other", Key: "config.yaml"}},
+		{ClusterPackage: &corev1alpha1.PackageConfigClusterPackageReference{Name: "defaults"}},
+	}
+	sources := pkg.GetConfigSources()
+	assert.Equal(t, "test-ns", sources[0].ConfigMap.Namespace)
+	assert.Equal(t, "other", p.Spec.ConfigSources[0].ConfigMap.Namespace)
+	assert.Equal(t, p.Spec.ConfigSources[1], sources[1])
+
+	assert.Same(t, &p.Status.Conditions, pkg.GetConditions())
 	p.Status.Conditions = []metav1.Condition{
 		{
 			ObservedGeneration: 1,
@@ -67,6 +132,34 @@ func TestGenericClusterPackage(t *testing.T) {
 	p.Spec.Image = "test"
 	assert.Equal(t, p.Spec.Image, pkg.GetImage())
 
+	teardown := &corev1alpha1.PackageTeardownStatus{ObjectCount: 1}
+	pkg.SetStatusTeardown(teardown)
+	assert.Same(t, teardown, p.Status.Teardown)
+	assert.Same(t, teardown, pkg.GetStatusTeardown())
+
+	ttl := int32(60)
+	p.Spec.DeletionProtection = true
+	p.Spec.TTLSecondsAfterCreation = &ttl
+	p.Spec.TTLSecondsAfterAvailable = &ttl
+	assert.True(t, pkg.GetDeletionProtection())
+	assert.Same(t, &ttl, pkg.GetTTLSecondsAfterCreation())
+	assert.Same(t, &ttl, pkg.GetTTLSecondsAfterAvailable())
+	p.Spec.FieldManager = "platform-team"
+	assert.Equal(t, "platform-team", pkg.GetFieldManager())
+	p.Spec.FieldConflictPolicy = corev1alpha1.FieldConflictPolicyReport
+	assert.Equal(t, corev1alpha1.FieldConflictPolicyReport, pkg.GetFieldConflictPolicy())
+	expiry := &metav1.Time{}
+	pkg.SetStatusExpiryTime(expiry)
+	assert.Same(t, expiry, p.Status.ExpiryTime)
+	assert.Same(t, expiry, pkg.GetStatusExpiryTime())
+	schema := &runtime.RawExtension{}
+	pkg.SetStatusConfigSchema(schema)
+	assert.Same(t, schema, p.Status.ConfigSchema)
+	sync := &corev1alpha1.PackageSyncStatus{}
+	pkg.SetStatusSync(sync)
+	assert.Same(t, sync, p.Status.Sync)
+	assert.Same(t, sync, pkg.GetStatusSync())
+
 	pkg.SetUnpackedHash("123")
 	assert.Equal(t, "123", p.Status.UnpackedHash)
 	assert.Equal(t, "123", pkg.GetUnpackedHash())
@@ -79,7 +172,10 @@ func TestGenericClusterPackage(t *testing.T) {
 	p.Spec.Component = "test_component"
 	assert.Equal(t, p.Spec.Component, pkg.GetComponent())
 
-	assert.Empty(t, pkg.GetConditions())
+	p.Spec.ImagePullSecrets = []corev1alpha1.PackageImagePullSecret{{Name: "pull", Namespace: "other"}}
+	assert.Equal(t, []client.ObjectKey{{Namespace: "other", Name: "pull"}}, pkg.GetImagePullSecrets())
+
+	assert.Same(t, &p.Status.Conditions, pkg.GetConditions())
 	p.Status.Conditions = []metav1.Condition{
 		{
 			ObservedGeneration: 1,
@@ -0,0 +1,278 @@
+// Package apidocs extracts documentation from the Go source of API types,
+// so it can be presented to users without maintaining a second copy.
+package apidocs
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Package contains the documented types and constants of a Go package.
+type Package struct {
+	Types map[string]*Type
+	// Constants that are not declared with one of the package types,
+	// e.g. annotation and label keys.
+	Constants []Value
+}
+
+// Type is a documented type declaration.
+type Type struct {
+	Name string
+	Doc  Doc
+	// Fields of struct types, in declaration order.
+	Fields []Field
+	// Constants declared with this type, e.g. the values of an enum.
+	Values []Value
+}
+
+// Field is a documented struct field.
+type Field struct {
+	// Name of the field in its JSON representation.
+	Name string
+	Type TypeRef
+	Doc  Doc
+	// Inline fields are embedded into their parent object.
+	Inline   bool
+	Optional bool
+}
+
+// Value is a documented constant.
+type Value struct {
+	Name  string
+	Value string
+	Doc   Doc
+}
+
+// Doc is a doc comment with its kubebuilder markers removed.
+type Doc struct {
+	Text     string
+	Examples []string
+	Optional bool
+}
+
+// TypeRef references the type of a field.
+type TypeRef struct {
+	// Import name of the package declaring the type,
+	// empty for builtin types and types of the same package.
+	Package string
+	Name    string
+	Slice   bool
+	Map     bool
+}
+
+func (r TypeRef) String() string {
+	var b strings.Builder
+
+	switch {
+	case r.Slice:
+		b.WriteString("[]")
+	case r.Map:
+		b.WriteString("map[string]")
+	}
+
+	if r.Package != "" {
+		b.WriteString(r.Package + ".")
+	}
+	b.WriteString(r.Name)
+
+	return b.String()
+}
+
+// Load parses all non-test Go files in the root of fsys.
+func Load(fsys fs.FS) (*Package, error) {
+	paths, err := fs.Glob(fsys, "*.go")
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &Package{Types: map[string]*Type{}}
+	typedValues := map[string][]Value{}
+	fset := token.NewFileSet()
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		src, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+
+		file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			switch gen.Tok {
+			case token.TYPE:
+				for _, spec := range gen.Specs {
+					t := loadType(gen, spec.(*ast.TypeSpec))
+					pkg.Types[t.Name] = t
+				}
+			case token.CONST:
+				for _, spec := range gen.Specs {
+					vspec := spec.(*ast.ValueSpec)
+					for _, v := range loadValues(gen, vspec) {
+						if ident, ok := vspec.Type.(*ast.Ident); ok {
+							typedValues[ident.Name] = append(typedValues[ident.Name], v)
+							continue
+						}
+						pkg.Constants = append(pkg.Constants, v)
+					}
+				}
+			}
+		}
+	}
+
+	for typeName, values := range typedValues {
+		if t, ok := pkg.Types[typeName]; ok {
+			t.Values = values
+		}
+	}
+
+	return pkg, nil
+}
+
+func loadType(gen *ast.GenDecl, spec *ast.TypeSpec) *Type {
+	t := &Type{
+		Name: spec.Name.Name,
+		Doc:  parseDoc(specDoc(gen, spec.Doc)),
+	}
+
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return t
+	}
+
+	for _, f := range st.Fields.List {
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			tag = reflect.StructTag(strings.Trim(f.Tag.Value, "`"))
+		}
+
+		jsonName, jsonOpts, _ := strings.Cut(tag.Get("json"), ",")
+		if jsonName == "-" {
+			continue
+		}
+
+		field := Field{
+			Name:     jsonName,
+			Type:     typeRef(f.Type),
+			Doc:      parseDoc(f.Doc),
+			Optional: strings.Contains(jsonOpts, "omitempty"),
+		}
+		field.Optional = field.Optional || field.Doc.Optional
+
+		switch {
+		case len(f.Names) == 0 && jsonName == "":
+			field.Inline = true
+		case jsonName == "":
+			field.Name = f.Names[0].Name
+		}
+
+		t.Fields = append(t.Fields, field)
+	}
+
+	return t
+}
+
+func loadValues(gen *ast.GenDecl, spec *ast.ValueSpec) []Value {
+	doc := parseDoc(specDoc(gen, spec.Doc))
+
+	values := make([]Value, 0, len(spec.Names))
+	for i, name := range spec.Names {
+		if i >= len(spec.Values) {
+			break
+		}
+
+		lit, ok := spec.Values[i].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			continue
+		}
+
+		values = append(values, Value{Name: name.Name, Value: value, Doc: doc})
+	}
+
+	return values
+}
+
+// Lone declarations carry their comment on the declaration instead of the spec.
+func specDoc(gen *ast.GenDecl, doc *ast.CommentGroup) *ast.CommentGroup {
+	if doc == nil && !gen.Lparen.IsValid() {
+		return gen.Doc
+	}
+
+	return doc
+}
+
+func typeRef(expr ast.Expr) TypeRef {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return TypeRef{Name: e.Name}
+	case *ast.StarExpr:
+		return typeRef(e.X)
+	case *ast.SelectorExpr:
+		ref := typeRef(e.Sel)
+		if pkg, ok := e.X.(*ast.Ident); ok {
+			ref.Package = pkg.Name
+		}
+
+		return ref
+	case *ast.ArrayType:
+		ref := typeRef(e.Elt)
+		ref.Slice = true
+
+		return ref
+	case *ast.MapType:
+		ref := typeRef(e.Value)
+		ref.Map = true
+
+		return ref
+	default:
+		return TypeRef{Name: "object"}
+	}
+}
+
+func parseDoc(group *ast.CommentGroup) Doc {
+	var doc Doc
+	if group == nil {
+		return doc
+	}
+
+	var lines []string
+	for _, line := range strings.Split(group.Text(), "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(line, "+example="):
+			doc.Examples = append(doc.Examples, strings.TrimPrefix(line, "+example="))
+		case line == "+optional":
+			doc.Optional = true
+		case strings.HasPrefix(line, "+"), strings.HasPrefix(line, "nolint:"):
+			// kubebuilder markers and linter directives are not documentation.
+		case line != "":
+			lines = append(lines, line)
+		}
+	}
+	doc.Text = strings.Join(lines, "\n")
+
+	return doc
+}
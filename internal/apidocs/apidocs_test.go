@@ -0,0 +1,87 @@
+package apidocs
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSource = `package test
+
+// TestAnnotation is an annotation.
+const TestAnnotation = "test.io/annotation"
+
+// Color of a thing.
+type Color string
+
+const (
+	// Red color.
+	Red Color = "Red"
+	// Blue color.
+	Blue Color = "Blue"
+)
+
+// Thing is a test type.
+// +kubebuilder:object:root=true
+type Thing struct {
+	metav1.TypeMeta ` + "`json:\",inline\"`" + `
+	// Name of the thing.
+	// +example=banana
+	Name string ` + "`json:\"name\"`" + `
+	// Colors of the thing.
+	// +optional
+	Colors []Color ` + "`json:\"colors\"`" + `
+	Labels map[string]string ` + "`json:\"labels,omitempty\"`" + `
+	Ref *other.Ref ` + "`json:\"ref\"`" + `
+	Ignored string ` + "`json:\"-\"`" + `
+}
+`
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := Load(fstest.MapFS{
+		"test_types.go": {Data: []byte(testSource)},
+		"test_test.go":  {Data: []byte("not go")},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Value{{
+		Name: "TestAnnotation", Value: "test.io/annotation", Doc: Doc{Text: "TestAnnotation is an annotation."},
+	}}, pkg.Constants)
+
+	color := pkg.Types["Color"]
+	require.NotNil(t, color)
+	assert.Equal(t, "Color of a thing.", color.Doc.Text)
+	assert.Equal(t, []Value{
+		{Name: "Red", Value: "Red", Doc: Doc{Text: "Red color."}},
+		{Name: "Blue", Value: "Blue", Doc: Doc{Text: "Blue color."}},
+	}, color.Values)
+
+	thing := pkg.Types["Thing"]
+	require.NotNil(t, thing)
+	assert.Equal(t, "Thing is a test type.", thing.Doc.Text)
+	assert.Equal(t, []Field{
+		{Type: TypeRef{Package: "metav1", Name: "TypeMeta"}, Inline: true},
+		{
+			Name: "name", Type: TypeRef{Name: "string"},
+			Doc: Doc{Text: "Name of the thing.", Examples: []string{"banana"}},
+		},
+		{
+			Name: "colors", Type: TypeRef{Name: "Color", Slice: true},
+			Doc: Doc{Text: "Colors of the thing.", Optional: true}, Optional: true,
+		},
+		{Name: "labels", Type: TypeRef{Name: "string", Map: true}, Optional: true},
+		{Name: "ref", Type: TypeRef{Package: "other", Name: "Ref"}},
+	}, thing.Fields)
+}
+
+func TestTypeRef_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "[]corev1alpha1.ObjectSetProbe",
+		TypeRef{Package: "corev1alpha1", Name: "ObjectSetProbe", Slice: true}.String())
+	assert.Equal(t, "map[string]string", TypeRef{Name: "string", Map: true}.String())
+}
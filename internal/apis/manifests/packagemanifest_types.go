@@ -13,6 +13,7 @@ const (
 	PackagePhaseAnnotation        = manifestsv1alpha1.PackagePhaseAnnotation
 	PackageConditionMapAnnotation = manifestsv1alpha1.PackageConditionMapAnnotation
 	PackageCELConditionAnnotation = manifestsv1alpha1.PackageCELConditionAnnotation
+	PackageNamespacesPhase        = manifestsv1alpha1.PackageNamespacesPhase
 )
 
 const (
@@ -48,14 +49,33 @@ type PackageManifestSpec struct {
 	// Scopes declare the available installation scopes for the package.
 	// Either Cluster, Namespaced, or both.
 	Scopes []PackageManifestScope
+	// Cluster-scoped kinds the package may contain when installed into the Namespaced scope.
+	// Each kind also has to be allowed by the cluster admin in the Package Operator manager and webhook configuration.
+	// +optional
+	ClusterScopedKinds []metav1.GroupKind
 	// Phases correspond to the references to the phases which are going to
 	// be the part of the ObjectDeployment/ClusterObjectDeployment.
 	Phases []PackageManifestPhase
+	// Phase of objects without a phase annotation,
+	// when no entry of DirectoryPhases matches the directory of their file.
+	// +optional
+	DefaultPhase string
+	// Phases of objects without a phase annotation, by the directory of their file.
+	// Entries also apply to subdirectories, the entry with the longest matching directory wins.
+	// +optional
+	DirectoryPhases []PackageManifestDirectoryPhase
+	// Namespaces created by the package when installed into the Cluster scope.
+	// Namespaces are reconciled in the reserved "namespaces" phase before all other phases.
+	Namespaces []PackageManifestNamespace
 	// Availability Probes check objects that are part of the package.
 	// All probes need to succeed for a package to be considered Available.
 	// Failing probes will prevent the reconciliation of objects in later phases.
 	// +optional
 	AvailabilityProbes []corev1alpha1.ObjectSetProbe
+	// Condition Mappings report conditions of objects that are part of the package
+	// as conditions of the Package. Replaces the condition-map annotation.
+	// +optional
+	ConditionMappings []PackageManifestConditionMapping
 	// Configuration specification.
 	Config PackageManifestSpecConfig
 	// List of images to be resolved
@@ -75,6 +95,72 @@ type PackageManifestSpec struct {
 	Repositories []PackageManifestRepository
 	// Dependency references to resolve and use within this package.
 	Dependencies []PackageManifestDependency
+	// Rendering configures how the package templates are rendered.
+	// +optional
+	Rendering PackageManifestRendering
+	// Values read from objects of the currently deployed revision before rendering the next one,
+	// e.g. to keep generated passwords. Available in templates under .previous by their name.
+	// +optional
+	CarryOver []PackageManifestCarryOver
+	// Monitoring standardizes Prometheus scraping of the package workloads.
+	Monitoring *PackageManifestMonitoring
+}
+
+// PackageManifestMonitoring standardizes Prometheus scraping of the Deployments and Services of the package.
+type PackageManifestMonitoring struct {
+	// Name of the container and Service ports serving metrics.
+	Port string
+	// HTTP path metrics are served at.
+	// Defaults to /metrics.
+	Path string
+	// Generates a ServiceMonitor for every Service exposing the metrics port,
+	// when the Prometheus Operator API is present in the cluster.
+	ServiceMonitors bool
+}
+
+// PackageManifestConditionMapping maps conditions of objects within the package into Package Operator APIs.
+type PackageManifestConditionMapping struct {
+	// Selects the objects to map conditions from.
+	// Conditions of all selected objects within a phase are aggregated into one destination condition,
+	// reporting the worst status: False before Unknown before True.
+	Selector corev1alpha1.ProbeSelector
+	// Source condition type.
+	SourceType string
+	// Destination condition type to report into Package Operator APIs.
+	DestinationType string
+	// Go template rendering the message of the destination condition.
+	// Defaults to the message of the source condition.
+	// +optional
+	Message string
+}
+
+// PackageManifestCarryOver reads a value from an object deployed by the current revision of the package.
+// The value is missing from the template context when the object does not exist
+// or is not part of the package.
+type PackageManifestCarryOver struct {
+	// Name of the value in the template context.
+	Name string
+	// APIVersion of the object.
+	APIVersion string
+	// Kind of the object.
+	Kind string
+	// Name of the object.
+	ObjectName string
+	// Namespace of the object, defaults to the namespace of the package.
+	// +optional
+	Namespace string
+	// JSONPath of the value within the object.
+	Path string
+}
+
+// PackageManifestRendering configures how the package templates are rendered.
+type PackageManifestRendering struct {
+	// Strict rendering fails on template values that resolve to nothing,
+	// e.g. map entries accessed via index or null values, and on configuration
+	// fields unknown to the config schema, instead of rendering "<no value>"
+	// or silently pruning the fields.
+	// +optional
+	Strict bool
 }
 
 // PackageManifestFilter is used to conditionally render objects based on CEL expressions.
@@ -185,6 +271,28 @@ type PackageManifestPhase struct {
 	Class string
 }
 
+// PackageManifestDirectoryPhase assigns objects of files within a directory to a phase.
+type PackageManifestDirectoryPhase struct {
+	// Directory relative to the package root.
+	Directory string
+	// Name of the phase.
+	Phase string
+}
+
+// PackageManifestNamespace declares a namespace created by the package.
+// Name, label and annotation values are templated with the package template context.
+type PackageManifestNamespace struct {
+	// Name of the namespace.
+	Name string
+	// Labels to set on the namespace.
+	Labels map[string]string
+	// Annotations to set on the namespace.
+	Annotations map[string]string
+	// CleanupPolicy decides what happens to the namespace when the package is uninstalled.
+	// "Delete" removes the namespace and everything in it, "Orphan" leaves it in the cluster.
+	CleanupPolicy corev1alpha1.ObjectDeletionPolicy
+}
+
 // PackageManifestImage specifies an image tag to be resolved.
 type PackageManifestImage struct {
 	// Image name to be use to reference it in the templates
@@ -241,11 +349,25 @@ type PackageEnvironment struct {
 	// HyperShift specific information. Only available when installed alongside HyperShift.
 	// https://github.com/openshift/hypershift
 	HyperShift *PackageEnvironmentHyperShift `json:"hyperShift,omitempty"`
+	// Metadata identifying the cluster within a fleet of clusters.
+	// Only set when ClusterClaims or Node labels shared by all Nodes are found.
+	Cluster *PackageEnvironmentCluster `json:"cluster,omitempty"`
+	// Prometheus Operator information.
+	// Only set when the monitoring.coreos.com/v1 ServiceMonitor API is present.
+	PrometheusOperator *PackageEnvironmentPrometheusOperator `json:"prometheusOperator,omitempty"`
 }
 
 type PackageEnvironmentKubernetes struct {
 	// Kubernetes server version.
 	Version string `json:"version"`
+	// DNS domain of the cluster, used in Service and Pod DNS names.
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+	// Cloud provider hosting the cluster nodes, taken from the scheme of their provider IDs.
+	// Empty when nodes report no provider ID, e.g. on bare metal.
+	CloudProvider string `json:"cloudProvider,omitempty"`
+	// Topology zones the cluster nodes are spread across,
+	// taken from their topology.kubernetes.io/zone label.
+	Zones []string `json:"zones,omitempty"`
 }
 
 type PackageEnvironmentOpenShift struct {
@@ -292,11 +414,30 @@ type PackageEnvironmentHyperShiftHostedCluster struct {
 	HostedClusterNamespace    string `json:"hostedClusterNamespace"`
 }
 
+// PackageEnvironmentCluster contains metadata identifying the cluster within a fleet of clusters.
+type PackageEnvironmentCluster struct {
+	// Claims about the cluster, taken from Open Cluster Management ClusterClaims.
+	Claims map[string]string `json:"claims,omitempty"`
+	// Labels set to the same value on all Nodes of the cluster.
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+}
+
+// PackageEnvironmentPrometheusOperator contains Prometheus Operator specific information.
+// Only available when the Prometheus Operator API is present in the cluster.
+type PackageEnvironmentPrometheusOperator struct{}
+
 // TemplateContextPackage represents the (Cluster)Package object requesting this package content.
 type TemplateContextPackage struct {
 	TemplateContextObjectMeta `json:"metadata"`
 	// Image as presented via the (Cluster)Package API after admission.
 	Image string `json:"image"`
+	// Short hash of the configuration after admission, which only changes with the configuration.
+	// Combine it with the package name via truncateName to derive collision-free object names.
+	ConfigHash string `json:"configHash,omitempty"`
+	// SHA256 checksums of the top-level configuration keys after admission.
+	// Embed them as annotations into pod templates, to roll pods when the configuration they consume changes,
+	// e.g. checksum/database: {{ index .package.configChecksums "database" }}.
+	ConfigChecksums map[string]string `json:"configChecksums,omitempty"`
 }
 
 // TemplateContextObjectMeta represents a simplified version of metav1.ObjectMeta for use in templates.
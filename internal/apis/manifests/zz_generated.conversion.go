@@ -10,6 +10,7 @@ import (
 
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
@@ -33,6 +34,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageEnvironmentCluster)(nil), (*v1alpha1.PackageEnvironmentCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageEnvironmentCluster_To_v1alpha1_PackageEnvironmentCluster(a.(*PackageEnvironmentCluster), b.(*v1alpha1.PackageEnvironmentCluster), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageEnvironmentCluster)(nil), (*PackageEnvironmentCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageEnvironmentCluster_To_manifests_PackageEnvironmentCluster(a.(*v1alpha1.PackageEnvironmentCluster), b.(*PackageEnvironmentCluster), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageEnvironmentHyperShift)(nil), (*v1alpha1.PackageEnvironmentHyperShift)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageEnvironmentHyperShift_To_v1alpha1_PackageEnvironmentHyperShift(a.(*PackageEnvironmentHyperShift), b.(*v1alpha1.PackageEnvironmentHyperShift), scope)
 	}); err != nil {
@@ -83,6 +94,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageEnvironmentPrometheusOperator)(nil), (*v1alpha1.PackageEnvironmentPrometheusOperator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageEnvironmentPrometheusOperator_To_v1alpha1_PackageEnvironmentPrometheusOperator(a.(*PackageEnvironmentPrometheusOperator), b.(*v1alpha1.PackageEnvironmentPrometheusOperator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageEnvironmentPrometheusOperator)(nil), (*PackageEnvironmentPrometheusOperator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageEnvironmentPrometheusOperator_To_manifests_PackageEnvironmentPrometheusOperator(a.(*v1alpha1.PackageEnvironmentPrometheusOperator), b.(*PackageEnvironmentPrometheusOperator), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageEnvironmentProxy)(nil), (*v1alpha1.PackageEnvironmentProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageEnvironmentProxy_To_v1alpha1_PackageEnvironmentProxy(a.(*PackageEnvironmentProxy), b.(*v1alpha1.PackageEnvironmentProxy), scope)
 	}); err != nil {
@@ -103,6 +124,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestCarryOver)(nil), (*v1alpha1.PackageManifestCarryOver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestCarryOver_To_v1alpha1_PackageManifestCarryOver(a.(*PackageManifestCarryOver), b.(*v1alpha1.PackageManifestCarryOver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestCarryOver)(nil), (*PackageManifestCarryOver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestCarryOver_To_manifests_PackageManifestCarryOver(a.(*v1alpha1.PackageManifestCarryOver), b.(*PackageManifestCarryOver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageManifestComponentsConfig)(nil), (*v1alpha1.PackageManifestComponentsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageManifestComponentsConfig_To_v1alpha1_PackageManifestComponentsConfig(a.(*PackageManifestComponentsConfig), b.(*v1alpha1.PackageManifestComponentsConfig), scope)
 	}); err != nil {
@@ -113,6 +144,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestConditionMapping)(nil), (*v1alpha1.PackageManifestConditionMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestConditionMapping_To_v1alpha1_PackageManifestConditionMapping(a.(*PackageManifestConditionMapping), b.(*v1alpha1.PackageManifestConditionMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestConditionMapping)(nil), (*PackageManifestConditionMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestConditionMapping_To_manifests_PackageManifestConditionMapping(a.(*v1alpha1.PackageManifestConditionMapping), b.(*PackageManifestConditionMapping), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageManifestConstraint)(nil), (*v1alpha1.PackageManifestConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageManifestConstraint_To_v1alpha1_PackageManifestConstraint(a.(*PackageManifestConstraint), b.(*v1alpha1.PackageManifestConstraint), scope)
 	}); err != nil {
@@ -143,6 +184,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestDirectoryPhase)(nil), (*v1alpha1.PackageManifestDirectoryPhase)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestDirectoryPhase_To_v1alpha1_PackageManifestDirectoryPhase(a.(*PackageManifestDirectoryPhase), b.(*v1alpha1.PackageManifestDirectoryPhase), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestDirectoryPhase)(nil), (*PackageManifestDirectoryPhase)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestDirectoryPhase_To_manifests_PackageManifestDirectoryPhase(a.(*v1alpha1.PackageManifestDirectoryPhase), b.(*PackageManifestDirectoryPhase), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageManifestFilter)(nil), (*v1alpha1.PackageManifestFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageManifestFilter_To_v1alpha1_PackageManifestFilter(a.(*PackageManifestFilter), b.(*v1alpha1.PackageManifestFilter), scope)
 	}); err != nil {
@@ -213,6 +264,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestMonitoring)(nil), (*v1alpha1.PackageManifestMonitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestMonitoring_To_v1alpha1_PackageManifestMonitoring(a.(*PackageManifestMonitoring), b.(*v1alpha1.PackageManifestMonitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestMonitoring)(nil), (*PackageManifestMonitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestMonitoring_To_manifests_PackageManifestMonitoring(a.(*v1alpha1.PackageManifestMonitoring), b.(*PackageManifestMonitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestNamespace)(nil), (*v1alpha1.PackageManifestNamespace)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestNamespace_To_v1alpha1_PackageManifestNamespace(a.(*PackageManifestNamespace), b.(*v1alpha1.PackageManifestNamespace), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestNamespace)(nil), (*PackageManifestNamespace)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestNamespace_To_manifests_PackageManifestNamespace(a.(*v1alpha1.PackageManifestNamespace), b.(*PackageManifestNamespace), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageManifestPath)(nil), (*v1alpha1.PackageManifestPath)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageManifestPath_To_v1alpha1_PackageManifestPath(a.(*PackageManifestPath), b.(*v1alpha1.PackageManifestPath), scope)
 	}); err != nil {
@@ -243,6 +314,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PackageManifestRendering)(nil), (*v1alpha1.PackageManifestRendering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifests_PackageManifestRendering_To_v1alpha1_PackageManifestRendering(a.(*PackageManifestRendering), b.(*v1alpha1.PackageManifestRendering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PackageManifestRendering)(nil), (*PackageManifestRendering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PackageManifestRendering_To_manifests_PackageManifestRendering(a.(*v1alpha1.PackageManifestRendering), b.(*PackageManifestRendering), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PackageManifestRepository)(nil), (*v1alpha1.PackageManifestRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifests_PackageManifestRepository_To_v1alpha1_PackageManifestRepository(a.(*PackageManifestRepository), b.(*v1alpha1.PackageManifestRepository), scope)
 	}); err != nil {
@@ -388,6 +469,8 @@ func autoConvert_manifests_PackageEnvironment_To_v1alpha1_PackageEnvironment(in
 	out.OpenShift = (*v1alpha1.PackageEnvironmentOpenShift)(unsafe.Pointer(in.OpenShift))
 	out.Proxy = (*v1alpha1.PackageEnvironmentProxy)(unsafe.Pointer(in.Proxy))
 	out.HyperShift = (*v1alpha1.PackageEnvironmentHyperShift)(unsafe.Pointer(in.HyperShift))
+	out.Cluster = (*v1alpha1.PackageEnvironmentCluster)(unsafe.Pointer(in.Cluster))
+	out.PrometheusOperator = (*v1alpha1.PackageEnvironmentPrometheusOperator)(unsafe.Pointer(in.PrometheusOperator))
 	return nil
 }
 
@@ -403,6 +486,8 @@ func autoConvert_v1alpha1_PackageEnvironment_To_manifests_PackageEnvironment(in
 	out.OpenShift = (*PackageEnvironmentOpenShift)(unsafe.Pointer(in.OpenShift))
 	out.Proxy = (*PackageEnvironmentProxy)(unsafe.Pointer(in.Proxy))
 	out.HyperShift = (*PackageEnvironmentHyperShift)(unsafe.Pointer(in.HyperShift))
+	out.Cluster = (*PackageEnvironmentCluster)(unsafe.Pointer(in.Cluster))
+	out.PrometheusOperator = (*PackageEnvironmentPrometheusOperator)(unsafe.Pointer(in.PrometheusOperator))
 	return nil
 }
 
@@ -411,6 +496,28 @@ func Convert_v1alpha1_PackageEnvironment_To_manifests_PackageEnvironment(in *v1a
 	return autoConvert_v1alpha1_PackageEnvironment_To_manifests_PackageEnvironment(in, out, s)
 }
 
+func autoConvert_manifests_PackageEnvironmentCluster_To_v1alpha1_PackageEnvironmentCluster(in *PackageEnvironmentCluster, out *v1alpha1.PackageEnvironmentCluster, s conversion.Scope) error {
+	out.Claims = *(*map[string]string)(unsafe.Pointer(&in.Claims))
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	return nil
+}
+
+// Convert_manifests_PackageEnvironmentCluster_To_v1alpha1_PackageEnvironmentCluster is an autogenerated conversion function.
+func Convert_manifests_PackageEnvironmentCluster_To_v1alpha1_PackageEnvironmentCluster(in *PackageEnvironmentCluster, out *v1alpha1.PackageEnvironmentCluster, s conversion.Scope) error {
+	return autoConvert_manifests_PackageEnvironmentCluster_To_v1alpha1_PackageEnvironmentCluster(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageEnvironmentCluster_To_manifests_PackageEnvironmentCluster(in *v1alpha1.PackageEnvironmentCluster, out *PackageEnvironmentCluster, s conversion.Scope) error {
+	out.Claims = *(*map[string]string)(unsafe.Pointer(&in.Claims))
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	return nil
+}
+
+// Convert_v1alpha1_PackageEnvironmentCluster_To_manifests_PackageEnvironmentCluster is an autogenerated conversion function.
+func Convert_v1alpha1_PackageEnvironmentCluster_To_manifests_PackageEnvironmentCluster(in *v1alpha1.PackageEnvironmentCluster, out *PackageEnvironmentCluster, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageEnvironmentCluster_To_manifests_PackageEnvironmentCluster(in, out, s)
+}
+
 func autoConvert_manifests_PackageEnvironmentHyperShift_To_v1alpha1_PackageEnvironmentHyperShift(in *PackageEnvironmentHyperShift, out *v1alpha1.PackageEnvironmentHyperShift, s conversion.Scope) error {
 	out.HostedCluster = (*v1alpha1.PackageEnvironmentHyperShiftHostedCluster)(unsafe.Pointer(in.HostedCluster))
 	return nil
@@ -459,6 +566,9 @@ func Convert_v1alpha1_PackageEnvironmentHyperShiftHostedCluster_To_manifests_Pac
 
 func autoConvert_manifests_PackageEnvironmentKubernetes_To_v1alpha1_PackageEnvironmentKubernetes(in *PackageEnvironmentKubernetes, out *v1alpha1.PackageEnvironmentKubernetes, s conversion.Scope) error {
 	out.Version = in.Version
+	out.ClusterDomain = in.ClusterDomain
+	out.CloudProvider = in.CloudProvider
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
 	return nil
 }
 
@@ -469,6 +579,9 @@ func Convert_manifests_PackageEnvironmentKubernetes_To_v1alpha1_PackageEnvironme
 
 func autoConvert_v1alpha1_PackageEnvironmentKubernetes_To_manifests_PackageEnvironmentKubernetes(in *v1alpha1.PackageEnvironmentKubernetes, out *PackageEnvironmentKubernetes, s conversion.Scope) error {
 	out.Version = in.Version
+	out.ClusterDomain = in.ClusterDomain
+	out.CloudProvider = in.CloudProvider
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
 	return nil
 }
 
@@ -519,6 +632,24 @@ func Convert_v1alpha1_PackageEnvironmentOpenShift_To_manifests_PackageEnvironmen
 	return autoConvert_v1alpha1_PackageEnvironmentOpenShift_To_manifests_PackageEnvironmentOpenShift(in, out, s)
 }
 
+func autoConvert_manifests_PackageEnvironmentPrometheusOperator_To_v1alpha1_PackageEnvironmentPrometheusOperator(in *PackageEnvironmentPrometheusOperator, out *v1alpha1.PackageEnvironmentPrometheusOperator, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_manifests_PackageEnvironmentPrometheusOperator_To_v1alpha1_PackageEnvironmentPrometheusOperator is an autogenerated conversion function.
+func Convert_manifests_PackageEnvironmentPrometheusOperator_To_v1alpha1_PackageEnvironmentPrometheusOperator(in *PackageEnvironmentPrometheusOperator, out *v1alpha1.PackageEnvironmentPrometheusOperator, s conversion.Scope) error {
+	return autoConvert_manifests_PackageEnvironmentPrometheusOperator_To_v1alpha1_PackageEnvironmentPrometheusOperator(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageEnvironmentPrometheusOperator_To_manifests_PackageEnvironmentPrometheusOperator(in *v1alpha1.PackageEnvironmentPrometheusOperator, out *PackageEnvironmentPrometheusOperator, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_PackageEnvironmentPrometheusOperator_To_manifests_PackageEnvironmentPrometheusOperator is an autogenerated conversion function.
+func Convert_v1alpha1_PackageEnvironmentPrometheusOperator_To_manifests_PackageEnvironmentPrometheusOperator(in *v1alpha1.PackageEnvironmentPrometheusOperator, out *PackageEnvironmentPrometheusOperator, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageEnvironmentPrometheusOperator_To_manifests_PackageEnvironmentPrometheusOperator(in, out, s)
+}
+
 func autoConvert_manifests_PackageEnvironmentProxy_To_v1alpha1_PackageEnvironmentProxy(in *PackageEnvironmentProxy, out *v1alpha1.PackageEnvironmentProxy, s conversion.Scope) error {
 	out.HTTPProxy = in.HTTPProxy
 	out.HTTPSProxy = in.HTTPSProxy
@@ -575,6 +706,36 @@ func Convert_v1alpha1_PackageManifest_To_manifests_PackageManifest(in *v1alpha1.
 	return autoConvert_v1alpha1_PackageManifest_To_manifests_PackageManifest(in, out, s)
 }
 
+func autoConvert_manifests_PackageManifestCarryOver_To_v1alpha1_PackageManifestCarryOver(in *PackageManifestCarryOver, out *v1alpha1.PackageManifestCarryOver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.ObjectName = in.ObjectName
+	out.Namespace = in.Namespace
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_manifests_PackageManifestCarryOver_To_v1alpha1_PackageManifestCarryOver is an autogenerated conversion function.
+func Convert_manifests_PackageManifestCarryOver_To_v1alpha1_PackageManifestCarryOver(in *PackageManifestCarryOver, out *v1alpha1.PackageManifestCarryOver, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestCarryOver_To_v1alpha1_PackageManifestCarryOver(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestCarryOver_To_manifests_PackageManifestCarryOver(in *v1alpha1.PackageManifestCarryOver, out *PackageManifestCarryOver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.ObjectName = in.ObjectName
+	out.Namespace = in.Namespace
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestCarryOver_To_manifests_PackageManifestCarryOver is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestCarryOver_To_manifests_PackageManifestCarryOver(in *v1alpha1.PackageManifestCarryOver, out *PackageManifestCarryOver, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestCarryOver_To_manifests_PackageManifestCarryOver(in, out, s)
+}
+
 func autoConvert_manifests_PackageManifestComponentsConfig_To_v1alpha1_PackageManifestComponentsConfig(in *PackageManifestComponentsConfig, out *v1alpha1.PackageManifestComponentsConfig, s conversion.Scope) error {
 	return nil
 }
@@ -593,6 +754,32 @@ func Convert_v1alpha1_PackageManifestComponentsConfig_To_manifests_PackageManife
 	return autoConvert_v1alpha1_PackageManifestComponentsConfig_To_manifests_PackageManifestComponentsConfig(in, out, s)
 }
 
+func autoConvert_manifests_PackageManifestConditionMapping_To_v1alpha1_PackageManifestConditionMapping(in *PackageManifestConditionMapping, out *v1alpha1.PackageManifestConditionMapping, s conversion.Scope) error {
+	out.Selector = in.Selector
+	out.SourceType = in.SourceType
+	out.DestinationType = in.DestinationType
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_manifests_PackageManifestConditionMapping_To_v1alpha1_PackageManifestConditionMapping is an autogenerated conversion function.
+func Convert_manifests_PackageManifestConditionMapping_To_v1alpha1_PackageManifestConditionMapping(in *PackageManifestConditionMapping, out *v1alpha1.PackageManifestConditionMapping, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestConditionMapping_To_v1alpha1_PackageManifestConditionMapping(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestConditionMapping_To_manifests_PackageManifestConditionMapping(in *v1alpha1.PackageManifestConditionMapping, out *PackageManifestConditionMapping, s conversion.Scope) error {
+	out.Selector = in.Selector
+	out.SourceType = in.SourceType
+	out.DestinationType = in.DestinationType
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestConditionMapping_To_manifests_PackageManifestConditionMapping is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestConditionMapping_To_manifests_PackageManifestConditionMapping(in *v1alpha1.PackageManifestConditionMapping, out *PackageManifestConditionMapping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestConditionMapping_To_manifests_PackageManifestConditionMapping(in, out, s)
+}
+
 func autoConvert_manifests_PackageManifestConstraint_To_v1alpha1_PackageManifestConstraint(in *PackageManifestConstraint, out *v1alpha1.PackageManifestConstraint, s conversion.Scope) error {
 	out.PlatformVersion = (*v1alpha1.PackageManifestPlatformVersionConstraint)(unsafe.Pointer(in.PlatformVersion))
 	out.Platform = *(*[]v1alpha1.PlatformName)(unsafe.Pointer(&in.Platform))
@@ -661,6 +848,28 @@ func Convert_v1alpha1_PackageManifestDependencyImage_To_manifests_PackageManifes
 	return autoConvert_v1alpha1_PackageManifestDependencyImage_To_manifests_PackageManifestDependencyImage(in, out, s)
 }
 
+func autoConvert_manifests_PackageManifestDirectoryPhase_To_v1alpha1_PackageManifestDirectoryPhase(in *PackageManifestDirectoryPhase, out *v1alpha1.PackageManifestDirectoryPhase, s conversion.Scope) error {
+	out.Directory = in.Directory
+	out.Phase = in.Phase
+	return nil
+}
+
+// Convert_manifests_PackageManifestDirectoryPhase_To_v1alpha1_PackageManifestDirectoryPhase is an autogenerated conversion function.
+func Convert_manifests_PackageManifestDirectoryPhase_To_v1alpha1_PackageManifestDirectoryPhase(in *PackageManifestDirectoryPhase, out *v1alpha1.PackageManifestDirectoryPhase, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestDirectoryPhase_To_v1alpha1_PackageManifestDirectoryPhase(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestDirectoryPhase_To_manifests_PackageManifestDirectoryPhase(in *v1alpha1.PackageManifestDirectoryPhase, out *PackageManifestDirectoryPhase, s conversion.Scope) error {
+	out.Directory = in.Directory
+	out.Phase = in.Phase
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestDirectoryPhase_To_manifests_PackageManifestDirectoryPhase is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestDirectoryPhase_To_manifests_PackageManifestDirectoryPhase(in *v1alpha1.PackageManifestDirectoryPhase, out *PackageManifestDirectoryPhase, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestDirectoryPhase_To_manifests_PackageManifestDirectoryPhase(in, out, s)
+}
+
 func autoConvert_manifests_PackageManifestFilter_To_v1alpha1_PackageManifestFilter(in *PackageManifestFilter, out *v1alpha1.PackageManifestFilter, s conversion.Scope) error {
 	out.Conditions = *(*[]v1alpha1.PackageManifestNamedCondition)(unsafe.Pointer(&in.Conditions))
 	out.Paths = *(*[]v1alpha1.PackageManifestPath)(unsafe.Pointer(&in.Paths))
@@ -825,6 +1034,56 @@ func Convert_v1alpha1_PackageManifestNamedCondition_To_manifests_PackageManifest
 	return autoConvert_v1alpha1_PackageManifestNamedCondition_To_manifests_PackageManifestNamedCondition(in, out, s)
 }
 
+func autoConvert_manifests_PackageManifestMonitoring_To_v1alpha1_PackageManifestMonitoring(in *PackageManifestMonitoring, out *v1alpha1.PackageManifestMonitoring, s conversion.Scope) error {
+	out.Port = in.Port
+	out.Path = in.Path
+	out.ServiceMonitors = in.ServiceMonitors
+	return nil
+}
+
+// Convert_manifests_PackageManifestMonitoring_To_v1alpha1_PackageManifestMonitoring is an autogenerated conversion function.
+func Convert_manifests_PackageManifestMonitoring_To_v1alpha1_PackageManifestMonitoring(in *PackageManifestMonitoring, out *v1alpha1.PackageManifestMonitoring, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestMonitoring_To_v1alpha1_PackageManifestMonitoring(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestMonitoring_To_manifests_PackageManifestMonitoring(in *v1alpha1.PackageManifestMonitoring, out *PackageManifestMonitoring, s conversion.Scope) error {
+	out.Port = in.Port
+	out.Path = in.Path
+	out.ServiceMonitors = in.ServiceMonitors
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestMonitoring_To_manifests_PackageManifestMonitoring is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestMonitoring_To_manifests_PackageManifestMonitoring(in *v1alpha1.PackageManifestMonitoring, out *PackageManifestMonitoring, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestMonitoring_To_manifests_PackageManifestMonitoring(in, out, s)
+}
+
+func autoConvert_manifests_PackageManifestNamespace_To_v1alpha1_PackageManifestNamespace(in *PackageManifestNamespace, out *v1alpha1.PackageManifestNamespace, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.CleanupPolicy = corev1alpha1.ObjectDeletionPolicy(in.CleanupPolicy)
+	return nil
+}
+
+// Convert_manifests_PackageManifestNamespace_To_v1alpha1_PackageManifestNamespace is an autogenerated conversion function.
+func Convert_manifests_PackageManifestNamespace_To_v1alpha1_PackageManifestNamespace(in *PackageManifestNamespace, out *v1alpha1.PackageManifestNamespace, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestNamespace_To_v1alpha1_PackageManifestNamespace(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestNamespace_To_manifests_PackageManifestNamespace(in *v1alpha1.PackageManifestNamespace, out *PackageManifestNamespace, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.CleanupPolicy = corev1alpha1.ObjectDeletionPolicy(in.CleanupPolicy)
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestNamespace_To_manifests_PackageManifestNamespace is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestNamespace_To_manifests_PackageManifestNamespace(in *v1alpha1.PackageManifestNamespace, out *PackageManifestNamespace, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestNamespace_To_manifests_PackageManifestNamespace(in, out, s)
+}
+
 func autoConvert_manifests_PackageManifestPath_To_v1alpha1_PackageManifestPath(in *PackageManifestPath, out *v1alpha1.PackageManifestPath, s conversion.Scope) error {
 	out.Glob = in.Glob
 	out.Expression = in.Expression
@@ -891,6 +1150,26 @@ func Convert_v1alpha1_PackageManifestPlatformVersionConstraint_To_manifests_Pack
 	return autoConvert_v1alpha1_PackageManifestPlatformVersionConstraint_To_manifests_PackageManifestPlatformVersionConstraint(in, out, s)
 }
 
+func autoConvert_manifests_PackageManifestRendering_To_v1alpha1_PackageManifestRendering(in *PackageManifestRendering, out *v1alpha1.PackageManifestRendering, s conversion.Scope) error {
+	out.Strict = in.Strict
+	return nil
+}
+
+// Convert_manifests_PackageManifestRendering_To_v1alpha1_PackageManifestRendering is an autogenerated conversion function.
+func Convert_manifests_PackageManifestRendering_To_v1alpha1_PackageManifestRendering(in *PackageManifestRendering, out *v1alpha1.PackageManifestRendering, s conversion.Scope) error {
+	return autoConvert_manifests_PackageManifestRendering_To_v1alpha1_PackageManifestRendering(in, out, s)
+}
+
+func autoConvert_v1alpha1_PackageManifestRendering_To_manifests_PackageManifestRendering(in *v1alpha1.PackageManifestRendering, out *PackageManifestRendering, s conversion.Scope) error {
+	out.Strict = in.Strict
+	return nil
+}
+
+// Convert_v1alpha1_PackageManifestRendering_To_manifests_PackageManifestRendering is an autogenerated conversion function.
+func Convert_v1alpha1_PackageManifestRendering_To_manifests_PackageManifestRendering(in *v1alpha1.PackageManifestRendering, out *PackageManifestRendering, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PackageManifestRendering_To_manifests_PackageManifestRendering(in, out, s)
+}
+
 func autoConvert_manifests_PackageManifestRepository_To_v1alpha1_PackageManifestRepository(in *PackageManifestRepository, out *v1alpha1.PackageManifestRepository, s conversion.Scope) error {
 	out.File = in.File
 	out.Image = in.Image
@@ -915,8 +1194,13 @@ func Convert_v1alpha1_PackageManifestRepository_To_manifests_PackageManifestRepo
 
 func autoConvert_manifests_PackageManifestSpec_To_v1alpha1_PackageManifestSpec(in *PackageManifestSpec, out *v1alpha1.PackageManifestSpec, s conversion.Scope) error {
 	out.Scopes = *(*[]v1alpha1.PackageManifestScope)(unsafe.Pointer(&in.Scopes))
+	out.ClusterScopedKinds = *(*[]metav1.GroupKind)(unsafe.Pointer(&in.ClusterScopedKinds))
 	out.Phases = *(*[]v1alpha1.PackageManifestPhase)(unsafe.Pointer(&in.Phases))
+	out.DefaultPhase = in.DefaultPhase
+	out.DirectoryPhases = *(*[]v1alpha1.PackageManifestDirectoryPhase)(unsafe.Pointer(&in.DirectoryPhases))
+	out.Namespaces = *(*[]v1alpha1.PackageManifestNamespace)(unsafe.Pointer(&in.Namespaces))
 	out.AvailabilityProbes = *(*[]corev1alpha1.ObjectSetProbe)(unsafe.Pointer(&in.AvailabilityProbes))
+	out.ConditionMappings = *(*[]v1alpha1.PackageManifestConditionMapping)(unsafe.Pointer(&in.ConditionMappings))
 	if err := Convert_manifests_PackageManifestSpecConfig_To_v1alpha1_PackageManifestSpecConfig(&in.Config, &out.Config, s); err != nil {
 		return err
 	}
@@ -928,6 +1212,11 @@ func autoConvert_manifests_PackageManifestSpec_To_v1alpha1_PackageManifestSpec(i
 	out.Constraints = *(*[]v1alpha1.PackageManifestConstraint)(unsafe.Pointer(&in.Constraints))
 	out.Repositories = *(*[]v1alpha1.PackageManifestRepository)(unsafe.Pointer(&in.Repositories))
 	out.Dependencies = *(*[]v1alpha1.PackageManifestDependency)(unsafe.Pointer(&in.Dependencies))
+	if err := Convert_manifests_PackageManifestRendering_To_v1alpha1_PackageManifestRendering(&in.Rendering, &out.Rendering, s); err != nil {
+		return err
+	}
+	out.CarryOver = *(*[]v1alpha1.PackageManifestCarryOver)(unsafe.Pointer(&in.CarryOver))
+	out.Monitoring = (*v1alpha1.PackageManifestMonitoring)(unsafe.Pointer(in.Monitoring))
 	return nil
 }
 
@@ -938,8 +1227,13 @@ func Convert_manifests_PackageManifestSpec_To_v1alpha1_PackageManifestSpec(in *P
 
 func autoConvert_v1alpha1_PackageManifestSpec_To_manifests_PackageManifestSpec(in *v1alpha1.PackageManifestSpec, out *PackageManifestSpec, s conversion.Scope) error {
 	out.Scopes = *(*[]PackageManifestScope)(unsafe.Pointer(&in.Scopes))
+	out.ClusterScopedKinds = *(*[]metav1.GroupKind)(unsafe.Pointer(&in.ClusterScopedKinds))
 	out.Phases = *(*[]PackageManifestPhase)(unsafe.Pointer(&in.Phases))
+	out.DefaultPhase = in.DefaultPhase
+	out.DirectoryPhases = *(*[]PackageManifestDirectoryPhase)(unsafe.Pointer(&in.DirectoryPhases))
+	out.Namespaces = *(*[]PackageManifestNamespace)(unsafe.Pointer(&in.Namespaces))
 	out.AvailabilityProbes = *(*[]corev1alpha1.ObjectSetProbe)(unsafe.Pointer(&in.AvailabilityProbes))
+	out.ConditionMappings = *(*[]PackageManifestConditionMapping)(unsafe.Pointer(&in.ConditionMappings))
 	if err := Convert_v1alpha1_PackageManifestSpecConfig_To_manifests_PackageManifestSpecConfig(&in.Config, &out.Config, s); err != nil {
 		return err
 	}
@@ -951,6 +1245,11 @@ func autoConvert_v1alpha1_PackageManifestSpec_To_manifests_PackageManifestSpec(i
 	out.Constraints = *(*[]PackageManifestConstraint)(unsafe.Pointer(&in.Constraints))
 	out.Repositories = *(*[]PackageManifestRepository)(unsafe.Pointer(&in.Repositories))
 	out.Dependencies = *(*[]PackageManifestDependency)(unsafe.Pointer(&in.Dependencies))
+	if err := Convert_v1alpha1_PackageManifestRendering_To_manifests_PackageManifestRendering(&in.Rendering, &out.Rendering, s); err != nil {
+		return err
+	}
+	out.CarryOver = *(*[]PackageManifestCarryOver)(unsafe.Pointer(&in.CarryOver))
+	out.Monitoring = (*PackageManifestMonitoring)(unsafe.Pointer(in.Monitoring))
 	return nil
 }
 
@@ -1220,6 +1519,8 @@ func autoConvert_manifests_TemplateContextPackage_To_v1alpha1_TemplateContextPac
 		return err
 	}
 	out.Image = in.Image
+	out.ConfigHash = in.ConfigHash
+	out.ConfigChecksums = *(*map[string]string)(unsafe.Pointer(&in.ConfigChecksums))
 	return nil
 }
 
@@ -1233,6 +1534,8 @@ func autoConvert_v1alpha1_TemplateContextPackage_To_manifests_TemplateContextPac
 		return err
 	}
 	out.Image = in.Image
+	out.ConfigHash = in.ConfigHash
+	out.ConfigChecksums = *(*map[string]string)(unsafe.Pointer(&in.ConfigChecksums))
 	return nil
 }
 
@@ -5,6 +5,7 @@
 package manifests
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"package-operator.run/apis/core/v1alpha1"
 )
@@ -12,7 +13,7 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageEnvironment) DeepCopyInto(out *PackageEnvironment) {
 	*out = *in
-	out.Kubernetes = in.Kubernetes
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
 	if in.OpenShift != nil {
 		in, out := &in.OpenShift, &out.OpenShift
 		*out = new(PackageEnvironmentOpenShift)
@@ -28,6 +29,16 @@ func (in *PackageEnvironment) DeepCopyInto(out *PackageEnvironment) {
 		*out = new(PackageEnvironmentHyperShift)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(PackageEnvironmentCluster)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusOperator != nil {
+		in, out := &in.PrometheusOperator, &out.PrometheusOperator
+		*out = new(PackageEnvironmentPrometheusOperator)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageEnvironment.
@@ -40,6 +51,35 @@ func (in *PackageEnvironment) DeepCopy() *PackageEnvironment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageEnvironmentCluster) DeepCopyInto(out *PackageEnvironmentCluster) {
+	*out = *in
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageEnvironmentCluster.
+func (in *PackageEnvironmentCluster) DeepCopy() *PackageEnvironmentCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageEnvironmentCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageEnvironmentHyperShift) DeepCopyInto(out *PackageEnvironmentHyperShift) {
 	*out = *in
@@ -79,6 +119,11 @@ func (in *PackageEnvironmentHyperShiftHostedCluster) DeepCopy() *PackageEnvironm
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageEnvironmentKubernetes) DeepCopyInto(out *PackageEnvironmentKubernetes) {
 	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageEnvironmentKubernetes.
@@ -133,6 +178,21 @@ func (in *PackageEnvironmentOpenShift) DeepCopy() *PackageEnvironmentOpenShift {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageEnvironmentPrometheusOperator) DeepCopyInto(out *PackageEnvironmentPrometheusOperator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageEnvironmentPrometheusOperator.
+func (in *PackageEnvironmentPrometheusOperator) DeepCopy() *PackageEnvironmentPrometheusOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageEnvironmentPrometheusOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageEnvironmentProxy) DeepCopyInto(out *PackageEnvironmentProxy) {
 	*out = *in
@@ -175,6 +235,21 @@ func (in *PackageManifest) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestCarryOver) DeepCopyInto(out *PackageManifestCarryOver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestCarryOver.
+func (in *PackageManifestCarryOver) DeepCopy() *PackageManifestCarryOver {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestCarryOver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageManifestComponentsConfig) DeepCopyInto(out *PackageManifestComponentsConfig) {
 	*out = *in
@@ -190,6 +265,22 @@ func (in *PackageManifestComponentsConfig) DeepCopy() *PackageManifestComponents
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestConditionMapping) DeepCopyInto(out *PackageManifestConditionMapping) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestConditionMapping.
+func (in *PackageManifestConditionMapping) DeepCopy() *PackageManifestConditionMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestConditionMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageManifestConstraint) DeepCopyInto(out *PackageManifestConstraint) {
 	*out = *in
@@ -255,6 +346,21 @@ func (in *PackageManifestDependencyImage) DeepCopy() *PackageManifestDependencyI
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestDirectoryPhase) DeepCopyInto(out *PackageManifestDirectoryPhase) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestDirectoryPhase.
+func (in *PackageManifestDirectoryPhase) DeepCopy() *PackageManifestDirectoryPhase {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestDirectoryPhase)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageManifestFilter) DeepCopyInto(out *PackageManifestFilter) {
 	*out = *in
@@ -406,6 +512,50 @@ func (in *PackageManifestPath) DeepCopy() *PackageManifestPath {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestMonitoring) DeepCopyInto(out *PackageManifestMonitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestMonitoring.
+func (in *PackageManifestMonitoring) DeepCopy() *PackageManifestMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestNamespace) DeepCopyInto(out *PackageManifestNamespace) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestNamespace.
+func (in *PackageManifestNamespace) DeepCopy() *PackageManifestNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageManifestPhase) DeepCopyInto(out *PackageManifestPhase) {
 	*out = *in
@@ -436,6 +586,21 @@ func (in *PackageManifestPlatformVersionConstraint) DeepCopy() *PackageManifestP
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageManifestRendering) DeepCopyInto(out *PackageManifestRendering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestRendering.
+func (in *PackageManifestRendering) DeepCopy() *PackageManifestRendering {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageManifestRendering)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PackageManifestRepository) DeepCopyInto(out *PackageManifestRepository) {
 	*out = *in
@@ -459,11 +624,28 @@ func (in *PackageManifestSpec) DeepCopyInto(out *PackageManifestSpec) {
 		*out = make([]PackageManifestScope, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClusterScopedKinds != nil {
+		in, out := &in.ClusterScopedKinds, &out.ClusterScopedKinds
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
 	if in.Phases != nil {
 		in, out := &in.Phases, &out.Phases
 		*out = make([]PackageManifestPhase, len(*in))
 		copy(*out, *in)
 	}
+	if in.DirectoryPhases != nil {
+		in, out := &in.DirectoryPhases, &out.DirectoryPhases
+		*out = make([]PackageManifestDirectoryPhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]PackageManifestNamespace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AvailabilityProbes != nil {
 		in, out := &in.AvailabilityProbes, &out.AvailabilityProbes
 		*out = make([]v1alpha1.ObjectSetProbe, len(*in))
@@ -471,6 +653,13 @@ func (in *PackageManifestSpec) DeepCopyInto(out *PackageManifestSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ConditionMappings != nil {
+		in, out := &in.ConditionMappings, &out.ConditionMappings
+		*out = make([]PackageManifestConditionMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Config.DeepCopyInto(&out.Config)
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
@@ -502,6 +691,17 @@ func (in *PackageManifestSpec) DeepCopyInto(out *PackageManifestSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.Rendering = in.Rendering
+	if in.CarryOver != nil {
+		in, out := &in.CarryOver, &out.CarryOver
+		*out = make([]PackageManifestCarryOver, len(*in))
+		copy(*out, *in)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(PackageManifestMonitoring)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageManifestSpec.
@@ -744,6 +944,13 @@ func (in *TemplateContextObjectMeta) DeepCopy() *TemplateContextObjectMeta {
 func (in *TemplateContextPackage) DeepCopyInto(out *TemplateContextPackage) {
 	*out = *in
 	in.TemplateContextObjectMeta.DeepCopyInto(&out.TemplateContextObjectMeta)
+	if in.ConfigChecksums != nil {
+		in, out := &in.ConfigChecksums, &out.ConfigChecksums
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateContextPackage.
@@ -0,0 +1,146 @@
+// Package audit records the changes Package Operator makes to the objects it manages,
+// so they can be reviewed without access to the API server audit log.
+package audit
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Operation performed on an object.
+type Operation string
+
+const (
+	OperationCreate Operation = "Create"
+	OperationPatch  Operation = "Patch"
+	OperationDelete Operation = "Delete"
+)
+
+// Outcome of an operation.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "Success"
+	OutcomeFailure Outcome = "Failure"
+)
+
+// Entry describes a single operation performed on an object.
+type Entry struct {
+	Time       time.Time `json:"time"`
+	Operation  Operation `json:"operation"`
+	APIVersion string    `json:"apiVersion"`
+	Kind       string    `json:"kind"`
+	Namespace  string    `json:"namespace,omitempty"`
+	Name       string    `json:"name"`
+	// ObjectSet or ObjectSetPhase that performed the operation.
+	Owner ObjectReference `json:"owner"`
+	// Paths of the fields changed by a patch.
+	Changes []string `json:"changes,omitempty"`
+	Outcome Outcome  `json:"outcome"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// ObjectReference identifies the owner of an object.
+type ObjectReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// NewEntry returns an entry for the given operation on obj, performed by owner.
+// The outcome is derived from err.
+func NewEntry(
+	op Operation, obj client.Object, ownerGVK schema.GroupVersionKind, owner client.Object, err error,
+) Entry {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	e := Entry{
+		Time:       time.Now().UTC(),
+		Operation:  op,
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		Owner: ObjectReference{
+			APIVersion: ownerGVK.GroupVersion().String(),
+			Kind:       ownerGVK.Kind,
+			Namespace:  owner.GetNamespace(),
+			Name:       owner.GetName(),
+		},
+		Outcome: OutcomeSuccess,
+	}
+	if err != nil {
+		e.Outcome = OutcomeFailure
+		e.Error = err.Error()
+	}
+	return e
+}
+
+// Sink receives audit entries.
+// Implementations must be safe for concurrent use and must not block reconciliation for long.
+type Sink interface {
+	Record(ctx context.Context, entry Entry)
+}
+
+// Discard drops all entries, used when no audit sink is configured.
+type Discard struct{}
+
+func (Discard) Record(context.Context, Entry) {}
+
+// Multi records entries to all contained sinks.
+type Multi []Sink
+
+func (m Multi) Record(ctx context.Context, entry Entry) {
+	for _, s := range m {
+		s.Record(ctx, entry)
+	}
+}
+
+// Changes returns the paths of all fields specified in desired,
+// that have a different value in current. Lists are compared as a whole.
+// The status is ignored, because it is never patched.
+func Changes(current, desired *unstructured.Unstructured) []string {
+	var changes []string
+	for key, desiredValue := range desired.Object {
+		switch key {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			desiredMeta, _ := desiredValue.(map[string]any)
+			currentMeta, _ := current.Object[key].(map[string]any)
+			// Only metadata fields that are managed by the desired object.
+			for _, metaKey := range []string{"labels", "annotations", "ownerReferences"} {
+				if v, ok := desiredMeta[metaKey]; ok {
+					changes = appendChanges(changes, []string{key, metaKey}, currentMeta[metaKey], v)
+				}
+			}
+		default:
+			changes = appendChanges(changes, []string{key}, current.Object[key], desiredValue)
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+func appendChanges(changes []string, path []string, current, desired any) []string {
+	desiredMap, ok := desired.(map[string]any)
+	if !ok {
+		if !reflect.DeepEqual(current, desired) {
+			changes = append(changes, strings.Join(path, "."))
+		}
+		return changes
+	}
+
+	currentMap, _ := current.(map[string]any)
+	for key, value := range desiredMap {
+		changes = appendChanges(changes, append(path[:len(path):len(path)], key), currentMap[key], value)
+	}
+	return changes
+}
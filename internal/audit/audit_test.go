@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+var errTest = errors.New("test")
+
+func TestNewEntry(t *testing.T) {
+	t.Parallel()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+	obj.SetNamespace("test-ns")
+	obj.SetName("test")
+
+	owner := &corev1alpha1.ObjectSet{}
+	owner.SetNamespace("test-ns")
+	owner.SetName("test-1234")
+	ownerGVK := corev1alpha1.GroupVersion.WithKind("ObjectSet")
+
+	e := NewEntry(OperationPatch, obj, ownerGVK, owner, nil)
+	assert.Equal(t, OutcomeSuccess, e.Outcome)
+	assert.Equal(t, "apps/v1", e.APIVersion)
+	assert.Equal(t, "Deployment", e.Kind)
+	assert.Equal(t, ObjectReference{
+		APIVersion: "package-operator.run/v1alpha1",
+		Kind:       "ObjectSet",
+		Namespace:  "test-ns",
+		Name:       "test-1234",
+	}, e.Owner)
+
+	e = NewEntry(OperationDelete, obj, schema.GroupVersionKind{}, owner, errTest)
+	assert.Equal(t, OutcomeFailure, e.Outcome)
+	assert.Equal(t, "test", e.Error)
+}
+
+func TestChanges(t *testing.T) {
+	t.Parallel()
+
+	current := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":            "test",
+			"resourceVersion": "123",
+			"labels":          map[string]any{"a": "1"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"image": "a"}},
+				},
+			},
+		},
+		"status": map[string]any{"replicas": int64(1)},
+	}}
+	desired := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":   "test",
+			"labels": map[string]any{"a": "1", "b": "2"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"image": "b"}},
+				},
+			},
+		},
+		"status": map[string]any{"replicas": int64(3)},
+	}}
+
+	assert.Equal(t, []string{
+		"metadata.labels.b",
+		"spec.replicas",
+		"spec.template.spec.containers",
+	}, Changes(current, desired))
+	assert.Empty(t, Changes(current, current))
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	s.Record(context.Background(), Entry{Operation: OperationCreate, Name: "a"})
+	s.Record(context.Background(), Entry{Operation: OperationDelete, Name: "b"})
+	require.NoError(t, s.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+
+	var e Entry
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &e))
+	assert.Equal(t, OperationDelete, e.Operation)
+	assert.Equal(t, "b", e.Name)
+}
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan Entry, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e Entry
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- e
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSink(testr.New(t), srv.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = s.Start(ctx) }()
+
+	s.Record(ctx, Entry{Operation: OperationPatch, Name: "a", Changes: []string{"spec.replicas"}})
+
+	e := <-received
+	assert.Equal(t, OperationPatch, e.Operation)
+	assert.Equal(t, []string{"spec.replicas"}, e.Changes)
+}
+
+func TestWebhookSink_errorStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSink(testr.New(t), srv.URL)
+	err := s.send(context.Background(), Entry{})
+	require.ErrorIs(t, err, ErrWebhookStatus)
+}
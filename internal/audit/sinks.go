@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// FileSink writes entries as JSON lines.
+type FileSink struct {
+	lock sync.Mutex
+	w    io.WriteCloser
+}
+
+// NewFileSink appends entries to the file at path, creating it if needed.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	return &FileSink{w: f}, nil
+}
+
+func (s *FileSink) Record(ctx context.Context, entry Entry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "encoding audit entry")
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := s.w.Write(append(b, '\n')); err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "writing audit entry")
+	}
+}
+
+func (s *FileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.w.Close()
+}
+
+// ErrWebhookStatus is returned when the audit webhook responds with an error status.
+var ErrWebhookStatus = errors.New("audit webhook returned error status")
+
+const (
+	webhookQueueSize = 1000
+	webhookTimeout   = 10 * time.Second
+)
+
+// WebhookSink posts entries as JSON to a HTTP endpoint.
+// Entries are queued and sent in the background by Start,
+// so an unavailable endpoint does not block reconciliation.
+// Entries are dropped when the queue is full.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+	log    logr.Logger
+	queue  chan Entry
+}
+
+func NewWebhookSink(log logr.Logger, url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		log:    log,
+		queue:  make(chan Entry, webhookQueueSize),
+	}
+}
+
+func (s *WebhookSink) Record(_ context.Context, entry Entry) {
+	select {
+	case s.queue <- entry:
+	default:
+		s.log.Info("audit webhook queue is full, dropping entry",
+			"operation", entry.Operation, "kind", entry.Kind,
+			"namespace", entry.Namespace, "name", entry.Name)
+	}
+}
+
+// Start sends queued entries until ctx is cancelled.
+func (s *WebhookSink) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case entry := <-s.queue:
+			if err := s.send(ctx, entry); err != nil {
+				s.log.Error(err, "sending audit entry")
+			}
+		}
+	}
+}
+
+// Sending entries is not tied to leadership,
+// because entries are recorded by the leader only anyway.
+func (s *WebhookSink) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *WebhookSink) send(ctx context.Context, entry Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%w: %s", ErrWebhookStatus, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package cachejanitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/constants"
+	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/ownerhandling"
+	"package-operator.run/internal/utils"
+)
+
+// Janitor removes the dynamic cache label from objects, whose Package Operator owners are gone.
+// Owners deleted while Package Operator was crash looping leave labeled objects behind,
+// that would otherwise be cached forever.
+type Janitor struct {
+	log       logr.Logger
+	client    client.Client
+	discovery discovery.DiscoveryInterface
+	recorder  recorder
+	interval  time.Duration
+	pageSize  int64
+}
+
+type recorder interface {
+	RecordDynamicCacheLabelsRemoved(gvk schema.GroupVersionKind, count int)
+}
+
+// NewJanitor returns a Janitor sweeping all resources of the cluster in the given interval.
+// c should not be backed by a cache, so objects outside of caches are found.
+func NewJanitor(
+	log logr.Logger, c client.Client, discoveryClient discovery.DiscoveryInterface,
+	recorder recorder, interval time.Duration, pageSize int64,
+) *Janitor {
+	return &Janitor{
+		log:       log,
+		client:    c,
+		discovery: discoveryClient,
+		recorder:  recorder,
+		interval:  interval,
+		pageSize:  pageSize,
+	}
+}
+
+// Start sweeps all resources in the configured interval, until ctx is canceled.
+// Does nothing for an interval of 0.
+func (j *Janitor) Start(ctx context.Context) error {
+	if j.interval <= 0 {
+		return nil
+	}
+
+	ctx = logr.NewContext(ctx, j.log)
+	t := time.NewTicker(j.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := j.Sweep(ctx); err != nil {
+				j.log.Error(err, "removing orphaned dynamic cache labels")
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Sweep removes the dynamic cache label from all objects of the cluster, whose owners are gone.
+func (j *Janitor) Sweep(ctx context.Context) error {
+	resourceLists, err := j.discovery.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return fmt.Errorf("discovering resources: %w", err)
+	}
+
+	var errs []error
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return fmt.Errorf("parsing group version: %w", err)
+		}
+		for _, resource := range resourceList.APIResources {
+			if strings.Contains(resource.Name, "/") ||
+				!slices.Contains(resource.Verbs, "list") || !slices.Contains(resource.Verbs, "patch") {
+				// Subresources and read-only resources.
+				continue
+			}
+			if err := j.sweepGVK(ctx, gv.WithKind(resource.Kind)); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (j *Janitor) sweepGVK(ctx context.Context, gvk schema.GroupVersionKind) error {
+	var removed int
+	err := utils.ListPages(ctx, j.client,
+		func() *unstructured.UnstructuredList {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			return list
+		}, j.pageSize,
+		func(page *unstructured.UnstructuredList) error {
+			for i := range page.Items {
+				obj := &page.Items[i]
+				orphaned, err := j.orphaned(ctx, obj)
+				if err != nil {
+					return err
+				}
+				if !orphaned {
+					continue
+				}
+
+				if _, err := controllers.RemoveDynamicCacheLabel(ctx, j.client, obj); err != nil {
+					return err
+				}
+				removed++
+				j.log.Info("removed orphaned dynamic cache label",
+					"gvk", gvk, "object", client.ObjectKeyFromObject(obj))
+			}
+			return nil
+		},
+		client.HasLabels{constants.DynamicCacheLabel},
+	)
+	if removed > 0 {
+		j.recorder.RecordDynamicCacheLabelsRemoved(gvk, removed)
+	}
+	if err != nil {
+		return fmt.Errorf("sweeping %s: %w", gvk, err)
+	}
+	return nil
+}
+
+// Returns true when obj is owned by Package Operator objects and all of them are gone.
+// Objects without Package Operator owners are never orphaned,
+// e.g. sources of ObjectTemplates.
+func (j *Janitor) orphaned(ctx context.Context, obj client.Object) (bool, error) {
+	owners, err := ownerhandling.Owners(obj)
+	if err != nil {
+		// Leave malformed owners to the controllers.
+		return false, nil //nolint:nilerr
+	}
+
+	var pkoOwners int
+	for _, owner := range owners {
+		gv, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil || gv.Group != corev1alpha1.GroupVersion.Group {
+			continue
+		}
+		pkoOwners++
+
+		ownerObj := &metav1.PartialObjectMetadata{}
+		ownerObj.SetGroupVersionKind(gv.WithKind(owner.Kind))
+		err = j.client.Get(ctx, client.ObjectKey{Name: owner.Name, Namespace: owner.Namespace}, ownerObj)
+		switch {
+		case apimachineryerrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return false, fmt.Errorf("getting owner %s %s: %w", owner.Kind, owner.Name, err)
+		case len(owner.UID) > 0 && owner.UID != ownerObj.GetUID():
+			// Recreated with the same name.
+			continue
+		}
+		return false, nil
+	}
+	return pkoOwners > 0, nil
+}
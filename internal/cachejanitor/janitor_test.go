@@ -0,0 +1,92 @@
+package cachejanitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/constants"
+)
+
+func TestJanitor_sweepGVK(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, corev1alpha1.AddToScheme(scheme))
+
+	owner := &corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "test", UID: "owner"},
+	}
+	newConfigMap := func(name string, ownerRefs ...metav1.OwnerReference) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "test",
+			Labels:          map[string]string{constants.DynamicCacheLabel: "True"},
+			OwnerReferences: ownerRefs,
+		}}
+	}
+	ownerRef := func(name, uid string) metav1.OwnerReference {
+		return metav1.OwnerReference{
+			APIVersion: corev1alpha1.GroupVersion.String(),
+			Kind:       "ObjectSet",
+			Name:       name,
+			UID:        types.UID(uid),
+		}
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			owner,
+			newConfigMap("owned", ownerRef("owner", "owner")),
+			newConfigMap("orphaned", ownerRef("gone", "gone")),
+			newConfigMap("recreated-owner", ownerRef("owner", "previous")),
+			newConfigMap("no-pko-owner", metav1.OwnerReference{APIVersion: "v1", Kind: "Secret", Name: "gone"}),
+			newConfigMap("no-owner"),
+		).
+		Build()
+
+	rec := &recorderStub{}
+	j := NewJanitor(testr.New(t), c, nil, rec, 0, 0)
+
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	require.NoError(t, j.sweepGVK(context.Background(), gvk))
+	assert.Equal(t, 2, rec.removed[gvk])
+
+	for name, labeled := range map[string]bool{
+		"owned":           true,
+		"orphaned":        false,
+		"recreated-owner": false,
+		"no-pko-owner":    true,
+		"no-owner":        true,
+	} {
+		cm := &corev1.ConfigMap{}
+		require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "test"}, cm))
+		_, ok := cm.Labels[constants.DynamicCacheLabel]
+		assert.Equal(t, labeled, ok, name)
+	}
+}
+
+type recorderStub struct {
+	removed map[schema.GroupVersionKind]int
+}
+
+func (r *recorderStub) RecordDynamicCacheLabelsRemoved(gvk schema.GroupVersionKind, count int) {
+	if r.removed == nil {
+		r.removed = map[schema.GroupVersionKind]int{}
+	}
+	r.removed[gvk] += count
+}
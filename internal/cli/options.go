@@ -10,6 +10,10 @@ func (w WithOut) ConfigurePrinter(c *PrinterConfig) {
 	c.Out = w.Out
 }
 
+func (w WithOut) ConfigureSpinner(c *SpinnerConfig) {
+	c.Out = w.Out
+}
+
 // WithErr configures the Err stream
 // to the given io.Writer implementations.
 type WithErr struct{ Err io.Writer }
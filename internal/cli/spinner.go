@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+const defaultSpinnerInterval = 100 * time.Millisecond
+
+// NewSpinner takes a variadic slice of SpinnerOptions
+// and returns a configured Spinner instance.
+func NewSpinner(opts ...SpinnerOption) *Spinner {
+	var cfg SpinnerConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Spinner{
+		cfg: cfg,
+	}
+}
+
+// Spinner renders a single, continuously updated progress line.
+type Spinner struct {
+	cfg SpinnerConfig
+
+	mux   sync.Mutex
+	text  string
+	frame int
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// Start begins rendering the spinner in the background.
+func (s *Spinner) Start(text string) {
+	s.mux.Lock()
+	s.text = text
+	s.done = make(chan struct{})
+	done := s.done
+	s.mux.Unlock()
+
+	s.render()
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		t := time.NewTicker(s.cfg.Interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				s.render()
+			}
+		}
+	}()
+}
+
+// UpdateText replaces the text shown next to the spinner.
+func (s *Spinner) UpdateText(text string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.text = text
+}
+
+// Println prints a message on its own line above the spinner.
+func (s *Spinner) Println(msg string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, _ = fmt.Fprintf(s.cfg.Out, "\r\033[K%s\n", msg)
+}
+
+// Stop ends rendering and replaces the spinner line with the given final message.
+func (s *Spinner) Stop(msg string) {
+	s.mux.Lock()
+	if s.done != nil {
+		close(s.done)
+	}
+	s.mux.Unlock()
+
+	s.wg.Wait()
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.done = nil
+	_, _ = fmt.Fprintf(s.cfg.Out, "\r\033[K%s\n", msg)
+}
+
+func (s *Spinner) render() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, _ = fmt.Fprintf(s.cfg.Out, "\r\033[K%s %s", spinnerFrames[s.frame], s.text)
+	s.frame = (s.frame + 1) % len(spinnerFrames)
+}
+
+type SpinnerConfig struct {
+	Out      io.Writer
+	Interval time.Duration
+}
+
+func (c *SpinnerConfig) Option(opts ...SpinnerOption) {
+	for _, opt := range opts {
+		opt.ConfigureSpinner(c)
+	}
+}
+
+func (c *SpinnerConfig) Default() {
+	if c.Out == nil {
+		c.Out = io.Discard
+	}
+	if c.Interval == 0 {
+		c.Interval = defaultSpinnerInterval
+	}
+}
+
+type SpinnerOption interface {
+	ConfigureSpinner(*SpinnerConfig)
+}
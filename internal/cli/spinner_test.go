@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpinner(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	spinner := NewSpinner(
+		WithOut{Out: &out},
+	)
+
+	spinner.Start("installing")
+	spinner.UpdateText("progressing")
+	spinner.Println("probe failed")
+	spinner.Stop("done")
+
+	assert.Contains(t, out.String(), "| installing")
+	assert.Contains(t, out.String(), "\r\033[Kprobe failed\n")
+	assert.Equal(t, "\r\033[Kdone\n", out.String()[len(out.String())-len("\r\033[Kdone\n"):])
+}
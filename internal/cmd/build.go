@@ -3,9 +3,12 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/crane"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
 
 	"package-operator.run/internal/packages"
 )
@@ -65,6 +68,20 @@ func (b *Build) BuildFromSource(ctx context.Context, srcPath string, opts ...Bui
 
 	cfg.Option(opts...)
 
+	platforms, err := parsePlatforms(cfg.Platforms)
+	if err != nil {
+		return err
+	}
+	if cfg.Artifact && len(platforms) > 0 {
+		return fmt.Errorf("%w: package artifacts are platform agnostic and can not be built for platforms",
+			ErrInvalidOptions)
+	}
+
+	sbomFormat := packages.SBOMFormat(cfg.SBOM)
+	if cfg.SBOM != "" && sbomFormat.ArtifactType() == "" {
+		return fmt.Errorf("%w: %w: %q", ErrInvalidOptions, packages.ErrUnknownSBOMFormat, cfg.SBOM)
+	}
+
 	rawPkg, err := getPackageFromPath(ctx, srcPath)
 	if err != nil {
 		return fmt.Errorf("load source from disk path %s: %w", srcPath, err)
@@ -76,6 +93,9 @@ func (b *Build) BuildFromSource(ctx context.Context, srcPath string, opts ...Bui
 	if err != nil {
 		return fmt.Errorf("loading package from files: %w", err)
 	}
+	if cfg.Strict {
+		enableStrictRendering(pkg)
+	}
 
 	var craneOpts []crane.Option
 	if cfg.Insecure {
@@ -95,28 +115,109 @@ func (b *Build) BuildFromSource(ctx context.Context, srcPath string, opts ...Bui
 		return fmt.Errorf("loading package from files: %w", err)
 	}
 
+	var sbom []byte
+	if cfg.SBOM != "" {
+		b.cfg.Log.Info("generating SBOM", "format", cfg.SBOM)
+
+		if sbom, err = packages.SBOM(pkg, sbomFormat, time.Now()); err != nil {
+			return fmt.Errorf("generating SBOM: %w", err)
+		}
+	}
+
 	if cfg.OutputPath != "" {
 		b.cfg.Log.Info("writing tagged image to disk", "path", cfg.OutputPath)
 
-		if err := packages.ToOCIFile(cfg.OutputPath, cfg.Tags, rawPkg); err != nil {
+		if err := exportToFile(cfg.OutputPath, cfg.Tags, rawPkg, platforms, cfg.Artifact); err != nil {
 			return fmt.Errorf("exporting package to file: %w", err)
 		}
+
+		if sbom != nil {
+			if err := os.WriteFile(SBOMOutputPath(cfg.OutputPath), sbom, 0o600); err != nil {
+				return fmt.Errorf("writing SBOM to file: %w", err)
+			}
+		}
 	}
 
 	if cfg.Push {
-		if err := packages.ToPushedOCI(ctx, cfg.Tags, rawPkg, craneOpts...); err != nil {
+		if err := exportToRegistry(ctx, cfg.Tags, rawPkg, platforms, cfg.Artifact, craneOpts...); err != nil {
 			return fmt.Errorf("exporting package to image: %w", err)
 		}
+
+		if sbom != nil {
+			if err := packages.AttachSBOM(ctx, cfg.Tags, sbom, sbomFormat, craneOpts...); err != nil {
+				return fmt.Errorf("attaching SBOM: %w", err)
+			}
+		}
 	}
 
 	return nil
 }
 
+// SBOMOutputPath returns where the SBOM is written to, when exporting a package image to disk.
+func SBOMOutputPath(outputPath string) string {
+	return outputPath + ".sbom.json"
+}
+
+// Without explicit platforms a single linux/amd64 image is exported,
+// otherwise an image index holding one image per platform.
+// Package artifacts are exported instead of images, if requested.
+func exportToFile(
+	dst string, tags []string, rawPkg *packages.RawPackage,
+	platforms []containerregistrypkgv1.Platform, artifact bool,
+) error {
+	if artifact {
+		return packages.ToOCIArtifactFile(dst, tags, rawPkg)
+	}
+	if len(platforms) == 0 {
+		return packages.ToOCIFile(dst, tags, rawPkg)
+	}
+
+	return packages.ToOCIIndexFile(dst, tags, rawPkg, platforms)
+}
+
+func exportToRegistry(
+	ctx context.Context, refs []string, rawPkg *packages.RawPackage,
+	platforms []containerregistrypkgv1.Platform, artifact bool, craneOpts ...crane.Option,
+) error {
+	if artifact {
+		return packages.ToPushedOCIArtifact(ctx, refs, rawPkg, craneOpts...)
+	}
+	if len(platforms) == 0 {
+		return packages.ToPushedOCI(ctx, refs, rawPkg, craneOpts...)
+	}
+
+	return packages.ToPushedOCIIndex(ctx, refs, rawPkg, platforms, craneOpts...)
+}
+
+func parsePlatforms(in []string) ([]containerregistrypkgv1.Platform, error) {
+	platforms := make([]containerregistrypkgv1.Platform, 0, len(in))
+	for _, p := range in {
+		platform, err := containerregistrypkgv1.ParsePlatform(p)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid platform %q: %w", ErrInvalidOptions, p, err)
+		}
+		platforms = append(platforms, *platform)
+	}
+
+	return platforms, nil
+}
+
 type BuildFromSourceConfig struct {
 	Insecure   bool
 	OutputPath string
 	Tags       []string
 	Push       bool
+	// Platforms to build an image index for, e.g. "linux/arm64".
+	// A single linux/amd64 image is built when empty.
+	Platforms []string
+	// SBOM format to generate, either "spdx" or "cyclonedx".
+	// The SBOM is attached to pushed images as OCI referrer.
+	SBOM string
+	// Enforce strict rendering, even if not enabled in the PackageManifest.
+	Strict bool
+	// Build an OCI artifact of type application/vnd.package-operator.package,
+	// instead of a container image.
+	Artifact bool
 }
 
 func (c *BuildFromSourceConfig) Option(opts ...BuildFromSourceOption) {
@@ -16,6 +16,7 @@ import (
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/utils"
 )
 
 func NewClient(client client.Client) *Client {
@@ -231,6 +232,22 @@ func (d *ObjectDeployment) CurrentRevision() int64 {
 	return d.obj.(*corev1alpha1.ObjectDeployment).Status.Revision
 }
 
+func (d *ObjectDeployment) Phase() corev1alpha1.ObjectDeploymentPhase {
+	if cod, ok := d.obj.(*corev1alpha1.ClusterObjectDeployment); ok {
+		return cod.Status.Phase
+	}
+
+	return d.obj.(*corev1alpha1.ObjectDeployment).Status.Phase
+}
+
+func (d *ObjectDeployment) Conditions() []metav1.Condition {
+	if cod, ok := d.obj.(*corev1alpha1.ClusterObjectDeployment); ok {
+		return cod.Status.Conditions
+	}
+
+	return d.obj.(*corev1alpha1.ObjectDeployment).Status.Conditions
+}
+
 func (d *ObjectDeployment) ObjectSets(ctx context.Context) (ObjectSetList, error) {
 	opts := []findObjectSetsOption{
 		withSelector{
@@ -256,34 +273,40 @@ func findObjectSets(ctx context.Context, c client.Client, opts ...findObjectSets
 		},
 	}
 
+	revs := ObjectSetList{}
+
 	if cfg.Namespace != "" {
 		listOpts = append(listOpts, client.InNamespace(cfg.Namespace))
 
-		var sets corev1alpha1.ObjectSetList
-
-		if err := c.List(ctx, &sets, listOpts...); err != nil {
+		if err := utils.ListPages(
+			ctx, c, func() *corev1alpha1.ObjectSetList { return &corev1alpha1.ObjectSetList{} },
+			utils.DefaultListPageSize,
+			func(sets *corev1alpha1.ObjectSetList) error {
+				for i := range sets.Items {
+					revs = append(revs, NewObjectSet(&sets.Items[i]))
+				}
+				return nil
+			}, listOpts...,
+		); err != nil {
 			return nil, fmt.Errorf("listing ObjectSets: %w", err)
 		}
 
-		revs := make(ObjectSetList, 0, len(sets.Items))
-		for i := range sets.Items {
-			revs = append(revs, NewObjectSet(&sets.Items[i]))
-		}
-
 		return revs, nil
 	}
 
-	var sets corev1alpha1.ClusterObjectSetList
-
-	if err := c.List(ctx, &sets, listOpts...); err != nil {
+	if err := utils.ListPages(
+		ctx, c, func() *corev1alpha1.ClusterObjectSetList { return &corev1alpha1.ClusterObjectSetList{} },
+		utils.DefaultListPageSize,
+		func(sets *corev1alpha1.ClusterObjectSetList) error {
+			for i := range sets.Items {
+				revs = append(revs, NewObjectSet(&sets.Items[i]))
+			}
+			return nil
+		}, listOpts...,
+	); err != nil {
 		return nil, fmt.Errorf("listing ClusterObjectSets: %w", err)
 	}
 
-	revs := make(ObjectSetList, 0, len(sets.Items))
-	for i := range sets.Items {
-		revs = append(revs, NewObjectSet(&sets.Items[i]))
-	}
-
 	return revs, nil
 }
 
@@ -336,6 +359,46 @@ func (s *ObjectSet) HasSucceeded() bool {
 	return meta.IsStatusConditionTrue(s.getConditions(), corev1alpha1.ObjectSetSucceeded)
 }
 
+func (s *ObjectSet) IsArchived() bool {
+	return s.LifecycleState() == corev1alpha1.ObjectSetLifecycleStateArchived
+}
+
+func (s *ObjectSet) LifecycleState() corev1alpha1.ObjectSetLifecycleState {
+	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
+		return cos.Spec.LifecycleState
+	}
+
+	return s.obj.(*corev1alpha1.ObjectSet).Spec.LifecycleState
+}
+
+func (s *ObjectSet) Phase() corev1alpha1.ObjectSetStatusPhase {
+	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
+		return cos.Status.Phase
+	}
+
+	return s.obj.(*corev1alpha1.ObjectSet).Status.Phase
+}
+
+func (s *ObjectSet) Phases() []corev1alpha1.ObjectSetTemplatePhase {
+	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
+		return cos.Spec.Phases
+	}
+
+	return s.obj.(*corev1alpha1.ObjectSet).Spec.Phases
+}
+
+func (s *ObjectSet) ControllerOf() []corev1alpha1.ControlledObjectReference {
+	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
+		return cos.Status.ControllerOf
+	}
+
+	return s.obj.(*corev1alpha1.ObjectSet).Status.ControllerOf
+}
+
+func (s *ObjectSet) Conditions() []metav1.Condition {
+	return s.getConditions()
+}
+
 func (s *ObjectSet) getConditions() []metav1.Condition {
 	if cos, ok := s.obj.(*corev1alpha1.ClusterObjectSet); ok {
 		return cos.Status.Conditions
@@ -0,0 +1,378 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apidocs"
+	"package-operator.run/internal/packages"
+)
+
+func NewExplain() *Explain {
+	return &Explain{}
+}
+
+// Explain documents the PackageManifest schema, the template context, annotations
+// and template functions. Documentation is read from the Go types at runtime,
+// so it always matches the version of the binary.
+type Explain struct{}
+
+type explainTopic struct {
+	Name        string
+	Description string
+	// Package and type the topic is documented by, empty for topics that are no types.
+	Package string
+	Type    string
+}
+
+var explainTopics = []explainTopic{
+	{
+		Name:        "manifest",
+		Description: "PackageManifest declared in the manifest.yaml file of a package.",
+		Package:     "manifestsv1alpha1",
+		Type:        "PackageManifest",
+	},
+	{
+		Name:        "manifestlock",
+		Description: "PackageManifestLock declared in the manifest.lock.yaml file of a package.",
+		Package:     "manifestsv1alpha1",
+		Type:        "PackageManifestLock",
+	},
+	{
+		Name:        "context",
+		Description: "Context available to .gotmpl files and CEL expressions while rendering a package.",
+		Package:     "packagetypes",
+		Type:        "PackageRenderContext",
+	},
+	{
+		Name:        "annotations",
+		Description: "Annotations and labels interpreted by Package Operator on package objects.",
+	},
+	{
+		Name:        "functions",
+		Description: "Functions available in .gotmpl files.",
+	},
+}
+
+// Annotations that accept one of the values of an enum type.
+var explainAnnotationValueTypes = map[string]string{
+	manifestsv1alpha1.PackageCollisionProtectionAnnotation:   "CollisionProtection",
+	manifestsv1alpha1.PackageDeletionPolicyAnnotation:        "ObjectDeletionPolicy",
+	manifestsv1alpha1.PackageFieldConflictPolicyAnnotation:   "FieldConflictPolicy",
+	manifestsv1alpha1.PackageExternalMissingPolicyAnnotation: "ExternalObjectMissingPolicy",
+}
+
+const explainAnnotationPrefix = "package-operator.run/"
+
+// Explain documents the given path. Paths start with a topic, e.g. "manifest",
+// optionally followed by JSON field names, e.g. "manifest.spec.phases".
+// An empty path lists all topics.
+func (e *Explain) Explain(_ context.Context, path string) (*Explanation, error) {
+	if path == "" {
+		exp := &Explanation{Description: "Available topics, use a topic as path to explain it."}
+		for _, topic := range explainTopics {
+			exp.Values = append(exp.Values, ExplanationValue{Name: topic.Name, Description: topic.Description})
+		}
+
+		return exp, nil
+	}
+
+	docs, err := loadExplainDocs()
+	if err != nil {
+		return nil, fmt.Errorf("loading API documentation: %w", err)
+	}
+
+	segments := strings.Split(path, ".")
+
+	for _, topic := range explainTopics {
+		if topic.Name != segments[0] {
+			continue
+		}
+
+		switch topic.Name {
+		case "annotations":
+			return docs.explainAnnotations(topic, segments)
+		case "functions":
+			return explainFunctions(topic, segments)
+		default:
+			return docs.explainType(topic, segments)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown topic %q", ErrInvalidArgs, segments[0])
+}
+
+type explainDocs struct {
+	// Documented packages by the import name they are referenced with.
+	pkgs map[string]*apidocs.Package
+}
+
+func loadExplainDocs() (*explainDocs, error) {
+	manifests, err := apidocs.Load(manifestsv1alpha1.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	core, err := apidocs.Load(corev1alpha1.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := apidocs.Load(packages.PackageTypesSource)
+	if err != nil {
+		return nil, err
+	}
+
+	return &explainDocs{
+		pkgs: map[string]*apidocs.Package{
+			"manifestsv1alpha1": manifests,
+			// The internal manifests API mirrors the public v1alpha1 types.
+			"manifests":    manifests,
+			"corev1alpha1": core,
+			"packagetypes": types,
+		},
+	}, nil
+}
+
+// Resolves a type reference made from within the given package.
+// Returns nil for builtin and third party types.
+func (d *explainDocs) resolve(pkg string, ref apidocs.TypeRef) (*apidocs.Type, string) {
+	if ref.Package != "" {
+		pkg = ref.Package
+	}
+
+	docs, ok := d.pkgs[pkg]
+	if !ok {
+		return nil, ""
+	}
+
+	t, ok := docs.Types[ref.Name]
+	if !ok {
+		return nil, ""
+	}
+
+	return t, pkg
+}
+
+type explainField struct {
+	apidocs.Field
+	// Package the field is declared in.
+	pkg string
+}
+
+// Returns the fields of t including the fields of inlined types.
+func (d *explainDocs) fields(pkg string, t *apidocs.Type) []explainField {
+	var fields []explainField
+	for _, f := range t.Fields {
+		if !f.Inline {
+			fields = append(fields, explainField{Field: f, pkg: pkg})
+			continue
+		}
+
+		// Inlined third party types, like metav1.TypeMeta, are not documented.
+		if inline, inlinePkg := d.resolve(pkg, f.Type); inline != nil {
+			fields = append(fields, d.fields(inlinePkg, inline)...)
+		}
+	}
+
+	return fields
+}
+
+func (d *explainDocs) explainType(topic explainTopic, segments []string) (*Explanation, error) {
+	t, pkg := d.resolve(topic.Package, apidocs.TypeRef{Name: topic.Type})
+	if t == nil {
+		return nil, fmt.Errorf("%w: type %s not found", ErrInvalidArgs, topic.Type)
+	}
+
+	exp := &Explanation{
+		Path:        topic.Name,
+		Type:        t.Name,
+		Description: topic.Description,
+	}
+
+	for _, segment := range segments[1:] {
+		if t == nil {
+			return nil, fmt.Errorf("%w: %s has no fields", ErrInvalidArgs, exp.Path)
+		}
+
+		var field *explainField
+		for _, f := range d.fields(pkg, t) {
+			if f.Name == segment {
+				field = &f
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("%w: field %q not found in %s", ErrInvalidArgs, segment, exp.Path)
+		}
+
+		t, pkg = d.resolve(field.pkg, field.Type)
+		exp = &Explanation{
+			Path:        exp.Path + "." + segment,
+			Type:        field.Type.String(),
+			Description: field.Doc.Text,
+			Examples:    field.Doc.Examples,
+		}
+		if exp.Description == "" && t != nil {
+			exp.Description = t.Doc.Text
+		}
+	}
+
+	if t == nil {
+		return exp, nil
+	}
+
+	for _, f := range d.fields(pkg, t) {
+		exp.Fields = append(exp.Fields, ExplanationField{
+			Name:        f.Name,
+			Type:        f.Type.String(),
+			Description: f.Doc.Text,
+			Optional:    f.Optional,
+		})
+	}
+	for _, v := range t.Values {
+		exp.Values = append(exp.Values, ExplanationValue{Name: v.Value, Description: v.Doc.Text})
+	}
+
+	return exp, nil
+}
+
+func (d *explainDocs) explainAnnotations(topic explainTopic, segments []string) (*Explanation, error) {
+	if len(segments) > 1 {
+		return nil, fmt.Errorf("%w: %s has no fields", ErrInvalidArgs, topic.Name)
+	}
+
+	exp := &Explanation{Path: topic.Name, Description: topic.Description}
+
+	for _, c := range d.pkgs["manifestsv1alpha1"].Constants {
+		if !strings.HasPrefix(c.Value, explainAnnotationPrefix) {
+			continue
+		}
+
+		desc := c.Doc.Text
+		if typeName, ok := explainAnnotationValueTypes[c.Value]; ok {
+			if t := d.pkgs["corev1alpha1"].Types[typeName]; t != nil {
+				desc += "\nValues:"
+				for _, v := range t.Values {
+					desc += fmt.Sprintf("\n- %s: %s", v.Value, v.Doc.Text)
+				}
+			}
+		}
+
+		exp.Values = append(exp.Values, ExplanationValue{Name: c.Value, Description: desc})
+	}
+
+	return exp, nil
+}
+
+func explainFunctions(topic explainTopic, segments []string) (*Explanation, error) {
+	if len(segments) > 1 {
+		return nil, fmt.Errorf("%w: %s has no fields", ErrInvalidArgs, topic.Name)
+	}
+
+	exp := &Explanation{Path: topic.Name, Description: topic.Description}
+	for _, fn := range packages.TemplateFunctions() {
+		exp.Values = append(exp.Values, ExplanationValue{Name: fn.Name, Description: fn.Description})
+	}
+
+	return exp, nil
+}
+
+// Explanation documents a topic or field.
+type Explanation struct {
+	Path        string             `json:"path,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Examples    []string           `json:"examples,omitempty"`
+	Fields      []ExplanationField `json:"fields,omitempty"`
+	Values      []ExplanationValue `json:"values,omitempty"`
+}
+
+type ExplanationField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Optional    bool   `json:"optional,omitempty"`
+}
+
+type ExplanationValue struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+func (e *Explanation) RenderJSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "    ")
+}
+
+func (e *Explanation) RenderYAML() ([]byte, error) {
+	return yaml.Marshal(e)
+}
+
+// RenderText renders the explanation similar to kubectl explain.
+func (e *Explanation) RenderText() string {
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	if e.Path != "" {
+		fmt.Fprintf(w, "PATH:\t%s\n", e.Path)
+	}
+	if e.Type != "" {
+		fmt.Fprintf(w, "TYPE:\t%s\n", e.Type)
+	}
+	_ = w.Flush()
+
+	if e.Description != "" {
+		fmt.Fprintf(&buf, "\nDESCRIPTION:\n%s\n", indentText(e.Description, "    "))
+	}
+
+	if len(e.Examples) > 0 {
+		fmt.Fprintln(&buf, "\nEXAMPLES:")
+		for _, example := range e.Examples {
+			fmt.Fprintf(&buf, "    %s\n", example)
+		}
+	}
+
+	if len(e.Fields) > 0 {
+		fmt.Fprintln(&buf, "\nFIELDS:")
+	}
+	for _, f := range e.Fields {
+		optional := ""
+		if f.Optional {
+			optional = " (optional)"
+		}
+		fmt.Fprintf(&buf, "    %s <%s>%s\n", f.Name, f.Type, optional)
+		if f.Description != "" {
+			fmt.Fprintf(&buf, "%s\n", indentText(f.Description, "        "))
+		}
+	}
+
+	if len(e.Values) > 0 {
+		fmt.Fprintln(&buf, "\nVALUES:")
+	}
+	for _, v := range e.Values {
+		fmt.Fprintf(&buf, "    %s\n", v.Name)
+		if v.Description != "" {
+			fmt.Fprintf(&buf, "%s\n", indentText(v.Description, "        "))
+		}
+	}
+
+	return buf.String()
+}
+
+func indentText(text, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = indent + lines[i]
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+func TestExplain_Topics(t *testing.T) {
+	t.Parallel()
+
+	exp, err := NewExplain().Explain(context.Background(), "")
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(exp.Values))
+	for _, v := range exp.Values {
+		names = append(names, v.Name)
+	}
+	assert.Equal(t, []string{"manifest", "manifestlock", "context", "annotations", "functions"}, names)
+}
+
+func TestExplain_Fields(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Path           string
+		ExpectedType   string
+		ExpectedFields []string
+		ExpectedValues []string
+	}{
+		"manifest": {
+			Path:           "manifest",
+			ExpectedType:   "PackageManifest",
+			ExpectedFields: []string{"metadata", "spec", "test"},
+		},
+		"phases": {
+			Path:           "manifest.spec.phases",
+			ExpectedType:   "[]PackageManifestPhase",
+			ExpectedFields: []string{"name", "class"},
+		},
+		"scopes": {
+			Path:           "manifest.spec.scopes",
+			ExpectedType:   "[]PackageManifestScope",
+			ExpectedValues: []string{"Cluster", "Namespaced"},
+		},
+		"probes from core API": {
+			Path:           "manifest.spec.availabilityProbes",
+			ExpectedType:   "[]corev1alpha1.ObjectSetProbe",
+			ExpectedFields: []string{"probes", "selector"},
+		},
+		"context": {
+			Path:           "context",
+			ExpectedType:   "PackageRenderContext",
+			ExpectedFields: []string{"package", "config", "images", "environment"},
+		},
+		"embedded metadata": {
+			Path:           "context.package.metadata",
+			ExpectedType:   "TemplateContextObjectMeta",
+			ExpectedFields: []string{"name", "namespace", "labels", "annotations"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			exp, err := NewExplain().Explain(context.Background(), tc.Path)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.Path, exp.Path)
+			assert.Equal(t, tc.ExpectedType, exp.Type)
+
+			fields := make([]string, 0, len(exp.Fields))
+			for _, f := range exp.Fields {
+				fields = append(fields, f.Name)
+			}
+			for _, f := range tc.ExpectedFields {
+				assert.Contains(t, fields, f)
+			}
+
+			values := make([]string, 0, len(exp.Values))
+			for _, v := range exp.Values {
+				values = append(values, v.Name)
+			}
+			assert.ElementsMatch(t, tc.ExpectedValues, values)
+		})
+	}
+}
+
+func TestExplain_FieldDocumentation(t *testing.T) {
+	t.Parallel()
+
+	exp, err := NewExplain().Explain(context.Background(), "manifest.spec.phases.class")
+	require.NoError(t, err)
+
+	assert.Equal(t, "string", exp.Type)
+	assert.Contains(t, exp.Description, "phase reconciliation is delegated to another controller")
+	assert.Equal(t, []string{"hosted-cluster"}, exp.Examples)
+	assert.NotContains(t, exp.RenderText(), "+example")
+}
+
+func TestExplain_Annotations(t *testing.T) {
+	t.Parallel()
+
+	exp, err := NewExplain().Explain(context.Background(), "annotations")
+	require.NoError(t, err)
+
+	descriptions := map[string]string{}
+	for _, v := range exp.Values {
+		descriptions[v.Name] = v.Description
+	}
+
+	for _, annotation := range []string{
+		manifestsv1alpha1.PackagePhaseAnnotation,
+		manifestsv1alpha1.PackageConditionMapAnnotation,
+		manifestsv1alpha1.PackageCELConditionAnnotation,
+		manifestsv1alpha1.PackageCollisionProtectionAnnotation,
+	} {
+		assert.NotEmpty(t, descriptions[annotation], annotation)
+	}
+	assert.Contains(t, descriptions[manifestsv1alpha1.PackageCollisionProtectionAnnotation], "IfNoController")
+}
+
+func TestExplain_Functions(t *testing.T) {
+	t.Parallel()
+
+	exp, err := NewExplain().Explain(context.Background(), "functions")
+	require.NoError(t, err)
+
+	names := map[string]struct{}{}
+	for _, v := range exp.Values {
+		names[v.Name] = struct{}{}
+	}
+	assert.Contains(t, names, "cel")
+	assert.Contains(t, names, "getFile")
+}
+
+func TestExplain_InvalidPath(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{
+		"banana",
+		"manifest.spec.banana",
+		"manifest.spec.phases.name.banana",
+		"functions.cel",
+	} {
+		_, err := NewExplain().Explain(context.Background(), path)
+		require.ErrorIs(t, err, ErrInvalidArgs, path)
+	}
+}
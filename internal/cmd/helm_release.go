@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"pkg.package-operator.run/cardboard/kubeutils/kubemanifests"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Keeps objects in place, when the Helm release is uninstalled.
+	helmResourcePolicyAnnotation = "helm.sh/resource-policy"
+	helmReleaseStatusLabel       = "status"
+	helmReleaseStatusSuperseded  = "superseded"
+)
+
+var (
+	ErrHelmReleaseNotFound = errors.New("deployed helm release not found")
+
+	gzipMagic = []byte{0x1f, 0x8b, 0x08}
+)
+
+// SupersedeHelmRelease hands the objects of a deployed Helm release over to Package Operator.
+// The objects are kept in place when the release is uninstalled later on,
+// and the release is marked as superseded, so Helm no longer considers it deployed.
+// Returns the number of objects handed over.
+func (c *Client) SupersedeHelmRelease(ctx context.Context, release, namespace string) (int, error) {
+	secrets := &corev1.SecretList{}
+	if err := c.client.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabels{
+		"owner":                "helm",
+		"name":                 release,
+		helmReleaseStatusLabel: "deployed",
+	}); err != nil {
+		return 0, fmt.Errorf("listing helm release secrets: %w", err)
+	}
+	if len(secrets.Items) != 1 {
+		return 0, fmt.Errorf("%w: %s/%s", ErrHelmReleaseNotFound, namespace, release)
+	}
+	secret := &secrets.Items[0]
+
+	rel, err := decodeHelmRelease(secret.Data["release"])
+	if err != nil {
+		return 0, fmt.Errorf("decoding helm release %s/%s: %w", namespace, release, err)
+	}
+	manifest, _ := rel["manifest"].(string)
+	objects, err := kubemanifests.LoadKubernetesObjectsFromBytes([]byte(manifest))
+	if err != nil {
+		return 0, fmt.Errorf("loading release manifest: %w", err)
+	}
+
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:"keep"}}}`, helmResourcePolicyAnnotation))
+	for i := range objects {
+		obj := &objects[i]
+		if len(obj.GetNamespace()) == 0 {
+			// Only used for namespaced objects.
+			obj.SetNamespace(namespace)
+		}
+		if err := c.client.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch)); err != nil {
+			return 0, fmt.Errorf("keeping %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	info, _ := rel["info"].(map[string]any)
+	if info == nil {
+		info = map[string]any{}
+		rel["info"] = info
+	}
+	info["status"] = helmReleaseStatusSuperseded
+	info["description"] = "Superseded by Package Operator"
+	if secret.Data["release"], err = encodeHelmRelease(rel); err != nil {
+		return 0, fmt.Errorf("encoding helm release %s/%s: %w", namespace, release, err)
+	}
+	secret.Labels[helmReleaseStatusLabel] = helmReleaseStatusSuperseded
+	if err := c.client.Update(ctx, secret); err != nil {
+		return 0, fmt.Errorf("marking helm release %s/%s as superseded: %w", namespace, release, err)
+	}
+	return len(objects), nil
+}
+
+// Helm stores releases as base64 encoded and usually gzipped JSON.
+// Unknown fields are preserved, by decoding into a map.
+func decodeHelmRelease(data []byte) (map[string]any, error) {
+	b, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(b, gzipMagic) {
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		if b, err = io.ReadAll(r); err != nil {
+			return nil, err
+		}
+	}
+
+	rel := map[string]any{}
+	if err := json.Unmarshal(b, &rel); err != nil {
+		return nil, err
+	}
+	return rel, nil
+}
+
+func encodeHelmRelease(rel map[string]any) ([]byte, error) {
+	b, err := json.Marshal(rel)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClient_SupersedeHelmRelease(t *testing.T) {
+	t.Parallel()
+
+	rel, err := encodeHelmRelease(map[string]any{
+		"name":     "web",
+		"version":  float64(2),
+		"info":     map[string]any{"status": "deployed"},
+		"manifest": "---\n# Source: web/templates/cm.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\n",
+	})
+	require.NoError(t, err)
+
+	releaseSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sh.helm.release.v1.web.v2",
+			Namespace: "web",
+			Labels:    map[string]string{"owner": "helm", "name": "web", "status": "deployed", "version": "2"},
+		},
+		Type: "helm.sh/release.v1",
+		Data: map[string][]byte{"release": rel},
+	}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "web"}}
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(releaseSecret, cm).Build()
+	c := NewClient(fakeClient)
+
+	_, err = c.SupersedeHelmRelease(context.Background(), "other", "web")
+	require.ErrorIs(t, err, ErrHelmReleaseNotFound)
+
+	n, err := c.SupersedeHelmRelease(context.Background(), "web", "web")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(cm), cm))
+	assert.Equal(t, "keep", cm.Annotations["helm.sh/resource-policy"])
+
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(releaseSecret), releaseSecret))
+	assert.Equal(t, "superseded", releaseSecret.Labels["status"])
+	updated, err := decodeHelmRelease(releaseSecret.Data["release"])
+	require.NoError(t, err)
+	assert.Equal(t, "superseded", updated["info"].(map[string]any)["status"])
+	assert.Equal(t, float64(2), updated["version"])
+
+	// Superseded releases are not handed over twice.
+	_, err = c.SupersedeHelmRelease(context.Background(), "web", "web")
+	require.ErrorIs(t, err, ErrHelmReleaseNotFound)
+}
@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/packages"
+)
+
+func NewInspect(opts ...InspectOption) *Inspect {
+	var cfg InspectConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Inspect{
+		cfg: cfg,
+	}
+}
+
+type Inspect struct {
+	cfg InspectConfig
+}
+
+type InspectConfig struct {
+	Log  logr.Logger
+	Pull PullFn
+}
+
+func (c *InspectConfig) Option(opts ...InspectOption) {
+	for _, opt := range opts {
+		opt.ConfigureInspect(c)
+	}
+}
+
+func (c *InspectConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+	if c.Pull == nil {
+		c.Pull = packages.FromRegistry
+	}
+}
+
+type InspectOption interface {
+	ConfigureInspect(*InspectConfig)
+}
+
+// InspectPackage pulls the package image from the given reference and summarizes its contents.
+// Nothing is installed or rendered.
+func (i *Inspect) InspectPackage(
+	ctx context.Context, ref string, opts ...InspectPackageOption,
+) (*PackageInspection, error) {
+	var cfg InspectPackageConfig
+
+	cfg.Option(opts...)
+
+	parsedRef, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parsing reference: %w", ErrInvalidArgs, err)
+	}
+
+	craneOpts, err := registryOptions(cfg.Insecure, cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	i.cfg.Log.Info("pulling image", "reference", parsedRef.String())
+
+	rawPkg, err := i.cfg.Pull(ctx, parsedRef.String(), craneOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("importing package from image: %w", err)
+	}
+
+	pkg, err := packages.DefaultStructuralLoader.Load(ctx, rawPkg)
+	if err != nil {
+		return nil, fmt.Errorf("loading package from files: %w", err)
+	}
+
+	return newPackageInspection(parsedRef.String(), pkg), nil
+}
+
+type InspectPackageConfig struct {
+	Insecure bool
+	// Path to a PEM encoded CA bundle used to verify the registry certificate.
+	CAFile string
+}
+
+func (c *InspectPackageConfig) Option(opts ...InspectPackageOption) {
+	for _, opt := range opts {
+		opt.ConfigureInspectPackage(c)
+	}
+}
+
+type InspectPackageOption interface {
+	ConfigureInspectPackage(*InspectPackageConfig)
+}
+
+// PackageInspection summarizes what a package would deploy and how it can be configured.
+type PackageInspection struct {
+	Reference  string            `json:"reference"`
+	Name       string            `json:"name"`
+	Scopes     []string          `json:"scopes"`
+	Phases     []InspectedPhase  `json:"phases,omitempty"`
+	Images     []InspectedImage  `json:"images,omitempty"`
+	Config     []ConfigParameter `json:"config,omitempty"`
+	Probes     []InspectedProbe  `json:"probes,omitempty"`
+	Components []string          `json:"components,omitempty"`
+}
+
+type InspectedPhase struct {
+	Name string `json:"name"`
+	// Non-empty if reconciliation of this phase is delegated to another controller.
+	Class string `json:"class,omitempty"`
+}
+
+type InspectedImage struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	// Digest the image is pinned to by the manifest lock, if present.
+	Digest string `json:"digest,omitempty"`
+}
+
+// ConfigParameter is a single leaf of the packages config schema.
+type ConfigParameter struct {
+	// Dot separated path into the config, "[]" denotes array items.
+	Path        string `json:"path"`
+	Type        string `json:"type,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type InspectedProbe struct {
+	// Objects the probe is applied to, e.g. "apps/Deployment".
+	Selector string `json:"selector"`
+	// Human readable description of each probe.
+	Probes []string `json:"probes"`
+}
+
+func newPackageInspection(ref string, pkg *packages.Package) *PackageInspection {
+	spec := pkg.Manifest.Spec
+
+	insp := &PackageInspection{
+		Reference: ref,
+		Name:      pkg.Manifest.Name,
+	}
+
+	for _, scope := range spec.Scopes {
+		insp.Scopes = append(insp.Scopes, string(scope))
+	}
+	for _, phase := range spec.Phases {
+		insp.Phases = append(insp.Phases, InspectedPhase{Name: phase.Name, Class: phase.Class})
+	}
+
+	digests := map[string]string{}
+	if pkg.ManifestLock != nil {
+		for _, img := range pkg.ManifestLock.Spec.Images {
+			digests[img.Name] = img.Digest
+		}
+	}
+	for _, img := range spec.Images {
+		insp.Images = append(insp.Images, InspectedImage{
+			Name:   img.Name,
+			Image:  img.Image,
+			Digest: digests[img.Name],
+		})
+	}
+
+	if schema := spec.Config.OpenAPIV3Schema; schema != nil {
+		insp.Config = flattenConfigSchema("", schema, false)
+	}
+
+	for _, probe := range spec.AvailabilityProbes {
+		insp.Probes = append(insp.Probes, newInspectedProbe(probe))
+	}
+
+	for _, comp := range pkg.Components {
+		insp.Components = append(insp.Components, comp.Manifest.Name)
+	}
+
+	return insp
+}
+
+// Walks the schema depth first and returns all leaf properties, sorted by path.
+func flattenConfigSchema(path string, schema *apiextensions.JSONSchemaProps, required bool) []ConfigParameter {
+	switch {
+	case len(schema.Properties) > 0:
+		var params []ConfigParameter
+
+		for _, prop := range sortedKeys(schema.Properties) {
+			propSchema := schema.Properties[prop]
+			params = append(params, flattenConfigSchema(
+				joinConfigPath(path, prop), &propSchema, slices.Contains(schema.Required, prop))...)
+		}
+
+		return params
+	case schema.Items != nil && schema.Items.Schema != nil:
+		return flattenConfigSchema(path+"[]", schema.Items.Schema, false)
+	case path == "":
+		return nil
+	}
+
+	param := ConfigParameter{
+		Path:        path,
+		Type:        schema.Type,
+		Required:    required,
+		Description: schema.Description,
+	}
+	if schema.Default != nil {
+		if b, err := json.Marshal(*schema.Default); err == nil {
+			param.Default = string(b)
+		}
+	}
+
+	return []ConfigParameter{param}
+}
+
+func joinConfigPath(path, prop string) string {
+	if path == "" {
+		return prop
+	}
+
+	return path + "." + prop
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func newInspectedProbe(probe corev1alpha1.ObjectSetProbe) InspectedProbe {
+	var selector string
+
+	if kind := probe.Selector.Kind; kind != nil {
+		selector = kind.Kind
+		if kind.Group != "" {
+			selector = kind.Group + "/" + kind.Kind
+		}
+	}
+	if probe.Selector.Selector != nil {
+		selector += " " + metav1.FormatLabelSelector(probe.Selector.Selector)
+	}
+
+	insp := InspectedProbe{Selector: selector}
+
+	for _, p := range probe.Probes {
+		switch {
+		case p.Condition != nil:
+			insp.Probes = append(insp.Probes,
+				fmt.Sprintf("condition %s == %s", p.Condition.Type, p.Condition.Status))
+		case p.FieldsEqual != nil:
+			insp.Probes = append(insp.Probes,
+				fmt.Sprintf("field %s == %s", p.FieldsEqual.FieldA, p.FieldsEqual.FieldB))
+		case p.CEL != nil:
+			insp.Probes = append(insp.Probes, fmt.Sprintf("cel %s", p.CEL.Rule))
+		case p.JobCompletion != nil:
+			insp.Probes = append(insp.Probes, "job completion")
+		}
+	}
+
+	return insp
+}
+
+func (i *PackageInspection) RenderJSON() ([]byte, error) {
+	return json.MarshalIndent(i, "", "    ")
+}
+
+func (i *PackageInspection) RenderYAML() ([]byte, error) {
+	return yaml.Marshal(i)
+}
+
+// RenderText renders a human readable summary.
+func (i *PackageInspection) RenderText() string {
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Package %s\n", i.Name)
+	fmt.Fprintf(w, "Reference:\t%s\n", i.Reference)
+	fmt.Fprintf(w, "Scopes:\t%s\n", strings.Join(i.Scopes, ", "))
+
+	if len(i.Components) > 0 {
+		fmt.Fprintf(w, "Components:\t%s\n", strings.Join(i.Components, ", "))
+	}
+
+	if len(i.Phases) > 0 {
+		fmt.Fprintln(w, "\nPhases:")
+	}
+	for _, phase := range i.Phases {
+		if phase.Class != "" {
+			fmt.Fprintf(w, "  %s\t(remote: %s)\n", phase.Name, phase.Class)
+			continue
+		}
+		fmt.Fprintf(w, "  %s\n", phase.Name)
+	}
+
+	if len(i.Images) > 0 {
+		fmt.Fprintln(w, "\nImages:")
+		fmt.Fprintln(w, "  NAME\tIMAGE\tDIGEST")
+	}
+	for _, img := range i.Images {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", img.Name, img.Image, valueOrNone(img.Digest))
+	}
+
+	if len(i.Config) > 0 {
+		fmt.Fprintln(w, "\nConfig:")
+		fmt.Fprintln(w, "  PATH\tTYPE\tREQUIRED\tDEFAULT\tDESCRIPTION")
+	}
+	for _, param := range i.Config {
+		fmt.Fprintf(w, "  %s\t%s\t%t\t%s\t%s\n",
+			param.Path, param.Type, param.Required, valueOrNone(param.Default), param.Description)
+	}
+
+	if len(i.Probes) > 0 {
+		fmt.Fprintln(w, "\nAvailability Probes:")
+	}
+	for _, probe := range i.Probes {
+		fmt.Fprintf(w, "  %s\n", probe.Selector)
+		for _, p := range probe.Probes {
+			fmt.Fprintf(w, "    - %s\n", p)
+		}
+	}
+
+	_ = w.Flush()
+
+	return buf.String()
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+
+	return s
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages"
+)
+
+const inspectTestManifest = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Namespaced
+  phases:
+  - name: deploy
+  - name: remote
+    class: hosted-cluster
+  images:
+  - name: stub
+    image: quay.io/package-operator/test-stub:v1.0.0
+  availabilityProbes:
+  - probes:
+    - condition:
+        type: Available
+        status: "True"
+    selector:
+      kind:
+        group: apps
+        kind: Deployment
+  config:
+    openAPIV3Schema:
+      type: object
+      required: [replicas]
+      properties:
+        replicas:
+          type: integer
+          description: Number of replicas.
+          default: 1
+        labels:
+          type: array
+          items:
+            type: string
+        resources:
+          type: object
+          properties:
+            cpu:
+              type: string
+`
+
+func TestInspect_InspectPackage(t *testing.T) {
+	t.Parallel()
+
+	var pulledRef string
+
+	inspect := NewInspect(WithPuller{Pull: func(
+		_ context.Context, ref string, _ ...crane.Option,
+	) (*packages.RawPackage, error) {
+		pulledRef = ref
+
+		return &packages.RawPackage{
+			Files: packages.Files{
+				"manifest.yaml": []byte(inspectTestManifest),
+			},
+		}, nil
+	}})
+
+	insp, err := inspect.InspectPackage(context.Background(), "quay.io/package-operator/test-stub-package:v1.0.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, "quay.io/package-operator/test-stub-package:v1.0.0", pulledRef)
+	assert.Equal(t, "test-stub", insp.Name)
+	assert.Equal(t, []string{"Namespaced"}, insp.Scopes)
+	assert.Equal(t, []InspectedPhase{{Name: "deploy"}, {Name: "remote", Class: "hosted-cluster"}}, insp.Phases)
+	assert.Equal(t, []InspectedImage{{Name: "stub", Image: "quay.io/package-operator/test-stub:v1.0.0"}}, insp.Images)
+	assert.Equal(t, []ConfigParameter{
+		{Path: "labels[]", Type: "string"},
+		{Path: "replicas", Type: "integer", Required: true, Default: "1", Description: "Number of replicas."},
+		{Path: "resources.cpu", Type: "string"},
+	}, insp.Config)
+	assert.Equal(t, []InspectedProbe{{
+		Selector: "apps/Deployment",
+		Probes:   []string{"condition Available == True"},
+	}}, insp.Probes)
+
+	text := insp.RenderText()
+	assert.Contains(t, text, "Package test-stub")
+	assert.Contains(t, text, "replicas")
+	assert.Contains(t, text, "hosted-cluster")
+}
+
+func TestInspect_InspectPackageInvalidReference(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewInspect().InspectPackage(context.Background(), "bread:a:b")
+	require.ErrorIs(t, err, ErrInvalidArgs)
+}
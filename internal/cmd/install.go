@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// InstallPackage creates a new Package when a namespace is given or a ClusterPackage otherwise.
+func (c *Client) InstallPackage(
+	ctx context.Context, name, image string, opts ...InstallPackageOption,
+) (*Package, error) {
+	var cfg InstallPackageConfig
+
+	cfg.Option(opts...)
+
+	spec := corev1alpha1.PackageSpec{
+		Image:     image,
+		Config:    cfg.Config,
+		Component: cfg.Component,
+	}
+
+	var obj client.Object
+
+	if cfg.Namespace != "" {
+		obj = &corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: cfg.Namespace,
+			},
+			Spec: spec,
+		}
+	} else {
+		obj = &corev1alpha1.ClusterPackage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: spec,
+		}
+	}
+
+	if err := c.client.Create(ctx, obj); err != nil {
+		return nil, fmt.Errorf("creating package object: %w", err)
+	}
+
+	return &Package{
+		client: c.client,
+		obj:    obj,
+	}, nil
+}
+
+// UpgradePackage patches the image and, if given, the config and component of an existing (Cluster)Package.
+func (c *Client) UpgradePackage(
+	ctx context.Context, name, image string, opts ...InstallPackageOption,
+) (*Package, error) {
+	var cfg InstallPackageConfig
+
+	cfg.Option(opts...)
+
+	pkg, err := c.GetPackage(ctx, name, WithNamespace(cfg.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	patch := client.MergeFrom(pkg.obj.DeepCopyObject().(client.Object))
+
+	spec := pkg.spec()
+	spec.Image = image
+	if cfg.Config != nil {
+		spec.Config = cfg.Config
+	}
+	if cfg.Component != "" {
+		spec.Component = cfg.Component
+	}
+
+	if err := c.client.Patch(ctx, pkg.obj, patch); err != nil {
+		return nil, fmt.Errorf("patching package object: %w", err)
+	}
+
+	return pkg, nil
+}
+
+type InstallPackageConfig struct {
+	Namespace string
+	Config    *runtime.RawExtension
+	Component string
+}
+
+func (c *InstallPackageConfig) Option(opts ...InstallPackageOption) {
+	for _, opt := range opts {
+		opt.ConfigureInstallPackage(c)
+	}
+}
+
+type InstallPackageOption interface {
+	ConfigureInstallPackage(*InstallPackageConfig)
+}
+
+// LoadPackageConfigFile reads a YAML or JSON file to be used as (Cluster)Package config.
+func LoadPackageConfigFile(path string) (*runtime.RawExtension, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config from file: %w", err)
+	}
+
+	raw, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal config from file %s: %w", path, err)
+	}
+
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// ErrPackageNotAvailable is returned when a (Cluster)Package
+// did not become available before the wait was aborted.
+var ErrPackageNotAvailable = errors.New("package not available")
+
+const defaultWaitInterval = time.Second
+
+// WaitForAvailable polls the (Cluster)Package until it reports the current generation as available.
+// When the context is done before that, the most relevant failing condition is reported.
+func (p *Package) WaitForAvailable(ctx context.Context, opts ...WaitForAvailableOption) error {
+	var cfg WaitForAvailableConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	var lastFailing FailingProbes
+
+	err := wait.PollUntilContextCancel(ctx, cfg.Interval, true, func(ctx context.Context) (bool, error) {
+		if err := p.client.Get(ctx, client.ObjectKeyFromObject(p.obj), p.obj); err != nil {
+			return false, fmt.Errorf("getting package object: %w", err)
+		}
+
+		if cfg.Progress != nil {
+			cfg.Progress(p.statusSummary())
+		}
+
+		if cfg.ProbeFailure != nil {
+			failing, err := p.failingProbes(ctx)
+			if err != nil {
+				return false, err
+			}
+			if failing != (FailingProbes{}) && failing != lastFailing {
+				lastFailing = failing
+				cfg.ProbeFailure(failing.Phase, failing.Message)
+			}
+		}
+
+		return p.IsAvailable(), nil
+	})
+	if err == nil {
+		return nil
+	}
+	if !wait.Interrupted(err) {
+		return err
+	}
+
+	if cond := p.FailingCondition(); cond != nil {
+		return fmt.Errorf("%w: %s=%s %s: %s",
+			ErrPackageNotAvailable, cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+
+	return fmt.Errorf("%w: waiting for status to be reported", ErrPackageNotAvailable)
+}
+
+type WaitForAvailableConfig struct {
+	Interval time.Duration
+	// Called with a short status summary after every poll.
+	Progress func(status string)
+	// Called whenever probes of the current revision start failing with a new message.
+	ProbeFailure func(phase, message string)
+}
+
+func (c *WaitForAvailableConfig) Option(opts ...WaitForAvailableOption) {
+	for _, opt := range opts {
+		opt.ConfigureWaitForAvailable(c)
+	}
+}
+
+func (c *WaitForAvailableConfig) Default() {
+	if c.Interval == 0 {
+		c.Interval = defaultWaitInterval
+	}
+}
+
+type WaitForAvailableOption interface {
+	ConfigureWaitForAvailable(*WaitForAvailableConfig)
+}
+
+// Returns the failing probes of the current revision or an empty value if no probe is failing.
+func (p *Package) failingProbes(ctx context.Context) (FailingProbes, error) {
+	sets, err := p.ObjectSets(ctx)
+	if err != nil {
+		return FailingProbes{}, err
+	}
+
+	set, ok := sets.FindRevision(p.CurrentRevision())
+	if !ok {
+		return FailingProbes{}, nil
+	}
+
+	if failing := newRevisionStatus(set).FailingProbes; failing != nil {
+		return *failing, nil
+	}
+
+	return FailingProbes{}, nil
+}
+
+// IsAvailable returns true when the Available condition is true for the current generation.
+func (p *Package) IsAvailable() bool {
+	cond := meta.FindStatusCondition(p.Conditions(), corev1alpha1.PackageAvailable)
+
+	return cond != nil &&
+		cond.Status == metav1.ConditionTrue &&
+		cond.ObservedGeneration == p.obj.GetGeneration()
+}
+
+// FailingCondition returns the condition best explaining why the package is not available.
+func (p *Package) FailingCondition() *metav1.Condition {
+	conds := p.Conditions()
+
+	if cond := meta.FindStatusCondition(conds, corev1alpha1.PackageInvalid); cond != nil &&
+		cond.Status == metav1.ConditionTrue {
+		return cond
+	}
+	if cond := meta.FindStatusCondition(conds, corev1alpha1.PackageUnpacked); cond != nil &&
+		cond.Status != metav1.ConditionTrue {
+		return cond
+	}
+	if cond := meta.FindStatusCondition(conds, corev1alpha1.PackageAvailable); cond != nil &&
+		(cond.Status != metav1.ConditionTrue || cond.ObservedGeneration != p.obj.GetGeneration()) {
+		return cond
+	}
+
+	return meta.FindStatusCondition(conds, corev1alpha1.PackageProgressing)
+}
+
+func (p *Package) Conditions() []metav1.Condition {
+	if cpkg, ok := p.obj.(*corev1alpha1.ClusterPackage); ok {
+		return cpkg.Status.Conditions
+	}
+
+	return p.obj.(*corev1alpha1.Package).Status.Conditions
+}
+
+func (p *Package) Phase() corev1alpha1.PackageStatusPhase {
+	if cpkg, ok := p.obj.(*corev1alpha1.ClusterPackage); ok {
+		return cpkg.Status.Phase
+	}
+
+	return p.obj.(*corev1alpha1.Package).Status.Phase
+}
+
+func (p *Package) spec() *corev1alpha1.PackageSpec {
+	if cpkg, ok := p.obj.(*corev1alpha1.ClusterPackage); ok {
+		return &cpkg.Spec
+	}
+
+	return &p.obj.(*corev1alpha1.Package).Spec
+}
+
+func (p *Package) statusSummary() string {
+	phase := p.Phase()
+	if phase == "" {
+		phase = corev1alpha1.PackagePhasePending
+	}
+
+	if cond := p.FailingCondition(); cond != nil && cond.Message != "" {
+		return fmt.Sprintf("%s: %s", phase, cond.Message)
+	}
+
+	return string(phase)
+}
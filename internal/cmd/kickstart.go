@@ -2,4 +2,9 @@ package cmd
 
 import "package-operator.run/internal/cmd/kickstart"
 
+type (
+	ConvertHelmOptions      = kickstart.ConvertHelmOptions
+	AdoptHelmReleaseOptions = kickstart.AdoptHelmReleaseOptions
+)
+
 var NewKickstarter = kickstart.NewKickstarter
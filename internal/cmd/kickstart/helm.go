@@ -0,0 +1,120 @@
+package kickstart
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+
+	"pkg.package-operator.run/cardboard/kubeutils/kubemanifests"
+
+	"package-operator.run/internal/packages"
+)
+
+// ConvertHelmOptions configures the conversion of a Helm chart.
+type ConvertHelmOptions struct {
+	// Name of the package, defaults to the chart name.
+	PkgName string
+	// Chart version to use, if the chart is pulled from a repository.
+	Version string
+	// Namespace to render the chart for.
+	Namespace string
+	// Values files passed to helm via --values.
+	ValuesFiles []string
+	// Values passed to helm via --set.
+	SetValues []string
+	ParamOpts []string
+	// Path to the helm binary, defaults to "helm" from $PATH.
+	HelmBinary string
+}
+
+type runHelmFn func(ctx context.Context, binary string, args ...string) ([]byte, error)
+
+func execHelm(ctx context.Context, binary string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, binary, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// ConvertHelm renders the given chart via `helm template` and
+// kickstarts a new package from the output.
+// Returns a user message on success.
+func (k *Kickstarter) ConvertHelm(
+	ctx context.Context, chart string, opts ConvertHelmOptions,
+) (string, error) {
+	if opts.PkgName == "" {
+		opts.PkgName = chartName(chart)
+	}
+	if opts.HelmBinary == "" {
+		opts.HelmBinary = "helm"
+	}
+
+	folderName := opts.PkgName
+	if err := preflightPackageFolder(folderName); err != nil {
+		return "", err
+	}
+
+	args := []string{"template", opts.PkgName, chart, "--include-crds", "--skip-tests"}
+	if opts.Version != "" {
+		args = append(args, "--version", opts.Version)
+	}
+	if opts.Namespace != "" {
+		args = append(args, "--namespace", opts.Namespace)
+	}
+	for _, f := range opts.ValuesFiles {
+		args = append(args, "--values", f)
+	}
+	for _, v := range opts.SetValues {
+		args = append(args, "--set", v)
+	}
+
+	out, err := k.runHelm(ctx, opts.HelmBinary, args...)
+	if err != nil {
+		return "", fmt.Errorf("helm template: %w", err)
+	}
+
+	objects, err := kubemanifests.LoadKubernetesObjectsFromBytes(out)
+	if err != nil {
+		return "", fmt.Errorf("loading rendered chart: %w", err)
+	}
+
+	rawPkg, res, err := packages.ConvertHelm(ctx, opts.PkgName, objects, opts.ParamOpts)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writePackageFolder(folderName, rawPkg); err != nil {
+		return "", err
+	}
+
+	msg := fmt.Sprintf("Converted the %q Helm chart into the %q package with %d objects.",
+		chart, opts.PkgName, res.ObjectCount)
+	if len(res.DroppedHooks) > 0 {
+		msg += "\n[WARN] Some Helm hooks have no equivalent and were dropped:\n"
+		for _, hook := range res.DroppedHooks {
+			msg += fmt.Sprintf("- %s\n", hook)
+		}
+	}
+	report, ok := reportGKsWithoutProbes(res.GroupKindsWithoutProbes)
+	if ok {
+		msg += "\n" + report
+	}
+	return msg, nil
+}
+
+// Derives a package name from a chart reference.
+// e.g. "oci://quay.io/charts/nginx", "./charts/nginx/", "nginx.tgz" or "bitnami/nginx".
+func chartName(chart string) string {
+	name := path.Base(strings.TrimSuffix(chart, "/"))
+	return strings.TrimSuffix(name, ".tgz")
+}
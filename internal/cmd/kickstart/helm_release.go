@@ -0,0 +1,72 @@
+package kickstart
+
+import (
+	"context"
+	"fmt"
+
+	"pkg.package-operator.run/cardboard/kubeutils/kubemanifests"
+
+	"package-operator.run/internal/packages"
+)
+
+// AdoptHelmReleaseOptions configures the adoption of a deployed Helm release.
+type AdoptHelmReleaseOptions struct {
+	// Name of the package, defaults to the release name.
+	PkgName string
+	// Namespace of the release.
+	Namespace string
+	ParamOpts []string
+	// Path to the helm binary, defaults to "helm" from $PATH.
+	HelmBinary string
+}
+
+// AdoptHelmRelease loads the manifest of a deployed Helm release via `helm get manifest`
+// and kickstarts a new package adopting the objects of the release.
+// Returns a user message on success.
+func (k *Kickstarter) AdoptHelmRelease(
+	ctx context.Context, release string, opts AdoptHelmReleaseOptions,
+) (string, error) {
+	if opts.PkgName == "" {
+		opts.PkgName = release
+	}
+	if opts.HelmBinary == "" {
+		opts.HelmBinary = "helm"
+	}
+
+	folderName := opts.PkgName
+	if err := preflightPackageFolder(folderName); err != nil {
+		return "", err
+	}
+
+	args := []string{"get", "manifest", release}
+	if opts.Namespace != "" {
+		args = append(args, "--namespace", opts.Namespace)
+	}
+
+	out, err := k.runHelm(ctx, opts.HelmBinary, args...)
+	if err != nil {
+		return "", fmt.Errorf("helm get manifest: %w", err)
+	}
+
+	objects, err := kubemanifests.LoadKubernetesObjectsFromBytes(out)
+	if err != nil {
+		return "", fmt.Errorf("loading release manifest: %w", err)
+	}
+
+	rawPkg, res, err := packages.AdoptHelmRelease(ctx, opts.PkgName, objects, opts.ParamOpts)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writePackageFolder(folderName, rawPkg); err != nil {
+		return "", err
+	}
+
+	msg := fmt.Sprintf("Converted the %q Helm release into the %q package adopting %d objects.",
+		release, opts.PkgName, res.ObjectCount)
+	report, ok := reportGKsWithoutProbes(res.GroupKindsWithoutProbes)
+	if ok {
+		msg += "\n" + report
+	}
+	return msg, nil
+}
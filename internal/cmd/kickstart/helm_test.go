@@ -0,0 +1,98 @@
+package kickstart
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const helmTemplateOutput = `---
+# Source: my-chart/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-chart
+---
+# Source: my-chart/templates/tests/test.yaml
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-chart-test
+  annotations:
+    helm.sh/hook: test
+`
+
+func TestConvertHelm(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if err := os.RemoveAll("my-chart"); err != nil {
+			panic(err)
+		}
+	}()
+
+	var helmArgs []string
+
+	k := NewKickstarter(nil)
+	k.runHelm = func(_ context.Context, binary string, args ...string) ([]byte, error) {
+		assert.Equal(t, "helm", binary)
+		helmArgs = args
+		return []byte(helmTemplateOutput), nil
+	}
+
+	msg, err := k.ConvertHelm(context.Background(), "oci://quay.io/charts/my-chart", ConvertHelmOptions{
+		Version:     "1.0.0",
+		ValuesFiles: []string{"values.yaml"},
+		SetValues:   []string{"replicas=2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"template", "my-chart", "oci://quay.io/charts/my-chart", "--include-crds", "--skip-tests",
+		"--version", "1.0.0", "--values", "values.yaml", "--set", "replicas=2",
+	}, helmArgs)
+	assert.Contains(t, msg,
+		`Converted the "oci://quay.io/charts/my-chart" Helm chart into the "my-chart" package with 1 objects.`)
+	assert.Contains(t, msg, "- Pod my-chart-test (test)")
+	assert.FileExists(t, "my-chart/manifest.yaml")
+	assert.FileExists(t, "my-chart/deploy/my-chart.deployment.yaml")
+}
+
+func TestAdoptHelmRelease(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if err := os.RemoveAll("web"); err != nil {
+			panic(err)
+		}
+	}()
+
+	var helmArgs []string
+
+	k := NewKickstarter(nil)
+	k.runHelm = func(_ context.Context, binary string, args ...string) ([]byte, error) {
+		assert.Equal(t, "helm", binary)
+		helmArgs = args
+		return []byte(helmTemplateOutput), nil
+	}
+
+	msg, err := k.AdoptHelmRelease(context.Background(), "web", AdoptHelmReleaseOptions{Namespace: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"get", "manifest", "web", "--namespace", "web"}, helmArgs)
+	assert.Contains(t, msg, `Converted the "web" Helm release into the "web" package adopting 1 objects.`)
+	assert.FileExists(t, "web/manifest.yaml")
+	assert.FileExists(t, "web/deploy/my-chart.deployment.yaml")
+}
+
+func TestChartName(t *testing.T) {
+	t.Parallel()
+
+	for chart, expected := range map[string]string{
+		"oci://quay.io/charts/nginx": "nginx",
+		"./charts/nginx/":            "nginx",
+		"bitnami/nginx":              "nginx",
+		"nginx.tgz":                  "nginx",
+	} {
+		assert.Equal(t, expected, chartName(chart), chart)
+	}
+}
@@ -23,8 +23,9 @@ import (
 var errPackageFolderExists = errors.New("package folder already exists")
 
 type Kickstarter struct {
-	stdin  io.Reader
-	client *http.Client
+	stdin   io.Reader
+	client  *http.Client
+	runHelm runHelmFn
 }
 
 func NewKickstarter(stdin io.Reader) *Kickstarter {
@@ -40,8 +41,9 @@ func NewKickstarter(stdin io.Reader) *Kickstarter {
 	}
 
 	return &Kickstarter{
-		stdin:  stdin,
-		client: client,
+		stdin:   stdin,
+		client:  client,
+		runHelm: execHelm,
 	}
 }
 
@@ -54,15 +56,8 @@ func (k *Kickstarter) Kickstart(
 	paramOpts []string,
 ) (string, error) {
 	folderName := pkgName
-	// Preflight check: Check if pkgName folder already exists.
-	if _, err := os.Stat(folderName); err != nil {
-		// If the error is "not exist" then we're fine.
-		if !os.IsNotExist(err) {
-			return "", fmt.Errorf("preflight check: %w", err)
-		}
-	} else {
-		// Stat was successful and thus something already exists at `pkgName`.
-		return "", fmt.Errorf("%w: %s", errPackageFolderExists, pkgName)
+	if err := preflightPackageFolder(folderName); err != nil {
+		return "", err
 	}
 
 	var objects []unstructured.Unstructured
@@ -97,26 +92,45 @@ func (k *Kickstarter) Kickstart(
 		return "", err
 	}
 
-	// Write files.
+	if err := writePackageFolder(folderName, rawPkg); err != nil {
+		return "", err
+	}
+
+	msg := fmt.Sprintf("Kickstarted the %q package with %d objects.", pkgName, res.ObjectCount)
+	report, ok := reportGKsWithoutProbes(res.GroupKindsWithoutProbes)
+	if ok {
+		msg += "\n" + report
+	}
+	return msg, nil
+}
+
+// Preflight check: Check if the package folder already exists.
+func preflightPackageFolder(folderName string) error {
+	if _, err := os.Stat(folderName); err != nil {
+		// If the error is "not exist" then we're fine.
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("preflight check: %w", err)
+		}
+		return nil
+	}
+	// Stat was successful and thus something already exists at `folderName`.
+	return fmt.Errorf("%w: %s", errPackageFolderExists, folderName)
+}
+
+func writePackageFolder(folderName string, rawPkg *packages.RawPackage) error {
 	if err := os.Mkdir(folderName, os.ModePerm); err != nil {
-		return "", fmt.Errorf("create directory: %w", err)
+		return fmt.Errorf("create directory: %w", err)
 	}
 	for path, data := range rawPkg.Files {
 		path = filepath.Join(folderName, path)
 		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
-			return "", fmt.Errorf("creating directory: %w", err)
+			return fmt.Errorf("creating directory: %w", err)
 		}
 		if err := os.WriteFile(path, data, os.ModePerm); err != nil {
-			return "", fmt.Errorf("writing file: %w", err)
+			return fmt.Errorf("writing file: %w", err)
 		}
 	}
-
-	msg := fmt.Sprintf("Kickstarted the %q package with %d objects.", pkgName, res.ObjectCount)
-	report, ok := reportGKsWithoutProbes(res.GroupKindsWithoutProbes)
-	if ok {
-		msg += "\n" + report
-	}
-	return msg, nil
+	return nil
 }
 
 func (k *Kickstarter) getInput(ctx context.Context, input string) (
@@ -1,7 +1,12 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"package-operator.run/internal/packages"
 )
 
 type WithClock struct{ Clock Clock }
@@ -10,6 +15,16 @@ func (w WithClock) ConfigureUpdate(c *UpdateConfig) {
 	c.Clock = w.Clock
 }
 
+func (w WithClock) ConfigureRun(c *RunConfig) {
+	c.Clock = w.Clock
+}
+
+type WithClusterRegistryHost string
+
+func (w WithClusterRegistryHost) ConfigureRunPackage(c *RunPackageConfig) {
+	c.ClusterRegistryHost = string(w)
+}
+
 type WithClusterScope bool
 
 func (w WithClusterScope) ConfigureRenderPackage(c *RenderPackageConfig) {
@@ -34,22 +49,90 @@ func (w WithComponent) ConfigureRenderPackage(c *RenderPackageConfig) {
 	c.Component = string(w)
 }
 
+func (w WithComponent) ConfigureInstallPackage(c *InstallPackageConfig) {
+	c.Component = string(w)
+}
+
+func (w WithComponent) ConfigureRunPackage(c *RunPackageConfig) {
+	c.Component = string(w)
+}
+
+type WithEnvironmentPath string
+
+func (w WithEnvironmentPath) ConfigureRenderPackage(c *RenderPackageConfig) {
+	c.EnvironmentPath = string(w)
+}
+
+type WithExec struct{ Exec ExecFn }
+
+func (w WithExec) ConfigureSignature(c *SignatureConfig) {
+	c.Exec = w.Exec
+}
+
+type WithKubernetesVersion string
+
+func (w WithKubernetesVersion) ConfigureRenderPackage(c *RenderPackageConfig) {
+	c.KubernetesVersion = string(w)
+}
+
+type WithOpenShiftVersion string
+
+func (w WithOpenShiftVersion) ConfigureRenderPackage(c *RenderPackageConfig) {
+	c.OpenShiftVersion = string(w)
+}
+
 type WithDigestResolver struct{ Resolver DigestResolver }
 
 func (w WithDigestResolver) ConfigureBuild(c *BuildConfig) {
 	c.Resolver = w.Resolver
 }
 
+func (w WithDigestResolver) ConfigureSignature(c *SignatureConfig) {
+	c.Resolver = w.Resolver
+}
+
 func (w WithDigestResolver) ConfigureUpdate(c *UpdateConfig) {
 	c.Resolver = w.Resolver
 }
 
+type WithLintConfigPath string
+
+func (w WithLintConfigPath) ConfigureValidatePackage(c *ValidatePackageConfig) {
+	c.LintConfigPath = string(w)
+}
+
+type WithLintWarning func(warning error)
+
+func (w WithLintWarning) ConfigureValidatePackage(c *ValidatePackageConfig) {
+	c.LintWarning = w
+}
+
 type WithLog struct{ Log logr.Logger }
 
 func (w WithLog) ConfigureBuild(c *BuildConfig) {
 	c.Log = w.Log
 }
 
+func (w WithLog) ConfigureInspect(c *InspectConfig) {
+	c.Log = w.Log
+}
+
+func (w WithLog) ConfigurePush(c *PushConfig) {
+	c.Log = w.Log
+}
+
+func (w WithLog) ConfigureRender(c *RenderConfig) {
+	c.Log = w.Log
+}
+
+func (w WithLog) ConfigureRun(c *RunConfig) {
+	c.Log = w.Log
+}
+
+func (w WithLog) ConfigureSignature(c *SignatureConfig) {
+	c.Log = w.Log
+}
+
 func (w WithLog) ConfigureTree(c *TreeConfig) {
 	c.Log = w.Log
 }
@@ -62,6 +145,20 @@ func (w WithLog) ConfigureValidate(c *ValidateConfig) {
 	c.Log = w.Log
 }
 
+type WithCAFile string
+
+func (w WithCAFile) ConfigureInspectPackage(c *InspectPackageConfig) {
+	c.CAFile = string(w)
+}
+
+func (w WithCAFile) ConfigurePushFromFile(c *PushFromFileConfig) {
+	c.CAFile = string(w)
+}
+
+func (w WithCAFile) ConfigureVerifyImage(c *VerifyImageConfig) {
+	c.CAFile = string(w)
+}
+
 type WithHeaders []string
 
 func (w WithHeaders) ConfigureTable(c *TableConfig) {
@@ -78,14 +175,40 @@ func (w WithInsecure) ConfigureGenerateLockData(c *GenerateLockDataConfig) {
 	c.Insecure = bool(w)
 }
 
+func (w WithInsecure) ConfigureInspectPackage(c *InspectPackageConfig) {
+	c.Insecure = bool(w)
+}
+
+func (w WithInsecure) ConfigurePushFromFile(c *PushFromFileConfig) {
+	c.Insecure = bool(w)
+}
+
 func (w WithInsecure) ConfigureResolveDigest(c *ResolveDigestConfig) {
 	c.Insecure = bool(w)
 }
 
+func (w WithInsecure) ConfigureRunPackage(c *RunPackageConfig) {
+	c.Insecure = bool(w)
+}
+
+func (w WithInsecure) ConfigureSignImage(c *SignImageConfig) {
+	c.Insecure = bool(w)
+}
+
 func (w WithInsecure) ConfigureValidatePackage(c *ValidatePackageConfig) {
 	c.Insecure = bool(w)
 }
 
+func (w WithInsecure) ConfigureVerifyImage(c *VerifyImageConfig) {
+	c.Insecure = bool(w)
+}
+
+type WithKey string
+
+func (w WithKey) ConfigureSignImage(c *SignImageConfig) {
+	c.Key = string(w)
+}
+
 type WithNamespace string
 
 func (w WithNamespace) ConfigureGetPackage(c *GetPackageConfig) {
@@ -96,6 +219,14 @@ func (w WithNamespace) ConfigureGetObjectDeployment(c *GetObjectDeploymentConfig
 	c.Namespace = string(w)
 }
 
+func (w WithNamespace) ConfigureInstallPackage(c *InstallPackageConfig) {
+	c.Namespace = string(w)
+}
+
+func (w WithNamespace) ConfigureRunPackage(c *RunPackageConfig) {
+	c.Namespace = string(w)
+}
+
 type WithOutputPath string
 
 func (w WithOutputPath) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
@@ -110,30 +241,138 @@ func (w WithPackageLoader) ConfigureUpdate(c *UpdateConfig) {
 
 type WithPuller struct{ Pull PullFn }
 
+func (w WithPuller) ConfigureInspect(c *InspectConfig) {
+	c.Pull = w.Pull
+}
+
 func (w WithPuller) ConfigureValidate(c *ValidateConfig) {
 	c.Pull = w.Pull
 }
 
+type WithPackageConfig struct{ Config *runtime.RawExtension }
+
+func (w WithPackageConfig) ConfigureInstallPackage(c *InstallPackageConfig) {
+	c.Config = w.Config
+}
+
+func (w WithPackageConfig) ConfigureRunPackage(c *RunPackageConfig) {
+	c.Config = w.Config
+}
+
+type WithPackageName string
+
+func (w WithPackageName) ConfigureScaffoldPackage(c *ScaffoldPackageConfig) {
+	c.Name = string(w)
+}
+
 type WithPath string
 
 func (w WithPath) ConfigureValidatePackage(c *ValidatePackageConfig) {
 	c.Path = string(w)
 }
 
+type WithPhases []string
+
+func (w WithPhases) ConfigureScaffoldPackage(c *ScaffoldPackageConfig) {
+	c.Phases = []string(w)
+}
+
+type WithPlatforms []string
+
+func (w WithPlatforms) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
+	c.Platforms = []string(w)
+}
+
+type WithProbeFailure func(phase, message string)
+
+func (w WithProbeFailure) ConfigureWaitForAvailable(c *WaitForAvailableConfig) {
+	c.ProbeFailure = w
+}
+
+type WithProgress func(status string)
+
+func (w WithProgress) ConfigureWaitForAvailable(c *WaitForAvailableConfig) {
+	c.Progress = w
+}
+
 type WithPush bool
 
 func (w WithPush) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
 	c.Push = bool(w)
 }
 
+type WithRegistry string
+
+func (w WithRegistry) ConfigureRunPackage(c *RunPackageConfig) {
+	c.Registry = string(w)
+}
+
 type WithRemoteReference string
 
 func (w WithRemoteReference) ConfigureValidatePackage(c *ValidatePackageConfig) {
 	c.RemoteReference = string(w)
 }
 
+type WithSBOM string
+
+func (w WithSBOM) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
+	c.SBOM = string(w)
+}
+
+type WithArtifact bool
+
+func (w WithArtifact) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
+	c.Artifact = bool(w)
+}
+
+type WithScopes []string
+
+func (w WithScopes) ConfigureScaffoldPackage(c *ScaffoldPackageConfig) {
+	c.Scopes = []string(w)
+}
+
+type WithSourceBuilder struct{ Builder SourceBuilder }
+
+func (w WithSourceBuilder) ConfigureRun(c *RunConfig) {
+	c.Builder = w.Builder
+}
+
+type WithStrict bool
+
+func (w WithStrict) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
+	c.Strict = bool(w)
+}
+
+func (w WithStrict) ConfigureValidatePackage(c *ValidatePackageConfig) {
+	c.Strict = bool(w)
+}
+
 type WithTags []string
 
 func (w WithTags) ConfigureBuildFromSource(c *BuildFromSourceConfig) {
 	c.Tags = append(c.Tags, w...)
 }
+
+func (w WithTags) ConfigurePushFromFile(c *PushFromFileConfig) {
+	c.Tags = append(c.Tags, w...)
+}
+
+type WithUpdateFixtures bool
+
+func (w WithUpdateFixtures) ConfigureValidatePackage(c *ValidatePackageConfig) {
+	c.UpdateFixtures = bool(w)
+}
+
+type WithVerificationPolicy struct {
+	Config packages.VerificationPolicyConfig
+}
+
+func (w WithVerificationPolicy) ConfigureVerifyImage(c *VerifyImageConfig) {
+	c.Policy = w.Config
+}
+
+type WithWaitInterval time.Duration
+
+func (w WithWaitInterval) ConfigureWaitForAvailable(c *WaitForAvailableConfig) {
+	c.Interval = time.Duration(w)
+}
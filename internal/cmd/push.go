@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"package-operator.run/internal/packages"
+)
+
+func NewPush(opts ...PushOption) *Push {
+	var cfg PushConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Push{
+		cfg: cfg,
+	}
+}
+
+type Push struct {
+	cfg PushConfig
+}
+
+type PushConfig struct {
+	Log logr.Logger
+}
+
+func (c *PushConfig) Option(opts ...PushOption) {
+	for _, opt := range opts {
+		opt.ConfigurePush(c)
+	}
+}
+
+func (c *PushConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+}
+
+type PushOption interface {
+	ConfigurePush(*PushConfig)
+}
+
+// PushFromFile pushes a package image file created by "kubectl package build --output".
+// Registry credentials are looked up from the docker config, including credential helpers.
+// Returns the references that have been pushed.
+func (p *Push) PushFromFile(ctx context.Context, srcPath string, opts ...PushFromFileOption) ([]string, error) {
+	var cfg PushFromFileConfig
+
+	cfg.Option(opts...)
+
+	craneOpts, err := registryOptions(cfg.Insecure, cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	p.cfg.Log.Info("pushing image from disk", "path", srcPath)
+
+	refs, err := packages.PushOCIFile(ctx, srcPath, cfg.Tags, craneOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("pushing image from file: %w", err)
+	}
+
+	return refs, nil
+}
+
+type PushFromFileConfig struct {
+	Insecure bool
+	// Path to a PEM encoded CA bundle used to verify the registry certificate,
+	// in addition to the system certificate pool.
+	CAFile string
+	// References to push to. Defaults to the tags stored in the image file.
+	Tags []string
+}
+
+func (c *PushFromFileConfig) Option(opts ...PushFromFileOption) {
+	for _, opt := range opts {
+		opt.ConfigurePushFromFile(c)
+	}
+}
+
+type PushFromFileOption interface {
+	ConfigurePushFromFile(*PushFromFileConfig)
+}
+
+func registryOptions(insecure bool, caFile string) ([]crane.Option, error) {
+	craneOpts := []crane.Option{
+		crane.WithAuthFromKeychain(authn.DefaultKeychain),
+	}
+	if insecure {
+		craneOpts = append(craneOpts, crane.Insecure)
+	}
+	if caFile == "" {
+		return craneOpts, nil
+	}
+
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA file: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%w: no certificates found in CA file %s", ErrInvalidOptions, caFile)
+	}
+
+	transport := remote.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:            pool,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecure, //nolint:gosec
+	}
+
+	return append(craneOpts, crane.WithTransport(transport)), nil
+}
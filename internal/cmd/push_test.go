@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages"
+)
+
+func TestPush_PushFromFileInvalidCA(t *testing.T) {
+	t.Parallel()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+
+	_, err := NewPush().PushFromFile(context.Background(), "image.tar", WithCAFile(caFile))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func TestPush_PushFromFileMissingCA(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPush().PushFromFile(
+		context.Background(), "image.tar", WithCAFile(filepath.Join(t.TempDir(), "missing.pem")))
+	require.Error(t, err)
+}
+
+func TestPush_PushFromFileInvalidImage(t *testing.T) {
+	t.Parallel()
+
+	src := filepath.Join(t.TempDir(), "image.tar")
+	require.NoError(t, os.WriteFile(src, []byte("not a tar"), 0o600))
+
+	_, err := NewPush().PushFromFile(context.Background(), src, WithTags{"chickens:oldest"})
+	require.ErrorIs(t, err, packages.ErrInvalidImageFile)
+}
+
+func TestParsePlatforms(t *testing.T) {
+	t.Parallel()
+
+	platforms, err := parsePlatforms([]string{"linux/amd64", "linux/arm64/v8"})
+	require.NoError(t, err)
+	require.Len(t, platforms, 2)
+	assert.Equal(t, "arm64", platforms[1].Architecture)
+	assert.Equal(t, "v8", platforms[1].Variant)
+
+	_, err = parsePlatforms([]string{"linux/amd64/v1/extra"})
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// Verbs Package Operator needs on every object type of a package to watch and create objects.
+var rbacTypeVerbs = []string{"create", "list", "watch"}
+
+// Verbs Package Operator needs on every single object of a package to adopt, update and delete it.
+var rbacObjectVerbs = []string{"delete", "get", "patch", "update"}
+
+// Cluster scoped kinds of the APIs built into Kubernetes and Package Operator.
+// Other kinds are assumed to be namespaced, unless they are defined by a CRD in the package.
+var clusterScopedGroupKinds = sets.New(
+	schema.GroupKind{Kind: "Namespace"},
+	schema.GroupKind{Kind: "Node"},
+	schema.GroupKind{Kind: "PersistentVolume"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicyBinding"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"},
+	schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"},
+	schema.GroupKind{Group: "apiregistration.k8s.io", Kind: "APIService"},
+	schema.GroupKind{Group: "certificates.k8s.io", Kind: "CertificateSigningRequest"},
+	schema.GroupKind{Group: "flowcontrol.apiserver.k8s.io", Kind: "FlowSchema"},
+	schema.GroupKind{Group: "flowcontrol.apiserver.k8s.io", Kind: "PriorityLevelConfiguration"},
+	schema.GroupKind{Group: "networking.k8s.io", Kind: "IngressClass"},
+	schema.GroupKind{Group: "node.k8s.io", Kind: "RuntimeClass"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterObjectDeployment"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterObjectSet"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterObjectSetPhase"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterObjectSlice"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterObjectTemplate"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterPackage"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterPackageRollout"},
+	schema.GroupKind{Group: "package-operator.run", Kind: "ClusterTarget"},
+	schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+	schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"},
+	schema.GroupKind{Group: "scheduling.k8s.io", Kind: "PriorityClass"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "CSIDriver"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "CSINode"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "StorageClass"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "VolumeAttachment"},
+)
+
+// RenderedRBAC holds the RBAC objects granting the permissions needed to apply a rendered package.
+type RenderedRBAC struct {
+	// Permissions for cluster scoped objects, nil if not needed.
+	ClusterRole *rbacv1.ClusterRole
+	// Permissions for namespaced objects, one Role per namespace.
+	// Objects without namespace are covered by a Role without namespace,
+	// which is created in the current namespace when applied.
+	Roles []rbacv1.Role
+}
+
+// RBAC generates the minimal RBAC rules needed to apply the rendered objects.
+// Objects can be watched and created by type, but only updated and deleted by name.
+//
+// Kubernetes prevents privilege escalation, so Roles, ClusterRoles and their bindings
+// contained in the package require holding the permissions they grant or the bind verb,
+// which are added to the generated rules.
+func (m *RenderedManifests) RBAC(name, namespace string) *RenderedRBAC {
+	cluster := newRBACRuleSet()
+	namespaced := map[string]*rbacRuleSet{}
+	namespacedRuleSet := func(ns string) *rbacRuleSet {
+		if len(ns) == 0 {
+			ns = namespace
+		}
+		if _, ok := namespaced[ns]; !ok {
+			namespaced[ns] = newRBACRuleSet()
+		}
+		return namespaced[ns]
+	}
+
+	crdResources := crdResourcesFromObjects(m.Objects)
+	for _, ro := range m.Objects {
+		obj := ro.Object
+		gvk := obj.GroupVersionKind()
+		resource, clusterScoped := rbacResourceFor(gvk, crdResources)
+
+		rs := cluster
+		if !clusterScoped {
+			rs = namespacedRuleSet(obj.GetNamespace())
+		}
+		rs.addObject(gvk.Group, resource, obj.GetName())
+
+		if gvk.Group != rbacv1.GroupName {
+			continue
+		}
+		switch gvk.Kind {
+		case "ClusterRole":
+			cluster.addEscalation(policyRulesFromObject(obj)...)
+		case "Role":
+			rs.addEscalation(policyRulesFromObject(obj)...)
+		case "ClusterRoleBinding", "RoleBinding":
+			if rule, ok := bindRuleFromObject(obj); ok {
+				rs.addEscalation(rule)
+			}
+		}
+	}
+
+	rbac := &RenderedRBAC{}
+	if !cluster.empty() {
+		rbac.ClusterRole = &rbacv1.ClusterRole{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Rules:      cluster.rules(),
+		}
+	}
+	for _, ns := range sets.List(sets.KeySet(namespaced)) {
+		rbac.Roles = append(rbac.Roles, rbacv1.Role{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Rules:      namespaced[ns].rules(),
+		})
+	}
+	return rbac
+}
+
+// YAML returns all RBAC objects as a single multi-document YAML stream.
+func (r *RenderedRBAC) YAML() ([]byte, error) {
+	var objs []any
+	if r.ClusterRole != nil {
+		objs = append(objs, r.ClusterRole)
+	}
+	for i := range r.Roles {
+		objs = append(objs, &r.Roles[i])
+	}
+
+	docs := make([][]byte, 0, len(objs))
+	for _, obj := range objs {
+		data, err := sigsyaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling RBAC: %w", err)
+		}
+		docs = append(docs, append([]byte("---\n"), data...))
+	}
+	return bytes.Join(docs, nil), nil
+}
+
+type crdResource struct {
+	plural        string
+	clusterScoped bool
+}
+
+// Collects the resources defined by CRDs, which are part of the package.
+func crdResourcesFromObjects(objs []RenderedObject) map[schema.GroupKind]crdResource {
+	crds := map[schema.GroupKind]crdResource{}
+	for _, ro := range objs {
+		obj := ro.Object
+		if obj.GroupVersionKind().GroupKind() != crdGroupKind {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+		plural, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "plural")
+		scope, _, _ := unstructured.NestedString(obj.Object, "spec", "scope")
+		crds[schema.GroupKind{Group: group, Kind: kind}] = crdResource{
+			plural:        plural,
+			clusterScoped: scope == "Cluster",
+		}
+	}
+	return crds
+}
+
+var crdGroupKind = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
+// Returns the resource name of the given kind and whether it is cluster scoped.
+func rbacResourceFor(
+	gvk schema.GroupVersionKind, crds map[schema.GroupKind]crdResource,
+) (resource string, clusterScoped bool) {
+	if crd, ok := crds[gvk.GroupKind()]; ok && len(crd.plural) > 0 {
+		return crd.plural, crd.clusterScoped
+	}
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	return plural.Resource, clusterScopedGroupKinds.Has(gvk.GroupKind())
+}
+
+func policyRulesFromObject(obj unstructured.Unstructured) []rbacv1.PolicyRule {
+	role := &rbacv1.ClusterRole{}
+	if err := convertUnstructured(obj, role); err != nil {
+		// Invalid roles are rejected by the API server anyways.
+		return nil
+	}
+	return role.Rules
+}
+
+// Binding a role requires either holding all its permissions or the bind verb on it.
+func bindRuleFromObject(obj unstructured.Unstructured) (rbacv1.PolicyRule, bool) {
+	binding := &rbacv1.RoleBinding{}
+	if err := convertUnstructured(obj, binding); err != nil || len(binding.RoleRef.Name) == 0 {
+		return rbacv1.PolicyRule{}, false
+	}
+	resource := "roles"
+	if binding.RoleRef.Kind == "ClusterRole" {
+		resource = "clusterroles"
+	}
+	return rbacv1.PolicyRule{
+		APIGroups:     []string{rbacv1.GroupName},
+		Resources:     []string{resource},
+		ResourceNames: []string{binding.RoleRef.Name},
+		Verbs:         []string{"bind"},
+	}, true
+}
+
+func convertUnstructured(obj unstructured.Unstructured, into any) error {
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return sigsyaml.Unmarshal(data, into)
+}
+
+type rbacRuleSet struct {
+	// group -> resource -> object names.
+	objects map[string]map[string]sets.Set[string]
+	// Resources with objects without name, that can't be restricted by name.
+	unnamed     sets.Set[schema.GroupResource]
+	escalations []rbacv1.PolicyRule
+}
+
+func newRBACRuleSet() *rbacRuleSet {
+	return &rbacRuleSet{
+		objects: map[string]map[string]sets.Set[string]{},
+		unnamed: sets.New[schema.GroupResource](),
+	}
+}
+
+func (rs *rbacRuleSet) empty() bool {
+	return len(rs.objects) == 0 && len(rs.escalations) == 0
+}
+
+func (rs *rbacRuleSet) addObject(group, resource, name string) {
+	if _, ok := rs.objects[group]; !ok {
+		rs.objects[group] = map[string]sets.Set[string]{}
+	}
+	if _, ok := rs.objects[group][resource]; !ok {
+		rs.objects[group][resource] = sets.New[string]()
+	}
+	if len(name) == 0 {
+		rs.unnamed.Insert(schema.GroupResource{Group: group, Resource: resource})
+		return
+	}
+	rs.objects[group][resource].Insert(name)
+}
+
+func (rs *rbacRuleSet) addEscalation(rules ...rbacv1.PolicyRule) {
+	rs.escalations = append(rs.escalations, rules...)
+}
+
+func (rs *rbacRuleSet) rules() []rbacv1.PolicyRule {
+	var rules []rbacv1.PolicyRule
+	for _, group := range sets.List(sets.KeySet(rs.objects)) {
+		resources := sets.List(sets.KeySet(rs.objects[group]))
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{group},
+			Resources: resources,
+			Verbs:     rbacTypeVerbs,
+		})
+
+		for _, resource := range resources {
+			rule := rbacv1.PolicyRule{
+				APIGroups: []string{group},
+				Resources: []string{resource},
+				Verbs:     rbacObjectVerbs,
+			}
+			if !rs.unnamed.Has(schema.GroupResource{Group: group, Resource: resource}) {
+				rule.ResourceNames = sets.List(rs.objects[group][resource])
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return append(rules, rs.escalations...)
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRenderedManifests_RBAC(t *testing.T) {
+	t.Parallel()
+
+	m := &RenderedManifests{
+		Objects: []RenderedObject{
+			renderedObject(map[string]any{
+				"apiVersion": "apiextensions.k8s.io/v1",
+				"kind":       "CustomResourceDefinition",
+				"metadata":   map[string]any{"name": "backups.example.com"},
+				"spec": map[string]any{
+					"group": "example.com",
+					"names": map[string]any{"kind": "Backup", "plural": "backups"},
+					"scope": "Cluster",
+				},
+			}),
+			renderedObject(map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Backup",
+				"metadata":   map[string]any{"name": "nightly"},
+			}),
+			renderedObject(map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]any{"name": "app"},
+			}),
+			renderedObject(map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"name": "other", "namespace": "other-ns"},
+			}),
+			renderedObject(map[string]any{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind":       "Role",
+				"metadata":   map[string]any{"name": "app"},
+				"rules": []any{
+					map[string]any{
+						"apiGroups": []any{""},
+						"resources": []any{"secrets"},
+						"verbs":     []any{"get"},
+					},
+				},
+			}),
+			renderedObject(map[string]any{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind":       "RoleBinding",
+				"metadata":   map[string]any{"name": "app"},
+				"roleRef": map[string]any{
+					"apiGroup": "rbac.authorization.k8s.io",
+					"kind":     "Role",
+					"name":     "app",
+				},
+			}),
+		},
+	}
+
+	rbac := m.RBAC("test", "test-ns")
+
+	require.NotNil(t, rbac.ClusterRole)
+	assert.Equal(t, "test", rbac.ClusterRole.Name)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"apiextensions.k8s.io"},
+			Resources: []string{"customresourcedefinitions"},
+			Verbs:     []string{"create", "list", "watch"},
+		},
+		{
+			APIGroups:     []string{"apiextensions.k8s.io"},
+			Resources:     []string{"customresourcedefinitions"},
+			ResourceNames: []string{"backups.example.com"},
+			Verbs:         []string{"delete", "get", "patch", "update"},
+		},
+		{
+			APIGroups: []string{"example.com"},
+			Resources: []string{"backups"},
+			Verbs:     []string{"create", "list", "watch"},
+		},
+		{
+			APIGroups:     []string{"example.com"},
+			Resources:     []string{"backups"},
+			ResourceNames: []string{"nightly"},
+			Verbs:         []string{"delete", "get", "patch", "update"},
+		},
+	}, rbac.ClusterRole.Rules)
+
+	require.Len(t, rbac.Roles, 2)
+	assert.Equal(t, "other-ns", rbac.Roles[0].Namespace)
+	assert.Equal(t, "test-ns", rbac.Roles[1].Namespace)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments"},
+			Verbs:     []string{"create", "list", "watch"},
+		},
+		{
+			APIGroups:     []string{"apps"},
+			Resources:     []string{"deployments"},
+			ResourceNames: []string{"app"},
+			Verbs:         []string{"delete", "get", "patch", "update"},
+		},
+		{
+			APIGroups: []string{"rbac.authorization.k8s.io"},
+			Resources: []string{"rolebindings", "roles"},
+			Verbs:     []string{"create", "list", "watch"},
+		},
+		{
+			APIGroups:     []string{"rbac.authorization.k8s.io"},
+			Resources:     []string{"rolebindings"},
+			ResourceNames: []string{"app"},
+			Verbs:         []string{"delete", "get", "patch", "update"},
+		},
+		{
+			APIGroups:     []string{"rbac.authorization.k8s.io"},
+			Resources:     []string{"roles"},
+			ResourceNames: []string{"app"},
+			Verbs:         []string{"delete", "get", "patch", "update"},
+		},
+		// Permissions granted by the Role.
+		{
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+			Verbs:     []string{"get"},
+		},
+		// Binding the Role.
+		{
+			APIGroups:     []string{"rbac.authorization.k8s.io"},
+			Resources:     []string{"roles"},
+			ResourceNames: []string{"app"},
+			Verbs:         []string{"bind"},
+		},
+	}, rbac.Roles[1].Rules)
+
+	data, err := rbac.YAML()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "kind: ClusterRole\n")
+	assert.Contains(t, string(data), "kind: Role\n")
+}
+
+func TestRenderedManifests_RBAC_generateName(t *testing.T) {
+	t.Parallel()
+
+	m := &RenderedManifests{
+		Objects: []RenderedObject{
+			renderedObject(map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]any{"generateName": "migrate-"},
+			}),
+		},
+	}
+
+	rbac := m.RBAC("test", "")
+	assert.Nil(t, rbac.ClusterRole)
+	require.Len(t, rbac.Roles, 1)
+	// Objects without name can't be restricted by name.
+	assert.Empty(t, rbac.Roles[0].Rules[1].ResourceNames)
+}
+
+func renderedObject(obj map[string]any) RenderedObject {
+	return RenderedObject{Object: unstructured.Unstructured{Object: obj}}
+}
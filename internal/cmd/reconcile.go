@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// RequestReconcile sets the reconcile-requested annotation of the given
+// (Cluster)Package, (Cluster)ObjectDeployment or (Cluster)ObjectSet to the given time,
+// so package-operator reconciles it immediately.
+func (c *Client) RequestReconcile(ctx context.Context, obj client.Object, at time.Time) error {
+	patch := map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				corev1alpha1.ReconcileRequestedAnnotation: at.UTC().Format(time.RFC3339),
+			},
+		},
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshalling patch: %w", err)
+	}
+
+	if err := c.client.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patchJSON)); err != nil {
+		return fmt.Errorf("requesting reconcile of %s: %w", client.ObjectKeyFromObject(obj), err)
+	}
+	return nil
+}
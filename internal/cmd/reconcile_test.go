@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestClient_RequestReconcile(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+
+	kc := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test",
+				Namespace:   "default",
+				Annotations: map[string]string{"other": "annotation"},
+			},
+		}).
+		Build()
+	c := NewClient(kc)
+
+	at := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	pkg := &corev1alpha1.Package{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	require.NoError(t, c.RequestReconcile(context.Background(), pkg, at))
+
+	actual := &corev1alpha1.Package{}
+	require.NoError(t, kc.Get(context.Background(), client.ObjectKeyFromObject(pkg), actual))
+	assert.Equal(t, map[string]string{
+		"other": "annotation",
+		corev1alpha1.ReconcileRequestedAnnotation: "2024-01-01T12:00:00Z",
+	}, actual.Annotations)
+}
+
+func TestClient_RequestReconcile_NotFound(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+
+	c := NewClient(fake.NewClientBuilder().WithScheme(scheme).Build())
+
+	err = c.RequestReconcile(context.Background(),
+		&corev1alpha1.ClusterPackage{ObjectMeta: metav1.ObjectMeta{Name: "dne"}}, time.Now())
+	require.True(t, apimachineryerrors.IsNotFound(err))
+}
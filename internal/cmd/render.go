@@ -0,0 +1,402 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages"
+	"package-operator.run/internal/utils"
+)
+
+func NewRender(scheme *runtime.Scheme, opts ...RenderOption) *Render {
+	var cfg RenderConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Render{
+		cfg:    cfg,
+		scheme: scheme,
+	}
+}
+
+// Render templates a package source into plain Kubernetes manifests.
+type Render struct {
+	cfg    RenderConfig
+	scheme *runtime.Scheme
+}
+
+type RenderConfig struct {
+	Log logr.Logger
+}
+
+func (c *RenderConfig) Option(opts ...RenderOption) {
+	for _, opt := range opts {
+		opt.ConfigureRender(c)
+	}
+}
+
+func (c *RenderConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+}
+
+type RenderOption interface {
+	ConfigureRender(*RenderConfig)
+}
+
+// RenderManifests templates the package at srcPath and returns all objects
+// ordered by phase, keeping their phase annotations intact.
+func (r *Render) RenderManifests(
+	ctx context.Context, srcPath string, opts ...RenderPackageOption,
+) (*RenderedManifests, error) {
+	var cfg RenderPackageConfig
+
+	cfg.Option(opts...)
+
+	r.cfg.Log.Info("loading source from disk", "path", srcPath)
+
+	rawPkg, err := packages.FromFolder(ctx, srcPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading package contents from folder: %w", err)
+	}
+
+	pkg, err := packages.DefaultStructuralLoader.LoadComponent(ctx, rawPkg, cfg.Component)
+	if err != nil {
+		return nil, fmt.Errorf("parsing package contents: %w", err)
+	}
+
+	tmplCtx, scope, err := newPackageRenderContext(ctx, pkg, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := packages.PackageValidatorList{
+		packages.DefaultPackageValidators,
+		packages.PackageScopeValidator(scope),
+	}
+	if err := validators.ValidatePackage(ctx, pkg); err != nil {
+		return nil, fmt.Errorf("validating package: %w", err)
+	}
+
+	if err := packages.RenderTemplates(ctx, pkg, tmplCtx); err != nil {
+		return nil, fmt.Errorf("rendering templates: %w", err)
+	}
+
+	pathObjects, _, err := packages.RenderObjectsWithFilterInfo(
+		ctx, pkg, tmplCtx, packages.DefaultObjectValidators)
+	if err != nil {
+		return nil, fmt.Errorf("rendering objects: %w", err)
+	}
+
+	return newRenderedManifests(pkg.Manifest, pathObjects), nil
+}
+
+func newRenderedManifests(
+	manifest *manifests.PackageManifest, pathObjects map[string][]unstructured.Unstructured,
+) *RenderedManifests {
+	phaseIndex := map[string]int{}
+	for i, phase := range manifest.Spec.Phases {
+		phaseIndex[phase.Name] = i
+	}
+
+	rendered := &RenderedManifests{PackageName: manifest.Name}
+
+	for path, objs := range pathObjects {
+		for _, obj := range objs {
+			phase := obj.GetAnnotations()[manifestsv1alpha1.PackagePhaseAnnotation]
+			idx, ok := phaseIndex[phase]
+			if !ok {
+				// objects in unknown phases are never reconciled.
+				continue
+			}
+
+			rendered.Objects = append(rendered.Objects, RenderedObject{
+				Path:       path,
+				Phase:      phase,
+				PhaseIndex: idx,
+				Object:     obj,
+			})
+		}
+	}
+
+	// SliceStable keeps document order within a single file.
+	sort.SliceStable(rendered.Objects, func(i, j int) bool {
+		a, b := rendered.Objects[i], rendered.Objects[j]
+		if a.PhaseIndex != b.PhaseIndex {
+			return a.PhaseIndex < b.PhaseIndex
+		}
+
+		return strings.ReplaceAll(a.Path, "/", "\x00") < strings.ReplaceAll(b.Path, "/", "\x00")
+	})
+
+	return rendered
+}
+
+// RenderedManifests holds all objects of a rendered package.
+type RenderedManifests struct {
+	// Name of the PackageManifest.
+	PackageName string
+	Objects     []RenderedObject
+}
+
+// RenderedObject is a single object rendered from a package file.
+type RenderedObject struct {
+	// Path of the file the object originates from, without template suffix.
+	Path string
+	// Name of the phase the object belongs to.
+	Phase string
+	// Index of the phase within the PackageManifest.
+	PhaseIndex int
+	Object     unstructured.Unstructured
+}
+
+// RenderSplit defines how rendered manifests are distributed over files.
+type RenderSplit string
+
+const (
+	// All objects are written into a single file.
+	RenderSplitNone RenderSplit = "none"
+	// One file is written per phase, prefixed with the phase index.
+	RenderSplitPhase RenderSplit = "phase"
+	// Objects are written to the same relative paths they have in the package source.
+	RenderSplitFile RenderSplit = "file"
+)
+
+const renderedManifestsFilename = "manifests.yaml"
+
+// YAML returns all objects as a single multi-document YAML stream.
+func (m *RenderedManifests) YAML() ([]byte, error) {
+	return marshalRenderedObjects(m.Objects)
+}
+
+// WriteToDir writes all rendered objects into the given directory.
+func (m *RenderedManifests) WriteToDir(dir string, split RenderSplit) error {
+	files := map[string][]RenderedObject{}
+
+	for _, obj := range m.Objects {
+		var name string
+
+		switch split {
+		case RenderSplitNone, "":
+			name = renderedManifestsFilename
+		case RenderSplitPhase:
+			name = fmt.Sprintf("%02d-%s.yaml", obj.PhaseIndex, obj.Phase)
+		case RenderSplitFile:
+			name = obj.Path
+		default:
+			return fmt.Errorf("%w: unknown split mode %q", ErrInvalidArgs, split)
+		}
+
+		files[name] = append(files[name], obj)
+	}
+
+	for name, objs := range files {
+		data, err := marshalRenderedObjects(objs)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("creating output directory: %w", err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func marshalRenderedObjects(objs []RenderedObject) ([]byte, error) {
+	docs := make([][]byte, 0, len(objs))
+
+	for _, obj := range objs {
+		data, err := sigsyaml.Marshal(obj.Object.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling %s: %w", obj.Object.GetName(), err)
+		}
+
+		docs = append(docs, append([]byte("---\n"), data...))
+	}
+
+	return bytes.Join(docs, nil), nil
+}
+
+type RenderPackageConfig struct {
+	ClusterScope      bool
+	ConfigPath        string
+	ConfigTestcase    string
+	Component         string
+	EnvironmentPath   string
+	KubernetesVersion string
+	OpenShiftVersion  string
+}
+
+func (c *RenderPackageConfig) Option(opts ...RenderPackageOption) {
+	for _, opt := range opts {
+		opt.ConfigureRenderPackage(c)
+	}
+}
+
+type RenderPackageOption interface {
+	ConfigureRenderPackage(*RenderPackageConfig)
+}
+
+// newPackageRenderContext assembles the template context used to render a package
+// from the given config and returns the scope the package is rendered in.
+func newPackageRenderContext(
+	ctx context.Context, pkg *packages.Package, cfg RenderPackageConfig,
+) (packages.PackageRenderContext, manifestsv1alpha1.PackageManifestScope, error) {
+	tmplCtx := getTemplateContext(pkg, cfg)
+	tmplCfg, err := getConfig(pkg, cfg)
+	if err != nil {
+		return tmplCtx, "", fmt.Errorf("getting config: %w", err)
+	}
+
+	if err := applyEnvironment(&tmplCtx.Environment, cfg); err != nil {
+		return tmplCtx, "", fmt.Errorf("getting environment: %w", err)
+	}
+
+	validationErrors, err := packages.AdmitPackageConfiguration(
+		ctx, tmplCfg, pkg.Manifest, field.NewPath("spec", "config"))
+	if err != nil {
+		return tmplCtx, "", fmt.Errorf("validate Package configuration: %w", err)
+	}
+	if len(validationErrors) > 0 {
+		return tmplCtx, "", validationErrors.ToAggregate()
+	}
+
+	tmplCtx.Config = tmplCfg
+	tmplCtx.Images = utils.GenerateStaticImages(pkg.Manifest)
+	tmplCtx.Package.ConfigHash = packages.ConfigHash(tmplCfg)
+	tmplCtx.Package.ConfigChecksums = packages.ConfigChecksums(tmplCfg)
+
+	scope := manifestsv1alpha1.PackageManifestScopeNamespaced
+	if cfg.ClusterScope || len(tmplCtx.Package.Namespace) == 0 {
+		scope = manifestsv1alpha1.PackageManifestScopeCluster
+		tmplCtx.Package.Namespace = ""
+	}
+
+	return tmplCtx, scope, nil
+}
+
+func getTemplateContext(pkg *packages.Package, cfg RenderPackageConfig) packages.PackageRenderContext {
+	templateContext := packages.PackageRenderContext{
+		Package: manifests.TemplateContextPackage{
+			TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+		},
+	}
+
+	switch {
+	case cfg.ConfigTestcase != "":
+		for _, test := range pkg.Manifest.Test.Template {
+			if test.Name != cfg.ConfigTestcase {
+				continue
+			}
+
+			templateContext = packages.PackageRenderContext{
+				Package:     test.Context.Package,
+				Environment: test.Context.Environment,
+			}
+		}
+	case len(pkg.Manifest.Test.Template) > 0:
+		test := pkg.Manifest.Test.Template[0]
+
+		templateContext = packages.PackageRenderContext{
+			Package:     test.Context.Package,
+			Environment: test.Context.Environment,
+		}
+	}
+
+	return templateContext
+}
+
+func getConfig(pkg *packages.Package, cfg RenderPackageConfig) (map[string]any, error) {
+	config := map[string]any{}
+
+	switch {
+	case cfg.ConfigPath != "":
+		data, err := os.ReadFile(cfg.ConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("read config from file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("unmarshal config from file %s: %w", cfg.ConfigPath, err)
+		}
+	case cfg.ConfigTestcase != "":
+		for _, test := range pkg.Manifest.Test.Template {
+			if test.Name != cfg.ConfigTestcase {
+				continue
+			}
+
+			if test.Context.Config == nil {
+				return config, nil
+			}
+			if err := json.Unmarshal(test.Context.Config.Raw, &config); err != nil {
+				return nil, fmt.Errorf("unmarshal config from test template %s: %w", cfg.ConfigTestcase, err)
+			}
+		}
+
+		if config == nil {
+			return nil, fmt.Errorf("%w: test template with name %s not found", ErrInvalidArgs, cfg.ConfigTestcase)
+		}
+	case len(pkg.Manifest.Test.Template) > 0:
+		testCtxCfg := pkg.Manifest.Test.Template[0].Context.Config
+		if testCtxCfg == nil {
+			return config, nil
+		}
+
+		if err := json.Unmarshal(testCtxCfg.Raw, &config); err != nil {
+			return nil, fmt.Errorf("unmarshal config from first test template: %w", err)
+		}
+	}
+
+	return config, nil
+}
+
+// applyEnvironment overrides the simulated environment with
+// information from an environment file and explicit version flags.
+func applyEnvironment(env *manifests.PackageEnvironment, cfg RenderPackageConfig) error {
+	if cfg.EnvironmentPath != "" {
+		data, err := os.ReadFile(cfg.EnvironmentPath)
+		if err != nil {
+			return fmt.Errorf("read environment from file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, env); err != nil {
+			return fmt.Errorf("unmarshal environment from file %s: %w", cfg.EnvironmentPath, err)
+		}
+	}
+
+	if cfg.KubernetesVersion != "" {
+		env.Kubernetes.Version = cfg.KubernetesVersion
+	}
+	if cfg.OpenShiftVersion != "" {
+		if env.OpenShift == nil {
+			env.OpenShift = &manifests.PackageEnvironmentOpenShift{}
+		}
+		env.OpenShift.Version = cfg.OpenShiftVersion
+	}
+
+	return nil
+}
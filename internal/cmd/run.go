@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"package-operator.run/internal/utils"
+)
+
+func NewRun(opts ...RunOption) *Run {
+	var cfg RunConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Run{
+		cfg: cfg,
+	}
+}
+
+// Run implements the local development loop of building a package from source,
+// pushing it to a development registry and rolling it out to a cluster.
+type Run struct {
+	cfg RunConfig
+}
+
+type RunConfig struct {
+	Log     logr.Logger
+	Builder SourceBuilder
+	Clock   Clock
+}
+
+// SourceBuilder builds package images from a source directory.
+type SourceBuilder interface {
+	BuildFromSource(ctx context.Context, srcPath string, opts ...BuildFromSourceOption) error
+}
+
+func (c *RunConfig) Option(opts ...RunOption) {
+	for _, opt := range opts {
+		opt.ConfigureRun(c)
+	}
+}
+
+func (c *RunConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+	if c.Builder == nil {
+		c.Builder = NewBuild(WithLog{Log: c.Log})
+	}
+	if c.Clock == nil {
+		c.Clock = &defaultClock{}
+	}
+}
+
+type RunOption interface {
+	ConfigureRun(*RunConfig)
+}
+
+// RunPackage builds the package at srcPath, pushes it to the development registry
+// and creates or updates the (Cluster)Package to point to the new image.
+// Package images are pulled by package-operator and not by the kubelet,
+// so the registry must be reachable from within the cluster.
+func (r *Run) RunPackage(
+	ctx context.Context, client *Client, pkgName, srcPath string, opts ...RunPackageOption,
+) (*Package, error) {
+	var cfg RunPackageConfig
+
+	cfg.Option(opts...)
+
+	if cfg.Registry == "" {
+		return nil, fmt.Errorf("%w: registry must not be empty", ErrInvalidOptions)
+	}
+
+	// Every build gets a new tag to make package-operator unpack the package again.
+	tag := "dev-" + strconv.FormatInt(r.cfg.Clock.Now().Unix(), 10)
+
+	ref, err := name.ParseReference(cfg.Registry + "/" + pkgName + ":" + tag)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid registry %q: %w", ErrInvalidOptions, cfg.Registry, err)
+	}
+
+	r.cfg.Log.Info("building package", "path", srcPath, "reference", ref.String())
+
+	if err := r.cfg.Builder.BuildFromSource(ctx, srcPath,
+		WithInsecure(cfg.Insecure),
+		WithPush(true),
+		WithTags{ref.String()},
+	); err != nil {
+		return nil, err
+	}
+
+	image, err := utils.ImageURLWithOverride(ref.String(), cfg.ClusterRegistryHost)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOptions, err)
+	}
+
+	installOpts := []InstallPackageOption{
+		WithNamespace(cfg.Namespace),
+		WithComponent(cfg.Component),
+		WithPackageConfig{Config: cfg.Config},
+	}
+
+	_, err = client.GetPackage(ctx, pkgName, WithNamespace(cfg.Namespace))
+	switch {
+	case apimachineryerrors.IsNotFound(err):
+		r.cfg.Log.Info("creating package", "name", pkgName, "image", image)
+
+		return client.InstallPackage(ctx, pkgName, image, installOpts...)
+	case err != nil:
+		return nil, err
+	default:
+		r.cfg.Log.Info("updating package", "name", pkgName, "image", image)
+
+		return client.UpgradePackage(ctx, pkgName, image, installOpts...)
+	}
+}
+
+type RunPackageConfig struct {
+	Namespace string
+	Config    *runtime.RawExtension
+	Component string
+	// Repository prefix the package image is pushed to, e.g. "localhost:5001/dev".
+	Registry string
+	// Registry host used to reference the image from within the cluster,
+	// when it differs from the host the image is pushed to.
+	ClusterRegistryHost string
+	Insecure            bool
+}
+
+func (c *RunPackageConfig) Option(opts ...RunPackageOption) {
+	for _, opt := range opts {
+		opt.ConfigureRunPackage(c)
+	}
+}
+
+type RunPackageOption interface {
+	ConfigureRunPackage(*RunPackageConfig)
+}
+
+const defaultSourceWatchInterval = time.Second
+
+// NewSourceWatcher detects changes to the files below path by polling.
+func NewSourceWatcher(path string, interval time.Duration) *SourceWatcher {
+	if interval == 0 {
+		interval = defaultSourceWatchInterval
+	}
+
+	return &SourceWatcher{
+		path:     path,
+		interval: interval,
+	}
+}
+
+type SourceWatcher struct {
+	path     string
+	interval time.Duration
+	last     string
+}
+
+// Snapshot records the current state of the source files.
+func (w *SourceWatcher) Snapshot() error {
+	fp, err := w.fingerprint()
+	if err != nil {
+		return err
+	}
+
+	w.last = fp
+
+	return nil
+}
+
+// WaitForChange blocks until the source files differ from the last snapshot
+// and records a new snapshot afterwards.
+func (w *SourceWatcher) WaitForChange(ctx context.Context) error {
+	return wait.PollUntilContextCancel(ctx, w.interval, false, func(context.Context) (bool, error) {
+		fp, err := w.fingerprint()
+		if err != nil {
+			return false, err
+		}
+		if fp == w.last {
+			return false, nil
+		}
+
+		w.last = fp
+
+		return true, nil
+	})
+}
+
+// Hashes path, size and modification time of all files below the source path.
+func (w *SourceWatcher) fingerprint() (string, error) {
+	h := sha256.New()
+
+	err := filepath.WalkDir(w.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(h, "%s\x00%d\x00%d\n", path, info.Size(), info.ModTime().UnixNano())
+
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("walking source directory: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
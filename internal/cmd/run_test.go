@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestRun_RunPackage(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		ActualObjects []client.Object
+	}{
+		"creates package": {},
+		"updates package": {
+			ActualObjects: []client.Object{
+				&corev1alpha1.Package{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-pkg", Namespace: "dev"},
+					Spec:       corev1alpha1.PackageSpec{Image: "dev-registry:5001/my-pkg:dev-1"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme, err := NewScheme()
+			require.NoError(t, err)
+
+			fakeClient := fake.
+				NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.ActualObjects...).
+				Build()
+
+			builder := &sourceBuilderMock{}
+			builder.
+				On("BuildFromSource", mock.Anything, "src", mock.Anything).
+				Return(nil)
+
+			clock := &clockMock{}
+			clock.On("Now").Return(metav1.NewTime(time.Unix(1700000000, 0)))
+
+			r := NewRun(WithSourceBuilder{Builder: builder}, WithClock{Clock: clock})
+
+			_, err = r.RunPackage(context.Background(), NewClient(fakeClient), "my-pkg", "src",
+				WithNamespace("dev"),
+				WithRegistry("localhost:5001"),
+				WithClusterRegistryHost("dev-registry:5001"),
+				WithInsecure(true),
+			)
+			require.NoError(t, err)
+
+			var buildCfg BuildFromSourceConfig
+			buildCfg.Option(builder.Calls[0].Arguments.Get(2).([]BuildFromSourceOption)...)
+			assert.True(t, buildCfg.Push)
+			assert.True(t, buildCfg.Insecure)
+			assert.Equal(t, []string{"localhost:5001/my-pkg:dev-1700000000"}, buildCfg.Tags)
+
+			pkg := &corev1alpha1.Package{}
+			require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKey{
+				Name: "my-pkg", Namespace: "dev",
+			}, pkg))
+			assert.Equal(t, "dev-registry:5001/my-pkg:dev-1700000000", pkg.Spec.Image)
+		})
+	}
+}
+
+func TestRun_RunPackageMissingRegistry(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRun(WithSourceBuilder{Builder: &sourceBuilderMock{}}).
+		RunPackage(context.Background(), nil, "my-pkg", "src")
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func TestSourceWatcher(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "manifest.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("a"), 0o600))
+
+	w := NewSourceWatcher(dir, 10*time.Millisecond)
+	require.NoError(t, w.Snapshot())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.Error(t, w.WaitForChange(ctx), "unchanged sources must not be reported")
+
+	require.NoError(t, os.WriteFile(file, []byte("changed"), 0o600))
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, w.WaitForChange(ctx))
+}
+
+type sourceBuilderMock struct {
+	mock.Mock
+}
+
+func (m *sourceBuilderMock) BuildFromSource(
+	ctx context.Context, srcPath string, opts ...BuildFromSourceOption,
+) error {
+	args := m.Called(ctx, srcPath, opts)
+
+	return args.Error(0)
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/packages"
+)
+
+// ErrDirectoryNotEmpty is returned when scaffolding into a directory that already has content.
+var ErrDirectoryNotEmpty = errors.New("directory is not empty")
+
+// DefaultScaffoldPhases are the phases used when none are specified.
+var DefaultScaffoldPhases = []string{"deploy"}
+
+// DefaultScaffoldScopes are the scopes used when none are specified.
+var DefaultScaffoldScopes = []string{
+	string(manifestsv1alpha1.PackageManifestScopeCluster),
+	string(manifestsv1alpha1.PackageManifestScopeNamespaced),
+}
+
+func NewScaffolder() *Scaffolder {
+	return &Scaffolder{}
+}
+
+type Scaffolder struct{}
+
+// ScaffoldPackage writes a new minimal package into the given directory
+// and returns the list of files created, relative to that directory.
+func (s *Scaffolder) ScaffoldPackage(
+	_ context.Context, path string, opts ...ScaffoldPackageOption,
+) ([]string, error) {
+	var cfg ScaffoldPackageConfig
+
+	cfg.Option(opts...)
+	cfg.Default(path)
+
+	if err := ensureEmptyDir(path); err != nil {
+		return nil, err
+	}
+
+	scopes := make([]manifestsv1alpha1.PackageManifestScope, len(cfg.Scopes))
+	for i, scope := range cfg.Scopes {
+		scopes[i] = manifestsv1alpha1.PackageManifestScope(scope)
+	}
+
+	rawPkg, err := packages.Scaffold(cfg.Name, packages.ScaffoldOptions{
+		Scopes: scopes,
+		Phases: cfg.Phases,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArgs, err)
+	}
+
+	files := make([]string, 0, len(rawPkg.Files))
+	for file, data := range rawPkg.Files {
+		dst := filepath.Join(path, file)
+		if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+			return nil, fmt.Errorf("creating directory: %w", err)
+		}
+		if err := os.WriteFile(dst, data, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("writing file: %w", err)
+		}
+
+		files = append(files, file)
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func ensureEmptyDir(path string) error {
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("preflight check: %w", err)
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("%w: %s", ErrDirectoryNotEmpty, path)
+	}
+
+	return nil
+}
+
+type ScaffoldPackageConfig struct {
+	// Name of the package. Defaults to the name of the target directory.
+	Name   string
+	Scopes []string
+	Phases []string
+}
+
+func (c *ScaffoldPackageConfig) Option(opts ...ScaffoldPackageOption) {
+	for _, opt := range opts {
+		opt.ConfigureScaffoldPackage(c)
+	}
+}
+
+func (c *ScaffoldPackageConfig) Default(path string) {
+	if c.Name == "" {
+		if abs, err := filepath.Abs(path); err == nil {
+			c.Name = filepath.Base(abs)
+		}
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = DefaultScaffoldScopes
+	}
+	if len(c.Phases) == 0 {
+		c.Phases = DefaultScaffoldPhases
+	}
+}
+
+type ScaffoldPackageOption interface {
+	ConfigureScaffoldPackage(*ScaffoldPackageConfig)
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+
+	"package-operator.run/internal/packages"
+)
+
+func NewSignature(opts ...SignatureOption) *Signature {
+	var cfg SignatureConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Signature{
+		cfg: cfg,
+	}
+}
+
+// Signature signs package images by wrapping cosign
+// and verifies them using the same code path as the package-operator.
+type Signature struct {
+	cfg SignatureConfig
+}
+
+type SignatureConfig struct {
+	Log logr.Logger
+	// Path to the cosign binary, looked up in $PATH by default.
+	Cosign   string
+	Exec     ExecFn
+	Resolver DigestResolver
+}
+
+// ExecFn runs an external command to completion.
+type ExecFn func(ctx context.Context, name string, args ...string) error
+
+func (c *SignatureConfig) Option(opts ...SignatureOption) {
+	for _, opt := range opts {
+		opt.ConfigureSignature(c)
+	}
+}
+
+func (c *SignatureConfig) Default() {
+	if c.Log.GetSink() == nil {
+		c.Log = logr.Discard()
+	}
+	if c.Cosign == "" {
+		c.Cosign = "cosign"
+	}
+	if c.Exec == nil {
+		c.Exec = execInteractive
+	}
+	c.Resolver = resolverOrDefaultResolver(c.Resolver)
+}
+
+type SignatureOption interface {
+	ConfigureSignature(*SignatureConfig)
+}
+
+// execInteractive connects the command to the terminal,
+// so cosign can prompt for key passwords and keyless sign-in.
+func execInteractive(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// SignImage resolves the digest of the given image reference and signs it with cosign.
+// Without a key, cosign signs keyless using a short lived certificate for the OIDC identity of the user.
+// Returns the signed digest reference.
+func (s *Signature) SignImage(ctx context.Context, ref string, opts ...SignImageOption) (string, error) {
+	var cfg SignImageConfig
+
+	cfg.Option(opts...)
+
+	parsedRef, err := name.ParseReference(ref)
+	if err != nil {
+		return "", fmt.Errorf("%w: parsing reference: %w", ErrInvalidArgs, err)
+	}
+
+	digest, err := s.cfg.Resolver.ResolveDigest(ref, WithInsecure(cfg.Insecure))
+	if err != nil {
+		return "", fmt.Errorf("resolving digest: %w", err)
+	}
+
+	// Signing by digest ensures the signed image can't be swapped out from under the tag.
+	digestRef := parsedRef.Context().Digest(digest).String()
+
+	args := []string{"sign", "--yes"}
+	if cfg.Key != "" {
+		args = append(args, "--key", cfg.Key)
+	}
+	if cfg.Insecure {
+		args = append(args, "--allow-insecure-registry")
+	}
+	args = append(args, digestRef)
+
+	s.cfg.Log.Info("signing image", "reference", digestRef, "keyless", cfg.Key == "")
+
+	if err := s.cfg.Exec(ctx, s.cfg.Cosign, args...); err != nil {
+		return "", fmt.Errorf("running cosign: %w", err)
+	}
+
+	return digestRef, nil
+}
+
+type SignImageConfig struct {
+	Insecure bool
+	// Key reference passed to cosign. Signs keyless if empty.
+	Key string
+}
+
+func (c *SignImageConfig) Option(opts ...SignImageOption) {
+	for _, opt := range opts {
+		opt.ConfigureSignImage(c)
+	}
+}
+
+type SignImageOption interface {
+	ConfigureSignImage(*SignImageConfig)
+}
+
+// VerifyImage checks the cosign signatures of the given image reference against the verification policy.
+// The package-operator enforces the same policy when pulling package images.
+func (s *Signature) VerifyImage(
+	ctx context.Context, ref string, opts ...VerifyImageOption,
+) (*packages.VerifiedSignature, error) {
+	var cfg VerifyImageConfig
+
+	cfg.Option(opts...)
+
+	if _, err := name.ParseReference(ref); err != nil {
+		return nil, fmt.Errorf("%w: parsing reference: %w", ErrInvalidArgs, err)
+	}
+
+	policy, err := packages.LoadVerificationPolicy(cfg.Policy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOptions, err)
+	}
+	if policy.Empty() {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOptions, packages.ErrEmptyPolicy)
+	}
+
+	craneOpts, err := registryOptions(cfg.Insecure, cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cfg.Log.Info("verifying image", "reference", ref)
+
+	return packages.VerifySignature(ctx, ref, policy, craneOpts...)
+}
+
+type VerifyImageConfig struct {
+	Insecure bool
+	// Path to a PEM encoded CA bundle used to verify the registry certificate,
+	// in addition to the system certificate pool.
+	CAFile string
+	Policy packages.VerificationPolicyConfig
+}
+
+func (c *VerifyImageConfig) Option(opts ...VerifyImageOption) {
+	for _, opt := range opts {
+		opt.ConfigureVerifyImage(c)
+	}
+}
+
+type VerifyImageOption interface {
+	ConfigureVerifyImage(*VerifyImageConfig)
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages"
+)
+
+const testSignDigest = "sha256:4ec2f57b7ee5e9a2e4b8da6e6c2e8b4fd2a4ecb9f0ce3b5e0cf9c4d8b8e6e4b2"
+
+func TestSignature_SignImage(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Options  []SignImageOption
+		Expected []string
+	}{
+		"keyless": {
+			Expected: []string{"sign", "--yes", "quay.io/pkg@" + testSignDigest},
+		},
+		"key": {
+			Options: []SignImageOption{WithKey("cosign.key"), WithInsecure(true)},
+			Expected: []string{
+				"sign", "--yes", "--key", "cosign.key", "--allow-insecure-registry", "quay.io/pkg@" + testSignDigest,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := &digestResolverMock{}
+			resolver.
+				On("ResolveDigest", "quay.io/pkg:v1", mock.Anything).
+				Return(testSignDigest, nil)
+
+			var (
+				executed string
+				args     []string
+			)
+			s := NewSignature(
+				WithDigestResolver{Resolver: resolver},
+				WithExec{Exec: func(_ context.Context, name string, a ...string) error {
+					executed = name
+					args = a
+					return nil
+				}},
+			)
+
+			signed, err := s.SignImage(context.Background(), "quay.io/pkg:v1", tc.Options...)
+			require.NoError(t, err)
+			assert.Equal(t, "quay.io/pkg@"+testSignDigest, signed)
+			assert.Equal(t, "cosign", executed)
+			assert.Equal(t, tc.Expected, args)
+		})
+	}
+}
+
+func TestSignature_SignImageInvalidReference(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSignature().SignImage(context.Background(), "Not A Reference")
+	require.ErrorIs(t, err, ErrInvalidArgs)
+}
+
+func TestSignature_VerifyImageEmptyPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSignature().VerifyImage(context.Background(), "quay.io/pkg:v1")
+	require.ErrorIs(t, err, ErrInvalidOptions)
+	require.ErrorIs(t, err, packages.ErrEmptyPolicy)
+}
+
+func TestSignature_VerifyImageMissingRoots(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSignature().VerifyImage(context.Background(), "quay.io/pkg:v1",
+		WithVerificationPolicy{Config: packages.VerificationPolicyConfig{
+			Identities: []packages.VerificationIdentity{{Issuer: "https://issuer", Subject: "me@example.com"}},
+		}})
+	require.ErrorIs(t, err, ErrInvalidOptions)
+	require.ErrorIs(t, err, packages.ErrMissingRoots)
+}
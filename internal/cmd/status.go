@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// GetPackageStatus aggregates the status of a (Cluster)Package,
+// its (Cluster)ObjectDeployment and all (Cluster)ObjectSet revisions.
+func (c *Client) GetPackageStatus(
+	ctx context.Context, name string, opts ...GetPackageOption,
+) (*PackageStatus, error) {
+	var cfg GetPackageConfig
+
+	cfg.Option(opts...)
+
+	pkg, err := c.GetPackage(ctx, name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &PackageStatus{
+		Kind:       "ClusterPackage",
+		Name:       pkg.Name(),
+		Namespace:  pkg.Namespace(),
+		Image:      pkg.spec().Image,
+		Phase:      string(pkg.Phase()),
+		Revision:   pkg.CurrentRevision(),
+		Conditions: pkg.Conditions(),
+	}
+	if cfg.Namespace != "" {
+		status.Kind = "Package"
+	}
+
+	deploy, err := c.GetObjectDeployment(ctx, name, WithNamespace(cfg.Namespace))
+	switch {
+	case apimachineryerrors.IsNotFound(err):
+		// Package has not been unpacked yet.
+	case err != nil:
+		return nil, err
+	default:
+		status.ObjectDeployment = &ObjectDeploymentStatus{
+			Name:       deploy.Name(),
+			Phase:      string(deploy.Phase()),
+			Revision:   deploy.CurrentRevision(),
+			Conditions: deploy.Conditions(),
+		}
+	}
+
+	sets, err := pkg.ObjectSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// newest revision first.
+	slices.SortFunc(sets, func(a, b ObjectSet) int {
+		return int(b.Revision() - a.Revision())
+	})
+
+	for _, set := range sets {
+		status.Revisions = append(status.Revisions, newRevisionStatus(set))
+	}
+
+	return status, nil
+}
+
+// PackageStatus is an aggregated view over all objects making up a package installation.
+type PackageStatus struct {
+	Kind             string                  `json:"kind"`
+	Name             string                  `json:"name"`
+	Namespace        string                  `json:"namespace,omitempty"`
+	Image            string                  `json:"image"`
+	Phase            string                  `json:"phase,omitempty"`
+	Revision         int64                   `json:"revision,omitempty"`
+	Conditions       []metav1.Condition      `json:"conditions,omitempty"`
+	ObjectDeployment *ObjectDeploymentStatus `json:"objectDeployment,omitempty"`
+	Revisions        []RevisionStatus        `json:"revisions,omitempty"`
+}
+
+type ObjectDeploymentStatus struct {
+	Name       string             `json:"name"`
+	Phase      string             `json:"phase,omitempty"`
+	Revision   int64              `json:"revision,omitempty"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// RevisionStatus summarizes a single (Cluster)ObjectSet.
+type RevisionStatus struct {
+	Name           string `json:"name"`
+	Revision       int64  `json:"revision"`
+	LifecycleState string `json:"lifecycleState,omitempty"`
+	Phase          string `json:"phase,omitempty"`
+	// Rollout progress of every phase.
+	// Not reported for archived revisions.
+	Phases []PhaseProgress `json:"phases,omitempty"`
+	// Probe failures blocking the revision from becoming available.
+	FailingProbes *FailingProbes `json:"failingProbes,omitempty"`
+	// Conditions mapped from objects into the ObjectSet.
+	MappedConditions []metav1.Condition `json:"mappedConditions,omitempty"`
+}
+
+// PhaseProgress reports how far the rollout of a single phase has progressed.
+type PhaseProgress struct {
+	Name  string     `json:"name"`
+	Class string     `json:"class,omitempty"`
+	State PhaseState `json:"state"`
+	// Number of objects in this phase.
+	Objects int `json:"objects"`
+	// Number of objects the revision is already controller of.
+	Controlled int `json:"controlled"`
+}
+
+type PhaseState string
+
+const (
+	PhaseStateComplete PhaseState = "Complete"
+	PhaseStateFailed   PhaseState = "Failed"
+	PhaseStatePending  PhaseState = "Pending"
+)
+
+type FailingProbes struct {
+	Phase   string `json:"phase"`
+	Message string `json:"message"`
+}
+
+// matches messages produced by controllers.ProbingResult.
+var probeFailureMessageRegEx = regexp.MustCompile(`^Phase "([^"]*)" failed: (.*)$`)
+
+func newRevisionStatus(set ObjectSet) RevisionStatus {
+	rev := RevisionStatus{
+		Name:           set.Name(),
+		Revision:       set.Revision(),
+		LifecycleState: string(set.LifecycleState()),
+		Phase:          string(set.Phase()),
+	}
+
+	for _, cond := range set.Conditions() {
+		if strings.Contains(cond.Type, "/") {
+			rev.MappedConditions = append(rev.MappedConditions, cond)
+		}
+	}
+
+	if set.IsArchived() {
+		return rev
+	}
+
+	available := meta.FindStatusCondition(set.Conditions(), corev1alpha1.ObjectSetAvailable)
+	if available != nil && available.Status == metav1.ConditionFalse {
+		if m := probeFailureMessageRegEx.FindStringSubmatch(available.Message); m != nil {
+			rev.FailingProbes = &FailingProbes{Phase: m[1], Message: m[2]}
+		}
+	}
+
+	isAvailable := available != nil && available.Status == metav1.ConditionTrue
+	reachedFailedPhase := false
+
+	for _, phase := range set.Phases() {
+		progress := PhaseProgress{
+			Name:       phase.Name,
+			Class:      phase.Class,
+			Objects:    len(phase.Objects),
+			Controlled: countControlled(set, phase),
+		}
+
+		switch {
+		case isAvailable:
+			progress.State = PhaseStateComplete
+		case reachedFailedPhase:
+			progress.State = PhaseStatePending
+		case rev.FailingProbes != nil && rev.FailingProbes.Phase == phase.Name:
+			progress.State = PhaseStateFailed
+			reachedFailedPhase = true
+		case rev.FailingProbes != nil:
+			progress.State = PhaseStateComplete
+		default:
+			progress.State = PhaseStatePending
+		}
+
+		rev.Phases = append(rev.Phases, progress)
+	}
+
+	return rev
+}
+
+func countControlled(set ObjectSet, phase corev1alpha1.ObjectSetTemplatePhase) int {
+	var count int
+
+	for _, phaseObj := range phase.Objects {
+		gvk := phaseObj.Object.GroupVersionKind()
+
+		if slices.ContainsFunc(set.ControllerOf(), func(ref corev1alpha1.ControlledObjectReference) bool {
+			return ref.Group == gvk.Group &&
+				ref.Kind == gvk.Kind &&
+				ref.Name == phaseObj.Object.GetName() &&
+				(phaseObj.Object.GetNamespace() == "" || ref.Namespace == phaseObj.Object.GetNamespace())
+		}) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (s *PackageStatus) RenderJSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "    ")
+}
+
+func (s *PackageStatus) RenderYAML() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// RenderText renders a human readable summary.
+func (s *PackageStatus) RenderText() string {
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s %s\n", s.Kind, objectKeyString(s.Namespace, s.Name))
+	fmt.Fprintf(w, "Image:\t%s\n", s.Image)
+	fmt.Fprintf(w, "Phase:\t%s\n", s.Phase)
+	fmt.Fprintf(w, "Revision:\t%d\n", s.Revision)
+	writeConditions(w, "", s.Conditions)
+
+	if d := s.ObjectDeployment; d != nil {
+		fmt.Fprintf(w, "\nObjectDeployment %s\n", objectKeyString(s.Namespace, d.Name))
+		fmt.Fprintf(w, "Phase:\t%s\n", d.Phase)
+		fmt.Fprintf(w, "Revision:\t%d\n", d.Revision)
+		writeConditions(w, "", d.Conditions)
+	}
+
+	if len(s.Revisions) > 0 {
+		fmt.Fprintln(w, "\nRevisions:")
+	}
+
+	for _, rev := range s.Revisions {
+		fmt.Fprintf(w, "  #%d %s\t(%s, %s)\n", rev.Revision, rev.Name, rev.LifecycleState, rev.Phase)
+
+		for _, phase := range rev.Phases {
+			objects := fmt.Sprintf("%d/%d objects", phase.Controlled, phase.Objects)
+			if phase.Class != "" {
+				objects = "remote: " + phase.Class
+			}
+
+			fmt.Fprintf(w, "    Phase %s\t%s\t%s\n", phase.Name, phase.State, objects)
+		}
+
+		if rev.FailingProbes != nil {
+			fmt.Fprintf(w, "    Failing probes in phase %s:\n", rev.FailingProbes.Phase)
+
+			for _, msg := range strings.Split(rev.FailingProbes.Message, ", ") {
+				fmt.Fprintf(w, "      - %s\n", msg)
+			}
+		}
+
+		if len(rev.MappedConditions) > 0 {
+			writeConditions(w, "    Mapped ", rev.MappedConditions)
+		}
+	}
+
+	_ = w.Flush()
+
+	return buf.String()
+}
+
+func writeConditions(w *tabwriter.Writer, prefix string, conds []metav1.Condition) {
+	if len(conds) == 0 {
+		return
+	}
+
+	indent := strings.Repeat(" ", len(prefix)-len(strings.TrimLeft(prefix, " "))+2)
+
+	fmt.Fprintf(w, "%sConditions:\n", prefix)
+
+	for _, cond := range conds {
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\n", indent, cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+}
+
+func objectKeyString(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "/" + name
+}
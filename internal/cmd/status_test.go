@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+func TestClient_GetPackageStatus(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+
+	deployment := unstructured.Unstructured{}
+	deployment.SetAPIVersion("apps/v1")
+	deployment.SetKind("Deployment")
+	deployment.SetName("test")
+
+	namespace := unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind("Namespace")
+	namespace.SetName("test")
+
+	labels := map[string]string{manifestsv1alpha1.PackageInstanceLabel: "test"}
+
+	c := NewClient(fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1alpha1.ClusterPackage{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       corev1alpha1.PackageSpec{Image: "quay.io/test:v2"},
+				Status: corev1alpha1.PackageStatus{
+					Phase:    corev1alpha1.PackagePhaseProgressing,
+					Revision: 2,
+				},
+			},
+			&corev1alpha1.ClusterObjectDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: labels},
+				Status: corev1alpha1.ClusterObjectDeploymentStatus{
+					Revision: 2,
+				},
+			},
+			&corev1alpha1.ClusterObjectSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-v1", Labels: labels},
+				Spec: corev1alpha1.ClusterObjectSetSpec{
+					LifecycleState: corev1alpha1.ObjectSetLifecycleStateArchived,
+				},
+				Status: corev1alpha1.ClusterObjectSetStatus{Revision: 1},
+			},
+			&corev1alpha1.ClusterObjectSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-v2", Labels: labels},
+				Spec: corev1alpha1.ClusterObjectSetSpec{
+					LifecycleState: corev1alpha1.ObjectSetLifecycleStateActive,
+					ObjectSetTemplateSpec: corev1alpha1.ObjectSetTemplateSpec{
+						Phases: []corev1alpha1.ObjectSetTemplatePhase{
+							{Name: "namespace", Objects: []corev1alpha1.ObjectSetObject{{Object: namespace}}},
+							{Name: "deploy", Objects: []corev1alpha1.ObjectSetObject{{Object: deployment}}},
+							{Name: "post"},
+						},
+					},
+				},
+				Status: corev1alpha1.ClusterObjectSetStatus{
+					Revision: 2,
+					Conditions: []metav1.Condition{
+						{
+							Type:    corev1alpha1.ObjectSetAvailable,
+							Status:  metav1.ConditionFalse,
+							Reason:  "ProbeFailure",
+							Message: `Phase "deploy" failed: Deployment apps/v1 /test: condition "Available" == "True": not found`,
+						},
+						{
+							Type:   "my-org.io/Ready",
+							Status: metav1.ConditionTrue,
+						},
+					},
+					ControllerOf: []corev1alpha1.ControlledObjectReference{
+						{Kind: "Namespace", Name: "test"},
+						{Kind: "Deployment", Group: "apps", Name: "test"},
+					},
+				},
+			},
+		).
+		Build())
+
+	status, err := c.GetPackageStatus(context.Background(), "test")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ClusterPackage", status.Kind)
+	assert.Equal(t, "quay.io/test:v2", status.Image)
+	require.NotNil(t, status.ObjectDeployment)
+	require.Len(t, status.Revisions, 2)
+
+	active := status.Revisions[0]
+	assert.Equal(t, int64(2), active.Revision)
+	require.NotNil(t, active.FailingProbes)
+	assert.Equal(t, "deploy", active.FailingProbes.Phase)
+	assert.Equal(t, []PhaseProgress{
+		{Name: "namespace", State: PhaseStateComplete, Objects: 1, Controlled: 1},
+		{Name: "deploy", State: PhaseStateFailed, Objects: 1, Controlled: 1},
+		{Name: "post", State: PhaseStatePending},
+	}, active.Phases)
+	require.Len(t, active.MappedConditions, 1)
+	assert.Equal(t, "my-org.io/Ready", active.MappedConditions[0].Type)
+
+	archived := status.Revisions[1]
+	assert.Equal(t, int64(1), archived.Revision)
+	assert.Empty(t, archived.Phases)
+
+	text := status.RenderText()
+	assert.Contains(t, text, "ClusterPackage test")
+	assert.Contains(t, text, "Failing probes in phase deploy:")
+}
+
+func TestClient_GetPackageStatus_NotFound(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+
+	c := NewClient(fake.NewClientBuilder().WithScheme(scheme).Build())
+
+	_, err = c.GetPackageStatus(context.Background(), "dne", WithNamespace("default"))
+	require.Error(t, err)
+}
@@ -2,23 +2,16 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"os"
 
 	"github.com/disiqueira/gotree"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"k8s.io/apimachinery/pkg/util/validation/field"
-
 	"package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
-	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/packages"
-	"package-operator.run/internal/utils"
 )
 
 func NewTree(scheme *runtime.Scheme, opts ...TreeOption) *Tree {
@@ -77,29 +70,13 @@ func (t *Tree) RenderPackage(ctx context.Context, srcPath string, opts ...Render
 		return "", fmt.Errorf("parsing package contents: %w", err)
 	}
 
-	tmplCtx := t.getTemplateContext(pkg, cfg)
-	tmplCfg, err := t.getConfig(pkg, cfg)
+	tmplCtx, scope, err := newPackageRenderContext(ctx, pkg, cfg)
 	if err != nil {
-		return "", fmt.Errorf("getting config: %w", err)
-	}
-
-	validationErrors, err := packages.AdmitPackageConfiguration(
-		ctx, tmplCfg, pkg.Manifest, field.NewPath("spec", "config"))
-	if err != nil {
-		return "", fmt.Errorf("validate Package configuration: %w", err)
-	}
-	if len(validationErrors) > 0 {
-		return "", validationErrors.ToAggregate()
+		return "", err
 	}
 
-	tmplCtx.Config = tmplCfg
-	tmplCtx.Images = utils.GenerateStaticImages(pkg.Manifest)
-
 	pkgPrefix := "Package"
-	scope := manifestsv1alpha1.PackageManifestScopeNamespaced
-	if cfg.ClusterScope || len(tmplCtx.Package.Namespace) == 0 {
-		scope = manifestsv1alpha1.PackageManifestScopeCluster
-		tmplCtx.Package.Namespace = ""
+	if scope == manifestsv1alpha1.PackageManifestScopeCluster {
 		pkgPrefix = "ClusterPackage"
 	}
 
@@ -125,81 +102,6 @@ func (t *Tree) RenderPackage(ctx context.Context, srcPath string, opts ...Render
 	return pkgTree.Print(), nil
 }
 
-func (t *Tree) getTemplateContext(pkg *packages.Package, cfg RenderPackageConfig) packages.PackageRenderContext {
-	templateContext := packages.PackageRenderContext{
-		Package: manifests.TemplateContextPackage{
-			TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{
-				Name:      "name",
-				Namespace: "namespace",
-			},
-		},
-	}
-
-	switch {
-	case cfg.ConfigTestcase != "":
-		for _, test := range pkg.Manifest.Test.Template {
-			if test.Name != cfg.ConfigTestcase {
-				continue
-			}
-
-			templateContext = packages.PackageRenderContext{
-				Package: test.Context.Package,
-			}
-		}
-	case len(pkg.Manifest.Test.Template) > 0:
-		test := pkg.Manifest.Test.Template[0]
-
-		templateContext = packages.PackageRenderContext{
-			Package: test.Context.Package,
-		}
-	}
-
-	return templateContext
-}
-
-func (t *Tree) getConfig(pkg *packages.Package, cfg RenderPackageConfig) (map[string]any, error) {
-	config := map[string]any{}
-
-	switch {
-	case cfg.ConfigPath != "":
-		data, err := os.ReadFile(cfg.ConfigPath)
-		if err != nil {
-			return nil, fmt.Errorf("read config from file: %w", err)
-		}
-		if err := yaml.Unmarshal(data, &config); err != nil {
-			return nil, fmt.Errorf("unmarshal config from file %s: %w", cfg.ConfigPath, err)
-		}
-	case cfg.ConfigTestcase != "":
-		for _, test := range pkg.Manifest.Test.Template {
-			if test.Name != cfg.ConfigTestcase {
-				continue
-			}
-
-			if test.Context.Config == nil {
-				return config, nil
-			}
-			if err := json.Unmarshal(test.Context.Config.Raw, &config); err != nil {
-				return nil, fmt.Errorf("unmarshal config from test template %s: %w", cfg.ConfigTestcase, err)
-			}
-		}
-
-		if config == nil {
-			return nil, fmt.Errorf("%w: test template with name %s not found", ErrInvalidArgs, cfg.ConfigTestcase)
-		}
-	case len(pkg.Manifest.Test.Template) > 0:
-		testCtxCfg := pkg.Manifest.Test.Template[0].Context.Config
-		if testCtxCfg == nil {
-			return config, nil
-		}
-
-		if err := json.Unmarshal(testCtxCfg.Raw, &config); err != nil {
-			return nil, fmt.Errorf("unmarshal config from first test template: %w", err)
-		}
-	}
-
-	return config, nil
-}
-
 func newTreeFromSpec(header string, spec v1alpha1.ObjectSetTemplateSpec) gotree.Tree {
 	tree := gotree.New(header)
 
@@ -216,20 +118,3 @@ func newTreeFromSpec(header string, spec v1alpha1.ObjectSetTemplateSpec) gotree.
 
 	return tree
 }
-
-type RenderPackageConfig struct {
-	ClusterScope   bool
-	ConfigPath     string
-	ConfigTestcase string
-	Component      string
-}
-
-func (c *RenderPackageConfig) Option(opts ...RenderPackageOption) {
-	for _, opt := range opts {
-		opt.ConfigureRenderPackage(c)
-	}
-}
-
-type RenderPackageOption interface {
-	ConfigureRenderPackage(*RenderPackageConfig)
-}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/crane"
@@ -65,8 +67,9 @@ func (v *Validate) ValidatePackage(ctx context.Context, opts ...ValidatePackageO
 	}
 
 	var (
-		rawPkg     *packages.RawPackage
-		validators = packages.DefaultPackageValidators
+		rawPkg         *packages.RawPackage
+		validators     = packages.DefaultPackageValidators
+		lintConfigPath = cfg.LintConfigPath
 	)
 
 	if cfg.Path != "" {
@@ -77,7 +80,12 @@ func (v *Validate) ValidatePackage(ctx context.Context, opts ...ValidatePackageO
 			return fmt.Errorf("getting package from path: %w", err)
 		}
 
-		validators = append(validators, packages.NewTemplateTestValidator(cfg.Path))
+		validators = append(validators, packages.NewTemplateTestValidator(
+			cfg.Path, packages.WithUpdateFixtures(cfg.UpdateFixtures)))
+
+		if lintConfigPath == "" {
+			lintConfigPath = filepath.Join(cfg.Path, packages.LintConfigFilename)
+		}
 	} else {
 		var err error
 
@@ -87,10 +95,20 @@ func (v *Validate) ValidatePackage(ctx context.Context, opts ...ValidatePackageO
 		}
 	}
 
+	lintValidator, err := newLintValidator(lintConfigPath, cfg.LintConfigPath != "", cfg.LintWarning)
+	if err != nil {
+		return err
+	}
+
+	validators = append(validators, lintValidator)
+
 	pkg, err := packages.DefaultStructuralLoader.Load(ctx, rawPkg)
 	if err != nil {
 		return err
 	}
+	if cfg.Strict {
+		enableStrictRendering(pkg)
+	}
 
 	if err := validators.ValidatePackage(ctx, pkg); err != nil {
 		return fmt.Errorf("loading package from files: %w", err)
@@ -99,6 +117,27 @@ func (v *Validate) ValidatePackage(ctx context.Context, opts ...ValidatePackageO
 	return nil
 }
 
+// Loads the lint config from the given path, if set.
+// A missing config file is only an error if it was requested explicitly.
+func newLintValidator(path string, explicit bool, warn func(error)) (*packages.LintValidator, error) {
+	var lintCfg packages.LintConfig
+
+	if path != "" {
+		if _, err := os.Stat(path); explicit && err != nil {
+			return nil, fmt.Errorf("loading lint config: %w", err)
+		}
+
+		var err error
+
+		lintCfg, err = packages.LoadLintConfig(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return packages.NewLintValidator(lintCfg, warn)
+}
+
 func getPackageFromPath(ctx context.Context, path string) (*packages.RawPackage, error) {
 	rawPkg, err := packages.FromFolder(ctx, path)
 	if err != nil {
@@ -107,6 +146,15 @@ func getPackageFromPath(ctx context.Context, path string) (*packages.RawPackage,
 	return rawPkg, nil
 }
 
+// Enables strict rendering for the package and all of its components,
+// regardless of what their manifests declare.
+func enableStrictRendering(pkg *packages.Package) {
+	pkg.Manifest.Spec.Rendering.Strict = true
+	for _, component := range pkg.Components {
+		component.Manifest.Spec.Rendering.Strict = true
+	}
+}
+
 func (v *Validate) getPackageFromRemoteRef(
 	ctx context.Context, cfg ValidatePackageConfig,
 ) (*packages.RawPackage, error) {
@@ -132,6 +180,15 @@ type ValidatePackageConfig struct {
 	Insecure        bool
 	Path            string
 	RemoteReference string
+	// Path to the lint config file.
+	// Defaults to .package-lint.yaml in the package directory.
+	LintConfigPath string
+	// Called for lint findings with warning severity.
+	LintWarning func(warning error)
+	// Regenerate test fixtures instead of comparing against them.
+	UpdateFixtures bool
+	// Enforce strict rendering, even if not enabled in the PackageManifest.
+	Strict bool
 }
 
 func (c *ValidatePackageConfig) Option(opts ...ValidatePackageOption) {
@@ -149,6 +206,9 @@ func (c *ValidatePackageConfig) Validate() error {
 	if c.Path != "" && c.RemoteReference != "" {
 		return fmt.Errorf("%w: 'Path' and 'RemoteReference' are mutually exclusive", ErrInvalidOptions)
 	}
+	if c.UpdateFixtures && c.Path == "" {
+		return fmt.Errorf("%w: 'UpdateFixtures' requires 'Path'", ErrInvalidOptions)
+	}
 
 	return nil
 }
@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	_ "embed"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-containerregistry/pkg/crane"
@@ -125,6 +127,54 @@ func TestValidate_ValidatePackage(t *testing.T) {
 	}
 }
 
+func TestValidate_ValidatePackageStrict(t *testing.T) {
+	t.Parallel()
+
+	// The template test passes config which is not declared in a schema.
+	const manifest = `apiVersion: manifests.package-operator.run/v1alpha1
+kind: PackageManifest
+metadata:
+  name: test-stub
+spec:
+  scopes:
+  - Cluster
+  phases:
+  - name: deploy
+test:
+  template:
+  - name: typo
+    context:
+      config:
+        replicsa: 3
+      package:
+        metadata:
+          name: test
+`
+
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		Strict    bool
+		Assertion require.ErrorAssertionFunc
+	}{
+		"lax":    {Assertion: require.NoError},
+		"strict": {Strict: true, Assertion: require.Error},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.yaml"), []byte(manifest), 0o600))
+
+			tc.Assertion(t, NewValidate(scheme).ValidatePackage(context.Background(),
+				WithPath(dir),
+				WithStrict(tc.Strict),
+			))
+		})
+	}
+}
+
 type pullerMock struct {
 	mock.Mock
 }
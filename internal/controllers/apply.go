@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"package-operator.run/internal/constants"
+	"package-operator.run/internal/errorclass"
+	"package-operator.run/pkg/phasecontroller"
+)
+
+const (
+	ErrorReasonApplyConflict            = ErrorReason(phasecontroller.ApplyErrorReasonConflict)
+	ErrorReasonFieldConflict            = ErrorReason(phasecontroller.ApplyErrorReasonFieldConflict)
+	ErrorReasonAdmissionWebhookRejected = ErrorReason(phasecontroller.ApplyErrorReasonAdmissionWebhookRejected)
+	ErrorReasonInvalid                  = ErrorReason(phasecontroller.ApplyErrorReasonInvalid)
+	ErrorReasonForbidden                = ErrorReason(phasecontroller.ApplyErrorReasonForbidden)
+)
+
+// Apply patches the given object using server-side apply
+// with the Package Operator field owner, unless another client.FieldOwner is passed.
+// Builds on phasecontroller.Apply, which phase controllers outside of Package Operator use as well,
+// and returns the errors it classifies as *ApplyError, so they are surfaced the same way by all controllers.
+func Apply(
+	ctx context.Context, writer client.Writer,
+	obj client.Object, patch client.Patch, opts ...client.PatchOption,
+) error {
+	return toApplyError(phasecontroller.Apply(ctx, writer, constants.FieldOwner, obj, patch, opts...))
+}
+
+// ClassifyApplyError wraps errors returned when applying an object into an *ApplyError.
+// Errors that can not be classified are returned unchanged.
+func ClassifyApplyError(obj client.Object, err error) error {
+	return toApplyError(phasecontroller.ClassifyApplyError(obj, err))
+}
+
+// Converts a *phasecontroller.ApplyError into an *ApplyError,
+// which adds error classes and condition reasons.
+func toApplyError(err error) error {
+	var applyErr *phasecontroller.ApplyError
+	if !errors.As(err, &applyErr) {
+		return err
+	}
+	return &ApplyError{
+		ObjectGVK: applyErr.ObjectGVK,
+		ObjectKey: applyErr.ObjectKey,
+		Reason:    ErrorReason(applyErr.Reason),
+		Err:       applyErr.Err,
+	}
+}
+
+// This error is returned when the API server refused to apply an object.
+type ApplyError struct {
+	ObjectGVK schema.GroupVersionKind
+	ObjectKey client.ObjectKey
+	Reason    ErrorReason
+	Err       error
+}
+
+func (e *ApplyError) Error() string {
+	return fmt.Sprintf("%s %s %s: %v", e.ObjectGVK, e.ObjectKey, e.Reason, e.Err)
+}
+
+func (e *ApplyError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ApplyError) CausedBy(reason ErrorReason) bool {
+	return e.Reason == reason
+}
+
+// ErrorClass implements errorclass.Of.
+func (e *ApplyError) ErrorClass() errorclass.Class {
+	switch e.Reason {
+	case ErrorReasonAdmissionWebhookRejected:
+		return errorclass.WebhookRejected
+	case ErrorReasonForbidden:
+		return errorclass.RBAC
+	case ErrorReasonInvalid, ErrorReasonFieldConflict:
+		return errorclass.Config
+	}
+	return errorclass.Infrastructure
+}
+
+// Returns the reason used in status conditions
+// reporting that an object could not be applied.
+func (e *ApplyError) ConditionReason() string {
+	switch e.Reason {
+	case ErrorReasonAdmissionWebhookRejected:
+		return "AdmissionWebhookRejected"
+	case ErrorReasonInvalid:
+		return "InvalidObject"
+	case ErrorReasonForbidden:
+		return "MissingPermissions"
+	case ErrorReasonApplyConflict:
+		return "Conflict"
+	case ErrorReasonFieldConflict:
+		return "FieldConflict"
+	}
+	return "ApplyFailed"
+}
@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"package-operator.run/internal/constants"
+	"package-operator.run/internal/testutil"
+)
+
+// Returned by the API server, when server-side apply conflicts with other field managers.
+func newFieldManagerConflictError() error {
+	return &apimachineryerrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    409,
+		Reason:  metav1.StatusReasonConflict,
+		Message: `Apply failed with 1 conflict: conflict with "kubectl-edit" using v1: .data.key`,
+		Details: &metav1.StatusDetails{
+			Causes: []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: `conflict with "kubectl-edit" using v1`,
+				Field:   ".data.key",
+			}},
+		},
+	}}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	gr := schema.GroupResource{Resource: "configmaps"}
+	conflictErr := apimachineryerrors.NewConflict(gr, "test", errTest)
+
+	t.Run("applies as Package Operator", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		c.
+			On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		err := Apply(context.Background(), c, &corev1.ConfigMap{}, client.Apply)
+		require.NoError(t, err)
+		c.AssertCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything,
+			[]client.PatchOption{client.FieldOwner(constants.FieldOwner)})
+	})
+
+	t.Run("retries conflicts", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		c.
+			On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(conflictErr).Once()
+		c.
+			On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).Once()
+
+		err := Apply(context.Background(), c, &corev1.ConfigMap{}, client.Apply)
+		require.NoError(t, err)
+		c.AssertNumberOfCalls(t, "Patch", 2)
+	})
+
+	t.Run("gives up on conflicts", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		c.
+			On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(conflictErr)
+
+		err := Apply(context.Background(), c, &corev1.ConfigMap{}, client.Apply)
+		var applyErr *ApplyError
+		require.ErrorAs(t, err, &applyErr)
+		assert.True(t, applyErr.CausedBy(ErrorReasonApplyConflict))
+		assert.True(t, apimachineryerrors.IsConflict(err))
+		c.AssertNumberOfCalls(t, "Patch", retry.DefaultRetry.Steps)
+	})
+
+	t.Run("does not retry field manager conflicts", func(t *testing.T) {
+		t.Parallel()
+
+		c := testutil.NewClient()
+		c.
+			On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(newFieldManagerConflictError())
+
+		err := Apply(context.Background(), c, &corev1.ConfigMap{}, client.Apply)
+		var applyErr *ApplyError
+		require.ErrorAs(t, err, &applyErr)
+		assert.True(t, applyErr.CausedBy(ErrorReasonFieldConflict))
+		c.AssertNumberOfCalls(t, "Patch", 1)
+	})
+}
+
+func TestClassifyApplyError(t *testing.T) {
+	t.Parallel()
+
+	gk := schema.GroupKind{Kind: "ConfigMap"}
+	gr := schema.GroupResource{Resource: "configmaps"}
+	webhookErr := &apimachineryerrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    403,
+		Reason:  metav1.StatusReasonForbidden,
+		Message: `admission webhook "test.example.com" denied the request: nope`,
+	}}
+
+	tests := []struct {
+		name                    string
+		err                     error
+		expectedReason          ErrorReason
+		expectedConditionReason string
+	}{
+		{
+			name:                    "webhook",
+			err:                     webhookErr,
+			expectedReason:          ErrorReasonAdmissionWebhookRejected,
+			expectedConditionReason: "AdmissionWebhookRejected",
+		},
+		{
+			name: "invalid",
+			err: apimachineryerrors.NewInvalid(gk, "test", field.ErrorList{
+				field.Required(field.NewPath("data"), ""),
+			}),
+			expectedReason:          ErrorReasonInvalid,
+			expectedConditionReason: "InvalidObject",
+		},
+		{
+			name:                    "forbidden",
+			err:                     apimachineryerrors.NewForbidden(gr, "test", errTest),
+			expectedReason:          ErrorReasonForbidden,
+			expectedConditionReason: "MissingPermissions",
+		},
+		{
+			name:                    "field manager conflict",
+			err:                     newFieldManagerConflictError(),
+			expectedReason:          ErrorReasonFieldConflict,
+			expectedConditionReason: "FieldConflict",
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ClassifyApplyError(&corev1.ConfigMap{}, test.err)
+			var applyErr *ApplyError
+			require.ErrorAs(t, err, &applyErr)
+			assert.Equal(t, test.expectedReason, applyErr.Reason)
+			assert.Equal(t, test.expectedConditionReason, applyErr.ConditionReason())
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+
+	t.Run("unclassified", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, ClassifyApplyError(&corev1.ConfigMap{}, nil))
+		assert.Equal(t, errTest, ClassifyApplyError(&corev1.ConfigMap{}, errTest))
+	})
+}
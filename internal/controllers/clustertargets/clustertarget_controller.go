@@ -0,0 +1,172 @@
+package clustertargets
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/kstatus"
+)
+
+const (
+	// How often ClusterTargets are probed.
+	clusterTargetProbeInterval = time.Minute
+	// Timeout for requests probing a ClusterTarget.
+	clusterTargetProbeTimeout = 10 * time.Second
+)
+
+// ClusterTargetController probes whether ClusterTargets are reachable
+// with their kubeconfig and reports the result in the Available condition.
+type ClusterTargetController struct {
+	client client.Client
+	// Uncached, to not cache all Secrets of the management cluster.
+	uncachedClient client.Reader
+	log            logr.Logger
+	recorder       clusterTargetMetricsRecorder
+
+	newServerVersionGetter func(kubeconfig []byte) (serverVersionGetter, error)
+}
+
+type clusterTargetMetricsRecorder interface {
+	RecordClusterTargetMetrics(target *corev1alpha1.ClusterTarget)
+	DeleteClusterTargetMetrics(name string)
+}
+
+type serverVersionGetter interface {
+	ServerVersion() (*version.Info, error)
+}
+
+func NewClusterTargetController(
+	c client.Client, uncachedClient client.Reader,
+	log logr.Logger, recorder clusterTargetMetricsRecorder,
+) *ClusterTargetController {
+	return &ClusterTargetController{
+		client:                 c,
+		uncachedClient:         uncachedClient,
+		log:                    log,
+		recorder:               recorder,
+		newServerVersionGetter: newDiscoveryClient,
+	}
+}
+
+func (c *ClusterTargetController) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	log := c.log.WithValues("ClusterTarget", req.String())
+	defer log.Info("reconciled")
+
+	target := &corev1alpha1.ClusterTarget{}
+	if err := c.client.Get(ctx, req.NamespacedName, target); errors.IsNotFound(err) {
+		if c.recorder != nil {
+			c.recorder.DeleteClusterTargetMetrics(req.Name)
+		}
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		return ctrl.Result{}, fmt.Errorf("getting ClusterTarget: %w", err)
+	}
+
+	status := target.Status.DeepCopy()
+	serverVersion, reason, err := c.probe(ctx, target)
+	var kstatusStatus kstatus.Status
+	if err != nil {
+		log.Info("ClusterTarget unavailable", "reason", reason, "error", err.Error())
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               corev1alpha1.ClusterTargetAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             reason,
+			Message:            err.Error(),
+			ObservedGeneration: target.Generation,
+		})
+		status.Phase = corev1alpha1.ClusterTargetPhaseUnavailable
+		status.ServerVersion = ""
+		kstatusStatus.Stalled = &kstatus.Reason{Reason: reason, Message: err.Error()}
+	} else {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               corev1alpha1.ClusterTargetAvailable,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Reachable",
+			Message:            "Cluster API server is reachable.",
+			ObservedGeneration: target.Generation,
+		})
+		status.Phase = corev1alpha1.ClusterTargetPhaseAvailable
+		status.ServerVersion = serverVersion
+	}
+	kstatusStatus.Apply(&status.Conditions, target.Generation)
+
+	if !reflect.DeepEqual(*status, target.Status) {
+		target.Status = *status
+		if err := c.client.Status().Update(ctx, target); err != nil {
+			return ctrl.Result{}, fmt.Errorf("updating ClusterTarget status: %w", err)
+		}
+	}
+	if c.recorder != nil {
+		c.recorder.RecordClusterTargetMetrics(target)
+	}
+	return ctrl.Result{RequeueAfter: clusterTargetProbeInterval}, nil
+}
+
+// Returns the version of the target cluster or an error and a condition reason describing it.
+func (c *ClusterTargetController) probe(
+	ctx context.Context, target *corev1alpha1.ClusterTarget,
+) (serverVersion string, reason string, err error) {
+	ref := target.Spec.KubeconfigSecretRef
+	secret := &corev1.Secret{}
+	if err := c.uncachedClient.Get(ctx, types.NamespacedName{
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}, secret); err != nil {
+		return "", "KubeconfigNotFound", fmt.Errorf("getting kubeconfig Secret: %w", err)
+	}
+	key := ref.Key
+	if len(key) == 0 {
+		key = corev1alpha1.DefaultKubeconfigSecretKey
+	}
+	kubeconfig, ok := secret.Data[key]
+	if !ok {
+		return "", "KubeconfigNotFound", fmt.Errorf(
+			"%w: Secret %s/%s is missing key %q", ErrTargetNotReady, ref.Namespace, ref.Name, key)
+	}
+
+	svg, err := c.newServerVersionGetter(kubeconfig)
+	if err != nil {
+		return "", "KubeconfigInvalid", err
+	}
+	info, err := svg.ServerVersion()
+	if err != nil {
+		return "", "Unreachable", fmt.Errorf("getting server version: %w", err)
+	}
+	return info.GitVersion, "", nil
+}
+
+func newDiscoveryClient(kubeconfig []byte) (serverVersionGetter, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("reading kubeconfig: %w", err)
+	}
+	cfg.Timeout = clusterTargetProbeTimeout
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating discovery client: %w", err)
+	}
+	return dc, nil
+}
+
+func (c *ClusterTargetController) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1alpha1.ClusterTarget{}).
+		Complete(c)
+}
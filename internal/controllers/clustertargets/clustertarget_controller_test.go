@@ -0,0 +1,119 @@
+package clustertargets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+var errUnreachable = errors.New("connection refused")
+
+type serverVersionGetterStub struct {
+	info *version.Info
+	err  error
+}
+
+func (s serverVersionGetterStub) ServerVersion() (*version.Info, error) {
+	return s.info, s.err
+}
+
+func TestClusterTargetController_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		secret          *corev1.Secret
+		versionGetter   serverVersionGetterStub
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedVersion string
+	}{
+		"available": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig", Namespace: "clusters"},
+				Data:       map[string][]byte{"kubeconfig": []byte("...")},
+			},
+			versionGetter:   serverVersionGetterStub{info: &version.Info{GitVersion: "v1.30.1"}},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  "Reachable",
+			expectedVersion: "v1.30.1",
+		},
+		"missing Secret": {
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "KubeconfigNotFound",
+		},
+		"missing key": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig", Namespace: "clusters"},
+				Data:       map[string][]byte{"value": []byte("...")},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "KubeconfigNotFound",
+		},
+		"unreachable": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig", Namespace: "clusters"},
+				Data:       map[string][]byte{"kubeconfig": []byte("...")},
+			},
+			versionGetter:  serverVersionGetterStub{err: errUnreachable},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "Unreachable",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := &corev1alpha1.ClusterTarget{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: corev1alpha1.ClusterTargetSpec{
+					KubeconfigSecretRef: corev1alpha1.KubeconfigSecretReference{
+						Name: "kubeconfig", Namespace: "clusters", Key: "kubeconfig",
+					},
+				},
+			}
+			b := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(target).
+				WithStatusSubresource(target)
+			if test.secret != nil {
+				b = b.WithObjects(test.secret)
+			}
+			c := b.Build()
+
+			controller := NewClusterTargetController(
+				c, c, ctrl.Log.WithName("clustertarget controller test"), nil)
+			controller.newServerVersionGetter = func([]byte) (serverVersionGetter, error) {
+				return test.versionGetter, nil
+			}
+
+			ctx := context.Background()
+			res, err := controller.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "test"},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, clusterTargetProbeInterval, res.RequeueAfter)
+
+			require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "test"}, target))
+			cond := meta.FindStatusCondition(target.Status.Conditions, corev1alpha1.ClusterTargetAvailable)
+			require.NotNil(t, cond)
+			assert.Equal(t, test.expectedStatus, cond.Status)
+			assert.Equal(t, test.expectedReason, cond.Reason)
+			assert.Equal(t, test.expectedVersion, target.Status.ServerVersion)
+			assert.Equal(t, test.expectedStatus == metav1.ConditionFalse,
+				meta.IsStatusConditionTrue(target.Status.Conditions, corev1alpha1.ConditionStalled))
+		})
+	}
+}
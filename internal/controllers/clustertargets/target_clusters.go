@@ -0,0 +1,330 @@
+package clustertargets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/audit"
+	"package-operator.run/internal/constants"
+	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/controllers/hostedclusters"
+	"package-operator.run/internal/dynamiccache"
+	"package-operator.run/internal/metrics"
+	"package-operator.run/internal/ownerhandling"
+	"package-operator.run/internal/preflight"
+)
+
+var (
+	// ErrTargetNotFound is returned when the targeted cluster does not exist (anymore).
+	ErrTargetNotFound = errors.New("target cluster not found")
+	// ErrTargetNotReady is returned when the targeted cluster can not be accessed yet.
+	ErrTargetNotReady = errors.New("target cluster not ready")
+)
+
+// TargetClusters provides PhaseReconcilers for the clusters targeted by ClusterObjectSets,
+// either via the ClusterTargetAnnotation or the HostedClusterTargetAnnotation,
+// so their objects are reconciled directly into the target cluster.
+//
+// Objects in target clusters are owned using annotations,
+// because their owner lives in the management cluster.
+// Every target cluster gets its own dynamic cache,
+// events of all caches are fanned in to the event sources returned by Source.
+type TargetClusters struct {
+	// Uncached, to not cache all Secrets of the management cluster.
+	client    client.Reader
+	scheme    *runtime.Scheme
+	recorder  metricsRecorder
+	auditSink audit.Sink
+
+	targetsLock sync.Mutex
+	targets     map[string]*targetCluster
+	sources     []*targetSource
+}
+
+type metricsRecorder interface {
+	ForTarget(target string) *metrics.TargetRecorder
+}
+
+type cacheMetricsRecorder interface {
+	RecordDynamicCacheInformers(total int)
+	RecordDynamicCacheObjects(gvk schema.GroupVersionKind, count int)
+}
+
+type targetCluster struct {
+	// ResourceVersion of the kubeconfig Secret the target was built from.
+	kubeconfigVersion string
+	cache             *dynamiccache.Cache
+	recorder          *metrics.TargetRecorder
+	phaseReconciler   *controllers.PhaseReconciler
+}
+
+func NewTargetClusters(
+	c client.Reader, scheme *runtime.Scheme,
+	recorder metricsRecorder, auditSink audit.Sink,
+) *TargetClusters {
+	return &TargetClusters{
+		client:    c,
+		scheme:    scheme,
+		recorder:  recorder,
+		auditSink: auditSink,
+		targets:   map[string]*targetCluster{},
+	}
+}
+
+// PhaseReconcilerFor returns a PhaseReconciler for the cluster targeted by owner.
+// Returns false if owner is not targeting another cluster.
+func (t *TargetClusters) PhaseReconcilerFor(
+	ctx context.Context, owner client.Object,
+) (*controllers.PhaseReconciler, bool, error) {
+	id, ref, ok, err := t.kubeconfigFor(ctx, owner)
+	if errors.Is(err, ErrTargetNotFound) {
+		if err := t.forget(ctx, id); err != nil {
+			return nil, true, err
+		}
+	}
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := t.client.Get(ctx, types.NamespacedName{
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}, secret); err != nil {
+		return nil, true, fmt.Errorf("getting kubeconfig Secret of %s: %w", id, err)
+	}
+	key := ref.Key
+	if len(key) == 0 {
+		key = corev1alpha1.DefaultKubeconfigSecretKey
+	}
+	kubeconfig, ok := secret.Data[key]
+	if !ok {
+		return nil, true, fmt.Errorf("%w: %s, Secret %s/%s is missing key %q",
+			ErrTargetNotReady, id, secret.Namespace, secret.Name, key)
+	}
+
+	t.targetsLock.Lock()
+	defer t.targetsLock.Unlock()
+
+	if existing, ok := t.targets[id]; ok {
+		if existing.kubeconfigVersion == secret.ResourceVersion {
+			return existing.phaseReconciler, true, nil
+		}
+		// Kubeconfig changed, start over with a new cache.
+		if err := t.discard(ctx, id, existing); err != nil {
+			return nil, true, err
+		}
+	}
+
+	target, err := t.newTargetCluster(ctx, id, kubeconfig)
+	if err != nil {
+		return nil, true, fmt.Errorf("%s: %w", id, err)
+	}
+	target.kubeconfigVersion = secret.ResourceVersion
+	t.targets[id] = target
+	return target.phaseReconciler, true, nil
+}
+
+// Free releases all watches associated with owner in all target clusters.
+func (t *TargetClusters) Free(ctx context.Context, owner client.Object) error {
+	t.targetsLock.Lock()
+	defer t.targetsLock.Unlock()
+
+	for id, target := range t.targets {
+		if err := target.cache.Free(ctx, owner); err != nil {
+			return fmt.Errorf("freeing cache of %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// Source returns an event source for objects in all target clusters.
+// Target cluster objects are owned via annotations,
+// so handler has to resolve owners from annotations.
+func (t *TargetClusters) Source(h handler.EventHandler, predicates ...predicate.Predicate) source.Source {
+	return &targetSource{targets: t, handler: h, predicates: predicates}
+}
+
+// Returns an ID and the kubeconfig Secret of the cluster targeted by owner.
+func (t *TargetClusters) kubeconfigFor(
+	ctx context.Context, owner client.Object,
+) (id string, ref corev1alpha1.KubeconfigSecretReference, ok bool, err error) {
+	annotations := owner.GetAnnotations()
+
+	if name, ok := annotations[corev1alpha1.ClusterTargetAnnotation]; ok {
+		id := "ClusterTarget/" + name
+		target := &corev1alpha1.ClusterTarget{}
+		if err := t.client.Get(ctx, client.ObjectKey{Name: name}, target); apimachineryerrors.IsNotFound(err) {
+			return id, ref, true, fmt.Errorf("%w: %s", ErrTargetNotFound, id)
+		} else if err != nil {
+			return id, ref, true, fmt.Errorf("getting ClusterTarget: %w", err)
+		}
+		if !meta.IsStatusConditionTrue(target.Status.Conditions, corev1alpha1.ClusterTargetAvailable) {
+			return id, ref, true, fmt.Errorf("%w: %s", ErrTargetNotReady, id)
+		}
+		return id, target.Spec.KubeconfigSecretRef, true, nil
+	}
+
+	if hostedCluster, ok := annotations[corev1alpha1.HostedClusterTargetAnnotation]; ok {
+		id := "HostedCluster/" + hostedCluster
+		ref, err := hostedclusters.KubeconfigSecretRef(ctx, t.client, hostedCluster)
+		switch {
+		case errors.Is(err, hostedclusters.ErrHostedClusterNotFound):
+			return id, ref, true, fmt.Errorf("%w: %w", ErrTargetNotFound, err)
+		case errors.Is(err, hostedclusters.ErrHostedClusterNotReady):
+			return id, ref, true, fmt.Errorf("%w: %w", ErrTargetNotReady, err)
+		case err != nil:
+			return id, ref, true, err
+		}
+		return id, ref, true, nil
+	}
+
+	return "", ref, false, nil
+}
+
+func (t *TargetClusters) newTargetCluster(
+	ctx context.Context, id string, kubeconfig []byte,
+) (*targetCluster, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("reading kubeconfig: %w", err)
+	}
+	httpClient, err := rest.HTTPClientFor(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("building http client for kubeconfig: %w", err)
+	}
+	mapper, err := apiutil.NewDynamicRESTMapper(cfg, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("creating rest mapper: %w", err)
+	}
+	c, err := client.New(cfg, client.Options{
+		HTTPClient: httpClient,
+		Scheme:     t.scheme,
+		Mapper:     mapper,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating client: %w", err)
+	}
+
+	target := &targetCluster{}
+	var cacheRecorder cacheMetricsRecorder
+	if t.recorder != nil {
+		target.recorder = t.recorder.ForTarget(id)
+		cacheRecorder = target.recorder
+	}
+	target.cache = dynamiccache.NewCache(
+		cfg, t.scheme, mapper, cacheRecorder,
+		dynamiccache.SelectorsByGVK{
+			// Only cache objects with our label selector,
+			// so we prevent our caches from exploding!
+			schema.GroupVersionKind{}: dynamiccache.Selector{
+				Label: labels.SelectorFromSet(labels.Set{
+					constants.DynamicCacheLabel: "True",
+				}),
+			},
+		})
+	for _, s := range t.sources {
+		if err := s.startFor(target.cache); err != nil {
+			return nil, fmt.Errorf("registering event source: %w", err)
+		}
+	}
+	// Blocks registration of further event handlers.
+	if err := target.cache.Start(ctx); err != nil {
+		return nil, fmt.Errorf("starting cache: %w", err)
+	}
+
+	target.phaseReconciler = controllers.NewPhaseReconciler(
+		t.scheme, c, target.cache, c,
+		ownerhandling.NewAnnotation(t.scheme),
+		preflight.NewAPIExistence(mapper,
+			preflight.List{
+				preflight.NewNoOwnerReferences(mapper),
+				preflight.NewNamespaceEscalation(mapper, nil),
+				preflight.NewDryRun(c, controllers.Apply),
+			},
+		),
+		t.auditSink, nil,
+	)
+	return target, nil
+}
+
+// Drops the target cluster with the given id, if known.
+func (t *TargetClusters) forget(ctx context.Context, id string) error {
+	t.targetsLock.Lock()
+	defer t.targetsLock.Unlock()
+
+	if target, ok := t.targets[id]; ok {
+		return t.discard(ctx, id, target)
+	}
+	return nil
+}
+
+// Stops all informers of the target cluster and removes it.
+// Must be called with targetsLock held.
+func (t *TargetClusters) discard(ctx context.Context, id string, target *targetCluster) error {
+	delete(t.targets, id)
+	if target.recorder != nil {
+		target.recorder.Delete()
+	}
+	if err := target.cache.FreeAll(ctx); err != nil {
+		return fmt.Errorf("freeing cache of %s: %w", id, err)
+	}
+	return nil
+}
+
+// Fans in events from the caches of all target clusters.
+type targetSource struct {
+	targets    *TargetClusters
+	handler    handler.EventHandler
+	predicates []predicate.Predicate
+
+	ctx   context.Context
+	queue workqueue.RateLimitingInterface
+}
+
+var _ source.Source = (*targetSource)(nil)
+
+// Start implements source.Source.
+func (s *targetSource) Start(ctx context.Context, queue workqueue.RateLimitingInterface) error {
+	t := s.targets
+	t.targetsLock.Lock()
+	defer t.targetsLock.Unlock()
+
+	s.ctx, s.queue = ctx, queue
+	t.sources = append(t.sources, s)
+
+	// Caches don't accept new event handlers after they have been started,
+	// so targets built before this source are rebuilt on next use.
+	for id, target := range t.targets {
+		if err := t.discard(ctx, id, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// For printing in startup log messages.
+func (s *targetSource) String() string { return "clustertargets.TargetSource" }
+
+func (s *targetSource) startFor(c *dynamiccache.Cache) error {
+	return c.Source(s.handler, s.predicates...).Start(s.ctx, s.queue)
+}
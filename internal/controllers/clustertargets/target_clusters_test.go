@@ -0,0 +1,147 @@
+package clustertargets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/audit"
+	"package-operator.run/internal/controllers/hostedclusters"
+	"package-operator.run/internal/dynamiccache"
+	"package-operator.run/internal/testutil"
+)
+
+var testScheme = runtime.NewScheme()
+
+func init() {
+	if err := corev1alpha1.AddToScheme(testScheme); err != nil {
+		panic(err)
+	}
+	if err := corev1.AddToScheme(testScheme); err != nil {
+		panic(err)
+	}
+}
+
+func TestTargetClusters_PhaseReconcilerFor_notTargeted(t *testing.T) {
+	t.Parallel()
+
+	clientMock := testutil.NewClient()
+	tc := NewTargetClusters(clientMock, testScheme, nil, audit.Discard{})
+
+	r, ok, err := tc.PhaseReconcilerFor(context.Background(), &corev1alpha1.ClusterObjectSet{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+	clientMock.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTargetClusters_PhaseReconcilerFor_clusterTargetErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		clusterTarget *corev1alpha1.ClusterTarget
+		getErr        error
+		expectedErr   error
+	}{
+		"not found": {
+			getErr:      errors.NewNotFound(schema.GroupResource{}, ""),
+			expectedErr: ErrTargetNotFound,
+		},
+		"not available": {
+			clusterTarget: &corev1alpha1.ClusterTarget{
+				Status: corev1alpha1.ClusterTargetStatus{
+					Conditions: []metav1.Condition{
+						{Type: corev1alpha1.ClusterTargetAvailable, Status: metav1.ConditionFalse},
+					},
+				},
+			},
+			expectedErr: ErrTargetNotReady,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clientMock := testutil.NewClient()
+			tc := NewTargetClusters(clientMock, testScheme, nil, audit.Discard{})
+
+			clientMock.
+				On("Get", mock.Anything, types.NamespacedName{Name: "test"},
+					mock.AnythingOfType("*v1alpha1.ClusterTarget"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					if test.clusterTarget != nil {
+						obj := args.Get(2).(*corev1alpha1.ClusterTarget)
+						*obj = *test.clusterTarget.DeepCopy()
+					}
+				}).
+				Return(test.getErr)
+
+			owner := &corev1alpha1.ClusterObjectSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						corev1alpha1.ClusterTargetAnnotation: "test",
+					},
+				},
+			}
+			_, ok, err := tc.PhaseReconcilerFor(context.Background(), owner)
+			assert.True(t, ok)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestTargetClusters_PhaseReconcilerFor_hostedClusterNotFound(t *testing.T) {
+	t.Parallel()
+
+	clientMock := testutil.NewClient()
+	tc := NewTargetClusters(clientMock, testScheme, nil, audit.Discard{})
+
+	clientMock.
+		On("Get", mock.Anything, types.NamespacedName{Namespace: "clusters", Name: "test"},
+			mock.AnythingOfType("*v1beta1.HostedCluster"), mock.Anything).
+		Return(errors.NewNotFound(schema.GroupResource{}, ""))
+
+	owner := &corev1alpha1.ClusterObjectSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				corev1alpha1.HostedClusterTargetAnnotation: "clusters/test",
+			},
+		},
+	}
+	_, ok, err := tc.PhaseReconcilerFor(context.Background(), owner)
+	assert.True(t, ok)
+	require.ErrorIs(t, err, ErrTargetNotFound)
+	require.ErrorIs(t, err, hostedclusters.ErrHostedClusterNotFound)
+}
+
+func TestTargetClusters_Source(t *testing.T) {
+	t.Parallel()
+
+	tc := NewTargetClusters(testutil.NewClient(), testScheme, nil, audit.Discard{})
+	tc.targets["ClusterTarget/test"] = &targetCluster{
+		cache: dynamiccache.NewCache(&rest.Config{}, testScheme, nil, nil),
+	}
+
+	s := tc.Source(&handler.EnqueueRequestForObject{})
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	require.NoError(t, s.Start(context.Background(), queue))
+
+	// Targets built before the source started are missing its event handler.
+	assert.Empty(t, tc.targets)
+	assert.Len(t, tc.sources, 1)
+}
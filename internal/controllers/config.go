@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"k8s.io/client-go/util/flowcontrol"
+
+	"package-operator.run/pkg/phasecontroller"
 )
 
 const (
@@ -18,6 +20,21 @@ const (
 	DefaultMaxBackoff     = 300 * time.Second
 )
 
+const (
+	// Interval in which phase controllers report a heartbeat on the ObjectSetPhases they reconcile.
+	PhaseHeartbeatInterval = phasecontroller.HeartbeatInterval
+	// Phase controllers are considered unavailable, when no heartbeat was reported for this long.
+	PhaseHeartbeatTimeout = phasecontroller.HeartbeatTimeout
+)
+
+// Interval in which external objects are checked again, while they are missing or failing their probes.
+// External objects are not watched, because they don't carry the cache label.
+const ExternalObjectPollInterval = 30 * time.Second
+
+// Interval in which availability probes are evaluated again,
+// while failure or success thresholds or damping windows hold back their results.
+const ProbeDampingRecheckInterval = 10 * time.Second
+
 type BackoffConfig struct {
 	InitialBackoff *time.Duration
 	MaxBackoff     *time.Duration
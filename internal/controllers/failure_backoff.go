@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"package-operator.run/internal/errorclass"
+)
+
+const (
+	// Number of consecutive failures with the same error,
+	// after which an object is considered to be failing persistently.
+	PersistentFailureThreshold = 3
+	// Up to this fraction of the delay is added to retries of failing objects,
+	// so objects failing at the same time are not retried in lockstep.
+	DefaultBackoffJitterFactor = 0.1
+)
+
+// FailureBackoff tracks objects that keep failing the same way
+// and exponentially increases the delay until they are retried.
+type FailureBackoff struct {
+	initial, max time.Duration
+	clock        clock.PassiveClock
+
+	lock     sync.Mutex
+	failures map[types.UID]*failure
+}
+
+type failure struct {
+	reason     string
+	count      int
+	delay      time.Duration
+	lastUpdate time.Time
+}
+
+func NewFailureBackoff(initial, max time.Duration) *FailureBackoff {
+	return &FailureBackoff{
+		initial:  initial,
+		max:      max,
+		clock:    clock.RealClock{},
+		failures: map[types.UID]*failure{},
+	}
+}
+
+// Failed records a failure of the given object and returns the delay until it should be retried
+// and the number of consecutive failures with the same reason. The jittered delay never exceeds max.
+// A failure with a different reason starts over with the initial delay.
+func (b *FailureBackoff) Failed(obj client.Object, reason string) (delay time.Duration, failures int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.clock.Now()
+	b.gc(now)
+
+	f, ok := b.failures[obj.GetUID()]
+	if !ok || f.reason != reason {
+		f = &failure{reason: reason, delay: b.initial}
+		b.failures[obj.GetUID()] = f
+	} else {
+		f.delay = min(f.delay*2, b.max)
+	}
+	f.count++
+	f.lastUpdate = now
+
+	return min(wait.Jitter(f.delay, DefaultBackoffJitterFactor), b.max), f.count
+}
+
+// Succeeded resets the backoff of the given object.
+// Calling it on a nil FailureBackoff is a no-op.
+func (b *FailureBackoff) Succeeded(obj client.Object) {
+	if b == nil {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.failures, obj.GetUID())
+}
+
+// Forget about objects that have not failed for a while, e.g. because they have been deleted.
+func (b *FailureBackoff) gc(now time.Time) {
+	for uid, f := range b.failures {
+		if now.Sub(f.lastUpdate) > 2*b.max {
+			delete(b.failures, uid)
+		}
+	}
+}
+
+// Backoff records a failure of the given object and returns the delay until it should be retried.
+// Errors that are not retriable, like configuration errors, are considered persistent right away,
+// other errors once the object failed PersistentFailureThreshold times in a row with the same error.
+// Persistent failures are reported in the given condition, with the class of the error as reason.
+func (b *FailureBackoff) Backoff(
+	obj client.Object, conditions *[]metav1.Condition,
+	conditionType string, reconcileErr error,
+) (delay time.Duration, persistent bool) {
+	class := errorclass.Of(reconcileErr)
+	delay, failures := b.Failed(obj, reconcileErr.Error())
+	if failures < PersistentFailureThreshold && class.Retriable() {
+		return delay, false
+	}
+
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:   conditionType,
+		Status: metav1.ConditionTrue,
+		Reason: class.ConditionReason(),
+		Message: fmt.Sprintf(
+			"Failed %d times in a row with the same error, next retry at %s.",
+			failures, b.clock.Now().Add(delay).UTC().Format(time.RFC3339)),
+		ObservedGeneration: obj.GetGeneration(),
+	})
+	return delay, true
+}
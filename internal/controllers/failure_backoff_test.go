@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestFailureBackoff(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktesting.NewFakePassiveClock(time.Now())
+	b := NewFailureBackoff(10*time.Second, 40*time.Second)
+	b.clock = clock
+
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{UID: "test"}}
+	assertDelay := func(expected time.Duration, delay time.Duration) {
+		t.Helper()
+		assert.GreaterOrEqual(t, delay, expected)
+		assert.LessOrEqual(t, delay, expected+time.Duration(float64(expected)*DefaultBackoffJitterFactor))
+	}
+
+	delay, failures := b.Failed(obj, "broken")
+	assertDelay(10*time.Second, delay)
+	assert.Equal(t, 1, failures)
+
+	delay, failures = b.Failed(obj, "broken")
+	assertDelay(20*time.Second, delay)
+	assert.Equal(t, 2, failures)
+
+	// capped
+	b.Failed(obj, "broken")
+	delay, failures = b.Failed(obj, "broken")
+	assert.Equal(t, 40*time.Second, delay, "jitter must not exceed the max delay")
+	assert.Equal(t, 4, failures)
+
+	// different failure starts over
+	delay, failures = b.Failed(obj, "broken differently")
+	assertDelay(10*time.Second, delay)
+	assert.Equal(t, 1, failures)
+
+	b.Succeeded(obj)
+	_, failures = b.Failed(obj, "broken differently")
+	assert.Equal(t, 1, failures)
+
+	// forgotten after a while
+	clock.SetTime(clock.Now().Add(time.Hour))
+	_, failures = b.Failed(obj, "broken differently")
+	assert.Equal(t, 1, failures)
+}
+
+func TestFailureBackoff_Backoff(t *testing.T) {
+	t.Parallel()
+
+	b := NewFailureBackoff(10*time.Second, 40*time.Second)
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{UID: "test"}}
+
+	t.Run("retriable", func(t *testing.T) {
+		t.Parallel()
+
+		obj := obj.DeepCopy()
+		obj.UID = "retriable"
+		var conditions []metav1.Condition
+		for i := 1; i < PersistentFailureThreshold; i++ {
+			_, persistent := b.Backoff(obj, &conditions, "RetryBackoff", errTest)
+			assert.False(t, persistent)
+		}
+		_, persistent := b.Backoff(obj, &conditions, "RetryBackoff", errTest)
+		assert.True(t, persistent)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, "InfrastructureError", conditions[0].Reason)
+		}
+	})
+
+	t.Run("config error", func(t *testing.T) {
+		t.Parallel()
+
+		var conditions []metav1.Condition
+		_, persistent := b.Backoff(obj, &conditions, "RetryBackoff",
+			&ApplyError{Reason: ErrorReasonInvalid, Err: errTest})
+		assert.True(t, persistent)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, "ConfigError", conditions[0].Reason)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"text/template"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -31,8 +32,17 @@ type HostedClusterController struct {
 	packageOperatorPackageImage string
 	ownerStrategy               ownerStrategy
 
-	remotePhaseAffinity    *corev1.Affinity
-	remotePhaseTolerations []corev1.Toleration
+	remotePhaseAffinity          *corev1.Affinity
+	remotePhaseTolerations       []corev1.Toleration
+	remotePhaseNodeSelector      map[string]string
+	remotePhasePriorityClassName string
+	remotePhaseResources         *corev1.ResourceRequirements
+	// Hosted control plane specific settings, unset when 0, false or nil.
+	remotePhaseReplicas                 int
+	remotePhaseColocateWithControlPlane bool
+	remotePhaseResourceQuota            *corev1.ResourceQuotaSpec
+	// Optional, renders the config of the remote-phase Package.
+	packageConfigTemplate *template.Template
 }
 
 type ownerStrategy interface {
@@ -47,6 +57,13 @@ func NewHostedClusterController(
 	packageOperatorPackageImage string,
 	remotePhaseAffinity *corev1.Affinity,
 	remotePhaseTolerations []corev1.Toleration,
+	remotePhaseNodeSelector map[string]string,
+	remotePhasePriorityClassName string,
+	remotePhaseResources *corev1.ResourceRequirements,
+	remotePhaseReplicas int,
+	remotePhaseColocateWithControlPlane bool,
+	remotePhaseResourceQuota *corev1.ResourceQuotaSpec,
+	packageConfigTemplate *template.Template,
 ) *HostedClusterController {
 	controller := &HostedClusterController{
 		client:                      c,
@@ -58,8 +75,16 @@ func NewHostedClusterController(
 		// e.g. clusters-my-cluster and not in the same Namespace as the HostedCluster object
 		ownerStrategy: ownerhandling.NewAnnotation(scheme),
 
-		remotePhaseAffinity:    remotePhaseAffinity,
-		remotePhaseTolerations: remotePhaseTolerations,
+		remotePhaseAffinity:          remotePhaseAffinity,
+		remotePhaseTolerations:       remotePhaseTolerations,
+		remotePhaseNodeSelector:      remotePhaseNodeSelector,
+		remotePhasePriorityClassName: remotePhasePriorityClassName,
+		remotePhaseResources:         remotePhaseResources,
+		packageConfigTemplate:        packageConfigTemplate,
+
+		remotePhaseReplicas:                 remotePhaseReplicas,
+		remotePhaseColocateWithControlPlane: remotePhaseColocateWithControlPlane,
+		remotePhaseResourceQuota:            remotePhaseResourceQuota,
 	}
 	return controller
 }
@@ -132,12 +157,37 @@ func (c *HostedClusterController) desiredRemotePhasePackage(
 	}
 
 	config := map[string]any{}
-	if c.remotePhaseAffinity != nil {
+	if c.packageConfigTemplate != nil {
+		var err error
+		if config, err = renderPackageConfig(c.packageConfigTemplate, cluster); err != nil {
+			return nil, err
+		}
+	}
+	// Settings from the template take precedence.
+	if _, ok := config["affinity"]; !ok && c.remotePhaseAffinity != nil {
 		config["affinity"] = c.remotePhaseAffinity
 	}
-	if c.remotePhaseTolerations != nil {
+	if _, ok := config["tolerations"]; !ok && c.remotePhaseTolerations != nil {
 		config["tolerations"] = c.remotePhaseTolerations
 	}
+	if _, ok := config["nodeSelector"]; !ok && c.remotePhaseNodeSelector != nil {
+		config["nodeSelector"] = c.remotePhaseNodeSelector
+	}
+	if _, ok := config["priorityClassName"]; !ok && len(c.remotePhasePriorityClassName) > 0 {
+		config["priorityClassName"] = c.remotePhasePriorityClassName
+	}
+	if _, ok := config["resources"]; !ok && c.remotePhaseResources != nil {
+		config["resources"] = c.remotePhaseResources
+	}
+	if _, ok := config["replicas"]; !ok && c.remotePhaseReplicas > 0 {
+		config["replicas"] = c.remotePhaseReplicas
+	}
+	if _, ok := config["colocateWithControlPlane"]; !ok && c.remotePhaseColocateWithControlPlane {
+		config["colocateWithControlPlane"] = true
+	}
+	if _, ok := config["resourceQuota"]; !ok && c.remotePhaseResourceQuota != nil {
+		config["resourceQuota"] = c.remotePhaseResourceQuota
+	}
 	if len(config) > 0 {
 		configJSON, err := json.Marshal(config)
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -40,7 +41,7 @@ func TestHostedClusterController_noop(t *testing.T) {
 
 	image := "image321"
 	controller := NewHostedClusterController(
-		mockClient, ctrl.Log.WithName("hc controller test"), testScheme, image, nil, nil,
+		mockClient, ctrl.Log.WithName("hc controller test"), testScheme, image, nil, nil, nil, "", nil, 0, false, nil, nil,
 	)
 	hcName := "testing123"
 	now := metav1.Now()
@@ -74,7 +75,7 @@ func TestHostedClusterController_DesiredPackage(t *testing.T) {
 
 	image := "image321"
 	controller := NewHostedClusterController(mockClient, ctrl.Log.WithName("hc controller test"), testScheme, image,
-		&corev1.Affinity{}, []corev1.Toleration{{}})
+		&corev1.Affinity{}, []corev1.Toleration{{}}, nil, "", nil, 0, false, nil, nil)
 	hcName := "testing123"
 	hc := &hypershiftv1beta1.HostedCluster{
 		ObjectMeta: metav1.ObjectMeta{Name: hcName, Namespace: "default"},
@@ -89,6 +90,123 @@ func TestHostedClusterController_DesiredPackage(t *testing.T) {
 	}
 }
 
+func TestHostedClusterController_DesiredPackage_schedulingAndResources(t *testing.T) {
+	t.Parallel()
+
+	controller := NewHostedClusterController(
+		testutil.NewClient(), ctrl.Log.WithName("hc controller test"), testScheme, "image321",
+		nil, nil,
+		map[string]string{"node-role.kubernetes.io/infra": ""},
+		"system-cluster-critical",
+		&corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")},
+		},
+		0, false, nil,
+		nil)
+	hc := &hypershiftv1beta1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "testing123", Namespace: "default"},
+	}
+
+	pkg, err := controller.desiredRemotePhasePackage(hc)
+	require.NoError(t, err)
+	if assert.NotNil(t, pkg.Spec.Config) {
+		assert.JSONEq(t, `{
+			"nodeSelector": {"node-role.kubernetes.io/infra": ""},
+			"priorityClassName": "system-cluster-critical",
+			"resources": {"requests": {"cpu": "10m"}}
+		}`, string(pkg.Spec.Config.Raw))
+	}
+}
+
+func TestHostedClusterController_DesiredPackage_hostedControlPlane(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := ParsePackageConfigTemplate(`
+{{- if eq (index .HostedCluster.Labels "tier") "premium" }}
+replicas: 3
+{{- end }}`)
+	require.NoError(t, err)
+
+	controller := NewHostedClusterController(
+		testutil.NewClient(), ctrl.Log.WithName("hc controller test"), testScheme, "image321",
+		nil, nil, nil, "", nil,
+		2, true,
+		&corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+		},
+		tmpl)
+	hc := &hypershiftv1beta1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "testing123", Namespace: "default",
+			Labels: map[string]string{"tier": "premium"},
+		},
+	}
+
+	pkg, err := controller.desiredRemotePhasePackage(hc)
+	require.NoError(t, err)
+	if assert.NotNil(t, pkg.Spec.Config) {
+		// replicas from the template take precedence.
+		assert.JSONEq(t, `{
+			"colocateWithControlPlane": true,
+			"replicas": 3,
+			"resourceQuota": {"hard": {"pods": "10"}}
+		}`, string(pkg.Spec.Config.Raw))
+	}
+}
+
+func TestHostedClusterController_DesiredPackage_configTemplate(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := ParsePackageConfigTemplate(`
+{{- if eq (index .HostedCluster.Labels "tier") "premium" }}
+replicas: 2
+{{- end }}
+affinity: {}
+clusterName: {{ .HostedCluster.Name }}
+platform: {{ .HostedCluster.Platform }}
+releaseVersion: {{ .HostedCluster.ReleaseVersion | quote }}`)
+	require.NoError(t, err)
+
+	controller := NewHostedClusterController(
+		testutil.NewClient(), ctrl.Log.WithName("hc controller test"), testScheme, "image321",
+		&corev1.Affinity{PodAffinity: &corev1.PodAffinity{}}, []corev1.Toleration{{}}, nil, "", nil, 0, false, nil, tmpl)
+	hc := &hypershiftv1beta1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "testing123", Namespace: "default",
+			Labels: map[string]string{"tier": "premium"},
+		},
+		Spec: hypershiftv1beta1.HostedClusterSpec{
+			Platform: hypershiftv1beta1.PlatformSpec{Type: "AWS"},
+		},
+		Status: hypershiftv1beta1.HostedClusterStatus{
+			Version: &hypershiftv1beta1.ClusterVersionStatus{
+				Desired: hypershiftv1beta1.ReleaseInfo{Version: "4.16.2"},
+			},
+		},
+	}
+
+	pkg, err := controller.desiredRemotePhasePackage(hc)
+	require.NoError(t, err)
+	if assert.NotNil(t, pkg.Spec.Config) {
+		// affinity from the template takes precedence.
+		assert.JSONEq(t, `{
+			"affinity": {},
+			"clusterName": "testing123",
+			"platform": "AWS",
+			"releaseVersion": "4.16.2",
+			"replicas": 2,
+			"tolerations": [{}]
+		}`, string(pkg.Spec.Config.Raw))
+	}
+}
+
+func TestParsePackageConfigTemplate_invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParsePackageConfigTemplate("{{ .HostedCluster.Name")
+	require.Error(t, err)
+}
+
 var readyHostedCluster = &hypershiftv1beta1.HostedCluster{
 	Status: hypershiftv1beta1.HostedClusterStatus{
 		Conditions: []metav1.Condition{
@@ -104,7 +222,8 @@ func TestHostedClusterController_Reconcile_GetHostedClusterError(t *testing.T) {
 
 	clientMock := testutil.NewClient()
 	c := NewHostedClusterController(
-		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test", nil, nil,
+		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test",
+		nil, nil, nil, "", nil, 0, false, nil, nil,
 	)
 
 	clientMock.
@@ -123,7 +242,8 @@ func TestHostedClusterController_Reconcile_DontHandleDeleted(t *testing.T) {
 
 	clientMock := testutil.NewClient()
 	c := NewHostedClusterController(
-		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test", nil, nil,
+		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test",
+		nil, nil, nil, "", nil, 0, false, nil, nil,
 	)
 
 	clientMock.
@@ -146,7 +266,8 @@ func TestHostedClusterController_Reconcile_waitsForClusterReady(t *testing.T) {
 
 	clientMock := testutil.NewClient()
 	c := NewHostedClusterController(
-		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test", nil, nil,
+		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test",
+		nil, nil, nil, "", nil, 0, false, nil, nil,
 	)
 
 	clientMock.
@@ -169,7 +290,8 @@ func TestHostedClusterController_Reconcile_createsPackage(t *testing.T) {
 
 	clientMock := testutil.NewClient()
 	c := NewHostedClusterController(
-		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test", nil, nil,
+		clientMock, ctrl.Log.WithName("hc controller test"), testScheme, "desired-image:test",
+		nil, nil, nil, "", nil, 0, false, nil, nil,
 	)
 
 	clientMock.
@@ -307,6 +429,9 @@ func TestHostedClusterController_Reconcile_updatesPackageSpec(t *testing.T) {
 			tcase.packageOperatorPackageImage,
 			tcase.remotePhaseAffinity,
 			tcase.remotePhaseTolerations,
+			nil, "", nil,
+			0, false, nil,
+			nil,
 		)
 
 		clientMock.
@@ -36,8 +36,52 @@ const (
 	HostedClusterAvailable = "Available"
 )
 
+// HostedClusterSpec is the desired behavior of a HostedCluster.
+type HostedClusterSpec struct {
+	// Release specifies the desired OCP release payload for the hosted cluster.
+	Release Release `json:"release"`
+
+	// Platform specifies the underlying infrastructure provider for the cluster.
+	Platform PlatformSpec `json:"platform"`
+}
+
+// Release represents the metadata for an OCP release payload image.
+type Release struct {
+	// Image is the image pullspec of an OCP release payload image.
+	Image string `json:"image"`
+}
+
+// PlatformType is a specific supported infrastructure provider.
+type PlatformType string
+
+// PlatformSpec specifies the underlying infrastructure provider for the cluster.
+type PlatformSpec struct {
+	// Type is the type of infrastructure provider for the cluster.
+	Type PlatformType `json:"type"`
+}
+
+// ClusterVersionStatus reports the status of the cluster versioning,
+// including any upgrades that are in progress.
+type ClusterVersionStatus struct {
+	// Desired is the version that the cluster is reconciling towards.
+	Desired ReleaseInfo `json:"desired"`
+}
+
+// ReleaseInfo identifies a release, taken from openshift/api config/v1 Release.
+type ReleaseInfo struct {
+	// Version is a semantic version identifying the update version.
+	Version string `json:"version"`
+	// Image is a container image location that contains the update.
+	Image string `json:"image"`
+}
+
 // HostedClusterStatus is the latest observed status of a HostedCluster.
 type HostedClusterStatus struct {
+	// Version is the status of the release version applied to the
+	// HostedCluster.
+	// +optional
+	Version *ClusterVersionStatus `json:"version,omitempty"`
+
 	// KubeConfig is a reference to the secret containing the default kubeconfig
 	// for the cluster.
 	// +optional
@@ -66,6 +110,9 @@ type HostedCluster struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
+	// Spec is the desired behavior of the HostedCluster.
+	Spec HostedClusterSpec `json:"spec,omitempty"`
+
 	// Status is the latest observed status of the HostedCluster.
 	Status HostedClusterStatus `json:"status,omitempty"`
 }
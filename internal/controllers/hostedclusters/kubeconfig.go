@@ -0,0 +1,66 @@
+package hostedclusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/controllers/hostedclusters/hypershift/v1beta1"
+)
+
+// Key of the kubeconfig in the HostedCluster kubeconfig Secret.
+const kubeconfigSecretKey = "kubeconfig"
+
+var (
+	// ErrInvalidHostedClusterTarget is returned when the HostedClusterTargetAnnotation is malformed.
+	ErrInvalidHostedClusterTarget = errors.New("invalid hosted cluster target")
+	// ErrHostedClusterNotFound is returned when the targeted HostedCluster does not exist.
+	ErrHostedClusterNotFound = errors.New("hosted cluster not found")
+	// ErrHostedClusterNotReady is returned when the targeted HostedCluster has no kubeconfig yet.
+	ErrHostedClusterNotReady = errors.New("hosted cluster not ready")
+)
+
+// KubeconfigSecretRef returns the Secret holding the kubeconfig for the workload cluster
+// of the HostedCluster referenced by target, formatted as "<namespace>/<name>".
+func KubeconfigSecretRef(
+	ctx context.Context, c client.Reader, target string,
+) (corev1alpha1.KubeconfigSecretReference, error) {
+	key, err := parseHostedClusterTarget(target)
+	if err != nil {
+		return corev1alpha1.KubeconfigSecretReference{}, err
+	}
+
+	hostedCluster := &v1beta1.HostedCluster{}
+	if err := c.Get(ctx, key, hostedCluster); apimachineryerrors.IsNotFound(err) {
+		return corev1alpha1.KubeconfigSecretReference{}, fmt.Errorf("%w: %s", ErrHostedClusterNotFound, key)
+	} else if err != nil {
+		return corev1alpha1.KubeconfigSecretReference{}, fmt.Errorf("getting HostedCluster: %w", err)
+	}
+	if hostedCluster.Status.KubeConfig == nil ||
+		!meta.IsStatusConditionTrue(hostedCluster.Status.Conditions, v1beta1.HostedClusterAvailable) {
+		return corev1alpha1.KubeconfigSecretReference{}, fmt.Errorf("%w: %s", ErrHostedClusterNotReady, key)
+	}
+
+	return corev1alpha1.KubeconfigSecretReference{
+		Namespace: hostedCluster.Namespace,
+		Name:      hostedCluster.Status.KubeConfig.Name,
+		Key:       kubeconfigSecretKey,
+	}, nil
+}
+
+// Parses "<namespace>/<name>".
+func parseHostedClusterTarget(target string) (types.NamespacedName, error) {
+	namespace, name, ok := strings.Cut(target, "/")
+	if !ok || len(namespace) == 0 || len(name) == 0 || strings.Contains(name, "/") {
+		return types.NamespacedName{}, fmt.Errorf(
+			`%w: %q, must be "<namespace>/<name>"`, ErrInvalidHostedClusterTarget, target)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
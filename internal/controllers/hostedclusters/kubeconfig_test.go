@@ -0,0 +1,101 @@
+package hostedclusters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	hypershiftv1beta1 "package-operator.run/internal/controllers/hostedclusters/hypershift/v1beta1"
+	"package-operator.run/internal/testutil"
+)
+
+func TestParseHostedClusterTarget(t *testing.T) {
+	t.Parallel()
+
+	key, err := parseHostedClusterTarget("clusters/my-cluster")
+	require.NoError(t, err)
+	assert.Equal(t, types.NamespacedName{Namespace: "clusters", Name: "my-cluster"}, key)
+
+	for _, target := range []string{"", "my-cluster", "/my-cluster", "clusters/", "a/b/c"} {
+		_, err := parseHostedClusterTarget(target)
+		require.ErrorIs(t, err, ErrInvalidHostedClusterTarget, target)
+	}
+}
+
+func TestKubeconfigSecretRef(t *testing.T) {
+	t.Parallel()
+
+	clientMock := testutil.NewClient()
+	clientMock.
+		On("Get", mock.Anything, types.NamespacedName{Namespace: "clusters", Name: "test"},
+			mock.AnythingOfType("*v1beta1.HostedCluster"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*hypershiftv1beta1.HostedCluster)
+			*obj = *readyHostedCluster.DeepCopy()
+			obj.Namespace = "clusters"
+			obj.Status.KubeConfig = &corev1.LocalObjectReference{Name: "test-kubeconfig"}
+		}).
+		Return(nil)
+
+	ref, err := KubeconfigSecretRef(context.Background(), clientMock, "clusters/test")
+	require.NoError(t, err)
+	assert.Equal(t, corev1alpha1.KubeconfigSecretReference{
+		Namespace: "clusters", Name: "test-kubeconfig", Key: "kubeconfig",
+	}, ref)
+}
+
+func TestKubeconfigSecretRef_errors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		hostedCluster *hypershiftv1beta1.HostedCluster
+		getErr        error
+		expectedErr   error
+	}{
+		"not found": {
+			getErr:      errors.NewNotFound(schema.GroupResource{}, ""),
+			expectedErr: ErrHostedClusterNotFound,
+		},
+		"not available": {
+			hostedCluster: &hypershiftv1beta1.HostedCluster{
+				Status: hypershiftv1beta1.HostedClusterStatus{
+					KubeConfig: &corev1.LocalObjectReference{Name: "kubeconfig"},
+				},
+			},
+			expectedErr: ErrHostedClusterNotReady,
+		},
+		"no kubeconfig": {
+			hostedCluster: readyHostedCluster,
+			expectedErr:   ErrHostedClusterNotReady,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clientMock := testutil.NewClient()
+			clientMock.
+				On("Get", mock.Anything, types.NamespacedName{Namespace: "clusters", Name: "test"},
+					mock.AnythingOfType("*v1beta1.HostedCluster"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					if test.hostedCluster != nil {
+						obj := args.Get(2).(*hypershiftv1beta1.HostedCluster)
+						*obj = *test.hostedCluster.DeepCopy()
+					}
+				}).
+				Return(test.getErr)
+
+			_, err := KubeconfigSecretRef(context.Background(), clientMock, "clusters/test")
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
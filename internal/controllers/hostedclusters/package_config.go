@@ -0,0 +1,74 @@
+package hostedclusters
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+
+	"package-operator.run/internal/controllers/hostedclusters/hypershift/v1beta1"
+	"package-operator.run/internal/transform"
+)
+
+// ParsePackageConfigTemplate parses a template rendering the config of the Package
+// installed for every HostedCluster, so clusters can be configured individually, e.g. by tier.
+// The template renders YAML or JSON and has the HostedCluster available as .HostedCluster.
+func ParsePackageConfigTemplate(content string) (*template.Template, error) {
+	tmpl := template.New("hosted-cluster-package-config")
+	tmpl, err := tmpl.Funcs(transform.SprigFuncs(tmpl)).Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing hosted cluster package config template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// Context the package config template is executed with.
+type packageConfigTemplateContext struct {
+	HostedCluster hostedClusterTemplateContext
+}
+
+type hostedClusterTemplateContext struct {
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+	// Infrastructure provider, e.g. AWS.
+	Platform string
+	// Pullspec of the desired release image.
+	ReleaseImage string
+	// Desired release version, empty until reported by HyperShift.
+	ReleaseVersion string
+}
+
+func renderPackageConfig(
+	tmpl *template.Template, cluster *v1beta1.HostedCluster,
+) (map[string]any, error) {
+	tmplCtx := packageConfigTemplateContext{
+		HostedCluster: hostedClusterTemplateContext{
+			Name:         cluster.Name,
+			Namespace:    cluster.Namespace,
+			Labels:       cluster.Labels,
+			Annotations:  cluster.Annotations,
+			Platform:     string(cluster.Spec.Platform.Type),
+			ReleaseImage: cluster.Spec.Release.Image,
+		},
+	}
+	if cluster.Status.Version != nil {
+		tmplCtx.HostedCluster.ReleaseVersion = cluster.Status.Version.Desired.Version
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, tmplCtx); err != nil {
+		return nil, fmt.Errorf("rendering package config template: %w", err)
+	}
+	config := map[string]any{}
+	if err := yaml.Unmarshal(buf.Bytes(), &config); err != nil {
+		return nil, fmt.Errorf("parsing rendered package config: %w", err)
+	}
+	if config == nil {
+		// Template rendered "null".
+		config = map[string]any{}
+	}
+	return config, nil
+}
@@ -54,6 +54,50 @@ func (m *phaseObjectOwnerMock) GetConditions() *[]metav1.Condition {
 	return args.Get(0).(*[]metav1.Condition)
 }
 
+type driftDetectionOwnerMock struct {
+	phaseObjectOwnerMock
+}
+
+func (m *driftDetectionOwnerMock) GetDriftDetection() *corev1alpha1.ObjectSetDriftDetection {
+	args := m.Called()
+	return args.Get(0).(*corev1alpha1.ObjectSetDriftDetection)
+}
+
+type successorOwnerMock struct {
+	phaseObjectOwnerMock
+}
+
+func (m *successorOwnerMock) GetSuccessors() []Successor {
+	args := m.Called()
+	return args.Get(0).([]Successor)
+}
+
+type fieldManagerOwnerMock struct {
+	phaseObjectOwnerMock
+}
+
+func (m *fieldManagerOwnerMock) GetFieldManager() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+type fieldConflictPolicyOwnerMock struct {
+	phaseObjectOwnerMock
+}
+
+func (m *fieldConflictPolicyOwnerMock) GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy {
+	args := m.Called()
+	return args.Get(0).(corev1alpha1.FieldConflictPolicy)
+}
+
+type fieldConflictOwnerMock struct {
+	phaseObjectOwnerMock
+}
+
+func (m *fieldConflictOwnerMock) RecordFieldConflict(conflict corev1alpha1.ObjectFieldConflict) {
+	m.Called(conflict)
+}
+
 type dynamicCacheMock struct {
 	testutil.CtrlClient
 }
@@ -82,10 +126,10 @@ type patcherMock struct {
 }
 
 func (m *patcherMock) Patch(
-	ctx context.Context,
+	ctx context.Context, fieldManager string, force bool,
 	desiredObj, currentObj, updatedObj *unstructured.Unstructured,
 ) error {
-	args := m.Called(ctx, desiredObj, currentObj, updatedObj)
+	args := m.Called(ctx, fieldManager, force, desiredObj, currentObj, updatedObj)
 	return args.Error(0)
 }
 
@@ -14,6 +14,8 @@ type objectDeploymentAccessor interface {
 	GetSelector() metav1.LabelSelector
 	GetObjectSetTemplate() corev1alpha1.ObjectSetTemplate
 	GetRevisionHistoryLimit() *int32
+	GetRolloutSchedule() *corev1alpha1.RolloutSchedule
+	GetFreeze() *corev1alpha1.RolloutFreeze
 	SetStatusConditions(...metav1.Condition)
 	SetStatusCollisionCount(*int32)
 	GetStatusCollisionCount() *int32
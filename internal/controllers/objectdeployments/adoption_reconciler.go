@@ -0,0 +1,56 @@
+package objectdeployments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// adoptionReconciler adopts pre-existing ObjectSets matching the selector of the ObjectDeployment,
+// e.g. ObjectSets created manually while prototyping.
+// Adopted ObjectSets become part of the revision history and are replaced by new revisions
+// like any other revision, handing over their objects without downtime.
+type adoptionReconciler struct {
+	client                      client.Client
+	scheme                      *runtime.Scheme
+	listObjectSetsForDeployment listObjectSetsForDeploymentFn
+}
+
+func (a *adoptionReconciler) Reconcile(
+	ctx context.Context, objectDeployment objectDeploymentAccessor,
+) (ctrl.Result, error) {
+	objectSets, err := a.listObjectSetsForDeployment(ctx, objectDeployment)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing objectsets under deployment errored: %w", err)
+	}
+
+	for _, objectSet := range objectSets {
+		obj := objectSet.ClientObject()
+		if metav1.GetControllerOf(obj) != nil || !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		logr.FromContextOrDiscard(ctx).Info("adopting ObjectSet", "name", obj.GetName())
+		if err := controllerutil.SetControllerReference(
+			objectDeployment.ClientObject(), obj, a.scheme); err != nil {
+			return ctrl.Result{}, err
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[ObjectSetObjectDeploymentLabel] = objectDeployment.ClientObject().GetName()
+		obj.SetLabels(labels)
+
+		if err := a.client.Update(ctx, obj); err != nil {
+			return ctrl.Result{}, fmt.Errorf("adopting ObjectSet: %w", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
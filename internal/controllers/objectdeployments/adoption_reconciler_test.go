@@ -0,0 +1,91 @@
+package objectdeployments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/testutil"
+)
+
+func TestAdoptionReconciler(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewClient()
+	deploymentController := NewObjectDeploymentController(client, logr.Discard(), testScheme)
+	r := &adoptionReconciler{
+		client:                      client,
+		scheme:                      testScheme,
+		listObjectSetsForDeployment: deploymentController.listObjectSetsByRevision,
+	}
+
+	objectDeployment := makeObjectDeploymentMock("test", "test", 1, "abc", &[]metav1.Condition{})
+	objectDeployment.ClientObject().SetUID("deploy-uid")
+
+	unowned := makeObjectSet("manual", "test", 1, "", true, true, false)
+	ownedByOther := makeObjectSet("other", "test", 1, "", true, true, false)
+	ownedByOther.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other-uid", Controller: ptr.To(true),
+	}}
+	owned := makeObjectSet("test-abc", "test", 2, "abc", true, true, false)
+	owned.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: corev1alpha1.GroupVersion.String(), Kind: "ObjectDeployment",
+		Name: "test", UID: "deploy-uid", Controller: ptr.To(true),
+	}}
+
+	client.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			objectList := args.Get(1).(*corev1alpha1.ObjectSetList)
+			objectList.Items = []corev1alpha1.ObjectSet{unowned, ownedByOther, owned}
+		}).
+		Return(nil)
+	client.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	res, err := r.Reconcile(context.Background(), objectDeployment)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+
+	client.AssertNumberOfCalls(t, "Update", 1)
+	client.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(obj *corev1alpha1.ObjectSet) bool {
+		controllerRef := metav1.GetControllerOf(obj)
+		return obj.Name == "manual" &&
+			controllerRef != nil && controllerRef.UID == "deploy-uid" &&
+			obj.Labels[ObjectSetObjectDeploymentLabel] == "test"
+	}), mock.Anything)
+}
+
+func TestListObjectSetsByRevision_skipsForeignControllers(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewClient()
+	deploymentController := NewObjectDeploymentController(client, logr.Discard(), testScheme)
+
+	objectDeployment := makeObjectDeploymentMock("test", "test", 1, "abc", &[]metav1.Condition{})
+	objectDeployment.ClientObject().SetUID("deploy-uid")
+
+	ownedByOther := makeObjectSet("other", "test", 1, "", true, true, false)
+	ownedByOther.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other-uid", Controller: ptr.To(true),
+	}}
+	unowned := makeObjectSet("manual", "test", 2, "", true, true, false)
+
+	client.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			objectList := args.Get(1).(*corev1alpha1.ObjectSetList)
+			objectList.Items = []corev1alpha1.ObjectSet{ownedByOther, unowned}
+		}).
+		Return(nil)
+
+	objectSets, err := deploymentController.listObjectSetsByRevision(context.Background(), objectDeployment)
+	require.NoError(t, err)
+	if assert.Len(t, objectSets, 1) {
+		assert.Equal(t, "manual", objectSets[0].ClientObject().GetName())
+	}
+}
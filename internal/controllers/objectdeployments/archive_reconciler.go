@@ -8,9 +8,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-)
 
-const defaultRevisionLimit int32 = 10
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
 
 type archiveReconciler struct {
 	client client.Client
@@ -223,7 +223,7 @@ func intersection(a, b []objectIdentifier) (res []objectIdentifier) {
 func (a *archiveReconciler) garbageCollectRevisions(
 	ctx context.Context, previousObjectSets []genericObjectSet, objectDeployment objectDeploymentAccessor,
 ) error {
-	revisionLimit := defaultRevisionLimit
+	revisionLimit := corev1alpha1.DefaultRevisionHistoryLimit
 	deploymentRevisionLimit := objectDeployment.GetRevisionHistoryLimit()
 	if deploymentRevisionLimit != nil {
 		revisionLimit = *deploymentRevisionLimit
@@ -148,6 +148,16 @@ func (o *genericObjectDeploymentMock) GetRevisionHistoryLimit() *int32 {
 	return args.Get(0).(*int32)
 }
 
+func (o *genericObjectDeploymentMock) GetRolloutSchedule() *corev1alpha1.RolloutSchedule {
+	args := o.Called()
+	return args.Get(0).(*corev1alpha1.RolloutSchedule)
+}
+
+func (o *genericObjectDeploymentMock) GetFreeze() *corev1alpha1.RolloutFreeze {
+	args := o.Called()
+	return args.Get(0).(*corev1alpha1.RolloutFreeze)
+}
+
 func (o *genericObjectDeploymentMock) GetStatusCollisionCount() *int32 {
 	args := o.Called()
 	res, _ := args.Get(0).(*int32)
@@ -231,6 +241,16 @@ func (o *genericObjectSetDeploymentMock) GetRevisionHistoryLimit() *int32 {
 	return args.Get(0).(*int32)
 }
 
+func (o *genericObjectSetDeploymentMock) GetRolloutSchedule() *corev1alpha1.RolloutSchedule {
+	args := o.Called()
+	return args.Get(0).(*corev1alpha1.RolloutSchedule)
+}
+
+func (o *genericObjectSetDeploymentMock) GetFreeze() *corev1alpha1.RolloutFreeze {
+	args := o.Called()
+	return args.Get(0).(*corev1alpha1.RolloutFreeze)
+}
+
 func (o *genericObjectSetDeploymentMock) GetStatusCollisionCount() *int32 {
 	args := o.Called()
 	res, _ := args.Get(0).(*int32)
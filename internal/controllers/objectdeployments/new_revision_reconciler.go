@@ -2,22 +2,35 @@ package objectdeployments
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/equality"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"package-operator.run/internal/rolloutschedule"
+	"package-operator.run/internal/tracing"
 )
 
+var errRolloutScheduleNeverOpens = errors.New("rollout schedule never opens")
+
+// Interval a freeze without expiry is checked again.
+// Lifting the freeze changes the spec and triggers a reconcile right away.
+const rolloutFreezeRecheckInterval = 10 * time.Minute
+
 type newRevisionReconciler struct {
 	client       client.Client
 	newObjectSet genericObjectSetFactory
 	scheme       *runtime.Scheme
+	clock        clock.PassiveClock
 }
 
 func (r *newRevisionReconciler) Reconcile(ctx context.Context,
@@ -37,17 +50,28 @@ func (r *newRevisionReconciler) Reconcile(ctx context.Context,
 		return ctrl.Result{}, nil
 	}
 
+	if len(prevObjectSets) > 0 {
+		// Only new revisions are subject to freezes and the rollout schedule,
+		// the initial revision is always activated right away.
+		if res, err := r.holdBackNewRevision(prevObjectSets, objectDeployment); err != nil || !res.IsZero() {
+			return res, err
+		}
+	}
+
 	newObjectSet, err := r.newObjectSetFromDeployment(objectDeployment, prevObjectSets)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("errored while trying to create a new objectset in memory: %w", err)
 	}
 
+	// Link the rollout of the new revision to this reconciliation.
+	tracing.InjectAnnotation(ctx, newObjectSet.ClientObject())
+
 	err = r.client.Create(ctx, newObjectSet.ClientObject())
 	if err == nil {
 		return ctrl.Result{}, nil
 	}
 
-	if err != nil && !errors.IsAlreadyExists(err) {
+	if err != nil && !apimachineryerrors.IsAlreadyExists(err) {
 		return ctrl.Result{}, fmt.Errorf("errored while creating new ObjectSet: %w", err)
 	}
 
@@ -88,6 +112,68 @@ func (r *newRevisionReconciler) Reconcile(ctx context.Context,
 	return ctrl.Result{}, nil
 }
 
+// Holds back the new revision while the ObjectDeployment is frozen or its rollout schedule is closed.
+func (r *newRevisionReconciler) holdBackNewRevision(
+	prevObjectSets []genericObjectSet,
+	objectDeployment objectDeploymentAccessor,
+) (ctrl.Result, error) {
+	reason, msg, requeueAfter, err := r.rolloutBlocked(objectDeployment, r.clock.Now())
+	if err != nil || requeueAfter == 0 {
+		return ctrl.Result{}, err
+	}
+
+	objectDeployment.SetStatusConditions(
+		newProgressingCondition(
+			metav1.ConditionTrue,
+			reason,
+			msg,
+			objectDeployment.GetGeneration(),
+		),
+		conditionFromPreviousObjectSets(objectDeployment.GetGeneration(), prevObjectSets...),
+	)
+	objectDeployment.SetStatusRevision(prevObjectSets[0].GetRevision())
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// Returns why and for how long the activation of new revisions is blocked.
+// A zero duration allows the activation right away.
+func (r *newRevisionReconciler) rolloutBlocked(
+	objectDeployment objectDeploymentAccessor, now time.Time,
+) (progressingReason, string, time.Duration, error) {
+	if freeze := objectDeployment.GetFreeze(); freeze != nil {
+		hash := objectDeployment.GetStatusTemplateHash()
+		if freeze.Until == nil {
+			return progressingReasonRolloutFrozen,
+				fmt.Sprintf("Rollout of revision %s is frozen: %s", hash, freeze.Reason),
+				rolloutFreezeRecheckInterval, nil
+		}
+		if now.Before(freeze.Until.Time) {
+			return progressingReasonRolloutFrozen,
+				fmt.Sprintf("Rollout of revision %s is frozen until %s: %s",
+					hash, freeze.Until.UTC().Format(time.RFC3339), freeze.Reason),
+				freeze.Until.Sub(now), nil
+		}
+	}
+
+	if objectDeployment.GetRolloutSchedule() == nil {
+		return "", "", 0, nil
+	}
+	schedule, err := rolloutschedule.New(objectDeployment.GetRolloutSchedule())
+	if err != nil {
+		return "", "", 0, err
+	}
+	next, ok := schedule.NextOpen(now)
+	if !ok {
+		return "", "", 0, errRolloutScheduleNeverOpens
+	}
+	if !next.After(now) {
+		return "", "", 0, nil
+	}
+	return progressingReasonRolloutPending,
+		fmt.Sprintf("Waiting for the next rollout window at %s.", next.UTC().Format(time.RFC3339)),
+		next.Sub(now), nil
+}
+
 // Creates and returns a new objectset in memory with the correct objectset template,
 // template hash, previous revision references and ownership set.
 func (r *newRevisionReconciler) newObjectSetFromDeployment(
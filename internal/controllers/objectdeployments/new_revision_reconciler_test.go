@@ -3,6 +3,7 @@ package objectdeployments
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -10,7 +11,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -44,6 +48,110 @@ func Test_newRevisionReconciler_delaysObjectSetCreation(t *testing.T) {
 		t, "Create", mock.Anything, mock.Anything, mock.Anything)
 }
 
+func Test_newRevisionReconciler_waitsForRolloutWindow(t *testing.T) {
+	t.Parallel()
+	log := testr.New(t)
+	ctx := logr.NewContext(context.Background(), log)
+	clientMock := testutil.NewClient()
+	deploymentController := NewObjectDeploymentController(clientMock, log, testScheme)
+	// Monday afternoon.
+	now := time.Date(2024, 6, 3, 15, 0, 0, 0, time.UTC)
+	r := newRevisionReconciler{
+		client:       clientMock,
+		newObjectSet: deploymentController.newObjectSet,
+		scheme:       testScheme,
+		clock:        clocktesting.NewFakePassiveClock(now),
+	}
+
+	objectDeployment := adapters.NewObjectDeployment(testScheme)
+	objectDeployment.ClientObject().SetName("test")
+	objectDeployment.ClientObject().SetNamespace("test")
+	objectDeployment.ClientObject().SetGeneration(2)
+	objectDeployment.SetTemplateSpec(corev1alpha1.ObjectSetTemplateSpec{
+		Phases: []corev1alpha1.ObjectSetTemplatePhase{{}},
+	})
+	objectDeployment.SetStatusTemplateHash("new")
+	objectDeployment.SetRolloutSchedule(&corev1alpha1.RolloutSchedule{
+		Windows: []corev1alpha1.RolloutWindow{
+			{Schedule: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+		},
+	})
+
+	prev := makeObjectSet("rev1", "test", 1, "old", true, true, false)
+	res, err := r.Reconcile(ctx, nil, []genericObjectSet{&GenericObjectSet{prev}}, objectDeployment)
+	require.NoError(t, err)
+	assert.Equal(t, 7*time.Hour, res.RequeueAfter)
+
+	cond := meta.FindStatusCondition(*objectDeployment.GetConditions(), corev1alpha1.ObjectDeploymentProgressing)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "RolloutPending", cond.Reason)
+		assert.Equal(t, "Waiting for the next rollout window at 2024-06-03T22:00:00Z.", cond.Message)
+	}
+	clientMock.AssertNotCalled(
+		t, "Create", mock.Anything, mock.Anything, mock.Anything)
+
+	// The initial revision is not held back.
+	clientMock.On("Create", mock.Anything, mock.Anything, []client.CreateOption(nil)).Return(nil)
+	res, err = r.Reconcile(ctx, nil, nil, objectDeployment)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+	clientMock.AssertCalled(
+		t, "Create", mock.Anything, mock.Anything, []client.CreateOption(nil))
+}
+
+func Test_newRevisionReconciler_frozen(t *testing.T) {
+	t.Parallel()
+	log := testr.New(t)
+	ctx := logr.NewContext(context.Background(), log)
+	clientMock := testutil.NewClient()
+	deploymentController := NewObjectDeploymentController(clientMock, log, testScheme)
+	now := time.Date(2024, 6, 3, 15, 0, 0, 0, time.UTC)
+	clock := clocktesting.NewFakePassiveClock(now)
+	r := newRevisionReconciler{
+		client:       clientMock,
+		newObjectSet: deploymentController.newObjectSet,
+		scheme:       testScheme,
+		clock:        clock,
+	}
+
+	objectDeployment := adapters.NewObjectDeployment(testScheme)
+	objectDeployment.ClientObject().SetName("test")
+	objectDeployment.ClientObject().SetNamespace("test")
+	objectDeployment.ClientObject().SetGeneration(2)
+	objectDeployment.SetTemplateSpec(corev1alpha1.ObjectSetTemplateSpec{
+		Phases: []corev1alpha1.ObjectSetTemplatePhase{{}},
+	})
+	objectDeployment.SetStatusTemplateHash("new")
+	objectDeployment.SetFreeze(&corev1alpha1.RolloutFreeze{
+		Reason: "incident 123",
+		Until:  &metav1.Time{Time: now.Add(time.Hour)},
+	})
+
+	prev := makeObjectSet("rev1", "test", 1, "old", true, true, false)
+	res, err := r.Reconcile(ctx, nil, []genericObjectSet{&GenericObjectSet{prev}}, objectDeployment)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, res.RequeueAfter)
+
+	cond := meta.FindStatusCondition(*objectDeployment.GetConditions(), corev1alpha1.ObjectDeploymentProgressing)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "RolloutFrozen", cond.Reason)
+		assert.Equal(t, "Rollout of revision new is frozen until 2024-06-03T16:00:00Z: incident 123", cond.Message)
+	}
+	clientMock.AssertNotCalled(
+		t, "Create", mock.Anything, mock.Anything, mock.Anything)
+
+	// The freeze expired.
+	clock.SetTime(now.Add(time.Hour))
+	clientMock.On("Create", mock.Anything, mock.Anything, []client.CreateOption(nil)).Return(nil)
+	res, err = r.Reconcile(ctx, nil, []genericObjectSet{&GenericObjectSet{prev}}, objectDeployment)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+	clientMock.AssertCalled(
+		t, "Create", mock.Anything, mock.Anything, []client.CreateOption(nil))
+}
+
 func Test_newRevisionReconciler_createsObjectSet(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +244,7 @@ func Test_newRevisionReconciler_createsObjectSet(t *testing.T) {
 				client:       clientMock,
 				newObjectSet: deploymentController.newObjectSet,
 				scheme:       testScheme,
+				clock:        clocktesting.NewFakePassiveClock(time.Now()),
 			}
 
 			objectDeployment := adapters.NewObjectDeployment(testScheme)
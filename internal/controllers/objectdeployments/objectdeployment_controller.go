@@ -9,11 +9,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/tracing"
 )
 
 const (
@@ -60,6 +65,11 @@ func newGenericObjectDeploymentController(
 		&hashReconciler{
 			client: c,
 		},
+		&adoptionReconciler{
+			client:                      c,
+			scheme:                      scheme,
+			listObjectSetsForDeployment: controller.listObjectSetsByRevision,
+		},
 		&objectSetReconciler{
 			client:                      c,
 			listObjectSetsForDeployment: controller.listObjectSetsByRevision,
@@ -68,6 +78,7 @@ func newGenericObjectDeploymentController(
 					client:       c,
 					newObjectSet: newObjectSet,
 					scheme:       scheme,
+					clock:        clock.RealClock{},
 				},
 				&archiveReconciler{
 					client: c,
@@ -111,7 +122,7 @@ func NewClusterObjectDeploymentController(
 
 func (od *GenericObjectDeploymentController) Reconcile(
 	ctx context.Context, req ctrl.Request,
-) (ctrl.Result, error) {
+) (res ctrl.Result, err error) {
 	log := od.log.WithValues("ObjectDeployment", req.String())
 	defer log.Info("reconciled")
 	ctx = logr.NewContext(ctx, log)
@@ -121,10 +132,9 @@ func (od *GenericObjectDeploymentController) Reconcile(
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	var (
-		res ctrl.Result
-		err error
-	)
+	ctx, span := tracing.StartReconcile(ctx, "ObjectDeployment", objectDeployment.ClientObject())
+	defer func() { tracing.End(span, err) }()
+
 	for _, reconciler := range od.reconciler {
 		res, err = reconciler.Reconcile(ctx, objectDeployment)
 		if err != nil || !res.IsZero() {
@@ -142,12 +152,28 @@ func (od *GenericObjectDeploymentController) SetupWithManager(mgr ctrl.Manager)
 	objectDeployment := od.newObjectDeployment(od.scheme).ClientObject()
 	objectSet := od.newObjectSet(od.scheme).ClientObject()
 
+	priorities := controllers.NewPriorityIndex()
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(objectDeployment).
+		WithOptions(controller.Options{
+			NewQueue: controllers.NewPriorityQueueFactory(priorities),
+		}).
+		For(objectDeployment, builder.WithPredicates(priorities.Predicate(objectDeploymentPriority))).
 		Owns(objectSet).
 		Complete(od)
 }
 
+// Priority of an ObjectDeployment or ClusterObjectDeployment object.
+func objectDeploymentPriority(obj client.Object) int32 {
+	switch deploy := obj.(type) {
+	case *corev1alpha1.ObjectDeployment:
+		return deploy.Spec.Priority
+	case *corev1alpha1.ClusterObjectDeployment:
+		return deploy.Spec.Priority
+	}
+	return 0
+}
+
 func (od *GenericObjectDeploymentController) listObjectSetsByRevision(
 	ctx context.Context,
 	objectDeployment objectDeploymentAccessor,
@@ -169,7 +195,16 @@ func (od *GenericObjectDeploymentController) listObjectSetsByRevision(
 		return nil, fmt.Errorf("listing ObjectSets: %w", err)
 	}
 
-	items := objectSetList.GetItems()
+	// ObjectSets controlled by something else are never adopted,
+	// ObjectSets without controller are adopted by the adoptionReconciler.
+	var items []genericObjectSet
+	for _, objectSet := range objectSetList.GetItems() {
+		controllerRef := metav1.GetControllerOf(objectSet.ClientObject())
+		if controllerRef != nil && controllerRef.UID != objectDeployment.ClientObject().GetUID() {
+			continue
+		}
+		items = append(items, objectSet)
+	}
 
 	// Ensure everything is sorted by revision.
 	sort.Sort(objectSetsByRevisionAscending(items))
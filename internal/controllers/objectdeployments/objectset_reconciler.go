@@ -290,4 +290,6 @@ const (
 	progressingReasonIdle                    progressingReason = "Idle"
 	progressingReasonLatestRevPendingSuccess progressingReason = "LatestRevisionPendingSuccess"
 	progressingReasonProgressing             progressingReason = "Progressing"
+	progressingReasonRolloutPending          progressingReason = "RolloutPending"
+	progressingReasonRolloutFrozen           progressingReason = "RolloutFrozen"
 )
@@ -1,11 +1,13 @@
 package objectsetphases
 
 import (
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/kstatus"
 )
 
 type genericObjectSetPhase interface {
@@ -19,6 +21,7 @@ type genericObjectSetPhase interface {
 	GetGeneration() int64
 	IsPaused() bool
 	SetStatusControllerOf([]corev1alpha1.ControlledObjectReference)
+	SetStatusLastHeartbeatTime(metav1.Time)
 	UpdateStatusPhase()
 }
 
@@ -98,12 +101,18 @@ func (a *GenericObjectSetPhase) IsPaused() bool {
 func (a *GenericObjectSetPhase) GetGeneration() int64 {
 	return a.Generation
 }
-func (a *GenericObjectSetPhase) UpdateStatusPhase() {}
+func (a *GenericObjectSetPhase) UpdateStatusPhase() {
+	objectSetPhaseKStatus(a).Apply(&a.Status.Conditions, a.Generation)
+}
 
 func (a *GenericObjectSetPhase) SetStatusControllerOf(controllerOf []corev1alpha1.ControlledObjectReference) {
 	a.Status.ControllerOf = controllerOf
 }
 
+func (a *GenericObjectSetPhase) SetStatusLastHeartbeatTime(t metav1.Time) {
+	a.Status.LastHeartbeatTime = &t
+}
+
 type GenericClusterObjectSetPhase struct {
 	corev1alpha1.ClusterObjectSetPhase
 }
@@ -149,4 +158,26 @@ func (a *GenericClusterObjectSetPhase) IsPaused() bool {
 func (a *GenericClusterObjectSetPhase) SetStatusControllerOf(controllerOf []corev1alpha1.ControlledObjectReference) {
 	a.Status.ControllerOf = controllerOf
 }
-func (a *GenericClusterObjectSetPhase) UpdateStatusPhase() {}
+
+func (a *GenericClusterObjectSetPhase) SetStatusLastHeartbeatTime(t metav1.Time) {
+	a.Status.LastHeartbeatTime = &t
+}
+func (a *GenericClusterObjectSetPhase) UpdateStatusPhase() {
+	objectSetPhaseKStatus(a).Apply(&a.Status.Conditions, a.Generation)
+}
+
+// ObjectSetPhases are reconciling until their objects are available.
+func objectSetPhaseKStatus(objectSetPhase genericObjectSetPhase) kstatus.Status {
+	conds := *objectSetPhase.GetConditions()
+	switch {
+	case !objectSetPhase.ClientObject().GetDeletionTimestamp().IsZero():
+		return kstatus.Status{Reconciling: &kstatus.Reason{
+			Reason: "Terminating", Message: "Tearing down objects."}}
+	case objectSetPhase.IsPaused():
+		return kstatus.Status{}
+	case !meta.IsStatusConditionTrue(conds, corev1alpha1.ObjectSetPhaseAvailable):
+		return kstatus.Status{Reconciling: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conds, corev1alpha1.ObjectSetPhaseAvailable), "Pending")}
+	}
+	return kstatus.Status{}
+}
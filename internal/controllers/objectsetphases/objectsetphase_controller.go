@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/audit"
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/controllers"
 	"package-operator.run/internal/ownerhandling"
@@ -70,6 +71,8 @@ type GenericObjectSetPhaseController struct {
 	dynamicCache    dynamicCache
 	ownerStrategy   ownerStrategy
 	teardownHandler teardownHandler
+	// Slows down retries of ObjectSetPhases failing persistently.
+	failureBackoff *controllers.FailureBackoff
 
 	reconciler []reconciler
 }
@@ -82,6 +85,7 @@ func NewMultiClusterObjectSetPhaseController(
 	client client.Client, // client to get and update ObjectSetPhases (management cluster).
 	targetWriter client.Writer, // client to patch objects with (hosted cluster).
 	targetRESTMapper meta.RESTMapper,
+	auditSink audit.Sink,
 ) *GenericObjectSetPhaseController {
 	return NewGenericObjectSetPhaseController(
 		newGenericObjectSetPhase,
@@ -93,9 +97,10 @@ func NewMultiClusterObjectSetPhaseController(
 			targetRESTMapper,
 			preflight.List{
 				preflight.NewNoOwnerReferences(targetRESTMapper),
-				preflight.NewDryRun(targetWriter),
+				preflight.NewDryRun(targetWriter, controllers.Apply),
 			},
 		),
+		auditSink,
 	)
 }
 
@@ -107,6 +112,7 @@ func NewMultiClusterClusterObjectSetPhaseController(
 	client client.Client, // client to get and update ObjectSetPhases (management cluster).
 	targetWriter client.Writer, // client to patch objects with (hosted cluster).
 	targetRESTMapper meta.RESTMapper,
+	auditSink audit.Sink,
 ) *GenericObjectSetPhaseController {
 	return NewGenericObjectSetPhaseController(
 		newGenericClusterObjectSetPhase,
@@ -117,10 +123,11 @@ func NewMultiClusterClusterObjectSetPhaseController(
 		preflight.NewAPIExistence(
 			targetRESTMapper,
 			preflight.List{
-				preflight.NewDryRun(targetWriter),
+				preflight.NewDryRun(targetWriter, controllers.Apply),
 				preflight.NewNoOwnerReferences(targetRESTMapper),
 			},
 		),
+		auditSink,
 	)
 }
 
@@ -131,6 +138,7 @@ func NewSameClusterObjectSetPhaseController(
 	class string,
 	client client.Client, // client to get and update ObjectSetPhases.
 	restMapper meta.RESTMapper,
+	auditSink audit.Sink,
 ) *GenericObjectSetPhaseController {
 	return NewGenericObjectSetPhaseController(
 		newGenericObjectSetPhase,
@@ -141,11 +149,12 @@ func NewSameClusterObjectSetPhaseController(
 		preflight.NewAPIExistence(
 			restMapper,
 			preflight.List{
-				preflight.NewNamespaceEscalation(restMapper),
-				preflight.NewDryRun(client),
+				preflight.NewNamespaceEscalation(restMapper, nil),
+				preflight.NewDryRun(client, controllers.Apply),
 				preflight.NewNoOwnerReferences(restMapper),
 			},
 		),
+		auditSink,
 	)
 }
 
@@ -156,6 +165,7 @@ func NewSameClusterClusterObjectSetPhaseController(
 	class string,
 	client client.Client, // client to get and update ObjectSetPhases.
 	restMapper meta.RESTMapper,
+	auditSink audit.Sink,
 ) *GenericObjectSetPhaseController {
 	return NewGenericObjectSetPhaseController(
 		newGenericClusterObjectSetPhase,
@@ -166,10 +176,11 @@ func NewSameClusterClusterObjectSetPhaseController(
 		preflight.NewAPIExistence(
 			restMapper,
 			preflight.List{
-				preflight.NewDryRun(client),
+				preflight.NewDryRun(client, controllers.Apply),
 				preflight.NewNoOwnerReferences(restMapper),
 			},
 		),
+		auditSink,
 	)
 }
 
@@ -184,6 +195,7 @@ func NewGenericObjectSetPhaseController(
 	client client.Client, // client to get and update ObjectSetPhases.
 	targetWriter client.Writer, // client to patch objects with.
 	preflightChecker preflightChecker,
+	auditSink audit.Sink,
 ) *GenericObjectSetPhaseController {
 	controller := &GenericObjectSetPhaseController{
 		newObjectSetPhase: newObjectSetPhase,
@@ -195,12 +207,14 @@ func NewGenericObjectSetPhaseController(
 		client:        client,
 		dynamicCache:  dynamicCache,
 		ownerStrategy: ownerStrategy,
+		failureBackoff: controllers.NewFailureBackoff(
+			controllers.DefaultGlobalMissConfigurationRetry, controllers.DefaultMaxBackoff),
 	}
 
 	phaseReconciler := newObjectSetPhaseReconciler(
 		scheme,
 		controllers.NewPhaseReconciler(
-			scheme, targetWriter, dynamicCache, uncachedClient, ownerStrategy, preflightChecker),
+			scheme, targetWriter, dynamicCache, uncachedClient, ownerStrategy, preflightChecker, auditSink, nil),
 		controllers.NewPreviousRevisionLookup(
 			scheme, func(s *runtime.Scheme) controllers.PreviousObjectSet {
 				return newObjectSet(s)
@@ -231,6 +245,8 @@ func (c *GenericObjectSetPhaseController) Reconcile(
 	if objectSetPhase.GetClass() != c.class {
 		return ctrl.Result{}, nil
 	}
+	// Tell the parent ObjectSet that a controller is taking care of this phase.
+	objectSetPhase.SetStatusLastHeartbeatTime(metav1.Now())
 
 	if !objectSetPhase.ClientObject().GetDeletionTimestamp().IsZero() {
 		if err := c.handleDeletionAndArchival(ctx, objectSetPhase); err != nil {
@@ -256,13 +272,18 @@ func (c *GenericObjectSetPhaseController) Reconcile(
 	}
 
 	if err != nil {
-		return controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSetPhase, err,
+		return controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSetPhase, err, c.failureBackoff,
 			func(ctx context.Context) error {
 				return c.updateStatus(ctx, objectSetPhase)
 			})
 	}
+	c.failureBackoff.Succeeded(objectSetPhase.ClientObject())
+	meta.RemoveStatusCondition(objectSetPhase.GetConditions(), corev1alpha1.ObjectSetRetryBackoff)
 
 	c.reportPausedCondition(ctx, objectSetPhase)
+	if res.IsZero() {
+		res.RequeueAfter = controllers.PhaseHeartbeatInterval
+	}
 	return res, c.updateStatus(ctx, objectSetPhase)
 }
 
@@ -285,6 +306,7 @@ func (c *GenericObjectSetPhaseController) reportPausedCondition(
 func (c *GenericObjectSetPhaseController) updateStatus(
 	ctx context.Context, objectSetPhase genericObjectSetPhase,
 ) error {
+	objectSetPhase.UpdateStatusPhase()
 	if err := c.client.Status().Update(ctx, objectSetPhase.ClientObject()); err != nil {
 		return fmt.Errorf("updating ObjectSetPhase status: %w", err)
 	}
@@ -21,7 +21,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/audit"
 	"package-operator.run/internal/constants"
+	"package-operator.run/internal/controllers"
 	"package-operator.run/internal/ownerhandling"
 	"package-operator.run/internal/testutil"
 )
@@ -162,10 +164,10 @@ func TestGenericObjectSetPhaseController_Reconcile(t *testing.T) {
 				Return(test.getObjectSetPhaseError)
 
 			res, err := controller.Reconcile(context.Background(), ctrl.Request{})
-			assert.Empty(t, res)
 			require.NoError(t, err)
 
 			if test.getObjectSetPhaseError != nil || test.class != "default" {
+				assert.Empty(t, res)
 				pr.AssertNotCalled(t, "Teardown", mock.Anything, mock.Anything)
 				pr.AssertNotCalled(t, "Reconcile", mock.Anything, mock.Anything)
 				c.StatusMock.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
@@ -173,6 +175,7 @@ func TestGenericObjectSetPhaseController_Reconcile(t *testing.T) {
 			}
 
 			if test.deletionTimestamp != nil {
+				assert.Empty(t, res)
 				pr.AssertCalled(t, "Teardown", mock.Anything, mock.Anything)
 				pr.AssertNotCalled(t, "Reconcile", mock.Anything, mock.Anything)
 				dc.AssertCalled(t, "Free", mock.Anything, mock.Anything)
@@ -180,6 +183,8 @@ func TestGenericObjectSetPhaseController_Reconcile(t *testing.T) {
 				return
 			}
 
+			// Requeued to keep reporting heartbeats.
+			assert.Equal(t, controllers.PhaseHeartbeatInterval, res.RequeueAfter)
 			pr.AssertNotCalled(t, "Teardown", mock.Anything, mock.Anything)
 			pr.AssertCalled(t, "Reconcile", mock.Anything, mock.Anything)
 			c.StatusMock.AssertCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
@@ -297,7 +302,7 @@ func TestInitializers(t *testing.T) {
 		ctrl := NewMultiClusterObjectSetPhaseController(
 			log, scheme,
 			dc, client, class, client, client,
-			mapper,
+			mapper, audit.Discard{},
 		)
 
 		require.NotNil(t, ctrl)
@@ -309,7 +314,7 @@ func TestInitializers(t *testing.T) {
 		ctrl := NewMultiClusterClusterObjectSetPhaseController(
 			log, scheme,
 			dc, client, class, client, client,
-			mapper,
+			mapper, audit.Discard{},
 		)
 
 		require.NotNil(t, ctrl)
@@ -321,7 +326,7 @@ func TestInitializers(t *testing.T) {
 		ctrl := NewSameClusterObjectSetPhaseController(
 			log, scheme,
 			dc, client, class, client,
-			mapper,
+			mapper, audit.Discard{},
 		)
 
 		require.NotNil(t, ctrl)
@@ -333,7 +338,7 @@ func TestInitializers(t *testing.T) {
 		ctrl := NewSameClusterClusterObjectSetPhaseController(
 			log, scheme,
 			dc, client, class, client,
-			mapper,
+			mapper, audit.Discard{},
 		)
 
 		require.NotNil(t, ctrl)
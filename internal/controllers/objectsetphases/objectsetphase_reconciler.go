@@ -7,7 +7,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"k8s.io/apimachinery/pkg/api/meta"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/flowcontrol"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +15,7 @@ import (
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/controllers"
 	internalprobing "package-operator.run/internal/probing"
+	"package-operator.run/pkg/phasecontroller"
 	"package-operator.run/pkg/probing"
 )
 
@@ -26,6 +26,7 @@ type objectSetPhaseReconciler struct {
 	lookupPreviousRevisions lookupPreviousRevisions
 	ownerStrategy           ownerStrategy
 	backoff                 *flowcontrol.Backoff
+	probeDamping            *internalprobing.Damping
 }
 
 func newObjectSetPhaseReconciler(
@@ -46,6 +47,7 @@ func newObjectSetPhaseReconciler(
 		lookupPreviousRevisions: lookupPreviousRevisions,
 		ownerStrategy:           ownerStrategy,
 		backoff:                 cfg.GetBackoff(),
+		probeDamping:            internalprobing.NewDamping(),
 	}
 }
 
@@ -72,14 +74,20 @@ func (r *objectSetPhaseReconciler) Reconcile(
 	defer r.backoff.GC()
 
 	controllers.DeleteMappedConditions(ctx, objectSetPhase.GetConditions())
+	// Set again while external objects are missing.
+	meta.RemoveStatusCondition(objectSetPhase.GetConditions(), corev1alpha1.ObjectSetExternalObjectMissing)
+	// Set again while names of CustomResourceDefinitions conflict.
+	meta.RemoveStatusCondition(objectSetPhase.GetConditions(), corev1alpha1.ObjectSetCRDNamesConflict)
+	// Set again while objects are skipped, because their namespace is being deleted.
+	meta.RemoveStatusCondition(objectSetPhase.GetConditions(), corev1alpha1.ObjectSetNamespaceTerminating)
 
 	previous, err := r.lookupPreviousRevisions(ctx, objectSetPhase)
 	if err != nil {
 		return res, fmt.Errorf("lookup previous revisions: %w", err)
 	}
 
-	probe, err := internalprobing.Parse(
-		ctx, objectSetPhase.GetAvailabilityProbes())
+	probe, err := r.probeDamping.Parse(
+		ctx, objectSetPhase.ClientObject().GetUID(), objectSetPhase.GetAvailabilityProbes())
 	if err != nil {
 		return res, fmt.Errorf("parsing probes: %w", err)
 	}
@@ -102,40 +110,46 @@ func (r *objectSetPhaseReconciler) Reconcile(
 		return res, fmt.Errorf("reporting active objects: %w", err)
 	}
 
-	if !probingResult.IsZero() {
-		meta.SetStatusCondition(
-			objectSetPhase.GetConditions(), metav1.Condition{
-				Type:               corev1alpha1.ObjectSetAvailable,
-				Status:             metav1.ConditionFalse,
-				Reason:             "ProbeFailure",
-				Message:            probingResult.StringWithoutPhase(),
-				ObservedGeneration: objectSetPhase.ClientObject().GetGeneration(),
-			})
+	if hasExternalObjects(objectSetPhase) && (!probingResult.IsZero() || meta.FindStatusCondition(
+		*objectSetPhase.GetConditions(), corev1alpha1.ObjectSetExternalObjectMissing) != nil) {
+		// External objects are not watched, so they have to be checked again until they are available.
+		res.RequeueAfter = controllers.ExternalObjectPollInterval
+	}
+	if meta.FindStatusCondition(*objectSetPhase.GetConditions(), corev1alpha1.ObjectSetNamespaceTerminating) != nil &&
+		(res.RequeueAfter == 0 || res.RequeueAfter > controllers.DefaultGlobalMissConfigurationRetry) {
+		// Skipped objects are created again, once their namespace is gone or recreated.
+		res.RequeueAfter = controllers.DefaultGlobalMissConfigurationRetry
+	}
+	if r.probeDamping.Pending(objectSetPhase.ClientObject().GetUID()) &&
+		(res.RequeueAfter == 0 || res.RequeueAfter > controllers.ProbeDampingRecheckInterval) {
+		// Probes have to be evaluated again, until held back results are reported.
+		res.RequeueAfter = controllers.ProbeDampingRecheckInterval
+	}
 
+	if !probingResult.IsZero() {
+		phasecontroller.SetProbeFailure(objectSetPhase.GetConditions(),
+			objectSetPhase.ClientObject().GetGeneration(), probingResult.StringWithoutPhase())
 		return res, nil
 	}
 
-	meta.SetStatusCondition(objectSetPhase.GetConditions(), metav1.Condition{
-		Type:               corev1alpha1.ObjectSetPhaseAvailable,
-		Status:             metav1.ConditionTrue,
-		Reason:             "Available",
-		Message:            "Object is available and passes all probes.",
-		ObservedGeneration: objectSetPhase.ClientObject().GetGeneration(),
-	})
-
-	return ctrl.Result{}, nil
+	phasecontroller.SetAvailable(objectSetPhase.GetConditions(), objectSetPhase.ClientObject().GetGeneration())
+	return res, nil
 }
 
 func (r *objectSetPhaseReconciler) Teardown(
 	ctx context.Context, objectSetPhase genericObjectSetPhase,
 ) (cleanupDone bool, err error) {
 	// objectSetPhase is deleted with the `orphan` cascade option, so we don't need to delete the owned objects.
-	if controllerutil.ContainsFinalizer(objectSetPhase.ClientObject(), "orphan") {
+	if controllerutil.ContainsFinalizer(objectSetPhase.ClientObject(), phasecontroller.OrphanFinalizer) {
 		return true, nil
 	}
 
-	return r.phaseReconciler.TeardownPhase(
+	cleanupDone, err = r.phaseReconciler.TeardownPhase(
 		ctx, objectSetPhase, objectSetPhase.GetPhase())
+	if cleanupDone {
+		r.probeDamping.Forget(objectSetPhase.ClientObject().GetUID())
+	}
+	return cleanupDone, err
 }
 
 // Sets .status.activeObjects to all objects actively reconciled and controlled by this Phase.
@@ -169,3 +183,12 @@ func (c *objectSetPhaseReconcilerConfig) Default() {
 type objectSetPhaseReconcilerOption interface {
 	ConfigureObjectSetPhaseReconciler(*objectSetPhaseReconcilerConfig)
 }
+
+func hasExternalObjects(objectSetPhase genericObjectSetPhase) bool {
+	for _, obj := range objectSetPhase.GetPhase().Objects {
+		if obj.External != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -1,12 +1,17 @@
 package objectsets
 
 import (
+	"slices"
+	"time"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/kstatus"
 )
 
 type genericObjectSet interface {
@@ -15,17 +20,25 @@ type genericObjectSet interface {
 	GetConditions() *[]metav1.Condition
 	IsArchived() bool
 	IsPaused() bool
+	IsPreview() bool
 	GetPrevious() []corev1alpha1.PreviousRevisionReference
 	GetPhases() []corev1alpha1.ObjectSetTemplatePhase
 	SetPhases(phases []corev1alpha1.ObjectSetTemplatePhase)
 	GetAvailabilityProbes() []corev1alpha1.ObjectSetProbe
 	GetSuccessDelaySeconds() int32
+	GetDriftDetection() *corev1alpha1.ObjectSetDriftDetection
+	GetFieldManager() string
+	GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy
 	SetRevision(revision int64)
 	GetRevision() int64
 	GetRemotePhases() []corev1alpha1.RemotePhaseReference
 	SetRemotePhases([]corev1alpha1.RemotePhaseReference)
 	GetStatusControllerOf() []corev1alpha1.ControlledObjectReference
 	SetStatusControllerOf([]corev1alpha1.ControlledObjectReference)
+	SetStatusObjects([]corev1alpha1.ObjectStatus)
+	SetStatusFieldConflicts([]corev1alpha1.ObjectFieldConflict)
+	GetStatusPhaseTimings() []corev1alpha1.ObjectSetPhaseTiming
+	SetStatusPhaseTimings([]corev1alpha1.ObjectSetPhaseTiming)
 }
 
 type genericObjectSetFactory func(
@@ -65,6 +78,8 @@ var (
 
 type GenericObjectSet struct {
 	corev1alpha1.ObjectSet
+	// Revisions replacing this one, only known during teardown.
+	successors []controllers.Successor
 }
 
 func (a *GenericObjectSet) ClientObject() client.Object {
@@ -73,6 +88,7 @@ func (a *GenericObjectSet) ClientObject() client.Object {
 
 func (a *GenericObjectSet) UpdateStatusPhase() {
 	a.Status.Phase = objectSetStatusPhase(a.Status.Conditions)
+	objectSetKStatus(a.ClientObject(), a.Status.Conditions).Apply(&a.Status.Conditions, a.Generation)
 }
 
 func (a *GenericObjectSet) GetConditions() *[]metav1.Condition {
@@ -87,6 +103,10 @@ func (a *GenericObjectSet) IsArchived() bool {
 	return a.Spec.LifecycleState == corev1alpha1.ObjectSetLifecycleStateArchived
 }
 
+func (a *GenericObjectSet) IsPreview() bool {
+	return a.Spec.LifecycleState == corev1alpha1.ObjectSetLifecycleStatePreview
+}
+
 func (a *GenericObjectSet) GetPrevious() []corev1alpha1.PreviousRevisionReference {
 	return a.Spec.Previous
 }
@@ -107,10 +127,30 @@ func (a *GenericObjectSet) GetSuccessDelaySeconds() int32 {
 	return a.Spec.SuccessDelaySeconds
 }
 
+func (a *GenericObjectSet) GetDriftDetection() *corev1alpha1.ObjectSetDriftDetection {
+	return a.Spec.DriftDetection
+}
+
+func (a *GenericObjectSet) GetFieldManager() string {
+	return a.Spec.FieldManager
+}
+
+func (a *GenericObjectSet) GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy {
+	return a.Spec.FieldConflictPolicy
+}
+
 func (a *GenericObjectSet) SetRevision(revision int64) {
 	a.Status.Revision = revision
 }
 
+func (a *GenericObjectSet) SetSuccessors(successors []controllers.Successor) {
+	a.successors = successors
+}
+
+func (a *GenericObjectSet) GetSuccessors() []controllers.Successor {
+	return a.successors
+}
+
 func (a *GenericObjectSet) GetRevision() int64 {
 	return a.Status.Revision
 }
@@ -131,8 +171,44 @@ func (a *GenericObjectSet) GetStatusControllerOf() []corev1alpha1.ControlledObje
 	return a.Status.ControllerOf
 }
 
+func (a *GenericObjectSet) RecordObjectChange(change corev1alpha1.ObjectChange) {
+	a.Status.ObjectChanges = recordObjectChange(a.Status.ObjectChanges, change)
+}
+
+func (a *GenericObjectSet) SetStatusObjects(objects []corev1alpha1.ObjectStatus) {
+	a.Status.Objects = objects
+}
+
+func (a *GenericObjectSet) RecordObjectStatus(status corev1alpha1.ObjectStatus) {
+	a.Status.Objects = recordObjectStatus(a.Status.Objects, status)
+}
+
+func (a *GenericObjectSet) SetStatusFieldConflicts(conflicts []corev1alpha1.ObjectFieldConflict) {
+	a.Status.FieldConflicts = conflicts
+}
+
+func (a *GenericObjectSet) RecordFieldConflict(conflict corev1alpha1.ObjectFieldConflict) {
+	a.Status.FieldConflicts = recordFieldConflict(a.Status.FieldConflicts, conflict)
+}
+
+func (a *GenericObjectSet) GetStatusPhaseTimings() []corev1alpha1.ObjectSetPhaseTiming {
+	return a.Status.PhaseTimings
+}
+
+func (a *GenericObjectSet) SetStatusPhaseTimings(timings []corev1alpha1.ObjectSetPhaseTiming) {
+	a.Status.PhaseTimings = timings
+}
+
+func (a *GenericObjectSet) RecordProbeFailure(
+	phase string, obj corev1alpha1.ControlledObjectReference, observed time.Time,
+) {
+	a.Status.SlowestProbes = recordProbeFailure(a.Status.PhaseTimings, a.Status.SlowestProbes, phase, obj, observed)
+}
+
 type GenericClusterObjectSet struct {
 	corev1alpha1.ClusterObjectSet
+	// Revisions replacing this one, only known during teardown.
+	successors []controllers.Successor
 }
 
 func (a *GenericClusterObjectSet) ClientObject() client.Object {
@@ -141,6 +217,7 @@ func (a *GenericClusterObjectSet) ClientObject() client.Object {
 
 func (a *GenericClusterObjectSet) UpdateStatusPhase() {
 	a.Status.Phase = objectSetStatusPhase(a.Status.Conditions)
+	objectSetKStatus(a.ClientObject(), a.Status.Conditions).Apply(&a.Status.Conditions, a.Generation)
 }
 
 func (a *GenericClusterObjectSet) GetConditions() *[]metav1.Condition {
@@ -155,6 +232,10 @@ func (a *GenericClusterObjectSet) IsArchived() bool {
 	return a.Spec.LifecycleState == corev1alpha1.ObjectSetLifecycleStateArchived
 }
 
+func (a *GenericClusterObjectSet) IsPreview() bool {
+	return a.Spec.LifecycleState == corev1alpha1.ObjectSetLifecycleStatePreview
+}
+
 func (a *GenericClusterObjectSet) GetPrevious() []corev1alpha1.PreviousRevisionReference {
 	return a.Spec.Previous
 }
@@ -175,10 +256,30 @@ func (a *GenericClusterObjectSet) GetSuccessDelaySeconds() int32 {
 	return a.Spec.SuccessDelaySeconds
 }
 
+func (a *GenericClusterObjectSet) GetDriftDetection() *corev1alpha1.ObjectSetDriftDetection {
+	return a.Spec.DriftDetection
+}
+
+func (a *GenericClusterObjectSet) GetFieldManager() string {
+	return a.Spec.FieldManager
+}
+
+func (a *GenericClusterObjectSet) GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy {
+	return a.Spec.FieldConflictPolicy
+}
+
 func (a *GenericClusterObjectSet) SetRevision(revision int64) {
 	a.Status.Revision = revision
 }
 
+func (a *GenericClusterObjectSet) SetSuccessors(successors []controllers.Successor) {
+	a.successors = successors
+}
+
+func (a *GenericClusterObjectSet) GetSuccessors() []controllers.Successor {
+	return a.successors
+}
+
 func (a *GenericClusterObjectSet) GetRevision() int64 {
 	return a.Status.Revision
 }
@@ -199,6 +300,40 @@ func (a *GenericClusterObjectSet) GetStatusControllerOf() []corev1alpha1.Control
 	return a.Status.ControllerOf
 }
 
+func (a *GenericClusterObjectSet) RecordObjectChange(change corev1alpha1.ObjectChange) {
+	a.Status.ObjectChanges = recordObjectChange(a.Status.ObjectChanges, change)
+}
+
+func (a *GenericClusterObjectSet) SetStatusObjects(objects []corev1alpha1.ObjectStatus) {
+	a.Status.Objects = objects
+}
+
+func (a *GenericClusterObjectSet) RecordObjectStatus(status corev1alpha1.ObjectStatus) {
+	a.Status.Objects = recordObjectStatus(a.Status.Objects, status)
+}
+
+func (a *GenericClusterObjectSet) SetStatusFieldConflicts(conflicts []corev1alpha1.ObjectFieldConflict) {
+	a.Status.FieldConflicts = conflicts
+}
+
+func (a *GenericClusterObjectSet) RecordFieldConflict(conflict corev1alpha1.ObjectFieldConflict) {
+	a.Status.FieldConflicts = recordFieldConflict(a.Status.FieldConflicts, conflict)
+}
+
+func (a *GenericClusterObjectSet) GetStatusPhaseTimings() []corev1alpha1.ObjectSetPhaseTiming {
+	return a.Status.PhaseTimings
+}
+
+func (a *GenericClusterObjectSet) SetStatusPhaseTimings(timings []corev1alpha1.ObjectSetPhaseTiming) {
+	a.Status.PhaseTimings = timings
+}
+
+func (a *GenericClusterObjectSet) RecordProbeFailure(
+	phase string, obj corev1alpha1.ControlledObjectReference, observed time.Time,
+) {
+	a.Status.SlowestProbes = recordProbeFailure(a.Status.PhaseTimings, a.Status.SlowestProbes, phase, obj, observed)
+}
+
 func objectSetStatusPhase(conditions []metav1.Condition) corev1alpha1.ObjectSetStatusPhase {
 	if meta.IsStatusConditionTrue(
 		conditions,
@@ -223,3 +358,105 @@ func objectSetStatusPhase(conditions []metav1.Condition) corev1alpha1.ObjectSetS
 
 	return corev1alpha1.ObjectSetStatusPhaseNotReady
 }
+
+// ObjectSets are reconciling while adopting objects from previous revisions,
+// tearing down or waiting for their objects to become available.
+func objectSetKStatus(obj client.Object, conditions []metav1.Condition) kstatus.Status {
+	for _, condType := range []string{
+		corev1alpha1.ObjectSetRetryBackoff,
+		corev1alpha1.ObjectSetSliceCorrupted,
+	} {
+		if cond := meta.FindStatusCondition(conditions, condType); cond != nil &&
+			cond.Status == metav1.ConditionTrue {
+			return kstatus.Status{Stalled: kstatus.ReasonFrom(cond, condType)}
+		}
+	}
+
+	switch {
+	case !obj.GetDeletionTimestamp().IsZero():
+		return kstatus.Status{Reconciling: &kstatus.Reason{
+			Reason: "Terminating", Message: "Tearing down objects."}}
+	case meta.IsStatusConditionTrue(conditions, corev1alpha1.ObjectSetArchived),
+		meta.IsStatusConditionTrue(conditions, corev1alpha1.ObjectSetPaused):
+		return kstatus.Status{}
+	case meta.IsStatusConditionTrue(conditions, corev1alpha1.ObjectSetInTransition):
+		return kstatus.Status{Reconciling: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conditions, corev1alpha1.ObjectSetInTransition), "InTransition")}
+	case !meta.IsStatusConditionTrue(conditions, corev1alpha1.ObjectSetAvailable):
+		return kstatus.Status{Reconciling: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conditions, corev1alpha1.ObjectSetAvailable), "Pending")}
+	}
+	return kstatus.Status{}
+}
+
+// Number of objects listed with their last change in the ObjectSet status.
+const maxObjectChanges = 32
+
+// Lists the change first, replacing an earlier change to the same object.
+// The changes of the objects changed longest ago are dropped, to stay within maxObjectChanges.
+func recordObjectChange(
+	changes []corev1alpha1.ObjectChange, change corev1alpha1.ObjectChange,
+) []corev1alpha1.ObjectChange {
+	out := make([]corev1alpha1.ObjectChange, 0, min(len(changes)+1, maxObjectChanges))
+	out = append(out, change)
+	for _, c := range changes {
+		if len(out) == maxObjectChanges {
+			break
+		}
+		if c.Object != change.Object {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Number of objects listed with their field conflicts in the ObjectSet status.
+const maxFieldConflicts = 32
+
+// Lists the field conflict of the object, replacing an earlier conflict of the same object.
+// Conflicts beyond maxFieldConflicts are dropped.
+func recordFieldConflict(
+	conflicts []corev1alpha1.ObjectFieldConflict, conflict corev1alpha1.ObjectFieldConflict,
+) []corev1alpha1.ObjectFieldConflict {
+	conflicts = slices.DeleteFunc(conflicts, func(c corev1alpha1.ObjectFieldConflict) bool {
+		return c.Object == conflict.Object
+	})
+	if len(conflicts) == maxFieldConflicts {
+		return conflicts
+	}
+	return append(conflicts, conflict)
+}
+
+// Number of objects listed with their state in the ObjectSet status.
+const maxObjectStatuses = 128
+
+// Lists the state of the object, replacing an earlier state of the same object.
+// Objects failing to apply or failing probes are listed before applied objects,
+// applied objects are dropped first to stay within maxObjectStatuses.
+func recordObjectStatus(
+	statuses []corev1alpha1.ObjectStatus, status corev1alpha1.ObjectStatus,
+) []corev1alpha1.ObjectStatus {
+	statuses = slices.DeleteFunc(statuses, func(s corev1alpha1.ObjectStatus) bool {
+		return s.APIVersion == status.APIVersion && s.Kind == status.Kind &&
+			s.Name == status.Name && s.Namespace == status.Namespace
+	})
+
+	if status.State == corev1alpha1.ObjectStateApplied {
+		if len(statuses) == maxObjectStatuses {
+			return statuses
+		}
+		return append(statuses, status)
+	}
+
+	i := slices.IndexFunc(statuses, func(s corev1alpha1.ObjectStatus) bool {
+		return s.State == corev1alpha1.ObjectStateApplied
+	})
+	if i < 0 {
+		i = len(statuses)
+	}
+	statuses = slices.Insert(statuses, i, status)
+	if len(statuses) > maxObjectStatuses {
+		statuses = statuses[:maxObjectStatuses]
+	}
+	return statuses
+}
@@ -1,9 +1,12 @@
 package objectsets
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
@@ -91,6 +94,91 @@ func TestGenericClusterObjectSet_UpdateStatusPhase(t *testing.T) {
 	}
 }
 
+func TestObjectSetKStatus(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	tests := []struct {
+		name                string
+		conditions          []metav1.Condition
+		deleting            bool
+		expectedReconciling string
+		expectedStalled     string
+	}{
+		{
+			name: "available",
+			conditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionTrue, Reason: "Available"},
+			},
+		},
+		{
+			name: "not available",
+			conditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionFalse, Reason: "ProbeFailure"},
+			},
+			expectedReconciling: "ProbeFailure",
+		},
+		{
+			name:                "no conditions",
+			expectedReconciling: "Pending",
+		},
+		{
+			name: "in transition",
+			conditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionTrue, Reason: "Available"},
+				{Type: corev1alpha1.ObjectSetInTransition, Status: metav1.ConditionTrue, Reason: "Adopting"},
+			},
+			expectedReconciling: "Adopting",
+		},
+		{
+			name: "archived",
+			conditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetArchived, Status: metav1.ConditionTrue, Reason: "Archived"},
+			},
+		},
+		{
+			name:                "deleting",
+			deleting:            true,
+			expectedReconciling: "Terminating",
+		},
+		{
+			name: "retry backoff",
+			conditions: []metav1.Condition{
+				{Type: corev1alpha1.ObjectSetRetryBackoff, Status: metav1.ConditionTrue, Reason: "RepeatedFailure"},
+			},
+			deleting:        true,
+			expectedStalled: "RepeatedFailure",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			objectSet := &GenericObjectSet{}
+			objectSet.Status.Conditions = slices.Clone(test.conditions)
+			if test.deleting {
+				objectSet.DeletionTimestamp = &now
+			}
+			objectSet.UpdateStatusPhase()
+
+			for condType, expectedReason := range map[string]string{
+				corev1alpha1.ConditionReconciling: test.expectedReconciling,
+				corev1alpha1.ConditionStalled:     test.expectedStalled,
+			} {
+				cond := meta.FindStatusCondition(objectSet.Status.Conditions, condType)
+				if len(expectedReason) == 0 {
+					assert.Nil(t, cond, condType)
+					continue
+				}
+				if assert.NotNil(t, cond, condType) {
+					assert.Equal(t, expectedReason, cond.Reason)
+				}
+			}
+		})
+	}
+}
+
 func TestGenericObjectSet(t *testing.T) {
 	t.Parallel()
 
@@ -108,6 +196,9 @@ func TestGenericObjectSet(t *testing.T) {
 	assert.True(t, objectSet.IsPaused())
 	objectSet.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStateArchived
 	assert.True(t, objectSet.IsArchived())
+	assert.False(t, objectSet.IsPreview())
+	objectSet.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStatePreview
+	assert.True(t, objectSet.IsPreview())
 
 	phases := []corev1alpha1.ObjectSetTemplatePhase{{}}
 	objectSet.SetPhases(phases)
@@ -152,6 +243,9 @@ func TestGenericClusterObjectSet(t *testing.T) {
 	assert.True(t, objectSet.IsPaused())
 	objectSet.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStateArchived
 	assert.True(t, objectSet.IsArchived())
+	assert.False(t, objectSet.IsPreview())
+	objectSet.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStatePreview
+	assert.True(t, objectSet.IsPreview())
 
 	phases := []corev1alpha1.ObjectSetTemplatePhase{{}}
 	objectSet.SetPhases(phases)
@@ -178,3 +272,85 @@ func TestGenericClusterObjectSet(t *testing.T) {
 	objectSet.SetStatusControllerOf(controllerOf)
 	assert.Equal(t, controllerOf, objectSet.Status.ControllerOf)
 }
+
+func TestRecordObjectChange(t *testing.T) {
+	t.Parallel()
+
+	objectSet := newGenericObjectSet(testScheme).(*GenericObjectSet)
+	change := func(name string, fields int32) corev1alpha1.ObjectChange {
+		return corev1alpha1.ObjectChange{
+			Object:        corev1alpha1.ControlledObjectReference{Kind: "ConfigMap", Name: name},
+			Reason:        corev1alpha1.ObjectChangeReasonDriftCorrected,
+			FieldsChanged: fields,
+		}
+	}
+
+	objectSet.RecordObjectChange(change("a", 1))
+	objectSet.RecordObjectChange(change("b", 1))
+	objectSet.RecordObjectChange(change("a", 2))
+	assert.Equal(t, []corev1alpha1.ObjectChange{change("a", 2), change("b", 1)}, objectSet.Status.ObjectChanges)
+
+	for i := range maxObjectChanges {
+		objectSet.RecordObjectChange(change(fmt.Sprintf("c%d", i), 1))
+	}
+	assert.Len(t, objectSet.Status.ObjectChanges, maxObjectChanges)
+	assert.Equal(t, change(fmt.Sprintf("c%d", maxObjectChanges-1), 1), objectSet.Status.ObjectChanges[0])
+}
+
+func TestRecordObjectStatus(t *testing.T) {
+	t.Parallel()
+
+	objectSet := newGenericObjectSet(testScheme).(*GenericObjectSet)
+	status := func(name string, state corev1alpha1.ObjectState) corev1alpha1.ObjectStatus {
+		return corev1alpha1.ObjectStatus{APIVersion: "v1", Kind: "ConfigMap", Name: name, State: state}
+	}
+
+	objectSet.RecordObjectStatus(status("a", corev1alpha1.ObjectStateApplied))
+	objectSet.RecordObjectStatus(status("b", corev1alpha1.ObjectStateApplied))
+	objectSet.RecordObjectStatus(status("c", corev1alpha1.ObjectStateProbing))
+	objectSet.RecordObjectStatus(status("b", corev1alpha1.ObjectStateFailed))
+	assert.Equal(t, []corev1alpha1.ObjectStatus{
+		status("c", corev1alpha1.ObjectStateProbing),
+		status("b", corev1alpha1.ObjectStateFailed),
+		status("a", corev1alpha1.ObjectStateApplied),
+	}, objectSet.Status.Objects)
+
+	for i := range maxObjectStatuses {
+		objectSet.RecordObjectStatus(status(fmt.Sprintf("d%d", i), corev1alpha1.ObjectStateApplied))
+	}
+	objectSet.RecordObjectStatus(status("e", corev1alpha1.ObjectStateProbing))
+	assert.Len(t, objectSet.Status.Objects, maxObjectStatuses)
+	assert.Equal(t, status("e", corev1alpha1.ObjectStateProbing), objectSet.Status.Objects[2])
+	assert.Equal(t, status("a", corev1alpha1.ObjectStateApplied), objectSet.Status.Objects[3])
+
+	objectSet.SetStatusObjects(nil)
+	assert.Empty(t, objectSet.Status.Objects)
+}
+
+func TestRecordFieldConflict(t *testing.T) {
+	t.Parallel()
+
+	objectSet := newGenericObjectSet(testScheme).(*GenericObjectSet)
+	conflict := func(name, manager string) corev1alpha1.ObjectFieldConflict {
+		return corev1alpha1.ObjectFieldConflict{
+			Object:        corev1alpha1.ControlledObjectReference{Kind: "ConfigMap", Name: name},
+			FieldManagers: []string{manager},
+			Fields:        []string{".data.key"},
+		}
+	}
+
+	objectSet.RecordFieldConflict(conflict("a", "kubectl-edit"))
+	objectSet.RecordFieldConflict(conflict("b", "kubectl-edit"))
+	objectSet.RecordFieldConflict(conflict("a", "helm"))
+	assert.Equal(t, []corev1alpha1.ObjectFieldConflict{
+		conflict("b", "kubectl-edit"), conflict("a", "helm"),
+	}, objectSet.Status.FieldConflicts)
+
+	for i := range maxFieldConflicts {
+		objectSet.RecordFieldConflict(conflict(fmt.Sprintf("c%d", i), "helm"))
+	}
+	assert.Len(t, objectSet.Status.FieldConflicts, maxFieldConflicts)
+
+	objectSet.SetStatusFieldConflicts(nil)
+	assert.Empty(t, objectSet.Status.FieldConflicts)
+}
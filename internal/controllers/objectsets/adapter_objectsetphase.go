@@ -18,6 +18,8 @@ type genericObjectSetPhase interface {
 	SetRevision(revision int64)
 	SetPrevious([]corev1alpha1.PreviousRevisionReference)
 	GetStatusControllerOf() []corev1alpha1.ControlledObjectReference
+	GetStatusLastHeartbeatTime() *metav1.Time
+	GetClass() string
 }
 
 type genericObjectSetPhaseFactory func(
@@ -99,6 +101,14 @@ func (a *GenericObjectSetPhase) GetStatusControllerOf() []corev1alpha1.Controlle
 	return a.Status.ControllerOf
 }
 
+func (a *GenericObjectSetPhase) GetStatusLastHeartbeatTime() *metav1.Time {
+	return a.Status.LastHeartbeatTime
+}
+
+func (a *GenericObjectSetPhase) GetClass() string {
+	return a.Labels[corev1alpha1.ObjectSetPhaseClassLabel]
+}
+
 type GenericClusterObjectSetPhase struct {
 	corev1alpha1.ClusterObjectSetPhase
 }
@@ -142,3 +152,11 @@ func (a *GenericClusterObjectSetPhase) SetPrevious(previous []corev1alpha1.Previ
 func (a *GenericClusterObjectSetPhase) GetStatusControllerOf() []corev1alpha1.ControlledObjectReference {
 	return a.Status.ControllerOf
 }
+
+func (a *GenericClusterObjectSetPhase) GetStatusLastHeartbeatTime() *metav1.Time {
+	return a.Status.LastHeartbeatTime
+}
+
+func (a *GenericClusterObjectSetPhase) GetClass() string {
+	return a.Labels[corev1alpha1.ObjectSetPhaseClassLabel]
+}
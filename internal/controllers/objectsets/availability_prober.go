@@ -0,0 +1,138 @@
+package objectsets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/pkg/probing"
+)
+
+// Re-evaluates the availability probes of ObjectSets against the cache in a fixed interval,
+// so Available conditions follow outages of the probed objects, even when no full reconcile is due.
+// ObjectSets whose probe outcome no longer matches their Available condition are enqueued for reconciliation,
+// which updates the condition.
+type availabilityProber struct {
+	controller *GenericObjectSetController
+	restMapper meta.RESTMapper
+	interval   time.Duration
+	enqueue    chan<- event.GenericEvent
+}
+
+func (p *availabilityProber) Start(ctx context.Context) error {
+	ctx = logr.NewContext(ctx, p.controller.log.WithName("availability-prober"))
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := p.probeAll(ctx); err != nil {
+				logr.FromContextOrDiscard(ctx).Error(err, "probing availability")
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (p *availabilityProber) probeAll(ctx context.Context) error {
+	c := p.controller
+	objs, err := c.listObjectSets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objs {
+		if !c.shard.Owns(obj) {
+			continue
+		}
+		objectSet := c.newObjectSet(c.scheme)
+		if err := c.client.Get(ctx, client.ObjectKeyFromObject(obj), objectSet.ClientObject()); err != nil {
+			if apimachineryerrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("getting %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+		if !p.outdated(ctx, objectSet) {
+			continue
+		}
+
+		select {
+		case p.enqueue <- event.GenericEvent{Object: objectSet.ClientObject()}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return nil
+}
+
+// Returns true when the probe outcome of the objects controlled by the ObjectSet
+// differs from what its Available condition reports.
+func (p *availabilityProber) outdated(ctx context.Context, objectSet genericObjectSet) bool {
+	obj := objectSet.ClientObject()
+	if !obj.GetDeletionTimestamp().IsZero() || objectSet.IsArchived() ||
+		objectSet.IsPaused() || objectSet.IsPreview() || hasRemotePhases(objectSet) {
+		return false
+	}
+	if _, ok := obj.GetAnnotations()[corev1alpha1.ClusterTargetAnnotation]; ok {
+		// Objects live in another cluster.
+		return false
+	}
+	if _, ok := obj.GetAnnotations()[corev1alpha1.HostedClusterTargetAnnotation]; ok {
+		return false
+	}
+
+	cond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetAvailable)
+	if cond == nil || cond.ObservedGeneration != obj.GetGeneration() ||
+		cond.Reason != "Available" && cond.Reason != "ProbeFailure" {
+		// Only the outcome of probes is checked, everything else is left to the reconciler.
+		return false
+	}
+
+	available, err := p.probe(ctx, objectSet)
+	if err != nil {
+		logr.FromContextOrDiscard(ctx).V(1).Info(
+			"skipping ObjectSet", "ObjectSet", client.ObjectKeyFromObject(obj), "reason", err.Error())
+		return false
+	}
+	return available != (cond.Status == metav1.ConditionTrue)
+}
+
+// Returns whether all objects controlled by the ObjectSet pass its availability probes.
+func (p *availabilityProber) probe(ctx context.Context, objectSet genericObjectSet) (bool, error) {
+	probe, err := probing.Parse(ctx, objectSet.GetAvailabilityProbes())
+	if err != nil {
+		return false, fmt.Errorf("parsing probes: %w", err)
+	}
+
+	for _, ref := range objectSet.GetStatusControllerOf() {
+		mapping, err := p.restMapper.RESTMapping(schema.GroupKind{Group: ref.Group, Kind: ref.Kind})
+		if err != nil {
+			return false, err
+		}
+		actual := &unstructured.Unstructured{}
+		actual.SetGroupVersionKind(mapping.GroupVersionKind)
+		err = p.controller.dynamicCache.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, actual)
+		if apimachineryerrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if ok, _ := probe.Probe(actual); !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
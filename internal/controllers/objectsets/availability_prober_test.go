@@ -0,0 +1,129 @@
+package objectsets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestAvailabilityProber_outdated(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		reason           string
+		status           metav1.ConditionStatus
+		paused           bool
+		objectStatus     string
+		objectMissing    bool
+		expectedOutdated bool
+	}{
+		{
+			name:         "available and passing",
+			reason:       "Available",
+			status:       metav1.ConditionTrue,
+			objectStatus: "True",
+		},
+		{
+			name:             "available, but failing",
+			reason:           "Available",
+			status:           metav1.ConditionTrue,
+			objectStatus:     "False",
+			expectedOutdated: true,
+		},
+		{
+			name:             "available, but missing",
+			reason:           "Available",
+			status:           metav1.ConditionTrue,
+			objectMissing:    true,
+			expectedOutdated: true,
+		},
+		{
+			name:             "probe failure, but passing",
+			reason:           "ProbeFailure",
+			status:           metav1.ConditionFalse,
+			objectStatus:     "True",
+			expectedOutdated: true,
+		},
+		{
+			name:         "probe failure and failing",
+			reason:       "ProbeFailure",
+			status:       metav1.ConditionFalse,
+			objectStatus: "False",
+		},
+		{
+			name:         "other reason",
+			reason:       "CollisionDetected",
+			status:       metav1.ConditionFalse,
+			objectStatus: "True",
+		},
+		{
+			name:         "paused",
+			reason:       "Available",
+			status:       metav1.ConditionTrue,
+			paused:       true,
+			objectStatus: "False",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			controller, _, dc, _, _ := newControllerAndMocks()
+			deploymentGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+			mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{deploymentGVK.GroupVersion()})
+			mapper.Add(deploymentGVK, meta.RESTScopeNamespace)
+			p := &availabilityProber{controller: controller, restMapper: mapper}
+
+			if test.objectMissing {
+				dc.On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+			} else {
+				dc.On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						obj := args.Get(2).(*unstructured.Unstructured)
+						assert.Equal(t, deploymentGVK, obj.GroupVersionKind())
+						_ = unstructured.SetNestedSlice(obj.Object, []any{
+							map[string]any{"type": "Available", "status": test.objectStatus},
+						}, "status", "conditions")
+					}).
+					Return(nil)
+			}
+
+			objectSet := &GenericObjectSet{}
+			objectSet.Generation = 1
+			if test.paused {
+				objectSet.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStatePaused
+			}
+			objectSet.Spec.AvailabilityProbes = []corev1alpha1.ObjectSetProbe{{
+				Selector: corev1alpha1.ProbeSelector{
+					Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "Deployment"},
+				},
+				Probes: []corev1alpha1.Probe{{
+					Condition: &corev1alpha1.ProbeConditionSpec{Type: "Available", Status: "True"},
+				}},
+			}}
+			objectSet.Status.ControllerOf = []corev1alpha1.ControlledObjectReference{
+				{Group: "apps", Kind: "Deployment", Name: "test", Namespace: "test"},
+			}
+			objectSet.Status.Conditions = []metav1.Condition{{
+				Type:               corev1alpha1.ObjectSetAvailable,
+				Status:             test.status,
+				Reason:             test.reason,
+				ObservedGeneration: 1,
+			}}
+
+			assert.Equal(t, test.expectedOutdated, p.outdated(context.Background(), objectSet))
+		})
+	}
+}
@@ -2,28 +2,42 @@ package objectsets
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/audit"
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/controllers"
 	"package-operator.run/internal/metrics"
 	"package-operator.run/internal/ownerhandling"
 	"package-operator.run/internal/preflight"
+	"package-operator.run/internal/sharding"
+	"package-operator.run/internal/tracing"
 )
 
 // Generic reconciler for both ObjectSet and ClusterObjectSet objects.
@@ -37,10 +51,40 @@ type GenericObjectSetController struct {
 	reconciler []reconciler
 
 	recorder        metricsRecorder
+	eventRecorder   record.EventRecorder
 	dynamicCache    dynamicCache
 	teardownHandler teardownHandler
+	// Optional, only set for ClusterObjectSets.
+	targetClusters targetClusters
+	// ObjectSets reconciled by this manager, when sharding is enabled.
+	shard sharding.Shard
+	// Slows down retries of ObjectSets failing persistently.
+	failureBackoff *controllers.FailureBackoff
+	restMapper     meta.RESTMapper
+	// Interval availability probes are re-evaluated in, independent of reconciles. Disabled when 0.
+	probeInterval time.Duration
+	probeEvents   chan event.GenericEvent
+
+	// ObjectSets reconciled since start, until all existing ObjectSets have been reconciled once.
+	reconciledLock  sync.Mutex
+	reconciled      map[types.UID]struct{}
+	initialSyncDone atomic.Bool
 }
 
+// Interval in which archiving ObjectSets check on the teardown of their objects.
+// Archiving ObjectSets free their watches right away, so they are not notified about changes to their objects.
+const archivalRecheckInterval = 10 * time.Second
+
+// Reasons of Events emitted for ObjectSets.
+const (
+	eventReasonPhaseStarted      = "PhaseStarted"
+	eventReasonPhaseCompleted    = "PhaseCompleted"
+	eventReasonProbeFailure      = "ProbeFailure"
+	eventReasonCollisionDetected = "CollisionDetected"
+	eventReasonObjectSetArchived = "Archived"
+	eventReasonDriftDetected     = "DriftDetected"
+)
+
 type reconciler interface {
 	Reconcile(ctx context.Context, objectSet genericObjectSet) (ctrl.Result, error)
 }
@@ -67,49 +111,78 @@ func NewObjectSetController(
 	scheme *runtime.Scheme,
 	dw dynamicCache, uc client.Reader,
 	r metricsRecorder, restMapper meta.RESTMapper,
+	auditSink audit.Sink, eventRecorder record.EventRecorder,
+	shard sharding.Shard, probeInterval time.Duration,
+	objectStatus bool, allowedClusterScopedKinds []schema.GroupKind,
 ) *GenericObjectSetController {
-	return newGenericObjectSetController(
+	controller := newGenericObjectSetController(
 		newGenericObjectSet,
+		newGenericObjectSetList,
 		newGenericObjectSetPhase,
 		adapters.NewObjectSlice,
 		c, log, scheme, dw, uc, r,
-		restMapper,
+		restMapper, auditSink, eventRecorder, allowedClusterScopedKinds,
+		withObjectStatus{Enabled: objectStatus},
 	)
+	controller.shard = shard
+	controller.probeInterval = probeInterval
+	return controller
 }
 
+// ClusterObjectSets may target other clusters, resolved by targets.
 func NewClusterObjectSetController(
 	c client.Client, log logr.Logger,
 	scheme *runtime.Scheme,
 	dw dynamicCache, uc client.Reader,
 	r metricsRecorder, restMapper meta.RESTMapper,
+	auditSink audit.Sink, eventRecorder record.EventRecorder,
+	targets targetClusters, shard sharding.Shard,
+	probeInterval time.Duration, objectStatus bool,
 ) *GenericObjectSetController {
-	return newGenericObjectSetController(
+	controller := newGenericObjectSetController(
 		newGenericClusterObjectSet,
+		newGenericClusterObjectSetList,
 		newGenericClusterObjectSetPhase,
 		adapters.NewClusterObjectSlice,
 		c, log, scheme, dw, uc, r,
-		restMapper,
+		restMapper, auditSink, eventRecorder, nil,
+		withTargetClusters{TargetClusters: targets},
+		withObjectStatus{Enabled: objectStatus},
 	)
+	controller.targetClusters = targets
+	controller.shard = shard
+	controller.probeInterval = probeInterval
+	return controller
 }
 
 func newGenericObjectSetController(
 	newObjectSet genericObjectSetFactory,
+	newObjectSetList genericObjectSetListFactory,
 	newObjectSetPhase genericObjectSetPhaseFactory,
 	newObjectSlice adapters.ObjectSliceFactory,
 	client client.Client, log logr.Logger,
 	scheme *runtime.Scheme,
 	dynamicCache dynamicCache, uncachedClient client.Reader,
 	recorder metricsRecorder, restMapper meta.RESTMapper,
+	auditSink audit.Sink, eventRecorder record.EventRecorder,
+	allowedClusterScopedKinds []schema.GroupKind,
+	phasesOpts ...objectSetPhasesReconcilerOption,
 ) *GenericObjectSetController {
 	controller := &GenericObjectSetController{
 		newObjectSet:      newObjectSet,
 		newObjectSetPhase: newObjectSetPhase,
 
-		client:       client,
-		log:          log,
-		scheme:       scheme,
-		dynamicCache: dynamicCache,
-		recorder:     recorder,
+		client:        client,
+		log:           log,
+		scheme:        scheme,
+		dynamicCache:  dynamicCache,
+		recorder:      recorder,
+		eventRecorder: eventRecorder,
+		reconciled:    map[types.UID]struct{}{},
+		restMapper:    restMapper,
+		probeEvents:   make(chan event.GenericEvent),
+		failureBackoff: controllers.NewFailureBackoff(
+			controllers.DefaultGlobalMissConfigurationRetry, controllers.DefaultMaxBackoff),
 	}
 
 	phasesReconciler := newObjectSetPhasesReconciler(
@@ -122,10 +195,11 @@ func newGenericObjectSetController(
 			preflight.NewAPIExistence(restMapper,
 				preflight.List{
 					preflight.NewNoOwnerReferences(restMapper),
-					preflight.NewNamespaceEscalation(restMapper),
-					preflight.NewDryRun(client),
+					preflight.NewNamespaceEscalation(restMapper, allowedClusterScopedKinds),
+					preflight.NewDryRun(client, controllers.Apply),
 				},
 			),
+			auditSink, allowedClusterScopedKinds,
 		),
 		newObjectSetRemotePhaseReconciler(
 			client, uncachedClient, scheme, newObjectSetPhase),
@@ -136,6 +210,14 @@ func newGenericObjectSetController(
 		preflight.PhasesCheckerList{
 			preflight.NewObjectDuplicate(),
 		},
+		eventRecorder,
+		append([]objectSetPhasesReconcilerOption{
+			withSuccessorLookup{Lookup: (&successorLookup{
+				scheme:           scheme,
+				newObjectSetList: newObjectSetList,
+				client:           client,
+			}).Lookup},
+		}, phasesOpts...)...,
 	)
 
 	controller.teardownHandler = phasesReconciler
@@ -157,8 +239,10 @@ func (c *GenericObjectSetController) SetupWithManager(mgr ctrl.Manager) error {
 	objectSet := c.newObjectSet(c.scheme).ClientObject()
 	objectSetPhase := c.newObjectSetPhase(c.scheme).ClientObject()
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(objectSet, builder.WithPredicates(&predicate.GenerationChangedPredicate{})).
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(objectSet, builder.WithPredicates(
+			predicate.Or(&predicate.GenerationChangedPredicate{}, controllers.ReconcileRequestedPredicate()),
+			c.shard.Predicate())).
 		Owns(objectSetPhase).
 		WatchesRawSource(
 			c.dynamicCache.Source(
@@ -172,7 +256,34 @@ func (c *GenericObjectSetController) SetupWithManager(mgr ctrl.Manager) error {
 				}),
 			),
 		).
-		Complete(c)
+		WatchesRawSource(
+			// Cluster-scoped objects of namespaced ObjectSets are owned via annotations.
+			c.dynamicCache.Source(
+				ownerhandling.NewAnnotation(c.scheme).EnqueueRequestForOwner(
+					objectSet, mgr.GetRESTMapper(), false),
+			),
+		)
+	if c.probeInterval > 0 {
+		b = b.WatchesRawSource(source.Channel(c.probeEvents, &handler.EnqueueRequestForObject{}))
+		if err := mgr.Add(&availabilityProber{
+			controller: c,
+			restMapper: c.restMapper,
+			interval:   c.probeInterval,
+			enqueue:    c.probeEvents,
+		}); err != nil {
+			return fmt.Errorf("adding availability prober: %w", err)
+		}
+	}
+	if c.targetClusters != nil {
+		// Objects in target clusters are owned via annotations.
+		b = b.WatchesRawSource(
+			c.targetClusters.Source(
+				ownerhandling.NewAnnotation(c.scheme).EnqueueRequestForOwner(
+					objectSet, mgr.GetRESTMapper(), false),
+			),
+		)
+	}
+	return b.Complete(c)
 }
 
 func (c *GenericObjectSetController) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
@@ -185,10 +296,18 @@ func (c *GenericObjectSetController) Reconcile(ctx context.Context, req ctrl.Req
 		ctx, req.NamespacedName, objectSet.ClientObject()); err != nil {
 		return res, client.IgnoreNotFound(err)
 	}
+	if !c.shard.Owns(objectSet.ClientObject()) {
+		// Requests for ObjectSets of other shards may still come in through owned objects.
+		return res, nil
+	}
+
+	ctx, span := tracing.StartReconcile(ctx, "ObjectSet", objectSet.ClientObject())
+	defer func() { tracing.End(span, err) }()
 	defer func() {
 		if err != nil {
 			return
 		}
+		c.markReconciled(objectSet.ClientObject())
 		if c.recorder != nil {
 			c.recorder.RecordObjectSetMetrics(objectSet)
 		}
@@ -210,6 +329,9 @@ func (c *GenericObjectSetController) Reconcile(ctx context.Context, req ctrl.Req
 			// no way to update status now :)
 			return res, nil
 		}
+		if !meta.IsStatusConditionTrue(*objectSet.GetConditions(), corev1alpha1.ObjectSetArchived) {
+			res.RequeueAfter = archivalRecheckInterval
+		}
 
 		return res, c.updateStatus(ctx, objectSet)
 	}
@@ -225,11 +347,14 @@ func (c *GenericObjectSetController) Reconcile(ctx context.Context, req ctrl.Req
 		}
 	}
 	if err != nil {
-		return controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, err,
+		c.recordCollision(objectSet, err)
+		return controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, err, c.failureBackoff,
 			func(ctx context.Context) error {
 				return c.updateStatus(ctx, objectSet)
 			})
 	}
+	c.failureBackoff.Succeeded(objectSet.ClientObject())
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetRetryBackoff)
 
 	if err := c.reportPausedCondition(ctx, objectSet); err != nil {
 		return res, fmt.Errorf("getting paused status: %w", err)
@@ -238,6 +363,99 @@ func (c *GenericObjectSetController) Reconcile(ctx context.Context, req ctrl.Req
 	return res, c.updateStatus(ctx, objectSet)
 }
 
+// Emits a Warning Event when a new object collision is detected.
+func (c *GenericObjectSetController) recordCollision(objectSet genericObjectSet, err error) {
+	if !controllers.IsAdoptionRefusedError(err) {
+		return
+	}
+
+	// Don't repeat the Event on every retry.
+	cond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetAvailable)
+	if cond != nil && cond.Reason == "CollisionDetected" && cond.Message == err.Error() {
+		return
+	}
+	c.eventRecorder.Event(objectSet.ClientObject(), corev1.EventTypeWarning, eventReasonCollisionDetected, err.Error())
+}
+
+func (c *GenericObjectSetController) markReconciled(obj client.Object) {
+	if c.initialSyncDone.Load() {
+		return
+	}
+
+	c.reconciledLock.Lock()
+	defer c.reconciledLock.Unlock()
+	if c.reconciled != nil {
+		c.reconciled[obj.GetUID()] = struct{}{}
+	}
+}
+
+// ErrObjectSetsNotReconciled is returned by ReadyCheck until all ObjectSets have been reconciled once.
+var ErrObjectSetsNotReconciled = errors.New("waiting for initial reconciliation")
+
+// ReadyCheck implements healthz.Checker.
+// It fails until every existing ObjectSet has been reconciled once since start,
+// so the watches for all objects are registered with the dynamic cache.
+func (c *GenericObjectSetController) ReadyCheck(req *http.Request) error {
+	if c.initialSyncDone.Load() {
+		return nil
+	}
+
+	objs, err := c.listObjectSets(req.Context())
+	if err != nil {
+		return err
+	}
+	gvk, err := apiutil.GVKForObject(c.newObjectSet(c.scheme).ClientObject(), c.scheme)
+	if err != nil {
+		return err
+	}
+
+	c.reconciledLock.Lock()
+	defer c.reconciledLock.Unlock()
+
+	var pending, total int
+	for _, obj := range objs {
+		if !c.shard.Owns(obj) {
+			continue
+		}
+		total++
+		if _, ok := c.reconciled[obj.GetUID()]; !ok {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d of %d %s pending", ErrObjectSetsNotReconciled, pending, total, gvk.Kind)
+	}
+
+	c.initialSyncDone.Store(true)
+	c.reconciled = nil
+	return nil
+}
+
+// Lists all ObjectSets of the kind reconciled by the controller.
+func (c *GenericObjectSetController) listObjectSets(ctx context.Context) ([]client.Object, error) {
+	gvk, err := apiutil.GVKForObject(c.newObjectSet(c.scheme).ClientObject(), c.scheme)
+	if err != nil {
+		return nil, err
+	}
+	listObj, err := c.scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	list := listObj.(client.ObjectList)
+	if err := c.client.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("listing %s: %w", gvk.Kind, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, len(items))
+	for i := range items {
+		objs[i] = items[i].(client.Object)
+	}
+	return objs, nil
+}
+
 func (c *GenericObjectSetController) updateStatus(ctx context.Context, objectSet genericObjectSet) error {
 	objectSet.UpdateStatusPhase()
 	if err := c.client.Status().Update(ctx, objectSet.ClientObject()); err != nil {
@@ -323,6 +541,15 @@ func (c *GenericObjectSetController) handleDeletionAndArchival(
 
 	done := true
 
+	if objectSet.IsArchived() {
+		// Archived revisions don't need to watch their objects anymore,
+		// free the watches right away instead of keeping them until teardown is done.
+		// Teardown of archived revisions does not watch again, but polls.
+		if err := c.dynamicCache.Free(ctx, objectSet.ClientObject()); err != nil {
+			return fmt.Errorf("free cache: %w", err)
+		}
+	}
+
 	// When removing the finalizer this function may be called one last time.
 	// .Teardown may allocate new watches and leave dangling watches.
 	if controllerutil.ContainsFinalizer(objectSet.ClientObject(), constants.CachedFinalizer) {
@@ -362,6 +589,8 @@ func (c *GenericObjectSetController) handleDeletionAndArchival(
 			ObservedGeneration: objectSet.ClientObject().GetGeneration(),
 		})
 		objectSet.SetStatusControllerOf(nil) // we are no longer controlling anything.
+		c.eventRecorder.Event(objectSet.ClientObject(), corev1.EventTypeNormal, eventReasonObjectSetArchived,
+			"Revision archived, all objects have been handed over to a newer revision or removed.")
 	}
 
 	return nil
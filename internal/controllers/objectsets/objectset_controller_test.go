@@ -3,6 +3,8 @@ package objectsets
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -13,6 +15,8 @@ import (
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
@@ -397,7 +401,8 @@ func TestGenericObjectSetController_handleDeletionAndArchival(t *testing.T) {
 			require.NoError(t, err)
 			conds := *objectSet.GetConditions()
 
-			if test.teardownDone {
+			// Archived ObjectSets free their watches right away.
+			if test.teardownDone || test.lifecycleState == corev1alpha1.ObjectSetLifecycleStateArchived {
 				dc.AssertCalled(t, "Free", mock.Anything, mock.Anything)
 			} else {
 				dc.AssertNotCalled(t, "Free", mock.Anything, mock.Anything)
@@ -428,7 +433,7 @@ func TestGenericObjectSetController_updateStatusError(t *testing.T) {
 
 		c, _, _, _, _ := newControllerAndMocks()
 		ctx := context.Background()
-		_, err := controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, errTest,
+		_, err := controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, errTest, nil,
 			func(ctx context.Context) error {
 				return c.updateStatus(ctx, objectSet)
 			})
@@ -449,7 +454,7 @@ func TestGenericObjectSetController_updateStatusError(t *testing.T) {
 			Return(nil)
 
 		ctx := context.Background()
-		_, err := controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, &preflight.Error{},
+		_, err := controllers.UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, &preflight.Error{}, nil,
 			func(ctx context.Context) error {
 				return c.updateStatus(ctx, objectSet)
 			})
@@ -459,6 +464,32 @@ func TestGenericObjectSetController_updateStatusError(t *testing.T) {
 	})
 }
 
+func TestGenericObjectSetController_ReadyCheck(t *testing.T) {
+	t.Parallel()
+
+	controller, c, _, _, _ := newControllerAndMocks()
+	controller.reconciled = map[types.UID]struct{}{}
+
+	c.On("List", mock.Anything, mock.AnythingOfType("*v1alpha1.ObjectSetList"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1alpha1.ObjectSetList)
+			list.Items = []corev1alpha1.ObjectSet{
+				{ObjectMeta: metav1.ObjectMeta{UID: "1234"}},
+			}
+		}).
+		Return(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	require.ErrorIs(t, controller.ReadyCheck(req), ErrObjectSetsNotReconciled)
+
+	controller.markReconciled(&corev1alpha1.ObjectSet{ObjectMeta: metav1.ObjectMeta{UID: "1234"}})
+	require.NoError(t, controller.ReadyCheck(req))
+
+	// Stays ready without listing ObjectSets again.
+	require.NoError(t, controller.ReadyCheck(req))
+	c.AssertNumberOfCalls(t, "List", 2)
+}
+
 func newControllerAndMocks() (
 	*GenericObjectSetController,
 	*testutil.CtrlClient,
@@ -477,6 +508,7 @@ func newControllerAndMocks() (
 		log:               ctrl.Log.WithName("controllers"),
 		scheme:            scheme,
 		dynamicCache:      dc,
+		eventRecorder:     record.NewFakeRecorder(10),
 	}
 	pr := &objectSetPhasesReconcilerMock{}
 
@@ -2,20 +2,27 @@ package objectsets
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/flowcontrol"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/controllers/clustertargets"
 	"package-operator.run/internal/ownerhandling"
 	"package-operator.run/internal/preflight"
 	internalprobing "package-operator.run/internal/probing"
@@ -30,8 +37,11 @@ type objectSetPhasesReconciler struct {
 	remotePhase             remotePhaseReconciler
 	lookupPreviousRevisions lookupPreviousRevisions
 	ownerStrategy           ownerStrategy
+	targetOwnerStrategy     ownerStrategy
 	preflightChecker        phasesChecker
+	eventRecorder           record.EventRecorder
 	backoff                 *flowcontrol.Backoff
+	probeDamping            *internalprobing.Damping
 }
 
 type ownerStrategy interface {
@@ -51,6 +61,7 @@ func newObjectSetPhasesReconciler(
 	remotePhase remotePhaseReconciler,
 	lookupPreviousRevisions lookupPreviousRevisions,
 	checker phasesChecker,
+	eventRecorder record.EventRecorder,
 	opts ...objectSetPhasesReconcilerOption,
 ) *objectSetPhasesReconciler {
 	var cfg objectSetPhasesReconcilerConfig
@@ -65,8 +76,11 @@ func newObjectSetPhasesReconciler(
 		remotePhase:             remotePhase,
 		lookupPreviousRevisions: lookupPreviousRevisions,
 		ownerStrategy:           ownerhandling.NewNative(scheme),
+		targetOwnerStrategy:     ownerhandling.NewAnnotation(scheme),
 		preflightChecker:        checker,
+		eventRecorder:           eventRecorder,
 		backoff:                 cfg.GetBackoff(),
+		probeDamping:            internalprobing.NewDamping(),
 	}
 }
 
@@ -81,6 +95,52 @@ type remotePhaseReconciler interface {
 	) (cleanupDone bool, err error)
 }
 
+// Provides PhaseReconcilers for ObjectSets that target another cluster than the one they live in.
+type targetClusters interface {
+	PhaseReconcilerFor(
+		ctx context.Context, owner client.Object,
+	) (r *controllers.PhaseReconciler, ok bool, err error)
+	// Free all watches of owner in target clusters.
+	Free(ctx context.Context, owner client.Object) error
+	// Source of events for objects in target clusters.
+	Source(handler handler.EventHandler, predicates ...predicate.Predicate) source.Source
+}
+
+// Cluster the phases of an ObjectSet are reconciled in.
+type phaseTarget struct {
+	phaseReconciler phaseReconciler
+	ownerStrategy   ownerStrategy
+	// True if the phases are reconciled in another cluster than the ObjectSet lives in.
+	remote bool
+}
+
+func (r *objectSetPhasesReconciler) phaseTargetFor(
+	ctx context.Context, objectSet genericObjectSet,
+) (phaseTarget, error) {
+	local := phaseTarget{phaseReconciler: r.phaseReconciler, ownerStrategy: r.ownerStrategy}
+	if r.cfg.TargetClusters == nil {
+		return local, nil
+	}
+
+	pr, ok, err := r.cfg.TargetClusters.PhaseReconcilerFor(ctx, objectSet.ClientObject())
+	if err != nil {
+		return phaseTarget{}, fmt.Errorf("resolving target cluster: %w", err)
+	}
+	if !ok {
+		return local, nil
+	}
+	return phaseTarget{phaseReconciler: pr, ownerStrategy: r.targetOwnerStrategy, remote: true}, nil
+}
+
+type lookupSuccessors func(
+	ctx context.Context, objectSet genericObjectSet,
+) ([]controllers.Successor, error)
+
+// Implemented by ObjectSets handing over their objects to successors on teardown.
+type successorsSetter interface {
+	SetSuccessors(successors []controllers.Successor)
+}
+
 type lookupPreviousRevisions func(
 	ctx context.Context, owner controllers.PreviousOwner,
 ) ([]controllers.PreviousObjectSet, error)
@@ -96,6 +156,11 @@ type phaseReconciler interface {
 		ctx context.Context, owner controllers.PhaseObjectOwner,
 		phase corev1alpha1.ObjectSetTemplatePhase,
 	) (cleanupDone bool, err error)
+
+	PreviewPhase(
+		ctx context.Context, owner controllers.PhaseObjectOwner,
+		phase corev1alpha1.ObjectSetTemplatePhase,
+	) error
 }
 
 func (r *objectSetPhasesReconciler) Reconcile(
@@ -103,6 +168,13 @@ func (r *objectSetPhasesReconciler) Reconcile(
 ) (res ctrl.Result, err error) {
 	defer r.backoff.GC()
 
+	// Rebuilt while reconciling phases.
+	objectSet.SetStatusObjects(nil)
+	objectSet.SetStatusFieldConflicts(nil)
+	if !r.cfg.ObjectStatus {
+		defer objectSet.SetStatusObjects(nil)
+	}
+
 	violations, err := r.preflightChecker.Check(ctx, objectSet.GetPhases())
 	if err != nil {
 		return res, err
@@ -114,9 +186,43 @@ func (r *objectSetPhasesReconciler) Reconcile(
 		return res, preflightErr
 	}
 
+	if objectSet.IsPreview() {
+		return res, r.preview(ctx, objectSet)
+	}
+	// Only reported while in Preview.
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetPreviewed)
+
+	// Copy, because the condition is updated in place.
+	var previousAvailable *metav1.Condition
+	if cond := meta.FindStatusCondition(
+		*objectSet.GetConditions(), corev1alpha1.ObjectSetAvailable); cond != nil {
+		previousAvailable = cond.DeepCopy()
+	}
+
+	var previousDrifted *metav1.Condition
+	if cond := meta.FindStatusCondition(
+		*objectSet.GetConditions(), corev1alpha1.ObjectSetDrifted); cond != nil {
+		previousDrifted = cond.DeepCopy()
+	}
+
 	controllers.DeleteMappedConditions(ctx, objectSet.GetConditions())
+	// Set again while reconciling remote phases, as long as their controller is unavailable.
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetPhaseControllerUnavailable)
+	// Set again while external objects are missing.
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetExternalObjectMissing)
+	// Set again while names of CustomResourceDefinitions conflict.
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetCRDNamesConflict)
+	// Set again while objects are skipped, because their namespace is being deleted.
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetNamespaceTerminating)
+	// Set again while objects drifted from their desired state.
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetDrifted)
+
+	target, err := r.phaseTargetFor(ctx, objectSet)
+	if err != nil {
+		return res, err
+	}
 
-	controllerOf, probingResult, err := r.reconcile(ctx, objectSet)
+	controllerOf, probingResult, err := r.reconcile(ctx, objectSet, target)
 	if controllers.IsExternalResourceNotFound(err) {
 		id := string(objectSet.ClientObject().GetUID())
 
@@ -129,6 +235,34 @@ func (r *objectSetPhasesReconciler) Reconcile(
 		return res, err
 	}
 	objectSet.SetStatusControllerOf(controllerOf)
+	r.recordEvents(objectSet, previousAvailable, probingResult)
+	r.recordDrift(objectSet, previousDrifted)
+	if hasRemotePhases(objectSet) {
+		// Heartbeats of phase controllers have to be checked, even when no events come in.
+		res.RequeueAfter = controllers.PhaseHeartbeatTimeout
+	}
+	if hasExternalObjects(objectSet) && (!probingResult.IsZero() || meta.FindStatusCondition(
+		*objectSet.GetConditions(), corev1alpha1.ObjectSetExternalObjectMissing) != nil) {
+		// External objects are not watched, so they have to be checked again until they are available.
+		if res.RequeueAfter == 0 || res.RequeueAfter > controllers.ExternalObjectPollInterval {
+			res.RequeueAfter = controllers.ExternalObjectPollInterval
+		}
+	}
+	if interval := driftDetectionInterval(objectSet); interval > 0 &&
+		(res.RequeueAfter == 0 || res.RequeueAfter > interval) {
+		// Objects are compared to their desired state again, even when no events come in.
+		res.RequeueAfter = interval
+	}
+	if meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetNamespaceTerminating) != nil &&
+		(res.RequeueAfter == 0 || res.RequeueAfter > controllers.DefaultGlobalMissConfigurationRetry) {
+		// Skipped objects are created again, once their namespace is gone or recreated.
+		res.RequeueAfter = controllers.DefaultGlobalMissConfigurationRetry
+	}
+	if r.probeDamping.Pending(objectSet.ClientObject().GetUID()) &&
+		(res.RequeueAfter == 0 || res.RequeueAfter > controllers.ProbeDampingRecheckInterval) {
+		// Probes have to be evaluated again, until held back results are reported.
+		res.RequeueAfter = controllers.ProbeDampingRecheckInterval
+	}
 
 	inTransition := isObjectSetInTransition(objectSet, controllerOf)
 	if inTransition {
@@ -182,24 +316,160 @@ func (r *objectSetPhasesReconciler) Reconcile(
 	return
 }
 
+// Runs preflight checks and dry-runs for all phases, without applying any object.
+// Preflight violations are returned as error and reported like for active ObjectSets.
+func (r *objectSetPhasesReconciler) preview(ctx context.Context, objectSet genericObjectSet) error {
+	target, err := r.phaseTargetFor(ctx, objectSet)
+	if err != nil {
+		return err
+	}
+
+	for _, phase := range objectSet.GetPhases() {
+		if len(phase.Class) > 0 {
+			// Remote phases are checked by their phase controller once active.
+			continue
+		}
+		if err := target.phaseReconciler.PreviewPhase(ctx, objectSet, phase); err != nil {
+			return err
+		}
+	}
+
+	meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetPreviewed,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Previewed",
+		Message:            "All objects passed preflight checks and dry-runs.",
+		ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+	})
+	meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetAvailable,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Preview",
+		Message:            "ObjectSet is in Preview, set lifecycleState to Active to apply its objects.",
+		ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+	})
+	return nil
+}
+
+// Emits Events for phases that started or completed and for new probe failures,
+// by comparing the rollout progress with the progress reported by the previous Available condition.
+func (r *objectSetPhasesReconciler) recordEvents(
+	objectSet genericObjectSet, previousAvailable *metav1.Condition,
+	probingResult controllers.ProbingResult,
+) {
+	obj := objectSet.ClientObject()
+	phases := objectSet.GetPhases()
+	previous := progressFromAvailableCondition(phases, previousAvailable)
+	current := progressFromProbingResult(phases, probingResult)
+
+	for i := max(previous, 0); i <= current && i < len(phases); i++ {
+		if i > previous {
+			r.eventRecorder.Eventf(obj, corev1.EventTypeNormal, eventReasonPhaseStarted,
+				"Phase %q started.", phases[i].Name)
+		}
+		if i < current {
+			r.eventRecorder.Eventf(obj, corev1.EventTypeNormal, eventReasonPhaseCompleted,
+				"Phase %q completed.", phases[i].Name)
+		}
+	}
+
+	if probingResult.IsZero() {
+		return
+	}
+	msg := probingResult.String()
+	if previousAvailable == nil ||
+		previousAvailable.Reason != "ProbeFailure" ||
+		previousAvailable.Message != msg {
+		r.eventRecorder.Event(obj, corev1.EventTypeWarning, eventReasonProbeFailure, msg)
+	}
+}
+
+// Emits a Warning Event when objects drifted from their desired state,
+// unless the same drift was already reported by the previous Drifted condition.
+func (r *objectSetPhasesReconciler) recordDrift(objectSet genericObjectSet, previousDrifted *metav1.Condition) {
+	cond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetDrifted)
+	if cond == nil {
+		return
+	}
+	if previousDrifted != nil {
+		// The condition is set again on every reconcile, keep the time drift was first detected.
+		cond.LastTransitionTime = previousDrifted.LastTransitionTime
+		if previousDrifted.Message == cond.Message {
+			return
+		}
+	}
+	r.eventRecorder.Event(objectSet.ClientObject(), corev1.EventTypeWarning, eventReasonDriftDetected, cond.Message)
+}
+
+func driftDetectionInterval(objectSet genericObjectSet) time.Duration {
+	driftDetection := objectSet.GetDriftDetection()
+	if driftDetection == nil || driftDetection.Interval == nil {
+		return 0
+	}
+	return driftDetection.Interval.Duration
+}
+
+// Returns the index of the first phase that has not completed, as reported by the Available condition.
+// Returns len(phases) if all phases completed and -1 if the progress is unknown.
+func progressFromAvailableCondition(
+	phases []corev1alpha1.ObjectSetTemplatePhase, cond *metav1.Condition,
+) int {
+	switch {
+	case cond == nil:
+		return -1
+	case cond.Status == metav1.ConditionTrue:
+		return len(phases)
+	case cond.Reason == "ProbeFailure":
+		phaseName, ok := controllers.PhaseFromProbingResultMessage(cond.Message)
+		if !ok {
+			return -1
+		}
+		return phaseIndex(phases, phaseName)
+	default:
+		return -1
+	}
+}
+
+// Returns the index of the first phase that has not completed or len(phases) if all phases completed.
+func progressFromProbingResult(
+	phases []corev1alpha1.ObjectSetTemplatePhase, probingResult controllers.ProbingResult,
+) int {
+	if probingResult.IsZero() {
+		return len(phases)
+	}
+	return phaseIndex(phases, probingResult.PhaseName)
+}
+
+func phaseIndex(phases []corev1alpha1.ObjectSetTemplatePhase, name string) int {
+	for i, phase := range phases {
+		if phase.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (r *objectSetPhasesReconciler) reconcile(
-	ctx context.Context, objectSet genericObjectSet,
+	ctx context.Context, objectSet genericObjectSet, target phaseTarget,
 ) ([]corev1alpha1.ControlledObjectReference, controllers.ProbingResult, error) {
 	previous, err := r.lookupPreviousRevisions(ctx, objectSet)
 	if err != nil {
 		return nil, controllers.ProbingResult{}, fmt.Errorf("lookup previous revisions: %w", err)
 	}
 
-	probe, err := internalprobing.Parse(
-		ctx, objectSet.GetAvailabilityProbes())
+	probe, err := r.probeDamping.Parse(
+		ctx, objectSet.ClientObject().GetUID(), objectSet.GetAvailabilityProbes())
 	if err != nil {
 		return nil, controllers.ProbingResult{}, fmt.Errorf("parsing probes: %w", err)
 	}
 
 	var controllerOfAll []corev1alpha1.ControlledObjectReference
 	for _, phase := range objectSet.GetPhases() {
+		objectSet.SetStatusPhaseTimings(startPhaseTiming(
+			objectSet.GetStatusPhaseTimings(), phase.Name, r.cfg.Clock.Now()))
+
 		controllerOf, probingResult, err := r.reconcilePhase(
-			ctx, objectSet, phase, probe, previous)
+			ctx, objectSet, target, phase, probe, previous)
 		if err != nil {
 			return nil, controllers.ProbingResult{}, err
 		}
@@ -211,13 +481,15 @@ func (r *objectSetPhasesReconciler) reconcile(
 			// break on first failing probe
 			return controllerOfAll, probingResult, nil
 		}
+		objectSet.SetStatusPhaseTimings(completePhaseTiming(
+			objectSet.GetStatusPhaseTimings(), phase.Name, r.cfg.Clock.Now()))
 	}
 
 	return controllerOfAll, controllers.ProbingResult{}, nil
 }
 
 func (r *objectSetPhasesReconciler) reconcilePhase(
-	ctx context.Context, objectSet genericObjectSet,
+	ctx context.Context, objectSet genericObjectSet, target phaseTarget,
 	phase corev1alpha1.ObjectSetTemplatePhase,
 	probe probing.Prober,
 	previous []controllers.PreviousObjectSet,
@@ -227,24 +499,24 @@ func (r *objectSetPhasesReconciler) reconcilePhase(
 			ctx, objectSet, phase)
 	}
 	return r.reconcileLocalPhase(
-		ctx, objectSet, phase, probe, previous)
+		ctx, objectSet, target, phase, probe, previous)
 }
 
 // Reconciles the Phase directly in-process.
 func (r *objectSetPhasesReconciler) reconcileLocalPhase(
-	ctx context.Context, objectSet genericObjectSet,
+	ctx context.Context, objectSet genericObjectSet, target phaseTarget,
 	phase corev1alpha1.ObjectSetTemplatePhase,
 	probe probing.Prober,
 	previous []controllers.PreviousObjectSet,
 ) ([]corev1alpha1.ControlledObjectReference, controllers.ProbingResult, error) {
-	actualObjects, probingResult, err := r.phaseReconciler.ReconcilePhase(
+	actualObjects, probingResult, err := target.phaseReconciler.ReconcilePhase(
 		ctx, objectSet, phase, probe, previous)
 	if err != nil {
 		return nil, probingResult, err
 	}
 
 	controllerOf, err := controllers.GetControllerOf(
-		ctx, r.scheme, r.ownerStrategy,
+		ctx, r.scheme, target.ownerStrategy,
 		objectSet.ClientObject(), actualObjects)
 	if err != nil {
 		return nil, controllers.ProbingResult{}, err
@@ -262,29 +534,72 @@ func (r *objectSetPhasesReconciler) Teardown(
 		return true, nil
 	}
 
+	target, err := r.phaseTargetFor(ctx, objectSet)
+	if errors.Is(err, clustertargets.ErrTargetNotFound) {
+		// Objects are gone together with the target cluster.
+		log.Info("target cluster not found, skipping cleanup")
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if o, ok := objectSet.(successorsSetter); ok && r.cfg.LookupSuccessors != nil {
+		successors, err := r.cfg.LookupSuccessors(ctx, objectSet)
+		if err != nil {
+			return false, fmt.Errorf("lookup successors: %w", err)
+		}
+		o.SetSuccessors(successors)
+	}
+
 	phases := objectSet.GetPhases()
 	reverse(phases) // teardown in reverse order
 
 	for _, phase := range phases {
-		if cleanupDone, err := r.teardownPhase(ctx, objectSet, phase); err != nil {
+		if cleanupDone, err := r.teardownPhase(ctx, objectSet, target, phase); err != nil {
 			return false, fmt.Errorf("error archiving phase: %w", err)
 		} else if !cleanupDone {
 			return false, nil
 		}
 		log.Info("cleanup done", "phase", phase.Name)
 	}
+	r.probeDamping.Forget(objectSet.ClientObject().GetUID())
 
+	if target.remote {
+		if err := r.cfg.TargetClusters.Free(ctx, objectSet.ClientObject()); err != nil {
+			return false, fmt.Errorf("freeing target cluster cache: %w", err)
+		}
+	}
 	return true, nil
 }
 
 func (r *objectSetPhasesReconciler) teardownPhase(
-	ctx context.Context, objectSet genericObjectSet,
+	ctx context.Context, objectSet genericObjectSet, target phaseTarget,
 	phase corev1alpha1.ObjectSetTemplatePhase,
 ) (cleanupDone bool, err error) {
 	if len(phase.Class) > 0 {
 		return r.remotePhase.Teardown(ctx, objectSet, phase)
 	}
-	return r.phaseReconciler.TeardownPhase(ctx, objectSet, phase)
+	return target.phaseReconciler.TeardownPhase(ctx, objectSet, phase)
+}
+
+func hasRemotePhases(objectSet genericObjectSet) bool {
+	for _, phase := range objectSet.GetPhases() {
+		if len(phase.Class) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func hasExternalObjects(objectSet genericObjectSet) bool {
+	for _, phase := range objectSet.GetPhases() {
+		for _, obj := range phase.Objects {
+			if obj.External != nil {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // reverse the order of a slice.
@@ -370,6 +685,12 @@ func (r *objectSetPhasesReconciler) hasSurvivedDelay(objectSet genericObjectSet)
 
 type objectSetPhasesReconcilerConfig struct {
 	Clock clock
+	// Optional, phases are always reconciled in the local cluster if nil.
+	TargetClusters targetClusters
+	// Reports the state of every object in the ObjectSet status.
+	ObjectStatus bool
+	// Optional, objects are always deleted on teardown if nil.
+	LookupSuccessors lookupSuccessors
 	controllers.BackoffConfig
 }
 
@@ -399,6 +720,30 @@ func (w withClock) ConfigureObjectSetPhasesReconciler(c *objectSetPhasesReconcil
 	c.Clock = w.Clock
 }
 
+type withTargetClusters struct {
+	TargetClusters targetClusters
+}
+
+func (w withTargetClusters) ConfigureObjectSetPhasesReconciler(c *objectSetPhasesReconcilerConfig) {
+	c.TargetClusters = w.TargetClusters
+}
+
+type withObjectStatus struct {
+	Enabled bool
+}
+
+func (w withObjectStatus) ConfigureObjectSetPhasesReconciler(c *objectSetPhasesReconcilerConfig) {
+	c.ObjectStatus = w.Enabled
+}
+
+type withSuccessorLookup struct {
+	Lookup lookupSuccessors
+}
+
+func (w withSuccessorLookup) ConfigureObjectSetPhasesReconciler(c *objectSetPhasesReconcilerConfig) {
+	c.LookupSuccessors = w.Lookup
+}
+
 type clock interface {
 	Now() time.Time
 }
@@ -12,11 +12,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/controllers/clustertargets"
 	"package-operator.run/internal/preflight"
 	"package-operator.run/internal/testutil/controllersmocks"
 )
@@ -65,7 +70,7 @@ func TestObjectSetPhasesReconciler_Reconcile(t *testing.T) {
 		return []controllers.PreviousObjectSet{}, nil
 	}
 	checker := &phasesCheckerMock{}
-	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker)
+	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker, record.NewFakeRecorder(10))
 
 	phase1 := corev1alpha1.ObjectSetTemplatePhase{
 		Name: "phase1",
@@ -88,7 +93,8 @@ func TestObjectSetPhasesReconciler_Reconcile(t *testing.T) {
 	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
 
 	res, err := r.Reconcile(context.Background(), os)
-	assert.Empty(t, res)
+	// phase2 is reconciled remotely, so heartbeats are checked periodically.
+	assert.Equal(t, controllers.PhaseHeartbeatTimeout, res.RequeueAfter)
 	require.NoError(t, err)
 
 	pr.AssertCalled(t, "ReconcilePhase", mock.Anything, mock.Anything, phase1, mock.Anything, mock.Anything)
@@ -109,6 +115,100 @@ func TestObjectSetPhasesReconciler_Reconcile(t *testing.T) {
 	assert.Equal(t, metav1.ConditionTrue, availableCond.Status)
 }
 
+func TestObjectSetPhasesReconciler_Reconcile_events(t *testing.T) {
+	t.Parallel()
+
+	pr := &phaseReconcilerMock{}
+	remotePr := &remotePhaseReconcilerMock{}
+	lookup := func(_ context.Context, _ controllers.PreviousOwner) ([]controllers.PreviousObjectSet, error) {
+		return []controllers.PreviousObjectSet{}, nil
+	}
+	checker := &phasesCheckerMock{}
+	recorder := record.NewFakeRecorder(10)
+	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker, recorder)
+
+	phase1 := corev1alpha1.ObjectSetTemplatePhase{Name: "phase1"}
+	phase2 := corev1alpha1.ObjectSetTemplatePhase{Name: "phase2"}
+
+	os := &GenericObjectSet{}
+	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{phase1, phase2}
+
+	pr.On("ReconcilePhase", mock.Anything, mock.Anything, phase1, mock.Anything, mock.Anything).
+		Return([]client.Object{}, controllers.ProbingResult{}, nil)
+	pr.On("ReconcilePhase", mock.Anything, mock.Anything, phase2, mock.Anything, mock.Anything).
+		Return([]client.Object{}, controllers.ProbingResult{
+			PhaseName:    "phase2",
+			FailedProbes: []string{"not ready"},
+		}, nil)
+	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
+
+	_, err := r.Reconcile(context.Background(), os)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.Events, 4)
+	assert.Equal(t, `Normal PhaseStarted Phase "phase1" started.`, <-recorder.Events)
+	assert.Equal(t, `Normal PhaseCompleted Phase "phase1" completed.`, <-recorder.Events)
+	assert.Equal(t, `Normal PhaseStarted Phase "phase2" started.`, <-recorder.Events)
+	assert.Equal(t, `Warning ProbeFailure Phase "phase2" failed: not ready`, <-recorder.Events)
+
+	// Nothing changed, no new Events.
+	_, err = r.Reconcile(context.Background(), os)
+	require.NoError(t, err)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestObjectSetPhasesReconciler_Reconcile_preview(t *testing.T) {
+	t.Parallel()
+
+	pr := &phaseReconcilerMock{}
+	remotePr := &remotePhaseReconcilerMock{}
+	lookup := func(_ context.Context, _ controllers.PreviousOwner) ([]controllers.PreviousObjectSet, error) {
+		return []controllers.PreviousObjectSet{}, nil
+	}
+	checker := &phasesCheckerMock{}
+	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker, record.NewFakeRecorder(10))
+
+	phase1 := corev1alpha1.ObjectSetTemplatePhase{Name: "phase1"}
+	phase2 := corev1alpha1.ObjectSetTemplatePhase{Name: "phase2", Class: "class"}
+
+	os := &GenericObjectSet{}
+	os.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStatePreview
+	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{phase1, phase2}
+
+	pr.On("PreviewPhase", mock.Anything, mock.Anything, phase1).Return(nil).Once()
+	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
+
+	_, err := r.Reconcile(context.Background(), os)
+	require.NoError(t, err)
+
+	pr.AssertExpectations(t)
+	pr.AssertNotCalled(t, "ReconcilePhase", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	remotePr.AssertNotCalled(t, "Reconcile", mock.Anything, mock.Anything, mock.Anything)
+	assert.True(t, meta.IsStatusConditionTrue(*os.GetConditions(), corev1alpha1.ObjectSetPreviewed))
+	availableCond := meta.FindStatusCondition(*os.GetConditions(), corev1alpha1.ObjectSetAvailable)
+	require.NotNil(t, availableCond)
+	assert.Equal(t, metav1.ConditionFalse, availableCond.Status)
+	assert.Equal(t, "Preview", availableCond.Reason)
+
+	// Preflight violations surface like for active ObjectSets.
+	previewErr := &preflight.Error{Violations: []preflight.Violation{{Error: "invalid"}}}
+	pr.On("PreviewPhase", mock.Anything, mock.Anything, phase1).Return(previewErr).Once()
+	_, err = r.Reconcile(context.Background(), os)
+	require.ErrorIs(t, err, previewErr)
+
+	// Promoted to Active.
+	os.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStateActive
+	pr.On("ReconcilePhase", mock.Anything, mock.Anything, phase1, mock.Anything, mock.Anything).
+		Return([]client.Object{}, controllers.ProbingResult{}, nil)
+	remotePr.On("Reconcile", mock.Anything, mock.Anything, phase2).
+		Return([]corev1alpha1.ControlledObjectReference{}, controllers.ProbingResult{}, nil)
+
+	_, err = r.Reconcile(context.Background(), os)
+	require.NoError(t, err)
+	assert.Nil(t, meta.FindStatusCondition(*os.GetConditions(), corev1alpha1.ObjectSetPreviewed))
+	assert.True(t, meta.IsStatusConditionTrue(*os.GetConditions(), corev1alpha1.ObjectSetAvailable))
+}
+
 func TestPhaseReconciler_ReconcileBackoff(t *testing.T) {
 	t.Parallel()
 
@@ -118,7 +218,7 @@ func TestPhaseReconciler_ReconcileBackoff(t *testing.T) {
 		return []controllers.PreviousObjectSet{}, nil
 	}
 	checker := &phasesCheckerMock{}
-	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker)
+	r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker, record.NewFakeRecorder(10))
 
 	os := &GenericObjectSet{}
 	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{
@@ -168,7 +268,7 @@ func TestObjectSetPhasesReconciler_Teardown(t *testing.T) {
 				return []controllers.PreviousObjectSet{}, nil
 			}
 			checker := &phasesCheckerMock{}
-			r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker)
+			r := newObjectSetPhasesReconciler(testScheme, pr, remotePr, lookup, checker, record.NewFakeRecorder(10))
 
 			phase1 := corev1alpha1.ObjectSetTemplatePhase{
 				Name: "phase1",
@@ -289,7 +389,7 @@ func TestObjectSetPhasesReconciler_SuccessDelay(t *testing.T) {
 			checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
 
 			rec := newObjectSetPhasesReconciler(
-				testScheme, prm, rprm, lookup, checker,
+				testScheme, prm, rprm, lookup, checker, record.NewFakeRecorder(10),
 				withClock{
 					Clock: cm,
 				},
@@ -317,6 +417,58 @@ func (m *clockMock) Now() time.Time {
 	return args.Get(0).(time.Time)
 }
 
+type targetClustersStub struct {
+	err error
+}
+
+func (s targetClustersStub) PhaseReconcilerFor(
+	context.Context, client.Object,
+) (*controllers.PhaseReconciler, bool, error) {
+	return nil, true, s.err
+}
+
+func (s targetClustersStub) Free(context.Context, client.Object) error {
+	return nil
+}
+
+func (s targetClustersStub) Source(handler.EventHandler, ...predicate.Predicate) source.Source {
+	return nil
+}
+
+func TestObjectSetPhasesReconciler_targetClusterErrors(t *testing.T) {
+	t.Parallel()
+
+	pr := &phaseReconcilerMock{}
+	remotePr := &remotePhaseReconcilerMock{}
+	lookup := func(_ context.Context, _ controllers.PreviousOwner) ([]controllers.PreviousObjectSet, error) {
+		return []controllers.PreviousObjectSet{}, nil
+	}
+	checker := &phasesCheckerMock{}
+	checker.On("Check", mock.Anything, mock.Anything).Return([]preflight.Violation{}, nil)
+
+	os := &GenericObjectSet{}
+	os.Spec.Phases = []corev1alpha1.ObjectSetTemplatePhase{{Name: "phase1"}}
+
+	r := newObjectSetPhasesReconciler(
+		testScheme, pr, remotePr, lookup, checker, record.NewFakeRecorder(10),
+		withTargetClusters{TargetClusters: targetClustersStub{err: clustertargets.ErrTargetNotReady}},
+	)
+	_, err := r.Reconcile(context.Background(), os)
+	require.ErrorIs(t, err, clustertargets.ErrTargetNotReady)
+
+	// Nothing to clean up, when the target cluster is gone.
+	r = newObjectSetPhasesReconciler(
+		testScheme, pr, remotePr, lookup, checker, record.NewFakeRecorder(10),
+		withTargetClusters{TargetClusters: targetClustersStub{err: clustertargets.ErrTargetNotFound}},
+	)
+	done, err := r.Teardown(context.Background(), os)
+	require.NoError(t, err)
+	assert.True(t, done)
+
+	pr.AssertNotCalled(t, "ReconcilePhase", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	pr.AssertNotCalled(t, "TeardownPhase", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_isObjectSetInTransition(t *testing.T) {
 	t.Parallel()
 
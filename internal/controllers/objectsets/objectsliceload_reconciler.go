@@ -3,16 +3,26 @@ package objectsets
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/ownerhandling"
+	"package-operator.run/internal/utils"
 )
 
+// Interval corrupted ObjectSlices are checked again.
+// ObjectSlices are immutable, so a corrupted slice only recovers when it is restored or recreated.
+const sliceCorruptedRecheckInterval = 5 * time.Minute
+
 // objectSliceLoadReconciler loads ObjectSlices to inline all objects into the ObjectSet again.
 type objectSliceLoadReconciler struct {
 	scheme         *runtime.Scheme
@@ -38,8 +48,11 @@ func (r *objectSliceLoadReconciler) Reconcile(
 	ctx context.Context, objectSet genericObjectSet,
 ) (res ctrl.Result, err error) {
 	phases := objectSet.GetPhases()
-	for i := range phases {
-		phase := &phases[i]
+	// Objects are only added to the phases after all slices have been verified,
+	// so partial content is never reconciled.
+	sliceObjects := make([][]corev1alpha1.ObjectSetObject, len(phases))
+	var corrupted []string
+	for i, phase := range phases {
 		for _, slice := range phase.Slices {
 			objSlice := r.newObjectSlice(r.scheme)
 			if err := r.client.Get(ctx, client.ObjectKey{
@@ -60,9 +73,46 @@ func (r *objectSliceLoadReconciler) Reconcile(
 				}
 			}
 
-			phase.Objects = append(phase.Objects, objSlice.GetObjects()...)
+			ok, err := verifySliceChecksum(objSlice)
+			if err != nil {
+				return res, err
+			}
+			if !ok {
+				corrupted = append(corrupted, slice)
+				continue
+			}
+			sliceObjects[i] = append(sliceObjects[i], objSlice.GetObjects()...)
 		}
 	}
+
+	if len(corrupted) > 0 {
+		meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+			Type:               corev1alpha1.ObjectSetSliceCorrupted,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ChecksumMismatch",
+			Message:            "Objects do not match the checksum of ObjectSlices: " + strings.Join(corrupted, ", "),
+			ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+		})
+		return ctrl.Result{RequeueAfter: sliceCorruptedRecheckInterval}, nil
+	}
+	meta.RemoveStatusCondition(objectSet.GetConditions(), corev1alpha1.ObjectSetSliceCorrupted)
+
+	for i := range phases {
+		phases[i].Objects = append(phases[i].Objects, sliceObjects[i]...)
+	}
 	objectSet.SetPhases(phases)
 	return
 }
+
+// Returns false, if the objects of the slice do not match its checksum.
+// Slices without checksum were created by older versions and are not verified.
+func verifySliceChecksum(slice adapters.ObjectSliceAccessor) (bool, error) {
+	if len(slice.GetChecksum()) == 0 {
+		return true, nil
+	}
+	checksum, err := utils.ComputeObjectsChecksum(slice.GetObjects())
+	if err != nil {
+		return false, err
+	}
+	return checksum == slice.GetChecksum(), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,6 +17,7 @@ import (
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/testutil"
+	"package-operator.run/internal/utils"
 )
 
 func TestObjectSliceLoadReconciler(t *testing.T) {
@@ -104,3 +106,70 @@ func TestObjectSliceLoadReconciler(t *testing.T) {
 		object1, object2,
 	}, objectSet.Spec.Phases[0].Objects)
 }
+
+func TestObjectSliceLoadReconciler_corrupted(t *testing.T) {
+	t.Parallel()
+
+	c := testutil.NewClient()
+
+	r := newObjectSliceLoadReconciler(testScheme, c, adapters.NewObjectSlice)
+
+	objects := []corev1alpha1.ObjectSetObject{
+		{Object: unstructured.Unstructured{Object: map[string]any{"metadata": map[string]any{"name": "o-1"}}}},
+		{Object: unstructured.Unstructured{Object: map[string]any{"metadata": map[string]any{"name": "o-2"}}}},
+	}
+	checksum, err := utils.ComputeObjectsChecksum(objects)
+	require.NoError(t, err)
+
+	objectSet := &GenericObjectSet{
+		ObjectSet: corev1alpha1.ObjectSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test-ns",
+			},
+			Spec: corev1alpha1.ObjectSetSpec{
+				ObjectSetTemplateSpec: corev1alpha1.ObjectSetTemplateSpec{
+					Phases: []corev1alpha1.ObjectSetTemplatePhase{
+						{Slices: []string{"slice-1", "slice-2"}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, name := range []string{"slice-1", "slice-2"} {
+		c.
+			On("Get", mock.Anything, client.ObjectKey{
+				Name:      name,
+				Namespace: "test-ns",
+			}, mock.AnythingOfType("*v1alpha1.ObjectSlice"), mock.Anything).
+			Run(func(args mock.Arguments) {
+				slice := args.Get(2).(*corev1alpha1.ObjectSlice)
+				slice.Name = name
+				slice.Namespace = "test-ns"
+				slice.Checksum = checksum
+				slice.Objects = objects
+				if name == "slice-2" {
+					// Truncated.
+					slice.Objects = objects[:1]
+				}
+			}).
+			Return(nil)
+	}
+	c.
+		On("Update", mock.Anything, mock.AnythingOfType("*v1alpha1.ObjectSlice"), mock.Anything).
+		Return(nil)
+
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	res, err := r.Reconcile(ctx, objectSet)
+	require.NoError(t, err)
+	assert.Equal(t, sliceCorruptedRecheckInterval, res.RequeueAfter)
+
+	// Objects of intact slices are not loaded either.
+	assert.Empty(t, objectSet.Spec.Phases[0].Objects)
+	cond := meta.FindStatusCondition(objectSet.Status.Conditions, corev1alpha1.ObjectSetSliceCorrupted)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Contains(t, cond.Message, "slice-2")
+	assert.NotContains(t, cond.Message, "slice-1")
+}
@@ -0,0 +1,78 @@
+package objectsets
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// Number of objects listed with the time they failed their probes in the ObjectSet status.
+const maxSlowestProbes = 10
+
+// Starts the timing of the phase, unless it was started before.
+func startPhaseTiming(
+	timings []corev1alpha1.ObjectSetPhaseTiming, phase string, now time.Time,
+) []corev1alpha1.ObjectSetPhaseTiming {
+	if slices.ContainsFunc(timings, func(t corev1alpha1.ObjectSetPhaseTiming) bool {
+		return t.Name == phase
+	}) {
+		return timings
+	}
+	return append(timings, corev1alpha1.ObjectSetPhaseTiming{
+		Name:    phase,
+		Started: metav1.NewTime(now),
+	})
+}
+
+// Completes the timing of the phase, unless it was completed before.
+// Phases failing probes again later on do not change their timing.
+func completePhaseTiming(
+	timings []corev1alpha1.ObjectSetPhaseTiming, phase string, now time.Time,
+) []corev1alpha1.ObjectSetPhaseTiming {
+	i := slices.IndexFunc(timings, func(t corev1alpha1.ObjectSetPhaseTiming) bool {
+		return t.Name == phase
+	})
+	if i == -1 || timings[i].Completed != nil {
+		return timings
+	}
+
+	completed := metav1.NewTime(now)
+	timings[i].Completed = &completed
+	timings[i].Duration = &metav1.Duration{Duration: now.Sub(timings[i].Started.Time)}
+	return timings
+}
+
+// Records the time the object failed its probes since its phase started, slowest objects first.
+// Failures are only recorded while the phase is rolling out,
+// objects failing for a shorter time are dropped to stay within maxSlowestProbes.
+func recordProbeFailure(
+	timings []corev1alpha1.ObjectSetPhaseTiming, probes []corev1alpha1.ObjectProbeTiming,
+	phase string, obj corev1alpha1.ControlledObjectReference, observed time.Time,
+) []corev1alpha1.ObjectProbeTiming {
+	i := slices.IndexFunc(timings, func(t corev1alpha1.ObjectSetPhaseTiming) bool {
+		return t.Name == phase
+	})
+	if i == -1 || timings[i].Completed != nil {
+		return probes
+	}
+
+	probes = slices.DeleteFunc(probes, func(p corev1alpha1.ObjectProbeTiming) bool {
+		return p.Phase == phase && p.Object == obj
+	})
+	probes = append(probes, corev1alpha1.ObjectProbeTiming{
+		Object:   obj,
+		Phase:    phase,
+		Duration: metav1.Duration{Duration: observed.Sub(timings[i].Started.Time)},
+	})
+	slices.SortStableFunc(probes, func(a, b corev1alpha1.ObjectProbeTiming) int {
+		return cmp.Compare(b.Duration.Duration, a.Duration.Duration)
+	})
+	if len(probes) > maxSlowestProbes {
+		probes = probes[:maxSlowestProbes]
+	}
+	return probes
+}
@@ -0,0 +1,70 @@
+package objectsets
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestPhaseTiming(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timings := startPhaseTiming(nil, "deploy", start)
+	// Started again on every reconcile.
+	timings = startPhaseTiming(timings, "deploy", start.Add(time.Minute))
+	require.Len(t, timings, 1)
+	assert.Equal(t, metav1.NewTime(start), timings[0].Started)
+	assert.Nil(t, timings[0].Completed)
+
+	timings = completePhaseTiming(timings, "deploy", start.Add(2*time.Minute))
+	// Completed only once.
+	timings = completePhaseTiming(timings, "deploy", start.Add(3*time.Minute))
+	if assert.NotNil(t, timings[0].Completed) {
+		assert.Equal(t, start.Add(2*time.Minute), timings[0].Completed.Time)
+	}
+	assert.Equal(t, &metav1.Duration{Duration: 2 * time.Minute}, timings[0].Duration)
+}
+
+func TestRecordProbeFailure(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	objectSet := newGenericObjectSet(testScheme).(*GenericObjectSet)
+	objectSet.SetStatusPhaseTimings(startPhaseTiming(nil, "deploy", start))
+	obj := func(name string) corev1alpha1.ControlledObjectReference {
+		return corev1alpha1.ControlledObjectReference{Group: "apps", Kind: "Deployment", Name: name}
+	}
+	probe := func(name string, d time.Duration) corev1alpha1.ObjectProbeTiming {
+		return corev1alpha1.ObjectProbeTiming{
+			Object: obj(name), Phase: "deploy", Duration: metav1.Duration{Duration: d},
+		}
+	}
+
+	objectSet.RecordProbeFailure("deploy", obj("a"), start.Add(time.Second))
+	objectSet.RecordProbeFailure("deploy", obj("b"), start.Add(time.Second))
+	objectSet.RecordProbeFailure("deploy", obj("b"), start.Add(time.Minute))
+	// Phase not started.
+	objectSet.RecordProbeFailure("rollout", obj("c"), start.Add(time.Minute))
+	assert.Equal(t, []corev1alpha1.ObjectProbeTiming{
+		probe("b", time.Minute), probe("a", time.Second),
+	}, objectSet.Status.SlowestProbes)
+
+	for i := range maxSlowestProbes {
+		objectSet.RecordProbeFailure("deploy", obj(fmt.Sprintf("d%d", i)), start.Add(time.Duration(i)*time.Second))
+	}
+	require.Len(t, objectSet.Status.SlowestProbes, maxSlowestProbes)
+	assert.Equal(t, probe("b", time.Minute), objectSet.Status.SlowestProbes[0])
+
+	// Failures after the phase completed are not recorded.
+	objectSet.SetStatusPhaseTimings(completePhaseTiming(
+		objectSet.GetStatusPhaseTimings(), "deploy", start.Add(2*time.Minute)))
+	objectSet.RecordProbeFailure("deploy", obj("a"), start.Add(time.Hour))
+	assert.Equal(t, probe("b", time.Minute), objectSet.Status.SlowestProbes[0])
+}
@@ -18,6 +18,7 @@ import (
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 
 	"package-operator.run/internal/controllers"
+	"package-operator.run/pkg/phasecontroller"
 )
 
 // Reconciles ObjectSetPhase objects for the parent ObjectSet.
@@ -26,6 +27,7 @@ type objectSetRemotePhaseReconciler struct {
 	uncachedClient    client.Reader
 	scheme            *runtime.Scheme
 	newObjectSetPhase genericObjectSetPhaseFactory
+	clock             clock
 }
 
 func newObjectSetRemotePhaseReconciler(
@@ -39,6 +41,7 @@ func newObjectSetRemotePhaseReconciler(
 		uncachedClient:    uncachedClient,
 		scheme:            scheme,
 		newObjectSetPhase: newObjectSetPhase,
+		clock:             defaultClock{},
 	}
 }
 
@@ -165,6 +168,15 @@ func (r *objectSetRemotePhaseReconciler) Reconcile(
 		}
 	}
 
+	// -> check that a phase controller is still alive
+	if phasecontroller.IsHeartbeatExpired(
+		currentObjectSetPhase.ClientObject(),
+		currentObjectSetPhase.GetStatusLastHeartbeatTime(),
+		r.clock.Now(),
+	) {
+		return r.reportPhaseControllerUnavailable(ctx, objectSet, phase, currentObjectSetPhase)
+	}
+
 	// ObjectSetPhase already exists
 	// -> copy mapped status conditions
 	controllers.MapConditions(
@@ -205,6 +217,56 @@ func (r *objectSetRemotePhaseReconciler) Reconcile(
 	}, nil
 }
 
+// Reports that no controller is reconciling the ObjectSetPhase
+// and hands the phase over to the failover class, if configured.
+func (r *objectSetRemotePhaseReconciler) reportPhaseControllerUnavailable(
+	ctx context.Context, objectSet genericObjectSet,
+	phase corev1alpha1.ObjectSetTemplatePhase,
+	objectSetPhase genericObjectSetPhase,
+) ([]corev1alpha1.ControlledObjectReference, controllers.ProbingResult, error) {
+	class := objectSetPhase.GetClass()
+	msg := fmt.Sprintf(
+		"No heartbeat from a controller for phase class %q on %s within %s.",
+		class, objectSetPhase.ClientObject().GetName(), controllers.PhaseHeartbeatTimeout)
+
+	failoverClass := objectSet.ClientObject().GetAnnotations()[corev1alpha1.ObjectSetPhaseFailoverAnnotation]
+	if len(failoverClass) > 0 && failoverClass != class {
+		logr.FromContextOrDiscard(ctx).Info("failing over remote phase",
+			"phase", phase.Name, "class", class, "failoverClass", failoverClass)
+
+		current := objectSetPhase.ClientObject()
+		patch := map[string]any{
+			"metadata": map[string]any{
+				"resourceVersion": current.GetResourceVersion(),
+				"labels": map[string]any{
+					corev1alpha1.ObjectSetPhaseClassLabel: failoverClass,
+				},
+			},
+		}
+		patchJSON, err := json.Marshal(patch)
+		if err != nil {
+			panic(err)
+		}
+		if err := r.client.Patch(
+			ctx, current, client.RawPatch(types.MergePatchType, patchJSON)); err != nil {
+			return nil, controllers.ProbingResult{}, fmt.Errorf("patching ObjectSetPhase class: %w", err)
+		}
+		msg += fmt.Sprintf(" Failed over to phase class %q.", failoverClass)
+	}
+
+	meta.SetStatusCondition(objectSet.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetPhaseControllerUnavailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             "HeartbeatExpired",
+		Message:            msg,
+		ObservedGeneration: objectSet.ClientObject().GetGeneration(),
+	})
+	return objectSetPhase.GetStatusControllerOf(), controllers.ProbingResult{
+		PhaseName:    phase.Name,
+		FailedProbes: []string{msg},
+	}, nil
+}
+
 func (r *objectSetRemotePhaseReconciler) desiredObjectSetPhase(
 	objectSet genericObjectSet,
 	phase corev1alpha1.ObjectSetTemplatePhase,
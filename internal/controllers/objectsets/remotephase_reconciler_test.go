@@ -11,12 +11,14 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	corev1 "k8s.io/api/core/v1"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/controllers"
 	"package-operator.run/internal/testutil"
 )
 
@@ -266,6 +268,64 @@ func TestObjectSetRemotePhaseReconciler_desiredObjectSetPhase(t *testing.T) {
 	assert.Equal(t, objectSet.Namespace, objectSetPhase.Namespace)
 }
 
+func TestObjectSetRemotePhaseReconciler_Reconcile_HeartbeatExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	clientMock := testutil.NewClient()
+	cm := &clockMock{}
+	cm.On("Now").Return(now)
+	r := &objectSetRemotePhaseReconciler{
+		client:            clientMock,
+		scheme:            testScheme,
+		newObjectSetPhase: newGenericObjectSetPhase,
+		clock:             cm,
+	}
+
+	genObjectSet := newGenericObjectSet(testScheme)
+	objectSet := genObjectSet.ClientObject().(*corev1alpha1.ObjectSet)
+	objectSet.Name = "my-stuff"
+	objectSet.Namespace = "my-namespace"
+	objectSet.Annotations = map[string]string{
+		corev1alpha1.ObjectSetPhaseFailoverAnnotation: "default",
+	}
+
+	phase := corev1alpha1.ObjectSetTemplatePhase{
+		Name:  "phase-1",
+		Class: "hosted-cluster",
+	}
+
+	lastHeartbeat := metav1.NewTime(now.Add(-2 * controllers.PhaseHeartbeatTimeout))
+	clientMock.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			out := args.Get(2).(*corev1alpha1.ObjectSetPhase)
+			out.Name = "my-stuff-phase-1"
+			out.Labels = map[string]string{
+				corev1alpha1.ObjectSetPhaseClassLabel: phase.Class,
+			}
+			out.CreationTimestamp = lastHeartbeat
+			out.Status.LastHeartbeatTime = &lastHeartbeat
+		}).
+		Return(nil)
+	clientMock.
+		On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	_, probingResult, err := r.Reconcile(context.Background(), genObjectSet, phase)
+	require.NoError(t, err)
+	assert.False(t, probingResult.IsZero())
+
+	clientMock.AssertCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	cond := meta.FindStatusCondition(
+		objectSet.Status.Conditions, corev1alpha1.ObjectSetPhaseControllerUnavailable)
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "HeartbeatExpired", cond.Reason)
+		assert.Contains(t, cond.Message, `Failed over to phase class "default".`)
+	}
+}
+
 func TestObjectSetRemotePhaseReconciler_TeardownNamespaceDeletion_ObjectSet(t *testing.T) {
 	t.Parallel()
 
@@ -311,7 +371,7 @@ func TestObjectSetRemotePhaseReconciler_TeardownNamespaceDeletion_ObjectSet(t *t
 	}
 
 	objectSet := &GenericObjectSet{
-		corev1alpha1.ObjectSet{
+		ObjectSet: corev1alpha1.ObjectSet{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: "chickenspace",
 			},
@@ -369,7 +429,7 @@ func TestObjectSetRemotePhaseReconciler_TeardownNamespaceDeletion_ClusterObjectS
 	}
 
 	objectSet := &GenericObjectSet{
-		corev1alpha1.ObjectSet{
+		ObjectSet: corev1alpha1.ObjectSet{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: "",
 			},
@@ -37,7 +37,7 @@ func Test_revisionReconciler(t *testing.T) {
 		}
 
 		objectSet := &GenericObjectSet{
-			corev1alpha1.ObjectSet{},
+			ObjectSet: corev1alpha1.ObjectSet{},
 		}
 
 		ctx := context.Background()
@@ -93,7 +93,7 @@ func Test_revisionReconciler(t *testing.T) {
 			Return(nil)
 
 		objectSet := &GenericObjectSet{
-			corev1alpha1.ObjectSet{
+			ObjectSet: corev1alpha1.ObjectSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "xxx",
 				},
@@ -147,7 +147,7 @@ func Test_revisionReconciler(t *testing.T) {
 			Return(nil)
 
 		objectSet := &GenericObjectSet{
-			corev1alpha1.ObjectSet{
+			ObjectSet: corev1alpha1.ObjectSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "xxx",
 				},
@@ -0,0 +1,103 @@
+package objectsets
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/controllers"
+)
+
+// Finds the revisions replacing an ObjectSet,
+// so objects moving between phases across revisions are handed over instead of deleted on teardown.
+type successorLookup struct {
+	scheme           *runtime.Scheme
+	newObjectSetList genericObjectSetListFactory
+	client           client.Reader
+}
+
+// Returns all not archived ObjectSets in the same namespace and of the same deployment
+// that reference the given ObjectSet as previous revision and have a higher revision.
+func (l *successorLookup) Lookup(
+	ctx context.Context, objectSet genericObjectSet,
+) ([]controllers.Successor, error) {
+	selector, err := l.deploymentSelector(ctx, objectSet)
+	if apimachineryerrors.IsNotFound(err) {
+		// Remaining revisions of a deleted deployment are torn down as well.
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	list := l.newObjectSetList(l.scheme)
+	if err := l.client.List(
+		ctx, list.ClientObjectList(),
+		client.InNamespace(objectSet.ClientObject().GetNamespace()),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf("listing ObjectSets: %w", err)
+	}
+
+	var successors []controllers.Successor
+	for _, candidate := range list.GetItems() {
+		if candidate.ClientObject().GetUID() == objectSet.ClientObject().GetUID() ||
+			candidate.IsArchived() ||
+			!candidate.ClientObject().GetDeletionTimestamp().IsZero() ||
+			candidate.GetRevision() <= objectSet.GetRevision() {
+			continue
+		}
+		if !slices.ContainsFunc(candidate.GetPrevious(), func(prev corev1alpha1.PreviousRevisionReference) bool {
+			return prev.Name == objectSet.ClientObject().GetName()
+		}) {
+			continue
+		}
+		successors = append(successors, candidate)
+	}
+	return successors, nil
+}
+
+// Returns the label selector of the (Cluster)ObjectDeployment controlling the given ObjectSet,
+// or a selector matching everything for ObjectSets not managed by a deployment.
+func (l *successorLookup) deploymentSelector(
+	ctx context.Context, objectSet genericObjectSet,
+) (labels.Selector, error) {
+	controllerRef := metav1.GetControllerOf(objectSet.ClientObject())
+	if controllerRef == nil || controllerRef.APIVersion != corev1alpha1.GroupVersion.String() {
+		return labels.Everything(), nil
+	}
+
+	key := client.ObjectKey{
+		Name:      controllerRef.Name,
+		Namespace: objectSet.ClientObject().GetNamespace(),
+	}
+	var selector metav1.LabelSelector
+	switch controllerRef.Kind {
+	case "ObjectDeployment":
+		deploy := &corev1alpha1.ObjectDeployment{}
+		if err := l.client.Get(ctx, key, deploy); err != nil {
+			return nil, fmt.Errorf("getting ObjectDeployment: %w", err)
+		}
+		selector = deploy.Spec.Selector
+	case "ClusterObjectDeployment":
+		deploy := &corev1alpha1.ClusterObjectDeployment{}
+		if err := l.client.Get(ctx, key, deploy); err != nil {
+			return nil, fmt.Errorf("getting ClusterObjectDeployment: %w", err)
+		}
+		selector = deploy.Spec.Selector
+	default:
+		return labels.Everything(), nil
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(&selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+	return s, nil
+}
@@ -0,0 +1,153 @@
+package objectsets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/testutil"
+)
+
+func newSuccessorLookupObjectSet(name string, revision int64, previous ...string) corev1alpha1.ObjectSet {
+	os := corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+			UID:       types.UID("uid-" + name),
+		},
+		Status: corev1alpha1.ObjectSetStatus{
+			Revision: revision,
+		},
+	}
+	for _, prev := range previous {
+		os.Spec.Previous = append(os.Spec.Previous, corev1alpha1.PreviousRevisionReference{Name: prev})
+	}
+	return os
+}
+
+func listOptionsSelector(opts []client.ListOption) labels.Selector {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	return listOpts.LabelSelector
+}
+
+func Test_successorLookup(t *testing.T) {
+	t.Parallel()
+
+	objectSet := newSuccessorLookupObjectSet("rev-2", 2, "rev-1")
+	objectSet.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: corev1alpha1.GroupVersion.String(),
+		Kind:       "ObjectDeployment",
+		Name:       "deploy",
+		UID:        "uid-deploy",
+		Controller: ptr.To(true),
+	}}
+
+	successor := newSuccessorLookupObjectSet("rev-3", 3, "rev-2")
+	archived := newSuccessorLookupObjectSet("rev-4", 4, "rev-2")
+	archived.Spec.LifecycleState = corev1alpha1.ObjectSetLifecycleStateArchived
+	older := newSuccessorLookupObjectSet("rev-1", 1)
+	unrelated := newSuccessorLookupObjectSet("rev-5", 5, "rev-3")
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, client.ObjectKey{Name: "deploy", Namespace: "test"},
+		mock.AnythingOfType("*v1alpha1.ObjectDeployment"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			deploy := args.Get(2).(*corev1alpha1.ObjectDeployment)
+			deploy.Spec.Selector = metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			}
+		}).
+		Return(nil)
+	c.On("List", mock.Anything, mock.AnythingOfType("*v1alpha1.ObjectSetList"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1alpha1.ObjectSetList)
+			list.Items = []corev1alpha1.ObjectSet{objectSet, successor, archived, older, unrelated}
+		}).
+		Return(nil)
+
+	l := &successorLookup{
+		scheme:           testScheme,
+		newObjectSetList: newGenericObjectSetList,
+		client:           c,
+	}
+	successors, err := l.Lookup(context.Background(), &GenericObjectSet{ObjectSet: objectSet})
+	require.NoError(t, err)
+
+	if assert.Len(t, successors, 1) {
+		assert.Equal(t, "rev-3", successors[0].ClientObject().GetName())
+	}
+	c.AssertCalled(t, "List", mock.Anything, mock.Anything, mock.MatchedBy(func(opts []client.ListOption) bool {
+		return listOptionsSelector(opts).String() == "app=test"
+	}))
+	c.AssertNumberOfCalls(t, "Get", 1)
+}
+
+func Test_successorLookup_withoutDeployment(t *testing.T) {
+	t.Parallel()
+
+	objectSet := newSuccessorLookupObjectSet("rev-1", 1)
+	successor := newSuccessorLookupObjectSet("rev-2", 2, "rev-1")
+
+	c := testutil.NewClient()
+	c.On("List", mock.Anything, mock.AnythingOfType("*v1alpha1.ObjectSetList"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1alpha1.ObjectSetList)
+			list.Items = []corev1alpha1.ObjectSet{objectSet, successor}
+		}).
+		Return(nil)
+
+	l := &successorLookup{
+		scheme:           testScheme,
+		newObjectSetList: newGenericObjectSetList,
+		client:           c,
+	}
+	successors, err := l.Lookup(context.Background(), &GenericObjectSet{ObjectSet: objectSet})
+	require.NoError(t, err)
+
+	if assert.Len(t, successors, 1) {
+		assert.Equal(t, "rev-2", successors[0].ClientObject().GetName())
+	}
+	c.AssertCalled(t, "List", mock.Anything, mock.Anything, mock.MatchedBy(func(opts []client.ListOption) bool {
+		return listOptionsSelector(opts).Empty()
+	}))
+	c.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_successorLookup_deploymentGone(t *testing.T) {
+	t.Parallel()
+
+	objectSet := newSuccessorLookupObjectSet("rev-1", 1)
+	objectSet.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: corev1alpha1.GroupVersion.String(),
+		Kind:       "ObjectDeployment",
+		Name:       "deploy",
+		UID:        "uid-deploy",
+		Controller: ptr.To(true),
+	}}
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, "deploy"))
+
+	l := &successorLookup{
+		scheme:           testScheme,
+		newObjectSetList: newGenericObjectSetList,
+		client:           c,
+	}
+	successors, err := l.Lookup(context.Background(), &GenericObjectSet{ObjectSet: objectSet})
+	require.NoError(t, err)
+	assert.Empty(t, successors)
+	c.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -2,9 +2,14 @@ package objecttemplate
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/errorclass"
 )
 
 type JSONPathFormatError struct {
@@ -20,12 +25,26 @@ type SourceError struct {
 	Err    error
 }
 
+// ErrorClass implements errorclass.Of.
+func (e *SourceError) ErrorClass() errorclass.Class {
+	return errorclass.Config
+}
+
 func (e *SourceError) Error() string {
 	return fmt.Sprintf("for source %s %s: %s",
 		e.Source.GetObjectKind().GroupVersionKind().Kind,
 		client.ObjectKeyFromObject(e.Source), e.Err)
 }
 
+type SourceNamespaceNotAllowedError struct {
+	Namespace string
+}
+
+func (e *SourceNamespaceNotAllowedError) Error() string {
+	return fmt.Sprintf("namespace %s does not allow this ClusterObjectTemplate to read sources, see annotation %s",
+		e.Namespace, corev1alpha1.ClusterObjectTemplateSourcesAnnotation)
+}
+
 type SourceKeyNotFoundError struct {
 	Key string
 }
@@ -38,7 +57,32 @@ type TemplateError struct {
 	Err error
 }
 
+// ErrorClass implements errorclass.Of.
+func (e *TemplateError) ErrorClass() errorclass.Class {
+	return errorclass.Template
+}
+
 func (e *TemplateError) Error() string {
 	// sanitize template error output a bit
 	return strings.Replace(e.Err.Error(), `executing "" `, "", 1)
 }
+
+// Matches the location text/template prefixes parse and exec errors with,
+// e.g. "template: :6:25: " or "template: :3: ".
+var templateErrorLocationRegexp = regexp.MustCompile(`^template: [^:]*:(\d+)(?::(\d+))?:`)
+
+// Position returns the line and column within the template the error occurred at.
+// Zero values are returned when the position is unknown.
+func (e *TemplateError) Position() (line, column int32) {
+	m := templateErrorLocationRegexp.FindStringSubmatch(e.Err.Error())
+	if m == nil {
+		return 0, 0
+	}
+	if l, err := strconv.ParseInt(m[1], 10, 32); err == nil {
+		line = int32(l)
+	}
+	if c, err := strconv.ParseInt(m[2], 10, 32); err == nil {
+		column = int32(c)
+	}
+	return line, column
+}
@@ -10,6 +10,8 @@ import (
 
 var errTemplate = errors.New(`template: :6:25: executing "" at <.config.password>: map has no entry for key "password"`)
 
+var errTemplateParse = errors.New(`template: :3: unexpected "}" in operand`)
+
 func TestTemplateError(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +19,27 @@ func TestTemplateError(t *testing.T) {
 	assert.Equal(t, `template: :6:25: at <.config.password>: map has no entry for key "password"`, e.Error())
 }
 
+func TestTemplateError_Position(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		err          error
+		line, column int32
+	}{
+		"exec error":  {err: errTemplate, line: 6, column: 25},
+		"parse error": {err: errTemplateParse, line: 3},
+		"unknown":     {err: errTest},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			line, column := (&TemplateError{Err: tc.err}).Position()
+			assert.Equal(t, tc.line, line)
+			assert.Equal(t, tc.column, column)
+		})
+	}
+}
+
 func TestJSONPathFormatError(t *testing.T) {
 	t.Parallel()
 
@@ -31,6 +54,14 @@ func TestSourceKeyNotFoundError(t *testing.T) {
 	assert.Equal(t, "key test-key not found", e.Error())
 }
 
+func TestSourceNamespaceNotAllowedError(t *testing.T) {
+	t.Parallel()
+
+	e := &SourceNamespaceNotAllowedError{Namespace: "test"}
+	assert.Equal(t, "namespace test does not allow this ClusterObjectTemplate to read sources, "+
+		"see annotation package-operator.run/cluster-object-template-sources", e.Error())
+}
+
 func TestSourceError(t *testing.T) {
 	t.Parallel()
 
@@ -7,17 +7,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/kstatus"
 )
 
 type genericObjectTemplate interface {
 	ClientObject() client.Object
 	GetTemplate() string
 	GetSources() []corev1alpha1.ObjectTemplateSource
+	GetPatches() []corev1alpha1.ObjectTemplatePatch
 	GetConditions() *[]metav1.Condition
 	GetGeneration() int64
 	UpdatePhase()
 	SetStatusControllerOf(corev1alpha1.ControlledObjectReference)
 	GetStatusControllerOf() corev1alpha1.ControlledObjectReference
+	SetStatusSources([]corev1alpha1.ObjectTemplateSourceStatus)
+	SetStatusTemplateError(*corev1alpha1.ObjectTemplateRenderError)
 }
 
 type genericObjectTemplateFactory func(
@@ -66,6 +70,10 @@ func (t *GenericObjectTemplate) GetSources() []corev1alpha1.ObjectTemplateSource
 	return t.Spec.Sources
 }
 
+func (t *GenericObjectTemplate) GetPatches() []corev1alpha1.ObjectTemplatePatch {
+	return t.Spec.Patches
+}
+
 func (t *GenericObjectTemplate) GetConditions() *[]metav1.Condition {
 	return &t.Status.Conditions
 }
@@ -76,6 +84,7 @@ func (t *GenericObjectTemplate) GetGeneration() int64 {
 
 func (t *GenericObjectTemplate) UpdatePhase() {
 	t.Status.Phase = getObjectTemplatePhase(t)
+	objectTemplateKStatus(t).Apply(&t.Status.Conditions, t.Generation)
 }
 
 func (t *GenericObjectTemplate) SetStatusControllerOf(controllerOf corev1alpha1.ControlledObjectReference) {
@@ -86,6 +95,14 @@ func (t *GenericObjectTemplate) GetStatusControllerOf() corev1alpha1.ControlledO
 	return t.Status.ControllerOf
 }
 
+func (t *GenericObjectTemplate) SetStatusSources(sources []corev1alpha1.ObjectTemplateSourceStatus) {
+	t.Status.Sources = sources
+}
+
+func (t *GenericObjectTemplate) SetStatusTemplateError(templateError *corev1alpha1.ObjectTemplateRenderError) {
+	t.Status.TemplateError = templateError
+}
+
 type GenericClusterObjectTemplate struct {
 	corev1alpha1.ClusterObjectTemplate
 }
@@ -98,6 +115,10 @@ func (t *GenericClusterObjectTemplate) GetSources() []corev1alpha1.ObjectTemplat
 	return t.Spec.Sources
 }
 
+func (t *GenericClusterObjectTemplate) GetPatches() []corev1alpha1.ObjectTemplatePatch {
+	return t.Spec.Patches
+}
+
 func (t *GenericClusterObjectTemplate) GetConditions() *[]metav1.Condition {
 	return &t.Status.Conditions
 }
@@ -108,6 +129,7 @@ func (t *GenericClusterObjectTemplate) ClientObject() client.Object {
 
 func (t *GenericClusterObjectTemplate) UpdatePhase() {
 	t.Status.Phase = getObjectTemplatePhase(t)
+	objectTemplateKStatus(t).Apply(&t.Status.Conditions, t.Generation)
 }
 
 func (t *GenericClusterObjectTemplate) GetGeneration() int64 {
@@ -121,6 +143,19 @@ func getObjectTemplatePhase(objectTemplate genericObjectTemplate) corev1alpha1.O
 	return corev1alpha1.ObjectTemplatePhaseActive
 }
 
+// Templates stall while they cannot be rendered.
+func objectTemplateKStatus(objectTemplate genericObjectTemplate) kstatus.Status {
+	conds := *objectTemplate.GetConditions()
+	switch {
+	case meta.IsStatusConditionTrue(conds, corev1alpha1.ObjectTemplateInvalid):
+		return kstatus.Status{Stalled: kstatus.ReasonFrom(
+			meta.FindStatusCondition(conds, corev1alpha1.ObjectTemplateInvalid), "Invalid")}
+	case !objectTemplate.ClientObject().GetDeletionTimestamp().IsZero():
+		return kstatus.Status{Reconciling: &kstatus.Reason{Reason: "Terminating"}}
+	}
+	return kstatus.Status{}
+}
+
 func (t *GenericClusterObjectTemplate) SetStatusControllerOf(controllerOf corev1alpha1.ControlledObjectReference) {
 	t.Status.ControllerOf = controllerOf
 }
@@ -128,3 +163,11 @@ func (t *GenericClusterObjectTemplate) SetStatusControllerOf(controllerOf corev1
 func (t *GenericClusterObjectTemplate) GetStatusControllerOf() corev1alpha1.ControlledObjectReference {
 	return t.Status.ControllerOf
 }
+
+func (t *GenericClusterObjectTemplate) SetStatusSources(sources []corev1alpha1.ObjectTemplateSourceStatus) {
+	t.Status.Sources = sources
+}
+
+func (t *GenericClusterObjectTemplate) SetStatusTemplateError(templateError *corev1alpha1.ObjectTemplateRenderError) {
+	t.Status.TemplateError = templateError
+}
@@ -20,6 +20,7 @@ import (
 	"package-operator.run/internal/dynamiccache"
 	"package-operator.run/internal/environment"
 	"package-operator.run/internal/preflight"
+	"package-operator.run/internal/tracing"
 )
 
 type dynamicCache interface {
@@ -61,6 +62,9 @@ type ControllerConfig struct {
 	// ResourceRetryInterval is the interval at which the controller will retry to fetch
 	// resources(non optional).
 	ResourceRetryInterval time.Duration
+	// RestrictClusterSources only allows ClusterObjectTemplates to read sources from namespaces
+	// that permit it via the ClusterObjectTemplateSourcesAnnotation.
+	RestrictClusterSources bool
 }
 
 func NewObjectTemplateController(
@@ -111,11 +115,12 @@ func newGenericObjectTemplateController(
 				preflight.List{
 					preflight.NewNoOwnerReferences(restMapper),
 					preflight.NewEmptyNamespaceNoDefault(restMapper),
-					preflight.NewNamespaceEscalation(restMapper),
+					preflight.NewNamespaceEscalation(restMapper, nil),
 				},
 			),
 			cfg.OptionalResourceRetryInterval,
 			cfg.ResourceRetryInterval,
+			cfg.RestrictClusterSources,
 		),
 	}
 	controller.reconciler = []reconciler{controller.templateReconciler}
@@ -124,7 +129,7 @@ func newGenericObjectTemplateController(
 
 func (c *GenericObjectTemplateController) Reconcile(
 	ctx context.Context, req ctrl.Request,
-) (ctrl.Result, error) {
+) (res ctrl.Result, err error) {
 	log := c.log.WithValues("ObjectTemplate", req.String())
 	defer log.Info("reconciled")
 	ctx = logr.NewContext(ctx, log)
@@ -135,6 +140,9 @@ func (c *GenericObjectTemplateController) Reconcile(
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	ctx, span := tracing.StartReconcile(ctx, "ObjectTemplate", objectTemplate.ClientObject())
+	defer func() { tracing.End(span, err) }()
+
 	if !objectTemplate.ClientObject().GetDeletionTimestamp().IsZero() {
 		if err := controllers.FreeCacheAndRemoveFinalizer(
 			ctx, c.client, objectTemplate.ClientObject(), c.dynamicCache); err != nil {
@@ -147,10 +155,6 @@ func (c *GenericObjectTemplateController) Reconcile(
 		return ctrl.Result{}, err
 	}
 
-	var (
-		res ctrl.Result
-		err error
-	)
 	for _, r := range c.reconciler {
 		res, err = r.Reconcile(ctx, objectTemplate)
 		if err != nil || !res.IsZero() {
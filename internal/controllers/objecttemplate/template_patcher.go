@@ -0,0 +1,125 @@
+package objecttemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apiserver/pkg/cel/library"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// TemplatePatcher sets fields of a rendered template to the results of CEL expressions.
+type TemplatePatcher struct {
+	env  *cel.Env
+	tctx map[string]any
+}
+
+func NewTemplatePatcher(tmplCtx TemplateContext) (*TemplatePatcher, error) {
+	j, err := json.Marshal(tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+	// CEL has no arithmetic mixing doubles and ints,
+	// so whole numbers are kept as integers for expressions like `config.replicas * 2`.
+	actualCtx := map[string]any{}
+	if err := utiljson.Unmarshal(j, &actualCtx); err != nil {
+		return nil, err
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable("config", cel.DynType),
+		cel.Variable("environment", cel.DynType),
+		cel.EagerlyValidateDeclarations(true),
+		cel.DefaultUTCTimeZone(true),
+
+		ext.Strings(ext.StringsVersion(0)),
+		library.URLs(),
+		library.Regex(),
+		library.Lists(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating CEL env: %w", err)
+	}
+
+	return &TemplatePatcher{env: env, tctx: actualCtx}, nil
+}
+
+// Applies the patches in order to the rendered template and returns the patched object as JSON.
+func (p *TemplatePatcher) patch(patches []corev1alpha1.ObjectTemplatePatch, content []byte) ([]byte, error) {
+	obj := map[string]any{}
+	if err := yaml.Unmarshal(content, &obj); err != nil {
+		return nil, fmt.Errorf("unmarshalling yaml of rendered template: %w", err)
+	}
+
+	for _, patch := range patches {
+		if err := p.apply(patch, obj); err != nil {
+			return nil, &TemplateError{Err: fmt.Errorf("patch %s: %w", patch.Path, err)}
+		}
+	}
+
+	return json.Marshal(obj)
+}
+
+func (p *TemplatePatcher) apply(patch corev1alpha1.ObjectTemplatePatch, obj map[string]any) error {
+	if !strings.HasPrefix(patch.Path, ".") {
+		return &JSONPathFormatError{Path: patch.Path}
+	}
+
+	ast, issues := p.env.Compile(patch.Expression)
+	if issues != nil && issues.Err() != nil {
+		return fmt.Errorf("compiling CEL: %w", issues.Err())
+	}
+
+	prgm, err := p.env.Program(ast)
+	if err != nil {
+		return fmt.Errorf("CEL program failed: %w", err)
+	}
+
+	val, _, err := prgm.Eval(p.tctx)
+	if err != nil {
+		return fmt.Errorf("evaluating CEL: %w", err)
+	}
+
+	value, err := celValueToJSON(val)
+	if err != nil {
+		return fmt.Errorf("converting CEL result: %w", err)
+	}
+
+	fields := strings.Split(strings.TrimPrefix(patch.Path, "."), ".")
+	if err := unstructured.SetNestedField(obj, value, fields...); err != nil {
+		return fmt.Errorf("setting nested field: %w", err)
+	}
+
+	return nil
+}
+
+func celValueToJSON(val ref.Val) (any, error) {
+	native, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, err
+	}
+
+	// structpb represents all numbers as float64,
+	// decoding the JSON representation restores integers.
+	j, err := json.Marshal(native.(*structpb.Value).AsInterface())
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err := utiljson.Unmarshal(j, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,65 @@
+package objecttemplate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestTemplatePatcher(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewTemplatePatcher(TemplateContext{
+		Config: map[string]any{
+			"replicas": 2,
+			"name":     "banana",
+		},
+		Environment: map[string]any{},
+	})
+	require.NoError(t, err)
+
+	out, err := p.patch([]corev1alpha1.ObjectTemplatePatch{
+		{Path: ".spec.replicas", Expression: "config.replicas * 2"},
+		{Path: ".metadata.name", Expression: `config.name + "-deployment"`},
+		{Path: ".metadata.labels", Expression: `{"app": config.name}`},
+	}, []byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: test\n"))
+	require.NoError(t, err)
+
+	obj := map[string]any{}
+	require.NoError(t, json.Unmarshal(out, &obj))
+	assert.Equal(t, map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":   "banana-deployment",
+			"labels": map[string]any{"app": "banana"},
+		},
+		"spec": map[string]any{"replicas": float64(4)},
+	}, obj)
+}
+
+func TestTemplatePatcher_Errors(t *testing.T) {
+	t.Parallel()
+
+	for name, patch := range map[string]corev1alpha1.ObjectTemplatePatch{
+		"no leading dot":   {Path: "spec.replicas", Expression: "1"},
+		"invalid CEL":      {Path: ".spec.replicas", Expression: "1 +"},
+		"missing key":      {Path: ".spec.replicas", Expression: "config.missing"},
+		"path into scalar": {Path: ".kind.replicas", Expression: "1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := NewTemplatePatcher(TemplateContext{Config: map[string]any{}})
+			require.NoError(t, err)
+
+			_, err = p.patch([]corev1alpha1.ObjectTemplatePatch{patch}, []byte("kind: Deployment\n"))
+			var templateErr *TemplateError
+			require.ErrorAs(t, err, &templateErr)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,7 +28,9 @@ import (
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/controllers"
 	"package-operator.run/internal/environment"
+	"package-operator.run/internal/errorclass"
 	"package-operator.run/internal/preflight"
+	"package-operator.run/internal/tracing"
 )
 
 // Requeue every 30s to check if input sources exist now.
@@ -42,6 +45,7 @@ type templateReconciler struct {
 	preflightChecker              preflightChecker
 	optionalResourceRetryInterval time.Duration
 	resourceRetryInterval         time.Duration
+	restrictClusterSources        bool
 }
 
 func newTemplateReconciler(
@@ -52,6 +56,7 @@ func newTemplateReconciler(
 	preflightChecker preflightChecker,
 	optionalResourceRetryInterval time.Duration,
 	resourceRetryInterval time.Duration,
+	restrictClusterSources bool,
 ) *templateReconciler {
 	return &templateReconciler{
 		Sink: environment.NewSink(client),
@@ -63,6 +68,7 @@ func newTemplateReconciler(
 		preflightChecker:              preflightChecker,
 		optionalResourceRetryInterval: optionalResourceRetryInterval,
 		resourceRetryInterval:         resourceRetryInterval,
+		restrictClusterSources:        restrictClusterSources,
 	}
 }
 
@@ -76,7 +82,7 @@ func (r *templateReconciler) Reconcile(
 	sourcesConfig := map[string]any{}
 	retryLater, err := r.getValuesFromSources(ctx, objectTemplate, sourcesConfig)
 	if err != nil {
-		if isMissingResourceError(err) {
+		if isMissingResourceError(err) || isSourceNamespaceNotAllowedError(err) {
 			res.RequeueAfter = r.resourceRetryInterval
 		}
 		return res, fmt.Errorf("retrieving values from sources: %w", err)
@@ -151,15 +157,43 @@ func (r *templateReconciler) getValuesFromSources(
 	sourcesConfig map[string]any,
 ) (retryLater bool, err error) {
 	log := logr.FromContextOrDiscard(ctx)
+
+	sources := make([]corev1alpha1.ObjectTemplateSourceStatus, 0, len(objectTemplate.GetSources()))
+	defer func() {
+		objectTemplate.SetStatusSources(sources)
+	}()
+
 	for _, src := range objectTemplate.GetSources() {
+		srcStatus := corev1alpha1.ObjectTemplateSourceStatus{
+			APIVersion: src.APIVersion,
+			Kind:       src.Kind,
+			Namespace:  src.Namespace,
+			Name:       src.Name,
+		}
+		if len(srcStatus.Namespace) == 0 {
+			srcStatus.Namespace = objectTemplate.ClientObject().GetNamespace()
+		}
+
 		sourceObj, found, err := r.getSourceObject(ctx, objectTemplate.ClientObject(), src)
 		if err != nil {
+			if isMissingResourceError(err) {
+				sources = append(sources, srcStatus)
+			}
 			return false, err
 		}
+		if found {
+			srcStatus.Found = true
+			srcStatus.ResourceVersion = sourceObj.GetResourceVersion()
+		}
+		sources = append(sources, srcStatus)
+
 		if !found {
 			log.Info(fmt.Sprintf("optional source not found, retry in %s", defaultMissingResourceRetryInterval),
 				"source", fmt.Sprintf("%s %s/%s", src.Kind, src.Namespace, src.Name))
 			retryLater = true
+			if err := copySourceItemDefaults(src.Items, sourcesConfig); err != nil {
+				return false, &SourceError{Source: sourceObj, Err: err}
+			}
 			continue
 		}
 		if err := copySourceItems(src.Items, sourceObj, sourcesConfig); err != nil {
@@ -192,6 +226,12 @@ func (r *templateReconciler) getSourceObject(
 		sourceObj.SetNamespace(objectTemplate.GetNamespace())
 	}
 
+	if r.restrictClusterSources && len(objectTemplate.GetNamespace()) == 0 {
+		if err := r.checkSourceNamespaceAllowed(ctx, objectTemplate, sourceObj); err != nil {
+			return nil, false, err
+		}
+	}
+
 	if err := r.dynamicCache.Watch(
 		ctx, objectTemplate, sourceObj); err != nil {
 		return nil, false, fmt.Errorf("watching new source: %w", err)
@@ -223,6 +263,30 @@ func (r *templateReconciler) getSourceObject(
 	return sourceObj, true, nil
 }
 
+// Ensures that the namespace of the source allows the cluster-scoped objectTemplate to read from it.
+func (r *templateReconciler) checkSourceNamespaceAllowed(
+	ctx context.Context, objectTemplate client.Object, sourceObj *unstructured.Unstructured,
+) error {
+	if len(sourceObj.GetNamespace()) == 0 {
+		// cluster-scoped source.
+		return nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.uncachedClient.Get(ctx, client.ObjectKey{Name: sourceObj.GetNamespace()}, ns); err != nil {
+		return fmt.Errorf("getting namespace %s of source: %w", sourceObj.GetNamespace(), err)
+	}
+
+	allowed := ns.GetAnnotations()[corev1alpha1.ClusterObjectTemplateSourcesAnnotation]
+	for _, name := range strings.Split(allowed, ",") {
+		name = strings.TrimSpace(name)
+		if name == "*" || name == objectTemplate.GetName() {
+			return nil
+		}
+	}
+	return &SourceError{Source: sourceObj, Err: &SourceNamespaceNotAllowedError{Namespace: ns.Name}}
+}
+
 func (r *templateReconciler) lookupUncached(
 	ctx context.Context, src corev1alpha1.ObjectTemplateSource, key client.ObjectKey, obj client.Object,
 ) (found bool, err error) {
@@ -251,6 +315,26 @@ func copySourceItems(
 	return nil
 }
 
+// Stores the defaults of all items that declare one, used when an optional source is not found.
+func copySourceItemDefaults(
+	src []corev1alpha1.ObjectTemplateSourceItem, sourcesConfig map[string]any,
+) error {
+	for _, item := range src {
+		if item.Default == nil {
+			continue
+		}
+
+		value, err := sourceItemDefault(item)
+		if err != nil {
+			return err
+		}
+		if err := setSourceItemValue(item, value, sourcesConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func copySourceItem(
 	item corev1alpha1.ObjectTemplateSourceItem,
 	sourceObj *unstructured.Unstructured,
@@ -263,6 +347,8 @@ func copySourceItem(
 
 	jp := jsonpath.New("key")
 	jp.EnableJSONOutput(true)
+	// Missing keys are only tolerated when they can be defaulted.
+	jp.AllowMissingKeys(item.Default != nil)
 	if err := jp.Parse(jpString); err != nil {
 		return err
 	}
@@ -277,8 +363,26 @@ func copySourceItem(
 	}
 	if vslice, ok := value.([]any); ok && len(vslice) == 1 {
 		value = vslice[0]
+	} else if ok && len(vslice) == 0 && item.Default != nil {
+		if value, err = sourceItemDefault(item); err != nil {
+			return err
+		}
 	}
 
+	return setSourceItemValue(item, value, sourcesConfig)
+}
+
+func sourceItemDefault(item corev1alpha1.ObjectTemplateSourceItem) (any, error) {
+	var value any
+	if err := json.Unmarshal(item.Default.Raw, &value); err != nil {
+		return nil, fmt.Errorf("decoding default of %s: %w", item.Key, err)
+	}
+	return value, nil
+}
+
+func setSourceItemValue(
+	item corev1alpha1.ObjectTemplateSourceItem, value any, sourcesConfig map[string]any,
+) error {
 	if string(item.Destination[0]) != "." {
 		return &JSONPathFormatError{Path: item.Destination}
 	}
@@ -306,10 +410,22 @@ func (r *templateReconciler) templateObject(
 	if err != nil {
 		return fmt.Errorf("creating transformer: %w", err)
 	}
-	renderedTemplate, err := transformer.transform(ctx, []byte(objectTemplate.GetTemplate()))
+	renderCtx, renderSpan := tracing.Start(ctx, "RenderTemplate")
+	renderedTemplate, err := transformer.transform(renderCtx, []byte(objectTemplate.GetTemplate()))
+	tracing.End(renderSpan, err)
 	if err != nil {
 		return fmt.Errorf("rendering template: %w", err)
 	}
+	if patches := objectTemplate.GetPatches(); len(patches) > 0 {
+		patcher, err := NewTemplatePatcher(templateContext)
+		if err != nil {
+			return fmt.Errorf("creating patcher: %w", err)
+		}
+		if renderedTemplate, err = patcher.patch(patches, renderedTemplate); err != nil {
+			return fmt.Errorf("patching template: %w", err)
+		}
+	}
+	objectTemplate.SetStatusTemplateError(nil)
 
 	if err := yaml.Unmarshal(renderedTemplate, object); err != nil {
 		return fmt.Errorf("unmarshalling yaml of rendered template: %w", err)
@@ -414,11 +530,17 @@ func setObjectTemplateConditionBasedOnError(objectTemplate genericObjectTemplate
 	}
 	var templateError *TemplateError
 	if errors.As(err, &templateError) {
+		line, column := templateError.Position()
+		objectTemplate.SetStatusTemplateError(&corev1alpha1.ObjectTemplateRenderError{
+			Message: templateError.Error(),
+			Line:    line,
+			Column:  column,
+		})
 		meta.SetStatusCondition(objectTemplate.GetConditions(), metav1.Condition{
 			Type:               corev1alpha1.ObjectTemplateInvalid,
 			Status:             metav1.ConditionTrue,
 			ObservedGeneration: objectTemplate.GetGeneration(),
-			Reason:             "TemplateError",
+			Reason:             errorclass.Template.ConditionReason(),
 			Message:            templateError.Error(),
 		})
 		return nil // don't retry error
@@ -464,6 +586,15 @@ func RelaxedJSONPathExpression(pathExpression string) (string, error) {
 	return fmt.Sprintf("{.%s}", fieldSpec), nil
 }
 
+func isSourceNamespaceNotAllowedError(err error) bool {
+	var sourceError *SourceError
+	if errors.As(err, &sourceError) {
+		var nsErr *SourceNamespaceNotAllowedError
+		return errors.As(sourceError.Err, &nsErr)
+	}
+	return false
+}
+
 func isMissingResourceError(err error) bool {
 	var sourceError *SourceError
 	if errors.As(err, &sourceError) {
@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -98,6 +100,69 @@ func Test_templateReconciler_getSourceObject_stopAtViolation(t *testing.T) {
 	require.EqualError(t, err, "for source ConfigMap default/test: here: aaaaaaah!")
 }
 
+func Test_templateReconciler_getSourceObject_restrictClusterSources(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		annotation string
+		allowed    bool
+	}{
+		"no annotation": {},
+		"wildcard":      {annotation: "*", allowed: true},
+		"listed":        {annotation: "other, test", allowed: true},
+		"not listed":    {annotation: "other"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uncachedClient := testutil.NewClient()
+			uncachedClient.
+				On("Get", mock.Anything, client.ObjectKey{Name: "default"}, mock.AnythingOfType("*v1.Namespace"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					ns := args.Get(2).(*corev1.Namespace)
+					ns.Name = "default"
+					ns.Annotations = map[string]string{
+						corev1alpha1.ClusterObjectTemplateSourcesAnnotation: tc.annotation,
+					}
+				}).
+				Return(nil)
+
+			dynamicCache := &dynamiccachemocks.DynamicCacheMock{}
+			dynamicCache.
+				On("Watch", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			dynamicCache.
+				On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+
+			r := &templateReconciler{
+				uncachedClient:         uncachedClient,
+				dynamicCache:           dynamicCache,
+				preflightChecker:       preflight.List{},
+				restrictClusterSources: true,
+			}
+
+			objectTemplate := &corev1alpha1.ClusterObjectTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			}
+			_, found, err := r.getSourceObject(
+				context.Background(), objectTemplate, corev1alpha1.ObjectTemplateSource{
+					Kind:      "Secret",
+					Name:      "test",
+					Namespace: "default",
+				})
+			if tc.allowed {
+				require.NoError(t, err)
+				assert.True(t, found)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, isSourceNamespaceNotAllowedError(err))
+		})
+	}
+}
+
 func Test_copySourceItems(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -200,6 +265,43 @@ func Test_copySourceItems_notfound(t *testing.T) {
 	require.EqualError(t, err, "data is not found")
 }
 
+func Test_copySourceItems_default(t *testing.T) {
+	t.Parallel()
+	sourceObj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"data": map[string]any{
+				"something": "123",
+			},
+		},
+	}
+	sourcesConfig := map[string]any{}
+	items := []corev1alpha1.ObjectTemplateSourceItem{
+		{Key: ".data.something", Destination: ".present", Default: &apiextensionsv1.JSON{Raw: []byte(`"456"`)}},
+		{Key: ".data.missing", Destination: ".missing", Default: &apiextensionsv1.JSON{Raw: []byte(`{"a":1}`)}},
+		{Key: ".status.missing", Destination: ".nested.missing", Default: &apiextensionsv1.JSON{Raw: []byte(`3`)}},
+	}
+	err := copySourceItems(
+		items, sourceObj, sourcesConfig)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"present": "123",
+		"missing": map[string]any{"a": float64(1)},
+		"nested":  map[string]any{"missing": float64(3)},
+	}, sourcesConfig)
+}
+
+func Test_copySourceItemDefaults(t *testing.T) {
+	t.Parallel()
+	sourcesConfig := map[string]any{}
+	items := []corev1alpha1.ObjectTemplateSourceItem{
+		{Key: ".data.something", Destination: ".something", Default: &apiextensionsv1.JSON{Raw: []byte(`"abc"`)}},
+		{Key: ".data.other", Destination: ".other"},
+	}
+	err := copySourceItemDefaults(items, sourcesConfig)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"something": "abc"}, sourcesConfig)
+}
+
 func Test_copySourceItems_nonJSONPath_destination(t *testing.T) {
 	t.Parallel()
 	sourceObj := &unstructured.Unstructured{
@@ -466,11 +568,12 @@ func Test_setObjectTemplateConditionBasedOnError(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name               string
-		objectTemplate     *GenericObjectTemplate
-		err                error
-		expectedConditions []metav1.Condition
-		expectedErr        error
+		name                  string
+		objectTemplate        *GenericObjectTemplate
+		err                   error
+		expectedConditions    []metav1.Condition
+		expectedTemplateError *corev1alpha1.ObjectTemplateRenderError
+		expectedErr           error
 	}{
 		{
 			name: "sets invalid condition for SourceError",
@@ -504,7 +607,8 @@ func Test_setObjectTemplateConditionBasedOnError(t *testing.T) {
 					Reason:  "TemplateError",
 				},
 			},
-			expectedErr: nil,
+			expectedTemplateError: &corev1alpha1.ObjectTemplateRenderError{Message: "something"},
+			expectedErr:           nil,
 		},
 		{
 			name: "removes invalid condition",
@@ -548,6 +652,8 @@ func Test_setObjectTemplateConditionBasedOnError(t *testing.T) {
 				require.ErrorIs(t, outErr, test.expectedErr)
 			}
 
+			assert.Equal(t, test.expectedTemplateError, test.objectTemplate.Status.TemplateError)
+
 			conds := *test.objectTemplate.GetConditions()
 			if assert.Len(t, conds, len(test.expectedConditions)) {
 				for i, expectedCond := range test.expectedConditions {
@@ -614,6 +720,9 @@ func TestRequeueDurationOnMissingSource(t *testing.T) {
 		require.False(t, res.IsZero())
 		assert.Equal(t, optionalResourceRetryInterval, res.RequeueAfter)
 		require.NoError(t, err)
+		assert.Equal(t, []corev1alpha1.ObjectTemplateSourceStatus{
+			{Kind: "ConfigMap", Name: "test", Namespace: "default"},
+		}, objectTemplate.Status.Sources)
 	})
 
 	t.Run("missing source returns configured resourceRetryInterval", func(t *testing.T) {
@@ -662,6 +771,9 @@ func TestRequeueDurationOnMissingSource(t *testing.T) {
 		require.False(t, res.IsZero())
 		assert.Equal(t, resourceRetryInterval, res.RequeueAfter)
 		require.NoError(t, err)
+		assert.Equal(t, []corev1alpha1.ObjectTemplateSourceStatus{
+			{Kind: "ConfigMap", Name: "test", Namespace: "default"},
+		}, objectTemplate.Status.Sources)
 	})
 
 	t.Run("reconciler returns error on non missing source errors", func(t *testing.T) {
@@ -18,6 +18,15 @@ type TemplateTransformer struct {
 }
 
 func NewTemplateTransformer(tmplCtx TemplateContext) (*TemplateTransformer, error) {
+	actualCtx, err := templateContextMap(tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateTransformer{actualCtx}, nil
+}
+
+func templateContextMap(tmplCtx TemplateContext) (map[string]any, error) {
 	p, err := json.Marshal(tmplCtx)
 	if err != nil {
 		return nil, err
@@ -28,7 +37,7 @@ func NewTemplateTransformer(tmplCtx TemplateContext) (*TemplateTransformer, erro
 		return nil, err
 	}
 
-	return &TemplateTransformer{actualCtx}, nil
+	return actualCtx, nil
 }
 
 func (t *TemplateTransformer) transform(_ context.Context, content []byte) ([]byte, error) {
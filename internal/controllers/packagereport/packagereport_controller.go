@@ -0,0 +1,183 @@
+package packagereport
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// PackageReportController maintains the PackageReport,
+// summarizing the status of all Packages and ClusterPackages.
+type PackageReportController struct {
+	client client.Client
+	log    logr.Logger
+}
+
+func NewPackageReportController(c client.Client, log logr.Logger) *PackageReportController {
+	return &PackageReportController{
+		client: c,
+		log:    log,
+	}
+}
+
+func (c *PackageReportController) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	log := c.log.WithValues("PackageReport", req.String())
+	defer log.Info("reconciled")
+
+	packages := &corev1alpha1.PackageList{}
+	if err := c.client.List(ctx, packages); err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing Packages: %w", err)
+	}
+	clusterPackages := &corev1alpha1.ClusterPackageList{}
+	if err := c.client.List(ctx, clusterPackages); err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing ClusterPackages: %w", err)
+	}
+
+	report := &corev1alpha1.PackageReport{}
+	err := c.client.Get(ctx, req.NamespacedName, report)
+	if errors.IsNotFound(err) {
+		report.Name = req.Name
+		if err := c.client.Create(ctx, report); err != nil {
+			return ctrl.Result{}, fmt.Errorf("creating PackageReport: %w", err)
+		}
+	} else if err != nil {
+		return ctrl.Result{}, fmt.Errorf("getting PackageReport: %w", err)
+	}
+
+	status := newPackageReportStatus(packages.Items, clusterPackages.Items)
+	// Only compare the content, so the report is not updated just to bump the timestamp.
+	status.LastUpdateTime = report.Status.LastUpdateTime
+	if reflect.DeepEqual(status, report.Status) {
+		return ctrl.Result{}, nil
+	}
+
+	now := metav1.Now()
+	status.LastUpdateTime = &now
+	report.Status = status
+	if err := c.client.Status().Update(ctx, report); err != nil {
+		return ctrl.Result{}, fmt.Errorf("updating PackageReport status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+func newPackageReportStatus(
+	packages []corev1alpha1.Package, clusterPackages []corev1alpha1.ClusterPackage,
+) corev1alpha1.PackageReportStatus {
+	entries := make([]corev1alpha1.PackageReportEntry, 0, len(packages)+len(clusterPackages))
+	for _, pkg := range clusterPackages {
+		entries = append(entries, newPackageReportEntry("ClusterPackage", &pkg.ObjectMeta, pkg.Spec, pkg.Status))
+	}
+	for _, pkg := range packages {
+		entries = append(entries, newPackageReportEntry("Package", &pkg.ObjectMeta, pkg.Spec, pkg.Status))
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	status := corev1alpha1.PackageReportStatus{}
+	if len(entries) > 0 {
+		status.Packages = entries
+	}
+	for _, e := range entries {
+		status.Summary.Total++
+		if e.Available {
+			status.Summary.Available++
+		} else {
+			status.Summary.Unavailable++
+		}
+		if e.Progressing {
+			status.Summary.Progressing++
+		}
+		if e.Phase == corev1alpha1.PackagePhaseInvalid {
+			status.Summary.Invalid++
+		}
+	}
+	return status
+}
+
+func newPackageReportEntry(
+	kind string, objMeta *metav1.ObjectMeta,
+	spec corev1alpha1.PackageSpec, status corev1alpha1.PackageStatus,
+) corev1alpha1.PackageReportEntry {
+	e := corev1alpha1.PackageReportEntry{
+		Kind:        kind,
+		Namespace:   objMeta.Namespace,
+		Name:        objMeta.Name,
+		Image:       spec.Image,
+		Revision:    status.Revision,
+		Phase:       status.Phase,
+		Available:   meta.IsStatusConditionTrue(status.Conditions, corev1alpha1.PackageAvailable),
+		Progressing: meta.IsStatusConditionTrue(status.Conditions, corev1alpha1.PackageProgressing),
+	}
+	if e.Available {
+		return e
+	}
+
+	if cond := failingCondition(status.Conditions); cond != nil {
+		e.Reason = cond.Reason
+		e.Message = cond.Message
+	}
+	return e
+}
+
+// Returns the condition explaining best why a package is not available.
+// Invalid packages and unpack failures prevent any rollout,
+// so they take precedence over the Available condition.
+func failingCondition(conds []metav1.Condition) *metav1.Condition {
+	if cond := meta.FindStatusCondition(conds, corev1alpha1.PackageInvalid); cond != nil &&
+		cond.Status == metav1.ConditionTrue {
+		return cond
+	}
+	if cond := meta.FindStatusCondition(conds, corev1alpha1.PackageUnpacked); cond != nil &&
+		cond.Status == metav1.ConditionFalse {
+		return cond
+	}
+	if cond := meta.FindStatusCondition(conds, corev1alpha1.PackageAvailable); cond != nil &&
+		cond.Status != metav1.ConditionTrue {
+		return cond
+	}
+	return nil
+}
+
+func (c *PackageReportController) SetupWithManager(mgr ctrl.Manager) error {
+	enqueueReport := handler.EnqueueRequestsFromMapFunc(
+		func(context.Context, client.Object) []reconcile.Request {
+			return []reconcile.Request{{
+				NamespacedName: types.NamespacedName{Name: corev1alpha1.PackageReportName},
+			}}
+		})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1alpha1.PackageReport{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetName() == corev1alpha1.PackageReportName
+			}),
+		)).
+		Watches(&corev1alpha1.Package{}, enqueueReport).
+		Watches(&corev1alpha1.ClusterPackage{}, enqueueReport).
+		Complete(c)
+}
@@ -0,0 +1,135 @@
+package packagereport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+var testScheme = runtime.NewScheme()
+
+func init() {
+	if err := corev1alpha1.AddToScheme(testScheme); err != nil {
+		panic(err)
+	}
+}
+
+func TestNewPackageReportStatus(t *testing.T) {
+	t.Parallel()
+
+	packages := []corev1alpha1.Package{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns"},
+			Spec:       corev1alpha1.PackageSpec{Image: "quay.io/b:v2"},
+			Status: corev1alpha1.PackageStatus{
+				Phase: corev1alpha1.PackagePhaseInvalid,
+				Conditions: []metav1.Condition{
+					{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse, Reason: "Unknown"},
+					{
+						Type: corev1alpha1.PackageInvalid, Status: metav1.ConditionTrue,
+						Reason: "LoadError", Message: "missing manifest",
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"},
+			Spec:       corev1alpha1.PackageSpec{Image: "quay.io/a:v1"},
+			Status: corev1alpha1.PackageStatus{
+				Phase:    corev1alpha1.PackagePhaseAvailable,
+				Revision: 3,
+				Conditions: []metav1.Condition{
+					{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionTrue},
+					{Type: corev1alpha1.PackageProgressing, Status: metav1.ConditionTrue},
+				},
+			},
+		},
+	}
+	clusterPackages := []corev1alpha1.ClusterPackage{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "c"},
+			Spec:       corev1alpha1.PackageSpec{Image: "quay.io/c:v1"},
+			Status: corev1alpha1.PackageStatus{
+				Phase: corev1alpha1.PackagePhaseNotReady,
+				Conditions: []metav1.Condition{
+					{
+						Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse,
+						Reason: "ProbeFailure", Message: "deployment not ready",
+					},
+				},
+			},
+		},
+	}
+
+	status := newPackageReportStatus(packages, clusterPackages)
+	assert.Equal(t, corev1alpha1.PackageReportSummary{
+		Total:       3,
+		Available:   1,
+		Unavailable: 2,
+		Progressing: 1,
+		Invalid:     1,
+	}, status.Summary)
+	assert.Equal(t, []corev1alpha1.PackageReportEntry{
+		{
+			Kind: "ClusterPackage", Name: "c", Image: "quay.io/c:v1",
+			Phase:  corev1alpha1.PackagePhaseNotReady,
+			Reason: "ProbeFailure", Message: "deployment not ready",
+		},
+		{
+			Kind: "Package", Namespace: "ns", Name: "a", Image: "quay.io/a:v1",
+			Revision: 3, Phase: corev1alpha1.PackagePhaseAvailable,
+			Available: true, Progressing: true,
+		},
+		{
+			Kind: "Package", Namespace: "ns", Name: "b", Image: "quay.io/b:v2",
+			Phase:  corev1alpha1.PackagePhaseInvalid,
+			Reason: "LoadError", Message: "missing manifest",
+		},
+	}, status.Packages)
+}
+
+func TestPackageReportController_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	pkg := &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"},
+		Spec:       corev1alpha1.PackageSpec{Image: "quay.io/a:v1"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(pkg).
+		WithStatusSubresource(&corev1alpha1.PackageReport{}).
+		Build()
+
+	controller := NewPackageReportController(c, ctrl.Log.WithName("packagereport controller test"))
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: corev1alpha1.PackageReportName}}
+
+	ctx := context.Background()
+	res, err := controller.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+
+	report := &corev1alpha1.PackageReport{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, report))
+	assert.Equal(t, int32(1), report.Status.Summary.Total)
+	assert.Equal(t, int32(1), report.Status.Summary.Unavailable)
+	require.Len(t, report.Status.Packages, 1)
+	assert.Equal(t, "a", report.Status.Packages[0].Name)
+	require.NotNil(t, report.Status.LastUpdateTime)
+
+	// Nothing changed, the report must not be updated.
+	resourceVersion := report.ResourceVersion
+	_, err = controller.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, req.NamespacedName, report))
+	assert.Equal(t, resourceVersion, report.ResourceVersion)
+}
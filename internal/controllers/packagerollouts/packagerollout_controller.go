@@ -0,0 +1,480 @@
+package packagerollouts
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/utils"
+)
+
+// Maximum number of failed targets listed in the status of a rollout.
+const failuresLimit = 100
+
+// ClusterPackageRolloutController rolls out packages across namespaces and ClusterTargets in waves.
+type ClusterPackageRolloutController struct {
+	client client.Client
+	log    logr.Logger
+	scheme *runtime.Scheme
+}
+
+func NewClusterPackageRolloutController(
+	c client.Client, log logr.Logger, scheme *runtime.Scheme,
+) *ClusterPackageRolloutController {
+	return &ClusterPackageRolloutController{
+		client: c,
+		log:    log,
+		scheme: scheme,
+	}
+}
+
+func (c *ClusterPackageRolloutController) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	log := c.log.WithValues("ClusterPackageRollout", req.String())
+	defer log.Info("reconciled")
+	ctx = logr.NewContext(ctx, log)
+
+	rollout := &corev1alpha1.ClusterPackageRollout{}
+	if err := c.client.Get(ctx, req.NamespacedName, rollout); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !rollout.DeletionTimestamp.IsZero() {
+		// Packages are garbage collected together with the rollout.
+		return ctrl.Result{}, nil
+	}
+
+	if err := c.reconcileRollout(ctx, rollout); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := c.client.Status().Update(ctx, rollout); err != nil {
+		return ctrl.Result{}, fmt.Errorf("updating ClusterPackageRollout status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+type targetState int
+
+const (
+	// Package is missing or was not updated to the current template yet.
+	targetStateOutdated targetState = iota
+	// Package is updated, but not available yet.
+	targetStateProgressing
+	targetStateAvailable
+	targetStateFailed
+)
+
+// Reason reported for targets whose package is not managed by the rollout.
+const conflictReason = "Conflict"
+
+// rolloutTarget is the package installed into a single target of a rollout.
+type rolloutTarget struct {
+	ref     corev1alpha1.PackageRolloutTarget
+	obj     client.Object
+	spec    *corev1alpha1.PackageSpec
+	status  *corev1alpha1.PackageStatus
+	exists  bool
+	state   targetState
+	failure *metav1.Condition
+}
+
+func (c *ClusterPackageRolloutController) reconcileRollout(
+	ctx context.Context, rollout *corev1alpha1.ClusterPackageRollout,
+) error {
+	hash := utils.ComputeFNV32Hash(rollout.Spec.Template, nil)
+
+	// Observe all targets first, so failures of all waves count towards the failure threshold.
+	waves := make([][]*rolloutTarget, len(rollout.Spec.Waves))
+	for i, wave := range rollout.Spec.Waves {
+		for _, ref := range wave.Targets {
+			target, err := c.getTarget(ctx, rollout, ref, hash)
+			if err != nil {
+				return err
+			}
+			waves[i] = append(waves[i], target)
+		}
+	}
+
+	halted := countTargets(waves, targetStateFailed) > rollout.Spec.FailureThreshold
+	maxUnavailable := int32(1)
+	if rollout.Spec.MaxUnavailable != nil {
+		maxUnavailable = *rollout.Spec.MaxUnavailable
+	}
+
+	if !rollout.Spec.Paused {
+		if err := c.deleteRemovedTargets(ctx, rollout, waves); err != nil {
+			return err
+		}
+	}
+
+	var currentWave string
+	for i, wave := range waves {
+		done := countState(wave, targetStateAvailable)
+		if !halted {
+			// Failures within the failure threshold are tolerated and don't block the next wave.
+			done += countState(wave, targetStateFailed)
+		}
+		if done == int32(len(wave)) {
+			continue
+		}
+		currentWave = rollout.Spec.Waves[i].Name
+		if !halted && !rollout.Spec.Paused {
+			if err := c.updateWave(ctx, rollout, wave, hash, maxUnavailable); err != nil {
+				return err
+			}
+		}
+		// The next wave only starts once all targets of this wave are available or tolerated failures.
+		break
+	}
+
+	setStatus(rollout, waves, hash, currentWave, halted)
+	return nil
+}
+
+// Updates outdated targets of the wave, while not more than maxUnavailable targets are progressing.
+// Failed targets are tolerated by the failure threshold and don't take up the budget.
+func (c *ClusterPackageRolloutController) updateWave(
+	ctx context.Context, rollout *corev1alpha1.ClusterPackageRollout,
+	wave []*rolloutTarget, hash string, maxUnavailable int32,
+) error {
+	unavailable := countState(wave, targetStateProgressing)
+	for _, target := range wave {
+		if unavailable >= maxUnavailable {
+			return nil
+		}
+		if target.state != targetStateOutdated {
+			continue
+		}
+		if err := c.updateTarget(ctx, rollout, target, hash); err != nil {
+			return err
+		}
+		target.state = targetStateProgressing
+		unavailable++
+	}
+	return nil
+}
+
+// Returns the package of the target and its state.
+func (c *ClusterPackageRolloutController) getTarget(
+	ctx context.Context, rollout *corev1alpha1.ClusterPackageRollout,
+	ref corev1alpha1.PackageRolloutTarget, hash string,
+) (*rolloutTarget, error) {
+	target := &rolloutTarget{ref: ref}
+	if len(ref.Namespace) > 0 {
+		pkg := &corev1alpha1.Package{}
+		pkg.Name = rollout.Name
+		pkg.Namespace = ref.Namespace
+		target.obj, target.spec, target.status = pkg, &pkg.Spec, &pkg.Status
+	} else {
+		pkg := &corev1alpha1.ClusterPackage{}
+		pkg.Name = rollout.Name + "-" + ref.ClusterTarget
+		target.obj, target.spec, target.status = pkg, &pkg.Spec, &pkg.Status
+	}
+
+	err := c.client.Get(ctx, client.ObjectKeyFromObject(target.obj), target.obj)
+	if errors.IsNotFound(err) {
+		return target, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting package of target: %w", err)
+	}
+	target.exists = true
+	if conflict := packageConflict(rollout, target.obj); conflict != nil {
+		target.state, target.failure = targetStateFailed, conflict
+		return target, nil
+	}
+	target.state, target.failure = packageState(target.obj, target.status, hash)
+	return target, nil
+}
+
+// Returns a condition describing why the package can't be managed by the rollout, if any.
+// Only packages labeled for the rollout are adopted, so packages managed by users are never taken over.
+func packageConflict(rollout *corev1alpha1.ClusterPackageRollout, obj client.Object) *metav1.Condition {
+	controllerRef := metav1.GetControllerOf(obj)
+	if controllerRef != nil && controllerRef.UID == rollout.UID {
+		return nil
+	}
+	if obj.GetLabels()[corev1alpha1.PackageRolloutLabel] == rollout.Name && controllerRef == nil {
+		return nil
+	}
+	return &metav1.Condition{
+		Reason: conflictReason,
+		Message: fmt.Sprintf(
+			"Package %s exists and is not managed by this rollout, label it with %s=%s to hand it over.",
+			client.ObjectKeyFromObject(obj), corev1alpha1.PackageRolloutLabel, rollout.Name),
+	}
+}
+
+// Deletes packages of the rollout, whose target was removed from all waves.
+func (c *ClusterPackageRolloutController) deleteRemovedTargets(
+	ctx context.Context, rollout *corev1alpha1.ClusterPackageRollout, waves [][]*rolloutTarget,
+) error {
+	desired := map[client.ObjectKey]struct{}{}
+	for _, wave := range waves {
+		for _, target := range wave {
+			desired[client.ObjectKeyFromObject(target.obj)] = struct{}{}
+		}
+	}
+
+	selector := client.MatchingLabels{corev1alpha1.PackageRolloutLabel: rollout.Name}
+	packages := &corev1alpha1.PackageList{}
+	if err := c.client.List(ctx, packages, selector); err != nil {
+		return fmt.Errorf("listing packages of rollout: %w", err)
+	}
+	clusterPackages := &corev1alpha1.ClusterPackageList{}
+	if err := c.client.List(ctx, clusterPackages, selector); err != nil {
+		return fmt.Errorf("listing cluster packages of rollout: %w", err)
+	}
+
+	var removed []client.Object
+	for i := range packages.Items {
+		removed = append(removed, &packages.Items[i])
+	}
+	for i := range clusterPackages.Items {
+		removed = append(removed, &clusterPackages.Items[i])
+	}
+	for _, obj := range removed {
+		if _, ok := desired[client.ObjectKeyFromObject(obj)]; ok {
+			continue
+		}
+		if controllerRef := metav1.GetControllerOf(obj); controllerRef == nil || controllerRef.UID != rollout.UID {
+			continue
+		}
+		logr.FromContextOrDiscard(ctx).Info("deleting package of removed target",
+			"namespace", obj.GetNamespace(), "name", obj.GetName())
+		if err := c.client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("deleting package of removed target: %w", err)
+		}
+	}
+	return nil
+}
+
+// Determines the state of a package by its conditions.
+// Packages are available, once their latest revision finished progressing and is available.
+func packageState(
+	obj client.Object, status *corev1alpha1.PackageStatus, hash string,
+) (targetState, *metav1.Condition) {
+	if obj.GetAnnotations()[corev1alpha1.PackageRolloutHashAnnotation] != hash {
+		return targetStateOutdated, nil
+	}
+
+	if invalid := meta.FindStatusCondition(status.Conditions, corev1alpha1.PackageInvalid); invalid != nil &&
+		invalid.Status == metav1.ConditionTrue {
+		return targetStateFailed, invalid
+	}
+
+	progressing := meta.FindStatusCondition(status.Conditions, corev1alpha1.PackageProgressing)
+	if progressing == nil ||
+		progressing.ObservedGeneration != obj.GetGeneration() ||
+		progressing.Status != metav1.ConditionFalse {
+		return targetStateProgressing, nil
+	}
+	available := meta.FindStatusCondition(status.Conditions, corev1alpha1.PackageAvailable)
+	if available != nil && available.Status == metav1.ConditionTrue {
+		return targetStateAvailable, nil
+	}
+	if available == nil {
+		available = progressing
+	}
+	return targetStateFailed, available
+}
+
+// Creates or updates the package of a target from the template of the rollout.
+func (c *ClusterPackageRolloutController) updateTarget(
+	ctx context.Context, rollout *corev1alpha1.ClusterPackageRollout,
+	target *rolloutTarget, hash string,
+) error {
+	logr.FromContextOrDiscard(ctx).Info("updating target",
+		"namespace", target.ref.Namespace, "clusterTarget", target.ref.ClusterTarget)
+
+	obj := target.obj
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, rollout.Spec.Template.Metadata.Labels)
+	labels[corev1alpha1.PackageRolloutLabel] = rollout.Name
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	maps.Copy(annotations, rollout.Spec.Template.Metadata.Annotations)
+	annotations[corev1alpha1.PackageRolloutHashAnnotation] = hash
+	if len(target.ref.ClusterTarget) > 0 {
+		annotations[corev1alpha1.ClusterTargetAnnotation] = target.ref.ClusterTarget
+	}
+	obj.SetAnnotations(annotations)
+
+	*target.spec = *rollout.Spec.Template.Spec.DeepCopy()
+	if err := controllerutil.SetControllerReference(rollout, obj, c.scheme); err != nil {
+		return fmt.Errorf("setting controller reference on package of target: %w", err)
+	}
+
+	if target.exists {
+		if err := c.client.Update(ctx, obj); err != nil {
+			return fmt.Errorf("updating package of target: %w", err)
+		}
+		return nil
+	}
+	if err := c.client.Create(ctx, obj); err != nil {
+		return fmt.Errorf("creating package of target: %w", err)
+	}
+	return nil
+}
+
+func setStatus(
+	rollout *corev1alpha1.ClusterPackageRollout, waves [][]*rolloutTarget,
+	hash, currentWave string, halted bool,
+) {
+	status := &rollout.Status
+	status.TemplateHash = hash
+	status.CurrentWave = currentWave
+	status.AvailableTargets = countTargets(waves, targetStateAvailable)
+	status.FailedTargets = countTargets(waves, targetStateFailed)
+	status.UpdatedTargets = status.AvailableTargets + status.FailedTargets +
+		countTargets(waves, targetStateProgressing)
+
+	status.Failures = nil
+	for _, wave := range waves {
+		for _, target := range wave {
+			if target.state != targetStateFailed || len(status.Failures) >= failuresLimit {
+				continue
+			}
+			status.Failures = append(status.Failures, corev1alpha1.PackageRolloutTargetFailure{
+				PackageRolloutTarget: target.ref,
+				Reason:               target.failure.Reason,
+				Message:              target.failure.Message,
+			})
+		}
+	}
+
+	generation := rollout.Generation
+	if halted {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:   corev1alpha1.ClusterPackageRolloutHalted,
+			Status: metav1.ConditionTrue,
+			Reason: "FailureThresholdExceeded",
+			Message: fmt.Sprintf("%d targets failed, tolerating %d.",
+				status.FailedTargets, rollout.Spec.FailureThreshold),
+			ObservedGeneration: generation,
+		})
+	} else {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               corev1alpha1.ClusterPackageRolloutHalted,
+			Status:             metav1.ConditionFalse,
+			Reason:             "WithinFailureThreshold",
+			ObservedGeneration: generation,
+		})
+	}
+
+	progressing := metav1.Condition{
+		Type:               corev1alpha1.ClusterPackageRolloutProgressing,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+	}
+	switch {
+	case len(currentWave) == 0 && status.FailedTargets > 0:
+		progressing.Reason = "CompleteWithFailures"
+		progressing.Message = fmt.Sprintf("All targets are updated, %d failed within the failure threshold.",
+			status.FailedTargets)
+	case len(currentWave) == 0:
+		progressing.Reason = "Complete"
+		progressing.Message = "All targets are updated and available."
+	case halted:
+		progressing.Reason = "Halted"
+		progressing.Message = fmt.Sprintf("Rollout halted in wave %s.", currentWave)
+	case rollout.Spec.Paused:
+		progressing.Reason = "Paused"
+		progressing.Message = fmt.Sprintf("Rollout paused in wave %s.", currentWave)
+	default:
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = "Progressing"
+		progressing.Message = fmt.Sprintf("Rolling out wave %s.", currentWave)
+	}
+	meta.SetStatusCondition(&status.Conditions, progressing)
+
+	switch {
+	case len(currentWave) == 0 && status.FailedTargets > 0:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:   corev1alpha1.ClusterPackageRolloutAvailable,
+			Status: metav1.ConditionTrue,
+			Reason: "AvailableWithinFailureThreshold",
+			Message: fmt.Sprintf("%d of %d targets are available, tolerating %d failures.",
+				status.AvailableTargets, countAll(waves), rollout.Spec.FailureThreshold),
+			ObservedGeneration: generation,
+		})
+	case len(currentWave) == 0:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               corev1alpha1.ClusterPackageRolloutAvailable,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Available",
+			Message:            "All targets are available.",
+			ObservedGeneration: generation,
+		})
+	default:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               corev1alpha1.ClusterPackageRolloutAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             "TargetsUnavailable",
+			Message:            fmt.Sprintf("%d of %d targets are available.", status.AvailableTargets, countAll(waves)),
+			ObservedGeneration: generation,
+		})
+	}
+
+	switch {
+	case halted:
+		status.Phase = corev1alpha1.ClusterPackageRolloutPhaseHalted
+	case len(currentWave) == 0:
+		status.Phase = corev1alpha1.ClusterPackageRolloutPhaseAvailable
+	case rollout.Spec.Paused:
+		status.Phase = corev1alpha1.ClusterPackageRolloutPhasePaused
+	default:
+		status.Phase = corev1alpha1.ClusterPackageRolloutPhaseProgressing
+	}
+}
+
+func countState(wave []*rolloutTarget, state targetState) int32 {
+	var n int32
+	for _, target := range wave {
+		if target.state == state {
+			n++
+		}
+	}
+	return n
+}
+
+func countTargets(waves [][]*rolloutTarget, state targetState) int32 {
+	var n int32
+	for _, wave := range waves {
+		n += countState(wave, state)
+	}
+	return n
+}
+
+func countAll(waves [][]*rolloutTarget) int {
+	var n int
+	for _, wave := range waves {
+		n += len(wave)
+	}
+	return n
+}
+
+func (c *ClusterPackageRolloutController) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1alpha1.ClusterPackageRollout{}).
+		Owns(&corev1alpha1.Package{}).
+		Owns(&corev1alpha1.ClusterPackage{}).
+		Complete(c)
+}
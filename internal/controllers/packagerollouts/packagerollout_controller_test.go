@@ -0,0 +1,334 @@
+package packagerollouts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/utils"
+)
+
+var testScheme = runtime.NewScheme()
+
+func init() {
+	if err := corev1alpha1.AddToScheme(testScheme); err != nil {
+		panic(err)
+	}
+}
+
+func newTestRollout() *corev1alpha1.ClusterPackageRollout {
+	return &corev1alpha1.ClusterPackageRollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", UID: "rollout-uid"},
+		Spec: corev1alpha1.ClusterPackageRolloutSpec{
+			Template: corev1alpha1.PackageRolloutTemplate{
+				Spec: corev1alpha1.PackageSpec{Image: "quay.io/app:v2"},
+			},
+			Waves: []corev1alpha1.PackageRolloutWave{
+				{
+					Name: "canary",
+					Targets: []corev1alpha1.PackageRolloutTarget{
+						{Namespace: "ns-a"}, {Namespace: "ns-b"},
+					},
+				},
+				{
+					Name: "fleet",
+					Targets: []corev1alpha1.PackageRolloutTarget{
+						{ClusterTarget: "prod"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Returns a package of the rollout, updated to the current template and reporting the given conditions.
+func newTestPackage(
+	rollout *corev1alpha1.ClusterPackageRollout, namespace string, conds ...metav1.Condition,
+) *corev1alpha1.Package {
+	return &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: rollout.Name, Namespace: namespace,
+			Labels: map[string]string{corev1alpha1.PackageRolloutLabel: rollout.Name},
+			Annotations: map[string]string{
+				corev1alpha1.PackageRolloutHashAnnotation: utils.ComputeFNV32Hash(rollout.Spec.Template, nil),
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: corev1alpha1.GroupVersion.String(),
+				Kind:       "ClusterPackageRollout",
+				Name:       rollout.Name,
+				UID:        rollout.UID,
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec:   rollout.Spec.Template.Spec,
+		Status: corev1alpha1.PackageStatus{Conditions: conds},
+	}
+}
+
+var (
+	condDone      = metav1.Condition{Type: corev1alpha1.PackageProgressing, Status: metav1.ConditionFalse}
+	condAvailable = metav1.Condition{Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionTrue}
+	condInvalid   = metav1.Condition{
+		Type: corev1alpha1.PackageInvalid, Status: metav1.ConditionTrue, Reason: "LoadError", Message: "broken",
+	}
+)
+
+func TestClusterPackageRolloutController_firstWave(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	c := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	// maxUnavailable defaults to 1, so only the first target is updated.
+	pkg := &corev1alpha1.Package{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "app", Namespace: "ns-a"}, pkg))
+	assert.Equal(t, "quay.io/app:v2", pkg.Spec.Image)
+	assert.Equal(t, "app", pkg.Labels[corev1alpha1.PackageRolloutLabel])
+	assert.Equal(t, "rollout-uid", string(metav1.GetControllerOf(pkg).UID))
+
+	packages := &corev1alpha1.PackageList{}
+	require.NoError(t, c.List(context.Background(), packages))
+	assert.Len(t, packages.Items, 1)
+
+	assert.Equal(t, "canary", rollout.Status.CurrentWave)
+	assert.Equal(t, corev1alpha1.ClusterPackageRolloutPhaseProgressing, rollout.Status.Phase)
+	assert.True(t, meta.IsStatusConditionTrue(rollout.Status.Conditions, corev1alpha1.ClusterPackageRolloutProgressing))
+}
+
+func TestClusterPackageRolloutController_nextWave(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+		newTestPackage(rollout, "ns-a", condDone, condAvailable),
+		newTestPackage(rollout, "ns-b", condDone, condAvailable),
+	).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	pkg := &corev1alpha1.ClusterPackage{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "app-prod"}, pkg))
+	assert.Equal(t, "prod", pkg.Annotations[corev1alpha1.ClusterTargetAnnotation])
+
+	assert.Equal(t, "fleet", rollout.Status.CurrentWave)
+	assert.Equal(t, int32(2), rollout.Status.AvailableTargets)
+	assert.Equal(t, int32(3), rollout.Status.UpdatedTargets)
+}
+
+func TestClusterPackageRolloutController_halted(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	rollout.Spec.MaxUnavailable = ptr.To(int32(2))
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+		newTestPackage(rollout, "ns-a", condInvalid),
+	).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	// ns-b would fit into maxUnavailable, but the failure halts the rollout.
+	packages := &corev1alpha1.PackageList{}
+	require.NoError(t, c.List(context.Background(), packages))
+	assert.Len(t, packages.Items, 1)
+
+	assert.Equal(t, corev1alpha1.ClusterPackageRolloutPhaseHalted, rollout.Status.Phase)
+	assert.True(t, meta.IsStatusConditionTrue(rollout.Status.Conditions, corev1alpha1.ClusterPackageRolloutHalted))
+	if assert.Len(t, rollout.Status.Failures, 1) {
+		assert.Equal(t, "ns-a", rollout.Status.Failures[0].Namespace)
+		assert.Equal(t, "LoadError", rollout.Status.Failures[0].Reason)
+	}
+}
+
+func TestClusterPackageRolloutController_toleratedFailure(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	rollout.Spec.FailureThreshold = 1
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+		newTestPackage(rollout, "ns-a", condInvalid),
+		newTestPackage(rollout, "ns-b", condDone, condAvailable),
+	).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	// The tolerated failure neither blocks the next wave, nor takes up maxUnavailable.
+	pkg := &corev1alpha1.ClusterPackage{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "app-prod"}, pkg))
+
+	assert.Equal(t, "fleet", rollout.Status.CurrentWave)
+	assert.Equal(t, int32(1), rollout.Status.FailedTargets)
+	assert.Equal(t, corev1alpha1.ClusterPackageRolloutPhaseProgressing, rollout.Status.Phase)
+	assert.True(t, meta.IsStatusConditionFalse(rollout.Status.Conditions, corev1alpha1.ClusterPackageRolloutHalted))
+}
+
+func TestClusterPackageRolloutController_completeWithFailures(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	rollout.Spec.FailureThreshold = 1
+	prod := &corev1alpha1.ClusterPackage{ObjectMeta: newTestPackage(rollout, "").ObjectMeta}
+	prod.Name = "app-prod"
+	prod.Status.Conditions = []metav1.Condition{condDone, condAvailable}
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+		newTestPackage(rollout, "ns-a", condInvalid),
+		newTestPackage(rollout, "ns-b", condDone, condAvailable),
+		prod,
+	).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	assert.Empty(t, rollout.Status.CurrentWave)
+	assert.Equal(t, corev1alpha1.ClusterPackageRolloutPhaseAvailable, rollout.Status.Phase)
+	available := meta.FindStatusCondition(rollout.Status.Conditions, corev1alpha1.ClusterPackageRolloutAvailable)
+	if assert.NotNil(t, available) {
+		assert.Equal(t, metav1.ConditionTrue, available.Status)
+		assert.Equal(t, "AvailableWithinFailureThreshold", available.Reason)
+	}
+}
+
+func TestClusterPackageRolloutController_conflict(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	rollout.Spec.MaxUnavailable = ptr.To(int32(2))
+	rollout.Spec.FailureThreshold = 1
+	unmanaged := &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns-a"},
+		Spec:       corev1alpha1.PackageSpec{Image: "quay.io/app:v1"},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(unmanaged).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	pkg := &corev1alpha1.Package{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "app", Namespace: "ns-a"}, pkg))
+	assert.Equal(t, "quay.io/app:v1", pkg.Spec.Image)
+	assert.Nil(t, metav1.GetControllerOf(pkg))
+
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "app", Namespace: "ns-b"}, pkg))
+	assert.Equal(t, "quay.io/app:v2", pkg.Spec.Image)
+
+	if assert.Len(t, rollout.Status.Failures, 1) {
+		assert.Equal(t, "ns-a", rollout.Status.Failures[0].Namespace)
+		assert.Equal(t, "Conflict", rollout.Status.Failures[0].Reason)
+	}
+}
+
+func TestClusterPackageRolloutController_adopt(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	labeled := &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app", Namespace: "ns-a",
+			Labels: map[string]string{corev1alpha1.PackageRolloutLabel: "app"},
+		},
+		Spec: corev1alpha1.PackageSpec{Image: "quay.io/app:v1"},
+	}
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(labeled).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	pkg := &corev1alpha1.Package{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "app", Namespace: "ns-a"}, pkg))
+	assert.Equal(t, "quay.io/app:v2", pkg.Spec.Image)
+	assert.Equal(t, "rollout-uid", string(metav1.GetControllerOf(pkg).UID))
+	assert.Empty(t, rollout.Status.Failures)
+}
+
+func TestClusterPackageRolloutController_removedTarget(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	removed := newTestPackage(rollout, "ns-removed", condDone, condAvailable)
+	foreign := newTestPackage(rollout, "ns-foreign", condDone, condAvailable)
+	foreign.OwnerReferences = nil
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+		newTestPackage(rollout, "ns-a", condDone, condAvailable),
+		removed, foreign,
+	).Build()
+	r := NewClusterPackageRolloutController(c, logr.Discard(), testScheme)
+
+	require.NoError(t, r.reconcileRollout(context.Background(), rollout))
+
+	err := c.Get(context.Background(), client.ObjectKeyFromObject(removed), &corev1alpha1.Package{})
+	assert.True(t, errors.IsNotFound(err))
+	// Packages not controlled by the rollout are left alone.
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(foreign), &corev1alpha1.Package{}))
+	require.NoError(t, c.Get(context.Background(),
+		client.ObjectKey{Name: "app", Namespace: "ns-a"}, &corev1alpha1.Package{}))
+}
+
+func TestPackageState(t *testing.T) {
+	t.Parallel()
+
+	rollout := newTestRollout()
+	hash := utils.ComputeFNV32Hash(rollout.Spec.Template, nil)
+
+	tests := []struct {
+		name     string
+		pkg      *corev1alpha1.Package
+		expected targetState
+	}{
+		{
+			name:     "outdated",
+			pkg:      &corev1alpha1.Package{},
+			expected: targetStateOutdated,
+		},
+		{
+			name:     "no conditions yet",
+			pkg:      newTestPackage(rollout, "ns"),
+			expected: targetStateProgressing,
+		},
+		{
+			name:     "previous revision still available",
+			pkg:      newTestPackage(rollout, "ns", condAvailable),
+			expected: targetStateProgressing,
+		},
+		{
+			name:     "available",
+			pkg:      newTestPackage(rollout, "ns", condDone, condAvailable),
+			expected: targetStateAvailable,
+		},
+		{
+			name: "unavailable after progressing",
+			pkg: newTestPackage(rollout, "ns", condDone, metav1.Condition{
+				Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionFalse,
+			}),
+			expected: targetStateFailed,
+		},
+		{
+			name:     "invalid",
+			pkg:      newTestPackage(rollout, "ns", condInvalid),
+			expected: targetStateFailed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, _ := packageState(test.pkg, &test.pkg.Status, hash)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
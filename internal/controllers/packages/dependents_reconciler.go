@@ -0,0 +1,160 @@
+package packages
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/controllers"
+)
+
+// Holds back the deletion of packages other packages depend on.
+const dependentsFinalizer = "package-operator.run/dependents"
+
+// Maximum number of dependents named in the DeletionBlocked condition.
+const dependentsMessageLimit = 10
+
+// Sequences the deletion of packages after the packages depending on them.
+// While a package has dependents, its finalizer keeps the package and the ObjectDeployment
+// it owns around, until all dependents are gone.
+type dependentsReconciler struct {
+	client client.Client
+}
+
+// Reconcile keeps the dependents finalizer on packages that other packages depend on.
+func (r *dependentsReconciler) Reconcile(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (ctrl.Result, error) {
+	dependents, err := r.dependents(ctx, pkg)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	obj := pkg.ClientObject()
+	if len(dependents) == 0 {
+		return ctrl.Result{}, controllers.RemoveFinalizer(ctx, r.client, obj, dependentsFinalizer)
+	}
+	return ctrl.Result{}, controllers.EnsureFinalizer(ctx, r.client, obj, dependentsFinalizer)
+}
+
+// HandleDeletion releases the package once no other package depends on it anymore.
+// Returns true while the deletion is blocked, reporting the dependents in the DeletionBlocked condition.
+func (r *dependentsReconciler) HandleDeletion(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (blocked bool, err error) {
+	obj := pkg.ClientObject()
+	if !controllerutil.ContainsFinalizer(obj, dependentsFinalizer) {
+		return false, nil
+	}
+
+	dependents, err := r.dependents(ctx, pkg)
+	if err != nil {
+		return false, err
+	}
+	if len(dependents) == 0 {
+		meta.RemoveStatusCondition(pkg.GetConditions(), corev1alpha1.PackageDeletionBlocked)
+		return false, controllers.RemoveFinalizer(ctx, r.client, obj, dependentsFinalizer)
+	}
+
+	meta.SetStatusCondition(pkg.GetConditions(), metav1.Condition{
+		Type:   corev1alpha1.PackageDeletionBlocked,
+		Status: metav1.ConditionTrue,
+		Reason: "DependentsExist",
+		Message: fmt.Sprintf("Waiting for %d dependent package(s) to be deleted: %s.",
+			len(dependents), dependentsMessage(dependents)),
+		ObservedGeneration: obj.GetGeneration(),
+	})
+	return true, nil
+}
+
+// Returns the sorted keys of all packages depending on the given package,
+// as <namespace>/<name> for Packages and <name> for ClusterPackages.
+// Dependents that are deleted already still count, until they are gone.
+func (r *dependentsReconciler) dependents(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) ([]string, error) {
+	obj := pkg.ClientObject()
+	dependency := corev1alpha1.PackageDependency{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+
+	packages := &corev1alpha1.PackageList{}
+	if err := r.client.List(ctx, packages); err != nil {
+		return nil, fmt.Errorf("listing Packages: %w", err)
+	}
+	clusterPackages := &corev1alpha1.ClusterPackageList{}
+	if err := r.client.List(ctx, clusterPackages); err != nil {
+		return nil, fmt.Errorf("listing ClusterPackages: %w", err)
+	}
+
+	var dependents []string
+	for i := range packages.Items {
+		dependent := &packages.Items[i]
+		if dependent.UID != obj.GetUID() && dependsOn(dependent.Spec, dependency) {
+			dependents = append(dependents, client.ObjectKeyFromObject(dependent).String())
+		}
+	}
+	for i := range clusterPackages.Items {
+		dependent := &clusterPackages.Items[i]
+		if dependent.UID != obj.GetUID() && dependsOn(dependent.Spec, dependency) {
+			dependents = append(dependents, dependent.Name)
+		}
+	}
+	sort.Strings(dependents)
+	return dependents, nil
+}
+
+func dependsOn(spec corev1alpha1.PackageSpec, dependency corev1alpha1.PackageDependency) bool {
+	for _, dep := range spec.Dependencies {
+		if dep == dependency {
+			return true
+		}
+	}
+	return false
+}
+
+// Lists the first dependents, so the condition message stays readable.
+func dependentsMessage(dependents []string) string {
+	if len(dependents) <= dependentsMessageLimit {
+		return strings.Join(dependents, ", ")
+	}
+	return fmt.Sprintf("%s and %d more",
+		strings.Join(dependents[:dependentsMessageLimit], ", "),
+		len(dependents)-dependentsMessageLimit)
+}
+
+// Maps packages to the packages they depend on, so dependencies pick up new dependents
+// and blocked deletions continue once their dependents are gone.
+func dependencyRequests(clusterScoped bool) func(ctx context.Context, obj client.Object) []reconcile.Request {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		var dependencies []corev1alpha1.PackageDependency
+		switch pkg := obj.(type) {
+		case *corev1alpha1.Package:
+			dependencies = pkg.Spec.Dependencies
+		case *corev1alpha1.ClusterPackage:
+			dependencies = pkg.Spec.Dependencies
+		}
+
+		var reqs []reconcile.Request
+		for _, dep := range dependencies {
+			if (len(dep.Namespace) == 0) != clusterScoped {
+				continue
+			}
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: client.ObjectKey{Name: dep.Name, Namespace: dep.Namespace},
+			})
+		}
+		return reqs
+	}
+}
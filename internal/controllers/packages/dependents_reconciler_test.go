@@ -0,0 +1,179 @@
+package packages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func newDependentsClient(
+	packages []corev1alpha1.Package, clusterPackages []corev1alpha1.ClusterPackage,
+) *testutil.CtrlClient {
+	c := testutil.NewClient()
+	c.On("List", mock.Anything, mock.AnythingOfType("*v1alpha1.PackageList"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*corev1alpha1.PackageList).Items = packages
+		}).
+		Return(nil)
+	c.On("List", mock.Anything, mock.AnythingOfType("*v1alpha1.ClusterPackageList"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*corev1alpha1.ClusterPackageList).Items = clusterPackages
+		}).
+		Return(nil)
+	c.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	return c
+}
+
+func TestDependentsReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	dependent := corev1alpha1.ClusterPackage{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", UID: "app"},
+		Spec: corev1alpha1.PackageSpec{
+			Dependencies: []corev1alpha1.PackageDependency{{Name: "base", Namespace: "infra"}},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		clusterPackages   []corev1alpha1.ClusterPackage
+		finalizers        []string
+		expectedFinalizer bool
+	}{
+		{
+			name:              "dependents",
+			clusterPackages:   []corev1alpha1.ClusterPackage{dependent},
+			expectedFinalizer: true,
+		},
+		{
+			name:       "no dependents",
+			finalizers: []string{dependentsFinalizer},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newDependentsClient(nil, test.clusterPackages)
+			r := &dependentsReconciler{client: c}
+
+			pkg := &adapters.GenericPackage{}
+			pkg.Name = "base"
+			pkg.Namespace = "infra"
+			pkg.Finalizers = test.finalizers
+
+			res, err := r.Reconcile(context.Background(), pkg)
+			require.NoError(t, err)
+			assert.True(t, res.IsZero())
+			assert.Equal(t, test.expectedFinalizer,
+				controllerutil.ContainsFinalizer(pkg.ClientObject(), dependentsFinalizer))
+			c.AssertNumberOfCalls(t, "Patch", 1)
+		})
+	}
+}
+
+func TestDependentsReconciler_HandleDeletion(t *testing.T) {
+	t.Parallel()
+
+	base := &adapters.GenericClusterPackage{}
+	base.Name = "base"
+	base.UID = "base"
+	base.Finalizers = []string{dependentsFinalizer}
+	base.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	dependencies := []corev1alpha1.PackageDependency{{Name: "base"}}
+	packages := []corev1alpha1.Package{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team-a", UID: "app"},
+			Spec:       corev1alpha1.PackageSpec{Dependencies: dependencies},
+		},
+		{
+			// Same name, but a Package instead of the ClusterPackage.
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "team-b", UID: "other"},
+			Spec: corev1alpha1.PackageSpec{
+				Dependencies: []corev1alpha1.PackageDependency{{Name: "base", Namespace: "team-b"}},
+			},
+		},
+	}
+	clusterPackages := []corev1alpha1.ClusterPackage{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "monitoring", UID: "monitoring"},
+			Spec:       corev1alpha1.PackageSpec{Dependencies: dependencies},
+		},
+	}
+
+	t.Run("blocked", func(t *testing.T) {
+		t.Parallel()
+
+		c := newDependentsClient(packages, clusterPackages)
+		r := &dependentsReconciler{client: c}
+		pkg := &adapters.GenericClusterPackage{ClusterPackage: *base.DeepCopy()}
+
+		blocked, err := r.HandleDeletion(context.Background(), pkg)
+		require.NoError(t, err)
+		assert.True(t, blocked)
+		c.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		cond := meta.FindStatusCondition(pkg.Status.Conditions, corev1alpha1.PackageDeletionBlocked)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "DependentsExist", cond.Reason)
+		assert.Equal(t, "Waiting for 2 dependent package(s) to be deleted: monitoring, team-a/app.", cond.Message)
+	})
+
+	t.Run("released", func(t *testing.T) {
+		t.Parallel()
+
+		c := newDependentsClient(packages[1:], nil)
+		r := &dependentsReconciler{client: c}
+		pkg := &adapters.GenericClusterPackage{ClusterPackage: *base.DeepCopy()}
+
+		blocked, err := r.HandleDeletion(context.Background(), pkg)
+		require.NoError(t, err)
+		assert.False(t, blocked)
+		assert.Empty(t, pkg.Finalizers)
+		c.AssertNumberOfCalls(t, "Patch", 1)
+	})
+}
+
+func TestDependentsMessage(t *testing.T) {
+	t.Parallel()
+
+	dependents := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}
+	assert.Equal(t, "a, b", dependentsMessage(dependents[:2]))
+	assert.Equal(t, "a, b, c, d, e, f, g, h, i, j and 2 more", dependentsMessage(dependents))
+}
+
+func TestDependencyRequests(t *testing.T) {
+	t.Parallel()
+
+	pkg := &corev1alpha1.Package{
+		Spec: corev1alpha1.PackageSpec{
+			Dependencies: []corev1alpha1.PackageDependency{
+				{Name: "base", Namespace: "infra"},
+				{Name: "operators"},
+			},
+		},
+	}
+
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: "base", Namespace: "infra"}},
+	}, dependencyRequests(false)(context.Background(), pkg))
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: "operators"}},
+	}, dependencyRequests(true)(context.Background(), pkg))
+}
@@ -0,0 +1,102 @@
+package packages
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+)
+
+// Deletes packages after their TTLs expired.
+type expiryReconciler struct {
+	client client.Writer
+	clock  clock.PassiveClock
+}
+
+// Reconcile reports the expiry of the package and deletes it once expired.
+// Returns true when the package was deleted.
+func (r *expiryReconciler) Reconcile(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (deleted bool, err error) {
+	expiry := packageExpiryTime(pkg)
+	if expiry == nil {
+		pkg.SetStatusExpiryTime(nil)
+		meta.RemoveStatusCondition(pkg.GetConditions(), corev1alpha1.PackageExpiring)
+		return false, nil
+	}
+	pkg.SetStatusExpiryTime(expiry)
+
+	obj := pkg.ClientObject()
+	cond := metav1.Condition{
+		Type:               corev1alpha1.PackageExpiring,
+		Status:             metav1.ConditionTrue,
+		Reason:             "TTL",
+		Message:            fmt.Sprintf("Package is deleted at %s.", expiry.UTC().Format(time.RFC3339)),
+		ObservedGeneration: obj.GetGeneration(),
+	}
+	if r.clock.Now().Before(expiry.Time) {
+		meta.SetStatusCondition(pkg.GetConditions(), cond)
+		return false, nil
+	}
+
+	if pkg.GetDeletionProtection() {
+		// The deletion would be denied anyways.
+		cond.Reason = "DeletionProtected"
+		cond.Message = fmt.Sprintf("Package expired at %s, but is protected from deletion.",
+			expiry.UTC().Format(time.RFC3339))
+		meta.SetStatusCondition(pkg.GetConditions(), cond)
+		return false, nil
+	}
+
+	logr.FromContextOrDiscard(ctx).Info("deleting expired package", "expiryTime", expiry)
+	err = r.client.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if client.IgnoreNotFound(err) != nil {
+		return false, fmt.Errorf("deleting expired package: %w", err)
+	}
+	return true, nil
+}
+
+// Returns the time until the package expires.
+// Zero, when the package has no expiry or already expired.
+func (r *expiryReconciler) RequeueAfter(pkg adapters.GenericPackageAccessor) time.Duration {
+	expiry := pkg.GetStatusExpiryTime()
+	if expiry == nil {
+		return 0
+	}
+	d := expiry.Sub(r.clock.Now())
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// Returns the earliest expiry of the TTLs of the package.
+// The TTL after availability is only counted while the package is Available.
+func packageExpiryTime(pkg adapters.GenericPackageAccessor) *metav1.Time {
+	var expiry *metav1.Time
+	consider := func(start time.Time, ttl int32) {
+		t := metav1.NewTime(start.Add(time.Duration(ttl) * time.Second))
+		if expiry == nil || t.Before(expiry) {
+			expiry = &t
+		}
+	}
+
+	if ttl := pkg.GetTTLSecondsAfterCreation(); ttl != nil {
+		consider(pkg.ClientObject().GetCreationTimestamp().Time, *ttl)
+	}
+	if ttl := pkg.GetTTLSecondsAfterAvailable(); ttl != nil {
+		cond := meta.FindStatusCondition(*pkg.GetConditions(), corev1alpha1.PackageAvailable)
+		if cond != nil && cond.Status == metav1.ConditionTrue {
+			consider(cond.LastTransitionTime.Time, *ttl)
+		}
+	}
+	return expiry
+}
@@ -0,0 +1,120 @@
+package packages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func TestExpiryReconciler(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		afterCreation    *int32
+		afterAvailable   *int32
+		availableSince   *time.Time
+		protected        bool
+		expectedExpiry   *time.Time
+		expectedDeletion bool
+		expectedReason   string
+	}{
+		{
+			name: "no ttl",
+		},
+		{
+			name:           "after creation",
+			afterCreation:  ptr.To[int32](7200),
+			expectedExpiry: ptr.To(now.Add(time.Hour)),
+			expectedReason: "TTL",
+		},
+		{
+			name:           "after available, but unavailable",
+			afterAvailable: ptr.To[int32](60),
+		},
+		{
+			name:           "earlier ttl wins",
+			afterCreation:  ptr.To[int32](7200),
+			afterAvailable: ptr.To[int32](600),
+			availableSince: ptr.To(now.Add(-5 * time.Minute)),
+			expectedExpiry: ptr.To(now.Add(5 * time.Minute)),
+			expectedReason: "TTL",
+		},
+		{
+			name:             "expired",
+			afterAvailable:   ptr.To[int32](60),
+			availableSince:   ptr.To(now.Add(-5 * time.Minute)),
+			expectedExpiry:   ptr.To(now.Add(-4 * time.Minute)),
+			expectedDeletion: true,
+		},
+		{
+			name:           "expired, but protected",
+			afterCreation:  ptr.To[int32](60),
+			protected:      true,
+			expectedExpiry: ptr.To(now.Add(-59 * time.Minute)),
+			expectedReason: "DeletionProtected",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.NewClient()
+			c.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			r := &expiryReconciler{client: c, clock: clocktesting.NewFakePassiveClock(now)}
+
+			pkg := &adapters.GenericPackage{}
+			pkg.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+			pkg.Spec.TTLSecondsAfterCreation = test.afterCreation
+			pkg.Spec.TTLSecondsAfterAvailable = test.afterAvailable
+			pkg.Spec.DeletionProtection = test.protected
+			if test.availableSince != nil {
+				pkg.Status.Conditions = []metav1.Condition{{
+					Type:               corev1alpha1.PackageAvailable,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(*test.availableSince),
+				}}
+			}
+
+			deleted, err := r.Reconcile(context.Background(), pkg)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedDeletion, deleted)
+			if test.expectedDeletion {
+				c.AssertCalled(t, "Delete", mock.Anything, &pkg.Package, mock.Anything)
+				return
+			}
+			c.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+
+			if test.expectedExpiry == nil {
+				assert.Nil(t, pkg.Status.ExpiryTime)
+				assert.Nil(t, meta.FindStatusCondition(pkg.Status.Conditions, corev1alpha1.PackageExpiring))
+				assert.Zero(t, r.RequeueAfter(pkg))
+				return
+			}
+			require.NotNil(t, pkg.Status.ExpiryTime)
+			assert.Equal(t, *test.expectedExpiry, pkg.Status.ExpiryTime.Time)
+			cond := meta.FindStatusCondition(pkg.Status.Conditions, corev1alpha1.PackageExpiring)
+			require.NotNil(t, cond)
+			assert.Equal(t, metav1.ConditionTrue, cond.Status)
+			assert.Equal(t, test.expectedReason, cond.Reason)
+			if test.expectedReason == "TTL" {
+				assert.Equal(t, test.expectedExpiry.Sub(now), r.RequeueAfter(pkg))
+			}
+		})
+	}
+}
@@ -2,21 +2,29 @@ package packages
 
 import (
 	"context"
+	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/controllers"
+	"package-operator.run/internal/controllers/objectdeployments"
+	"package-operator.run/internal/utils"
 )
 
 type objectDeploymentStatusReconciler struct {
 	client              client.Client
 	scheme              *runtime.Scheme
 	newObjectDeployment adapters.ObjectDeploymentFactory
+	packageHashModifier *int32
+	clock               clock.PassiveClock
 }
 
 func (r *objectDeploymentStatusReconciler) Reconcile(
@@ -52,5 +60,203 @@ func (r *objectDeploymentStatusReconciler) Reconcile(
 
 	packageObj.SetStatusRevision(objDep.GetStatusRevision())
 
+	objectSets, err := r.listObjectSets(ctx, objDep)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	packageObj.SetStatusTeardown(teardownStatus(objectSets))
+	packageObj.SetStatusSync(r.syncStatus(packageObj, objDep, objectSets))
+
 	return ctrl.Result{}, nil
 }
+
+// ObjectSet or ClusterObjectSet of a package.
+type packageObjectSet struct {
+	annotations    map[string]string
+	lifecycleState corev1alpha1.ObjectSetLifecycleState
+	revision       int64
+	conditions     []metav1.Condition
+	controllerOf   []corev1alpha1.ControlledObjectReference
+}
+
+// Returns the ObjectSets referenced by the ObjectDeployment, skipping ObjectSets that are already gone.
+func (r *objectDeploymentStatusReconciler) listObjectSets(
+	ctx context.Context, objDep adapters.ObjectDeploymentAccessor,
+) ([]packageObjectSet, error) {
+	var objectSets []packageObjectSet
+	for _, ref := range objDep.GetStatusControllerOf() {
+		objectSet, found, err := r.getObjectSet(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			objectSets = append(objectSets, objectSet)
+		}
+	}
+	return objectSets, nil
+}
+
+// Maximum number of objects listed in the teardown status of a package.
+const teardownObjectsLimit = 100
+
+// Summarizes the objects controlled by the ObjectSets of the ObjectDeployment,
+// which are deleted together with the package.
+func teardownStatus(objectSets []packageObjectSet) *corev1alpha1.PackageTeardownStatus {
+	teardown := &corev1alpha1.PackageTeardownStatus{}
+	seen := map[corev1alpha1.ControlledObjectReference]struct{}{}
+	for _, objectSet := range objectSets {
+		for _, obj := range objectSet.controllerOf {
+			if _, ok := seen[obj]; ok {
+				continue
+			}
+			seen[obj] = struct{}{}
+			teardown.ObjectCount++
+			if len(teardown.Objects) < teardownObjectsLimit {
+				teardown.Objects = append(teardown.Objects, obj)
+			}
+		}
+	}
+	return teardown
+}
+
+// Returns the referenced ObjectSet or ClusterObjectSet.
+func (r *objectDeploymentStatusReconciler) getObjectSet(
+	ctx context.Context, ref corev1alpha1.ControlledObjectReference,
+) (objectSet packageObjectSet, found bool, err error) {
+	key := client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}
+	if len(ref.Namespace) == 0 {
+		clusterObjectSet := &corev1alpha1.ClusterObjectSet{}
+		if err := r.client.Get(ctx, key, clusterObjectSet); err != nil {
+			return objectSet, false, client.IgnoreNotFound(err)
+		}
+		return packageObjectSet{
+			annotations:    clusterObjectSet.Annotations,
+			lifecycleState: clusterObjectSet.Spec.LifecycleState,
+			revision:       clusterObjectSet.Status.Revision,
+			conditions:     clusterObjectSet.Status.Conditions,
+			controllerOf:   clusterObjectSet.Status.ControllerOf,
+		}, true, nil
+	}
+
+	namespacedObjectSet := &corev1alpha1.ObjectSet{}
+	if err := r.client.Get(ctx, key, namespacedObjectSet); err != nil {
+		return objectSet, false, client.IgnoreNotFound(err)
+	}
+	return packageObjectSet{
+		annotations:    namespacedObjectSet.Annotations,
+		lifecycleState: namespacedObjectSet.Spec.LifecycleState,
+		revision:       namespacedObjectSet.Status.Revision,
+		conditions:     namespacedObjectSet.Status.Conditions,
+		controllerOf:   namespacedObjectSet.Status.ControllerOf,
+	}, true, nil
+}
+
+// Reasons the activation of the current image and configuration of a package is blocked for.
+const (
+	syncBlockedReasonUnpacking = "Unpacking"
+	syncBlockedReasonInvalid   = "Invalid"
+	syncBlockedReasonPaused    = "Paused"
+)
+
+// Compares the active revision of the package with its current image and configuration.
+// The active revision is the newest ObjectSet that is available,
+// the current revision the ObjectSet rendered from the current template of the ObjectDeployment.
+func (r *objectDeploymentStatusReconciler) syncStatus(
+	packageObj adapters.GenericPackageAccessor, objDep adapters.ObjectDeploymentAccessor,
+	objectSets []packageObjectSet,
+) *corev1alpha1.PackageSyncStatus {
+	sync := &corev1alpha1.PackageSyncStatus{
+		DesiredImage:      packageObj.GetImage(),
+		DesiredConfigHash: configHash(objDep.ClientObject().GetAnnotations()),
+	}
+
+	var current, active *packageObjectSet
+	for i := range objectSets {
+		objectSet := &objectSets[i]
+		if objectSet.lifecycleState == corev1alpha1.ObjectSetLifecycleStateArchived {
+			continue
+		}
+		if objectSet.annotations[objectdeployments.ObjectSetHashAnnotation] == objDep.GetStatusTemplateHash() {
+			current = objectSet
+		}
+		if meta.IsStatusConditionTrue(objectSet.conditions, corev1alpha1.ObjectSetAvailable) &&
+			(active == nil || objectSet.revision > active.revision) {
+			active = objectSet
+		}
+	}
+	if active != nil {
+		sync.ActiveRevision = active.revision
+		sync.ActiveImage = active.annotations[manifestsv1alpha1.PackageSourceImageAnnotation]
+		sync.ActiveConfigHash = configHash(active.annotations)
+	}
+
+	sync.BlockedReason, sync.BlockedMessage = r.activationBlocked(packageObj, objDep, current)
+	sync.InSync = len(sync.BlockedReason) == 0 &&
+		current != nil && current == active &&
+		meta.IsStatusConditionTrue(current.conditions, corev1alpha1.ObjectSetSucceeded)
+	if sync.InSync {
+		return sync
+	}
+
+	// Keep the time the package first went out of sync.
+	if prev := packageObj.GetStatusSync(); prev != nil && !prev.InSync && prev.OutOfSyncSince != nil {
+		sync.OutOfSyncSince = prev.OutOfSyncSince
+	} else {
+		now := metav1.NewTime(r.clock.Now())
+		sync.OutOfSyncSince = &now
+	}
+	return sync
+}
+
+// Returns why the current image and configuration of the package are not activated.
+// Returns an empty reason while the rollout is progressing or done.
+func (r *objectDeploymentStatusReconciler) activationBlocked(
+	packageObj adapters.GenericPackageAccessor, objDep adapters.ObjectDeploymentAccessor,
+	current *packageObjectSet,
+) (reason, message string) {
+	conditions := *packageObj.GetConditions()
+	if invalid := meta.FindStatusCondition(conditions, corev1alpha1.PackageInvalid); invalid != nil &&
+		invalid.Status == metav1.ConditionTrue {
+		return syncBlockedReasonInvalid, invalid.Message
+	}
+
+	if packageObj.GetUnpackedHash() != packageObj.GetSpecHash(r.packageHashModifier) {
+		unpacked := meta.FindStatusCondition(conditions, corev1alpha1.PackageUnpacked)
+		if unpacked != nil && unpacked.Status == metav1.ConditionFalse &&
+			unpacked.ObservedGeneration == packageObj.ClientObject().GetGeneration() {
+			return unpacked.Reason, unpacked.Message
+		}
+		return syncBlockedReasonUnpacking, "Waiting for the package image to be unpacked."
+	}
+
+	if current == nil {
+		// New revisions are held back by freezes and rollout schedules of the ObjectDeployment,
+		// reported with the RolloutFrozen and RolloutPending reasons.
+		progressing := meta.FindStatusCondition(*objDep.GetConditions(), corev1alpha1.ObjectDeploymentProgressing)
+		if progressing != nil && (progressing.Reason == "RolloutFrozen" || progressing.Reason == "RolloutPending") {
+			return progressing.Reason, progressing.Message
+		}
+		return "", ""
+	}
+
+	if meta.IsStatusConditionTrue(current.conditions, corev1alpha1.ObjectSetSucceeded) {
+		return "", ""
+	}
+	if current.lifecycleState == corev1alpha1.ObjectSetLifecycleStatePaused {
+		return syncBlockedReasonPaused, fmt.Sprintf("Revision %d is paused.", current.revision)
+	}
+	if available := meta.FindStatusCondition(current.conditions, corev1alpha1.ObjectSetAvailable); available != nil &&
+		available.Reason == "PreflightError" {
+		return available.Reason, available.Message
+	}
+	return "", ""
+}
+
+// Hash of the package configuration recorded on an ObjectDeployment or ObjectSet.
+func configHash(annotations map[string]string) string {
+	config, ok := annotations[manifestsv1alpha1.PackageConfigAnnotation]
+	if !ok {
+		return ""
+	}
+	return utils.ComputeFNV32Hash(config, nil)
+}
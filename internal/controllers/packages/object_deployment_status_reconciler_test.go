@@ -0,0 +1,198 @@
+package packages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/controllers/objectdeployments"
+	"package-operator.run/internal/testutil"
+)
+
+func TestObjectDeploymentStatusReconciler_teardownStatus(t *testing.T) {
+	t.Parallel()
+
+	cm := func(name string) corev1alpha1.ControlledObjectReference {
+		return corev1alpha1.ControlledObjectReference{Kind: "ConfigMap", Name: name, Namespace: "test"}
+	}
+	controllerOf := map[string][]corev1alpha1.ControlledObjectReference{
+		"test-1": {cm("a"), cm("b")},
+		// Objects taken over by the next revision are only counted once.
+		"test-2": {cm("b"), cm("c")},
+	}
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1alpha1.ObjectSet"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			objectSet := args.Get(2).(*corev1alpha1.ObjectSet)
+			objectSet.Status.ControllerOf = controllerOf[args.Get(1).(client.ObjectKey).Name]
+		}).
+		Return(nil)
+	r := &objectDeploymentStatusReconciler{client: c}
+
+	objDep := &adapters.ObjectDeployment{}
+	objDep.SetStatusControllerOf([]corev1alpha1.ControlledObjectReference{
+		{Kind: "ObjectSet", Name: "test-1", Namespace: "test"},
+		{Kind: "ObjectSet", Name: "test-2", Namespace: "test"},
+	})
+
+	objectSets, err := r.listObjectSets(context.Background(), objDep)
+	require.NoError(t, err)
+	teardown := teardownStatus(objectSets)
+	assert.Equal(t, &corev1alpha1.PackageTeardownStatus{
+		ObjectCount: 3,
+		Objects:     []corev1alpha1.ControlledObjectReference{cm("a"), cm("b"), cm("c")},
+	}, teardown)
+}
+
+func TestObjectDeploymentStatusReconciler_teardownStatusLimit(t *testing.T) {
+	t.Parallel()
+
+	controllerOf := make([]corev1alpha1.ControlledObjectReference, teardownObjectsLimit+1)
+	for i := range controllerOf {
+		controllerOf[i] = corev1alpha1.ControlledObjectReference{Kind: "Namespace", Name: string(rune('a' + i))}
+	}
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, client.ObjectKey{Name: "test-1"},
+		mock.AnythingOfType("*v1alpha1.ClusterObjectSet"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*corev1alpha1.ClusterObjectSet).Status.ControllerOf = controllerOf
+		}).
+		Return(nil)
+	// Already deleted.
+	c.On("Get", mock.Anything, client.ObjectKey{Name: "test-2"},
+		mock.AnythingOfType("*v1alpha1.ClusterObjectSet"), mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	r := &objectDeploymentStatusReconciler{client: c}
+
+	objDep := &adapters.ClusterObjectDeployment{}
+	objDep.SetStatusControllerOf([]corev1alpha1.ControlledObjectReference{
+		{Kind: "ClusterObjectSet", Name: "test-1"},
+		{Kind: "ClusterObjectSet", Name: "test-2"},
+	})
+
+	objectSets, err := r.listObjectSets(context.Background(), objDep)
+	require.NoError(t, err)
+	teardown := teardownStatus(objectSets)
+	assert.Equal(t, int32(teardownObjectsLimit+1), teardown.ObjectCount)
+	assert.Len(t, teardown.Objects, teardownObjectsLimit)
+}
+
+func TestObjectDeploymentStatusReconciler_syncStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+	available := metav1.Condition{Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionTrue}
+	succeeded := metav1.Condition{Type: corev1alpha1.ObjectSetSucceeded, Status: metav1.ConditionTrue}
+	objectSet := func(revision int64, hash, image string, conds ...metav1.Condition) packageObjectSet {
+		return packageObjectSet{
+			revision: revision,
+			annotations: map[string]string{
+				objectdeployments.ObjectSetHashAnnotation:      hash,
+				manifestsv1alpha1.PackageSourceImageAnnotation: image,
+			},
+			conditions: conds,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		unpacked    bool
+		prev        *corev1alpha1.PackageSyncStatus
+		progressing *metav1.Condition
+		objectSets  []packageObjectSet
+		expected    *corev1alpha1.PackageSyncStatus
+	}{
+		{
+			name:     "in sync",
+			unpacked: true,
+			prev:     &corev1alpha1.PackageSyncStatus{OutOfSyncSince: &earlier},
+			objectSets: []packageObjectSet{
+				objectSet(1, "old", "quay.io/pkg:v1", available, succeeded),
+				objectSet(2, "current", "quay.io/pkg:v2", available, succeeded),
+			},
+			expected: &corev1alpha1.PackageSyncStatus{
+				InSync: true, ActiveRevision: 2, ActiveImage: "quay.io/pkg:v2", DesiredImage: "quay.io/pkg:v2",
+			},
+		},
+		{
+			name:     "frozen",
+			unpacked: true,
+			progressing: &metav1.Condition{
+				Type: corev1alpha1.ObjectDeploymentProgressing, Status: metav1.ConditionTrue,
+				Reason: "RolloutFrozen", Message: "Rollout of revision current is frozen: incident",
+			},
+			objectSets: []packageObjectSet{
+				objectSet(1, "old", "quay.io/pkg:v1", available, succeeded),
+			},
+			expected: &corev1alpha1.PackageSyncStatus{
+				ActiveRevision: 1, ActiveImage: "quay.io/pkg:v1", DesiredImage: "quay.io/pkg:v2",
+				OutOfSyncSince: &metav1.Time{Time: now},
+				BlockedReason:  "RolloutFrozen", BlockedMessage: "Rollout of revision current is frozen: incident",
+			},
+		},
+		{
+			name:     "failing preflight",
+			unpacked: true,
+			prev:     &corev1alpha1.PackageSyncStatus{OutOfSyncSince: &earlier},
+			objectSets: []packageObjectSet{
+				objectSet(1, "old", "quay.io/pkg:v1", available, succeeded),
+				objectSet(2, "current", "quay.io/pkg:v2", metav1.Condition{
+					Type: corev1alpha1.ObjectSetAvailable, Status: metav1.ConditionFalse,
+					Reason: "PreflightError", Message: "namespace missing",
+				}),
+			},
+			expected: &corev1alpha1.PackageSyncStatus{
+				ActiveRevision: 1, ActiveImage: "quay.io/pkg:v1", DesiredImage: "quay.io/pkg:v2",
+				OutOfSyncSince: &earlier,
+				BlockedReason:  "PreflightError", BlockedMessage: "namespace missing",
+			},
+		},
+		{
+			name: "unpacking",
+			objectSets: []packageObjectSet{
+				objectSet(1, "current", "quay.io/pkg:v1", available, succeeded),
+			},
+			expected: &corev1alpha1.PackageSyncStatus{
+				ActiveRevision: 1, ActiveImage: "quay.io/pkg:v1", DesiredImage: "quay.io/pkg:v2",
+				OutOfSyncSince: &metav1.Time{Time: now},
+				BlockedReason:  syncBlockedReasonUnpacking, BlockedMessage: "Waiting for the package image to be unpacked.",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			pkg := &adapters.GenericPackage{}
+			pkg.Spec.Image = "quay.io/pkg:v2"
+			pkg.Status.Sync = test.prev
+			if test.unpacked {
+				pkg.SetUnpackedHash(pkg.GetSpecHash(nil))
+			}
+
+			objDep := &adapters.ObjectDeployment{}
+			objDep.SetStatusTemplateHash("current")
+			if test.progressing != nil {
+				objDep.SetStatusConditions(*test.progressing)
+			}
+
+			r := &objectDeploymentStatusReconciler{clock: clocktesting.NewFakePassiveClock(now)}
+			assert.Equal(t, test.expected, r.syncStatus(pkg, objDep, test.objectSets))
+		})
+	}
+}
@@ -6,17 +6,24 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/controllers"
 	"package-operator.run/internal/environment"
 	"package-operator.run/internal/metrics"
 	"package-operator.run/internal/packages"
+	"package-operator.run/internal/tracing"
 )
 
 const loaderJobFinalizer = "package-operator.run/loader-job"
@@ -43,6 +50,14 @@ type GenericPackageController struct {
 	scheme           *runtime.Scheme
 	reconciler       []reconciler
 	unpackReconciler *unpackReconciler
+	// Requeues packages for their next check for new images.
+	updatePolicyReconciler *updatePolicyReconciler
+	// Deletes packages after their TTLs expired.
+	expiryReconciler *expiryReconciler
+	// Holds back the deletion of packages other packages depend on.
+	dependentsReconciler *dependentsReconciler
+	// Slows down retries of Packages failing persistently.
+	failureBackoff *controllers.FailureBackoff
 }
 
 func NewPackageController(
@@ -51,11 +66,14 @@ func NewPackageController(
 	imagePuller imagePuller,
 	metricsRecorder metricsRecorder,
 	packageHashModifier *int32,
+	unpackJobs *UnpackJobConfig,
+	renderLimits packages.RenderLimits,
 ) *GenericPackageController {
 	return newGenericPackageController(
 		adapters.NewGenericPackage, adapters.NewObjectDeployment,
-		c, uncachedClient, log, scheme, imagePuller, packages.NewPackageDeployer(c, uncachedClient, scheme),
-		metricsRecorder, packageHashModifier,
+		c, uncachedClient, log, scheme, imagePuller, packages.NewPackageDeployer(
+			c, uncachedClient, scheme, packages.WithRenderLimits{Limits: renderLimits}),
+		metricsRecorder, packageHashModifier, unpackReconcilerOptions(unpackJobs)...,
 	)
 }
 
@@ -65,11 +83,14 @@ func NewClusterPackageController(
 	imagePuller imagePuller,
 	metricsRecorder metricsRecorder,
 	packageHashModifier *int32,
+	unpackJobs *UnpackJobConfig,
+	renderLimits packages.RenderLimits,
 ) *GenericPackageController {
 	return newGenericPackageController(
 		adapters.NewGenericClusterPackage, adapters.NewClusterObjectDeployment,
-		c, uncachedClient, log, scheme, imagePuller, packages.NewClusterPackageDeployer(c, scheme),
-		metricsRecorder, packageHashModifier,
+		c, uncachedClient, log, scheme, imagePuller, packages.NewClusterPackageDeployer(
+			c, uncachedClient, scheme, packages.WithRenderLimits{Limits: renderLimits}),
+		metricsRecorder, packageHashModifier, unpackReconcilerOptions(unpackJobs)...,
 	)
 }
 
@@ -82,6 +103,7 @@ func newGenericPackageController(
 	packageDeployer packageDeployer,
 	metricsRecorder metricsRecorder,
 	packageHashModifier *int32,
+	opts ...unpackReconcilerOption,
 ) *GenericPackageController {
 	controller := &GenericPackageController{
 		newPackage:          newPackage,
@@ -92,37 +114,89 @@ func newGenericPackageController(
 		scheme:              scheme,
 		unpackReconciler: newUnpackReconciler(
 			client, uncachedClient, imagePuller, packageDeployer,
-			metricsRecorder, packageHashModifier,
+			metricsRecorder, packageHashModifier, opts...,
 		),
+		updatePolicyReconciler: &updatePolicyReconciler{
+			uncachedClient: uncachedClient,
+			imagePuller:    imagePuller,
+			clock:          clock.RealClock{},
+		},
+		expiryReconciler: &expiryReconciler{
+			client: client,
+			clock:  clock.RealClock{},
+		},
+		dependentsReconciler: &dependentsReconciler{client: client},
+		failureBackoff: controllers.NewFailureBackoff(
+			controllers.DefaultGlobalMissConfigurationRetry, controllers.DefaultMaxBackoff),
 	}
 
 	controller.reconciler = []reconciler{
+		// Resolves the image to unpack, so it has to run first.
+		controller.updatePolicyReconciler,
+		controller.dependentsReconciler,
 		controller.unpackReconciler,
 		&objectDeploymentStatusReconciler{
 			client:              client,
 			scheme:              scheme,
 			newObjectDeployment: newObjectDeployment,
+			packageHashModifier: packageHashModifier,
+			clock:               clock.RealClock{},
 		},
 	}
 
 	return controller
 }
 
+// Packages are unpacked within the manager process, when no Job config is given.
+func unpackReconcilerOptions(unpackJobs *UnpackJobConfig) []unpackReconcilerOption {
+	if unpackJobs == nil {
+		return nil
+	}
+	return []unpackReconcilerOption{WithUnpackJobs{*unpackJobs}}
+}
+
 func (c *GenericPackageController) SetEnvironment(env *manifests.PackageEnvironment) {
 	c.unpackReconciler.SetEnvironment(env)
 }
 
 func (c *GenericPackageController) SetupWithManager(mgr ctrl.Manager) error {
-	pkg := c.newPackage(c.scheme).ClientObject()
+	pkg := c.newPackage(c.scheme)
 	objDep := c.newObjectDeployment(c.scheme).ClientObject()
+	_, clusterScoped := pkg.ClientObject().(*corev1alpha1.ClusterPackage)
+
+	// Infrastructure-critical packages are reconciled first, e.g. after a restart.
+	priorities := controllers.NewPriorityIndex()
 
 	return ctrl.NewControllerManagedBy(mgr).
-		WithOptions(controller.Options{MaxConcurrentReconciles: 5}).
-		For(pkg).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 5,
+			NewQueue:                controllers.NewPriorityQueueFactory(priorities),
+		}).
+		For(pkg.ClientObject(), builder.WithPredicates(priorities.Predicate(packagePriority))).
 		Owns(objDep).
+		// Unpack Jobs may run in another namespace than the package,
+		// so they are mapped via annotation instead of owner references.
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(
+			unpackJobRequests(unpackPackageKind(pkg)))).
+		// Dependencies are referenced across scopes, e.g. Packages may depend on ClusterPackages.
+		Watches(&corev1alpha1.Package{}, handler.EnqueueRequestsFromMapFunc(
+			dependencyRequests(clusterScoped))).
+		Watches(&corev1alpha1.ClusterPackage{}, handler.EnqueueRequestsFromMapFunc(
+			dependencyRequests(clusterScoped))).
 		Complete(c)
 }
 
+// Priority of a Package or ClusterPackage object.
+func packagePriority(obj client.Object) int32 {
+	switch pkg := obj.(type) {
+	case *corev1alpha1.Package:
+		return pkg.Spec.Priority
+	case *corev1alpha1.ClusterPackage:
+		return pkg.Spec.Priority
+	}
+	return 0
+}
+
 func (c *GenericPackageController) Reconcile(
 	ctx context.Context, req ctrl.Request,
 ) (res ctrl.Result, err error) {
@@ -135,6 +209,9 @@ func (c *GenericPackageController) Reconcile(
 		ctx, req.NamespacedName, pkg.ClientObject()); err != nil {
 		return res, client.IgnoreNotFound(err)
 	}
+
+	ctx, span := tracing.StartReconcile(ctx, "Package", pkg.ClientObject())
+	defer func() { tracing.End(span, err) }()
 	defer func() {
 		if err != nil {
 			return
@@ -146,9 +223,12 @@ func (c *GenericPackageController) Reconcile(
 
 	pkgClientObject := pkg.ClientObject()
 	if !pkgClientObject.GetDeletionTimestamp().IsZero() {
-		if err := c.handleDeletion(ctx, pkg); err != nil {
-			return res, err
-		}
+		return res, c.handleDeletion(ctx, pkg)
+	}
+
+	if deleted, err := c.expiryReconciler.Reconcile(ctx, pkg); err != nil {
+		return res, err
+	} else if deleted {
 		return res, nil
 	}
 
@@ -159,12 +239,41 @@ func (c *GenericPackageController) Reconcile(
 		}
 	}
 	if err != nil {
-		return res, err
+		return c.handleError(ctx, pkg, err)
+	}
+	c.failureBackoff.Succeeded(pkgClientObject)
+	meta.RemoveStatusCondition(pkg.GetConditions(), corev1alpha1.PackageRetryBackoff)
+	if res.IsZero() {
+		res.RequeueAfter = c.updatePolicyReconciler.RequeueAfter(pkg)
+	}
+	if d := c.expiryReconciler.RequeueAfter(pkg); d > 0 && (res.RequeueAfter == 0 || d < res.RequeueAfter) {
+		res.RequeueAfter = d
 	}
 
 	return res, c.updateStatus(ctx, pkg)
 }
 
+// Stops hot-looping on Packages that keep failing the same way.
+// Other errors are returned unchanged.
+func (c *GenericPackageController) handleError(
+	ctx context.Context, pkg adapters.GenericPackageAccessor, reconcileErr error,
+) (res ctrl.Result, err error) {
+	if c.failureBackoff == nil {
+		return res, reconcileErr
+	}
+
+	delay, persistent := c.failureBackoff.Backoff(
+		pkg.ClientObject(), pkg.GetConditions(),
+		corev1alpha1.PackageRetryBackoff, reconcileErr)
+	if !persistent {
+		return res, reconcileErr
+	}
+
+	logr.FromContextOrDiscard(ctx).Error(reconcileErr, "persistent failure", "retryAfter", delay)
+	res.RequeueAfter = delay
+	return res, c.updateStatus(ctx, pkg)
+}
+
 func (c *GenericPackageController) updateStatus(ctx context.Context, pkg adapters.GenericPackageAccessor) error {
 	pkg.UpdatePhase()
 	if err := c.client.Status().Update(ctx, pkg.ClientObject()); err != nil {
@@ -182,5 +291,13 @@ func (c *GenericPackageController) handleDeletion(
 		return err
 	}
 
-	return c.client.Update(ctx, pkg.ClientObject())
+	// Dependents are watched, so blocked deletions continue once they are gone.
+	blocked, err := c.dependentsReconciler.HandleDeletion(ctx, pkg)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return c.updateStatus(ctx, pkg)
+	}
+	return nil
 }
@@ -0,0 +1,287 @@
+package packages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/constants"
+	"package-operator.run/internal/environment"
+	"package-operator.run/internal/packages"
+	"package-operator.run/internal/utils"
+)
+
+const (
+	// Kind of the package, that an unpack Job is unpacking.
+	unpackJobPackageKindLabel = "package-operator.run/unpack-package-kind"
+	// Key of the package, that an unpack Job is unpacking.
+	unpackJobPackageAnnotation = "package-operator.run/unpack-package"
+	// Finished unpack Jobs are deleted by Kubernetes after this time.
+	unpackJobTTL = time.Hour
+	// Default for UnpackJobConfig.Timeout.
+	defaultUnpackJobTimeout = 10 * time.Minute
+)
+
+// ErrInvalidUnpackPackageKey is returned when an unpack Job is started for an invalid package key.
+var ErrInvalidUnpackPackageKey = errors.New("invalid package key, expected <namespace>/<name> or <name>")
+
+// UnpackJobConfig configures Jobs unpacking package images outside of the manager process.
+type UnpackJobConfig struct {
+	// Image running the package-operator-manager.
+	Image string
+	// Namespace the Jobs are created in.
+	Namespace string
+	// ServiceAccount the Jobs run as.
+	// Needs the same permissions as Package Operator to deploy packages.
+	ServiceAccountName string
+	// Resource requirements of the unpack container.
+	Resources corev1.ResourceRequirements
+	// Jobs running longer than this are failed.
+	Timeout time.Duration
+	// Additional environment variables, e.g. to configure registry host overrides.
+	Env []corev1.EnvVar
+}
+
+// WithUnpackJobs unpacks packages in Jobs instead of the manager process.
+type WithUnpackJobs struct{ UnpackJobConfig }
+
+func (w WithUnpackJobs) ConfigureUnpackReconciler(c *unpackReconcilerConfig) {
+	cfg := w.UnpackJobConfig
+	c.UnpackJobs = &cfg
+}
+
+// Creates and looks up Jobs unpacking a specific version of a package.
+type unpackJobs struct {
+	client client.Client
+	cfg    UnpackJobConfig
+}
+
+// Returns the Job unpacking the given package at the given spec hash, creating it if needed.
+func (u *unpackJobs) ensure(
+	ctx context.Context, pkg adapters.GenericPackageAccessor, specHash string,
+) (*batchv1.Job, error) {
+	desired := u.desiredJob(pkg, specHash)
+
+	job := &batchv1.Job{}
+	err := u.client.Get(ctx, client.ObjectKeyFromObject(desired), job)
+	if err == nil {
+		return job, nil
+	}
+	if !apimachineryerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting unpack Job: %w", err)
+	}
+
+	if err := u.client.Create(ctx, desired); err != nil &&
+		!apimachineryerrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("creating unpack Job: %w", err)
+	}
+	return desired, nil
+}
+
+func (u *unpackJobs) delete(ctx context.Context, job *batchv1.Job) error {
+	if err := u.client.Delete(
+		ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("deleting unpack Job: %w", err)
+	}
+	return nil
+}
+
+func (u *unpackJobs) desiredJob(pkg adapters.GenericPackageAccessor, specHash string) *batchv1.Job {
+	kind := unpackPackageKind(pkg)
+	key := unpackPackageKey(pkg)
+
+	timeout := u.cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultUnpackJobTimeout
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			// Every version of a package is unpacked by its own Job.
+			Name:      "pko-unpack-" + utils.ComputeFNV32Hash([]string{kind, key, specHash}, nil),
+			Namespace: u.cfg.Namespace,
+			Labels: map[string]string{
+				constants.DynamicCacheLabel: "True",
+				unpackJobPackageKindLabel:   kind,
+			},
+			Annotations: map[string]string{
+				unpackJobPackageAnnotation: key,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(int32(0)),
+			ActiveDeadlineSeconds:   ptr.To(int64(timeout.Seconds())),
+			TTLSecondsAfterFinished: ptr.To(int32(unpackJobTTL.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: u.cfg.ServiceAccountName,
+					Containers: []corev1.Container{
+						{
+							Name:  "unpack",
+							Image: u.cfg.Image,
+							Args: []string{
+								"--unpack-package=" + key,
+								"--namespace=" + u.cfg.Namespace,
+							},
+							Env:                      u.cfg.Env,
+							Resources:                u.cfg.Resources,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func unpackPackageKind(pkg adapters.GenericPackageAccessor) string {
+	if _, ok := pkg.ClientObject().(*corev1alpha1.ClusterPackage); ok {
+		return "ClusterPackage"
+	}
+	return "Package"
+}
+
+// Returns the finished condition of the given Job, if it has finished.
+func unpackJobFinished(job *batchv1.Job) (batchv1.JobConditionType, *batchv1.JobCondition) {
+	for i := range job.Status.Conditions {
+		cond := &job.Status.Conditions[i]
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		if cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed {
+			return cond.Type, cond
+		}
+	}
+	return "", nil
+}
+
+// Maps unpack Jobs to the package they are unpacking.
+func unpackJobRequests(kind string) func(ctx context.Context, obj client.Object) []reconcile.Request {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		if obj.GetLabels()[unpackJobPackageKindLabel] != kind {
+			return nil
+		}
+		key, err := parseUnpackPackageKey(obj.GetAnnotations()[unpackJobPackageAnnotation])
+		if err != nil {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: key}}
+	}
+}
+
+// Formats keys in the form <namespace>/<name> for Packages and <name> for ClusterPackages.
+func unpackPackageKey(pkg adapters.GenericPackageAccessor) string {
+	obj := pkg.ClientObject()
+	if len(obj.GetNamespace()) == 0 {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// Parses keys in the form <namespace>/<name> for Packages and <name> for ClusterPackages.
+func parseUnpackPackageKey(key string) (client.ObjectKey, error) {
+	parts := strings.Split(key, "/")
+	switch {
+	case len(parts) == 1 && len(parts[0]) > 0:
+		return client.ObjectKey{Name: parts[0]}, nil
+	case len(parts) == 2 && len(parts[0]) > 0 && len(parts[1]) > 0:
+		return client.ObjectKey{Namespace: parts[0], Name: parts[1]}, nil
+	}
+	return client.ObjectKey{}, fmt.Errorf("%w: %q", ErrInvalidUnpackPackageKey, key)
+}
+
+// UnpackJobRunner is run within unpack Jobs.
+// It pulls the image of a single package and deploys its contents,
+// like the package controllers do when unpacking in process.
+type UnpackJobRunner struct {
+	*environment.Sink
+
+	client client.Client
+	scheme *runtime.Scheme
+
+	imagePuller            imagePuller
+	packageDeployer        packageDeployer
+	clusterPackageDeployer packageDeployer
+}
+
+func NewUnpackJobRunner(
+	c client.Client, scheme *runtime.Scheme, imagePuller imagePuller, renderLimits packages.RenderLimits,
+) *UnpackJobRunner {
+	limits := packages.WithRenderLimits{Limits: renderLimits}
+	return &UnpackJobRunner{
+		Sink: environment.NewSink(c),
+
+		client: c,
+		scheme: scheme,
+
+		imagePuller:            imagePuller,
+		packageDeployer:        packages.NewPackageDeployer(c, c, scheme, limits),
+		clusterPackageDeployer: packages.NewClusterPackageDeployer(c, c, scheme, limits),
+	}
+}
+
+// Run unpacks the Package (<namespace>/<name>) or ClusterPackage (<name>) with the given key.
+func (r *UnpackJobRunner) Run(ctx context.Context, packageKey string) error {
+	key, err := parseUnpackPackageKey(packageKey)
+	if err != nil {
+		return err
+	}
+
+	newPackage, deployer := adapters.NewGenericPackage, r.packageDeployer
+	if len(key.Namespace) == 0 {
+		newPackage, deployer = adapters.NewGenericClusterPackage, r.clusterPackageDeployer
+	}
+
+	pkg := newPackage(r.scheme)
+	if err := r.client.Get(ctx, key, pkg.ClientObject()); err != nil {
+		return fmt.Errorf("getting package: %w", err)
+	}
+
+	env, err := r.GetEnvironment(ctx, key.Namespace)
+	if err != nil {
+		return fmt.Errorf("getting environment: %w", err)
+	}
+	pullOpts, err := imagePullOptions(ctx, r.client, pkg)
+	if err != nil {
+		return err
+	}
+	rawPkg, err := r.imagePuller.Pull(ctx, pkg.GetImage(), pullOpts...)
+	if err != nil {
+		return fmt.Errorf("pulling image: %w", err)
+	}
+	if err := deployer.Deploy(ctx, pkg, rawPkg, *env); err != nil {
+		return fmt.Errorf("deploying package: %w", err)
+	}
+
+	// Report load errors, the rest of the status is owned by the package controller.
+	invalid := meta.FindStatusCondition(*pkg.GetConditions(), corev1alpha1.PackageInvalid)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := newPackage(r.scheme)
+		if err := r.client.Get(ctx, key, current.ClientObject()); err != nil {
+			return err
+		}
+		if invalid != nil {
+			meta.SetStatusCondition(current.GetConditions(), *invalid)
+		} else {
+			meta.RemoveStatusCondition(current.GetConditions(), corev1alpha1.PackageInvalid)
+		}
+		return r.client.Status().Update(ctx, current.ClientObject())
+	})
+}
@@ -0,0 +1,164 @@
+package packages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func TestUnpackReconciler_job(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		jobConditions     []batchv1.JobCondition
+		expectedReason    string
+		expectedUnpacked  bool
+		expectedRequeue   bool
+		expectedJobDelete bool
+	}{
+		{
+			name:           "running",
+			expectedReason: "Unpacking",
+		},
+		{
+			name: "complete",
+			jobConditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			},
+			expectedReason:   "UnpackSuccess",
+			expectedUnpacked: true,
+		},
+		{
+			name: "failed",
+			jobConditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded"},
+			},
+			expectedReason:    "UnpackJobFailed",
+			expectedRequeue:   true,
+			expectedJobDelete: true,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.NewClient()
+			ur := newUnpackReconciler(c, testutil.NewClient(), &imagePullerMock{}, &packageDeployerMock{}, nil, nil,
+				WithUnpackJobs{UnpackJobConfig{Image: "pko:test", Namespace: "pko"}})
+
+			c.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.Job"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					job := args.Get(2).(*batchv1.Job)
+					job.Name = args.Get(1).(client.ObjectKey).Name
+					job.Namespace = "pko"
+					job.Status.Conditions = test.jobConditions
+				}).
+				Return(nil)
+			c.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			pkg := &adapters.GenericPackage{
+				Package: corev1alpha1.Package{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", UID: "test-uid"},
+					Spec:       corev1alpha1.PackageSpec{Image: "test123:latest"},
+				},
+			}
+			res, err := ur.Reconcile(context.Background(), pkg)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRequeue, res.RequeueAfter > 0)
+
+			cond := meta.FindStatusCondition(*pkg.GetConditions(), corev1alpha1.PackageUnpacked)
+			require.NotNil(t, cond)
+			assert.Equal(t, test.expectedReason, cond.Reason)
+			assert.Equal(t, test.expectedUnpacked, pkg.GetUnpackedHash() == pkg.GetSpecHash(nil))
+			if test.expectedJobDelete {
+				c.AssertCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				c.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestUnpackReconciler_jobCreate(t *testing.T) {
+	t.Parallel()
+
+	c := testutil.NewClient()
+	ur := newUnpackReconciler(c, testutil.NewClient(), &imagePullerMock{}, &packageDeployerMock{}, nil, nil,
+		WithUnpackJobs{UnpackJobConfig{Image: "pko:test", Namespace: "pko", ServiceAccountName: "pko"}})
+
+	c.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.Job"), mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	c.On("Create", mock.Anything, mock.AnythingOfType("*v1.Job"), mock.Anything).Return(nil)
+
+	pkg := &adapters.GenericClusterPackage{
+		ClusterPackage: corev1alpha1.ClusterPackage{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec:       corev1alpha1.PackageSpec{Image: "test123:latest"},
+		},
+	}
+	res, err := ur.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.True(t, res.IsZero())
+
+	job := c.Calls[1].Arguments.Get(1).(*batchv1.Job)
+	assert.Equal(t, "pko", job.Namespace)
+	assert.Equal(t, "ClusterPackage", job.Labels[unpackJobPackageKindLabel])
+	assert.Equal(t, "test", job.Annotations[unpackJobPackageAnnotation])
+	if assert.Len(t, job.Spec.Template.Spec.Containers, 1) {
+		assert.Equal(t, "pko:test", job.Spec.Template.Spec.Containers[0].Image)
+		assert.Contains(t, job.Spec.Template.Spec.Containers[0].Args, "--unpack-package=test")
+	}
+	assert.True(t, meta.IsStatusConditionFalse(*pkg.GetConditions(), corev1alpha1.PackageUnpacked))
+}
+
+func TestUnpackJobRequests(t *testing.T) {
+	t.Parallel()
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{unpackJobPackageKindLabel: "Package"},
+			Annotations: map[string]string{unpackJobPackageAnnotation: "ns/name"},
+		},
+	}
+	ctx := context.Background()
+
+	reqs := unpackJobRequests("Package")(ctx, job)
+	if assert.Len(t, reqs, 1) {
+		assert.Equal(t, client.ObjectKey{Namespace: "ns", Name: "name"}, reqs[0].NamespacedName)
+	}
+	assert.Empty(t, unpackJobRequests("ClusterPackage")(ctx, job))
+}
+
+func TestParseUnpackPackageKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := parseUnpackPackageKey("name")
+	require.NoError(t, err)
+	assert.Equal(t, client.ObjectKey{Name: "name"}, key)
+
+	key, err = parseUnpackPackageKey("ns/name")
+	require.NoError(t, err)
+	assert.Equal(t, client.ObjectKey{Namespace: "ns", Name: "name"}, key)
+
+	for _, invalid := range []string{"", "/name", "ns/", "a/b/c"} {
+		_, err := parseUnpackPackageKey(invalid)
+		require.ErrorIs(t, err, ErrInvalidUnpackPackageKey, invalid)
+	}
+}
@@ -2,10 +2,14 @@ package packages
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/flowcontrol"
@@ -33,6 +37,9 @@ type unpackReconciler struct {
 
 	backoff             *flowcontrol.Backoff
 	packageHashModifier *int32
+
+	// Optional, unpacks packages in Jobs instead of in process.
+	unpackJobs *unpackJobs
 }
 
 type packageLoadRecorder interface {
@@ -54,20 +61,29 @@ func newUnpackReconciler(
 	cfg.Option(opts...)
 	cfg.Default()
 
-	return &unpackReconciler{
-		environment.NewSink(c),
+	r := &unpackReconciler{
+		Sink: environment.NewSink(c),
 
-		uncachedClient,
-		imagePuller,
-		packageDeployer,
-		packageLoadRecorder,
-		cfg.GetBackoff(),
-		packageHashModifier,
+		uncachedClient:      uncachedClient,
+		imagePuller:         imagePuller,
+		packageDeployer:     packageDeployer,
+		packageLoadRecorder: packageLoadRecorder,
+		backoff:             cfg.GetBackoff(),
+		packageHashModifier: packageHashModifier,
+	}
+	if cfg.UnpackJobs != nil {
+		r.unpackJobs = &unpackJobs{client: c, cfg: *cfg.UnpackJobs}
 	}
+	return r
 }
 
 type imagePuller interface {
-	Pull(ctx context.Context, image string) (*packages.RawPackage, error)
+	Pull(ctx context.Context, image string, opts ...packages.PullOption) (*packages.RawPackage, error)
+}
+
+// Implemented by image pullers that can discover SBOMs attached to package images.
+type sbomFinder interface {
+	FindSBOMs(ctx context.Context, image string, opts ...packages.PullOption) ([]packages.SBOMReference, error)
 }
 
 type packageDeployer interface {
@@ -91,9 +107,13 @@ func (r *unpackReconciler) Reconcile(
 		return res, nil
 	}
 
+	if r.unpackJobs != nil {
+		return r.unpackInJob(ctx, pkg, specHash)
+	}
+
 	pullStart := time.Now()
 	log := logr.FromContextOrDiscard(ctx)
-	rawPkg, err := r.imagePuller.Pull(ctx, pkg.GetImage())
+	rawPkg, pullOpts, err := r.pull(ctx, pkg)
 	if err != nil {
 		meta.SetStatusCondition(
 			pkg.GetConditions(), metav1.Condition{
@@ -122,6 +142,91 @@ func (r *unpackReconciler) Reconcile(
 		r.packageLoadRecorder.RecordPackageLoadMetric(
 			pkg, time.Since(pullStart))
 	}
+	r.unpacked(ctx, pkg, specHash, pullOpts)
+
+	return
+}
+
+// Pulls the package image with the image pull secrets referenced by the package.
+func (r *unpackReconciler) pull(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (*packages.RawPackage, []packages.PullOption, error) {
+	pullOpts, err := imagePullOptions(ctx, r.uncachedClient, pkg)
+	if err != nil {
+		return nil, nil, err
+	}
+	rawPkg, err := r.imagePuller.Pull(ctx, pkg.GetImage(), pullOpts...)
+	return rawPkg, pullOpts, err
+}
+
+// Unpacks large packages in a Job, so pulling images does not put memory pressure on the manager.
+// The Job deploys the package contents, so only its result is reported here.
+func (r *unpackReconciler) unpackInJob(
+	ctx context.Context, pkg adapters.GenericPackageAccessor, specHash string,
+) (res ctrl.Result, err error) {
+	backoffID := string(pkg.ClientObject().GetUID())
+	if r.backoff.IsInBackOffSinceUpdate(backoffID, r.backoff.Clock.Now()) {
+		// Don't start another Job until the backoff of the last failure has passed.
+		return ctrl.Result{RequeueAfter: r.backoff.Get(backoffID)}, nil
+	}
+
+	job, err := r.unpackJobs.ensure(ctx, pkg, specHash)
+	if err != nil {
+		return res, err
+	}
+
+	finished, cond := unpackJobFinished(job)
+	switch finished {
+	case batchv1.JobComplete:
+		// Finished Jobs are cleaned up by their TTL.
+		if r.packageLoadRecorder != nil {
+			r.packageLoadRecorder.RecordPackageLoadMetric(
+				pkg, cond.LastTransitionTime.Sub(job.CreationTimestamp.Time))
+		}
+		r.backoff.Reset(backoffID)
+		pullOpts, err := imagePullOptions(ctx, r.uncachedClient, pkg)
+		if err != nil {
+			return res, err
+		}
+		r.unpacked(ctx, pkg, specHash, pullOpts)
+		return res, nil
+
+	case batchv1.JobFailed:
+		meta.SetStatusCondition(
+			pkg.GetConditions(), metav1.Condition{
+				Type:   corev1alpha1.PackageUnpacked,
+				Status: metav1.ConditionFalse,
+				Reason: "UnpackJobFailed",
+				Message: fmt.Sprintf("Unpack Job %s failed: %s: %s, check its logs for details.",
+					client.ObjectKeyFromObject(job), cond.Reason, cond.Message),
+				ObservedGeneration: pkg.ClientObject().GetGeneration(),
+			})
+		// Delete the failed Job, so it will be retried after the backoff.
+		if err := r.unpackJobs.delete(ctx, job); err != nil {
+			return res, err
+		}
+		r.backoff.Next(backoffID, r.backoff.Clock.Now())
+		backoff := r.backoff.Get(backoffID)
+		logr.FromContextOrDiscard(ctx).Info("unpack Job failed", "job", client.ObjectKeyFromObject(job), "backoff", backoff)
+		return ctrl.Result{RequeueAfter: backoff}, nil
+	}
+
+	// Running Jobs are watched, so we are requeued when they finish.
+	meta.SetStatusCondition(
+		pkg.GetConditions(), metav1.Condition{
+			Type:               corev1alpha1.PackageUnpacked,
+			Status:             metav1.ConditionFalse,
+			Reason:             "Unpacking",
+			Message:            fmt.Sprintf("Unpack Job %s is running.", client.ObjectKeyFromObject(job)),
+			ObservedGeneration: pkg.ClientObject().GetGeneration(),
+		})
+	return res, nil
+}
+
+func (r *unpackReconciler) unpacked(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+	specHash string, pullOpts []packages.PullOption,
+) {
 	pkg.SetUnpackedHash(specHash)
 	meta.SetStatusCondition(
 		pkg.GetConditions(), metav1.Condition{
@@ -131,12 +236,80 @@ func (r *unpackReconciler) Reconcile(
 			Message:            "Unpack job succeeded",
 			ObservedGeneration: pkg.ClientObject().GetGeneration(),
 		})
+	r.reportSBOM(ctx, pkg, pullOpts)
+}
 
-	return
+// reportSBOM sets the SBOMAttached condition, if the image puller supports SBOM discovery.
+func (r *unpackReconciler) reportSBOM(
+	ctx context.Context, pkg adapters.GenericPackageAccessor, pullOpts []packages.PullOption,
+) {
+	finder, ok := r.imagePuller.(sbomFinder)
+	if !ok {
+		return
+	}
+
+	cond := metav1.Condition{
+		Type:               corev1alpha1.PackageSBOMAttached,
+		ObservedGeneration: pkg.ClientObject().GetGeneration(),
+	}
+
+	sboms, err := finder.FindSBOMs(ctx, pkg.GetImage(), pullOpts...)
+	switch {
+	case err != nil:
+		logr.FromContextOrDiscard(ctx).Info("looking up SBOMs", "error", err.Error())
+
+		cond.Status = metav1.ConditionUnknown
+		cond.Reason = "LookupFailed"
+		cond.Message = err.Error()
+	case len(sboms) == 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = "NotFound"
+		cond.Message = "No SBOM is attached to the package image."
+	default:
+		formats := make([]string, 0, len(sboms))
+		for _, sbom := range sboms {
+			formats = append(formats, string(sbom.Format))
+		}
+
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = "Found"
+		cond.Message = "SBOM attached in format: " + strings.Join(formats, ", ")
+	}
+
+	meta.SetStatusCondition(pkg.GetConditions(), cond)
+}
+
+// ErrImagePullSecretNamespaceMissing is returned when a ClusterPackage references a Secret without namespace.
+var ErrImagePullSecretNamespaceMissing = errors.New("namespace of image pull secret is required")
+
+// Loads the image pull secrets referenced by the package.
+func imagePullOptions(
+	ctx context.Context, c client.Reader, pkg adapters.GenericPackageAccessor,
+) ([]packages.PullOption, error) {
+	keys := pkg.GetImagePullSecrets()
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	secrets := make(packages.WithPullSecrets, 0, len(keys))
+	for _, key := range keys {
+		if len(key.Namespace) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrImagePullSecretNamespaceMissing, key.Name)
+		}
+		secret := corev1.Secret{}
+		if err := c.Get(ctx, key, &secret); err != nil {
+			return nil, fmt.Errorf("getting image pull secret %s: %w", key, err)
+		}
+		secrets = append(secrets, secret)
+	}
+	return []packages.PullOption{secrets}, nil
 }
 
 type unpackReconcilerConfig struct {
 	controllers.BackoffConfig
+
+	// Optional, unpacks packages in Jobs when set.
+	UnpackJobs *UnpackJobConfig
 }
 
 func (c *unpackReconcilerConfig) Option(opts ...unpackReconcilerOption) {
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/adapters"
@@ -121,17 +123,130 @@ func TestUnpackReconciler_pullBackoff(t *testing.T) {
 			corev1alpha1.PackageUnpacked))
 }
 
+func TestImagePullOptions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, client.ObjectKey{Namespace: "test", Name: "pull-secret"},
+		mock.AnythingOfType("*v1.Secret"), mock.Anything).
+		Return(nil)
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+			Spec: corev1alpha1.PackageSpec{
+				ImagePullSecrets: []corev1alpha1.PackageImagePullSecret{{Name: "pull-secret"}},
+			},
+		},
+	}
+	opts, err := imagePullOptions(ctx, c, pkg)
+	require.NoError(t, err)
+	assert.Len(t, opts, 1)
+	c.AssertExpectations(t)
+
+	clusterPkg := &adapters.GenericClusterPackage{
+		ClusterPackage: corev1alpha1.ClusterPackage{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: corev1alpha1.PackageSpec{
+				ImagePullSecrets: []corev1alpha1.PackageImagePullSecret{{Name: "pull-secret"}},
+			},
+		},
+	}
+	_, err = imagePullOptions(ctx, c, clusterPkg)
+	require.ErrorIs(t, err, ErrImagePullSecretNamespaceMissing)
+
+	opts, err = imagePullOptions(ctx, c, &adapters.GenericPackage{})
+	require.NoError(t, err)
+	assert.Empty(t, opts)
+}
+
+func TestUnpackReconciler_sbom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		sboms  []packages.SBOMReference
+		err    error
+		status metav1.ConditionStatus
+	}{
+		{
+			name:   "found",
+			sboms:  []packages.SBOMReference{{Format: packages.SBOMFormatSPDX, Digest: "sha256:123"}},
+			status: metav1.ConditionTrue,
+		},
+		{
+			name:   "not found",
+			status: metav1.ConditionFalse,
+		},
+		{
+			name:   "lookup failed",
+			err:    errTest,
+			status: metav1.ConditionUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			c := testutil.NewClient()
+			uc := testutil.NewClient()
+
+			ipm := &sbomImagePullerMock{}
+			pd := &packageDeployerMock{}
+			ur := newUnpackReconciler(c, uc, ipm, pd, nil, nil)
+
+			ipm.
+				On("Pull", mock.Anything, mock.Anything).
+				Return(&packages.RawPackage{}, nil)
+			ipm.
+				On("FindSBOMs", mock.Anything, mock.Anything).
+				Return(test.sboms, test.err)
+			pd.
+				On("Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+
+			pkg := &adapters.GenericPackage{
+				Package: corev1alpha1.Package{
+					Spec: corev1alpha1.PackageSpec{
+						Image: "test123:latest",
+					},
+				},
+			}
+			ur.SetEnvironment(&manifests.PackageEnvironment{})
+
+			_, err := ur.Reconcile(context.Background(), pkg)
+			require.NoError(t, err)
+
+			cond := meta.FindStatusCondition(*pkg.GetConditions(), corev1alpha1.PackageSBOMAttached)
+			require.NotNil(t, cond)
+			assert.Equal(t, test.status, cond.Status)
+		})
+	}
+}
+
 type imagePullerMock struct {
 	mock.Mock
 }
 
 func (m *imagePullerMock) Pull(
-	ctx context.Context, image string,
+	ctx context.Context, image string, _ ...packages.PullOption,
 ) (*packages.RawPackage, error) {
 	args := m.Called(ctx, image)
 	return args.Get(0).(*packages.RawPackage), args.Error(1)
 }
 
+type sbomImagePullerMock struct {
+	imagePullerMock
+}
+
+func (m *sbomImagePullerMock) FindSBOMs(
+	ctx context.Context, image string, _ ...packages.PullOption,
+) ([]packages.SBOMReference, error) {
+	args := m.Called(ctx, image)
+	return args.Get(0).([]packages.SBOMReference), args.Error(1)
+}
+
 type packageDeployerMock struct {
 	mock.Mock
 }
@@ -0,0 +1,178 @@
+package packages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"pkg.package-operator.run/semver"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/packages"
+)
+
+// Interval the registry is checked for new images, when the update policy does not specify one.
+const defaultUpdateInterval = time.Hour
+
+var (
+	errNoMatchingTag          = errors.New("no tag matches the semVer range")
+	errUnknownUpdateStrategy  = errors.New("unknown update strategy")
+	errImageResolverMissing   = errors.New("image puller can not resolve images")
+	errUpdatePolicyResolution = errors.New("resolving image of update policy")
+)
+
+// Optional capability of the imagePuller, to look up new images in the registry.
+type imageResolver interface {
+	ResolveDigest(ctx context.Context, image string, opts ...packages.PullOption) (string, error)
+	ListTags(ctx context.Context, image string, opts ...packages.PullOption) ([]string, error)
+}
+
+// Checks the registry for new images of packages with an update policy.
+// The image found replaces the image of the spec when unpacking the package.
+type updatePolicyReconciler struct {
+	uncachedClient client.Client
+	imagePuller    imagePuller
+	clock          clock.PassiveClock
+}
+
+func (r *updatePolicyReconciler) Reconcile(
+	ctx context.Context, pkg adapters.GenericPackageAccessor,
+) (ctrl.Result, error) {
+	policy := pkg.GetUpdatePolicy()
+	if policy == nil {
+		pkg.SetStatusUpdate(nil)
+		return ctrl.Result{}, nil
+	}
+
+	now := r.clock.Now()
+	generation := pkg.ClientObject().GetGeneration()
+	update := pkg.GetStatusUpdate()
+	// An image resolved for a previous generation may not match the spec anymore.
+	upToDate := update != nil && update.ObservedGeneration == generation
+	if upToDate {
+		if now.Before(update.LastCheckTime.Add(updateInterval(policy))) {
+			return ctrl.Result{}, nil
+		}
+		// Only move on to the next image, after the current one became available.
+		if !meta.IsStatusConditionTrue(*pkg.GetConditions(), corev1alpha1.PackageAvailable) {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	image, err := r.resolve(ctx, pkg, policy)
+	if err != nil && !upToDate {
+		return ctrl.Result{}, fmt.Errorf("%w: %w", errUpdatePolicyResolution, err)
+	}
+	if err != nil {
+		// Keep the current image and try again with the next check.
+		logr.FromContextOrDiscard(ctx).Error(err, "checking for new package image")
+		image = update.Image
+	}
+
+	pkg.SetStatusUpdate(&corev1alpha1.PackageUpdateStatus{
+		Image:              image,
+		LastCheckTime:      metav1.NewTime(now),
+		ObservedGeneration: generation,
+	})
+	return ctrl.Result{}, nil
+}
+
+// Returns the time until the next check for new images is due.
+// Zero, when there is nothing to check or the check waits for the package to become available.
+func (r *updatePolicyReconciler) RequeueAfter(pkg adapters.GenericPackageAccessor) time.Duration {
+	policy := pkg.GetUpdatePolicy()
+	update := pkg.GetStatusUpdate()
+	if policy == nil || update == nil {
+		return 0
+	}
+	d := update.LastCheckTime.Add(updateInterval(policy)).Sub(r.clock.Now())
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func (r *updatePolicyReconciler) resolve(
+	ctx context.Context, pkg adapters.GenericPackageAccessor, policy *corev1alpha1.PackageUpdatePolicy,
+) (string, error) {
+	resolver, ok := r.imagePuller.(imageResolver)
+	if !ok {
+		return "", errImageResolverMissing
+	}
+
+	pullOpts, err := imagePullOptions(ctx, r.uncachedClient, pkg)
+	if err != nil {
+		return "", err
+	}
+
+	image := pkg.GetSpecImage()
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+
+	switch policy.Strategy {
+	case corev1alpha1.PackageUpdateStrategyDigest:
+		digest, err := resolver.ResolveDigest(ctx, image, pullOpts...)
+		if err != nil {
+			return "", err
+		}
+		return ref.Context().Digest(digest).String(), nil
+
+	case corev1alpha1.PackageUpdateStrategySemVer:
+		tags, err := resolver.ListTags(ctx, image, pullOpts...)
+		if err != nil {
+			return "", err
+		}
+		tag, err := highestMatchingTag(tags, policy.SemVerRange)
+		if err != nil {
+			return "", err
+		}
+		return ref.Context().Tag(tag).String(), nil
+	}
+	return "", fmt.Errorf("%w: %s", errUnknownUpdateStrategy, policy.Strategy)
+}
+
+// Returns the tag with the highest semantic version within the given range.
+// Tags that are no semantic versions are ignored.
+func highestMatchingTag(tags []string, semVerRange string) (string, error) {
+	constraint, err := semver.NewConstraint(semVerRange)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		highestTag     string
+		highestVersion semver.Version
+	)
+	for _, tag := range tags {
+		v, err := semver.NewVersion(strings.TrimPrefix(tag, "v"))
+		if err != nil || !constraint.Check(v) {
+			continue
+		}
+		if len(highestTag) == 0 || v.Compare(highestVersion) > 0 {
+			highestTag, highestVersion = tag, v
+		}
+	}
+	if len(highestTag) == 0 {
+		return "", fmt.Errorf("%w %q", errNoMatchingTag, semVerRange)
+	}
+	return highestTag, nil
+}
+
+func updateInterval(policy *corev1alpha1.PackageUpdatePolicy) time.Duration {
+	if policy.Interval == nil {
+		return defaultUpdateInterval
+	}
+	return policy.Interval.Duration
+}
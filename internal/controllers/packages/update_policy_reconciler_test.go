@@ -0,0 +1,188 @@
+package packages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/packages"
+	"package-operator.run/internal/testutil"
+)
+
+func TestUpdatePolicyReconciler(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		policy    corev1alpha1.PackageUpdatePolicy
+		update    *corev1alpha1.PackageUpdateStatus
+		available bool
+		// Expected image, empty when the registry must not be asked.
+		image string
+	}{
+		{
+			name:   "digest",
+			policy: corev1alpha1.PackageUpdatePolicy{Strategy: corev1alpha1.PackageUpdateStrategyDigest},
+			image:  "quay.io/pkg/test@sha256:1234",
+		},
+		{
+			name: "semver",
+			policy: corev1alpha1.PackageUpdatePolicy{
+				Strategy: corev1alpha1.PackageUpdateStrategySemVer, SemVerRange: "1.0.0-1.99.0",
+			},
+			image: "quay.io/pkg/test:v1.10.0",
+		},
+		{
+			name:   "not due yet",
+			policy: corev1alpha1.PackageUpdatePolicy{Strategy: corev1alpha1.PackageUpdateStrategyDigest},
+			update: &corev1alpha1.PackageUpdateStatus{
+				Image: "quay.io/pkg/test@sha256:old", LastCheckTime: metav1.NewTime(now.Add(-30 * time.Minute)),
+			},
+			available: true,
+		},
+		{
+			name:   "due",
+			policy: corev1alpha1.PackageUpdatePolicy{Strategy: corev1alpha1.PackageUpdateStrategyDigest},
+			update: &corev1alpha1.PackageUpdateStatus{
+				Image: "quay.io/pkg/test@sha256:old", LastCheckTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			available: true,
+			image:     "quay.io/pkg/test@sha256:1234",
+		},
+		{
+			name:   "due, but unavailable",
+			policy: corev1alpha1.PackageUpdatePolicy{Strategy: corev1alpha1.PackageUpdateStrategyDigest},
+			update: &corev1alpha1.PackageUpdateStatus{
+				Image: "quay.io/pkg/test@sha256:old", LastCheckTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ipm := &imageResolverMock{}
+			ipm.
+				On("ResolveDigest", mock.Anything, "quay.io/pkg/test:latest").
+				Return("sha256:1234", nil)
+			ipm.
+				On("ListTags", mock.Anything, "quay.io/pkg/test:latest").
+				Return([]string{"latest", "v0.9.0", "v1.2.0", "v1.10.0", "v2.0.0"}, nil)
+			r := &updatePolicyReconciler{
+				uncachedClient: testutil.NewClient(),
+				imagePuller:    ipm,
+				clock:          clocktesting.NewFakePassiveClock(now),
+			}
+
+			pkg := &adapters.GenericPackage{
+				Package: corev1alpha1.Package{
+					Spec: corev1alpha1.PackageSpec{
+						Image:        "quay.io/pkg/test:latest",
+						UpdatePolicy: &test.policy,
+					},
+					Status: corev1alpha1.PackageStatus{Update: test.update},
+				},
+			}
+			if test.available {
+				meta.SetStatusCondition(pkg.GetConditions(), metav1.Condition{
+					Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionTrue,
+				})
+			}
+
+			res, err := r.Reconcile(context.Background(), pkg)
+			require.NoError(t, err)
+			assert.True(t, res.IsZero())
+
+			if len(test.image) == 0 {
+				ipm.AssertNotCalled(t, "ResolveDigest", mock.Anything, mock.Anything)
+				ipm.AssertNotCalled(t, "ListTags", mock.Anything, mock.Anything)
+				assert.Equal(t, test.update, pkg.GetStatusUpdate())
+				return
+			}
+			assert.Equal(t, test.image, pkg.GetImage())
+			assert.Equal(t, now, pkg.GetStatusUpdate().LastCheckTime.Time)
+			assert.Equal(t, time.Hour, r.RequeueAfter(pkg))
+		})
+	}
+}
+
+func TestUpdatePolicyReconciler_lookupFailed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+	ipm := &imageResolverMock{}
+	ipm.
+		On("ResolveDigest", mock.Anything, mock.Anything).
+		Return("", errTest)
+	r := &updatePolicyReconciler{
+		uncachedClient: testutil.NewClient(),
+		imagePuller:    ipm,
+		clock:          clocktesting.NewFakePassiveClock(now),
+	}
+
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			Spec: corev1alpha1.PackageSpec{
+				Image:        "quay.io/pkg/test:latest",
+				UpdatePolicy: &corev1alpha1.PackageUpdatePolicy{Strategy: corev1alpha1.PackageUpdateStrategyDigest},
+			},
+		},
+	}
+
+	// Without a previous image, unpacking has to wait for the lookup.
+	_, err := r.Reconcile(context.Background(), pkg)
+	require.ErrorIs(t, err, errTest)
+
+	// Otherwise the previous image is kept until the next check.
+	pkg.Status.Update = &corev1alpha1.PackageUpdateStatus{
+		Image: "quay.io/pkg/test@sha256:old", LastCheckTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+	}
+	meta.SetStatusCondition(pkg.GetConditions(), metav1.Condition{
+		Type: corev1alpha1.PackageAvailable, Status: metav1.ConditionTrue,
+	})
+	_, err = r.Reconcile(context.Background(), pkg)
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io/pkg/test@sha256:old", pkg.GetImage())
+	assert.Equal(t, time.Hour, r.RequeueAfter(pkg))
+}
+
+func TestHighestMatchingTag(t *testing.T) {
+	t.Parallel()
+
+	tag, err := highestMatchingTag([]string{"1.0.0", "v1.0.1", "2.0.0", "latest"}, "1.0.0-1.5.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.1", tag)
+
+	_, err = highestMatchingTag([]string{"latest"}, "1.0.0-1.5.0")
+	require.ErrorIs(t, err, errNoMatchingTag)
+}
+
+type imageResolverMock struct {
+	imagePullerMock
+}
+
+func (m *imageResolverMock) ResolveDigest(
+	ctx context.Context, image string, _ ...packages.PullOption,
+) (string, error) {
+	args := m.Called(ctx, image)
+	return args.String(0), args.Error(1)
+}
+
+func (m *imageResolverMock) ListTags(
+	ctx context.Context, image string, _ ...packages.PullOption,
+) ([]string, error) {
+	args := m.Called(ctx, image)
+	return args.Get(0).([]string), args.Error(1)
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"cmp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// Rank of well-known kinds within their apply weight,
+// so objects are applied after the objects they depend on.
+// Kinds not listed are applied last.
+var kindApplyOrder = map[schema.GroupKind]int{
+	{Kind: "Namespace"}: 0,
+
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: 1,
+
+	{Kind: "ServiceAccount"}:                                         2,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:        2,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}: 2,
+	{Group: "rbac.authorization.k8s.io", Kind: "Role"}:               2,
+	{Group: "rbac.authorization.k8s.io", Kind: "RoleBinding"}:        2,
+
+	{Kind: "Secret"}:                3,
+	{Kind: "ConfigMap"}:             3,
+	{Kind: "PersistentVolumeClaim"}: 3,
+
+	{Kind: "Service"}: 4,
+}
+
+const defaultKindApplyOrder = 5
+
+// Returns the objects of a phase in the order they are applied.
+// Objects are ordered by their apply weight first and their kind second,
+// objects of equal weight and kind keep the order of the phase.
+func applyOrder(objects []corev1alpha1.ObjectSetObject) []corev1alpha1.ObjectSetObject {
+	ordered := slices.Clone(objects)
+	slices.SortStableFunc(ordered, func(a, b corev1alpha1.ObjectSetObject) int {
+		return cmp.Or(
+			cmp.Compare(a.ApplyWeight, b.ApplyWeight),
+			cmp.Compare(kindRank(a), kindRank(b)),
+		)
+	})
+	return ordered
+}
+
+func kindRank(obj corev1alpha1.ObjectSetObject) int {
+	if rank, ok := kindApplyOrder[obj.Object.GroupVersionKind().GroupKind()]; ok {
+		return rank
+	}
+	return defaultKindApplyOrder
+}
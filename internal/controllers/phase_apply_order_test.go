@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestApplyOrder(t *testing.T) {
+	t.Parallel()
+
+	obj := func(apiVersion, kind, name string, weight int32) corev1alpha1.ObjectSetObject {
+		return corev1alpha1.ObjectSetObject{
+			Object: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": apiVersion,
+				"kind":       kind,
+				"metadata":   map[string]any{"name": name},
+			}},
+			ApplyWeight: weight,
+		}
+	}
+	objects := []corev1alpha1.ObjectSetObject{
+		obj("apps/v1", "Deployment", "operator", 0),
+		obj("v1", "Secret", "credentials", 0),
+		obj("example.com/v1", "Example", "example", -1),
+		obj("v1", "Service", "operator", 0),
+		obj("rbac.authorization.k8s.io/v1", "ClusterRole", "operator", 0),
+		obj("v1", "ConfigMap", "config", 0),
+		obj("apiextensions.k8s.io/v1", "CustomResourceDefinition", "examples.example.com", 0),
+		obj("v1", "Namespace", "operator-system", 0),
+		obj("batch/v1", "Job", "migrate", 10),
+	}
+
+	ordered := applyOrder(objects)
+	names := make([]string, len(ordered))
+	for i, o := range ordered {
+		names[i] = o.Object.GetKind() + "/" + o.Object.GetName()
+	}
+	assert.Equal(t, []string{
+		"Example/example",
+		"Namespace/operator-system",
+		"CustomResourceDefinition/examples.example.com",
+		"ClusterRole/operator",
+		"Secret/credentials",
+		"ConfigMap/config",
+		"Service/operator",
+		"Deployment/operator",
+		"Job/migrate",
+	}, names)
+	// The given objects are left untouched.
+	assert.Equal(t, "Deployment", objects[0].Object.GetKind())
+}
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"package-operator.run/internal/constants"
+)
+
+// Implemented by owners that are archived, when a newer revision takes over their objects.
+type archivableOwner interface {
+	IsArchived() bool
+}
+
+// Archived owners have freed their watches already and don't watch the objects they tear down,
+// so watch counts stay proportional to active revisions.
+func isOwnerArchived(owner PhaseObjectOwner) bool {
+	o, ok := owner.(archivableOwner)
+	return ok && o.IsArchived()
+}
+
+// Removes the dynamic cache label from objects released by an archived owner,
+// when no other owner is controlling and watching them.
+func (r *PhaseReconciler) releaseDynamicCacheLabel(owner PhaseObjectOwner, obj *unstructured.Unstructured) {
+	if !isOwnerArchived(owner) || r.ownerStrategy.HasController(obj) {
+		return
+	}
+
+	labels := obj.GetLabels()
+	delete(labels, constants.DynamicCacheLabel)
+	obj.SetLabels(labels)
+}
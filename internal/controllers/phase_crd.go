@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/pkg/probing"
+)
+
+// CustomResourceDefinitions have to be Established before objects of their kind can be created,
+// so subsequent phases only start after the CustomResourceDefinitions of a phase are served.
+var customResourceDefinitionProbe = probing.And{
+	&probing.ConditionProbe{Type: "NamesAccepted", Status: "True"},
+	&probing.ConditionProbe{Type: "Established", Status: "True"},
+}
+
+func isCustomResourceDefinition(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "apiextensions.k8s.io" && gvk.Kind == "CustomResourceDefinition"
+}
+
+// Probes CustomResourceDefinitions for being Established, in addition to the probes of the owner.
+func probeCustomResourceDefinition(
+	owner PhaseObjectOwner, rec *recordingProbe, actualObj *unstructured.Unstructured,
+) {
+	if !isCustomResourceDefinition(actualObj) {
+		return
+	}
+	if ok, msg := customResourceDefinitionProbe.Probe(actualObj); !ok {
+		rec.recordForObj(actualObj, msg)
+	}
+
+	if reason, msg, ok := namesConflict(actualObj); ok {
+		reportCRDNamesConflict(owner, actualObj, reason, msg)
+	}
+}
+
+// Returns the reason and message of the NamesAccepted condition, when it is False.
+func namesConflict(crd *unstructured.Unstructured) (reason, msg string, ok bool) {
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, isMap := c.(map[string]any)
+		if !isMap || cond["type"] != "NamesAccepted" || cond["status"] != string(metav1.ConditionFalse) {
+			continue
+		}
+		reason, _ = cond["reason"].(string)
+		msg, _ = cond["message"].(string)
+		return reason, msg, true
+	}
+	return "", "", false
+}
+
+// Sets the CRDNamesConflict condition, adding the CustomResourceDefinition to the message
+// when the names of multiple CustomResourceDefinitions conflict.
+func reportCRDNamesConflict(
+	owner PhaseObjectOwner, crd *unstructured.Unstructured, reason, msg string,
+) {
+	msg = fmt.Sprintf("%s: %s", crd.GetName(), msg)
+	if len(reason) == 0 {
+		reason = "NamesNotAccepted"
+	}
+
+	// The condition is removed before reconciling phases,
+	// so an existing condition was reported for another object during this reconcile.
+	if cond := meta.FindStatusCondition(
+		*owner.GetConditions(), corev1alpha1.ObjectSetCRDNamesConflict); cond != nil {
+		msg = cond.Message + ", " + msg
+		reason = cond.Reason
+	}
+	meta.SetStatusCondition(owner.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetCRDNamesConflict,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: owner.ClientObject().GetGeneration(),
+	})
+}
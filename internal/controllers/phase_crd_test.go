@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestProbeCustomResourceDefinition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		kind             string
+		conditions       []any
+		expectedFailures int
+		expectedConflict bool
+	}{
+		{
+			name: "not a crd",
+			kind: "ConfigMap",
+		},
+		{
+			name:             "no status",
+			kind:             "CustomResourceDefinition",
+			expectedFailures: 1,
+		},
+		{
+			name: "established",
+			kind: "CustomResourceDefinition",
+			conditions: []any{
+				map[string]any{"type": "NamesAccepted", "status": "True"},
+				map[string]any{"type": "Established", "status": "True"},
+			},
+		},
+		{
+			name: "names conflict",
+			kind: "CustomResourceDefinition",
+			conditions: []any{
+				map[string]any{
+					"type": "NamesAccepted", "status": "False",
+					"reason": "MultipleNamesNotAllowed", "message": "plural is already in use",
+				},
+				map[string]any{"type": "Established", "status": "False"},
+			},
+			expectedFailures: 1,
+			expectedConflict: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			conditions := []metav1.Condition{}
+			owner := &phaseObjectOwnerMock{}
+			owner.On("ClientObject").Return(&unstructured.Unstructured{})
+			owner.On("GetConditions").Return(&conditions)
+
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("apiextensions.k8s.io/v1")
+			obj.SetKind(test.kind)
+			obj.SetName("tests.example.com")
+			if test.conditions != nil {
+				_ = unstructured.SetNestedSlice(obj.Object, test.conditions, "status", "conditions")
+			}
+
+			rec := newRecordingProbe("crds", nil)
+			probeCustomResourceDefinition(owner, &rec, obj)
+			assert.Len(t, rec.failures, test.expectedFailures)
+
+			cond := meta.FindStatusCondition(conditions, corev1alpha1.ObjectSetCRDNamesConflict)
+			if !test.expectedConflict {
+				assert.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			assert.Equal(t, "MultipleNamesNotAllowed", cond.Reason)
+			assert.Equal(t, "tests.example.com: plural is already in use", cond.Message)
+		})
+	}
+}
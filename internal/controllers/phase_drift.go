@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// Implemented by owners that keep a summary of the changes applied to their objects.
+type objectChangeOwner interface {
+	RecordObjectChange(change corev1alpha1.ObjectChange)
+}
+
+// Implemented by owners that configure the detection of drifted objects.
+type driftDetectionOwner interface {
+	GetDriftDetection() *corev1alpha1.ObjectSetDriftDetection
+}
+
+// Returns true if drifted objects of the owner are only reported, instead of corrected.
+func isDriftDetectOnly(owner PhaseObjectOwner) bool {
+	o, ok := owner.(driftDetectionOwner)
+	if !ok {
+		return false
+	}
+	driftDetection := o.GetDriftDetection()
+	return driftDetection != nil && driftDetection.Remediation == corev1alpha1.DriftRemediationDetectOnly
+}
+
+// Sets the Drifted condition, adding the object to the message
+// when multiple objects drifted.
+func reportDrift(owner PhaseObjectOwner, obj *unstructured.Unstructured, changes []string) {
+	msg := fmt.Sprintf("%s %s/%s: %s",
+		obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName(), strings.Join(changes, ", "))
+
+	// The condition is removed before reconciling phases,
+	// so an existing condition was reported for another object during this reconcile.
+	if cond := meta.FindStatusCondition(
+		*owner.GetConditions(), corev1alpha1.ObjectSetDrifted); cond != nil {
+		msg = cond.Message + "; " + msg
+	}
+	meta.SetStatusCondition(owner.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetDrifted,
+		Status:             metav1.ConditionTrue,
+		Reason:             "DriftDetected",
+		Message:            msg,
+		ObservedGeneration: owner.ClientObject().GetGeneration(),
+	})
+}
+
+// Records a change applied to an object with the owner, if it keeps a summary of changes.
+// Changes to objects taken over from previous revisions are caused by the new revision,
+// all other changes correct drift.
+func (r *PhaseReconciler) recordObjectChange(
+	owner PhaseObjectOwner, currentObj *unstructured.Unstructured,
+	changes []string, newRevision bool,
+) {
+	o, ok := owner.(objectChangeOwner)
+	if !ok {
+		return
+	}
+
+	gvk := currentObj.GroupVersionKind()
+	change := corev1alpha1.ObjectChange{
+		Object: corev1alpha1.ControlledObjectReference{
+			Kind:      gvk.Kind,
+			Group:     gvk.Group,
+			Name:      currentObj.GetName(),
+			Namespace: currentObj.GetNamespace(),
+		},
+		Reason:         corev1alpha1.ObjectChangeReasonDriftCorrected,
+		FieldsChanged:  int32(len(changes)), //nolint:gosec
+		LastChangeTime: metav1.NewTime(r.clock.Now()),
+	}
+	if newRevision {
+		change.Reason = corev1alpha1.ObjectChangeReasonNewRevision
+	} else {
+		change.FieldManager = lastFieldManager(currentObj, fieldManagerFor(owner))
+	}
+	o.RecordObjectChange(change)
+}
+
+// Returns the field manager that modified the object last,
+// ignoring Package Operator itself using its default or the given field manager.
+// Changes to subresources like the status are ignored, because they are never patched.
+func lastFieldManager(obj client.Object, fieldManager string) string {
+	var (
+		manager  string
+		lastTime time.Time
+	)
+	for _, entry := range obj.GetManagedFields() {
+		if oldFieldOwners.Has(entry.Manager) || entry.Manager == fieldManager ||
+			len(entry.Subresource) > 0 || entry.Time == nil {
+			continue
+		}
+		if len(manager) == 0 || entry.Time.After(lastTime) {
+			manager, lastTime = entry.Manager, entry.Time.Time
+		}
+	}
+	return manager
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"package-operator.run/internal/constants"
+)
+
+func TestLastFieldManager(t *testing.T) {
+	t.Parallel()
+
+	at := func(minutes int) *metav1.Time {
+		ts := metav1.NewTime(time.Date(2024, 6, 3, 12, minutes, 0, 0, time.UTC))
+		return &ts
+	}
+
+	obj := &unstructured.Unstructured{}
+	assert.Empty(t, lastFieldManager(obj, ""))
+
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: at(1)},
+		{Manager: "kubectl-patch", Operation: metav1.ManagedFieldsOperationUpdate, Time: at(2)},
+		{Manager: constants.FieldOwner, Operation: metav1.ManagedFieldsOperationApply, Time: at(3)},
+		{Manager: "kube-controller-manager", Subresource: "status", Time: at(4)},
+	})
+	assert.Equal(t, "kubectl-patch", lastFieldManager(obj, ""))
+	assert.Equal(t, "kubectl-edit", lastFieldManager(obj, "kubectl-patch"))
+}
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/pkg/probing"
+)
+
+// Returned for missing external objects that should not block their phase.
+var errExternalObjectSkipped = errors.New("missing external object skipped")
+
+// Looks up an object created outside of Package Operator.
+// While waiting for a missing object, a NewExternalResourceNotFoundError is returned.
+// After the timeout, missing objects block the phase by returning a NotFound error
+// or are skipped by returning errExternalObjectSkipped, depending on the missing policy.
+func (r *PhaseReconciler) observeExternalObject(
+	ctx context.Context, owner PhaseObjectOwner,
+	external *corev1alpha1.ObjectSetObjectExternal,
+	desiredObj *unstructured.Unstructured,
+) (*unstructured.Unstructured, error) {
+	// External objects don't carry the cache label, so they are not in the dynamic cache.
+	actualObj := &unstructured.Unstructured{}
+	actualObj.SetGroupVersionKind(desiredObj.GroupVersionKind())
+	err := r.uncachedClient.Get(ctx, client.ObjectKeyFromObject(desiredObj), actualObj)
+	if err == nil {
+		return actualObj, nil
+	}
+	if !apimachineryerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting external object: %w", err)
+	}
+
+	if external.Timeout == nil {
+		reportExternalObjectMissing(owner, desiredObj, "Waiting", "waiting to be created")
+		return nil, NewExternalResourceNotFoundError(desiredObj)
+	}
+	deadline := owner.ClientObject().GetCreationTimestamp().Add(external.Timeout.Duration)
+	if r.clock.Now().Before(deadline) {
+		reportExternalObjectMissing(owner, desiredObj, "Waiting",
+			fmt.Sprintf("waiting to be created until %s", deadline.UTC().Format(time.RFC3339)))
+		return nil, NewExternalResourceNotFoundError(desiredObj)
+	}
+
+	if external.MissingPolicy == corev1alpha1.ExternalObjectMissingPolicyWarn {
+		reportExternalObjectMissing(owner, desiredObj, "Timeout", "not created in time, continuing without it")
+		return nil, errExternalObjectSkipped
+	}
+	reportExternalObjectMissing(owner, desiredObj, "Timeout", "not created in time")
+	return nil, err
+}
+
+// Probes an external object with the probes configured for it.
+func probeExternalObject(
+	ctx context.Context, rec *recordingProbe,
+	external *corev1alpha1.ObjectSetObjectExternal, actualObj *unstructured.Unstructured,
+) error {
+	if len(external.Probes) == 0 {
+		return nil
+	}
+	probe, err := probing.ParseProbes(ctx, external.Probes)
+	if err != nil {
+		return fmt.Errorf("parsing external object probes: %w", err)
+	}
+	if ok, msg := probe.Probe(actualObj); !ok {
+		rec.recordForObj(actualObj, msg)
+	}
+	return nil
+}
+
+// Sets the ExternalObjectMissing condition, adding the object to the message
+// when multiple external objects are missing.
+func reportExternalObjectMissing(
+	owner PhaseObjectOwner, obj *unstructured.Unstructured, reason, msg string,
+) {
+	gvk := obj.GroupVersionKind()
+	msg = fmt.Sprintf("%s %s %s/%s: %s", gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName(), msg)
+
+	// The condition is removed before reconciling phases,
+	// so an existing condition was reported for another object during this reconcile.
+	if cond := meta.FindStatusCondition(
+		*owner.GetConditions(), corev1alpha1.ObjectSetExternalObjectMissing); cond != nil {
+		msg = cond.Message + ", " + msg
+		reason = cond.Reason
+	}
+	meta.SetStatusCondition(owner.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetExternalObjectMissing,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: owner.ClientObject().GetGeneration(),
+	})
+}
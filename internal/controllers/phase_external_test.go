@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/testutil"
+)
+
+func TestPhaseReconciler_observeExternalObject(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		found          bool
+		external       corev1alpha1.ObjectSetObjectExternal
+		now            time.Time
+		expectedReason string
+		assertErr      func(t *testing.T, err error)
+	}{
+		{
+			name:  "found",
+			found: true,
+			now:   created,
+			assertErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:           "waiting without timeout",
+			now:            created.Add(time.Hour),
+			expectedReason: "Waiting",
+			assertErr: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsExternalResourceNotFound(err))
+			},
+		},
+		{
+			name: "waiting until timeout",
+			external: corev1alpha1.ObjectSetObjectExternal{
+				Timeout: &metav1.Duration{Duration: time.Hour},
+			},
+			now:            created.Add(time.Minute),
+			expectedReason: "Waiting",
+			assertErr: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsExternalResourceNotFound(err))
+			},
+		},
+		{
+			name: "timeout blocks",
+			external: corev1alpha1.ObjectSetObjectExternal{
+				Timeout:       &metav1.Duration{Duration: time.Hour},
+				MissingPolicy: corev1alpha1.ExternalObjectMissingPolicyBlock,
+			},
+			now:            created.Add(2 * time.Hour),
+			expectedReason: "Timeout",
+			assertErr: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, apimachineryerrors.IsNotFound(err))
+			},
+		},
+		{
+			name: "timeout warns",
+			external: corev1alpha1.ObjectSetObjectExternal{
+				Timeout:       &metav1.Duration{Duration: time.Hour},
+				MissingPolicy: corev1alpha1.ExternalObjectMissingPolicyWarn,
+			},
+			now:            created.Add(2 * time.Hour),
+			expectedReason: "Timeout",
+			assertErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, errExternalObjectSkipped)
+			},
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			uncachedClient := testutil.NewClient()
+			r := &PhaseReconciler{
+				uncachedClient: uncachedClient,
+				clock:          clocktesting.NewFakePassiveClock(test.now),
+			}
+
+			conditions := []metav1.Condition{}
+			owner := &phaseObjectOwnerMock{}
+			ownerObj := &unstructured.Unstructured{}
+			ownerObj.SetCreationTimestamp(metav1.NewTime(created))
+			owner.On("ClientObject").Return(ownerObj)
+			owner.On("GetConditions").Return(&conditions)
+
+			if test.found {
+				uncachedClient.
+					On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			} else {
+				uncachedClient.
+					On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+			}
+
+			desiredObj := &unstructured.Unstructured{}
+			desiredObj.SetAPIVersion("v1")
+			desiredObj.SetKind("Secret")
+			desiredObj.SetName("credentials")
+			desiredObj.SetNamespace("test")
+
+			_, err := r.observeExternalObject(context.Background(), owner, &test.external, desiredObj)
+			test.assertErr(t, err)
+
+			cond := meta.FindStatusCondition(conditions, corev1alpha1.ObjectSetExternalObjectMissing)
+			if len(test.expectedReason) == 0 {
+				assert.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			assert.Equal(t, test.expectedReason, cond.Reason)
+		})
+	}
+}
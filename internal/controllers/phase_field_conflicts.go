@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/pkg/phasecontroller"
+)
+
+// Implemented by owners that configure whether fields owned by other field managers are taken over.
+type fieldConflictPolicyOwner interface {
+	GetFieldConflictPolicy() corev1alpha1.FieldConflictPolicy
+}
+
+// Implemented by owners that report objects with fields owned by other field managers.
+type fieldConflictOwner interface {
+	RecordFieldConflict(conflict corev1alpha1.ObjectFieldConflict)
+}
+
+// Returns the field conflict policy of the object, falling back to the policy of the owner.
+func fieldConflictPolicyFor(
+	owner PhaseObjectOwner, phaseObject corev1alpha1.ObjectSetObject,
+) corev1alpha1.FieldConflictPolicy {
+	if len(phaseObject.FieldConflictPolicy) > 0 {
+		return phaseObject.FieldConflictPolicy
+	}
+	if o, ok := owner.(fieldConflictPolicyOwner); ok && len(o.GetFieldConflictPolicy()) > 0 {
+		return o.GetFieldConflictPolicy()
+	}
+	return corev1alpha1.FieldConflictPolicyForce
+}
+
+// Matches the field manager in messages of field manager conflicts,
+// e.g. `conflict with "kubectl-edit" using v1`.
+var conflictingFieldManagerRegexp = regexp.MustCompile(`^conflict with "([^"]*)"`)
+
+// Reports the fields of the object owned by other field managers with the owner,
+// if applying the object failed because of them and the owner reports field conflicts.
+func recordFieldConflict(owner PhaseObjectOwner, obj *unstructured.Unstructured, err error) {
+	o, ok := owner.(fieldConflictOwner)
+	if !ok {
+		return
+	}
+	causes := phasecontroller.FieldManagerConflicts(err)
+	if len(causes) == 0 {
+		return
+	}
+
+	gvk := obj.GroupVersionKind()
+	conflict := corev1alpha1.ObjectFieldConflict{
+		Object: corev1alpha1.ControlledObjectReference{
+			Kind:      gvk.Kind,
+			Group:     gvk.Group,
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+		},
+	}
+	for _, cause := range causes {
+		manager := cause.Message
+		if match := conflictingFieldManagerRegexp.FindStringSubmatch(cause.Message); match != nil {
+			manager = match[1]
+		}
+		if !slices.Contains(conflict.FieldManagers, manager) {
+			conflict.FieldManagers = append(conflict.FieldManagers, manager)
+		}
+		conflict.Fields = append(conflict.Fields, cause.Field)
+	}
+	o.RecordFieldConflict(conflict)
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/audit"
+)
+
+// Successor is a revision replacing the owner of phase objects.
+type Successor interface {
+	ClientObject() client.Object
+	GetRevision() int64
+	GetPhases() []corev1alpha1.ObjectSetTemplatePhase
+}
+
+// Implemented by owners that know the revisions replacing them.
+// Objects still part of a successor are handed over to it on teardown instead of being deleted,
+// so objects moving between phases across revisions are updated in place, once the successor reaches them.
+type successorOwner interface {
+	GetSuccessors() []Successor
+}
+
+// Returns the successor of the owner that contains the given object in any of its phases, if any.
+// Objects are matched by group, kind, name and namespace, independent of the phase they are in.
+func successorContaining(owner PhaseObjectOwner, obj client.Object) Successor {
+	o, ok := owner.(successorOwner)
+	if !ok {
+		return nil
+	}
+
+	gk := obj.GetObjectKind().GroupVersionKind().GroupKind()
+	for _, successor := range o.GetSuccessors() {
+		if successor.GetRevision() <= owner.GetRevision() {
+			continue
+		}
+		for _, phase := range successor.GetPhases() {
+			for _, phaseObject := range phase.Objects {
+				if phaseObject.External != nil {
+					continue
+				}
+				declared := &phaseObject.Object
+				namespace := declared.GetNamespace()
+				if len(namespace) == 0 && len(obj.GetNamespace()) > 0 {
+					// Namespace defaults to the namespace of the successor.
+					namespace = successor.ClientObject().GetNamespace()
+				}
+				if declared.GroupVersionKind().GroupKind() == gk &&
+					declared.GetName() == obj.GetName() &&
+					namespace == obj.GetNamespace() {
+					return successor
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Hands the object over to a successor of the owner containing it, instead of deleting it.
+// The successor becomes controller right away and updates the object when reconciling the phase containing it.
+// Returns true when the object was handed over.
+func (r *PhaseReconciler) handoverToSuccessor(
+	ctx context.Context, owner PhaseObjectOwner, currentObj client.Object,
+) (handedOver bool, err error) {
+	successor := successorContaining(owner, currentObj)
+	if successor == nil {
+		return false, nil
+	}
+
+	logr.FromContextOrDiscard(ctx).Info("handing over managed object to successor",
+		"apiVersion", currentObj.GetObjectKind().GroupVersionKind().GroupVersion().String(),
+		"kind", currentObj.GetObjectKind().GroupVersionKind().Kind,
+		"namespace", currentObj.GetNamespace(),
+		"name", currentObj.GetName(),
+		"successor", successor.ClientObject().GetName())
+
+	r.ownerStrategy.RemoveOwner(owner.ClientObject(), currentObj)
+	if err := r.ownerStrategy.SetControllerReference(successor.ClientObject(), currentObj); err != nil {
+		return false, fmt.Errorf("setting successor as controller: %w", err)
+	}
+	setObjectRevision(currentObj, successor.GetRevision())
+
+	err = r.writer.Update(ctx, currentObj)
+	r.recordAudit(ctx, audit.OperationPatch, owner, currentObj, []string{"metadata.ownerReferences"}, err)
+	if err != nil {
+		return false, fmt.Errorf("handing over object to successor: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,147 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/testutil"
+)
+
+type successorStub struct {
+	obj      *corev1alpha1.ObjectSet
+	revision int64
+	phases   []corev1alpha1.ObjectSetTemplatePhase
+}
+
+func (s *successorStub) ClientObject() client.Object { return s.obj }
+func (s *successorStub) GetRevision() int64          { return s.revision }
+func (s *successorStub) GetPhases() []corev1alpha1.ObjectSetTemplatePhase {
+	return s.phases
+}
+
+func newHandoverObject(kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func newHandoverSuccessor(revision int64, objs ...*unstructured.Unstructured) *successorStub {
+	phase := corev1alpha1.ObjectSetTemplatePhase{Name: "deploy"}
+	for _, obj := range objs {
+		phase.Objects = append(phase.Objects, corev1alpha1.ObjectSetObject{Object: *obj})
+	}
+	return &successorStub{
+		obj: &corev1alpha1.ObjectSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-v2", Namespace: "test-ns"},
+		},
+		revision: revision,
+		phases:   []corev1alpha1.ObjectSetTemplatePhase{phase},
+	}
+}
+
+func TestSuccessorContaining(t *testing.T) {
+	t.Parallel()
+
+	current := newHandoverObject("Deployment", "test-ns", "app")
+
+	tests := []struct {
+		name       string
+		successors []Successor
+		found      bool
+	}{
+		{
+			name:       "same object in other phase",
+			successors: []Successor{newHandoverSuccessor(2, current)},
+			found:      true,
+		},
+		{
+			name:       "namespace defaulted",
+			successors: []Successor{newHandoverSuccessor(2, newHandoverObject("Deployment", "", "app"))},
+			found:      true,
+		},
+		{
+			name:       "other name",
+			successors: []Successor{newHandoverSuccessor(2, newHandoverObject("Deployment", "test-ns", "other"))},
+		},
+		{
+			name:       "other kind",
+			successors: []Successor{newHandoverSuccessor(2, newHandoverObject("StatefulSet", "test-ns", "app"))},
+		},
+		{
+			name:       "older revision",
+			successors: []Successor{newHandoverSuccessor(1, current)},
+		},
+		{
+			name: "no successors",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			owner := &successorOwnerMock{}
+			owner.On("GetRevision").Return(int64(1))
+			owner.On("GetSuccessors").Return(test.successors)
+
+			successor := successorContaining(owner, current)
+			if test.found {
+				assert.Equal(t, test.successors[0], successor)
+			} else {
+				assert.Nil(t, successor)
+			}
+		})
+	}
+
+	t.Run("owner without successors", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, successorContaining(&phaseObjectOwnerMock{}, current))
+	})
+}
+
+func TestPhaseReconciler_handoverToSuccessor(t *testing.T) {
+	t.Parallel()
+
+	testClient := testutil.NewClient()
+	ownerStrategy := &ownerStrategyMock{}
+	r := &PhaseReconciler{
+		writer:        testClient,
+		ownerStrategy: ownerStrategy,
+	}
+
+	current := newHandoverObject("Deployment", "test-ns", "app")
+	successor := newHandoverSuccessor(2, current)
+
+	ownerObj := &corev1alpha1.ObjectSet{}
+	owner := &successorOwnerMock{}
+	owner.On("ClientObject").Return(ownerObj)
+	owner.On("GetRevision").Return(int64(1))
+	owner.On("GetSuccessors").Return([]Successor{successor})
+
+	ownerStrategy.On("RemoveOwner", ownerObj, current)
+	ownerStrategy.
+		On("SetControllerReference", successor.obj, current).
+		Return(nil)
+	testClient.
+		On("Update", mock.Anything, current, mock.Anything).
+		Return(nil)
+
+	handedOver, err := r.handoverToSuccessor(context.Background(), owner, current)
+	require.NoError(t, err)
+	assert.True(t, handedOver)
+	assert.Equal(t, "2", current.GetAnnotations()[corev1alpha1.ObjectSetRevisionAnnotation])
+	ownerStrategy.AssertExpectations(t)
+	testClient.AssertExpectations(t)
+}
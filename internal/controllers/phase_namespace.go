@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+const namespaceTerminatingMessagePrefix = "skipping objects in terminating namespaces: "
+
+// Returns true for errors of the API server, rejecting to create objects in a namespace that is being deleted.
+func isNamespaceTerminating(err error) bool {
+	return apimachineryerrors.HasStatusCause(err, corev1.NamespaceTerminatingCause)
+}
+
+// Returns true when the given namespace is being deleted or already gone.
+func (r *PhaseReconciler) namespaceTerminating(ctx context.Context, namespace string) (bool, error) {
+	if len(namespace) == 0 {
+		return false, nil
+	}
+
+	ns := &corev1.Namespace{}
+	err := r.uncachedClient.Get(ctx, client.ObjectKey{Name: namespace}, ns)
+	if apimachineryerrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting namespace: %w", err)
+	}
+	return !ns.DeletionTimestamp.IsZero() || ns.Status.Phase == corev1.NamespaceTerminating, nil
+}
+
+// Sets the NamespaceTerminating condition, adding the namespace to the message
+// when objects in multiple terminating namespaces are skipped.
+func reportNamespaceTerminating(owner PhaseObjectOwner, namespace string) {
+	namespaces := []string{namespace}
+
+	// The condition is removed before reconciling phases,
+	// so an existing condition was reported for another object during this reconcile.
+	if cond := meta.FindStatusCondition(
+		*owner.GetConditions(), corev1alpha1.ObjectSetNamespaceTerminating); cond != nil {
+		reported := strings.Split(strings.TrimPrefix(cond.Message, namespaceTerminatingMessagePrefix), ", ")
+		if slices.Contains(reported, namespace) {
+			return
+		}
+		namespaces = append(reported, namespace)
+	}
+	meta.SetStatusCondition(owner.GetConditions(), metav1.Condition{
+		Type:               corev1alpha1.ObjectSetNamespaceTerminating,
+		Status:             metav1.ConditionTrue,
+		Reason:             "NamespaceTerminating",
+		Message:            namespaceTerminatingMessagePrefix + strings.Join(namespaces, ", "),
+		ObservedGeneration: owner.ClientObject().GetGeneration(),
+	})
+}
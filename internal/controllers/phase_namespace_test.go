@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/preflight"
+	"package-operator.run/internal/testutil"
+)
+
+func TestIsNamespaceTerminating(t *testing.T) {
+	t.Parallel()
+
+	err := apimachineryerrors.NewForbidden(
+		schema.GroupResource{Resource: "configmaps"}, "test", errors.New("namespace test is being terminated"))
+	assert.False(t, isNamespaceTerminating(err))
+
+	err.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: corev1.NamespaceTerminatingCause}}
+	assert.True(t, isNamespaceTerminating(err))
+	assert.False(t, isNamespaceTerminating(nil))
+}
+
+func TestReportNamespaceTerminating(t *testing.T) {
+	t.Parallel()
+
+	conditions := []metav1.Condition{}
+	owner := &phaseObjectOwnerMock{}
+	owner.On("ClientObject").Return(&unstructured.Unstructured{})
+	owner.On("GetConditions").Return(&conditions)
+
+	reportNamespaceTerminating(owner, "a")
+	reportNamespaceTerminating(owner, "b")
+	reportNamespaceTerminating(owner, "a")
+
+	cond := meta.FindStatusCondition(conditions, corev1alpha1.ObjectSetNamespaceTerminating)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "skipping objects in terminating namespaces: a, b", cond.Message)
+}
+
+func TestPhaseReconciler_TeardownPhase_terminatingNamespace(t *testing.T) {
+	t.Parallel()
+
+	dynamicCache := &dynamicCacheMock{}
+	uncachedClient := testutil.NewClient()
+	ownerStrategy := &ownerStrategyMock{}
+	testClient := testutil.NewClient()
+	preflightChecker := &preflightCheckerMock{}
+	r := &PhaseReconciler{
+		dynamicCache:     dynamicCache,
+		uncachedClient:   uncachedClient,
+		ownerStrategy:    ownerStrategy,
+		writer:           testClient,
+		preflightChecker: preflightChecker,
+	}
+
+	conditions := []metav1.Condition{}
+	owner := &phaseObjectOwnerMock{}
+	ownerObj := &unstructured.Unstructured{}
+	owner.On("ClientObject").Return(ownerObj)
+	owner.On("GetConditions").Return(&conditions)
+	owner.On("GetRevision").Return(int64(1))
+
+	preflightChecker.
+		On("Check", mock.Anything, mock.Anything, mock.Anything).
+		Return([]preflight.Violation{}, nil)
+	dynamicCache.
+		On("Watch", mock.Anything, ownerObj, mock.Anything).
+		Return(nil)
+	uncachedClient.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			switch out := args.Get(2).(type) {
+			case *unstructured.Unstructured:
+				out.SetNamespace("test")
+			case *corev1.Namespace:
+				out.Status.Phase = corev1.NamespaceTerminating
+			}
+		}).
+		Return(nil)
+	ownerStrategy.
+		On("IsOwner", ownerObj, mock.Anything).
+		Return(true)
+
+	obj := unstructured.Unstructured{}
+	obj.SetNamespace("test")
+	done, err := r.TeardownPhase(context.Background(), owner, corev1alpha1.ObjectSetTemplatePhase{
+		Objects: []corev1alpha1.ObjectSetObject{
+			{
+				Object:         obj,
+				DeletionPolicy: corev1alpha1.ObjectDeletionPolicyOrphan,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, done)
+
+	// Objects in terminating namespaces are left to the namespace deletion.
+	testClient.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	assert.True(t, meta.IsStatusConditionTrue(conditions, corev1alpha1.ObjectSetNamespaceTerminating))
+}
@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// Implemented by owners that report the state of their objects.
+type objectStatusOwner interface {
+	RecordObjectStatus(status corev1alpha1.ObjectStatus)
+}
+
+// Reports the state of the object with the owner, if it reports the state of its objects.
+func recordObjectStatus(
+	owner PhaseObjectOwner, obj *unstructured.Unstructured,
+	state corev1alpha1.ObjectState, msg string,
+) {
+	o, ok := owner.(objectStatusOwner)
+	if !ok {
+		return
+	}
+
+	o.RecordObjectStatus(corev1alpha1.ObjectStatus{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		State:      state,
+		Message:    msg,
+	})
+}
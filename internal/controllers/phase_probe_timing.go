@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// Implemented by owners that keep track of the objects slowest to pass their probes.
+type probeTimingOwner interface {
+	RecordProbeFailure(phase string, obj corev1alpha1.ControlledObjectReference, observed time.Time)
+}
+
+// Reports that the object failed its probes to the owner, if it keeps track of probe timings.
+func (r *PhaseReconciler) recordProbeFailure(
+	owner PhaseObjectOwner, phase string, obj *unstructured.Unstructured,
+) {
+	o, ok := owner.(probeTimingOwner)
+	if !ok {
+		return
+	}
+
+	gvk := obj.GroupVersionKind()
+	o.RecordProbeFailure(phase, corev1alpha1.ControlledObjectReference{
+		Kind:      gvk.Kind,
+		Group:     gvk.Group,
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}, r.clock.Now())
+}
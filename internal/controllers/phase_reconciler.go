@@ -1,15 +1,20 @@
 package controllers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,14 +24,18 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/audit"
 	"package-operator.run/internal/constants"
+	"package-operator.run/internal/errorclass"
 	"package-operator.run/internal/preflight"
+	"package-operator.run/internal/tracing"
 	"package-operator.run/pkg/probing"
 )
 
@@ -42,6 +51,10 @@ type PhaseReconciler struct {
 	adoptionChecker  adoptionChecker
 	patcher          patcher
 	preflightChecker preflightChecker
+	auditSink        audit.Sink
+	clock            clock.PassiveClock
+	// Cluster-scoped kinds the cluster admin allows namespaced owners to contain.
+	allowedClusterScopedKinds []schema.GroupKind
 }
 
 type ownerStrategy interface {
@@ -65,7 +78,7 @@ type adoptionChecker interface {
 
 type patcher interface {
 	Patch(
-		ctx context.Context,
+		ctx context.Context, fieldManager string, force bool,
 		desiredObj, currentObj, updatedObj *unstructured.Unstructured,
 	) error
 }
@@ -90,16 +103,21 @@ func NewPhaseReconciler(
 	uncachedClient client.Reader,
 	ownerStrategy ownerStrategy,
 	preflightChecker preflightChecker,
+	auditSink audit.Sink,
+	allowedClusterScopedKinds []schema.GroupKind,
 ) *PhaseReconciler {
 	return &PhaseReconciler{
-		scheme:           scheme,
-		writer:           writer,
-		dynamicCache:     dynamicCache,
-		uncachedClient:   uncachedClient,
-		ownerStrategy:    ownerStrategy,
-		adoptionChecker:  &defaultAdoptionChecker{ownerStrategy: ownerStrategy, scheme: scheme},
-		patcher:          &defaultPatcher{writer: writer},
-		preflightChecker: preflightChecker,
+		scheme:                    scheme,
+		writer:                    writer,
+		dynamicCache:              dynamicCache,
+		uncachedClient:            uncachedClient,
+		ownerStrategy:             ownerStrategy,
+		adoptionChecker:           &defaultAdoptionChecker{ownerStrategy: ownerStrategy, scheme: scheme},
+		patcher:                   &defaultPatcher{writer: writer},
+		preflightChecker:          preflightChecker,
+		auditSink:                 auditSink,
+		clock:                     clock.RealClock{},
+		allowedClusterScopedKinds: allowedClusterScopedKinds,
 	}
 }
 
@@ -110,6 +128,19 @@ type PhaseObjectOwner interface {
 	IsPaused() bool
 }
 
+// Implemented by owners that configure the field manager their objects are applied with.
+type fieldManagerOwner interface {
+	GetFieldManager() string
+}
+
+// Returns the field manager to apply objects of the owner with.
+func fieldManagerFor(owner PhaseObjectOwner) string {
+	if o, ok := owner.(fieldManagerOwner); ok && len(o.GetFieldManager()) > 0 {
+		return o.GetFieldManager()
+	}
+	return constants.FieldOwner
+}
+
 func newRecordingProbe(name string, probe probing.Prober) recordingProbe {
 	return recordingProbe{
 		name:  name,
@@ -174,52 +205,133 @@ func (e *ProbingResult) String() string {
 		e.PhaseName, e.StringWithoutPhase())
 }
 
+// PhaseFromProbingResultMessage returns the name of the phase
+// from a message created by ProbingResult.String.
+func PhaseFromProbingResultMessage(msg string) (phaseName string, ok bool) {
+	quoted, err := strconv.QuotedPrefix(strings.TrimPrefix(msg, "Phase "))
+	if err != nil {
+		return "", false
+	}
+	phaseName, err = strconv.Unquote(quoted)
+	if err != nil {
+		return "", false
+	}
+	return phaseName, true
+}
+
 func (r *PhaseReconciler) ReconcilePhase(
 	ctx context.Context, owner PhaseObjectOwner,
 	phase corev1alpha1.ObjectSetTemplatePhase,
 	probe probing.Prober, previous []PreviousObjectSet,
 ) (actualObjects []client.Object, res ProbingResult, err error) {
-	desiredObjects := make([]unstructured.Unstructured, len(phase.Objects))
-	for i, phaseObject := range phase.Objects {
-		desired, err := r.desiredObject(ctx, owner, phaseObject)
-		if err != nil {
-			return nil, res, fmt.Errorf("%s: %w", phaseObject, err)
-		}
-		desiredObjects[i] = *desired
-	}
+	ctx, span := tracing.Start(ctx, "ReconcilePhase", attribute.String("phase", phase.Name))
+	defer func() { tracing.End(span, err) }()
 
-	violations, err := preflight.CheckAllInPhase(
-		ctx, r.preflightChecker, owner.ClientObject(), phase, desiredObjects)
+	phase.Objects = applyOrder(phase.Objects)
+	desiredObjects, err := r.desiredAndCheckedObjects(ctx, owner, phase)
 	if err != nil {
 		return nil, res, err
 	}
-	if len(violations) > 0 {
-		return nil, res, &preflight.Error{
-			Violations: violations,
-		}
-	}
 
 	rec := newRecordingProbe(phase.Name, probe)
+	mapped := mappedConditions{}
 
 	for i, phaseObject := range phase.Objects {
 		desiredObj := &desiredObjects[i]
-		actualObj, err := r.reconcilePhaseObject(ctx, owner, phaseObject, desiredObj, previous)
+		actualObj, err := r.reconcilePhaseObject(ctx, owner, phaseObject, desiredObj, previous, mapped)
 		if apimachineryerrors.IsNotFound(err) {
 			// Don't error, just observe.
 			rec.RecordMissingObject(desiredObj)
+			recordObjectStatus(owner, desiredObj, corev1alpha1.ObjectStateProbing, "not found")
+			r.recordProbeFailure(owner, phase.Name, desiredObj)
+			continue
+		}
+		if errors.Is(err, errExternalObjectSkipped) {
+			// Missing external object, that is not blocking the phase.
+			continue
+		}
+		if isNamespaceTerminating(err) {
+			// Retrying is pointless until the namespace is gone.
+			rec.recordForObj(desiredObj, "namespace terminating")
+			recordObjectStatus(owner, desiredObj, corev1alpha1.ObjectStateProbing, "namespace terminating")
+			r.recordProbeFailure(owner, phase.Name, desiredObj)
+			reportNamespaceTerminating(owner, desiredObj.GetNamespace())
 			continue
 		}
 		if err != nil {
+			recordObjectStatus(owner, desiredObj, corev1alpha1.ObjectStateFailed, err.Error())
 			return nil, res, fmt.Errorf("%s: %w", phaseObject, err)
 		}
-		actualObjects = append(actualObjects, actualObj)
+		if phaseObject.External == nil {
+			// External objects are not controlled by the owner.
+			actualObjects = append(actualObjects, actualObj)
+		}
 
+		_, probeSpan := tracing.Start(ctx, "Probe", attribute.String("object", phaseObject.String()))
+		failures := len(rec.failures)
 		rec.Probe(actualObj)
+		if phaseObject.External != nil {
+			err = probeExternalObject(ctx, &rec, phaseObject.External, actualObj)
+		} else {
+			probeCustomResourceDefinition(owner, &rec, actualObj)
+		}
+		probeSpan.End()
+		if err != nil {
+			return nil, res, fmt.Errorf("%s: %w", phaseObject, err)
+		}
+		if len(rec.failures) > failures {
+			recordObjectStatus(owner, desiredObj, corev1alpha1.ObjectStateProbing,
+				strings.Join(rec.failures[failures:], ", "))
+			r.recordProbeFailure(owner, phase.Name, desiredObj)
+		} else {
+			recordObjectStatus(owner, desiredObj, corev1alpha1.ObjectStateApplied, "")
+		}
 	}
+	mapped.apply(owner)
 
 	return actualObjects, rec.Result(), nil
 }
 
+// PreviewPhase runs all preflight checks and dry-runs for the objects of the given phase,
+// without applying anything to the cluster.
+func (r *PhaseReconciler) PreviewPhase(
+	ctx context.Context, owner PhaseObjectOwner,
+	phase corev1alpha1.ObjectSetTemplatePhase,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "PreviewPhase", attribute.String("phase", phase.Name))
+	defer func() { tracing.End(span, err) }()
+
+	_, err = r.desiredAndCheckedObjects(ctx, owner, phase)
+	return err
+}
+
+// Builds the desired objects of a phase and runs them through the preflight checker.
+func (r *PhaseReconciler) desiredAndCheckedObjects(
+	ctx context.Context, owner PhaseObjectOwner,
+	phase corev1alpha1.ObjectSetTemplatePhase,
+) ([]unstructured.Unstructured, error) {
+	desiredObjects := make([]unstructured.Unstructured, len(phase.Objects))
+	for i, phaseObject := range phase.Objects {
+		desired, err := r.desiredObject(ctx, owner, phaseObject)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", phaseObject, err)
+		}
+		desiredObjects[i] = *desired
+	}
+
+	violations, err := preflight.CheckAllInPhase(
+		ctx, r.preflightChecker, owner.ClientObject(), phase, desiredObjects)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return nil, &preflight.Error{
+			Violations: violations,
+		}
+	}
+	return desiredObjects, nil
+}
+
 func (r *PhaseReconciler) TeardownPhase(
 	ctx context.Context, owner PhaseObjectOwner,
 	phase corev1alpha1.ObjectSetTemplatePhase,
@@ -246,6 +358,11 @@ func (r *PhaseReconciler) teardownPhaseObject(
 ) (cleanupDone bool, err error) {
 	log := logr.FromContextOrDiscard(ctx)
 
+	if phaseObject.External != nil {
+		// External objects are never deleted by Package Operator.
+		return true, nil
+	}
+
 	desiredObj, err := r.desiredObject(ctx, owner, phaseObject)
 	if err != nil {
 		return false, fmt.Errorf("building desired object: %w", err)
@@ -261,9 +378,11 @@ func (r *PhaseReconciler) teardownPhaseObject(
 
 	// Ensure to watch this type of object, also during teardown!
 	// If the controller was restarted or crashed during deletion, we might not have a cache in memory anymore.
-	if err := r.dynamicCache.Watch(
-		ctx, owner.ClientObject(), desiredObj); err != nil {
-		return false, fmt.Errorf("watching new resource: %w", err)
+	if !isOwnerArchived(owner) {
+		if err := r.dynamicCache.Watch(
+			ctx, owner.ClientObject(), desiredObj); err != nil {
+			return false, fmt.Errorf("watching new resource: %w", err)
+		}
 	}
 
 	currentObj := desiredObj.DeepCopy()
@@ -278,21 +397,46 @@ func (r *PhaseReconciler) teardownPhaseObject(
 		return false, fmt.Errorf("getting object for teardown: %w", err)
 	}
 
-	if !r.ownerStrategy.IsController(owner.ClientObject(), currentObj) {
+	orphan := phaseObject.DeletionPolicy == corev1alpha1.ObjectDeletionPolicyOrphan
+	if orphan || !r.ownerStrategy.IsController(owner.ClientObject(), currentObj) {
 		if !r.ownerStrategy.IsOwner(owner.ClientObject(), currentObj) {
 			return true, nil
 		}
 
-		// This object is controlled by someone else
+		// Objects in terminating namespaces are deleted with their namespace,
+		// updating them only fails.
+		terminating, err := r.namespaceTerminating(ctx, currentObj.GetNamespace())
+		if err != nil {
+			return false, err
+		}
+		if terminating {
+			reportNamespaceTerminating(owner, currentObj.GetNamespace())
+			return true, nil
+		}
+
+		// This object is controlled by someone else or should be left in the cluster
 		// so we don't have to delete it for cleanup.
 		// But we still want to remove ourselves as potential owner.
 		r.ownerStrategy.RemoveOwner(owner.ClientObject(), currentObj)
-		if err := r.writer.Update(ctx, currentObj); err != nil {
+		r.releaseDynamicCacheLabel(owner, currentObj)
+		err = r.writer.Update(ctx, currentObj)
+		r.recordAudit(ctx, audit.OperationPatch, owner, currentObj, []string{"metadata.ownerReferences"}, err)
+		if isNamespaceTerminating(err) {
+			reportNamespaceTerminating(owner, currentObj.GetNamespace())
+			return true, nil
+		}
+		if err != nil {
 			return false, fmt.Errorf("removing owner reference: %w", err)
 		}
 		return true, nil
 	}
 
+	// Objects that moved into another phase of a newer revision are kept,
+	// even when that revision did not reach their new phase yet.
+	if handedOver, err := r.handoverToSuccessor(ctx, owner, currentObj); err != nil || handedOver {
+		return handedOver, err
+	}
+
 	log.Info("deleting managed object",
 		"apiVersion", currentObj.GetAPIVersion(),
 		"kind", currentObj.GroupVersionKind().Kind,
@@ -303,6 +447,7 @@ func (r *PhaseReconciler) teardownPhaseObject(
 	if err != nil && apimachineryerrors.IsNotFound(err) {
 		return true, nil
 	}
+	r.recordAudit(ctx, audit.OperationDelete, owner, currentObj, nil, err)
 	if err != nil {
 		return false, fmt.Errorf("deleting object for teardown: %w", err)
 	}
@@ -314,8 +459,16 @@ func (r *PhaseReconciler) reconcilePhaseObject(
 	ctx context.Context, owner PhaseObjectOwner,
 	phaseObject corev1alpha1.ObjectSetObject,
 	desiredObj *unstructured.Unstructured,
-	previous []PreviousObjectSet,
+	previous []PreviousObjectSet, mapped mappedConditions,
 ) (actualObj *unstructured.Unstructured, err error) {
+	if phaseObject.External != nil {
+		actualObj, err = r.observeExternalObject(ctx, owner, phaseObject.External, desiredObj)
+		if err != nil {
+			return nil, err
+		}
+		return actualObj, mapConditions(ctx, owner, phaseObject.ConditionMappings, actualObj, mapped)
+	}
+
 	// Set owner reference
 	if err := r.ownerStrategy.SetControllerReference(owner.ClientObject(), desiredObj); err != nil {
 		return nil, fmt.Errorf("set controller reference: %w", err)
@@ -335,21 +488,62 @@ func (r *PhaseReconciler) reconcilePhaseObject(
 		return actualObj, nil
 	}
 
-	if actualObj, err = r.reconcileObject(ctx, owner, desiredObj, previous, phaseObject.CollisionProtection); err != nil {
+	if actualObj, err = r.reconcileObject(
+		ctx, owner, desiredObj, previous,
+		phaseObject.CollisionProtection, fieldConflictPolicyFor(owner, phaseObject),
+	); err != nil {
 		return nil, err
 	}
 
-	if err = mapConditions(ctx, owner, phaseObject.ConditionMappings, actualObj); err != nil {
+	if err = mapConditions(ctx, owner, phaseObject.ConditionMappings, actualObj, mapped); err != nil {
 		return nil, err
 	}
 
 	return actualObj, nil
 }
 
+// Collects conditions mapped from multiple objects,
+// reporting one aggregated condition per destination type.
+type mappedConditions map[string]metav1.Condition
+
+// Adds a mapped condition, keeping the condition with the worst status per type.
+// False is worse than Unknown, which is worse than True.
+func (m mappedConditions) add(cond metav1.Condition) {
+	existing, ok := m[cond.Type]
+	if ok && conditionStatusSeverity(existing.Status) >= conditionStatusSeverity(cond.Status) {
+		return
+	}
+	m[cond.Type] = cond
+}
+
+func (m mappedConditions) apply(owner PhaseObjectOwner) {
+	// Sorted, so new conditions are always added in the same order.
+	condTypes := make([]string, 0, len(m))
+	for t := range m {
+		condTypes = append(condTypes, t)
+	}
+	sort.Strings(condTypes)
+	for _, t := range condTypes {
+		meta.SetStatusCondition(owner.GetConditions(), m[t])
+	}
+}
+
+func conditionStatusSeverity(status metav1.ConditionStatus) int {
+	switch status {
+	case metav1.ConditionTrue:
+		return 0
+	case metav1.ConditionFalse:
+		return 2
+	default:
+		return 1
+	}
+}
+
 func mapConditions(
 	_ context.Context, owner PhaseObjectOwner,
 	conditionMappings []corev1alpha1.ConditionMapping,
 	actualObject *unstructured.Unstructured,
+	mapped mappedConditions,
 ) error {
 	if len(conditionMappings) == 0 {
 		return nil
@@ -373,10 +567,10 @@ func mapConditions(
 		return err
 	}
 
-	// Maps from object condition type to PKO condition type.
-	conditionTypeMap := map[string]string{}
+	// Maps from object condition type to mappings into PKO condition types.
+	conditionTypeMap := map[string][]corev1alpha1.ConditionMapping{}
 	for _, m := range conditionMappings {
-		conditionTypeMap[m.SourceType] = m.DestinationType
+		conditionTypeMap[m.SourceType] = append(conditionTypeMap[m.SourceType], m)
 	}
 	for _, condition := range objectConditions {
 		if condition.ObservedGeneration != 0 &&
@@ -385,23 +579,49 @@ func mapConditions(
 			continue
 		}
 
-		destType, ok := conditionTypeMap[condition.Type]
-		if !ok {
-			// condition not mapped
-			continue
+		for _, m := range conditionTypeMap[condition.Type] {
+			message, err := mappedConditionMessage(m, actualObject, condition)
+			if err != nil {
+				return err
+			}
+			mapped.add(metav1.Condition{
+				Type:               m.DestinationType,
+				Status:             condition.Status,
+				Reason:             condition.Reason,
+				Message:            message,
+				ObservedGeneration: owner.ClientObject().GetGeneration(),
+			})
 		}
-
-		meta.SetStatusCondition(owner.GetConditions(), metav1.Condition{
-			Type:               destType,
-			Status:             condition.Status,
-			Reason:             condition.Reason,
-			Message:            condition.Message,
-			ObservedGeneration: owner.ClientObject().GetGeneration(),
-		})
 	}
 	return nil
 }
 
+func mappedConditionMessage(
+	m corev1alpha1.ConditionMapping, obj *unstructured.Unstructured, condition metav1.Condition,
+) (string, error) {
+	if len(m.Message) == 0 {
+		return condition.Message, nil
+	}
+
+	tmpl, err := template.New("").Parse(m.Message)
+	if err != nil {
+		return "", fmt.Errorf("parsing message template of condition mapping %s: %w", m.DestinationType, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]any{
+		"object": obj.Object,
+		"condition": map[string]any{
+			"type":    condition.Type,
+			"status":  string(condition.Status),
+			"reason":  condition.Reason,
+			"message": condition.Message,
+		},
+	}); err != nil {
+		return "", fmt.Errorf("executing message template of condition mapping %s: %w", m.DestinationType, err)
+	}
+	return buf.String(), nil
+}
+
 // Builds an object as specified in a phase.
 // Includes system labels, namespace and owner reference.
 func (r *PhaseReconciler) desiredObject(
@@ -410,8 +630,11 @@ func (r *PhaseReconciler) desiredObject(
 ) (desiredObj *unstructured.Unstructured, err error) {
 	desiredObj = phaseObject.Object.DeepCopy()
 
-	// Default namespace to the owners namespace
-	if len(desiredObj.GetNamespace()) == 0 {
+	// Default namespace to the owners namespace,
+	// except for cluster-scoped objects the owner is allowed to contain.
+	if len(desiredObj.GetNamespace()) == 0 &&
+		!preflight.IsClusterScopedKindAllowed(
+			owner.ClientObject(), desiredObj.GroupVersionKind().GroupKind(), r.allowedClusterScopedKinds) {
 		desiredObj.SetNamespace(
 			owner.ClientObject().GetNamespace())
 	}
@@ -449,9 +672,13 @@ type ObjectSetOrPhase interface {
 	UpdateStatusPhase()
 }
 
+// Reports known errors in the status of the ObjectSet or ObjectSetPhase.
+// When a failureBackoff is given, objects that keep failing with the same error
+// are retried with an exponentially increasing delay.
 func UpdateObjectSetOrPhaseStatusFromError(
 	ctx context.Context, objectSetOrPhase ObjectSetOrPhase,
-	reconcileErr error, updateStatus func(ctx context.Context) error,
+	reconcileErr error, failureBackoff *FailureBackoff,
+	updateStatus func(ctx context.Context) error,
 ) (res ctrl.Result, err error) {
 	var preflightError *preflight.Error
 	if errors.As(reconcileErr, &preflightError) {
@@ -463,7 +690,7 @@ func UpdateObjectSetOrPhaseStatusFromError(
 			Message:            preflightError.Error(),
 		})
 		// Retry every once and a while to automatically unblock, if the preflight check issue has been cleared.
-		res.RequeueAfter = DefaultGlobalMissConfigurationRetry
+		res.RequeueAfter, _ = backoffFailure(objectSetOrPhase, reconcileErr, failureBackoff)
 		return res, updateStatus(ctx)
 	}
 
@@ -476,7 +703,29 @@ func UpdateObjectSetOrPhaseStatusFromError(
 			Message:            reconcileErr.Error(),
 		})
 		// Retry every once and a while to automatically unblock, if the conflicting resource has been deleted.
-		res.RequeueAfter = DefaultGlobalMissConfigurationRetry
+		res.RequeueAfter, _ = backoffFailure(objectSetOrPhase, reconcileErr, failureBackoff)
+		return res, updateStatus(ctx)
+	}
+
+	var applyErr *ApplyError
+	if errors.As(reconcileErr, &applyErr) && !applyErr.CausedBy(ErrorReasonApplyConflict) {
+		meta.SetStatusCondition(objectSetOrPhase.GetConditions(), metav1.Condition{
+			Type:               corev1alpha1.ObjectSetAvailable,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: objectSetOrPhase.ClientObject().GetGeneration(),
+			Reason:             applyErr.ConditionReason(),
+			Message:            reconcileErr.Error(),
+		})
+		// Retry every once and a while to automatically unblock,
+		// if the object, the admission webhook or RBAC has been fixed.
+		res.RequeueAfter, _ = backoffFailure(objectSetOrPhase, reconcileErr, failureBackoff)
+		return res, updateStatus(ctx)
+	}
+
+	// Stop hot-looping on objects that keep failing the same way.
+	if delay, persistent := backoffFailure(objectSetOrPhase, reconcileErr, failureBackoff); persistent {
+		logr.FromContextOrDiscard(ctx).Error(reconcileErr, "persistent failure", "retryAfter", delay)
+		res.RequeueAfter = delay
 		return res, updateStatus(ctx)
 	}
 
@@ -485,11 +734,30 @@ func UpdateObjectSetOrPhaseStatusFromError(
 	return res, reconcileErr
 }
 
+// Returns the delay until a failed ObjectSet or ObjectSetPhase should be retried
+// and reports persistent failures in its status.
+func backoffFailure(
+	objectSetOrPhase ObjectSetOrPhase, reconcileErr error, failureBackoff *FailureBackoff,
+) (delay time.Duration, persistent bool) {
+	if failureBackoff == nil {
+		return DefaultGlobalMissConfigurationRetry, false
+	}
+	return failureBackoff.Backoff(
+		objectSetOrPhase.ClientObject(), objectSetOrPhase.GetConditions(),
+		corev1alpha1.ObjectSetRetryBackoff, reconcileErr)
+}
+
 type CommonObjectPhaseError struct {
 	OwnerKey, ObjectKey client.ObjectKey
 	OwnerGVK, ObjectGVK schema.GroupVersionKind
 }
 
+// ErrorClass implements errorclass.Of.
+// Adoption is refused until the conflicting object is removed or the collision protection relaxed.
+func (e CommonObjectPhaseError) ErrorClass() errorclass.Class {
+	return errorclass.Config
+}
+
 // This error is returned when a Phase contains objects
 // that are not owned by a previous revision.
 // Previous revisions of an Phase have to be declared in .spec.previousRevisions.
@@ -515,8 +783,10 @@ func (r *PhaseReconciler) reconcileObject(
 	ctx context.Context, owner PhaseObjectOwner,
 	desiredObj *unstructured.Unstructured, previous []PreviousObjectSet,
 	collisionProtection corev1alpha1.CollisionProtection,
+	fieldConflictPolicy corev1alpha1.FieldConflictPolicy,
 ) (actualObj *unstructured.Unstructured, err error) {
 	objKey := client.ObjectKeyFromObject(desiredObj)
+	fieldManager := fieldManagerFor(owner)
 	currentObj := desiredObj.DeepCopy()
 	err = r.dynamicCache.Get(ctx, objKey, currentObj)
 	if err != nil && !apimachineryerrors.IsNotFound(err) {
@@ -531,7 +801,8 @@ func (r *PhaseReconciler) reconcileObject(
 	if apimachineryerrors.IsNotFound(err) {
 		// The object is not yet present on the cluster,
 		// just create it using desired state!
-		err := r.writer.Patch(ctx, desiredObj, client.Apply, client.FieldOwner(constants.FieldOwner))
+		err := Apply(ctx, r.writer, desiredObj, client.Apply, client.FieldOwner(fieldManager))
+		r.recordAudit(ctx, audit.OperationCreate, owner, desiredObj, nil, err)
 		if apimachineryerrors.IsAlreadyExists(err) {
 			// object already exists, but was not in our cache.
 			// get object via uncached client directly from the API server.
@@ -574,20 +845,62 @@ func (r *PhaseReconciler) reconcileObject(
 
 	// Only issue updates when this instance is already controlled by this instance.
 	if r.ownerStrategy.IsController(owner.ClientObject(), updatedObj) {
-		if err := r.patcher.Patch(ctx, desiredObj, currentObj, updatedObj); err != nil {
+		// Owner references of desiredObj are set by the patcher, compare with the updated ones.
+		desiredWithOwners := desiredObj.DeepCopy()
+		desiredWithOwners.SetOwnerReferences(updatedObj.GetOwnerReferences())
+		changes := audit.Changes(currentObj, desiredWithOwners)
+		if len(changes) > 0 && !needsAdoption && isDriftDetectOnly(owner) {
+			// Objects already controlled by the owner only differ when changed by someone else.
+			// Leave them be, until the changes are reverted or approved with a new revision.
+			reportDrift(owner, currentObj, changes)
+			return updatedObj, nil
+		}
+
+		force := fieldConflictPolicy == corev1alpha1.FieldConflictPolicyForce
+		err = r.patcher.Patch(ctx, fieldManager, force, desiredObj, currentObj, updatedObj)
+		// Objects are patched on every reconcile, only record patches that change something.
+		if len(changes) > 0 || err != nil {
+			r.recordAudit(ctx, audit.OperationPatch, owner, desiredObj, changes, err)
+		}
+		if err != nil {
+			recordFieldConflict(owner, currentObj, err)
 			return nil, err
 		}
+		if len(changes) > 0 {
+			r.recordObjectChange(owner, currentObj, changes, needsAdoption)
+		}
 	}
 
 	return updatedObj, nil
 }
 
+// Records an operation on a managed object to the audit sink, if one is configured.
+func (r *PhaseReconciler) recordAudit(
+	ctx context.Context, op audit.Operation, owner PhaseObjectOwner,
+	obj client.Object, changes []string, err error,
+) {
+	if r.auditSink == nil {
+		return
+	}
+
+	ownerGVK, gvkErr := apiutil.GVKForObject(owner.ClientObject(), r.scheme)
+	if gvkErr != nil {
+		logr.FromContextOrDiscard(ctx).Error(gvkErr, "looking up owner GVK for audit entry")
+	}
+
+	entry := audit.NewEntry(op, obj, ownerGVK, owner.ClientObject(), err)
+	entry.Changes = changes
+	r.auditSink.Record(ctx, entry)
+}
+
 type defaultPatcher struct {
 	writer client.Writer
 }
 
 func (p *defaultPatcher) Patch(
 	ctx context.Context,
+	fieldManager string, // field manager to apply the object with
+	force bool, // whether to take over fields owned by other field managers
 	desiredObj, // object as specified by users
 	currentObj, // object as currently present on the cluster
 	// deepCopy of currentObj, already updated for owner handling
@@ -601,7 +914,7 @@ func (p *defaultPatcher) Patch(
 	// we would just start a fight with whatever controller is realizing this object.
 	unstructured.RemoveNestedField(patch.Object, "status")
 
-	if err := p.fixFieldManagers(ctx, currentObj); err != nil {
+	if err := p.fixFieldManagers(ctx, fieldManager, currentObj); err != nil {
 		return fmt.Errorf("fix field managers for SSA: %w", err)
 	}
 
@@ -609,10 +922,12 @@ func (p *defaultPatcher) Patch(
 	if err != nil {
 		return fmt.Errorf("creating patch: %w", err)
 	}
-	if err := p.writer.Patch(ctx, updatedObj, client.RawPatch(
-		types.ApplyPatchType, objectPatch),
-		client.FieldOwner(constants.FieldOwner),
-		client.ForceOwnership,
+	opts := []client.PatchOption{client.FieldOwner(fieldManager)}
+	if force {
+		opts = append(opts, client.ForceOwnership)
+	}
+	if err := Apply(ctx, p.writer, updatedObj, client.RawPatch(
+		types.ApplyPatchType, objectPatch), opts...,
 	); err != nil {
 		return fmt.Errorf("patching object: %w", err)
 	}
@@ -620,16 +935,16 @@ func (p *defaultPatcher) Patch(
 }
 
 // Autogenerated field owner names that we used previously.
-// We need the list replace all of them with the value of `FieldOwner`.
+// We need the list replace all of them with the field manager objects are applied with.
 var oldFieldOwners = sets.New(constants.FieldOwner, "package-operator-manager", "remote-phase-manger")
 
 // Migrate field ownerships to be compatible with server-side apply.
 // SSA really is complicated: https://github.com/kubernetes/kubernetes/issues/99003
 func (p *defaultPatcher) fixFieldManagers(
-	ctx context.Context,
+	ctx context.Context, fieldManager string,
 	currentObj *unstructured.Unstructured,
 ) error {
-	patch, err := csaupgrade.UpgradeManagedFieldsPatch(currentObj, oldFieldOwners, constants.FieldOwner)
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(currentObj, oldFieldOwners, fieldManager)
 	switch {
 	case err != nil:
 		return err
@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +22,7 @@ import (
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/audit"
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/preflight"
 	"package-operator.run/internal/testutil"
@@ -329,8 +331,159 @@ func TestPhaseReconciler_TeardownPhase(t *testing.T) {
 		ownerStrategy.AssertCalled(t, "IsController", ownerObj, currentObj)
 		ownerStrategy.AssertCalled(t, "IsOwner", ownerObj, currentObj)
 	})
+
+	t.Run("orphan", func(t *testing.T) {
+		t.Parallel()
+
+		dynamicCache := &dynamicCacheMock{}
+		uncachedClient := testutil.NewClient()
+		ownerStrategy := &ownerStrategyMock{}
+		testClient := testutil.NewClient()
+		preflightChecker := &preflightCheckerMock{}
+		r := &PhaseReconciler{
+			dynamicCache:     dynamicCache,
+			uncachedClient:   uncachedClient,
+			ownerStrategy:    ownerStrategy,
+			writer:           testClient,
+			preflightChecker: preflightChecker,
+		}
+
+		owner := &phaseObjectOwnerMock{}
+		ownerObj := &unstructured.Unstructured{}
+		owner.On("ClientObject").Return(ownerObj)
+		owner.On("GetRevision").Return(int64(5))
+
+		preflightChecker.
+			On("Check", mock.Anything, mock.Anything, mock.Anything).
+			Return([]preflight.Violation{}, nil)
+
+		ownerStrategy.
+			On("SetControllerReference", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		dynamicCache.
+			On("Watch", mock.Anything, ownerObj, mock.Anything).
+			Return(nil)
+		currentObj := &unstructured.Unstructured{}
+		uncachedClient.
+			On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				out := args.Get(2).(*unstructured.Unstructured)
+				*out = *currentObj
+			}).
+			Return(nil)
+
+		ownerStrategy.
+			On("IsOwner", ownerObj, currentObj).
+			Return(true)
+		ownerStrategy.
+			On("RemoveOwner", ownerObj, currentObj).
+			Return()
+		testClient.
+			On("Update", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		ctx := context.Background()
+		done, err := r.TeardownPhase(ctx, owner, corev1alpha1.ObjectSetTemplatePhase{
+			Objects: []corev1alpha1.ObjectSetObject{
+				{
+					Object:         unstructured.Unstructured{},
+					DeletionPolicy: corev1alpha1.ObjectDeletionPolicyOrphan,
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, done)
+
+		// Orphaned objects are released, but never deleted.
+		ownerStrategy.AssertCalled(t, "RemoveOwner", ownerObj, currentObj)
+		ownerStrategy.AssertNotCalled(t, "IsController", ownerObj, currentObj)
+		testClient.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("archived owner", func(t *testing.T) {
+		t.Parallel()
+
+		dynamicCache := &dynamicCacheMock{}
+		uncachedClient := testutil.NewClient()
+		ownerStrategy := &ownerStrategyMock{}
+		testClient := testutil.NewClient()
+		preflightChecker := &preflightCheckerMock{}
+		r := &PhaseReconciler{
+			dynamicCache:     dynamicCache,
+			uncachedClient:   uncachedClient,
+			ownerStrategy:    ownerStrategy,
+			writer:           testClient,
+			preflightChecker: preflightChecker,
+		}
+
+		ownerMock := &phaseObjectOwnerMock{}
+		owner := &archivedPhaseObjectOwnerMock{phaseObjectOwnerMock: ownerMock}
+		ownerObj := &unstructured.Unstructured{}
+		ownerMock.On("ClientObject").Return(ownerObj)
+		ownerMock.On("GetRevision").Return(int64(5))
+
+		preflightChecker.
+			On("Check", mock.Anything, mock.Anything, mock.Anything).
+			Return([]preflight.Violation{}, nil)
+		ownerStrategy.
+			On("SetControllerReference", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		currentObj := &unstructured.Unstructured{}
+		currentObj.SetLabels(map[string]string{
+			constants.DynamicCacheLabel: "True",
+			"app":                       "test",
+		})
+		uncachedClient.
+			On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				out := args.Get(2).(*unstructured.Unstructured)
+				currentObj.DeepCopyInto(out)
+			}).
+			Return(nil)
+
+		ownerStrategy.
+			On("IsOwner", ownerObj, mock.Anything).
+			Return(true)
+		ownerStrategy.
+			On("RemoveOwner", ownerObj, mock.Anything).
+			Return()
+		ownerStrategy.
+			On("HasController", mock.Anything).
+			Return(false)
+		testClient.
+			On("Update", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		ctx := context.Background()
+		done, err := r.TeardownPhase(ctx, owner, corev1alpha1.ObjectSetTemplatePhase{
+			Objects: []corev1alpha1.ObjectSetObject{
+				{
+					Object:         unstructured.Unstructured{},
+					DeletionPolicy: corev1alpha1.ObjectDeletionPolicyOrphan,
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, done)
+
+		// Archived owners don't watch again and release the cache label of objects nobody controls.
+		dynamicCache.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything, mock.Anything)
+		testClient.AssertCalled(t, "Update", mock.Anything,
+			mock.MatchedBy(func(obj *unstructured.Unstructured) bool {
+				_, cached := obj.GetLabels()[constants.DynamicCacheLabel]
+				return !cached && obj.GetLabels()["app"] == "test"
+			}), mock.Anything)
+	})
 }
 
+type archivedPhaseObjectOwnerMock struct {
+	*phaseObjectOwnerMock
+}
+
+func (m *archivedPhaseObjectOwnerMock) IsArchived() bool { return true }
+
 func TestPhaseReconciler_reconcileObject_create(t *testing.T) {
 	t.Parallel()
 
@@ -356,12 +509,107 @@ func TestPhaseReconciler_reconcileObject_create(t *testing.T) {
 
 	ctx := context.Background()
 	desired := &unstructured.Unstructured{}
-	actual, err := r.reconcileObject(ctx, owner, desired, nil, corev1alpha1.CollisionProtectionPrevent)
+	actual, err := r.reconcileObject(
+		ctx, owner, desired, nil,
+		corev1alpha1.CollisionProtectionPrevent, corev1alpha1.FieldConflictPolicyForce,
+	)
 	require.NoError(t, err)
 
 	assert.Same(t, desired, actual)
 }
 
+func TestPhaseReconciler_reconcileObject_createFieldManager(t *testing.T) {
+	t.Parallel()
+
+	testClient := testutil.NewClient()
+	dynamicCacheMock := &dynamicCacheMock{}
+	clientMock := &testutil.CtrlClient{}
+	r := &PhaseReconciler{
+		writer:         testClient,
+		dynamicCache:   dynamicCacheMock,
+		uncachedClient: clientMock,
+	}
+	owner := &fieldManagerOwnerMock{}
+	owner.On("GetFieldManager").Return("platform-team")
+
+	dynamicCacheMock.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	clientMock.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	patchOpts := &client.PatchOptions{}
+	testClient.
+		On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			patchOpts.ApplyOptions(args.Get(3).([]client.PatchOption))
+		}).
+		Return(nil)
+
+	ctx := context.Background()
+	_, err := r.reconcileObject(
+		ctx, owner, &unstructured.Unstructured{}, nil,
+		corev1alpha1.CollisionProtectionPrevent, corev1alpha1.FieldConflictPolicyForce,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "platform-team", patchOpts.FieldManager)
+}
+
+func TestPhaseReconciler_reconcileObject_createAudit(t *testing.T) {
+	t.Parallel()
+
+	testClient := testutil.NewClient()
+	dynamicCacheMock := &dynamicCacheMock{}
+	clientMock := &testutil.CtrlClient{}
+	sink := &auditSinkStub{}
+	r := &PhaseReconciler{
+		scheme:         testScheme,
+		writer:         testClient,
+		dynamicCache:   dynamicCacheMock,
+		uncachedClient: clientMock,
+		auditSink:      sink,
+	}
+	owner := &phaseObjectOwnerMock{}
+	owner.On("ClientObject").Return(&corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1234", Namespace: "test"},
+	})
+
+	dynamicCacheMock.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	clientMock.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(apimachineryerrors.NewNotFound(schema.GroupResource{}, ""))
+	testClient.
+		On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	desired := &unstructured.Unstructured{}
+	desired.SetAPIVersion("v1")
+	desired.SetKind("ConfigMap")
+	desired.SetName("cm")
+	desired.SetNamespace("test")
+	_, err := r.reconcileObject(
+		context.Background(), owner, desired, nil,
+		corev1alpha1.CollisionProtectionPrevent, corev1alpha1.FieldConflictPolicyForce,
+	)
+	require.NoError(t, err)
+
+	require.Len(t, sink.entries, 1)
+	entry := sink.entries[0]
+	assert.Equal(t, audit.OperationCreate, entry.Operation)
+	assert.Equal(t, audit.OutcomeSuccess, entry.Outcome)
+	assert.Equal(t, "ConfigMap", entry.Kind)
+	assert.Equal(t, "cm", entry.Name)
+	assert.Equal(t, audit.ObjectReference{
+		APIVersion: "package-operator.run/v1alpha1",
+		Kind:       "ObjectSet",
+		Namespace:  "test",
+		Name:       "test-1234",
+	}, entry.Owner)
+}
+
 func TestPhaseReconciler_reconcileObject_update(t *testing.T) {
 	t.Parallel()
 
@@ -405,14 +653,17 @@ func TestPhaseReconciler_reconcileObject_update(t *testing.T) {
 		Return(nil)
 
 	patcher.
-		On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("Patch", mock.Anything, constants.FieldOwner, true, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
 	ctx := context.Background()
 	obj := &unstructured.Unstructured{}
 	// set owner refs so we don't run into the panic
 	obj.SetOwnerReferences([]metav1.OwnerReference{{}})
-	actual, err := r.reconcileObject(ctx, owner, obj, nil, corev1alpha1.CollisionProtectionPrevent)
+	actual, err := r.reconcileObject(
+		ctx, owner, obj, nil,
+		corev1alpha1.CollisionProtectionPrevent, corev1alpha1.FieldConflictPolicyForce,
+	)
 	require.NoError(t, err)
 
 	assert.Equal(t, &unstructured.Unstructured{
@@ -434,6 +685,142 @@ func TestPhaseReconciler_reconcileObject_update(t *testing.T) {
 	}, actual)
 }
 
+func TestPhaseReconciler_reconcileObject_detectOnly(t *testing.T) {
+	t.Parallel()
+
+	dynamicCacheMock := &dynamicCacheMock{}
+	acMock := &adoptionCheckerMock{}
+	ownerStrategy := &ownerStrategyMock{}
+	patcher := &patcherMock{}
+	r := &PhaseReconciler{
+		dynamicCache:    dynamicCacheMock,
+		adoptionChecker: acMock,
+		ownerStrategy:   ownerStrategy,
+		patcher:         patcher,
+	}
+	var conditions []metav1.Condition
+	owner := &driftDetectionOwnerMock{}
+	owner.On("ClientObject").Return(&unstructured.Unstructured{})
+	owner.On("GetConditions").Return(&conditions)
+	owner.On("GetDriftDetection").Return(&corev1alpha1.ObjectSetDriftDetection{
+		Remediation: corev1alpha1.DriftRemediationDetectOnly,
+	})
+
+	acMock.
+		On("Check", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil)
+
+	// Someone applied a hotfix to the object.
+	dynamicCacheMock.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(2).(*unstructured.Unstructured)
+			obj.Object["data"] = map[string]any{"key": "hotfix"}
+		}).
+		Return(nil)
+
+	ownerStrategy.
+		On("IsController", mock.Anything, mock.Anything).
+		Return(true)
+
+	ctx := context.Background()
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      "test",
+			"namespace": "test-ns",
+		},
+		"data": map[string]any{"key": "value"},
+	}}
+	actual, err := r.reconcileObject(
+		ctx, owner, obj, nil,
+		corev1alpha1.CollisionProtectionPrevent, corev1alpha1.FieldConflictPolicyForce,
+	)
+	require.NoError(t, err)
+
+	// The drift is reported, but not corrected.
+	assert.Equal(t, map[string]any{"key": "hotfix"}, actual.Object["data"])
+	patcher.AssertNotCalled(t, "Patch",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	cond := meta.FindStatusCondition(conditions, corev1alpha1.ObjectSetDrifted)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "ConfigMap test-ns/test: data.key", cond.Message)
+}
+
+func TestPhaseReconciler_reconcileObject_fieldConflict(t *testing.T) {
+	t.Parallel()
+
+	dynamicCacheMock := &dynamicCacheMock{}
+	acMock := &adoptionCheckerMock{}
+	ownerStrategy := &ownerStrategyMock{}
+	patcher := &patcherMock{}
+	r := &PhaseReconciler{
+		dynamicCache:    dynamicCacheMock,
+		adoptionChecker: acMock,
+		ownerStrategy:   ownerStrategy,
+		patcher:         patcher,
+	}
+	owner := &fieldConflictOwnerMock{}
+	owner.On("ClientObject").Return(&unstructured.Unstructured{})
+	owner.On("RecordFieldConflict", mock.Anything)
+
+	acMock.
+		On("Check", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil)
+	dynamicCacheMock.
+		On("Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	ownerStrategy.
+		On("IsController", mock.Anything, mock.Anything).
+		Return(true)
+	patcher.
+		On("Patch", mock.Anything, constants.FieldOwner, false, mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("patching object: %w", newFieldManagerConflictError()))
+
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      "test",
+			"namespace": "test-ns",
+		},
+	}}
+	_, err := r.reconcileObject(
+		context.Background(), owner, obj, nil,
+		corev1alpha1.CollisionProtectionPrevent, corev1alpha1.FieldConflictPolicyReport,
+	)
+	require.Error(t, err)
+
+	owner.AssertCalled(t, "RecordFieldConflict", corev1alpha1.ObjectFieldConflict{
+		Object: corev1alpha1.ControlledObjectReference{
+			Kind:      "ConfigMap",
+			Name:      "test",
+			Namespace: "test-ns",
+		},
+		FieldManagers: []string{"kubectl-edit"},
+		Fields:        []string{".data.key"},
+	})
+}
+
+func TestFieldConflictPolicyFor(t *testing.T) {
+	t.Parallel()
+
+	owner := &phaseObjectOwnerMock{}
+	assert.Equal(t, corev1alpha1.FieldConflictPolicyForce,
+		fieldConflictPolicyFor(owner, corev1alpha1.ObjectSetObject{}))
+
+	policyOwner := &fieldConflictPolicyOwnerMock{}
+	policyOwner.On("GetFieldConflictPolicy").Return(corev1alpha1.FieldConflictPolicyReport)
+	assert.Equal(t, corev1alpha1.FieldConflictPolicyReport,
+		fieldConflictPolicyFor(policyOwner, corev1alpha1.ObjectSetObject{}))
+	assert.Equal(t, corev1alpha1.FieldConflictPolicyForce,
+		fieldConflictPolicyFor(policyOwner, corev1alpha1.ObjectSetObject{
+			FieldConflictPolicy: corev1alpha1.FieldConflictPolicyForce,
+		}))
+}
+
 func TestPhaseReconciler_desiredObject(t *testing.T) {
 	t.Parallel()
 
@@ -883,7 +1270,7 @@ func Test_defaultPatcher_patchObject_update_metadata(t *testing.T) {
 	}
 	updatedObj := currentObj.DeepCopy()
 
-	err := r.Patch(ctx, desiredObj, currentObj, updatedObj)
+	err := r.Patch(ctx, constants.FieldOwner, true, desiredObj, currentObj, updatedObj)
 	require.NoError(t, err)
 
 	clientMock.AssertNumberOfCalls(t, "Patch", 1) // only a single PATCH request
@@ -944,7 +1331,7 @@ func Test_defaultPatcher_patchObject_update_no_metadata(t *testing.T) {
 	err := controllerutil.SetControllerReference(&corev1.ConfigMap{}, updatedObj, testScheme)
 	require.NoError(t, err)
 
-	err = r.Patch(ctx, desiredObj, currentObj, updatedObj)
+	err = r.Patch(ctx, constants.FieldOwner, true, desiredObj, currentObj, updatedObj)
 	require.NoError(t, err)
 
 	clientMock.AssertNumberOfCalls(t, "Patch", 1) // only a single PATCH request
@@ -984,7 +1371,7 @@ func Test_defaultPatcher_fixFieldManagers(t *testing.T) {
 		},
 	})
 
-	err := r.fixFieldManagers(ctx, currentObj)
+	err := r.fixFieldManagers(ctx, constants.FieldOwner, currentObj)
 	require.NoError(t, err)
 
 	clientMock.AssertExpectations(t)
@@ -1018,7 +1405,7 @@ func Test_defaultPatcher_fixFieldManagers_error(t *testing.T) {
 		},
 	})
 
-	err := r.fixFieldManagers(ctx, currentObj)
+	err := r.fixFieldManagers(ctx, constants.FieldOwner, currentObj)
 	require.Error(t, err, errTest.Error())
 
 	clientMock.AssertExpectations(t)
@@ -1044,7 +1431,7 @@ func Test_defaultPatcher_fixFieldManagers_nowork(t *testing.T) {
 		FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{}`)},
 	}})
 
-	err := r.fixFieldManagers(ctx, currentObj)
+	err := r.fixFieldManagers(ctx, constants.FieldOwner, currentObj)
 	require.NoError(t, err)
 
 	clientMock.AssertExpectations(t)
@@ -1163,13 +1550,15 @@ func Test_mapConditions(t *testing.T) {
 			owner.On("ClientObject").Return(ownerObj)
 			owner.On("GetConditions").Return(&conditions)
 
+			mapped := mappedConditions{}
 			err := mapConditions(ctx, owner, []corev1alpha1.ConditionMapping{
 				{
 					SourceType:      "Available",
 					DestinationType: "my-prefix/Available",
 				},
-			}, test.object)
+			}, test.object, mapped)
 			require.NoError(t, err)
+			mapped.apply(owner)
 
 			if assert.Len(t, conditions, test.mappedConditions) &&
 				test.mappedConditions > 0 {
@@ -1181,6 +1570,59 @@ func Test_mapConditions(t *testing.T) {
 	}
 }
 
+func Test_mapConditions_aggregate(t *testing.T) {
+	t.Parallel()
+
+	newObject := func(name string, status metav1.ConditionStatus) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]any{
+				"metadata": map[string]any{
+					"name": name,
+				},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{
+							"type":    "Available",
+							"status":  string(status),
+							"reason":  "Test",
+							"message": "status " + string(status),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	ctx := context.Background()
+	owner := &phaseObjectOwnerMock{}
+	var conditions []metav1.Condition
+	owner.On("ClientObject").Return(&unstructured.Unstructured{})
+	owner.On("GetConditions").Return(&conditions)
+
+	mappings := []corev1alpha1.ConditionMapping{
+		{
+			SourceType:      "Available",
+			DestinationType: "my-prefix/Available",
+			Message:         "{{.object.metadata.name}}: {{.condition.message}}",
+		},
+	}
+	mapped := mappedConditions{}
+	for _, obj := range []*unstructured.Unstructured{
+		newObject("a", metav1.ConditionTrue),
+		newObject("b", metav1.ConditionFalse),
+		newObject("c", metav1.ConditionUnknown),
+	} {
+		require.NoError(t, mapConditions(ctx, owner, mappings, obj, mapped))
+	}
+	mapped.apply(owner)
+
+	if assert.Len(t, conditions, 1) {
+		assert.Equal(t, "my-prefix/Available", conditions[0].Type)
+		assert.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+		assert.Equal(t, "b: status False", conditions[0].Message)
+	}
+}
+
 func TestPhaseReconciler_ReconcilePhase_preflightError(t *testing.T) {
 	t.Parallel()
 
@@ -1298,7 +1740,7 @@ func TestUpdateObjectSetOrPhaseStatusFromError(t *testing.T) {
 
 		um := &testUpdateMock{}
 		ctx := context.Background()
-		res, err := UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, errTest, um.Update)
+		res, err := UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, errTest, nil, um.Update)
 
 		require.EqualError(t, err, errTest.Error())
 		assert.True(t, res.IsZero())
@@ -1315,7 +1757,7 @@ func TestUpdateObjectSetOrPhaseStatusFromError(t *testing.T) {
 		um.On("Update", mock.Anything).Return(nil)
 
 		ctx := context.Background()
-		res, err := UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, &preflight.Error{}, um.Update)
+		res, err := UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, &preflight.Error{}, nil, um.Update)
 
 		require.NoError(t, err)
 		assert.Equal(t, DefaultGlobalMissConfigurationRetry, res.RequeueAfter)
@@ -1337,7 +1779,8 @@ func TestUpdateObjectSetOrPhaseStatusFromError(t *testing.T) {
 		um.On("Update", mock.Anything).Return(nil)
 
 		ctx := context.Background()
-		res, err := UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, &ObjectNotOwnedByPreviousRevisionError{}, um.Update)
+		res, err := UpdateObjectSetOrPhaseStatusFromError(
+			ctx, objectSet, &ObjectNotOwnedByPreviousRevisionError{}, nil, um.Update)
 
 		require.NoError(t, err)
 		assert.Equal(t, DefaultGlobalMissConfigurationRetry, res.RequeueAfter)
@@ -1348,4 +1791,77 @@ func TestUpdateObjectSetOrPhaseStatusFromError(t *testing.T) {
 
 		um.AssertExpectations(t)
 	})
+
+	t.Run("reports rejected apply", func(t *testing.T) {
+		t.Parallel()
+
+		objectSet := &objectSetOrPhaseStub{}
+
+		um := &testUpdateMock{}
+
+		um.On("Update", mock.Anything).Return(nil)
+
+		ctx := context.Background()
+		applyErr := &ApplyError{Reason: ErrorReasonForbidden, Err: errTest}
+		res, err := UpdateObjectSetOrPhaseStatusFromError(
+			ctx, objectSet, fmt.Errorf("patching object: %w", applyErr), nil, um.Update)
+
+		require.NoError(t, err)
+		assert.Equal(t, DefaultGlobalMissConfigurationRetry, res.RequeueAfter)
+		if assert.NotEmpty(t, objectSet.GetConditions()) {
+			cond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetAvailable)
+			assert.Equal(t, "MissingPermissions", cond.Reason)
+		}
+
+		um.AssertExpectations(t)
+	})
+
+	t.Run("backs off persistent failures", func(t *testing.T) {
+		t.Parallel()
+
+		objectSet := &objectSetOrPhaseStub{}
+		objectSet.ObjectSet.UID = "test-uid"
+		failureBackoff := NewFailureBackoff(DefaultInitialBackoff, DefaultMaxBackoff)
+
+		um := &testUpdateMock{}
+
+		um.On("Update", mock.Anything).Return(nil)
+
+		ctx := context.Background()
+		for i := 1; i < PersistentFailureThreshold; i++ {
+			_, err := UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, errTest, failureBackoff, um.Update)
+			require.ErrorIs(t, err, errTest)
+		}
+		res, err := UpdateObjectSetOrPhaseStatusFromError(ctx, objectSet, errTest, failureBackoff, um.Update)
+
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, res.RequeueAfter, 4*DefaultInitialBackoff)
+		cond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetRetryBackoff)
+		if assert.NotNil(t, cond) {
+			assert.Equal(t, metav1.ConditionTrue, cond.Status)
+			assert.Equal(t, "InfrastructureError", cond.Reason)
+		}
+
+		um.AssertExpectations(t)
+	})
+}
+
+type auditSinkStub struct {
+	entries []audit.Entry
+}
+
+func (s *auditSinkStub) Record(_ context.Context, entry audit.Entry) {
+	s.entries = append(s.entries, entry)
+}
+
+func TestPhaseFromProbingResultMessage(t *testing.T) {
+	t.Parallel()
+
+	res := ProbingResult{PhaseName: "deploy \"all\"", FailedProbes: []string{"not ready"}}
+	phaseName, ok := PhaseFromProbingResultMessage(res.String())
+	assert.True(t, ok)
+	assert.Equal(t, "deploy \"all\"", phaseName)
+
+	_, ok = PhaseFromProbingResultMessage("Object is available and passes all probes.")
+	assert.False(t, ok)
 }
@@ -0,0 +1,239 @@
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// PriorityIndex remembers the priority of objects by their name,
+// so queued reconcile requests can be ordered by it.
+type PriorityIndex struct {
+	lock       sync.RWMutex
+	priorities map[types.NamespacedName]int32
+}
+
+func NewPriorityIndex() *PriorityIndex {
+	return &PriorityIndex{
+		priorities: map[types.NamespacedName]int32{},
+	}
+}
+
+// Priority returns the recorded priority of a queued reconcile.Request.
+// Unknown items have the default priority of 0.
+func (i *PriorityIndex) Priority(item any) int32 {
+	req, ok := item.(reconcile.Request)
+	if !ok {
+		return 0
+	}
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.priorities[req.NamespacedName]
+}
+
+// Predicate records the priority of every object passing through it and never filters events.
+// It has to be registered for the primary watch of the controller, so the priority is known
+// before the request for an object is queued.
+func (i *PriorityIndex) Predicate(priority func(obj client.Object) int32) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			i.set(e.Object, priority(e.Object))
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			i.set(e.ObjectNew, priority(e.ObjectNew))
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			i.lock.Lock()
+			defer i.lock.Unlock()
+			delete(i.priorities, client.ObjectKeyFromObject(e.Object))
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			i.set(e.Object, priority(e.Object))
+			return true
+		},
+	}
+}
+
+func (i *PriorityIndex) set(obj client.Object, priority int32) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if priority == 0 {
+		delete(i.priorities, client.ObjectKeyFromObject(obj))
+		return
+	}
+	i.priorities[client.ObjectKeyFromObject(obj)] = priority
+}
+
+// NewPriorityQueueFactory returns a constructor for controller.Options.NewQueue,
+// creating queues that hand out requests for objects with a higher priority first.
+func NewPriorityQueueFactory(
+	index *PriorityIndex,
+) func(controllerName string, rateLimiter ratelimiter.RateLimiter) workqueue.RateLimitingInterface {
+	return func(_ string, rateLimiter ratelimiter.RateLimiter) workqueue.RateLimitingInterface {
+		return NewPriorityQueue(rateLimiter, index.Priority)
+	}
+}
+
+var _ workqueue.RateLimitingInterface = (*PriorityQueue)(nil)
+
+// PriorityQueue is a rate limited work queue handing out the item with the highest priority first.
+// Items with the same priority are handed out in the order they were added.
+// Like the client-go work queue, it never processes an item concurrently
+// and deduplicates items that are added multiple times before being processed.
+type PriorityQueue struct {
+	rateLimiter ratelimiter.RateLimiter
+	priority    func(item any) int32
+
+	cond *sync.Cond
+	// queued items in order of addition.
+	queue []any
+	// items that need to be processed.
+	dirty map[any]struct{}
+	// items that are currently being processed.
+	processing map[any]struct{}
+
+	shuttingDown bool
+	drain        bool
+}
+
+func NewPriorityQueue(rateLimiter ratelimiter.RateLimiter, priority func(item any) int32) *PriorityQueue {
+	return &PriorityQueue{
+		rateLimiter: rateLimiter,
+		priority:    priority,
+		cond:        sync.NewCond(&sync.Mutex{}),
+		dirty:       map[any]struct{}{},
+		processing:  map[any]struct{}{},
+	}
+}
+
+// Add marks item as needing processing.
+func (q *PriorityQueue) Add(item any) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	if _, ok := q.dirty[item]; ok {
+		return
+	}
+	q.dirty[item] = struct{}{}
+	if _, ok := q.processing[item]; ok {
+		// Queued again when processing is done.
+		return
+	}
+	q.queue = append(q.queue, item)
+	q.cond.Signal()
+}
+
+// Len returns the number of items waiting to be processed.
+func (q *PriorityQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return len(q.queue)
+}
+
+// Get blocks until it can return the item with the highest priority to be processed.
+// If shutdown = true, the caller should end their goroutine.
+// Done must be called with the item when processing has finished.
+func (q *PriorityQueue) Get() (item any, shutdown bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for len(q.queue) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if len(q.queue) == 0 {
+		// We must be shutting down.
+		return nil, true
+	}
+
+	next := 0
+	nextPriority := q.priority(q.queue[0])
+	for i := 1; i < len(q.queue); i++ {
+		if p := q.priority(q.queue[i]); p > nextPriority {
+			next, nextPriority = i, p
+		}
+	}
+	item = q.queue[next]
+	q.queue = append(q.queue[:next], q.queue[next+1:]...)
+
+	q.processing[item] = struct{}{}
+	delete(q.dirty, item)
+	return item, false
+}
+
+// Done marks item as done processing and queues it again,
+// if it was marked dirty while being processed.
+func (q *PriorityQueue) Done(item any) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	delete(q.processing, item)
+	if _, ok := q.dirty[item]; ok {
+		q.queue = append(q.queue, item)
+	}
+	// Wakes up getters and a draining shutdown.
+	q.cond.Broadcast()
+}
+
+// ShutDown makes Get return and ignores all items added afterwards.
+func (q *PriorityQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = false
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain shuts down the queue and blocks until all items being processed are done.
+func (q *PriorityQueue) ShutDownWithDrain() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = true
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) != 0 && q.drain {
+		q.cond.Wait()
+	}
+}
+
+func (q *PriorityQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.shuttingDown
+}
+
+// AddAfter adds item after the given duration has passed.
+func (q *PriorityQueue) AddAfter(item any, duration time.Duration) {
+	if q.ShuttingDown() {
+		return
+	}
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+	time.AfterFunc(duration, func() { q.Add(item) })
+}
+
+// AddRateLimited adds item after the rate limiter says it is ok.
+func (q *PriorityQueue) AddRateLimited(item any) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+// Forget indicates that an item is finished being retried.
+func (q *PriorityQueue) Forget(item any) {
+	q.rateLimiter.Forget(item)
+}
+
+// NumRequeues returns how many times the item was requeued.
+func (q *PriorityQueue) NumRequeues(item any) int {
+	return q.rateLimiter.NumRequeues(item)
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Parallel()
+
+	priorities := map[string]int32{"cni": 100, "dns": 50}
+	q := NewPriorityQueue(workqueue.DefaultControllerRateLimiter(), func(item any) int32 {
+		return priorities[item.(string)]
+	})
+
+	for _, item := range []string{"app1", "dns", "app2", "cni", "app1"} {
+		q.Add(item)
+	}
+	assert.Equal(t, 4, q.Len())
+
+	var order []string
+	for range 4 {
+		item, shutdown := q.Get()
+		require.False(t, shutdown)
+		order = append(order, item.(string))
+		q.Done(item)
+	}
+	assert.Equal(t, []string{"cni", "dns", "app1", "app2"}, order)
+
+	q.ShutDown()
+	_, shutdown := q.Get()
+	assert.True(t, shutdown)
+}
+
+func TestPriorityQueue_requeueWhileProcessing(t *testing.T) {
+	t.Parallel()
+
+	q := NewPriorityQueue(workqueue.DefaultControllerRateLimiter(), func(any) int32 { return 0 })
+
+	q.Add("test")
+	item, _ := q.Get()
+
+	// Items are never handed out concurrently.
+	q.Add("test")
+	assert.Equal(t, 0, q.Len())
+
+	q.Done(item)
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestPriorityIndex(t *testing.T) {
+	t.Parallel()
+
+	index := NewPriorityIndex()
+	p := index.Predicate(func(obj client.Object) int32 {
+		return obj.(*corev1alpha1.Package).Spec.Priority
+	})
+
+	pkg := &corev1alpha1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: "cni", Namespace: "test"},
+		Spec:       corev1alpha1.PackageSpec{Priority: 100},
+	}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cni", Namespace: "test"}}
+
+	assert.True(t, p.Create(event.CreateEvent{Object: pkg}))
+	assert.Equal(t, int32(100), index.Priority(req))
+
+	assert.True(t, p.Delete(event.DeleteEvent{Object: pkg}))
+	assert.Equal(t, int32(0), index.Priority(req))
+}
@@ -0,0 +1,22 @@
+package controllers
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// ReconcileRequestedPredicate lets updates pass that change the reconcile-requested annotation.
+// Combine it with other update filters, like the GenerationChangedPredicate, via predicate.Or.
+func ReconcileRequestedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			return e.ObjectOld.GetAnnotations()[corev1alpha1.ReconcileRequestedAnnotation] !=
+				e.ObjectNew.GetAnnotations()[corev1alpha1.ReconcileRequestedAnnotation]
+		},
+	}
+}
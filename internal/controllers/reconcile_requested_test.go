@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestReconcileRequestedPredicate(t *testing.T) {
+	t.Parallel()
+
+	newObj := func(requested string) *corev1alpha1.ObjectSet {
+		obj := &corev1alpha1.ObjectSet{}
+		if len(requested) > 0 {
+			obj.Annotations = map[string]string{corev1alpha1.ReconcileRequestedAnnotation: requested}
+		}
+		return obj
+	}
+
+	tests := []struct {
+		name     string
+		old, new string
+		expected bool
+	}{
+		{name: "unchanged"},
+		{name: "same request", old: "1", new: "1"},
+		{name: "added", new: "1", expected: true},
+		{name: "changed", old: "1", new: "2", expected: true},
+		{name: "removed", old: "1", expected: true},
+	}
+
+	p := ReconcileRequestedPredicate()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, p.Update(event.UpdateEvent{
+				ObjectOld: newObj(test.old), ObjectNew: newObj(test.new),
+			}))
+		})
+	}
+
+	assert.True(t, p.Create(event.CreateEvent{Object: &corev1alpha1.ObjectSet{}}))
+}
@@ -3,6 +3,8 @@ package dynamiccache
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
 	"sync"
 
@@ -40,6 +42,7 @@ type informerMap interface {
 		ctx context.Context,
 		gvk schema.GroupVersionKind,
 	) error
+	Unsynced() []schema.GroupVersionKind
 }
 
 type cacheSourcer interface {
@@ -91,7 +94,7 @@ func NewCache(
 
 	c.informerMap = NewInformerMap(
 		config, scheme, mapper,
-		c.opts.ResyncInterval, c.opts.Selectors, c.opts.Indexers)
+		c.opts.ResyncInterval, c.opts.ListPageSize, c.opts.Selectors, c.opts.Indexers)
 
 	return c
 }
@@ -214,6 +217,44 @@ func (c *Cache) Free(
 	return nil
 }
 
+// FreeAll releases all watches, regardless of their owners.
+// Used when the whole cache is discarded, e.g. because the cluster it watches is no longer targeted.
+func (c *Cache) FreeAll(ctx context.Context) error {
+	c.informerReferencesMux.Lock()
+	defer c.informerReferencesMux.Unlock()
+
+	for gvk := range c.informerReferences {
+		if err := c.informerMap.Delete(ctx, gvk); err != nil {
+			return fmt.Errorf("releasing informer for %v: %w", gvk, err)
+		}
+		delete(c.informerReferences, gvk)
+	}
+	return nil
+}
+
+// CacheNotSyncedError is returned by ReadyCheck while informers are still syncing.
+type CacheNotSyncedError struct {
+	GVKs []schema.GroupVersionKind
+}
+
+func (e *CacheNotSyncedError) Error() string {
+	gvks := make([]string, len(e.GVKs))
+	for i, gvk := range e.GVKs {
+		gvks[i] = gvk.String()
+	}
+	sort.Strings(gvks)
+	return "waiting for informers to sync: " + strings.Join(gvks, ", ")
+}
+
+// ReadyCheck implements healthz.Checker.
+// It fails while any informer of the cache has not synced yet.
+func (c *Cache) ReadyCheck(_ *http.Request) error {
+	if unsynced := c.informerMap.Unsynced(); len(unsynced) > 0 {
+		return &CacheNotSyncedError{GVKs: unsynced}
+	}
+	return nil
+}
+
 // CacheNotStartedError is returned when trying to read from a cache before starting a watch.
 type CacheNotStartedError struct{}
 
@@ -169,6 +169,24 @@ func TestCache_Free(t *testing.T) {
 	})
 }
 
+func TestCache_FreeAll(t *testing.T) {
+	t.Parallel()
+	c, _, informerMap := setupTestCache(t)
+	secretGVK := schema.GroupVersionKind{Kind: "Secret", Version: "v1"}
+	configMapGVK := schema.GroupVersionKind{Kind: "ConfigMap", Version: "v1"}
+	c.informerReferences[secretGVK] = map[OwnerReference]struct{}{{Name: "a"}: {}}
+	c.informerReferences[configMapGVK] = map[OwnerReference]struct{}{{Name: "b"}: {}}
+	informerMap.
+		On("Delete", mock.Anything, mock.Anything).
+		Return(nil)
+
+	require.NoError(t, c.FreeAll(context.Background()))
+
+	informerMap.AssertCalled(t, "Delete", mock.Anything, secretGVK)
+	informerMap.AssertCalled(t, "Delete", mock.Anything, configMapGVK)
+	assert.Empty(t, c.informerReferences)
+}
+
 //nolint:paralleltest
 func TestCache_Reader(t *testing.T) {
 	c, _, informerMap := setupTestCache(t)
@@ -278,6 +296,28 @@ func TestCache_sampleMetrics(t *testing.T) {
 	recorderMock.AssertCalled(t, "RecordDynamicCacheObjects", configMapGVK, 1)
 }
 
+func TestCache_ReadyCheck(t *testing.T) {
+	t.Parallel()
+
+	c, _, informerMap := setupTestCache(t)
+
+	secretGVK := corev1.SchemeGroupVersion.WithKind("Secret")
+	informerMap.
+		On("Unsynced").
+		Return([]schema.GroupVersionKind{secretGVK}).
+		Once()
+	informerMap.
+		On("Unsynced").
+		Return([]schema.GroupVersionKind(nil))
+
+	err := c.ReadyCheck(nil)
+	var notSyncedErr *CacheNotSyncedError
+	require.ErrorAs(t, err, &notSyncedErr)
+	assert.Equal(t, "waiting for informers to sync: /v1, Kind=Secret", err.Error())
+
+	require.NoError(t, c.ReadyCheck(nil))
+}
+
 func setupTestCache(t *testing.T) (*Cache, *cacheSourceMock, *informerMapMock) {
 	t.Helper()
 	scheme := runtime.NewScheme()
@@ -328,6 +368,11 @@ func (m *informerMapMock) Delete(
 	return args.Error(0)
 }
 
+func (m *informerMapMock) Unsynced() []schema.GroupVersionKind {
+	args := m.Called()
+	return args.Get(0).([]schema.GroupVersionKind)
+}
+
 type cacheSourceMock struct {
 	mock.Mock
 }
@@ -36,16 +36,18 @@ func NewInformerMap(
 	scheme *runtime.Scheme,
 	mapper apimachinerymeta.RESTMapper,
 	resync time.Duration,
+	listPageSize int64,
 	selectors SelectorsByGVK,
 	indexers FieldIndexersByGVK,
 ) *InformerMap {
 	return &InformerMap{
-		config:    config,
-		scheme:    scheme,
-		mapper:    mapper,
-		resync:    resync,
-		selectors: selectors.forGVK,
-		indexers:  indexers.forGVK,
+		config:       config,
+		scheme:       scheme,
+		mapper:       mapper,
+		resync:       resync,
+		listPageSize: listPageSize,
+		selectors:    selectors.forGVK,
+		indexers:     indexers.forGVK,
 
 		informers:     map[schema.GroupVersionKind]mapEntry{},
 		dynamicClient: dynamic.NewForConfigOrDie(config),
@@ -68,6 +70,10 @@ type InformerMap struct {
 	// so that all informers will not send list requests simultaneously.
 	resync time.Duration
 
+	// listPageSize is the number of objects requested per page,
+	// when informers list objects from the apiserver.
+	listPageSize int64
+
 	// selectors are the label or field selectors that will be added to the
 	// ListWatch ListOptions.
 	selectors func(gvk schema.GroupVersionKind) Selector
@@ -117,6 +123,20 @@ func (im *InformerMap) Get(
 	return
 }
 
+// Unsynced returns the GVKs of all informers that have not synced yet.
+func (im *InformerMap) Unsynced() []schema.GroupVersionKind {
+	im.informersMux.RLock()
+	defer im.informersMux.RUnlock()
+
+	var unsynced []schema.GroupVersionKind
+	for gvk, entry := range im.informers {
+		if !entry.Informer.HasSynced() {
+			unsynced = append(unsynced, gvk)
+		}
+	}
+	return unsynced
+}
+
 // Delete shuts down an informer for the given GVK, if one is registered.
 func (im *InformerMap) Delete(
 	_ context.Context,
@@ -197,6 +217,10 @@ func (im *InformerMap) createListWatch(
 	return &cache.ListWatch{
 		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
 			im.selectors(gvk).ApplyToList(&opts)
+			// The reflector follows continue tokens until all pages have been listed.
+			if im.listPageSize > 0 {
+				opts.Limit = im.listPageSize
+			}
 			return client.List(ctx, opts)
 		},
 		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
@@ -10,6 +10,7 @@ import (
 var (
 	_ CacheOption = (*FieldIndexersByGVK)(nil)
 	_ CacheOption = (*SelectorsByGVK)(nil)
+	_ CacheOption = (*ListPageSize)(nil)
 )
 
 // FieldIndexers by GroupVersionKind.
@@ -44,6 +45,17 @@ type ResyncInterval time.Duration
 // Default cache resunc interval, if not specified.
 const defaultResyncInterval = 10 * time.Hour
 
+// Number of objects requested per page when informers list objects from the API server.
+// Lists are chunked to prevent memory spikes on the API server when priming caches.
+type ListPageSize int64
+
+func (s ListPageSize) ApplyToCacheOptions(opts *CacheOptions) {
+	opts.ListPageSize = int64(s)
+}
+
+// Default page size for informer lists, if not specified.
+const defaultListPageSize = 500
+
 // FieldIndexer adds a custom index to the cache.
 type FieldIndexer struct {
 	// Field name to refer to the index later.
@@ -65,10 +77,15 @@ type CacheOptions struct {
 	Selectors SelectorsByGVK
 	// Time between full cache resyncs.
 	ResyncInterval time.Duration
+	// Number of objects requested per page when listing objects.
+	ListPageSize int64
 }
 
 func (co *CacheOptions) Default() {
 	if co.ResyncInterval == 0 {
 		co.ResyncInterval = defaultResyncInterval
 	}
+	if co.ListPageSize == 0 {
+		co.ListPageSize = defaultListPageSize
+	}
 }
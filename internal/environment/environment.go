@@ -3,9 +3,14 @@
 package environment
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/discovery"
@@ -34,6 +40,11 @@ const (
 	// Special ConfigMap describing a managed OpenShift cluster.
 	managedOpenShiftCMName      = "osd-cluster-metadata"
 	managedOpenShiftCMNamespace = "openshift-config"
+
+	// Pods are configured to search "<namespace>.svc.<cluster-domain>", "svc.<cluster-domain>"
+	// and "<cluster-domain>" when resolving names.
+	defaultResolvConfPath = "/etc/resolv.conf"
+	defaultClusterDomain  = "cluster.local"
 )
 
 type Sinker interface {
@@ -63,6 +74,8 @@ type Manager struct {
 	client          client.Client
 	discoveryClient serverVersionDiscoverer
 	restMapper      restMapper
+	// Path to the resolver configuration of the Pod, used to detect the cluster domain.
+	resolvConfPath string
 
 	sinks []Sinker
 }
@@ -76,6 +89,7 @@ func NewManager(
 		client:          client,
 		discoveryClient: discoveryClient,
 		restMapper:      restMapper,
+		resolvConfPath:  defaultResolvConfPath,
 	}
 }
 
@@ -122,7 +136,12 @@ func (m *Manager) probe(ctx context.Context) (
 	env *manifests.PackageEnvironment, err error,
 ) {
 	env = &manifests.PackageEnvironment{}
-	kubeEnv, err := m.kubernetesEnvironment(ctx)
+	nodeList := &corev1.NodeList{}
+	if err := m.client.List(ctx, nodeList); err != nil {
+		return env, fmt.Errorf("listing Nodes: %w", err)
+	}
+
+	kubeEnv, err := m.kubernetesEnvironment(nodeList.Items)
 	if err != nil {
 		return env, fmt.Errorf("getting k8s env: %w", err)
 	}
@@ -151,10 +170,22 @@ func (m *Manager) probe(ctx context.Context) (
 	}
 	env.HyperShift = hyperShiftEnv
 
+	prometheusOperatorEnv, _, err := m.prometheusOperatorEnvironment()
+	if err != nil {
+		return env, fmt.Errorf("getting Prometheus Operator env: %w", err)
+	}
+	env.PrometheusOperator = prometheusOperatorEnv
+
+	clusterEnv, err := m.clusterEnvironment(ctx, nodeList.Items)
+	if err != nil {
+		return env, fmt.Errorf("getting cluster env: %w", err)
+	}
+	env.Cluster = clusterEnv
+
 	return env, nil
 }
 
-func (m *Manager) kubernetesEnvironment(_ context.Context) (
+func (m *Manager) kubernetesEnvironment(nodes []corev1.Node) (
 	kubeEnv manifests.PackageEnvironmentKubernetes, err error,
 ) {
 	serverVersion, err := m.discoveryClient.ServerVersion()
@@ -162,9 +193,136 @@ func (m *Manager) kubernetesEnvironment(_ context.Context) (
 		return kubeEnv, fmt.Errorf("getting server version from discovery API: %w", err)
 	}
 	kubeEnv.Version = serverVersion.GitVersion
+
+	kubeEnv.ClusterDomain, err = clusterDomain(m.resolvConfPath)
+	if err != nil {
+		return kubeEnv, fmt.Errorf("detecting cluster domain: %w", err)
+	}
+	kubeEnv.CloudProvider, kubeEnv.Zones = nodeTopology(nodes)
+
 	return kubeEnv, nil
 }
 
+// Detects the cluster domain from the DNS search domains of the Pod.
+// Falls back to the Kubernetes default when not running in a Pod.
+func clusterDomain(resolvConfPath string) (string, error) {
+	f, err := os.Open(resolvConfPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return defaultClusterDomain, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "search" {
+			continue
+		}
+
+		for _, domain := range fields[1:] {
+			if clusterDomain, ok := strings.CutPrefix(domain, "svc."); ok {
+				return strings.TrimSuffix(clusterDomain, "."), nil
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return defaultClusterDomain, nil
+}
+
+// Returns the cloud provider and sorted topology zones of the given nodes.
+// Provider IDs have the form "<provider>://<provider specific id>".
+func nodeTopology(nodes []corev1.Node) (cloudProvider string, zones []string) {
+	for _, node := range nodes {
+		if provider, _, ok := strings.Cut(node.Spec.ProviderID, "://"); ok && cloudProvider == "" {
+			cloudProvider = provider
+		}
+
+		zone := node.Labels[corev1.LabelTopologyZone]
+		if zone != "" && !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+	slices.Sort(zones)
+
+	return cloudProvider, zones
+}
+
+var clusterClaimListGVK = schema.GroupVersionKind{
+	Group:   "cluster.open-cluster-management.io",
+	Version: "v1alpha1",
+	Kind:    "ClusterClaimList",
+}
+
+// Collects metadata identifying the cluster within a fleet,
+// so one package can configure itself per cluster without external templating.
+func (m *Manager) clusterEnvironment(ctx context.Context, nodes []corev1.Node) (
+	clusterEnv *manifests.PackageEnvironmentCluster, err error,
+) {
+	claims, err := m.clusterClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodeLabels := sharedNodeLabels(nodes)
+	if len(claims) == 0 && len(nodeLabels) == 0 {
+		return nil, nil
+	}
+	return &manifests.PackageEnvironmentCluster{
+		Claims:     claims,
+		NodeLabels: nodeLabels,
+	}, nil
+}
+
+// Returns the values of Open Cluster Management ClusterClaims by name.
+func (m *Manager) clusterClaims(ctx context.Context) (map[string]string, error) {
+	claimList := &unstructured.UnstructuredList{}
+	claimList.SetGroupVersionKind(clusterClaimListGVK)
+	err := m.client.List(ctx, claimList)
+	switch {
+	case meta.IsNoMatchError(err) ||
+		apimachineryerrors.IsNotFound(err) ||
+		discovery.IsGroupDiscoveryFailedError(errors.Unwrap(err)):
+		// API not registered in cluster
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("listing ClusterClaims: %w", err)
+	}
+
+	var claims map[string]string
+	for _, claim := range claimList.Items {
+		value, _, _ := unstructured.NestedString(claim.Object, "spec", "value")
+		if claims == nil {
+			claims = map[string]string{}
+		}
+		claims[claim.GetName()] = value
+	}
+	return claims, nil
+}
+
+// Returns the labels set to the same value on all given nodes.
+func sharedNodeLabels(nodes []corev1.Node) map[string]string {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	labels := maps.Clone(nodes[0].Labels)
+	for _, node := range nodes[1:] {
+		maps.DeleteFunc(labels, func(k, v string) bool {
+			nodeValue, ok := node.Labels[k]
+			return !ok || nodeValue != v
+		})
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	return labels
+}
+
 func (m *Manager) openShiftEnvironment(ctx context.Context) (
 	openShiftEnv *manifests.PackageEnvironmentOpenShift, isOpenShift bool, err error,
 ) {
@@ -284,6 +442,32 @@ func (m *Manager) hyperShiftEnvironment() (
 	return nil, false, fmt.Errorf("hypershiftv1beta1 probing: %w", err)
 }
 
+var serviceMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "ServiceMonitor",
+}
+
+// Packages only generate ServiceMonitors when the Prometheus Operator API is present.
+func (m *Manager) prometheusOperatorEnvironment() (
+	prometheusOperator *manifests.PackageEnvironmentPrometheusOperator, isPrometheusOperator bool, err error,
+) {
+	_, err = m.restMapper.
+		RESTMapping(serviceMonitorGVK.GroupKind(), serviceMonitorGVK.Version)
+	switch {
+	case err == nil:
+		return &manifests.PackageEnvironmentPrometheusOperator{}, true, nil
+
+	case meta.IsNoMatchError(err) ||
+		apimachineryerrors.IsNotFound(err) ||
+		discovery.IsGroupDiscoveryFailedError(errors.Unwrap(err)):
+		// ServiceMonitor API is NOT present on the cluster.
+		return nil, false, nil
+	}
+
+	return nil, false, fmt.Errorf("ServiceMonitor API probing: %w", err)
+}
+
 var _ Sinker = (*Sink)(nil)
 
 type Sink struct {
@@ -13,6 +13,7 @@ import (
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/version"
 
@@ -63,11 +64,43 @@ func TestManager_Init_Kubernetes(t *testing.T) {
 			mock.AnythingOfType("*v1.ClusterVersion"), mock.Anything,
 		).
 		Return(&meta.NoKindMatchError{})
+	c.
+		On(
+			"List", mock.Anything,
+			mock.AnythingOfType("*v1.NodeList"), mock.Anything,
+		).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1.NodeList)
+			*list = corev1.NodeList{
+				Items: []corev1.Node{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{corev1.LabelTopologyZone: "us-east-1b"},
+						},
+						Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1b/i-2"},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{corev1.LabelTopologyZone: "us-east-1a"},
+						},
+						Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1a/i-1"},
+					},
+				},
+			}
+		}).
+		Return(nil)
+	c.
+		On(
+			"List", mock.Anything,
+			mock.AnythingOfType("*unstructured.UnstructuredList"), mock.Anything,
+		).
+		Return(&meta.NoKindMatchError{})
 	rm.
 		On("RESTMapping", mock.Anything, mock.Anything).
 		Return(&meta.RESTMapping{}, nil)
 
 	mgr := NewManager(c, dc, rm)
+	mgr.resolvConfPath = "testdata/resolv.conf"
 
 	ctx := context.Background()
 	err := mgr.Init(ctx, []Sinker{sink})
@@ -76,9 +109,13 @@ func TestManager_Init_Kubernetes(t *testing.T) {
 	env := sink.env
 	assert.Equal(t, &manifests.PackageEnvironment{
 		Kubernetes: manifests.PackageEnvironmentKubernetes{
-			Version: "v1.2.3",
+			Version:       "v1.2.3",
+			ClusterDomain: "example.local",
+			CloudProvider: "aws",
+			Zones:         []string{"us-east-1a", "us-east-1b"},
 		},
-		HyperShift: &manifests.PackageEnvironmentHyperShift{},
+		HyperShift:         &manifests.PackageEnvironmentHyperShift{},
+		PrometheusOperator: &manifests.PackageEnvironmentPrometheusOperator{},
 	}, env)
 }
 
@@ -144,11 +181,43 @@ func TestManager_Init_OpenShift(t *testing.T) {
 			}
 		}).
 		Return(nil)
+	c.
+		On(
+			"List", mock.Anything,
+			mock.AnythingOfType("*v1.NodeList"), mock.Anything,
+		).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1.NodeList)
+			*list = corev1.NodeList{
+				Items: []corev1.Node{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{corev1.LabelTopologyZone: "us-east-1b"},
+						},
+						Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1b/i-2"},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{corev1.LabelTopologyZone: "us-east-1a"},
+						},
+						Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1a/i-1"},
+					},
+				},
+			}
+		}).
+		Return(nil)
+	c.
+		On(
+			"List", mock.Anything,
+			mock.AnythingOfType("*unstructured.UnstructuredList"), mock.Anything,
+		).
+		Return(&meta.NoKindMatchError{})
 	rm.
 		On("RESTMapping", mock.Anything, mock.Anything).
 		Return(&meta.RESTMapping{}, nil)
 
 	mgr := NewManager(c, dc, rm)
+	mgr.resolvConfPath = "testdata/resolv.conf"
 
 	ctx := context.Background()
 	err := mgr.Init(ctx, []Sinker{sink})
@@ -157,7 +226,10 @@ func TestManager_Init_OpenShift(t *testing.T) {
 	env := sink.env
 	assert.Equal(t, &manifests.PackageEnvironment{
 		Kubernetes: manifests.PackageEnvironmentKubernetes{
-			Version: "v1.2.3",
+			Version:       "v1.2.3",
+			ClusterDomain: "example.local",
+			CloudProvider: "aws",
+			Zones:         []string{"us-east-1a", "us-east-1b"},
 		},
 		OpenShift: &manifests.PackageEnvironmentOpenShift{
 			Version: "v123",
@@ -167,7 +239,8 @@ func TestManager_Init_OpenShift(t *testing.T) {
 				},
 			},
 		},
-		HyperShift: &manifests.PackageEnvironmentHyperShift{},
+		HyperShift:         &manifests.PackageEnvironmentHyperShift{},
+		PrometheusOperator: &manifests.PackageEnvironmentPrometheusOperator{},
 		Proxy: &manifests.PackageEnvironmentProxy{
 			HTTPProxy:  "httpxxx",
 			HTTPSProxy: "httpsxxx",
@@ -300,6 +373,141 @@ func TestManager_hyperShiftEnvironment_error(t *testing.T) {
 	require.ErrorIs(t, err, errExample)
 }
 
+func TestManager_prometheusOperatorEnvironment_handledErrors(t *testing.T) {
+	t.Parallel()
+	rm := &restmappermock.RestMapperMock{}
+
+	rm.
+		On(
+			"RESTMapping", mock.Anything, mock.Anything,
+		).
+		Return(&meta.RESTMapping{}, &meta.NoKindMatchError{})
+
+	mgr := NewManager(nil, nil, rm)
+	env, ok, err := mgr.prometheusOperatorEnvironment()
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, env)
+}
+
+func TestManager_prometheusOperatorEnvironment_error(t *testing.T) {
+	t.Parallel()
+	rm := &restmappermock.RestMapperMock{}
+
+	rm.
+		On(
+			"RESTMapping", mock.Anything, mock.Anything,
+		).
+		Return(&meta.RESTMapping{}, errExample)
+
+	mgr := NewManager(nil, nil, rm)
+	_, _, err := mgr.prometheusOperatorEnvironment()
+	require.ErrorIs(t, err, errExample)
+}
+
+func TestClusterDomain(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		path     string
+		expected string
+	}{
+		"search domains": {path: "testdata/resolv.conf", expected: "example.local"},
+		"not in a pod":   {path: "testdata/does-not-exist", expected: "cluster.local"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			domain, err := clusterDomain(tc.path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, domain)
+		})
+	}
+}
+
+func TestNodeTopology(t *testing.T) {
+	t.Parallel()
+
+	provider, zones := nodeTopology([]corev1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{corev1.LabelTopologyZone: "b"}}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{corev1.LabelTopologyZone: "a"}},
+			Spec:       corev1.NodeSpec{ProviderID: "gce://project/a/node"},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{corev1.LabelTopologyZone: "b"}}},
+	})
+	assert.Equal(t, "gce", provider)
+	assert.Equal(t, []string{"a", "b"}, zones)
+
+	provider, zones = nodeTopology(nil)
+	assert.Empty(t, provider)
+	assert.Nil(t, zones)
+}
+
+func TestManager_clusterEnvironment(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+
+	c.
+		On(
+			"List", mock.Anything,
+			mock.AnythingOfType("*unstructured.UnstructuredList"), mock.Anything,
+		).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*unstructured.UnstructuredList)
+			assert.Equal(t, clusterClaimListGVK, list.GroupVersionKind())
+			claim := unstructured.Unstructured{}
+			claim.SetName("id.k8s.io")
+			_ = unstructured.SetNestedField(claim.Object, "4c8f1d2e", "spec", "value")
+			list.Items = []unstructured.Unstructured{claim}
+		}).
+		Return(nil)
+
+	ctx := context.Background()
+	mgr := NewManager(c, nil, nil)
+	clusterEnv, err := mgr.clusterEnvironment(ctx, []corev1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"fleet": "blue"}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &manifests.PackageEnvironmentCluster{
+		Claims:     map[string]string{"id.k8s.io": "4c8f1d2e"},
+		NodeLabels: map[string]string{"fleet": "blue"},
+	}, clusterEnv)
+}
+
+func TestManager_clusterEnvironment_API_not_registered(t *testing.T) {
+	t.Parallel()
+	c := testutil.NewClient()
+
+	c.
+		On(
+			"List", mock.Anything,
+			mock.AnythingOfType("*unstructured.UnstructuredList"), mock.Anything,
+		).
+		Return(&meta.NoKindMatchError{})
+
+	ctx := context.Background()
+	mgr := NewManager(c, nil, nil)
+	clusterEnv, err := mgr.clusterEnvironment(ctx, nil)
+	require.NoError(t, err)
+	assert.Nil(t, clusterEnv)
+}
+
+func TestSharedNodeLabels(t *testing.T) {
+	t.Parallel()
+
+	labels := sharedNodeLabels([]corev1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"fleet": "blue", corev1.LabelTopologyZone: "a", corev1.LabelOSStable: "linux",
+		}}},
+		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"fleet": "blue", corev1.LabelTopologyZone: "b",
+		}}},
+	})
+	assert.Equal(t, map[string]string{"fleet": "blue"}, labels)
+	assert.Nil(t, sharedNodeLabels(nil))
+}
+
 type discoveryClientMock struct {
 	mock.Mock
 }
@@ -0,0 +1,75 @@
+// Package errorclass classifies reconcile errors by what it takes to resolve them,
+// so all controllers report them with the same condition reasons and retry them the same way.
+package errorclass
+
+import (
+	"errors"
+	"strings"
+	"text/template"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Class of an error, also used as reason in status conditions reporting it.
+type Class string
+
+const (
+	// Failures of the API server, the network or other infrastructure,
+	// which are expected to go away on their own.
+	Infrastructure Class = "InfrastructureError"
+	// Invalid configuration provided by the user, e.g. objects rejected as invalid.
+	Config Class = "ConfigError"
+	// Package Operator lacks the permissions to work on an object.
+	RBAC Class = "MissingPermissions"
+	// An admission webhook denied a request.
+	WebhookRejected Class = "AdmissionWebhookRejected"
+	// Templates failed to render.
+	Template Class = "TemplateError"
+)
+
+// ConditionReason returns the reason used in status conditions reporting errors of this class.
+func (c Class) ConditionReason() string {
+	return string(c)
+}
+
+// Retriable returns true for errors expected to resolve without user intervention.
+// Other errors should not be retried in a hot loop, but with a backoff until the user fixed them.
+func (c Class) Retriable() bool {
+	return c == Infrastructure
+}
+
+// Implemented by errors that know their class.
+type classifiedError interface {
+	ErrorClass() Class
+}
+
+// Of returns the class of the given error.
+// Errors that can not be classified are assumed to be infrastructure errors.
+func Of(err error) Class {
+	var classified classifiedError
+	if errors.As(err, &classified) {
+		return classified.ErrorClass()
+	}
+
+	var execErr template.ExecError
+	switch {
+	case errors.As(err, &execErr):
+		return Template
+	case IsAdmissionWebhookDenial(err):
+		return WebhookRejected
+	case apimachineryerrors.IsForbidden(err), apimachineryerrors.IsUnauthorized(err):
+		return RBAC
+	case apimachineryerrors.IsInvalid(err), apimachineryerrors.IsBadRequest(err):
+		return Config
+	}
+	return Infrastructure
+}
+
+// IsAdmissionWebhookDenial returns true if an admission webhook denied the request.
+// Admission webhooks deny requests with a message like:
+// admission webhook "<name>" denied the request: <reason>.
+func IsAdmissionWebhookDenial(err error) bool {
+	var statusErr apimachineryerrors.APIStatus
+	return errors.As(err, &statusErr) &&
+		strings.HasPrefix(statusErr.Status().Message, "admission webhook ")
+}
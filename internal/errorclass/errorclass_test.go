@@ -0,0 +1,71 @@
+package errorclass
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type classifiedErrorStub struct{}
+
+func (classifiedErrorStub) Error() string { return "stub" }
+
+func (classifiedErrorStub) ErrorClass() Class { return Template }
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	gr := schema.GroupResource{Resource: "configmaps"}
+	for name, tc := range map[string]struct {
+		err      error
+		expected Class
+	}{
+		"unknown": {
+			err:      io.EOF,
+			expected: Infrastructure,
+		},
+		"server timeout": {
+			err:      apimachineryerrors.NewServerTimeout(gr, "get", 1),
+			expected: Infrastructure,
+		},
+		"classified": {
+			err:      fmt.Errorf("wrapped: %w", classifiedErrorStub{}),
+			expected: Template,
+		},
+		"template exec": {
+			err:      fmt.Errorf("executing: %w", template.ExecError{Name: "test", Err: io.EOF}),
+			expected: Template,
+		},
+		"webhook": {
+			err: &apimachineryerrors.StatusError{ErrStatus: metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    403,
+				Reason:  metav1.StatusReasonForbidden,
+				Message: `admission webhook "test.example.com" denied the request: nope`,
+			}},
+			expected: WebhookRejected,
+		},
+		"forbidden": {
+			err:      apimachineryerrors.NewForbidden(gr, "test", io.EOF),
+			expected: RBAC,
+		},
+		"invalid": {
+			err:      apimachineryerrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "test", nil),
+			expected: Config,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			class := Of(tc.err)
+			assert.Equal(t, tc.expected, class)
+			assert.Equal(t, tc.expected == Infrastructure, class.Retriable())
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package kstatus reports the Reconciling and Stalled conditions,
+// following the kstatus conventions for the status of Kubernetes objects.
+package kstatus
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// Reason explains a Reconciling or Stalled condition.
+type Reason struct {
+	Reason  string
+	Message string
+}
+
+// ReasonFrom returns the reason of the given condition, falling back to defaultReason.
+func ReasonFrom(cond *metav1.Condition, defaultReason string) *Reason {
+	if cond == nil || len(cond.Reason) == 0 {
+		return &Reason{Reason: defaultReason}
+	}
+	return &Reason{Reason: cond.Reason, Message: cond.Message}
+}
+
+// Status describes what the controller of an object is doing.
+type Status struct {
+	// Set while the controller works towards the desired state.
+	Reconciling *Reason
+	// Set while the controller cannot make progress without intervention.
+	// Takes precedence over Reconciling.
+	Stalled *Reason
+}
+
+// Apply sets the Reconciling and Stalled conditions,
+// removing them while they are not True, as kstatus conditions have abnormal-true polarity.
+func (s Status) Apply(conditions *[]metav1.Condition, generation int64) {
+	reconciling := s.Reconciling
+	if s.Stalled != nil {
+		reconciling = nil
+	}
+	set(conditions, corev1alpha1.ConditionStalled, generation, s.Stalled)
+	set(conditions, corev1alpha1.ConditionReconciling, generation, reconciling)
+}
+
+func set(conditions *[]metav1.Condition, condType string, generation int64, reason *Reason) {
+	if reason == nil {
+		meta.RemoveStatusCondition(conditions, condType)
+		return
+	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason.Reason,
+		Message:            reason.Message,
+		ObservedGeneration: generation,
+	})
+}
@@ -0,0 +1,56 @@
+package kstatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestStatus_Apply(t *testing.T) {
+	t.Parallel()
+
+	conditions := []metav1.Condition{{
+		Type:   "Available",
+		Status: metav1.ConditionFalse,
+		Reason: "ProbeFailure",
+	}}
+
+	Status{
+		Reconciling: ReasonFrom(meta.FindStatusCondition(conditions, "Available"), "Pending"),
+	}.Apply(&conditions, 3)
+	reconciling := meta.FindStatusCondition(conditions, corev1alpha1.ConditionReconciling)
+	if assert.NotNil(t, reconciling) {
+		assert.Equal(t, metav1.ConditionTrue, reconciling.Status)
+		assert.Equal(t, "ProbeFailure", reconciling.Reason)
+		assert.Equal(t, int64(3), reconciling.ObservedGeneration)
+	}
+	assert.Nil(t, meta.FindStatusCondition(conditions, corev1alpha1.ConditionStalled))
+
+	// Stalled takes precedence.
+	Status{
+		Reconciling: &Reason{Reason: "Progressing"},
+		Stalled:     &Reason{Reason: "Invalid", Message: "broken"},
+	}.Apply(&conditions, 3)
+	assert.Nil(t, meta.FindStatusCondition(conditions, corev1alpha1.ConditionReconciling))
+	stalled := meta.FindStatusCondition(conditions, corev1alpha1.ConditionStalled)
+	if assert.NotNil(t, stalled) {
+		assert.Equal(t, "Invalid", stalled.Reason)
+		assert.Equal(t, "broken", stalled.Message)
+	}
+
+	Status{}.Apply(&conditions, 3)
+	assert.Len(t, conditions, 1)
+}
+
+func TestReasonFrom(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, &Reason{Reason: "Pending"}, ReasonFrom(nil, "Pending"))
+	assert.Equal(t, &Reason{Reason: "Pending"}, ReasonFrom(&metav1.Condition{}, "Pending"))
+	assert.Equal(t, &Reason{Reason: "ProbeFailure", Message: "msg"},
+		ReasonFrom(&metav1.Condition{Reason: "ProbeFailure", Message: "msg"}, "Pending"))
+}
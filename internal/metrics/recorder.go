@@ -1,12 +1,14 @@
 package metrics
 
 import (
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
@@ -16,16 +18,34 @@ import (
 
 // Recorder stores all the metrics related to Addons.
 type Recorder struct {
-	dynamicCacheInformers prometheus.Gauge
-	dynamicCacheObjects   *prometheus.GaugeVec
+	dynamicCacheInformers     prometheus.Gauge
+	dynamicCacheObjects       *prometheus.GaugeVec
+	dynamicCacheLabelsRemoved *prometheus.CounterVec
 
-	packageAvailability *prometheus.GaugeVec
-	packageCreated      *prometheus.GaugeVec
-	packageLoadDuration *prometheus.GaugeVec
-	packageRevision     *prometheus.GaugeVec
+	packageAvailability        *prometheus.GaugeVec
+	packageCreated             *prometheus.GaugeVec
+	packageLoadDuration        *prometheus.GaugeVec
+	packageRevision            *prometheus.GaugeVec
+	packageProgressingDuration *prometheus.GaugeVec
+	packageRolloutDuration     *prometheus.HistogramVec
 
 	objectSetCreated   *prometheus.GaugeVec
 	objectSetSucceeded *prometheus.GaugeVec
+	objectSetDrifted   *prometheus.GaugeVec
+
+	clusterTargetAvailability   *prometheus.GaugeVec
+	clusterTargetCacheInformers *prometheus.GaugeVec
+	clusterTargetCacheObjects   *prometheus.GaugeVec
+
+	imageCacheRequests *prometheus.CounterVec
+	imageCacheSize     prometheus.Gauge
+	imageCacheEntries  prometheus.Gauge
+
+	// Rollouts that completed before the recorder was created have already been
+	// observed by a previous process and must not be observed twice.
+	startedAt        time.Time
+	rolloutsLock     sync.Mutex
+	observedRollouts map[types.UID]struct{}
 }
 
 func NewRecorder() *Recorder {
@@ -40,6 +60,11 @@ func NewRecorder() *Recorder {
 			Name: "package_operator_dynamic_cache_objects",
 			Help: "Number of objects for each GVK in the dynamic cache.",
 		}, []string{"pko_gvk"})
+	dynamicCacheLabelsRemoved := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "package_operator_dynamic_cache_labels_removed_total",
+			Help: "Number of objects the dynamic cache label was removed from, because their owners were gone.",
+		}, []string{"pko_gvk"})
 
 	// Package
 	packageAvailability := prometheus.NewGaugeVec(
@@ -67,6 +92,21 @@ func NewRecorder() *Recorder {
 		}, []string{"pko_name", "pko_namespace"},
 	)
 
+	packageProgressingDuration := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "package_operator_package_progressing_duration_seconds",
+			Help: "Seconds the Package has been progressing for, 0 when not progressing.",
+		}, []string{"pko_name", "pko_namespace"},
+	)
+	packageRolloutDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "package_operator_package_rollout_duration_seconds",
+			Help: "Seconds from the creation of a Package revision until it first became available.",
+			// 5s up to ~40min.
+			Buckets: prometheus.ExponentialBuckets(5, 2, 10),
+		}, []string{"pko_name", "pko_namespace"},
+	)
+
 	// Revisions
 	objectSetCreated := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -80,28 +120,92 @@ func NewRecorder() *Recorder {
 			Help: "ObjectSet Unix success timestamp.",
 		}, []string{"pko_name", "pko_namespace", "pko_package_instance"},
 	)
+	objectSetDrifted := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "package_operator_object_set_drifted",
+			Help: "ObjectSet objects drifted from their desired state 0=No,1=Yes.",
+		}, []string{"pko_name", "pko_namespace", "pko_package_instance"},
+	)
+
+	// ClusterTargets
+	clusterTargetAvailability := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "package_operator_cluster_target_availability",
+			Help: "ClusterTarget availability 0=Unavailable,1=Available,2=Unknown.",
+		}, []string{"pko_name"},
+	)
+	clusterTargetCacheInformers := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "package_operator_cluster_target_dynamic_cache_informers",
+			Help: "Tracks the number of active Informers running for the dynamic cache of a target cluster.",
+		}, []string{"pko_target"},
+	)
+	clusterTargetCacheObjects := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "package_operator_cluster_target_dynamic_cache_objects",
+			Help: "Number of objects for each GVK in the dynamic cache of a target cluster.",
+		}, []string{"pko_target", "pko_gvk"},
+	)
+
+	// ImageCache
+	imageCacheRequests := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "package_operator_image_cache_requests_total",
+			Help: "Package image cache lookups by result, hit or miss.",
+		}, []string{"pko_result"},
+	)
+	imageCacheSize := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "package_operator_image_cache_size_bytes",
+			Help: "Bytes stored in the package image cache.",
+		})
+	imageCacheEntries := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "package_operator_image_cache_entries",
+			Help: "Number of package images stored in the package image cache.",
+		})
 
 	return &Recorder{
-		dynamicCacheInformers: dynamicCacheInformers,
-		dynamicCacheObjects:   dynamicCacheObjects,
+		dynamicCacheInformers:     dynamicCacheInformers,
+		dynamicCacheObjects:       dynamicCacheObjects,
+		dynamicCacheLabelsRemoved: dynamicCacheLabelsRemoved,
 
-		packageAvailability: packageAvailability,
-		packageCreated:      packageCreated,
-		packageLoadDuration: packageLoadDuration,
-		packageRevision:     packageRevision,
+		packageAvailability:        packageAvailability,
+		packageCreated:             packageCreated,
+		packageLoadDuration:        packageLoadDuration,
+		packageRevision:            packageRevision,
+		packageProgressingDuration: packageProgressingDuration,
+		packageRolloutDuration:     packageRolloutDuration,
 
 		objectSetCreated:   objectSetCreated,
 		objectSetSucceeded: objectSetSucceeded,
+		objectSetDrifted:   objectSetDrifted,
+
+		clusterTargetAvailability:   clusterTargetAvailability,
+		clusterTargetCacheInformers: clusterTargetCacheInformers,
+		clusterTargetCacheObjects:   clusterTargetCacheObjects,
+
+		imageCacheRequests: imageCacheRequests,
+		imageCacheSize:     imageCacheSize,
+		imageCacheEntries:  imageCacheEntries,
+
+		startedAt:        time.Now(),
+		observedRollouts: map[types.UID]struct{}{},
 	}
 }
 
 // Register metrics into ctrl registry.
 func (r *Recorder) Register() {
 	metrics.Registry.MustRegister(
-		r.dynamicCacheInformers, r.dynamicCacheObjects,
+		r.dynamicCacheInformers, r.dynamicCacheObjects, r.dynamicCacheLabelsRemoved,
 		r.packageAvailability, r.packageCreated, r.packageLoadDuration, r.packageRevision,
+		r.packageProgressingDuration, r.packageRolloutDuration,
 
-		r.objectSetCreated, r.objectSetSucceeded,
+		r.objectSetCreated, r.objectSetSucceeded, r.objectSetDrifted,
+
+		r.clusterTargetAvailability, r.clusterTargetCacheInformers, r.clusterTargetCacheObjects,
+
+		r.imageCacheRequests, r.imageCacheSize, r.imageCacheEntries,
 	)
 }
 
@@ -118,6 +222,8 @@ func (r *Recorder) RecordPackageMetrics(pkg GenericPackage) {
 		r.packageCreated.DeleteLabelValues(obj.GetName(), obj.GetNamespace())
 		r.packageLoadDuration.DeleteLabelValues(obj.GetName(), obj.GetNamespace())
 		r.packageRevision.DeleteLabelValues(obj.GetName(), obj.GetNamespace())
+		r.packageProgressingDuration.DeleteLabelValues(obj.GetName(), obj.GetNamespace())
+		r.packageRolloutDuration.DeleteLabelValues(obj.GetName(), obj.GetNamespace())
 		return
 	}
 
@@ -144,6 +250,16 @@ func (r *Recorder) RecordPackageMetrics(pkg GenericPackage) {
 	r.packageRevision.WithLabelValues(
 		obj.GetName(), obj.GetNamespace(),
 	).Set(float64(pkg.GetStatusRevision()))
+
+	var progressingDuration time.Duration
+	if progressingCond := meta.FindStatusCondition(
+		*pkg.GetConditions(), corev1alpha1.PackageProgressing,
+	); progressingCond != nil && progressingCond.Status == metav1.ConditionTrue {
+		progressingDuration = time.Since(progressingCond.LastTransitionTime.Time)
+	}
+	r.packageProgressingDuration.WithLabelValues(
+		obj.GetName(), obj.GetNamespace(),
+	).Set(progressingDuration.Seconds())
 }
 
 func (r *Recorder) RecordPackageLoadMetric(pkg GenericPackage, d time.Duration) {
@@ -170,6 +286,7 @@ func (r *Recorder) RecordObjectSetMetrics(objectSet GenericObjectSet) {
 	if !obj.GetDeletionTimestamp().IsZero() ||
 		meta.IsStatusConditionTrue(*objectSet.GetConditions(), corev1alpha1.ObjectSetArchived) {
 		r.objectSetSucceeded.DeleteLabelValues(obj.GetName(), obj.GetNamespace(), instance)
+		r.objectSetDrifted.DeleteLabelValues(obj.GetName(), obj.GetNamespace(), instance)
 	} else {
 		succeededCond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetSucceeded)
 		if succeededCond != nil {
@@ -177,6 +294,14 @@ func (r *Recorder) RecordObjectSetMetrics(objectSet GenericObjectSet) {
 				WithLabelValues(obj.GetName(), obj.GetNamespace(), instance).
 				Set(float64(succeededCond.LastTransitionTime.Unix()))
 		}
+
+		var drifted float64
+		if meta.IsStatusConditionTrue(*objectSet.GetConditions(), corev1alpha1.ObjectSetDrifted) {
+			drifted = 1
+		}
+		r.objectSetDrifted.
+			WithLabelValues(obj.GetName(), obj.GetNamespace(), instance).
+			Set(drifted)
 	}
 
 	if !obj.GetDeletionTimestamp().IsZero() {
@@ -186,6 +311,42 @@ func (r *Recorder) RecordObjectSetMetrics(objectSet GenericObjectSet) {
 			WithLabelValues(obj.GetName(), obj.GetNamespace(), instance).
 			Set(float64(obj.GetCreationTimestamp().Unix()))
 	}
+
+	if len(instance) > 0 {
+		r.recordRollout(objectSet, instance)
+	}
+}
+
+// Observes the rollout duration of a package revision once, when it first becomes available.
+func (r *Recorder) recordRollout(objectSet GenericObjectSet, instance string) {
+	obj := objectSet.ClientObject()
+
+	r.rolloutsLock.Lock()
+	defer r.rolloutsLock.Unlock()
+
+	if !obj.GetDeletionTimestamp().IsZero() ||
+		meta.IsStatusConditionTrue(*objectSet.GetConditions(), corev1alpha1.ObjectSetArchived) {
+		delete(r.observedRollouts, obj.GetUID())
+		return
+	}
+
+	if _, ok := r.observedRollouts[obj.GetUID()]; ok {
+		return
+	}
+
+	availableCond := meta.FindStatusCondition(*objectSet.GetConditions(), corev1alpha1.ObjectSetAvailable)
+	if availableCond == nil || availableCond.Status != metav1.ConditionTrue {
+		return
+	}
+
+	r.observedRollouts[obj.GetUID()] = struct{}{}
+	if availableCond.LastTransitionTime.Time.Before(r.startedAt) {
+		return
+	}
+
+	r.packageRolloutDuration.
+		WithLabelValues(instance, obj.GetNamespace()).
+		Observe(availableCond.LastTransitionTime.Sub(obj.GetCreationTimestamp().Time).Seconds())
 }
 
 // Records the number of active Informers for the cache.
@@ -197,3 +358,73 @@ func (r *Recorder) RecordDynamicCacheInformers(total int) {
 func (r *Recorder) RecordDynamicCacheObjects(gvk schema.GroupVersionKind, count int) {
 	r.dynamicCacheObjects.WithLabelValues(gvk.String()).Set(float64(count))
 }
+
+// Records the number of objects of a GVK the dynamic cache label was removed from.
+func (r *Recorder) RecordDynamicCacheLabelsRemoved(gvk schema.GroupVersionKind, count int) {
+	r.dynamicCacheLabelsRemoved.WithLabelValues(gvk.String()).Add(float64(count))
+}
+
+// Records a lookup in the package image cache.
+func (r *Recorder) RecordImageCacheRequest(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	r.imageCacheRequests.WithLabelValues(result).Inc()
+}
+
+// Records the size of the package image cache.
+func (r *Recorder) RecordImageCacheSize(bytes int64, entries int) {
+	r.imageCacheSize.Set(float64(bytes))
+	r.imageCacheEntries.Set(float64(entries))
+}
+
+// Records the availability of a ClusterTarget.
+func (r *Recorder) RecordClusterTargetMetrics(target *corev1alpha1.ClusterTarget) {
+	// default to unknown
+	healthStatus := 2
+
+	if availableCond := meta.FindStatusCondition(
+		target.Status.Conditions, corev1alpha1.ClusterTargetAvailable,
+	); availableCond != nil {
+		switch availableCond.Status {
+		case metav1.ConditionFalse:
+			healthStatus = 0
+		case metav1.ConditionTrue:
+			healthStatus = 1
+		}
+	}
+	r.clusterTargetAvailability.WithLabelValues(target.Name).Set(float64(healthStatus))
+}
+
+// Deletes all metrics of a ClusterTarget that no longer exists.
+func (r *Recorder) DeleteClusterTargetMetrics(name string) {
+	r.clusterTargetAvailability.DeleteLabelValues(name)
+}
+
+// ForTarget returns a recorder for the dynamic cache of a target cluster.
+func (r *Recorder) ForTarget(target string) *TargetRecorder {
+	return &TargetRecorder{recorder: r, target: target}
+}
+
+// TargetRecorder records dynamic cache metrics for a single target cluster.
+type TargetRecorder struct {
+	recorder *Recorder
+	target   string
+}
+
+// Records the number of active Informers for the cache of the target cluster.
+func (r *TargetRecorder) RecordDynamicCacheInformers(total int) {
+	r.recorder.clusterTargetCacheInformers.WithLabelValues(r.target).Set(float64(total))
+}
+
+// Records the number of objects in the cache of the target cluster identified by GVK.
+func (r *TargetRecorder) RecordDynamicCacheObjects(gvk schema.GroupVersionKind, count int) {
+	r.recorder.clusterTargetCacheObjects.WithLabelValues(r.target, gvk.String()).Set(float64(count))
+}
+
+// Deletes all cache metrics of the target cluster, when its cache is discarded.
+func (r *TargetRecorder) Delete() {
+	r.recorder.clusterTargetCacheInformers.DeleteLabelValues(r.target)
+	r.recorder.clusterTargetCacheObjects.DeletePartialMatch(prometheus.Labels{"pko_target": r.target})
+}
@@ -9,9 +9,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
 	"package-operator.run/internal/adapters"
 )
 
@@ -137,6 +140,47 @@ func TestRecorder_RecordPackageMetrics(t *testing.T) {
 	}
 }
 
+func TestRecorder_RecordPackageMetrics_progressing(t *testing.T) {
+	t.Parallel()
+	pkg := &adapters.GenericPackage{
+		Package: corev1alpha1.Package{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test-ns",
+			},
+			Status: corev1alpha1.PackageStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               corev1alpha1.PackageProgressing,
+						Status:             metav1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+					},
+				},
+			},
+		},
+	}
+
+	recorder := NewRecorder()
+	recorder.RecordPackageMetrics(pkg)
+	assert.InDelta(t,
+		float64(60),
+		testutil.ToFloat64(recorder.packageProgressingDuration.WithLabelValues(
+			pkg.GetName(), pkg.GetNamespace(),
+		)),
+		5,
+	)
+
+	pkg.Status.Conditions[0].Status = metav1.ConditionFalse
+	recorder.RecordPackageMetrics(pkg)
+	assert.InDelta(t,
+		float64(0),
+		testutil.ToFloat64(recorder.packageProgressingDuration.WithLabelValues(
+			pkg.GetName(), pkg.GetNamespace(),
+		)),
+		0.01,
+	)
+}
+
 func TestRecorder_RecordPackageMetrics_delete(t *testing.T) {
 	t.Parallel()
 	d := metav1.Now()
@@ -235,3 +279,162 @@ func TestRecorder_RecordObjectSetMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestRecorder_RecordObjectSetMetrics_rollout(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewRecorder()
+	creationTimestamp := recorder.startedAt.Add(time.Second)
+	availableTimestamp := creationTimestamp.Add(90 * time.Second)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetName("test-1234")
+	obj.SetNamespace("test-ns")
+	obj.SetUID(types.UID("1234"))
+	obj.SetCreationTimestamp(metav1.NewTime(creationTimestamp))
+	obj.SetLabels(map[string]string{
+		manifestsv1alpha1.PackageInstanceLabel: "test",
+	})
+
+	conditions := []metav1.Condition{
+		{
+			Type:               corev1alpha1.ObjectSetAvailable,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(availableTimestamp),
+		},
+	}
+
+	osMock := &genericObjectSetMock{}
+	osMock.On("ClientObject").Return(obj)
+	osMock.On("GetConditions").Return(&conditions)
+
+	// Repeated reconciles must only observe the rollout once.
+	recorder.RecordObjectSetMetrics(osMock)
+	recorder.RecordObjectSetMetrics(osMock)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(recorder.packageRolloutDuration))
+	assert.Contains(t, recorder.observedRollouts, types.UID("1234"))
+
+	conditions = append(conditions, metav1.Condition{
+		Type:   corev1alpha1.ObjectSetArchived,
+		Status: metav1.ConditionTrue,
+	})
+	recorder.RecordObjectSetMetrics(osMock)
+	assert.NotContains(t, recorder.observedRollouts, types.UID("1234"))
+}
+
+func TestRecorder_RecordObjectSetMetrics_rolloutBeforeStart(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewRecorder()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetUID(types.UID("1234"))
+	obj.SetCreationTimestamp(metav1.NewTime(recorder.startedAt.Add(-time.Hour)))
+	obj.SetLabels(map[string]string{
+		manifestsv1alpha1.PackageInstanceLabel: "test",
+	})
+
+	conditions := []metav1.Condition{
+		{
+			Type:               corev1alpha1.ObjectSetAvailable,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(recorder.startedAt.Add(-time.Minute)),
+		},
+	}
+
+	osMock := &genericObjectSetMock{}
+	osMock.On("ClientObject").Return(obj)
+	osMock.On("GetConditions").Return(&conditions)
+
+	recorder.RecordObjectSetMetrics(osMock)
+	assert.Equal(t, 0, testutil.CollectAndCount(recorder.packageRolloutDuration))
+}
+
+func TestRecorder_RecordClusterTargetMetrics(t *testing.T) {
+	t.Parallel()
+
+	target := &corev1alpha1.ClusterTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+	}
+
+	recorder := NewRecorder()
+	recorder.RecordClusterTargetMetrics(target)
+	assert.InDelta(t, float64(2),
+		testutil.ToFloat64(recorder.clusterTargetAvailability.WithLabelValues("test")), 0.01)
+
+	target.Status.Conditions = []metav1.Condition{
+		{Type: corev1alpha1.ClusterTargetAvailable, Status: metav1.ConditionTrue},
+	}
+	recorder.RecordClusterTargetMetrics(target)
+	assert.InDelta(t, float64(1),
+		testutil.ToFloat64(recorder.clusterTargetAvailability.WithLabelValues("test")), 0.01)
+
+	recorder.DeleteClusterTargetMetrics("test")
+	assert.Equal(t, 0, testutil.CollectAndCount(recorder.clusterTargetAvailability))
+}
+
+func TestTargetRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewRecorder()
+	tr := recorder.ForTarget("ClusterTarget/test")
+	tr.RecordDynamicCacheInformers(2)
+	tr.RecordDynamicCacheObjects(corev1alpha1.GroupVersion.WithKind("Package"), 3)
+
+	assert.InDelta(t, float64(2), testutil.ToFloat64(
+		recorder.clusterTargetCacheInformers.WithLabelValues("ClusterTarget/test")), 0.01)
+	assert.Equal(t, 1, testutil.CollectAndCount(recorder.clusterTargetCacheObjects))
+
+	tr.Delete()
+	assert.Equal(t, 0, testutil.CollectAndCount(recorder.clusterTargetCacheInformers))
+	assert.Equal(t, 0, testutil.CollectAndCount(recorder.clusterTargetCacheObjects))
+}
+
+func TestRecorder_ImageCache(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewRecorder()
+	recorder.RecordImageCacheRequest(true)
+	recorder.RecordImageCacheRequest(false)
+	recorder.RecordImageCacheRequest(true)
+	recorder.RecordImageCacheSize(1024, 2)
+
+	assert.InDelta(t, float64(2), testutil.ToFloat64(recorder.imageCacheRequests.WithLabelValues("hit")), 0.01)
+	assert.InDelta(t, float64(1), testutil.ToFloat64(recorder.imageCacheRequests.WithLabelValues("miss")), 0.01)
+	assert.InDelta(t, float64(1024), testutil.ToFloat64(recorder.imageCacheSize), 0.01)
+	assert.InDelta(t, float64(2), testutil.ToFloat64(recorder.imageCacheEntries), 0.01)
+}
+
+func TestRecorder_RecordDynamicCacheLabelsRemoved(t *testing.T) {
+	t.Parallel()
+
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	recorder := NewRecorder()
+	recorder.RecordDynamicCacheLabelsRemoved(gvk, 2)
+	recorder.RecordDynamicCacheLabelsRemoved(gvk, 1)
+
+	assert.InDelta(t, float64(3),
+		testutil.ToFloat64(recorder.dynamicCacheLabelsRemoved.WithLabelValues(gvk.String())), 0.01)
+}
+
+func TestRecorder_RecordObjectSetMetrics_drifted(t *testing.T) {
+	t.Parallel()
+
+	obj := &unstructured.Unstructured{}
+	conditions := []metav1.Condition{
+		{Type: corev1alpha1.ObjectSetDrifted, Status: metav1.ConditionTrue},
+	}
+
+	osMock := &genericObjectSetMock{}
+	osMock.On("ClientObject").Return(obj)
+	osMock.On("GetConditions").Return(&conditions)
+
+	recorder := NewRecorder()
+	recorder.RecordObjectSetMetrics(osMock)
+	assert.InDelta(t, 1, testutil.ToFloat64(recorder.objectSetDrifted), 0.01)
+
+	conditions = nil
+	recorder.RecordObjectSetMetrics(osMock)
+	assert.InDelta(t, 0, testutil.ToFloat64(recorder.objectSetDrifted), 0.01)
+}
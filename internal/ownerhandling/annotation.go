@@ -11,7 +11,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,11 +21,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/pkg/phasecontroller"
 )
 
 var _ ownerStrategy = (*OwnerStrategyAnnotation)(nil)
 
-const ownerStrategyAnnotationKey = "package-operator.run/owners"
+const ownerStrategyAnnotationKey = phasecontroller.OwnersAnnotation
 
 // AnnotationOwner handling strategy uses .metadata.annotations.
 // Allows cross-namespace owner references.
@@ -42,7 +42,7 @@ func NewAnnotation(scheme *runtime.Scheme) *OwnerStrategyAnnotation {
 
 func (s *OwnerStrategyAnnotation) HasController(obj metav1.Object) bool {
 	for _, ref := range s.getOwnerReferences(obj) {
-		if ref.isController() {
+		if ref.IsController() {
 			return true
 		}
 	}
@@ -210,33 +210,15 @@ func (s *OwnerStrategyAnnotation) ReleaseController(obj metav1.Object) {
 }
 
 func (s *OwnerStrategyAnnotation) getOwnerReferences(obj metav1.Object) []annotationOwnerRef {
-	annotations := obj.GetAnnotations()
-	if annotations == nil {
-		return nil
-	}
-	if len(annotations[ownerStrategyAnnotationKey]) == 0 {
-		return nil
-	}
-
-	var ownerReferences []annotationOwnerRef
-	if err := json.Unmarshal([]byte(annotations[ownerStrategyAnnotationKey]), &ownerReferences); err != nil {
+	ownerReferences, err := phasecontroller.GetOwnerReferences(obj)
+	if err != nil {
 		panic(err)
 	}
-
 	return ownerReferences
 }
 
 func (s *OwnerStrategyAnnotation) setOwnerReferences(obj metav1.Object, owners []annotationOwnerRef) {
-	annotations := obj.GetAnnotations()
-	if annotations == nil {
-		annotations = map[string]string{}
-	}
-	j, err := json.Marshal(owners)
-	if err != nil {
-		panic(err)
-	}
-	annotations[ownerStrategyAnnotationKey] = string(j)
-	obj.SetAnnotations(annotations)
+	phasecontroller.SetOwnerReferences(obj, owners)
 }
 
 func (s *OwnerStrategyAnnotation) indexOf(ownerRefs []annotationOwnerRef, ownerRef annotationOwnerRef) int {
@@ -284,29 +266,7 @@ func (s *OwnerStrategyAnnotation) referSameObject(a, b annotationOwnerRef) bool
 	return aGV.Group == bGV.Group && a.Kind == b.Kind && a.Name == b.Name
 }
 
-type annotationOwnerRef struct {
-	// API version of the referent.
-	APIVersion string `json:"apiVersion"`
-	// Kind of the referent.
-	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind string `json:"kind"`
-	// Name of the referent.
-	// More info: http://kubernetes.io/docs/user-guide/identifiers#names
-	Name string `json:"name"`
-	// Name of the referent.
-	// More info: http://kubernetes.io/docs/user-guide/identifiers#namespaces
-	Namespace string `json:"namespace"`
-	// UID of the referent.
-	// More info: http://kubernetes.io/docs/user-guide/identifiers#uids
-	UID types.UID `json:"uid"`
-	// If true, this reference struct points to the managing controller.
-	// +optional
-	Controller *bool `json:"controller,omitempty"`
-}
-
-func (r *annotationOwnerRef) isController() bool {
-	return r.Controller != nil && *r.Controller
-}
+type annotationOwnerRef = phasecontroller.OwnerReference
 
 type AnnotationEnqueueRequestForOwner struct {
 	// OwnerType is the type of the Owner object to look for in OwnerReferences.  Only Group and Kind are compared.
@@ -374,7 +334,7 @@ func (e *AnnotationEnqueueRequestForOwner) getOwnerReconcileRequest(object metav
 			continue
 		}
 
-		if e.IsController && !ownerRef.isController() {
+		if e.IsController && !ownerRef.IsController() {
 			continue
 		}
 
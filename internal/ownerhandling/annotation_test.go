@@ -551,7 +551,7 @@ func TestIsController(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			annOwnerRef := tc.annOwnerRef
-			resultController := annOwnerRef.isController()
+			resultController := annOwnerRef.IsController()
 			assert.Equal(t, tc.expectedController, resultController)
 		})
 	}
@@ -1,8 +1,12 @@
 package ownerhandling
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
@@ -24,3 +28,46 @@ func remove[T any](s []T, i int) []T {
 	s[i] = s[len(s)-1]
 	return s[:len(s)-1]
 }
+
+// OwnerRef identifies an owner of an object.
+type OwnerRef struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	Namespace  string
+	UID        types.UID
+}
+
+// Owners returns the owners of obj from its native owner references and the owner annotation.
+// Native owner references always point to the namespace of obj.
+func Owners(obj metav1.Object) ([]OwnerRef, error) {
+	owners := make([]OwnerRef, 0, len(obj.GetOwnerReferences()))
+	for _, ref := range obj.GetOwnerReferences() {
+		owners = append(owners, OwnerRef{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			Namespace:  obj.GetNamespace(),
+			UID:        ref.UID,
+		})
+	}
+
+	annotation := obj.GetAnnotations()[ownerStrategyAnnotationKey]
+	if len(annotation) == 0 {
+		return owners, nil
+	}
+	var annotationRefs []annotationOwnerRef
+	if err := json.Unmarshal([]byte(annotation), &annotationRefs); err != nil {
+		return nil, fmt.Errorf("parsing %s annotation: %w", ownerStrategyAnnotationKey, err)
+	}
+	for _, ref := range annotationRefs {
+		owners = append(owners, OwnerRef{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			Namespace:  ref.Namespace,
+			UID:        ref.UID,
+		})
+	}
+	return owners, nil
+}
@@ -0,0 +1,35 @@
+package ownerhandling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestOwners(t *testing.T) {
+	t.Parallel()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("test")
+	obj.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "package-operator.run/v1alpha1", Kind: "ObjectSet", Name: "native", UID: "1"},
+	})
+	obj.SetAnnotations(map[string]string{
+		ownerStrategyAnnotationKey: `[{"apiVersion":"package-operator.run/v1alpha1",` +
+			`"kind":"ObjectSet","name":"annotation","namespace":"other","uid":"2"}]`,
+	})
+
+	owners, err := Owners(obj)
+	require.NoError(t, err)
+	assert.Equal(t, []OwnerRef{
+		{APIVersion: "package-operator.run/v1alpha1", Kind: "ObjectSet", Name: "native", Namespace: "test", UID: "1"},
+		{APIVersion: "package-operator.run/v1alpha1", Kind: "ObjectSet", Name: "annotation", Namespace: "other", UID: "2"},
+	}, owners)
+
+	obj.SetAnnotations(map[string]string{ownerStrategyAnnotationKey: "{"})
+	_, err = Owners(obj)
+	require.Error(t, err)
+}
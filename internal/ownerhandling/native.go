@@ -21,13 +21,17 @@ import (
 var _ ownerStrategy = (*OwnerStrategyNative)(nil)
 
 // NativeOwner handling strategy uses .metadata.ownerReferences.
+// Cluster-scoped objects can't reference namespaced owners,
+// so these owners are stored in annotations like the Annotation strategy does.
 type OwnerStrategyNative struct {
-	scheme *runtime.Scheme
+	scheme     *runtime.Scheme
+	annotation *OwnerStrategyAnnotation
 }
 
 func NewNative(scheme *runtime.Scheme) *OwnerStrategyNative {
 	return &OwnerStrategyNative{
-		scheme: scheme,
+		scheme:     scheme,
+		annotation: NewAnnotation(scheme),
 	}
 }
 
@@ -37,6 +41,9 @@ func (s *OwnerStrategyNative) HasController(obj metav1.Object) bool {
 			return true
 		}
 	}
+	if len(obj.GetNamespace()) == 0 {
+		return s.annotation.HasController(obj)
+	}
 	return false
 }
 
@@ -54,6 +61,9 @@ func (s *OwnerStrategyNative) OwnerPatch(owner metav1.Object) ([]byte, error) {
 }
 
 func (s *OwnerStrategyNative) IsOwner(owner, obj metav1.Object) bool {
+	if isNamespacedOwnerOfClusterScoped(owner, obj) {
+		return s.annotation.IsOwner(owner, obj)
+	}
 	ownerRefComp := s.ownerRefForCompare(owner)
 	for _, ownerRef := range obj.GetOwnerReferences() {
 		if s.referSameObject(ownerRefComp, ownerRef) {
@@ -66,6 +76,9 @@ func (s *OwnerStrategyNative) IsOwner(owner, obj metav1.Object) bool {
 func (s *OwnerStrategyNative) IsController(
 	owner, obj metav1.Object,
 ) bool {
+	if isNamespacedOwnerOfClusterScoped(owner, obj) {
+		return s.annotation.IsController(owner, obj)
+	}
 	ownerRefComp := s.ownerRefForCompare(owner)
 	for _, ownerRef := range obj.GetOwnerReferences() {
 		if s.referSameObject(ownerRefComp, ownerRef) &&
@@ -78,6 +91,10 @@ func (s *OwnerStrategyNative) IsController(
 }
 
 func (s *OwnerStrategyNative) RemoveOwner(owner, obj metav1.Object) {
+	if isNamespacedOwnerOfClusterScoped(owner, obj) {
+		s.annotation.RemoveOwner(owner, obj)
+		return
+	}
 	ownerRefComp := s.ownerRefForCompare(owner)
 	ownerRefs := obj.GetOwnerReferences()
 	foundIndex := -1
@@ -98,13 +115,22 @@ func (s *OwnerStrategyNative) ReleaseController(obj metav1.Object) {
 		ownerRefs[i].Controller = ptr.To(false)
 	}
 	obj.SetOwnerReferences(ownerRefs)
+	if len(s.annotation.getOwnerReferences(obj)) > 0 {
+		s.annotation.ReleaseController(obj)
+	}
 }
 
 func (s *OwnerStrategyNative) SetOwnerReference(owner, obj metav1.Object) error {
+	if isNamespacedOwnerOfClusterScoped(owner, obj) {
+		return s.annotation.SetOwnerReference(owner, obj)
+	}
 	return controllerutil.SetOwnerReference(owner, obj, s.scheme)
 }
 
 func (s *OwnerStrategyNative) SetControllerReference(owner, obj metav1.Object) error {
+	if isNamespacedOwnerOfClusterScoped(owner, obj) {
+		return s.annotation.SetControllerReference(owner, obj)
+	}
 	return controllerutil.SetControllerReference(owner, obj, s.scheme)
 }
 
@@ -152,3 +178,9 @@ func (s *OwnerStrategyNative) referSameObject(a, b metav1.OwnerReference) bool {
 
 	return aGV.Group == bGV.Group && a.Kind == b.Kind && a.Name == b.Name
 }
+
+// Cluster-scoped objects of namespaced owners,
+// e.g. allowed via clusterScopedKinds of an ObjectSet.
+func isNamespacedOwnerOfClusterScoped(owner, obj metav1.Object) bool {
+	return len(owner.GetNamespace()) > 0 && len(obj.GetNamespace()) == 0
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -254,3 +255,32 @@ func TestOwnerStrategyNative_OwnerPatch(t *testing.T) {
 		`[{"apiVersion":"v1","kind":"ConfigMap","name":"cm","uid":"asdfjkl","controller":true,"blockOwnerDeletion":true}]}}`
 	assert.Equal(t, expected, string(patch))
 }
+
+func TestOwnerStrategyNative_ClusterScopedObject(t *testing.T) {
+	t.Parallel()
+	s := NewNative(testScheme)
+	obj := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "cr1"},
+	}
+	cm1 := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm1",
+			Namespace: "test",
+			UID:       types.UID("1234"),
+		},
+	}
+
+	// Namespaced owners of cluster-scoped objects are stored in annotations.
+	require.NoError(t, s.SetControllerReference(cm1, obj))
+	assert.Empty(t, obj.GetOwnerReferences())
+	assert.Contains(t, obj.GetAnnotations(), ownerStrategyAnnotationKey)
+	assert.True(t, s.HasController(obj))
+	assert.True(t, s.IsController(cm1, obj))
+
+	s.ReleaseController(obj)
+	assert.False(t, s.IsController(cm1, obj))
+	assert.True(t, s.IsOwner(cm1, obj))
+
+	s.RemoveOwner(cm1, obj)
+	assert.False(t, s.IsOwner(cm1, obj))
+}
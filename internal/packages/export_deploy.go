@@ -7,6 +7,18 @@ import (
 // PackageDeployer loads package contents from file, wraps it into an ObjectDeployment and deploys it.
 type PackageDeployer = packagedeploy.PackageDeployer
 
+type (
+	// RenderLimits guard the cluster against packages rendering excessive amounts of objects.
+	RenderLimits = packagedeploy.RenderLimits
+	// PackageDeployerOption configures a PackageDeployer.
+	PackageDeployerOption = packagedeploy.PackageDeployerOption
+	// WithRenderLimits limits the objects rendered by packages.
+	WithRenderLimits = packagedeploy.WithRenderLimits
+)
+
+// ErrRenderLimitExceeded is returned when a package renders more or larger objects than allowed.
+var ErrRenderLimitExceeded = packagedeploy.ErrRenderLimitExceeded
+
 var (
 	// Returns a new namespace-scoped loader for the Package API.
 	NewPackageDeployer = packagedeploy.NewPackageDeployer
@@ -9,4 +9,31 @@ var (
 	ToOCIFile = packageexport.ToOCIFile
 	// Exports the given package by pushing it to an OCI registry.
 	ToPushedOCI = packageexport.ToPushedOCI
+	// Exports the package as OCI image index with one image per platform.
+	ToOCIIndex = packageexport.ToOCIIndex
+	// Exports the given package as image index to a tar in OCI image layout under the given tags.
+	ToOCIIndexFile = packageexport.ToOCIIndexFile
+	// Exports the given package as image index by pushing it to an OCI registry.
+	ToPushedOCIIndex = packageexport.ToPushedOCIIndex
+	// Exports the package as OCI artifact, instead of container image.
+	ToOCIArtifact = packageexport.ToOCIArtifact
+	// Exports the given package as OCI artifact to a tar in OCI image layout under the given tags.
+	ToOCIArtifactFile = packageexport.ToOCIArtifactFile
+	// Exports the given package as OCI artifact by pushing it to an OCI registry.
+	ToPushedOCIArtifact = packageexport.ToPushedOCIArtifact
+	// Pushes a package image exported via ToOCIFile, ToOCIIndexFile or ToOCIArtifactFile to an OCI registry.
+	PushOCIFile = packageexport.PushOCIFile
+	// Generates an SBOM listing the files and images of a package.
+	SBOM = packageexport.SBOM
+	// Pushes an SBOM as OCI referrer of the given image references.
+	AttachSBOM = packageexport.AttachSBOM
+)
+
+var (
+	// ErrNoPlatforms is returned when an image index is requested without any platform.
+	ErrNoPlatforms = packageexport.ErrNoPlatforms
+	// ErrNoReferences is returned when pushing a file that holds no tags, without specifying any.
+	ErrNoReferences = packageexport.ErrNoReferences
+	// ErrUnknownSBOMFormat is returned for SBOM formats other than spdx and cyclonedx.
+	ErrUnknownSBOMFormat = packageexport.ErrUnknownSBOMFormat
 )
@@ -2,6 +2,9 @@ package packages
 
 import "package-operator.run/internal/packages/internal/packageimport"
 
+// DefaultContainerdContentStore is the location of the containerd content store on nodes.
+const DefaultContainerdContentStore = packageimport.DefaultContainerdContentStore
+
 var (
 	// Import a RawPackage from the given folder path.
 	FromFolder = packageimport.FromFolder
@@ -15,9 +18,57 @@ var (
 
 	// Creates a new registry instance to de-duplicate parallel container image pulls.
 	NewRegistry = packageimport.NewRegistry
+	// Creates an on-disk cache for the contents of pulled package images.
+	NewImageCache = packageimport.NewImageCache
+
+	// Loads an image or image index from a tar file exported via "kubectl package build --output".
+	FromOCIFile = packageimport.FromOCIFile
+	// Lists all SBOMs attached to an image as OCI referrers.
+	FindSBOMs = packageimport.FindSBOMs
+
+	// ErrInvalidImageFile is returned when a file can not be read as package image.
+	ErrInvalidImageFile = packageimport.ErrInvalidImageFile
+
+	// Returns a pull backend reading images from the containerd content store of the node.
+	NewContainerdPullBackend = packageimport.NewContainerdPullBackend
+	// ErrImageNotFound is returned when a pull backend does not hold the requested image.
+	ErrImageNotFound = packageimport.ErrImageNotFound
+
+	// Returns a keychain resolving registry credentials from image pull secrets.
+	NewPullSecretKeychain = packageimport.NewPullSecretKeychain
+	// ErrInvalidPullSecret is returned for Secrets that do not contain docker registry credentials.
+	ErrInvalidPullSecret = packageimport.ErrInvalidPullSecret
 )
 
 type (
 	// Registry de-duplicates multiple parallel container image pulls.
 	Registry = packageimport.Registry
+	// RegistryOption configures a Registry.
+	RegistryOption = packageimport.RegistryOption
+	// WithVerificationPolicy makes the registry refuse images without signatures trusted by the policy.
+	WithVerificationPolicy = packageimport.WithVerificationPolicy
+	// ImageCache stores the contents of pulled package images on disk by digest.
+	ImageCache = packageimport.ImageCache
+	// ImageCacheRecorder records image cache metrics.
+	ImageCacheRecorder = packageimport.ImageCacheRecorder
+	// WithImageCache reuses the contents of images with the same digest from the given cache.
+	WithImageCache = packageimport.WithImageCache
+	// PullBackend fetches the contents of package images.
+	PullBackend = packageimport.PullBackend
+	// WithPullBackend fetches image contents via the given backend, instead of the registry.
+	WithPullBackend = packageimport.WithPullBackend
+	// RegistryPullBackend pulls images directly from their container image registry.
+	RegistryPullBackend = packageimport.RegistryPullBackend
+	// ContainerdPullBackend reads images from the containerd content store of the node.
+	ContainerdPullBackend = packageimport.ContainerdPullBackend
+	// FilePullBackend reads images from an OCI image layout directory.
+	FilePullBackend = packageimport.FilePullBackend
+	// OCIFile is a package image or image index loaded from a tar file.
+	OCIFile = packageimport.OCIFile
+	// SBOMReference points to an SBOM attached to a package image.
+	SBOMReference = packageimport.SBOMReference
+	// PullOption configures a single image pull.
+	PullOption = packageimport.PullOption
+	// WithPullSecrets authenticates image pulls with the credentials of the given Secrets.
+	WithPullSecrets = packageimport.WithPullSecrets
 )
@@ -2,9 +2,16 @@ package packages
 
 import "package-operator.run/internal/packages/internal/packagekickstart"
 
-type KickstartResult = packagekickstart.KickstartResult
+type (
+	KickstartResult   = packagekickstart.KickstartResult
+	ConvertHelmResult = packagekickstart.ConvertHelmResult
+	ScaffoldOptions   = packagekickstart.ScaffoldOptions
+)
 
 var (
 	Kickstart            = packagekickstart.Kickstart
 	ImportOLMBundleImage = packagekickstart.ImportOLMBundleImage
+	Scaffold             = packagekickstart.Scaffold
+	ConvertHelm          = packagekickstart.ConvertHelm
+	AdoptHelmRelease     = packagekickstart.AdoptHelmRelease
 )
@@ -9,8 +9,17 @@ var (
 	RenderObjects = packagerender.RenderObjects
 	// Renders all .yml and .yaml files into Kubernetes Objects and applies CEL conditionals to filter objects.
 	RenderObjectsWithFilter = packagerender.RenderObjectsWithFilter
+	// Renders all .yml and .yaml files into Kubernetes Objects and applies CEL conditionals to filter objects.
+	// Returns objects grouped by their source path and the indexes of filtered objects per path.
+	RenderObjectsWithFilterInfo = packagerender.RenderObjectsWithFilterInfo
 	// Renders a ObjectSetTemplateSpec from a PackageInstance to use with ObjectSet and ObjectDeployment APIs.
 	RenderObjectSetTemplateSpec = packagerender.RenderObjectSetTemplateSpec
 	// Turns a Package and PackageRenderContext into a PackageInstance.
 	RenderPackageInstance = packagerender.RenderPackageInstance
 )
+
+// TemplateFunction describes a function available in package templates.
+type TemplateFunction = packagerender.TemplateFunction
+
+// TemplateFunctions lists all functions available in package templates, sorted by name.
+var TemplateFunctions = packagerender.TemplateFunctions
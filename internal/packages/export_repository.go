@@ -9,11 +9,23 @@ type (
 )
 
 var (
-	NewMultiRepositoryIndex = packagerepository.NewMultiRepositoryIndex
-	NewRepositoryIndex      = packagerepository.NewRepositoryIndex
-	LoadRepositoryFromFile  = packagerepository.LoadRepositoryFromFile
-	LoadRepository          = packagerepository.LoadRepository
-	SaveRepositoryToFile    = packagerepository.SaveRepositoryToFile
-	SaveRepositoryToOCI     = packagerepository.SaveRepositoryToOCI
-	LoadRepositoryFromOCI   = packagerepository.LoadRepositoryFromOCI
+	NewMultiRepositoryIndex       = packagerepository.NewMultiRepositoryIndex
+	NewRepositoryIndex            = packagerepository.NewRepositoryIndex
+	LoadRepositoryFromFile        = packagerepository.LoadRepositoryFromFile
+	LoadRepository                = packagerepository.LoadRepository
+	SaveRepositoryToFile          = packagerepository.SaveRepositoryToFile
+	SaveRepositoryToOCI           = packagerepository.SaveRepositoryToOCI
+	SaveSignedRepositoryToOCI     = packagerepository.SaveSignedRepositoryToOCI
+	LoadRepositoryFromOCI         = packagerepository.LoadRepositoryFromOCI
+	LoadRepositorySigningKey      = packagerepository.LoadSigningKey
+	LoadRepositoryVerificationKey = packagerepository.LoadVerificationKey
+	SignRepository                = packagerepository.Sign
+	VerifyRepository              = packagerepository.Verify
+)
+
+const RepositorySignatureFileSuffix = packagerepository.SignatureFileSuffix
+
+var (
+	ErrInvalidRepositorySignature = packagerepository.ErrInvalidSignature
+	ErrUnsupportedKey             = packagerepository.ErrUnsupportedKey
 )
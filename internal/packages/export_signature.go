@@ -0,0 +1,35 @@
+package packages
+
+import "package-operator.run/internal/packages/internal/packagesignature"
+
+var (
+	// Verifies the cosign signatures of a package image against a policy.
+	VerifySignature = packagesignature.Verify
+	// Loads a signature verification policy from key and certificate files.
+	LoadVerificationPolicy = packagesignature.LoadPolicy
+	// Returns the tag cosign stores the signatures of an image digest at.
+	SignatureTag = packagesignature.SignatureTag
+
+	// ErrNoSignatures is returned when no signature could be found for an image.
+	ErrNoSignatures = packagesignature.ErrNoSignatures
+	// ErrNoValidSignature is returned when none of the signatures of an image satisfies the policy.
+	ErrNoValidSignature = packagesignature.ErrNoValidSignature
+	// ErrEmptyPolicy is returned when verifying against a policy without keys and identities.
+	ErrEmptyPolicy = packagesignature.ErrEmptyPolicy
+	// ErrMissingRoots is returned when keyless identities are trusted without certificate roots.
+	ErrMissingRoots = packagesignature.ErrMissingRoots
+	// ErrKeylessUnsupported is returned when keyless identities are trusted,
+	// where signatures have to be verified at a trusted signing time.
+	ErrKeylessUnsupported = packagesignature.ErrKeylessUnsupported
+)
+
+type (
+	// VerificationPolicy decides which package image signatures are trusted.
+	VerificationPolicy = packagesignature.Policy
+	// VerificationPolicyConfig references the files and identities a VerificationPolicy is loaded from.
+	VerificationPolicyConfig = packagesignature.PolicyConfig
+	// VerificationIdentity is a trusted keyless signer.
+	VerificationIdentity = packagesignature.Identity
+	// VerifiedSignature describes the signature that satisfied a VerificationPolicy.
+	VerifiedSignature = packagesignature.Verified
+)
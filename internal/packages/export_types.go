@@ -7,6 +7,9 @@ const (
 	PackageManifestFilename = packagetypes.PackageManifestFilename
 	// Package manifest lock filename without file-extension.
 	PackageManifestLockFilename = packagetypes.PackageManifestLockFilename
+
+	SBOMFormatSPDX      = packagetypes.SBOMFormatSPDX
+	SBOMFormatCycloneDX = packagetypes.SBOMFormatCycloneDX
 )
 
 type (
@@ -25,6 +28,8 @@ type (
 	// Files is an in-memory representation of the package FileSystem.
 	// It maps file paths to their contents.
 	Files = packagetypes.Files
+	// SBOMFormat names a supported software bill of materials format.
+	SBOMFormat = packagetypes.SBOMFormat
 )
 
 var (
@@ -32,4 +37,11 @@ var (
 	PackageManifestGroupKind = packagetypes.PackageManifestGroupKind
 	// PackageManifestLockGroupKind is the kubernetes schema group kind of a PackageManifestLock.
 	PackageManifestLockGroupKind = packagetypes.PackageManifestLockGroupKind
+	// PackageTypesSource contains the Go source of the package types,
+	// so the template context can be documented from its definition.
+	PackageTypesSource = packagetypes.Source
+	// ConfigHash returns a short hash of the given package configuration.
+	ConfigHash = packagetypes.ConfigHash
+	// ConfigChecksums returns the checksum of each top-level key of the given package configuration.
+	ConfigChecksums = packagetypes.ConfigChecksums
 )
@@ -21,6 +21,10 @@ type (
 	LockfileConsistencyValidator = packagevalidation.LockfileConsistencyValidator
 	// Validates that images referenced in the lockfile are still present in the registry.
 	LockfileDigestLookupValidator = packagevalidation.LockfileDigestLookupValidator
+	// Runs lint rules against the rendered objects of a package.
+	LintValidator = packagevalidation.LintValidator
+	// LintConfig is read from the .package-lint.yaml file in the package root.
+	LintConfig = packagevalidation.LintConfig
 
 	// ObjectValidator knows how to validate objects within a Package.
 	ObjectValidator = packagetypes.ObjectValidator
@@ -62,4 +66,13 @@ var (
 
 	// Creates a new TemplateTestValidator instance.
 	NewTemplateTestValidator = packagevalidation.NewTemplateTestValidator
+	// Regenerates the test fixtures of all template test cases instead of comparing against them.
+	WithUpdateFixtures = packagevalidation.WithUpdateFixtures
+	// Creates a new LintValidator instance.
+	NewLintValidator = packagevalidation.NewLintValidator
+	// Reads a lint config, returns an empty config if the file does not exist.
+	LoadLintConfig = packagevalidation.LoadLintConfig
 )
+
+// LintConfigFilename is the name of the lint configuration file in the package root.
+const LintConfigFilename = packagevalidation.LintConfigFilename
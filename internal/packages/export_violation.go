@@ -35,4 +35,7 @@ var (
 	ViolationReasonNestedMultiComponentPkg       = packagetypes.ViolationReasonNestedMultiComponentPkg
 	ViolationReasonInvalidFileInComponentsDir    = packagetypes.ViolationReasonInvalidFileInComponentsDir
 	ViolationReasonKubeconform                   = packagetypes.ViolationReasonKubeconform
+	ViolationReasonLintRule                      = packagetypes.ViolationReasonLintRule
+	ViolationReasonTemplateNoValue               = packagetypes.ViolationReasonTemplateNoValue
+	ViolationReasonUnknownConfigField            = packagetypes.ViolationReasonUnknownConfigField
 )
@@ -0,0 +1,115 @@
+package packagedeploy
+
+import (
+	"context"
+	"fmt"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/apis/manifests"
+)
+
+// Reads the values declared in the manifest from objects of the currently deployed revision.
+// Objects that do not exist (yet) or are not managed by the package are skipped,
+// so the package can not read arbitrary objects from the cluster.
+func carryOver(
+	ctx context.Context, c client.Reader,
+	apiPkg adapters.GenericPackageAccessor, carryOvers []manifests.PackageManifestCarryOver,
+) (map[string]any, error) {
+	values := map[string]any{}
+	for _, co := range carryOvers {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(co.APIVersion)
+		obj.SetKind(co.Kind)
+
+		key := client.ObjectKey{Name: co.ObjectName, Namespace: co.Namespace}
+		if len(key.Namespace) == 0 {
+			key.Namespace = apiPkg.ClientObject().GetNamespace()
+		}
+		err := c.Get(ctx, key, obj)
+		if apimachineryerrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting carry over object %s %s: %w", co.Kind, key, err)
+		}
+
+		managed, err := managedByPackage(ctx, c, apiPkg, obj)
+		if err != nil {
+			return nil, err
+		}
+		if !managed {
+			continue
+		}
+
+		value, found, err := jsonPathValue(obj, co.Path)
+		if err != nil {
+			return nil, fmt.Errorf("reading carry over value %s: %w", co.Name, err)
+		}
+		if found {
+			values[co.Name] = value
+		}
+	}
+	return values, nil
+}
+
+// Checks that the object is controlled by an ObjectSet belonging to the given package.
+func managedByPackage(
+	ctx context.Context, c client.Reader, apiPkg adapters.GenericPackageAccessor, obj client.Object,
+) (bool, error) {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.APIVersion != corev1alpha1.GroupVersion.String() {
+		return false, nil
+	}
+
+	objectSet := &metav1.PartialObjectMetadata{}
+	objectSet.SetGroupVersionKind(corev1alpha1.GroupVersion.WithKind(owner.Kind))
+	switch owner.Kind {
+	case "ObjectSet":
+		if obj.GetNamespace() != apiPkg.ClientObject().GetNamespace() {
+			return false, nil
+		}
+		objectSet.SetNamespace(obj.GetNamespace())
+	case "ClusterObjectSet":
+		if len(apiPkg.ClientObject().GetNamespace()) > 0 {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+	objectSet.SetName(owner.Name)
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(objectSet), objectSet); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return objectSet.GetUID() == owner.UID &&
+		objectSet.GetLabels()[manifestsv1alpha1.PackageInstanceLabel] == apiPkg.ClientObject().GetName(), nil
+}
+
+// Returns the first value matching the given JSONPath.
+func jsonPathValue(obj *unstructured.Unstructured, path string) (any, bool, error) {
+	jp := jsonpath.New("carryOver").AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return nil, false, err
+	}
+	results, err := jp.FindResults(obj.Object)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() {
+				return value.Interface(), true, nil
+			}
+		}
+	}
+	return nil, false, nil
+}
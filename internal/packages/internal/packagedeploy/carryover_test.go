@@ -0,0 +1,112 @@
+package packagedeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/testutil"
+)
+
+func Test_carryOver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		ownerUID   string
+		ownerLabel string
+		notFound   bool
+		expected   map[string]any
+	}{
+		{
+			name:       "managed by package",
+			ownerUID:   "os-uid",
+			ownerLabel: "test",
+			expected:   map[string]any{"password": "c2VjcmV0"},
+		},
+		{
+			name:     "not found",
+			notFound: true,
+			expected: map[string]any{},
+		},
+		{
+			name:       "owned by other package",
+			ownerUID:   "os-uid",
+			ownerLabel: "other",
+			expected:   map[string]any{},
+		},
+		{
+			name:       "owner uid mismatch",
+			ownerUID:   "other-uid",
+			ownerLabel: "test",
+			expected:   map[string]any{},
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.NewClient()
+			c.On("Get", mock.Anything, mock.Anything,
+				mock.AnythingOfType("*unstructured.Unstructured"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					obj := args.Get(2).(*unstructured.Unstructured)
+					obj.SetName("credentials")
+					obj.SetNamespace("test")
+					obj.SetOwnerReferences([]metav1.OwnerReference{{
+						APIVersion: corev1alpha1.GroupVersion.String(),
+						Kind:       "ObjectSet",
+						Name:       "test-1",
+						UID:        "os-uid",
+						Controller: ptr.To(true),
+					}})
+					_ = unstructured.SetNestedField(obj.Object, "c2VjcmV0", "data", "password")
+				}).
+				Return(func() error {
+					if test.notFound {
+						return errors.NewNotFound(schema.GroupResource{}, "")
+					}
+					return nil
+				}())
+			c.On("Get", mock.Anything, mock.Anything,
+				mock.AnythingOfType("*v1.PartialObjectMetadata"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					obj := args.Get(2).(*metav1.PartialObjectMetadata)
+					obj.SetUID(types.UID(test.ownerUID))
+					obj.SetLabels(map[string]string{
+						manifestsv1alpha1.PackageInstanceLabel: test.ownerLabel,
+					})
+				}).
+				Return(nil)
+
+			apiPkg := &adapters.GenericPackage{
+				Package: corev1alpha1.Package{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+				},
+			}
+			values, err := carryOver(context.Background(), c, apiPkg, []manifests.PackageManifestCarryOver{
+				{
+					Name: "password", APIVersion: "v1", Kind: "Secret",
+					ObjectName: "credentials", Path: "{.data.password}",
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, values)
+		})
+	}
+}
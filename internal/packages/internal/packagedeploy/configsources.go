@@ -0,0 +1,199 @@
+package packagedeploy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+)
+
+// ErrInvalidConfigSource is returned for config sources that can not be loaded.
+var ErrInvalidConfigSource = errors.New("invalid config source")
+
+// Remembers which source last set a configuration value, keyed by field path.
+type configOrigins map[string]string
+
+// Records the origin of the value at path, replacing the origins of values nested below it.
+func (o configOrigins) set(path *field.Path, origin string) {
+	p := path.String()
+	for k := range o {
+		if strings.HasPrefix(k, p+".") || strings.HasPrefix(k, p+"[") {
+			delete(o, k)
+		}
+	}
+	o[p] = origin
+}
+
+// Returns the origin of the value at path or of the closest value containing it.
+func (o configOrigins) of(path string) (string, bool) {
+	for len(path) > 0 {
+		if origin, ok := o[path]; ok {
+			return origin, true
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return "", false
+}
+
+// Names the sources responsible for configuration validation errors.
+func (o configOrigins) annotate(errs field.ErrorList) {
+	for _, err := range errs {
+		origin, ok := o.of(err.Field)
+		if !ok {
+			continue
+		}
+		if len(err.Detail) == 0 {
+			err.Detail = "set by " + origin
+			continue
+		}
+		err.Detail = fmt.Sprintf("%s (set by %s)", err.Detail, origin)
+	}
+}
+
+// Assembles the package configuration from the config sources of the package and .spec.config.
+// Sources are deep merged in order, .spec.config is merged last.
+func loadConfiguration(
+	ctx context.Context, c client.Reader, apiPkg adapters.GenericPackageAccessor,
+	fldPath *field.Path,
+) (*runtime.RawExtension, configOrigins, error) {
+	config := apiPkg.TemplateContext().Config
+	sources := apiPkg.GetConfigSources()
+	if len(sources) == 0 {
+		return config, nil, nil
+	}
+
+	merged := map[string]any{}
+	origins := configOrigins{}
+	for i, source := range sources {
+		origin := fmt.Sprintf("configSources[%d]", i)
+		values, err := loadConfigSource(ctx, c, source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", origin, err)
+		}
+		mergeConfiguration(merged, values, source.ListStrategy, fldPath, origin, origins)
+	}
+
+	if config != nil {
+		values := map[string]any{}
+		if err := json.Unmarshal(config.Raw, &values); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal config: %w", err)
+		}
+		mergeConfiguration(merged, values, corev1alpha1.PackageConfigListStrategyReplace,
+			fldPath, "spec.config", origins)
+	}
+
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal merged config: %w", err)
+	}
+	return &runtime.RawExtension{Raw: raw}, origins, nil
+}
+
+func loadConfigSource(
+	ctx context.Context, c client.Reader, source corev1alpha1.PackageConfigSource,
+) (map[string]any, error) {
+	var data []byte
+	switch {
+	case source.Inline != nil:
+		data = source.Inline.Raw
+
+	case source.ConfigMap != nil:
+		ref := source.ConfigMap
+		if len(ref.Namespace) == 0 {
+			return nil, fmt.Errorf("%w: namespace of ConfigMap %s missing", ErrInvalidConfigSource, ref.Name)
+		}
+		cm := &corev1.ConfigMap{}
+		if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return nil, fmt.Errorf("getting ConfigMap %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		value, ok := cm.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: key %q not found in ConfigMap %s/%s",
+				ErrInvalidConfigSource, ref.Key, ref.Namespace, ref.Name)
+		}
+		data = []byte(value)
+
+	case source.Secret != nil:
+		ref := source.Secret
+		if len(ref.Namespace) == 0 {
+			return nil, fmt.Errorf("%w: namespace of Secret %s missing", ErrInvalidConfigSource, ref.Name)
+		}
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, secret); err != nil {
+			return nil, fmt.Errorf("getting Secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: key %q not found in Secret %s/%s",
+				ErrInvalidConfigSource, ref.Key, ref.Namespace, ref.Name)
+		}
+		data = value
+
+	case source.ClusterPackage != nil:
+		clusterPkg := &corev1alpha1.ClusterPackage{}
+		if err := c.Get(ctx, client.ObjectKey{Name: source.ClusterPackage.Name}, clusterPkg); err != nil {
+			return nil, fmt.Errorf("getting ClusterPackage %s: %w", source.ClusterPackage.Name, err)
+		}
+		if clusterPkg.Spec.Config != nil {
+			data = clusterPkg.Spec.Config.Raw
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: no source set", ErrInvalidConfigSource)
+	}
+
+	values := map[string]any{}
+	if len(data) == 0 {
+		return values, nil
+	}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfigSource, err)
+	}
+	return values, nil
+}
+
+// Deep merges src into dst.
+// Objects are merged key by key, all other values of src replace the values of dst.
+// Lists are appended to lists of dst with the Append strategy.
+func mergeConfiguration(
+	dst, src map[string]any, listStrategy corev1alpha1.PackageConfigListStrategy,
+	fldPath *field.Path, origin string, origins configOrigins,
+) {
+	for k, v := range src {
+		path := fldPath.Child(k)
+
+		srcMap, srcIsMap := v.(map[string]any)
+		dstMap, dstIsMap := dst[k].(map[string]any)
+		if srcIsMap && dstIsMap {
+			mergeConfiguration(dstMap, srcMap, listStrategy, path, origin, origins)
+			continue
+		}
+
+		srcList, srcIsList := v.([]any)
+		dstList, dstIsList := dst[k].([]any)
+		if srcIsList && dstIsList && listStrategy == corev1alpha1.PackageConfigListStrategyAppend {
+			for i := range srcList {
+				origins.set(path.Index(len(dstList)+i), origin)
+			}
+			dst[k] = append(dstList, srcList...)
+			continue
+		}
+
+		origins.set(path, origin)
+		dst[k] = v
+	}
+}
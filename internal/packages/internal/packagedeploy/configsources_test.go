@@ -0,0 +1,110 @@
+package packagedeploy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/adapters"
+	"package-operator.run/internal/testutil"
+)
+
+func Test_loadConfiguration(t *testing.T) {
+	t.Parallel()
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1alpha1.ClusterPackage"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*corev1alpha1.ClusterPackage).Spec.Config = &runtime.RawExtension{
+				Raw: []byte(`{"replicas":1,"image":{"tag":"v1","pullPolicy":"Always"},"args":["-v"]}`),
+			}
+		}).
+		Return(nil)
+	c.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.ConfigMap"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*corev1.ConfigMap).Data = map[string]string{
+				"config.yaml": "image:\n  tag: v2\nargs:\n- --debug\n",
+			}
+		}).
+		Return(nil)
+	c.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.Secret"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*corev1.Secret).Data = map[string][]byte{"config": []byte(`{"password":"secret"}`)}
+		}).
+		Return(nil)
+
+	pkg := &adapters.GenericPackage{}
+	pkg.Namespace = "test"
+	pkg.Spec.Config = &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+	pkg.Spec.ConfigSources = []corev1alpha1.PackageConfigSource{
+		{ClusterPackage: &corev1alpha1.PackageConfigClusterPackageReference{Name: "defaults"}},
+		{
+			ConfigMap:    &corev1alpha1.PackageConfigKeyReference{Name: "cfg", Key: "config.yaml"},
+			ListStrategy: corev1alpha1.PackageConfigListStrategyAppend,
+		},
+		{Secret: &corev1alpha1.PackageConfigKeyReference{Name: "cfg", Key: "config"}},
+	}
+
+	fldPath := field.NewPath("spec", "config")
+	config, origins, err := loadConfiguration(context.Background(), c, pkg, fldPath)
+	require.NoError(t, err)
+
+	configuration := map[string]any{}
+	require.NoError(t, json.Unmarshal(config.Raw, &configuration))
+	assert.Equal(t, map[string]any{
+		"replicas": float64(3),
+		"image":    map[string]any{"tag": "v2", "pullPolicy": "Always"},
+		"args":     []any{"-v", "--debug"},
+		"password": "secret",
+	}, configuration)
+
+	errs := field.ErrorList{
+		field.Invalid(fldPath.Child("image", "tag"), "v2", "unknown tag"),
+		field.Invalid(fldPath.Child("image", "pullPolicy"), "Always", ""),
+		field.Invalid(fldPath.Child("args").Index(1), "--debug", "unknown flag"),
+		field.Invalid(fldPath.Child("replicas"), 3, "too many"),
+		field.Required(fldPath.Child("name"), ""),
+	}
+	origins.annotate(errs)
+	assert.Equal(t, "unknown tag (set by configSources[1])", errs[0].Detail)
+	assert.Equal(t, "set by configSources[0]", errs[1].Detail)
+	assert.Equal(t, "unknown flag (set by configSources[1])", errs[2].Detail)
+	assert.Equal(t, "too many (set by spec.config)", errs[3].Detail)
+	assert.Empty(t, errs[4].Detail)
+}
+
+func Test_loadConfiguration_noSources(t *testing.T) {
+	t.Parallel()
+
+	pkg := &adapters.GenericPackage{}
+	pkg.Spec.Config = &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+
+	config, _, err := loadConfiguration(context.Background(), testutil.NewClient(), pkg, field.NewPath("spec", "config"))
+	require.NoError(t, err)
+	assert.Same(t, pkg.Spec.Config, config)
+}
+
+func Test_loadConfiguration_invalidSource(t *testing.T) {
+	t.Parallel()
+
+	c := testutil.NewClient()
+	c.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.ConfigMap"), mock.Anything).
+		Return(nil)
+
+	pkg := &adapters.GenericClusterPackage{}
+	pkg.Spec.ConfigSources = []corev1alpha1.PackageConfigSource{
+		{ConfigMap: &corev1alpha1.PackageConfigKeyReference{Name: "cfg", Namespace: "test", Key: "config.yaml"}},
+	}
+
+	_, _, err := loadConfiguration(context.Background(), c, pkg, field.NewPath("spec", "config"))
+	require.ErrorIs(t, err, ErrInvalidConfigSource)
+	assert.EqualError(t, err, `configSources[0]: invalid config source: key "config.yaml" not found in ConfigMap test/cfg`)
+}
@@ -24,14 +24,20 @@ import (
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/constants"
+	"package-operator.run/internal/errorclass"
 	"package-operator.run/internal/packages/internal/packagemanifestvalidation"
 	"package-operator.run/internal/packages/internal/packagerender"
 	"package-operator.run/internal/packages/internal/packagestructure"
 	"package-operator.run/internal/packages/internal/packagetypes"
 	"package-operator.run/internal/packages/internal/packagevalidation"
+	"package-operator.run/internal/tracing"
 )
 
-var ErrNonExisting = errors.New("unable to validate non existing package")
+var (
+	ErrNonExisting = errors.New("unable to validate non existing package")
+	// ErrInvalidImageOverride is returned for image overrides that can not be applied.
+	ErrInvalidImageOverride = errors.New("invalid image override")
+)
 
 // PackageDeployer loads package contents from file, wraps it into an ObjectDeployment and deploys it.
 type PackageDeployer struct {
@@ -45,6 +51,7 @@ type PackageDeployer struct {
 
 	deploymentReconciler deploymentReconciler
 	packageValidators    packagevalidation.PackageValidatorList
+	renderLimits         RenderLimits
 }
 
 type (
@@ -59,8 +66,10 @@ type (
 )
 
 // Returns a new namespace-scoped loader for the Package API.
-func NewPackageDeployer(c client.Client, uncachedClient client.Client, scheme *runtime.Scheme) *PackageDeployer {
-	return &PackageDeployer{
+func NewPackageDeployer(
+	c client.Client, uncachedClient client.Client, scheme *runtime.Scheme, opts ...PackageDeployerOption,
+) *PackageDeployer {
+	l := &PackageDeployer{
 		client:         c,
 		uncachedClient: uncachedClient,
 
@@ -70,7 +79,7 @@ func NewPackageDeployer(c client.Client, uncachedClient client.Client, scheme *r
 		structuralLoader:    packagestructure.DefaultStructuralLoader,
 
 		deploymentReconciler: newDeploymentReconciler(
-			scheme, c,
+			scheme, c, uncachedClient,
 			adapters.NewObjectDeployment, adapters.NewObjectSlice,
 			adapters.NewObjectSliceList, newGenericObjectSetList,
 		),
@@ -79,12 +88,20 @@ func NewPackageDeployer(c client.Client, uncachedClient client.Client, scheme *r
 			packagevalidation.PackageScopeValidator(manifests.PackageManifestScopeNamespaced),
 		),
 	}
+	for _, opt := range opts {
+		opt.ConfigurePackageDeployer(l)
+	}
+	return l
 }
 
 // Returns a new cluster-scoped loader for the ClusterPackage API.
-func NewClusterPackageDeployer(c client.Client, scheme *runtime.Scheme) *PackageDeployer {
-	return &PackageDeployer{
-		client: c,
+func NewClusterPackageDeployer(
+	c client.Client, uncachedClient client.Client, scheme *runtime.Scheme, opts ...PackageDeployerOption,
+) *PackageDeployer {
+	l := &PackageDeployer{
+		client:         c,
+		uncachedClient: uncachedClient,
+
 		scheme: scheme,
 
 		newObjectDeployment: adapters.NewClusterObjectDeployment,
@@ -93,6 +110,7 @@ func NewClusterPackageDeployer(c client.Client, scheme *runtime.Scheme) *Package
 		deploymentReconciler: newDeploymentReconciler(
 			scheme,
 			c,
+			uncachedClient,
 			adapters.NewClusterObjectDeployment,
 			adapters.NewClusterObjectSlice,
 			adapters.NewClusterObjectSliceList,
@@ -103,6 +121,10 @@ func NewClusterPackageDeployer(c client.Client, scheme *runtime.Scheme) *Package
 			packagevalidation.PackageScopeValidator(manifests.PackageManifestScopeCluster),
 		),
 	}
+	for _, opt := range opts {
+		opt.ConfigurePackageDeployer(l)
+	}
+	return l
 }
 
 // ImageWithDigest replaces the tag/digest part of the given reference
@@ -120,6 +142,75 @@ func ImageWithDigest(reference string, digest string) (string, error) {
 	return ref.Context().Digest(digest).String(), nil
 }
 
+// Resolves images declared in the PackageManifest to their locked digest
+// and applies the image overrides of the package on top.
+func resolveImages(
+	manifest *manifests.PackageManifest, lock *manifests.PackageManifestLock,
+	overrides []corev1alpha1.PackageImageOverride,
+) (map[string]string, error) {
+	images := map[string]string{}
+	if lock != nil {
+		for _, packageImage := range lock.Spec.Images {
+			resolvedImage, err := ImageWithDigest(packageImage.Image, packageImage.Digest)
+			if err != nil {
+				return nil, err
+			}
+			images[packageImage.Name] = resolvedImage
+		}
+	}
+
+	for _, override := range overrides {
+		image, ok := images[override.Name]
+		if !ok {
+			// Images without lock entry are only rendered with an override.
+			for _, declared := range manifest.Spec.Images {
+				if declared.Name == override.Name {
+					image, ok = declared.Image, true
+					break
+				}
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: image %q is not declared in the PackageManifest", ErrInvalidImageOverride, override.Name)
+		}
+
+		overridden, err := overrideImage(image, override)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidImageOverride, override.Name, err)
+		}
+		images[override.Name] = overridden
+	}
+	return images, nil
+}
+
+// Replaces the repository and/or digest of the given image reference.
+func overrideImage(image string, override corev1alpha1.PackageImageOverride) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+
+	repository := ref.Context()
+	if len(override.Repository) > 0 {
+		if repository, err = name.NewRepository(override.Repository); err != nil {
+			return "", err
+		}
+	}
+
+	if len(override.Digest) > 0 {
+		digest, err := name.NewDigest(repository.String() + "@" + override.Digest)
+		if err != nil {
+			return "", err
+		}
+		return digest.String(), nil
+	}
+	if _, isDigest := ref.(name.Digest); isDigest {
+		return repository.Digest(ref.Identifier()).String(), nil
+	}
+	return repository.Tag(ref.Identifier()).String(), nil
+}
+
 func (l *PackageDeployer) Deploy(
 	ctx context.Context,
 	apiPkg adapters.GenericPackageAccessor,
@@ -131,6 +222,9 @@ func (l *PackageDeployer) Deploy(
 		setInvalidConditionBasedOnLoadError(apiPkg, err)
 		return nil
 	}
+	if err := setConfigSchema(apiPkg, pkg.Manifest); err != nil {
+		return err
+	}
 
 	// Check constraints
 	if err := validateConstraints(ctx, l.uncachedClient, apiPkg, pkg.Manifest, env); err != nil {
@@ -140,6 +234,16 @@ func (l *PackageDeployer) Deploy(
 
 	// prepare package render/template context
 	tmplCtx := apiPkg.TemplateContext()
+	configPath := field.NewPath("spec", "config")
+	config, origins, err := loadConfiguration(ctx, l.uncachedClient, apiPkg, configPath)
+	if errors.Is(err, ErrInvalidConfigSource) {
+		setInvalidConditionBasedOnLoadError(apiPkg, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	tmplCtx.Config = config
 	configuration := map[string]any{}
 	if tmplCtx.Config != nil {
 		if err := json.Unmarshal(tmplCtx.Config.Raw, &configuration); err != nil {
@@ -147,40 +251,54 @@ func (l *PackageDeployer) Deploy(
 		}
 	}
 	validationErrors, err := packagemanifestvalidation.AdmitPackageConfiguration(
-		ctx, configuration, pkg.Manifest, field.NewPath("spec", "config"))
+		ctx, configuration, pkg.Manifest, configPath)
 	if err != nil {
 		return fmt.Errorf("validate Package configuration: %w", err)
 	}
 	if len(validationErrors) > 0 {
+		origins.annotate(validationErrors)
 		setInvalidConditionBasedOnLoadError(apiPkg, validationErrors.ToAggregate())
 		return nil
 	}
-	images := map[string]string{}
-	if pkg.ManifestLock != nil {
-		for _, packageImage := range pkg.ManifestLock.Spec.Images {
-			resolvedImage, err := ImageWithDigest(packageImage.Image, packageImage.Digest)
-			if err != nil {
-				return err
-			}
-			images[packageImage.Name] = resolvedImage
-		}
+	images, err := resolveImages(pkg.Manifest, pkg.ManifestLock, apiPkg.GetImageOverrides())
+	if errors.Is(err, ErrInvalidImageOverride) {
+		setInvalidConditionBasedOnLoadError(apiPkg, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	tmplCtx.Package.ConfigHash = packagetypes.ConfigHash(configuration)
+	tmplCtx.Package.ConfigChecksums = packagetypes.ConfigChecksums(configuration)
+
+	previous, err := carryOver(ctx, l.uncachedClient, apiPkg, pkg.Manifest.Spec.CarryOver)
+	if err != nil {
+		return fmt.Errorf("carrying over values: %w", err)
 	}
 
 	// render package instance
+	renderCtx, renderSpan := tracing.Start(ctx, "RenderPackage")
 	pkgInstance, err := packagerender.RenderPackageInstance(
-		ctx, pkg,
+		renderCtx, pkg,
 		packagetypes.PackageRenderContext{
 			Package:     tmplCtx.Package,
 			Config:      configuration,
 			Images:      images,
 			Environment: env,
+			Previous:    previous,
 		}, l.packageValidators, packagevalidation.DefaultObjectValidators)
+	tracing.End(renderSpan, err)
 	if err != nil {
 		setInvalidConditionBasedOnLoadError(apiPkg, err)
 		return nil
 	}
+	if err := l.renderLimits.Check(pkgInstance.Objects); err != nil {
+		setInvalidConditionBasedOnLoadError(apiPkg, err)
+		return nil
+	}
 
-	desiredDeploy, err := l.desiredObjectDeployment(ctx, apiPkg, pkgInstance)
+	desiredDeploy, err := l.desiredObjectDeployment(ctx, apiPkg, tmplCtx.Config, pkgInstance)
 	if err != nil {
 		return fmt.Errorf("creating desired ObjectDeployment: %w", err)
 	}
@@ -196,14 +314,15 @@ func (l *PackageDeployer) Deploy(
 }
 
 func (l *PackageDeployer) desiredObjectDeployment(
-	_ context.Context, pkg adapters.GenericPackageAccessor, pkgInstance *packagetypes.PackageInstance,
+	_ context.Context, pkg adapters.GenericPackageAccessor,
+	config *runtime.RawExtension, pkgInstance *packagetypes.PackageInstance,
 ) (deploy adapters.ObjectDeploymentAccessor, err error) {
 	labels := map[string]string{
 		manifestsv1alpha1.PackageLabel:         pkgInstance.Manifest.Name,
 		manifestsv1alpha1.PackageInstanceLabel: pkg.ClientObject().GetName(),
 	}
 
-	configJSON, err := json.Marshal(pkg.TemplateContext().Config)
+	configJSON, err := json.Marshal(config)
 	if err != nil {
 		return nil, fmt.Errorf("marshalling config for package-config annotation: %w", err)
 	}
@@ -213,6 +332,18 @@ func (l *PackageDeployer) desiredObjectDeployment(
 		constants.ChangeCauseAnnotation: fmt.Sprintf(
 			"Installing %s package.", pkgInstance.Manifest.Name),
 	}
+	// Only cluster scoped packages may target another cluster,
+	// because objects of namespaced packages default into the namespace of the package.
+	if len(pkg.ClientObject().GetNamespace()) == 0 {
+		for _, key := range []string{
+			corev1alpha1.HostedClusterTargetAnnotation,
+			corev1alpha1.ClusterTargetAnnotation,
+		} {
+			if target, ok := pkg.ClientObject().GetAnnotations()[key]; ok {
+				annotations[key] = target
+			}
+		}
+	}
 
 	deploy = l.newObjectDeployment(l.scheme)
 	deploy.ClientObject().SetLabels(labels)
@@ -221,8 +352,14 @@ func (l *PackageDeployer) desiredObjectDeployment(
 	deploy.ClientObject().SetName(pkg.ClientObject().GetName())
 	deploy.ClientObject().SetNamespace(pkg.ClientObject().GetNamespace())
 
-	deploy.SetTemplateSpec(packagerender.RenderObjectSetTemplateSpec(pkgInstance))
+	templateSpec := packagerender.RenderObjectSetTemplateSpec(pkgInstance)
+	templateSpec.FieldManager = pkg.GetFieldManager()
+	templateSpec.FieldConflictPolicy = pkg.GetFieldConflictPolicy()
+	deploy.SetTemplateSpec(templateSpec)
 	deploy.SetSelector(labels)
+	deploy.SetPriority(pkg.GetPriority())
+	deploy.SetRolloutSchedule(pkg.GetRolloutSchedule())
+	deploy.SetFreeze(pkg.GetFreeze())
 
 	if err := controllerutil.SetControllerReference(
 		pkg.ClientObject(), deploy.ClientObject(), l.scheme); err != nil {
@@ -232,10 +369,36 @@ func (l *PackageDeployer) desiredObjectDeployment(
 	return deploy, nil
 }
 
+// Reports the configuration schema of the package in its status,
+// before the configuration is validated against it, so tooling can help fixing invalid configuration.
+func setConfigSchema(apiPkg adapters.GenericPackageAccessor, manifest *manifests.PackageManifest) error {
+	if manifest.Spec.Config.OpenAPIV3Schema == nil {
+		apiPkg.SetStatusConfigSchema(nil)
+		return nil
+	}
+
+	var config manifestsv1alpha1.PackageManifestSpecConfig
+	if err := manifests.Convert_manifests_PackageManifestSpecConfig_To_v1alpha1_PackageManifestSpecConfig(
+		&manifest.Spec.Config, &config, nil); err != nil {
+		return fmt.Errorf("converting config schema: %w", err)
+	}
+	schema, err := json.Marshal(config.OpenAPIV3Schema)
+	if err != nil {
+		return fmt.Errorf("marshalling config schema: %w", err)
+	}
+	apiPkg.SetStatusConfigSchema(&runtime.RawExtension{Raw: schema})
+	return nil
+}
+
 func setInvalidConditionBasedOnLoadError(pkg adapters.GenericPackageAccessor, err error) {
 	reason := "LoadError"
+	switch {
+	case errors.Is(err, ErrRenderLimitExceeded):
+		reason = "RenderLimitExceeded"
+	case errorclass.Of(err) == errorclass.Template:
+		reason = errorclass.Template.ConditionReason()
+	}
 
-	// Can not be determined more precisely
 	meta.SetStatusCondition(pkg.GetConditions(), metav1.Condition{
 		Type:               corev1alpha1.PackageInvalid,
 		Status:             metav1.ConditionTrue,
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -121,7 +122,8 @@ func TestNewClustePackageDeployer(t *testing.T) {
 	t.Parallel()
 
 	c := testutil.NewClient()
-	l := NewClusterPackageDeployer(c, testScheme)
+	uc := testutil.NewClient()
+	l := NewClusterPackageDeployer(c, uc, testScheme)
 	assert.NotNil(t, l)
 }
 
@@ -200,6 +202,34 @@ func TestPackageDeployer_Deploy(t *testing.T) {
 	assert.Nil(t, packageInvalid, "Invalid condition should not be reported")
 }
 
+func Test_setConfigSchema(t *testing.T) {
+	t.Parallel()
+
+	apiPkg := &adapters.GenericPackage{}
+	manifest := &manifests.PackageManifest{
+		Spec: manifests.PackageManifestSpec{
+			Config: manifests.PackageManifestSpecConfig{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"replicas": {Type: "integer"},
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, setConfigSchema(apiPkg, manifest))
+	if assert.NotNil(t, apiPkg.Status.ConfigSchema) {
+		assert.JSONEq(t,
+			`{"type":"object","properties":{"replicas":{"type":"integer"}}}`,
+			string(apiPkg.Status.ConfigSchema.Raw))
+	}
+
+	// Schema is removed again when a new version of the package drops it.
+	require.NoError(t, setConfigSchema(apiPkg, &manifests.PackageManifest{}))
+	assert.Nil(t, apiPkg.Status.ConfigSchema)
+}
+
 func TestPackageDeployer_Deploy_Error(t *testing.T) {
 	t.Parallel()
 
@@ -294,6 +324,52 @@ func TestImageWithDigestError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_resolveImages(t *testing.T) {
+	t.Parallel()
+
+	const pinnedDgst = "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	manifest := &manifests.PackageManifest{
+		Spec: manifests.PackageManifestSpec{
+			Images: []manifests.PackageManifestImage{
+				{Name: "app", Image: "quay.io/org/app:v1"},
+				{Name: "sidecar", Image: "quay.io/org/sidecar:v2"},
+				{Name: "unlocked", Image: "quay.io/org/unlocked:v3"},
+			},
+		},
+	}
+	lock := &manifests.PackageManifestLock{
+		Spec: manifests.PackageManifestLockSpec{
+			Images: []manifests.PackageManifestLockImage{
+				{Name: "app", Image: "quay.io/org/app:v1", Digest: testDgst},
+				{Name: "sidecar", Image: "quay.io/org/sidecar:v2", Digest: testDgst},
+			},
+		},
+	}
+
+	images, err := resolveImages(manifest, lock, []corev1alpha1.PackageImageOverride{
+		{Name: "app", Repository: "mirror.example.com/org/app"},
+		{Name: "sidecar", Digest: pinnedDgst},
+		{Name: "unlocked", Repository: "mirror.example.com/org/unlocked"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"app":      "mirror.example.com/org/app@" + testDgst,
+		"sidecar":  "quay.io/org/sidecar@" + pinnedDgst,
+		"unlocked": "mirror.example.com/org/unlocked:v3",
+	}, images)
+
+	_, err = resolveImages(manifest, lock, []corev1alpha1.PackageImageOverride{
+		{Name: "missing", Digest: pinnedDgst},
+	})
+	require.ErrorIs(t, err, ErrInvalidImageOverride)
+
+	_, err = resolveImages(manifest, lock, []corev1alpha1.PackageImageOverride{
+		{Name: "app", Digest: "sha256:invalid"},
+	})
+	require.ErrorIs(t, err, ErrInvalidImageOverride)
+}
+
 func Test_validateConstraints(t *testing.T) {
 	t.Parallel()
 	cli := testutil.NewClient()
@@ -2,9 +2,11 @@ package packagedeploy
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -12,7 +14,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
@@ -20,6 +24,7 @@ import (
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/ownerhandling"
+	"package-operator.run/internal/tracing"
 	"package-operator.run/internal/utils"
 )
 
@@ -34,23 +39,34 @@ type (
 	}
 )
 
-const sliceOwnerLabel = "slices.package-operator.run/owner"
+const (
+	sliceOwnerLabel = "slices.package-operator.run/owner"
+	// Set on ObjectSlices when garbage collection finds them unreferenced for the first time.
+	sliceUnreferencedSinceAnnotation = "slices.package-operator.run/unreferenced-since"
+	// Time ObjectSlices have to stay unreferenced before they are deleted.
+	// Covers ObjectSets that have just been created, but are not yet visible in the cache.
+	sliceGarbageCollectionGracePeriod = 5 * time.Minute
+)
 
 // DeploymentReconciler creates or updates an (Cluster)ObjectDeployment.
 // Will respect the given chunking strategy to create multiple ObjectSlices.
 type DeploymentReconciler struct {
 	scheme              *runtime.Scheme
 	client              client.Client
+	uncachedClient      client.Reader
+	listPageSize        int64
 	newObjectDeployment adapters.ObjectDeploymentFactory
 	newObjectSlice      adapters.ObjectSliceFactory
 	newObjectSliceList  adapters.ObjectSliceListFactory
 	newObjectSetList    genericObjectSetListFactory
 	ownerStrategy       ownerStrategy
+	clock               clock.PassiveClock
 }
 
 func newDeploymentReconciler(
 	scheme *runtime.Scheme,
 	client client.Client,
+	uncachedClient client.Reader,
 	newObjectDeployment adapters.ObjectDeploymentFactory,
 	newObjectSlice adapters.ObjectSliceFactory,
 	newObjectSliceList adapters.ObjectSliceListFactory,
@@ -59,11 +75,14 @@ func newDeploymentReconciler(
 	return &DeploymentReconciler{
 		scheme:              scheme,
 		client:              client,
+		uncachedClient:      uncachedClient,
+		listPageSize:        utils.DefaultListPageSize,
 		newObjectDeployment: newObjectDeployment,
 		newObjectSlice:      newObjectSlice,
 		newObjectSliceList:  newObjectSliceList,
 		newObjectSetList:    newObjectSetList,
 		ownerStrategy:       ownerhandling.NewNative(scheme),
+		clock:               clock.RealClock{},
 	}
 }
 
@@ -111,7 +130,14 @@ func (r *DeploymentReconciler) Reconcile(
 		)
 		actualDeploy.ClientObject().SetLabels(labels)
 
+		if !equality.Semantic.DeepEqual(actualDeploy.GetTemplateSpec(), templateSpec) {
+			// Link the rollout of the new spec to this reconciliation.
+			tracing.InjectAnnotation(ctx, actualDeploy.ClientObject())
+		}
 		actualDeploy.SetTemplateSpec(templateSpec)
+		actualDeploy.SetPriority(desiredDeploy.GetPriority())
+		actualDeploy.SetRolloutSchedule(desiredDeploy.GetRolloutSchedule())
+		actualDeploy.SetFreeze(desiredDeploy.GetFreeze())
 
 		err := r.client.Update(ctx, actualDeploy.ClientObject())
 		if err == nil {
@@ -141,6 +167,8 @@ func (r *DeploymentReconciler) Reconcile(
 }
 
 // GarbageCollect Slices that are no longer referenced.
+// Slices are first marked as unreferenced and only deleted by a later reconcile,
+// after they stayed unreferenced for the grace period.
 func (r *DeploymentReconciler) sliceGarbageCollection(
 	ctx context.Context, deploy adapters.ObjectDeploymentAccessor,
 ) error {
@@ -164,26 +192,68 @@ func (r *DeploymentReconciler) sliceGarbageCollection(
 	}
 
 	// List all Slices controlled by this Deployment.
-	controlledSlicesList := r.newObjectSliceList(r.scheme)
-	if err := r.client.List(
-		ctx, controlledSlicesList.ClientObjectList(),
+	// Slices are listed in pages from the API server,
+	// as Packages may be split into thousands of them.
+	var controlledSlicesList adapters.ObjectSliceListAccessor
+	if err := utils.ListPages(
+		ctx, r.uncachedClient,
+		func() client.ObjectList {
+			controlledSlicesList = r.newObjectSliceList(r.scheme)
+			return controlledSlicesList.ClientObjectList()
+		},
+		r.listPageSize,
+		func(client.ObjectList) error {
+			return r.sweepUnreferencedSlices(ctx, controlledSlicesList, referencedSlices)
+		},
 		client.MatchingLabels{
 			sliceOwnerLabel: deploy.ClientObject().GetName(),
 		},
 		client.InNamespace(
 			deploy.ClientObject().GetNamespace()),
 	); err != nil {
-		return fmt.Errorf("listing all controlled slices: %w", err)
+		return fmt.Errorf("garbage collecting controlled slices: %w", err)
 	}
 
-	// Delete Slices not referenced anymore.
-	for _, slice := range controlledSlicesList.GetItems() {
-		if _, referenced := referencedSlices[slice.ClientObject().GetName()]; referenced {
+	return nil
+}
+
+func (r *DeploymentReconciler) sweepUnreferencedSlices(
+	ctx context.Context, slices adapters.ObjectSliceListAccessor,
+	referencedSlices map[string]struct{},
+) error {
+	now := r.clock.Now()
+	for _, slice := range slices.GetItems() {
+		obj := slice.ClientObject()
+		_, referenced := referencedSlices[obj.GetName()]
+		// ObjectSets add an owner reference when loading a slice,
+		// which also covers ObjectSets missing from the cache.
+		referenced = referenced || isOwnedByObjectSet(obj)
+		since, marked := obj.GetAnnotations()[sliceUnreferencedSinceAnnotation]
+
+		if referenced {
+			if marked {
+				if err := r.setSliceUnreferencedSince(ctx, obj, ""); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if !marked {
+			if err := r.setSliceUnreferencedSince(ctx, obj, now.UTC().Format(time.RFC3339)); err != nil {
+				return err
+			}
+			continue
+		}
+		if sinceTime, err := time.Parse(time.RFC3339, since); err == nil &&
+			now.Sub(sinceTime) < sliceGarbageCollectionGracePeriod {
 			continue
 		}
 
-		// Slice is not referenced anymore.
-		if err := r.client.Delete(ctx, slice.ClientObject()); err != nil {
+		// Slice stayed unreferenced for the grace period.
+		// The precondition keeps slices that have been adopted by an ObjectSet in the meantime.
+		rv := obj.GetResourceVersion()
+		err := r.client.Delete(ctx, obj, client.Preconditions{ResourceVersion: &rv})
+		if err != nil && !apimachineryerrors.IsNotFound(err) && !apimachineryerrors.IsConflict(err) {
 			return fmt.Errorf("garbage collect ObjectSlice: %w", err)
 		}
 	}
@@ -191,6 +261,44 @@ func (r *DeploymentReconciler) sliceGarbageCollection(
 	return nil
 }
 
+// Sets or, when empty, removes the time a slice was found unreferenced.
+// Slices changed or deleted in the meantime are evaluated again with the next reconcile.
+func (r *DeploymentReconciler) setSliceUnreferencedSince(
+	ctx context.Context, obj client.Object, since string,
+) error {
+	var value any
+	if len(since) > 0 {
+		value = since
+	}
+	patch := map[string]any{
+		"metadata": map[string]any{
+			"resourceVersion": obj.GetResourceVersion(),
+			"annotations": map[string]any{
+				sliceUnreferencedSinceAnnotation: value,
+			},
+		},
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshalling patch to mark ObjectSlice: %w", err)
+	}
+
+	err = r.client.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patchJSON))
+	if err != nil && !apimachineryerrors.IsNotFound(err) && !apimachineryerrors.IsConflict(err) {
+		return fmt.Errorf("marking ObjectSlice for garbage collection: %w", err)
+	}
+	return nil
+}
+
+func isOwnedByObjectSet(obj client.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Kind == "ObjectSet" || ref.Kind == "ClusterObjectSet" {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *DeploymentReconciler) listObjectSetsForDeployment(
 	ctx context.Context, deploy adapters.ObjectDeploymentAccessor,
 ) ([]genericObjectSet, error) {
@@ -239,6 +347,11 @@ func (r *DeploymentReconciler) chunkPhase(
 			sliceOwnerLabel: deploy.ClientObject().GetName(),
 		})
 		slice.SetObjects(objectsForSlice)
+		checksum, err := utils.ComputeObjectsChecksum(objectsForSlice)
+		if err != nil {
+			return err
+		}
+		slice.SetChecksum(checksum)
 
 		if err := r.reconcileSlice(ctx, deploy, slice); err != nil {
 			return fmt.Errorf("reconcile ObjectSlice: %w", err)
@@ -3,6 +3,7 @@ package packagedeploy
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -13,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
@@ -20,13 +22,14 @@ import (
 	"package-operator.run/internal/adapters"
 	"package-operator.run/internal/constants"
 	"package-operator.run/internal/testutil"
+	"package-operator.run/internal/utils"
 )
 
 func Test_DeploymentReconciler_Reconcile(t *testing.T) {
 	t.Parallel()
 
 	c := testutil.NewClient()
-	r := newDeploymentReconciler(testScheme, c,
+	r := newDeploymentReconciler(testScheme, c, c,
 		adapters.NewObjectDeployment,
 		adapters.NewObjectSlice,
 		adapters.NewObjectSliceList,
@@ -131,11 +134,14 @@ func Test_DeploymentReconciler_Reconcile(t *testing.T) {
 			Object: unstructured.Unstructured{},
 		},
 	}, createdSlice.Objects)
+	checksum, err := utils.ComputeObjectsChecksum(createdSlice.Objects)
+	require.NoError(t, err)
+	assert.Equal(t, checksum, createdSlice.Checksum)
 
 	assert.Equal(t, []corev1alpha1.ObjectSetTemplatePhase{
 		{
 			Name:   "test",
-			Slices: []string{"test-depl-95565fb5c"},
+			Slices: []string{"test-depl-6896cff989"},
 		},
 	}, updatedDeployment.Spec.Template.Spec.Phases)
 }
@@ -144,7 +150,7 @@ func TestDeploymentReconciler_reconcileSlice_hashCollision(t *testing.T) {
 	t.Parallel()
 
 	c := testutil.NewClient()
-	r := newDeploymentReconciler(testScheme, c,
+	r := newDeploymentReconciler(testScheme, c, c,
 		adapters.NewObjectDeployment,
 		adapters.NewObjectSlice,
 		adapters.NewObjectSliceList,
@@ -208,12 +214,14 @@ func TestDeploymentReconciler_reconcileSlice_hashCollision(t *testing.T) {
 func TestDeploymentReconciler_sliceGarbageCollection(t *testing.T) {
 	t.Parallel()
 
+	now := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
 	c := testutil.NewClient()
-	r := newDeploymentReconciler(testScheme, c,
+	r := newDeploymentReconciler(testScheme, c, c,
 		adapters.NewObjectDeployment,
 		adapters.NewObjectSlice,
 		adapters.NewObjectSliceList,
 		newGenericObjectSetList)
+	r.clock = clocktesting.NewFakePassiveClock(now)
 	ctx := logr.NewContext(context.Background(), testr.New(t))
 
 	deploy := &adapters.ObjectDeployment{
@@ -253,21 +261,54 @@ func TestDeploymentReconciler_sliceGarbageCollection(t *testing.T) {
 		},
 	}
 
+	unreferencedSince := func(d time.Duration) map[string]string {
+		return map[string]string{
+			sliceUnreferencedSinceAnnotation: now.Add(-d).Format(time.RFC3339),
+		}
+	}
+	// Referenced by the ObjectDeployment.
 	objectSlice0 := &corev1alpha1.ObjectSlice{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "slice0-xxx",
 		},
 	}
+	// Referenced by an ObjectSet again.
 	objectSlice1 := &corev1alpha1.ObjectSlice{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "slice1-xxx",
+			Name:        "slice1-xxx",
+			Annotations: unreferencedSince(time.Minute),
 		},
 	}
+	// Found unreferenced for the first time.
 	objectSlice2 := &corev1alpha1.ObjectSlice{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "slice2-xxx",
 		},
 	}
+	// Unreferenced for longer than the grace period.
+	objectSlice3 := &corev1alpha1.ObjectSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "slice3-xxx",
+			ResourceVersion: "3",
+			Annotations:     unreferencedSince(time.Hour),
+		},
+	}
+	// Still within the grace period.
+	objectSlice4 := &corev1alpha1.ObjectSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "slice4-xxx",
+			Annotations: unreferencedSince(time.Minute),
+		},
+	}
+	// Owned by an ObjectSet missing from the cache.
+	objectSlice5 := &corev1alpha1.ObjectSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "slice5-xxx",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "package-operator.run/v1alpha1", Kind: "ObjectSet", Name: "test-depl-1"},
+			},
+		},
+	}
 
 	c.
 		On("List",
@@ -290,9 +331,24 @@ func TestDeploymentReconciler_sliceGarbageCollection(t *testing.T) {
 			list := args.Get(1).(*corev1alpha1.ObjectSliceList)
 			list.Items = []corev1alpha1.ObjectSlice{
 				*objectSlice0, *objectSlice1, *objectSlice2,
+				*objectSlice3, *objectSlice4, *objectSlice5,
 			}
 		}).
 		Return(nil)
+	patches := map[string]string{}
+	c.
+		On("Patch",
+			mock.Anything,
+			mock.AnythingOfType("*v1alpha1.ObjectSlice"),
+			mock.Anything,
+			mock.Anything).
+		Run(func(args mock.Arguments) {
+			obj := args.Get(1).(*corev1alpha1.ObjectSlice)
+			data, err := args.Get(2).(client.Patch).Data(obj)
+			require.NoError(t, err)
+			patches[obj.Name] = string(data)
+		}).
+		Return(nil)
 	c.
 		On("Delete",
 			mock.Anything,
@@ -303,9 +359,18 @@ func TestDeploymentReconciler_sliceGarbageCollection(t *testing.T) {
 	err := r.sliceGarbageCollection(ctx, deploy)
 	require.NoError(t, err)
 
+	assert.Equal(t, map[string]string{
+		"slice1-xxx": `{"metadata":{"annotations":{"slices.package-operator.run/unreferenced-since":null},` +
+			`"resourceVersion":""}}`,
+		"slice2-xxx": `{"metadata":{"annotations":{"slices.package-operator.run/unreferenced-since":` +
+			`"2024-06-03T12:00:00Z"},"resourceVersion":""}}`,
+	}, patches)
+
+	rv := "3"
 	c.AssertNumberOfCalls(t, "Delete", 1)
 	c.AssertCalled(
-		t, "Delete", mock.Anything, objectSlice2, mock.Anything)
+		t, "Delete", mock.Anything, objectSlice3,
+		[]client.DeleteOption{client.Preconditions{ResourceVersion: &rv}})
 }
 
 func Test_sliceCollisionError(t *testing.T) {
@@ -0,0 +1,72 @@
+package packagedeploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ErrRenderLimitExceeded is returned when a package renders more or larger objects than allowed.
+var ErrRenderLimitExceeded = errors.New("render limit exceeded")
+
+// RenderLimits guard the cluster against packages rendering excessive amounts of objects,
+// e.g. caused by a template looping over the wrong input.
+// Zero values disable the respective limit.
+type RenderLimits struct {
+	// Maximum number of objects rendered by a package.
+	MaxObjects int
+	// Maximum size of a single rendered object in bytes, as serialized to JSON.
+	MaxObjectSize int64
+	// Maximum size of all rendered objects of a package in bytes, as serialized to JSON.
+	MaxTotalSize int64
+}
+
+// Checks the rendered objects against the limits.
+func (l RenderLimits) Check(objects []unstructured.Unstructured) error {
+	if l.MaxObjects > 0 && len(objects) > l.MaxObjects {
+		return fmt.Errorf("%w: package renders %d objects, at most %d are allowed",
+			ErrRenderLimitExceeded, len(objects), l.MaxObjects)
+	}
+	if l.MaxObjectSize <= 0 && l.MaxTotalSize <= 0 {
+		return nil
+	}
+
+	var total int64
+	for i := range objects {
+		obj := &objects[i]
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("marshal %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		size := int64(len(b))
+		if l.MaxObjectSize > 0 && size > l.MaxObjectSize {
+			return fmt.Errorf("%w: %s %s has a size of %s, at most %s are allowed",
+				ErrRenderLimitExceeded, obj.GetKind(), obj.GetName(), byteSize(size), byteSize(l.MaxObjectSize))
+		}
+		total += size
+	}
+	if l.MaxTotalSize > 0 && total > l.MaxTotalSize {
+		return fmt.Errorf("%w: package renders objects with a total size of %s, at most %s are allowed",
+			ErrRenderLimitExceeded, byteSize(total), byteSize(l.MaxTotalSize))
+	}
+	return nil
+}
+
+func byteSize(b int64) string {
+	return resource.NewQuantity(b, resource.BinarySI).String()
+}
+
+// PackageDeployerOption configures a PackageDeployer.
+type PackageDeployerOption interface {
+	ConfigurePackageDeployer(l *PackageDeployer)
+}
+
+// WithRenderLimits limits the objects rendered by packages.
+type WithRenderLimits struct{ Limits RenderLimits }
+
+func (w WithRenderLimits) ConfigurePackageDeployer(l *PackageDeployer) {
+	l.renderLimits = w.Limits
+}
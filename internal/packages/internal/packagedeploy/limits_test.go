@@ -0,0 +1,70 @@
+package packagedeploy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRenderLimits_Check(t *testing.T) {
+	t.Parallel()
+
+	newObj := func(name, data string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": name},
+			"data":       map[string]any{"key": data},
+		}}
+	}
+	objects := []unstructured.Unstructured{
+		newObj("small", "x"),
+		newObj("large", strings.Repeat("x", 2048)),
+	}
+
+	tests := []struct {
+		name          string
+		limits        RenderLimits
+		expectedError string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:   "within limits",
+			limits: RenderLimits{MaxObjects: 2, MaxObjectSize: 4096, MaxTotalSize: 8192},
+		},
+		{
+			name:          "too many objects",
+			limits:        RenderLimits{MaxObjects: 1},
+			expectedError: "render limit exceeded: package renders 2 objects, at most 1 are allowed",
+		},
+		{
+			name:          "object too large",
+			limits:        RenderLimits{MaxObjectSize: 1024},
+			expectedError: "render limit exceeded: ConfigMap large has a size of",
+		},
+		{
+			name:          "total too large",
+			limits:        RenderLimits{MaxObjectSize: 4096, MaxTotalSize: 2048},
+			expectedError: "render limit exceeded: package renders objects with a total size of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.limits.Check(objects)
+			if len(test.expectedError) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrRenderLimitExceeded)
+			assert.Contains(t, err.Error(), test.expectedError)
+		})
+	}
+}
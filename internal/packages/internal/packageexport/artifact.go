@@ -0,0 +1,95 @@
+package packageexport
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// Exports the package as OCI artifact of type packagetypes.PackageArtifactType,
+// holding all package files in a single layer.
+// Artifacts are platform agnostic and handled better by artifact-first registries and signing tooling.
+// The artifact type is conveyed via the config media type for registries without OCI 1.1 support.
+func ToOCIArtifact(pkg *packagetypes.RawPackage) (containerregistrypkgv1.Image, error) {
+	layer, err := crane.Layer(pkg.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:     layer,
+		MediaType: packagetypes.PackageArtifactLayerMediaType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create package artifact: %w", err)
+	}
+
+	artifact = mutate.MediaType(artifact, types.OCIManifestSchema1)
+	artifact = mutate.ConfigMediaType(artifact, packagetypes.PackageArtifactType)
+
+	return artifact, nil
+}
+
+// Exports the given package as OCI artifact to a tar
+// in the OCI image layout format under the given tags.
+func ToOCIArtifactFile(dst string, tags []string, pkg *packagetypes.RawPackage) error {
+	artifact, err := ToOCIArtifact(pkg)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "pko-oci-layout-*")
+	if err != nil {
+		return fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	p, err := layout.Write(dir, empty.Index)
+	if err != nil {
+		return fmt.Errorf("create OCI layout: %w", err)
+	}
+	for _, tag := range tags {
+		if err := p.AppendImage(artifact, layout.WithAnnotations(map[string]string{
+			ociRefNameAnnotation: tag,
+		})); err != nil {
+			return fmt.Errorf("add %s to OCI layout: %w", tag, err)
+		}
+	}
+
+	if err := tarDirectory(dir, dst); err != nil {
+		return fmt.Errorf("dump to %s: %w", dst, err)
+	}
+
+	return nil
+}
+
+// Exports the given package as OCI artifact by pushing it to an OCI registry.
+func ToPushedOCIArtifact(
+	ctx context.Context, references []string, pkg *packagetypes.RawPackage, opts ...crane.Option,
+) error {
+	artifact, err := ToOCIArtifact(pkg)
+	if err != nil {
+		return err
+	}
+
+	opts = append(opts, crane.WithContext(ctx))
+	verboseLogger := logr.FromContextOrDiscard(ctx).V(1)
+	for _, ref := range references {
+		verboseLogger.Info("pushing package artifact", "reference", ref)
+		if err := crane.Push(artifact, ref, opts...); err != nil {
+			return fmt.Errorf("push: %w", err)
+		}
+	}
+
+	return nil
+}
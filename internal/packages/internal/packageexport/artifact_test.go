@@ -0,0 +1,71 @@
+package packageexport
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages/internal/packageimport"
+	"package-operator.run/internal/packages/internal/packagetypes"
+	"package-operator.run/internal/testutil"
+)
+
+func TestToOCIArtifact(t *testing.T) {
+	t.Parallel()
+
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": []byte("test: test")},
+	}
+	artifact, err := ToOCIArtifact(rawPkg)
+	require.NoError(t, err)
+
+	manifest, err := artifact.Manifest()
+	require.NoError(t, err)
+	assert.Equal(t, packagetypes.PackageArtifactType, string(manifest.Config.MediaType))
+	require.Len(t, manifest.Layers, 1)
+	assert.Equal(t, packagetypes.PackageArtifactLayerMediaType, string(manifest.Layers[0].MediaType))
+
+	// Artifacts are imported just like images.
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	imported, err := packageimport.FromOCI(ctx, artifact)
+	require.NoError(t, err)
+	assert.Equal(t, rawPkg.Files, imported.Files)
+}
+
+func TestToOCIArtifactFile(t *testing.T) {
+	t.Parallel()
+
+	dst := filepath.Join(t.TempDir(), "pkg.tar")
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": []byte("test: test")},
+	}
+	require.NoError(t, ToOCIArtifactFile(dst, []string{"chickens:oldest"}, rawPkg))
+
+	file, err := packageimport.FromOCIFile(dst)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, file.Close()) }()
+
+	assert.Equal(t, []string{"chickens:oldest"}, file.Tags)
+	require.NotNil(t, file.Image)
+	assert.Nil(t, file.Index)
+}
+
+func TestToPushedOCIArtifact(t *testing.T) { //nolint:paralleltest
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	reg := testutil.NewInMemoryRegistry()
+
+	ref := "chickens:oldest"
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": []byte("test: test")},
+	}
+	require.NoError(t, ToPushedOCIArtifact(ctx, []string{ref}, rawPkg, reg.CraneOpt))
+
+	imported, err := packageimport.FromRegistry(ctx, ref, reg.CraneOpt)
+	require.NoError(t, err)
+	assert.Equal(t, rawPkg.Files, imported.Files)
+}
@@ -0,0 +1,186 @@
+package packageexport
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// ErrNoPlatforms is returned when an image index is requested without any platform.
+var ErrNoPlatforms = errors.New("at least one platform is required")
+
+// ociRefNameAnnotation holds the tag of an image index inside an OCI image layout.
+const ociRefNameAnnotation = "org.opencontainers.image.ref.name"
+
+// Exports the package as OCI image index,
+// containing one image per given platform.
+// The package contents are architecture agnostic,
+// so all images share the same layer and only differ in their config.
+func ToOCIIndex(
+	pkg *packagetypes.RawPackage, platforms []containerregistrypkgv1.Platform,
+) (containerregistrypkgv1.ImageIndex, error) {
+	if len(platforms) == 0 {
+		return nil, ErrNoPlatforms
+	}
+
+	var index containerregistrypkgv1.ImageIndex = empty.Index
+	index = mutate.IndexMediaType(index, types.OCIImageIndex)
+
+	for _, platform := range platforms {
+		image, err := toOCIForPlatform(pkg, platform)
+		if err != nil {
+			return nil, fmt.Errorf("create image for %s: %w", platform.String(), err)
+		}
+
+		index = mutate.AppendManifests(index, mutate.IndexAddendum{
+			Add: image,
+			Descriptor: containerregistrypkgv1.Descriptor{
+				Platform: &platform,
+			},
+		})
+	}
+
+	return index, nil
+}
+
+// Exports the given package as multi-platform image index to a tar
+// in the OCI image layout format under the given tags.
+func ToOCIIndexFile(
+	dst string, tags []string, pkg *packagetypes.RawPackage,
+	platforms []containerregistrypkgv1.Platform,
+) error {
+	index, err := ToOCIIndex(pkg, platforms)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "pko-oci-layout-*")
+	if err != nil {
+		return fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	p, err := layout.Write(dir, empty.Index)
+	if err != nil {
+		return fmt.Errorf("create OCI layout: %w", err)
+	}
+	for _, tag := range tags {
+		if err := p.AppendIndex(index, layout.WithAnnotations(map[string]string{
+			ociRefNameAnnotation: tag,
+		})); err != nil {
+			return fmt.Errorf("add %s to OCI layout: %w", tag, err)
+		}
+	}
+
+	if err := tarDirectory(dir, dst); err != nil {
+		return fmt.Errorf("dump to %s: %w", dst, err)
+	}
+
+	return nil
+}
+
+// Exports the given package as multi-platform image index by pushing it to an OCI registry.
+func ToPushedOCIIndex(
+	ctx context.Context, references []string, pkg *packagetypes.RawPackage,
+	platforms []containerregistrypkgv1.Platform, opts ...crane.Option,
+) error {
+	index, err := ToOCIIndex(pkg, platforms)
+	if err != nil {
+		return err
+	}
+
+	return pushIndex(ctx, index, references, opts...)
+}
+
+func pushIndex(
+	ctx context.Context, index containerregistrypkgv1.ImageIndex,
+	references []string, opts ...crane.Option,
+) error {
+	opts = append(opts, crane.WithContext(ctx))
+	o := crane.GetOptions(opts...)
+
+	verboseLogger := logr.FromContextOrDiscard(ctx).V(1)
+	for _, ref := range references {
+		verboseLogger.Info("pushing image index", "reference", ref)
+
+		r, err := name.ParseReference(ref, o.Name...)
+		if err != nil {
+			return fmt.Errorf("parsing reference %s: %w", ref, err)
+		}
+		if err := remote.WriteIndex(r, index, o.Remote...); err != nil {
+			return fmt.Errorf("push: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Packs all files within the src directory into a tar file at dst.
+func tarDirectory(src, dst string) (err error) {
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := f.Close(); err == nil {
+			err = cErr
+		}
+	}()
+
+	tw := tar.NewWriter(f)
+	if err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
@@ -0,0 +1,97 @@
+package packageexport
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages/internal/packageimport"
+	"package-operator.run/internal/packages/internal/packagetypes"
+	"package-operator.run/internal/testutil"
+)
+
+var testPlatforms = []containerregistrypkgv1.Platform{
+	{OS: "linux", Architecture: "amd64"},
+	{OS: "linux", Architecture: "arm64"},
+}
+
+func TestToOCIIndex(t *testing.T) {
+	t.Parallel()
+
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": {5, 6}},
+	}
+
+	index, err := ToOCIIndex(rawPkg, testPlatforms)
+	require.NoError(t, err)
+
+	manifest, err := index.IndexManifest()
+	require.NoError(t, err)
+	require.Len(t, manifest.Manifests, 2)
+
+	for i, desc := range manifest.Manifests {
+		require.NotNil(t, desc.Platform)
+		assert.Equal(t, testPlatforms[i], *desc.Platform)
+
+		image, err := index.Image(desc.Digest)
+		require.NoError(t, err)
+		cfg, err := image.ConfigFile()
+		require.NoError(t, err)
+		assert.Equal(t, testPlatforms[i].Architecture, cfg.Architecture)
+		assert.Equal(t, testPlatforms[i].OS, cfg.OS)
+	}
+}
+
+func TestToOCIIndex_NoPlatforms(t *testing.T) {
+	t.Parallel()
+
+	_, err := ToOCIIndex(&packagetypes.RawPackage{}, nil)
+	require.ErrorIs(t, err, ErrNoPlatforms)
+}
+
+func TestToOCIIndexFile(t *testing.T) {
+	t.Parallel()
+
+	dst := filepath.Join(t.TempDir(), "pkg.tar")
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": {5, 6}},
+	}
+	require.NoError(t, ToOCIIndexFile(dst, []string{"chickens:oldest"}, rawPkg, testPlatforms))
+
+	file, err := packageimport.FromOCIFile(dst)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, file.Close()) }()
+
+	assert.Equal(t, []string{"chickens:oldest"}, file.Tags)
+	require.NotNil(t, file.Index)
+}
+
+func TestToPushedOCIIndex(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+	reg := testutil.NewInMemoryRegistry()
+
+	ref := "chickens:oldest"
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": {5, 6}},
+	}
+	require.NoError(t, ToPushedOCIIndex(ctx, []string{ref}, rawPkg, testPlatforms, reg.CraneOpt))
+
+	r, err := name.ParseReference(ref)
+	require.NoError(t, err)
+	index, err := remote.Index(r, remote.WithTransport(reg.RoundTripper))
+	require.NoError(t, err)
+	manifest, err := index.IndexManifest()
+	require.NoError(t, err)
+	assert.Len(t, manifest.Manifests, 2)
+
+	// Platform aware pulls resolve the image from the index.
+	_, err = crane.Pull(ref, reg.CraneOpt, crane.WithPlatform(&testPlatforms[1]))
+	require.NoError(t, err)
+}
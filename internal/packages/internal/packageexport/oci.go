@@ -14,14 +14,24 @@ import (
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
 
+// DefaultPlatform is the platform of images created by ToOCI.
+// Hardcoded to linux/amd64 or kubernetes will refuse to pull the image on
+// our target architecture. We will drop this after refactoring our in-cluster
+// package loading process to make it architecture agnostic.
+var DefaultPlatform = containerregistrypkgv1.Platform{OS: "linux", Architecture: "amd64"}
+
 // Exports the package as OCI (Open Container Image).
 func ToOCI(pkg *packagetypes.RawPackage) (containerregistrypkgv1.Image, error) {
-	// Hardcoded to linux/amd64 or kubernetes will refuse to pull the image on
-	// our target architecture. We will drop this after refactoring our in-cluster
-	// package loading process to make it architecture agnostic.
+	return toOCIForPlatform(pkg, DefaultPlatform)
+}
+
+func toOCIForPlatform(
+	pkg *packagetypes.RawPackage, platform containerregistrypkgv1.Platform,
+) (containerregistrypkgv1.Image, error) {
 	configFile := &containerregistrypkgv1.ConfigFile{
-		Architecture: "amd64",
-		OS:           "linux",
+		Architecture: platform.Architecture,
+		OS:           platform.OS,
+		Variant:      platform.Variant,
 		Config:       containerregistrypkgv1.Config{},
 		RootFS:       containerregistrypkgv1.RootFS{Type: "layers"},
 	}
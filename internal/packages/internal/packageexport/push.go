@@ -0,0 +1,59 @@
+package packageexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+
+	"package-operator.run/internal/packages/internal/packageimport"
+)
+
+// ErrNoReferences is returned when pushing a file that holds no tags, without specifying any.
+var ErrNoReferences = errors.New("no references to push to")
+
+// PushOCIFile pushes a package image previously exported via ToOCIFile, ToOCIIndexFile or ToOCIArtifactFile
+// to the given references.
+// If no references are given, the tags stored in the file are used.
+// Returns the references pushed to.
+func PushOCIFile(
+	ctx context.Context, src string, references []string, opts ...crane.Option,
+) (_ []string, err error) {
+	file, err := packageimport.FromOCIFile(src)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cErr := file.Close(); err == nil {
+			err = cErr
+		}
+	}()
+
+	if len(references) == 0 {
+		references = file.Tags
+	}
+	if len(references) == 0 {
+		return nil, ErrNoReferences
+	}
+
+	if file.Index != nil {
+		if err := pushIndex(ctx, file.Index, references, opts...); err != nil {
+			return nil, err
+		}
+
+		return references, nil
+	}
+
+	opts = append(opts, crane.WithContext(ctx))
+	verboseLogger := logr.FromContextOrDiscard(ctx).V(1)
+	for _, ref := range references {
+		verboseLogger.Info("pushing image", "reference", ref)
+		if err := crane.Push(file.Image, ref, opts...); err != nil {
+			return nil, fmt.Errorf("push: %w", err)
+		}
+	}
+
+	return references, nil
+}
@@ -0,0 +1,72 @@
+package packageexport
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+	"package-operator.run/internal/testutil"
+)
+
+func TestPushOCIFile(t *testing.T) { //nolint:paralleltest
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": {5, 6}},
+	}
+
+	tests := []struct {
+		name   string
+		export func(dst string, tags []string) error
+	}{
+		{
+			name: "image",
+			export: func(dst string, tags []string) error {
+				return ToOCIFile(dst, tags, rawPkg)
+			},
+		},
+		{
+			name: "index",
+			export: func(dst string, tags []string) error {
+				return ToOCIIndexFile(dst, tags, rawPkg, testPlatforms)
+			},
+		},
+	}
+
+	for _, test := range tests { //nolint:paralleltest
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			reg := testutil.NewInMemoryRegistry()
+
+			src := filepath.Join(t.TempDir(), "pkg.tar")
+			require.NoError(t, test.export(src, []string{"chickens:oldest"}))
+
+			// Tags stored in the file.
+			refs, err := PushOCIFile(ctx, src, nil, reg.CraneOpt)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"chickens:oldest"}, refs)
+			_, err = crane.Pull("chickens:oldest", reg.CraneOpt)
+			require.NoError(t, err)
+
+			// Explicit references.
+			refs, err = PushOCIFile(ctx, src, []string{"chickens:newest"}, reg.CraneOpt)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"chickens:newest"}, refs)
+			_, err = crane.Pull("chickens:newest", reg.CraneOpt)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPushOCIFile_NoReferences(t *testing.T) {
+	t.Parallel()
+
+	src := filepath.Join(t.TempDir(), "pkg.tar")
+	require.NoError(t, ToOCIIndexFile(src, nil, &packagetypes.RawPackage{}, testPlatforms))
+
+	_, err := PushOCIFile(context.Background(), src, nil)
+	require.Error(t, err)
+}
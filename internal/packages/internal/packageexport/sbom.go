@@ -0,0 +1,397 @@
+package packageexport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// ErrUnknownSBOMFormat is returned for SBOM formats other than spdx and cyclonedx.
+var ErrUnknownSBOMFormat = errors.New("unknown SBOM format")
+
+const sbomToolName = "kubectl-package"
+
+// SBOM generates a software bill of materials for the given package,
+// listing all package files and all container images declared in the PackageManifest.
+// Image digests are taken from the PackageManifestLock, if present.
+func SBOM(
+	pkg *packagetypes.Package, format packagetypes.SBOMFormat, created time.Time,
+) ([]byte, error) {
+	content := newSBOMContent(pkg)
+
+	switch format {
+	case packagetypes.SBOMFormatSPDX:
+		return json.MarshalIndent(content.spdx(created), "", "  ")
+	case packagetypes.SBOMFormatCycloneDX:
+		return json.MarshalIndent(content.cycloneDX(created), "", "  ")
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownSBOMFormat, format)
+}
+
+// AttachSBOM pushes the SBOM as OCI referrer of the image or image index behind each reference.
+func AttachSBOM(
+	ctx context.Context, references []string, sbom []byte,
+	format packagetypes.SBOMFormat, opts ...crane.Option,
+) error {
+	artifactType := format.ArtifactType()
+	if artifactType == "" {
+		return fmt.Errorf("%w: %q", ErrUnknownSBOMFormat, format)
+	}
+
+	opts = append(opts, crane.WithContext(ctx))
+	o := crane.GetOptions(opts...)
+
+	verboseLogger := logr.FromContextOrDiscard(ctx).V(1)
+	for _, ref := range references {
+		r, err := name.ParseReference(ref, o.Name...)
+		if err != nil {
+			return fmt.Errorf("parsing reference %s: %w", ref, err)
+		}
+
+		subject, err := remote.Head(r, o.Remote...)
+		if err != nil {
+			return fmt.Errorf("resolving %s: %w", ref, err)
+		}
+
+		artifact, err := sbomArtifact(sbom, artifactType, *subject)
+		if err != nil {
+			return err
+		}
+		digest, err := artifact.Digest()
+		if err != nil {
+			return err
+		}
+
+		verboseLogger.Info("attaching SBOM", "reference", ref, "digest", digest.String())
+		if err := remote.Write(r.Context().Digest(digest.String()), artifact, o.Remote...); err != nil {
+			return fmt.Errorf("push SBOM: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Builds an artifact manifest with the SBOM as only layer, referring to subject.
+// The artifact type is conveyed via the config media type for registries without OCI 1.1 support.
+func sbomArtifact(
+	sbom []byte, artifactType string, subject containerregistrypkgv1.Descriptor,
+) (containerregistrypkgv1.Image, error) {
+	image, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: static.NewLayer(sbom, types.MediaType(artifactType)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create SBOM artifact: %w", err)
+	}
+
+	image = mutate.MediaType(image, types.OCIManifestSchema1)
+	image = mutate.ConfigMediaType(image, types.MediaType(artifactType))
+
+	withSubject, ok := mutate.Subject(image, containerregistrypkgv1.Descriptor{
+		MediaType: subject.MediaType,
+		Size:      subject.Size,
+		Digest:    subject.Digest,
+	}).(containerregistrypkgv1.Image)
+	if !ok {
+		return nil, fmt.Errorf("create SBOM artifact: unexpected type %T", withSubject) //nolint:goerr113
+	}
+
+	return withSubject, nil
+}
+
+type sbomContent struct {
+	name   string
+	files  []sbomFile
+	images []sbomImage
+}
+
+type sbomFile struct {
+	path   string
+	sha256 string
+}
+
+type sbomImage struct {
+	name  string
+	image string
+	// Hex encoded sha256 digest, may be empty.
+	digest string
+}
+
+func newSBOMContent(pkg *packagetypes.Package) sbomContent {
+	content := sbomContent{name: pkg.Manifest.Name}
+
+	paths := make([]string, 0, len(pkg.Files))
+	for path := range pkg.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		sum := sha256.Sum256(pkg.Files[path])
+		content.files = append(content.files, sbomFile{path: path, sha256: hex.EncodeToString(sum[:])})
+	}
+
+	digests := map[string]string{}
+	if pkg.ManifestLock != nil {
+		for _, img := range pkg.ManifestLock.Spec.Images {
+			digests[img.Name] = strings.TrimPrefix(img.Digest, "sha256:")
+		}
+	}
+	for _, img := range pkg.Manifest.Spec.Images {
+		content.images = append(content.images, sbomImage{
+			name:   img.Name,
+			image:  img.Image,
+			digest: digests[img.Name],
+		})
+	}
+
+	return content
+}
+
+// Package URL of a container image, see https://github.com/package-url/purl-spec.
+func (i sbomImage) purl() string {
+	if i.digest == "" {
+		return ""
+	}
+
+	ref, err := name.ParseReference(i.image)
+	if err != nil {
+		return ""
+	}
+
+	repo := ref.Context()
+	parts := strings.Split(repo.RepositoryStr(), "/")
+
+	return fmt.Sprintf("pkg:oci/%s@sha256%%3A%s?repository_url=%s",
+		parts[len(parts)-1], i.digest, repo.Name())
+}
+
+// SPDX 2.3 JSON, see https://spdx.github.io/spdx-spec/v2.3/.
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Files             []spdxFile         `json:"files,omitempty"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxFile struct {
+	SPDXID    string         `json:"SPDXID"`
+	FileName  string         `json:"fileName"`
+	Checksums []spdxChecksum `json:"checksums"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func (c sbomContent) spdx(created time.Time) spdxDocument {
+	const pkgID = "SPDXRef-Package"
+
+	// The namespace has to be unique per document, so it includes the hash of all contents.
+	h := sha256.New()
+	for _, f := range c.files {
+		h.Write([]byte(f.path + f.sha256))
+	}
+	for _, img := range c.images {
+		h.Write([]byte(img.image + img.digest))
+	}
+
+	doc := spdxDocument{
+		SPDXVersion: "SPDX-2.3",
+		DataLicense: "CC0-1.0",
+		SPDXID:      "SPDXRef-DOCUMENT",
+		Name:        c.name,
+		DocumentNamespace: fmt.Sprintf(
+			"https://package-operator.run/spdx/%s-%s", c.name, hex.EncodeToString(h.Sum(nil))),
+		CreationInfo: spdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + sbomToolName},
+		},
+		Packages: []spdxPackage{{
+			SPDXID:           pkgID,
+			Name:             c.name,
+			DownloadLocation: "NOASSERTION",
+		}},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: pkgID,
+		}},
+	}
+
+	for i, f := range c.files {
+		id := fmt.Sprintf("SPDXRef-File-%d", i)
+		doc.Files = append(doc.Files, spdxFile{
+			SPDXID:    id,
+			FileName:  "./" + f.path,
+			Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: f.sha256}},
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID: pkgID, RelationshipType: "CONTAINS", RelatedSPDXElement: id,
+		})
+	}
+
+	for i, img := range c.images {
+		id := fmt.Sprintf("SPDXRef-Image-%d", i)
+		p := spdxPackage{
+			SPDXID:           id,
+			Name:             img.name,
+			DownloadLocation: img.image,
+		}
+		if img.digest != "" {
+			p.Checksums = []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: img.digest}}
+		}
+		if purl := img.purl(); purl != "" {
+			p.ExternalRefs = []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  purl,
+			}}
+		}
+		doc.Packages = append(doc.Packages, p)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID: pkgID, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: id,
+		})
+	}
+
+	return doc
+}
+
+// CycloneDX 1.5 JSON, see https://cyclonedx.org/docs/1.5/json/.
+type cycloneDXDocument struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDXMetadata     `json:"metadata"`
+	Components   []cycloneDXComponent  `json:"components,omitempty"`
+	Dependencies []cycloneDXDependency `json:"dependencies,omitempty"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     cycloneDXTools     `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	Type    string          `json:"type"`
+	BOMRef  string          `json:"bom-ref,omitempty"`
+	Name    string          `json:"name"`
+	Version string          `json:"version,omitempty"`
+	PURL    string          `json:"purl,omitempty"`
+	Hashes  []cycloneDXHash `json:"hashes,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+func (c sbomContent) cycloneDX(created time.Time) cycloneDXDocument {
+	const pkgRef = "package"
+
+	doc := cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: created.UTC().Format(time.RFC3339),
+			Tools: cycloneDXTools{Components: []cycloneDXComponent{{
+				Type: "application",
+				Name: sbomToolName,
+			}}},
+			Component: cycloneDXComponent{
+				Type:   "application",
+				BOMRef: pkgRef,
+				Name:   c.name,
+			},
+		},
+	}
+
+	for _, f := range c.files {
+		doc.Components = append(doc.Components, cycloneDXComponent{
+			Type:   "file",
+			BOMRef: "file:" + f.path,
+			Name:   f.path,
+			Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: f.sha256}},
+		})
+	}
+
+	dependency := cycloneDXDependency{Ref: pkgRef}
+	for _, img := range c.images {
+		comp := cycloneDXComponent{
+			Type:   "container",
+			BOMRef: "image:" + img.name,
+			Name:   img.image,
+			PURL:   img.purl(),
+		}
+		if img.digest != "" {
+			comp.Version = "sha256:" + img.digest
+			comp.Hashes = []cycloneDXHash{{Alg: "SHA-256", Content: img.digest}}
+		}
+		doc.Components = append(doc.Components, comp)
+		dependency.DependsOn = append(dependency.DependsOn, comp.BOMRef)
+	}
+	doc.Dependencies = []cycloneDXDependency{dependency}
+
+	return doc
+}
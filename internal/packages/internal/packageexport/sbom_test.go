@@ -0,0 +1,112 @@
+package packageexport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packageimport"
+	"package-operator.run/internal/packages/internal/packagetypes"
+	"package-operator.run/internal/testutil"
+)
+
+func newSBOMTestPackage() *packagetypes.Package {
+	pkg := &packagetypes.Package{
+		Manifest: &manifests.PackageManifest{},
+		ManifestLock: &manifests.PackageManifestLock{
+			Spec: manifests.PackageManifestLockSpec{
+				Images: []manifests.PackageManifestLockImage{{
+					Name:   "stub",
+					Image:  "quay.io/package-operator/test-stub:v1.0.0",
+					Digest: "sha256:1234",
+				}},
+			},
+		},
+		Files: packagetypes.Files{
+			"manifest.yaml":         []byte("manifest"),
+			"deploy/stub.yaml":      []byte("stub"),
+			"manifest.lock.yaml":    []byte("lock"),
+			"deploy/other.yaml.tpl": []byte("other"),
+		},
+	}
+	pkg.Manifest.Name = "test-stub"
+	pkg.Manifest.Spec.Images = []manifests.PackageManifestImage{
+		{Name: "stub", Image: "quay.io/package-operator/test-stub:v1.0.0"},
+		{Name: "unlocked", Image: "quay.io/package-operator/unlocked:v1.0.0"},
+	}
+
+	return pkg
+}
+
+func TestSBOM_SPDX(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	data, err := SBOM(newSBOMTestPackage(), packagetypes.SBOMFormatSPDX, created)
+	require.NoError(t, err)
+
+	var doc spdxDocument
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	assert.Equal(t, "2024-01-01T00:00:00Z", doc.CreationInfo.Created)
+	assert.Len(t, doc.Files, 4)
+	assert.Equal(t, "./deploy/other.yaml.tpl", doc.Files[0].FileName)
+	require.Len(t, doc.Packages, 3)
+	assert.Equal(t, "test-stub", doc.Packages[0].Name)
+	assert.Equal(t, []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: "1234"}}, doc.Packages[1].Checksums)
+	assert.Equal(t,
+		"pkg:oci/test-stub@sha256%3A1234?repository_url=quay.io/package-operator/test-stub",
+		doc.Packages[1].ExternalRefs[0].ReferenceLocator)
+	assert.Empty(t, doc.Packages[2].Checksums)
+	// DESCRIBES + 4x CONTAINS + 2x DEPENDS_ON.
+	assert.Len(t, doc.Relationships, 7)
+}
+
+func TestSBOM_CycloneDX(t *testing.T) {
+	t.Parallel()
+
+	data, err := SBOM(newSBOMTestPackage(), packagetypes.SBOMFormatCycloneDX, time.Now())
+	require.NoError(t, err)
+
+	var doc cycloneDXDocument
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	assert.Equal(t, "CycloneDX", doc.BOMFormat)
+	assert.Equal(t, "test-stub", doc.Metadata.Component.Name)
+	assert.Len(t, doc.Components, 6)
+	require.Len(t, doc.Dependencies, 1)
+	assert.Equal(t, []string{"image:stub", "image:unlocked"}, doc.Dependencies[0].DependsOn)
+}
+
+func TestSBOM_UnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := SBOM(newSBOMTestPackage(), "nope", time.Now())
+	require.ErrorIs(t, err, ErrUnknownSBOMFormat)
+}
+
+func TestAttachSBOM(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+	reg := testutil.NewInMemoryRegistry()
+
+	ref := "chickens:oldest"
+	rawPkg := &packagetypes.RawPackage{
+		Files: map[string][]byte{"manifest.yaml": {5, 6}},
+	}
+	require.NoError(t, ToPushedOCI(ctx, []string{ref}, rawPkg, reg.CraneOpt))
+
+	sbom, err := SBOM(newSBOMTestPackage(), packagetypes.SBOMFormatSPDX, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, AttachSBOM(ctx, []string{ref}, sbom, packagetypes.SBOMFormatSPDX, reg.CraneOpt))
+
+	sboms, err := packageimport.FindSBOMs(ctx, ref, reg.CraneOpt)
+	require.NoError(t, err)
+	require.Len(t, sboms, 1)
+	assert.Equal(t, packagetypes.SBOMFormatSPDX, sboms[0].Format)
+}
@@ -0,0 +1,262 @@
+package packageimport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// ErrImageNotFound is returned when a pull backend does not hold the requested image.
+var ErrImageNotFound = errors.New("image not found")
+
+// DefaultContainerdContentStore is the location of the containerd content store on nodes.
+const DefaultContainerdContentStore = "/var/lib/containerd/io.containerd.content.v1.content"
+
+// PullBackend fetches the contents of package images.
+type PullBackend interface {
+	Pull(ctx context.Context, ref string, opts ...crane.Option) (*packagetypes.RawPackage, error)
+}
+
+var (
+	_ PullBackend = RegistryPullBackend{}
+	_ PullBackend = (*ContainerdPullBackend)(nil)
+	_ PullBackend = FilePullBackend{}
+)
+
+// RegistryPullBackend pulls images directly from their container image registry.
+type RegistryPullBackend struct{}
+
+func (RegistryPullBackend) Pull(
+	ctx context.Context, ref string, opts ...crane.Option,
+) (*packagetypes.RawPackage, error) {
+	return FromRegistry(ctx, ref, opts...)
+}
+
+// ContainerdPullBackend reads images from the containerd content store of the node,
+// reusing images already downloaded by the kubelet to save bandwidth.
+// Tags are resolved to digests at the registry, images missing in the content store
+// are pulled from the registry.
+type ContainerdPullBackend struct {
+	contentStore string
+
+	resolveDigest resolveDigestFn
+	pullImage     pullImageFn
+}
+
+// NewContainerdPullBackend returns a ContainerdPullBackend reading from the given content store directory,
+// which has to be mounted from the node.
+func NewContainerdPullBackend(contentStore string) *ContainerdPullBackend {
+	return &ContainerdPullBackend{
+		contentStore:  contentStore,
+		resolveDigest: crane.Digest,
+		pullImage:     FromRegistry,
+	}
+}
+
+func (b *ContainerdPullBackend) Pull(
+	ctx context.Context, ref string, opts ...crane.Option,
+) (*packagetypes.RawPackage, error) {
+	parsed, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := parsed.Identifier()
+	if _, ok := parsed.(name.Tag); ok {
+		digest, err = b.resolveDigest(ref, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("resolving digest: %w", err)
+		}
+	}
+	hash, err := containerregistrypkgv1.NewHash(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := blobStore(b.contentStore).image(hash)
+	if errors.Is(err, os.ErrNotExist) {
+		logr.FromContextOrDiscard(ctx).V(1).Info(
+			"image not in containerd content store, pulling from registry", "image", ref)
+		return b.pullImage(ctx, ref, opts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading from containerd content store: %w", err)
+	}
+	return FromOCI(ctx, img)
+}
+
+// FilePullBackend reads images from an OCI image layout directory,
+// e.g. populated via "skopeo copy docker://<image> oci:<dir>:<image>".
+// Images are looked up by digest or by their full reference
+// in the org.opencontainers.image.ref.name annotation.
+type FilePullBackend struct {
+	Dir string
+}
+
+func (b FilePullBackend) Pull(
+	ctx context.Context, ref string, _ ...crane.Option,
+) (*packagetypes.RawPackage, error) {
+	parsed, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := layout.ImageIndexFromPath(b.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading OCI layout: %w", err)
+	}
+	rootManifest, err := root.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("reading OCI layout: %w", err)
+	}
+
+	for _, desc := range rootManifest.Manifests {
+		if !refMatches(parsed, ref, desc) {
+			continue
+		}
+
+		var img containerregistrypkgv1.Image
+		if desc.MediaType.IsIndex() {
+			index, err := root.ImageIndex(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+			img, err = (&OCIFile{Index: index}).PackageImage()
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			img, err = root.Image(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return FromOCI(ctx, img)
+	}
+	return nil, fmt.Errorf("%w: %s in %s", ErrImageNotFound, ref, b.Dir)
+}
+
+func refMatches(parsed name.Reference, ref string, desc containerregistrypkgv1.Descriptor) bool {
+	if _, ok := parsed.(name.Digest); ok {
+		return desc.Digest.String() == parsed.Identifier()
+	}
+	refName := desc.Annotations[ociRefNameAnnotation]
+	return refName == ref || refName == parsed.Name()
+}
+
+// blobStore is a directory holding blobs by digest under blobs/<algorithm>/<hex>,
+// like the containerd content store.
+type blobStore string
+
+func (s blobStore) path(h containerregistrypkgv1.Hash) string {
+	return filepath.Join(string(s), "blobs", h.Algorithm, h.Hex)
+}
+
+// Returns the image with the given manifest digest.
+// Image indexes resolve to their first image, as package contents are identical across platforms.
+// Returns an error wrapping os.ErrNotExist, when any blob of the image is missing.
+func (s blobStore) image(h containerregistrypkgv1.Hash) (containerregistrypkgv1.Image, error) {
+	raw, err := os.ReadFile(s.path(h))
+	if err != nil {
+		return nil, err
+	}
+
+	var mediaType struct {
+		MediaType types.MediaType `json:"mediaType"`
+		Manifests []any           `json:"manifests"`
+	}
+	if err := json.Unmarshal(raw, &mediaType); err != nil {
+		return nil, fmt.Errorf("decoding manifest %s: %w", h, err)
+	}
+	if mediaType.MediaType.IsIndex() || len(mediaType.Manifests) > 0 {
+		index, err := containerregistrypkgv1.ParseIndexManifest(bytes.NewReader(raw))
+		if err != nil {
+			return nil, err
+		}
+		if len(index.Manifests) == 0 {
+			return nil, fmt.Errorf("%w: image index is empty", ErrInvalidImageFile)
+		}
+		return s.image(index.Manifests[0].Digest)
+	}
+
+	manifest, err := containerregistrypkgv1.ParseManifest(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	// Content stores may garbage collect layers of unpacked images.
+	for _, layer := range manifest.Layers {
+		if _, err := os.Stat(s.path(layer.Digest)); err != nil {
+			return nil, err
+		}
+	}
+	return partial.CompressedToImage(&blobStoreImage{store: s, rawManifest: raw, manifest: manifest})
+}
+
+type blobStoreImage struct {
+	store       blobStore
+	rawManifest []byte
+	manifest    *containerregistrypkgv1.Manifest
+}
+
+func (i *blobStoreImage) RawManifest() ([]byte, error) {
+	return i.rawManifest, nil
+}
+
+func (i *blobStoreImage) MediaType() (types.MediaType, error) {
+	if len(i.manifest.MediaType) == 0 {
+		return types.OCIManifestSchema1, nil
+	}
+	return i.manifest.MediaType, nil
+}
+
+func (i *blobStoreImage) RawConfigFile() ([]byte, error) {
+	return os.ReadFile(i.store.path(i.manifest.Config.Digest))
+}
+
+func (i *blobStoreImage) LayerByDigest(h containerregistrypkgv1.Hash) (partial.CompressedLayer, error) {
+	if i.manifest.Config.Digest == h {
+		return &blobStoreLayer{store: i.store, desc: i.manifest.Config}, nil
+	}
+	for _, desc := range i.manifest.Layers {
+		if desc.Digest == h {
+			return &blobStoreLayer{store: i.store, desc: desc}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: layer %s", ErrImageNotFound, h)
+}
+
+type blobStoreLayer struct {
+	store blobStore
+	desc  containerregistrypkgv1.Descriptor
+}
+
+func (l *blobStoreLayer) Digest() (containerregistrypkgv1.Hash, error) {
+	return l.desc.Digest, nil
+}
+
+func (l *blobStoreLayer) Compressed() (io.ReadCloser, error) {
+	return os.Open(l.store.path(l.desc.Digest))
+}
+
+func (l *blobStoreLayer) Size() (int64, error) {
+	return l.desc.Size, nil
+}
+
+func (l *blobStoreLayer) MediaType() (types.MediaType, error) {
+	return l.desc.MediaType, nil
+}
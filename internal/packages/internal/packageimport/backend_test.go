@@ -0,0 +1,83 @@
+package packageimport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+	"package-operator.run/internal/testutil"
+)
+
+const backendTestImage = "quay.io/package-operator/test:v1"
+
+// Writes a package image into an OCI image layout and returns the directory and image digest.
+func writeBackendTestLayout(t *testing.T) (dir, digest string) {
+	t.Helper()
+
+	image := testutil.BuildImage(t, map[string][]byte{
+		packagetypes.OCIPathPrefix + "/file.yaml": []byte(`test: test`),
+	})
+	hash, err := image.Digest()
+	require.NoError(t, err)
+
+	dir = t.TempDir()
+	p, err := layout.Write(dir, empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, p.AppendImage(image, layout.WithAnnotations(map[string]string{
+		ociRefNameAnnotation: backendTestImage,
+	})))
+	return dir, hash.String()
+}
+
+func TestFilePullBackend(t *testing.T) {
+	t.Parallel()
+
+	dir, digest := writeBackendTestLayout(t)
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	b := FilePullBackend{Dir: dir}
+
+	for _, ref := range []string{backendTestImage, "quay.io/package-operator/test@" + digest} {
+		rawPkg, err := b.Pull(ctx, ref)
+		require.NoError(t, err, ref)
+		assert.Equal(t, packagetypes.Files{"file.yaml": []byte(`test: test`)}, rawPkg.Files)
+	}
+
+	_, err := b.Pull(ctx, "quay.io/package-operator/test:v2")
+	require.ErrorIs(t, err, ErrImageNotFound)
+}
+
+func TestContainerdPullBackend(t *testing.T) {
+	t.Parallel()
+
+	dir, digest := writeBackendTestLayout(t)
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+
+	var registryPulls []string
+	b := NewContainerdPullBackend(dir)
+	b.resolveDigest = func(string, ...crane.Option) (string, error) { return digest, nil }
+	b.pullImage = func(_ context.Context, ref string, _ ...crane.Option) (*packagetypes.RawPackage, error) {
+		registryPulls = append(registryPulls, ref)
+		return &packagetypes.RawPackage{}, nil
+	}
+
+	// Tags are resolved via the registry, contents are read from the content store.
+	rawPkg, err := b.Pull(ctx, backendTestImage)
+	require.NoError(t, err)
+	assert.Equal(t, packagetypes.Files{"file.yaml": []byte(`test: test`)}, rawPkg.Files)
+	assert.Empty(t, registryPulls)
+
+	// Images missing in the content store are pulled from the registry.
+	missing := "quay.io/package-operator/test@sha256:" +
+		"0000000000000000000000000000000000000000000000000000000000000000"
+	_, err = b.Pull(ctx, missing)
+	require.NoError(t, err)
+	assert.Equal(t, []string{missing}, registryPulls)
+}
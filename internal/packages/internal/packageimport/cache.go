@@ -0,0 +1,302 @@
+package packageimport
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"k8s.io/utils/clock"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+const imageCacheFileSuffix = ".tar"
+
+// ImageCacheRecorder records image cache metrics.
+type ImageCacheRecorder interface {
+	RecordImageCacheRequest(hit bool)
+	RecordImageCacheSize(bytes int64, entries int)
+}
+
+// ImageCache stores the contents of pulled package images on disk by digest,
+// so many packages referencing the same image digest only pull it once.
+// The least recently used entries are evicted when the cache grows beyond its size limit.
+type ImageCache struct {
+	dir      string
+	maxSize  int64
+	recorder ImageCacheRecorder
+	clock    clock.PassiveClock
+
+	lock    sync.Mutex
+	entries map[string]*imageCacheEntry
+	size    int64
+}
+
+type imageCacheEntry struct {
+	file     string
+	size     int64
+	lastUsed time.Time
+}
+
+// NewImageCache creates a cache storing up to maxSize bytes in the given directory.
+// Entries left in the directory by a previous process are reused.
+// The recorder is optional.
+func NewImageCache(dir string, maxSize int64, recorder ImageCacheRecorder) (*ImageCache, error) {
+	c := &ImageCache{
+		dir:      dir,
+		maxSize:  maxSize,
+		recorder: recorder,
+		clock:    clock.RealClock{},
+		entries:  map[string]*imageCacheEntry{},
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating image cache directory: %w", err)
+	}
+
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading image cache directory: %w", err)
+	}
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+		file := filepath.Join(dir, dirEntry.Name())
+		digest, ok := imageCacheDigest(dirEntry.Name())
+		if !ok {
+			// Leftovers of interrupted writes.
+			if err := os.Remove(file); err != nil {
+				return nil, fmt.Errorf("removing stale image cache file: %w", err)
+			}
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("reading image cache entry: %w", err)
+		}
+		c.entries[digest] = &imageCacheEntry{
+			file: file, size: info.Size(), lastUsed: info.ModTime(),
+		}
+		c.size += info.Size()
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.evict(""); err != nil {
+		return nil, err
+	}
+	c.recordSize()
+	return c, nil
+}
+
+// Get returns the package contents of the image with the given digest, if cached.
+func (c *ImageCache) Get(digest string) (*packagetypes.RawPackage, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[digest]
+	if !ok {
+		c.recordRequest(false)
+		return nil, false
+	}
+
+	rawPkg, err := readImageCacheFile(entry.file)
+	if err != nil {
+		// Broken entries are removed and pulled again.
+		_ = c.remove(digest)
+		c.recordRequest(false)
+		return nil, false
+	}
+
+	entry.lastUsed = c.clock.Now()
+	// Persist the last use so LRU order survives restarts, failing is not critical.
+	_ = os.Chtimes(entry.file, entry.lastUsed, entry.lastUsed)
+	c.recordRequest(true)
+	return rawPkg, true
+}
+
+// Put stores the package contents of the image with the given digest.
+// Packages larger than the whole cache are not stored.
+func (c *ImageCache) Put(digest string, rawPkg *packagetypes.RawPackage) error {
+	file, err := c.file(digest)
+	if err != nil {
+		return err
+	}
+	data, err := encodeImageCacheFile(rawPkg)
+	if err != nil {
+		return err
+	}
+	size := int64(len(data))
+	if size > c.maxSize {
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.entries[digest]; ok {
+		return nil
+	}
+
+	// Write to a temporary file first, so readers never see partial entries.
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating image cache file: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if cErr := tmp.Close(); err == nil {
+		err = cErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), file)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("writing image cache file: %w", err)
+	}
+
+	c.entries[digest] = &imageCacheEntry{file: file, size: size, lastUsed: c.clock.Now()}
+	c.size += size
+	if err := c.evict(digest); err != nil {
+		return err
+	}
+	c.recordSize()
+	return nil
+}
+
+// Evicts the least recently used entries, except keep, until the cache fits into maxSize.
+// Must be called with the lock held.
+func (c *ImageCache) evict(keep string) error {
+	if c.size <= c.maxSize {
+		return nil
+	}
+
+	digests := make([]string, 0, len(c.entries))
+	for digest := range c.entries {
+		if digest != keep {
+			digests = append(digests, digest)
+		}
+	}
+	sort.Slice(digests, func(i, j int) bool {
+		return c.entries[digests[i]].lastUsed.Before(c.entries[digests[j]].lastUsed)
+	})
+
+	for _, digest := range digests {
+		if c.size <= c.maxSize {
+			break
+		}
+		if err := c.remove(digest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Must be called with the lock held.
+func (c *ImageCache) remove(digest string) error {
+	entry := c.entries[digest]
+	delete(c.entries, digest)
+	c.size -= entry.size
+	if err := os.Remove(entry.file); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("evicting image cache entry: %w", err)
+	}
+	return nil
+}
+
+func (c *ImageCache) file(digest string) (string, error) {
+	// Validates the digest, so it is safe to use as file name.
+	hash, err := containerregistrypkgv1.NewHash(digest)
+	if err != nil {
+		return "", fmt.Errorf("invalid image digest: %w", err)
+	}
+	return filepath.Join(c.dir, hash.Algorithm+"-"+hash.Hex+imageCacheFileSuffix), nil
+}
+
+func (c *ImageCache) recordRequest(hit bool) {
+	if c.recorder != nil {
+		c.recorder.RecordImageCacheRequest(hit)
+	}
+}
+
+func (c *ImageCache) recordSize() {
+	if c.recorder != nil {
+		c.recorder.RecordImageCacheSize(c.size, len(c.entries))
+	}
+}
+
+// Returns the digest of the image stored in the cache file with the given name.
+func imageCacheDigest(fileName string) (string, bool) {
+	algorithm, hex, ok := strings.Cut(strings.TrimSuffix(fileName, imageCacheFileSuffix), "-")
+	if !ok || !strings.HasSuffix(fileName, imageCacheFileSuffix) {
+		return "", false
+	}
+	hash, err := containerregistrypkgv1.NewHash(algorithm + ":" + hex)
+	if err != nil {
+		return "", false
+	}
+	return hash.String(), true
+}
+
+func encodeImageCacheFile(rawPkg *packagetypes.RawPackage) ([]byte, error) {
+	paths := make([]string, 0, len(rawPkg.Files))
+	for path := range rawPkg.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, path := range paths {
+		data := rawPkg.Files[path]
+		if err := w.WriteHeader(&tar.Header{
+			Name: path, Mode: 0o600, Size: int64(len(data)), Typeflag: tar.TypeReg,
+		}); err != nil {
+			return nil, fmt.Errorf("encoding image cache file: %w", err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, fmt.Errorf("encoding image cache file: %w", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("encoding image cache file: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func readImageCacheFile(file string) (*packagetypes.RawPackage, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	files := packagetypes.Files{}
+	r := tar.NewReader(f)
+	for {
+		hdr, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		files[hdr.Name] = data
+	}
+	if len(files) == 0 {
+		return nil, packagetypes.ErrEmptyPackage
+	}
+	return &packagetypes.RawPackage{Files: files}, nil
+}
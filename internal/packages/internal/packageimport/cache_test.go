@@ -0,0 +1,125 @@
+package packageimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+const (
+	testDigestA = "sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testDigestB = "sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	testDigestC = "sha256:cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+)
+
+type imageCacheRecorderStub struct {
+	hits, misses int
+	size         int64
+	entries      int
+}
+
+func (r *imageCacheRecorderStub) RecordImageCacheRequest(hit bool) {
+	if hit {
+		r.hits++
+	} else {
+		r.misses++
+	}
+}
+
+func (r *imageCacheRecorderStub) RecordImageCacheSize(bytes int64, entries int) {
+	r.size, r.entries = bytes, entries
+}
+
+func TestImageCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rec := &imageCacheRecorderStub{}
+	c, err := NewImageCache(dir, 1<<20, rec)
+	require.NoError(t, err)
+
+	_, ok := c.Get(testDigestA)
+	assert.False(t, ok)
+
+	rawPkg := &packagetypes.RawPackage{Files: packagetypes.Files{
+		"manifest.yaml":         []byte("apiVersion: manifests.package-operator.run/v1alpha1"),
+		"deploy/cm.yaml.gotmpl": []byte("kind: ConfigMap"),
+	}}
+	require.NoError(t, c.Put(testDigestA, rawPkg))
+
+	cached, ok := c.Get(testDigestA)
+	require.True(t, ok)
+	assert.Equal(t, rawPkg, cached)
+	assert.Equal(t, 1, rec.hits)
+	assert.Equal(t, 1, rec.misses)
+	assert.Equal(t, 1, rec.entries)
+	assert.Positive(t, rec.size)
+
+	// Entries are reused after restarts.
+	c, err = NewImageCache(dir, 1<<20, nil)
+	require.NoError(t, err)
+	cached, ok = c.Get(testDigestA)
+	require.True(t, ok)
+	assert.Equal(t, rawPkg, cached)
+}
+
+func TestImageCache_evict(t *testing.T) {
+	t.Parallel()
+
+	rawPkg := &packagetypes.RawPackage{Files: packagetypes.Files{"test": make([]byte, 1000)}}
+	data, err := encodeImageCacheFile(rawPkg)
+	require.NoError(t, err)
+	entrySize := int64(len(data))
+
+	c, err := NewImageCache(t.TempDir(), 2*entrySize, nil)
+	require.NoError(t, err)
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	c.clock = clk
+
+	require.NoError(t, c.Put(testDigestA, rawPkg))
+	clk.SetTime(clk.Now().Add(time.Second))
+	require.NoError(t, c.Put(testDigestB, rawPkg))
+	clk.SetTime(clk.Now().Add(time.Second))
+
+	// Using A makes B the least recently used entry.
+	_, ok := c.Get(testDigestA)
+	require.True(t, ok)
+	clk.SetTime(clk.Now().Add(time.Second))
+	require.NoError(t, c.Put(testDigestC, rawPkg))
+
+	_, ok = c.Get(testDigestB)
+	assert.False(t, ok)
+	for _, digest := range []string{testDigestA, testDigestC} {
+		_, ok = c.Get(digest)
+		assert.True(t, ok, digest)
+	}
+	assert.Equal(t, 2*entrySize, c.size)
+
+	// Packages larger than the cache are not stored.
+	require.NoError(t, c.Put(testDigestB, &packagetypes.RawPackage{
+		Files: packagetypes.Files{"test": make([]byte, 3*entrySize)},
+	}))
+	_, ok = c.Get(testDigestB)
+	assert.False(t, ok)
+}
+
+func TestImageCache_invalid(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tmp-123"), []byte("partial"), 0o600))
+
+	c, err := NewImageCache(dir, 1<<20, nil)
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "tmp-123"))
+
+	err = c.Put("../../etc/passwd", &packagetypes.RawPackage{Files: packagetypes.Files{"test": nil}})
+	require.Error(t, err)
+}
@@ -0,0 +1,224 @@
+package packageimport
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+)
+
+// ErrInvalidImageFile is returned when a file can not be read as package image.
+var ErrInvalidImageFile = errors.New("invalid image file")
+
+const (
+	// ociLayoutFile marks the root of an OCI image layout.
+	ociLayoutFile = "oci-layout"
+	// ociRefNameAnnotation holds the tag of an image index inside an OCI image layout.
+	ociRefNameAnnotation = "org.opencontainers.image.ref.name"
+)
+
+// OCIFile is a package image loaded from a tar file,
+// either a single image in the docker tarball format
+// or a multi-platform image index or package artifact in the OCI image layout format.
+// OCIFile must be closed after use.
+type OCIFile struct {
+	// Tags stored within the file.
+	Tags []string
+	// Set when the file contains a single image or package artifact.
+	Image containerregistrypkgv1.Image
+	// Set when the file contains an image index.
+	Index containerregistrypkgv1.ImageIndex
+
+	dir string
+}
+
+// Loads an image or image index exported via "kubectl package build --output".
+func FromOCIFile(src string) (*OCIFile, error) {
+	isLayout, err := isOCILayoutTar(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImageFile, err)
+	}
+	if isLayout {
+		return fromOCILayoutTar(src)
+	}
+
+	opener := func() (io.ReadCloser, error) { return os.Open(src) }
+
+	manifest, err := tarball.LoadManifest(opener)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImageFile, err)
+	}
+	image, err := tarball.Image(opener, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImageFile, err)
+	}
+
+	f := &OCIFile{Image: image}
+	for _, desc := range manifest {
+		f.Tags = append(f.Tags, desc.RepoTags...)
+	}
+
+	return f, nil
+}
+
+func fromOCILayoutTar(src string) (_ *OCIFile, err error) {
+	dir, err := os.MkdirTemp("", "pko-oci-layout-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(dir)
+		}
+	}()
+
+	if err := untar(src, dir); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImageFile, err)
+	}
+
+	root, err := layout.ImageIndexFromPath(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImageFile, err)
+	}
+	rootManifest, err := root.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImageFile, err)
+	}
+	if len(rootManifest.Manifests) == 0 {
+		return nil, fmt.Errorf("%w: OCI layout is empty", ErrInvalidImageFile)
+	}
+
+	// All tags within the layout point to the same image index or package artifact.
+	f := &OCIFile{dir: dir}
+	if desc := rootManifest.Manifests[0]; desc.MediaType.IsIndex() {
+		f.Index, err = root.ImageIndex(desc.Digest)
+	} else {
+		f.Image, err = root.Image(desc.Digest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImageFile, err)
+	}
+
+	for _, desc := range rootManifest.Manifests {
+		if tag := desc.Annotations[ociRefNameAnnotation]; tag != "" {
+			f.Tags = append(f.Tags, tag)
+		}
+	}
+
+	return f, nil
+}
+
+// Digest of the image or image index, as it will be known to registries once pushed.
+func (f *OCIFile) Digest() (containerregistrypkgv1.Hash, error) {
+	if f.Index != nil {
+		return f.Index.Digest()
+	}
+
+	return f.Image.Digest()
+}
+
+// PackageImage returns the image holding the package contents.
+// For image indexes this is the first image, as package contents are identical across platforms.
+func (f *OCIFile) PackageImage() (containerregistrypkgv1.Image, error) {
+	if f.Index == nil {
+		return f.Image, nil
+	}
+
+	manifest, err := f.Index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	if len(manifest.Manifests) == 0 {
+		return nil, fmt.Errorf("%w: image index is empty", ErrInvalidImageFile)
+	}
+
+	return f.Index.Image(manifest.Manifests[0].Digest)
+}
+
+// Close removes temporary files backing the OCIFile.
+func (f *OCIFile) Close() error {
+	if f.dir == "" {
+		return nil
+	}
+
+	return os.RemoveAll(f.dir)
+}
+
+func isOCILayoutTar(src string) (bool, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if filepath.Clean(hdr.Name) == ociLayoutFile {
+			return true, nil
+		}
+	}
+}
+
+// Extracts all regular files from the tar at src into dst.
+func untar(src, dst string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		path := filepath.Join(dst, filepath.Clean(hdr.Name))
+		if !strings.HasPrefix(path, filepath.Clean(dst)+string(os.PathSeparator)) {
+			return fmt.Errorf("%w: illegal file path %s", ErrInvalidImageFile, hdr.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+		if err := writeFile(path, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func writeFile(path string, r io.Reader) (err error) {
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := out.Close(); err == nil {
+			err = cErr
+		}
+	}()
+
+	_, err = io.Copy(out, r)
+
+	return err
+}
@@ -0,0 +1,26 @@
+package packageimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromOCIFile_Invalid(t *testing.T) {
+	t.Parallel()
+
+	src := filepath.Join(t.TempDir(), "image.tar")
+	require.NoError(t, os.WriteFile(src, []byte("not a tar"), 0o600))
+
+	_, err := FromOCIFile(src)
+	require.ErrorIs(t, err, ErrInvalidImageFile)
+}
+
+func TestFromOCIFile_Missing(t *testing.T) {
+	t.Parallel()
+
+	_, err := FromOCIFile(filepath.Join(t.TempDir(), "missing.tar"))
+	require.ErrorIs(t, err, ErrInvalidImageFile)
+}
@@ -16,10 +16,15 @@ import (
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
 
-// Imports a RawPackage from the given OCI image.
+// Imports a RawPackage from the given OCI image or package artifact.
 func FromOCI(ctx context.Context, image containerregistrypkgv1.Image) (
 	rawPkg *packagetypes.RawPackage, err error,
 ) {
+	artifact, err := isPackageArtifact(image)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+
 	files := packagetypes.Files{}
 	reader := mutate.Extract(image)
 	verboseLog := logr.FromContextOrDiscard(ctx).V(1)
@@ -37,9 +42,13 @@ func FromOCI(ctx context.Context, image containerregistrypkgv1.Image) (
 			break
 		}
 
-		path, err := stripOCIPathPrefix(hdr.Name)
-		if err != nil {
-			return nil, err
+		// Package artifacts hold files at the root, images below OCIPathPrefix.
+		path := filepath.Clean(hdr.Name)
+		if !artifact {
+			path, err = stripOCIPathPrefix(hdr.Name)
+			if err != nil {
+				return nil, err
+			}
 		}
 		if strings.HasPrefix(path, "../") {
 			continue
@@ -67,6 +76,15 @@ func FromOCI(ctx context.Context, image containerregistrypkgv1.Image) (
 	}, nil
 }
 
+// Package artifacts are identified by their config media type.
+func isPackageArtifact(image containerregistrypkgv1.Image) (bool, error) {
+	manifest, err := image.Manifest()
+	if err != nil {
+		return false, err
+	}
+	return manifest.Config.MediaType == packagetypes.PackageArtifactType, nil
+}
+
 func stripOCIPathPrefix(path string) (string, error) {
 	strippedPath, err := filepath.Rel(packagetypes.OCIPathPrefix, path)
 	if err != nil {
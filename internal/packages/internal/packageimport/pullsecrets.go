@@ -0,0 +1,177 @@
+package packageimport
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ErrInvalidPullSecret is returned for Secrets that do not contain docker registry credentials.
+var ErrInvalidPullSecret = errors.New("invalid image pull secret")
+
+// PullConfig configures a single image pull.
+type PullConfig struct {
+	// Registry credentials from Secrets of type
+	// kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg.
+	PullSecrets []corev1.Secret
+}
+
+func (c *PullConfig) Option(opts ...PullOption) {
+	for _, opt := range opts {
+		opt.ConfigurePull(c)
+	}
+}
+
+type PullOption interface {
+	ConfigurePull(c *PullConfig)
+}
+
+// WithPullSecrets authenticates image pulls with the credentials of the given Secrets.
+// Credentials configured in the docker config of the process are still used as fallback.
+type WithPullSecrets []corev1.Secret
+
+func (w WithPullSecrets) ConfigurePull(c *PullConfig) {
+	c.PullSecrets = append(c.PullSecrets, w...)
+}
+
+// Identifies the credentials of a pull, so only pulls using the same credentials are de-duplicated.
+func (c *PullConfig) credentialsKey() string {
+	keys := make([]string, 0, len(c.PullSecrets))
+	for _, secret := range c.PullSecrets {
+		keys = append(keys, fmt.Sprintf("%s/%s@%s",
+			secret.Namespace, secret.Name, secret.ResourceVersion))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+func (c *PullConfig) craneOptions() ([]crane.Option, error) {
+	if len(c.PullSecrets) == 0 {
+		return nil, nil
+	}
+	kc, err := NewPullSecretKeychain(c.PullSecrets)
+	if err != nil {
+		return nil, err
+	}
+	return []crane.Option{
+		crane.WithAuthFromKeychain(authn.NewMultiKeychain(kc, authn.DefaultKeychain)),
+	}, nil
+}
+
+// NewPullSecretKeychain returns a keychain resolving registry credentials
+// from Secrets of type kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg.
+func NewPullSecretKeychain(secrets []corev1.Secret) (authn.Keychain, error) {
+	kc := pullSecretKeychain{}
+	for _, secret := range secrets {
+		auths, err := dockerConfigAuths(secret)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s/%s: %w", ErrInvalidPullSecret, secret.Namespace, secret.Name, err)
+		}
+		for registry, cfg := range auths {
+			kc = append(kc, pullSecretEntry{registry: normalizeRegistryKey(registry), config: cfg})
+		}
+	}
+	// Most specific entries first.
+	sort.SliceStable(kc, func(i, j int) bool {
+		return len(kc[i].registry) > len(kc[j].registry)
+	})
+	return kc, nil
+}
+
+type pullSecretKeychain []pullSecretEntry
+
+type pullSecretEntry struct {
+	// Registry host, optionally followed by a repository prefix.
+	registry string
+	config   authn.AuthConfig
+}
+
+func (kc pullSecretKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	repo := target.String()
+	if r, ok := target.(name.Repository); ok {
+		repo = r.RegistryStr() + "/" + r.RepositoryStr()
+	}
+
+	for _, entry := range kc {
+		if entry.registry == target.RegistryStr() ||
+			strings.HasPrefix(repo, entry.registry+"/") || repo == entry.registry {
+			return authn.FromConfig(entry.config), nil
+		}
+	}
+	return authn.Anonymous, nil
+}
+
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+type dockerConfigEntry struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+func dockerConfigAuths(secret corev1.Secret) (map[string]authn.AuthConfig, error) {
+	var entries map[string]dockerConfigEntry
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		cfg := dockerConfigJSON{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &cfg); err != nil {
+			return nil, err
+		}
+		entries = cfg.Auths
+	case corev1.SecretTypeDockercfg:
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &entries); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %q", secret.Type)
+	}
+
+	auths := map[string]authn.AuthConfig{}
+	for registry, entry := range entries {
+		cfg := authn.AuthConfig{
+			Username:      entry.Username,
+			Password:      entry.Password,
+			IdentityToken: entry.IdentityToken,
+			RegistryToken: entry.RegistryToken,
+		}
+		if len(entry.Auth) > 0 {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("decoding auth of %s: %w", registry, err)
+			}
+			cfg.Username, cfg.Password, _ = strings.Cut(string(decoded), ":")
+		}
+		auths[registry] = cfg
+	}
+	return auths, nil
+}
+
+// Docker configs key entries by URL, e.g. "https://index.docker.io/v1/", or by host and path.
+func normalizeRegistryKey(key string) string {
+	if strings.Contains(key, "://") {
+		if u, err := url.Parse(key); err == nil {
+			key = u.Host + u.Path
+		}
+	}
+	key = strings.TrimSuffix(key, "/")
+	for _, apiPath := range []string{"/v1", "/v2"} {
+		key = strings.TrimSuffix(key, apiPath)
+	}
+	if key == "docker.io" {
+		return name.DefaultRegistry
+	}
+	return key
+}
@@ -0,0 +1,89 @@
+package packageimport
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewPullSecretKeychain(t *testing.T) {
+	t.Parallel()
+
+	auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	kc, err := NewPullSecretKeychain([]corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "json", Namespace: "test"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{` +
+					`"quay.io":{"username":"quay","password":"secret"},` +
+					`"quay.io/private":{"auth":"` + auth + `"},` +
+					`"https://index.docker.io/v1/":{"username":"hub","password":"hub"}}}`),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cfg", Namespace: "test"},
+			Type:       corev1.SecretTypeDockercfg,
+			Data: map[string][]byte{
+				corev1.DockerConfigKey: []byte(`{"ghcr.io":{"username":"gh","password":"gh"}}`),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		repository string
+		expected   authn.AuthConfig
+	}{
+		{"quay.io/public/app", authn.AuthConfig{Username: "quay", Password: "secret"}},
+		{"quay.io/private/app", authn.AuthConfig{Username: "user", Password: "pass"}},
+		{"docker.io/library/nginx", authn.AuthConfig{Username: "hub", Password: "hub"}},
+		{"ghcr.io/org/app", authn.AuthConfig{Username: "gh", Password: "gh"}},
+		{"registry.example.com/app", authn.AuthConfig{}},
+	}
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.repository, func(t *testing.T) {
+			t.Parallel()
+
+			repo, err := name.NewRepository(test.repository)
+			require.NoError(t, err)
+			authenticator, err := kc.Resolve(repo)
+			require.NoError(t, err)
+			cfg, err := authenticator.Authorization()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, *cfg)
+		})
+	}
+}
+
+func TestNewPullSecretKeychain_invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPullSecretKeychain([]corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: "test"},
+			Type:       corev1.SecretTypeOpaque,
+		},
+	})
+	require.ErrorIs(t, err, ErrInvalidPullSecret)
+}
+
+func TestPullConfig_credentialsKey(t *testing.T) {
+	t.Parallel()
+
+	a := corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "test", ResourceVersion: "1"}}
+	b := corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "test", ResourceVersion: "2"}}
+
+	c1, c2 := PullConfig{}, PullConfig{}
+	c1.Option(WithPullSecrets{a, b})
+	c2.Option(WithPullSecrets{b}, WithPullSecrets{a})
+	assert.Equal(t, c1.credentialsKey(), c2.credentialsKey())
+	assert.Empty(t, (&PullConfig{}).credentialsKey())
+}
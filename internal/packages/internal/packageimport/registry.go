@@ -2,12 +2,18 @@ package packageimport
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"go.opentelemetry.io/otel/attribute"
 
+	"package-operator.run/internal/packages/internal/packagesignature"
 	"package-operator.run/internal/packages/internal/packagetypes"
+	"package-operator.run/internal/tracing"
 	"package-operator.run/internal/utils"
 )
 
@@ -25,10 +31,15 @@ func FromRegistry(ctx context.Context, ref string, opts ...crane.Option) (
 // Registry de-duplicates multiple parallel container image pulls.
 type Registry struct {
 	registryHostOverrides map[string]string
+	verificationPolicy    *packagesignature.Policy
+	imageCache            *ImageCache
 
-	pullImage    pullImageFn
-	inFlight     map[string][]chan<- response
-	inFlightLock sync.Mutex
+	pullImage     pullImageFn
+	verifyImage   verifyImageFn
+	resolveDigest resolveDigestFn
+	listTags      listTagsFn
+	inFlight      map[string][]chan<- response
+	inFlightLock  sync.Mutex
 }
 
 type response struct {
@@ -39,22 +50,94 @@ type response struct {
 type pullImageFn func(
 	ctx context.Context, ref string, opts ...crane.Option) (*packagetypes.RawPackage, error)
 
+type verifyImageFn func(
+	ctx context.Context, ref string, policy *packagesignature.Policy, opts ...crane.Option,
+) (*packagesignature.Verified, error)
+
+type resolveDigestFn func(ref string, opts ...crane.Option) (string, error)
+
+type listTagsFn func(repository string, opts ...crane.Option) ([]string, error)
+
 // Creates a new registry instance to de-duplicate parallel container image pulls.
-func NewRegistry(registryHostOverrides map[string]string) *Registry {
-	return &Registry{
+func NewRegistry(registryHostOverrides map[string]string, opts ...RegistryOption) *Registry {
+	var cfg RegistryConfig
+
+	cfg.Option(opts...)
+
+	r := &Registry{
 		registryHostOverrides: registryHostOverrides,
+		verificationPolicy:    cfg.VerificationPolicy,
+		imageCache:            cfg.ImageCache,
 		pullImage:             FromRegistry,
+		verifyImage:           packagesignature.Verify,
+		resolveDigest:         crane.Digest,
+		listTags:              crane.ListTags,
 		inFlight:              make(map[string][]chan<- response),
 	}
+	if cfg.PullBackend != nil {
+		r.pullImage = cfg.PullBackend.Pull
+	}
+	return r
+}
+
+type RegistryConfig struct {
+	// Signatures of pulled images are verified against this policy, if it is not empty.
+	VerificationPolicy *packagesignature.Policy
+	// Pulled images are cached by digest, if set.
+	ImageCache *ImageCache
+	// Fetches image contents, defaults to pulling from the registry.
+	PullBackend PullBackend
+}
+
+func (c *RegistryConfig) Option(opts ...RegistryOption) {
+	for _, opt := range opts {
+		opt.ConfigureRegistry(c)
+	}
+}
+
+type RegistryOption interface {
+	ConfigureRegistry(c *RegistryConfig)
 }
 
-func (r *Registry) Pull(ctx context.Context, image string) (*packagetypes.RawPackage, error) {
+// WithVerificationPolicy makes the registry refuse images without signatures trusted by the policy.
+type WithVerificationPolicy struct{ Policy *packagesignature.Policy }
+
+func (w WithVerificationPolicy) ConfigureRegistry(c *RegistryConfig) {
+	c.VerificationPolicy = w.Policy
+}
+
+// WithImageCache reuses the contents of images with the same digest from the given cache.
+type WithImageCache struct{ Cache *ImageCache }
+
+func (w WithImageCache) ConfigureRegistry(c *RegistryConfig) {
+	c.ImageCache = w.Cache
+}
+
+// WithPullBackend fetches image contents via the given backend, instead of the registry.
+type WithPullBackend struct{ Backend PullBackend }
+
+func (w WithPullBackend) ConfigureRegistry(c *RegistryConfig) {
+	c.PullBackend = w.Backend
+}
+
+func (r *Registry) Pull(
+	ctx context.Context, image string, opts ...PullOption,
+) (*packagetypes.RawPackage, error) {
+	var cfg PullConfig
+	cfg.Option(opts...)
+
 	image, err := r.applyOverride(image)
 	if err != nil {
 		return nil, err
 	}
+	craneOpts, err := cfg.craneOptions()
+	if err != nil {
+		return nil, err
+	}
 
-	res := <-r.handleRequest(ctx, image)
+	ctx, span := tracing.Start(ctx, "PullImage", attribute.String("image", image))
+	res := <-r.handleRequest(ctx, image, cfg.credentialsKey(), craneOpts)
+	tracing.End(span, res.Err)
 
 	return res.RawPackage, res.Err
 }
@@ -75,15 +158,24 @@ func (r *Registry) applyOverride(image string) (string, error) {
 // on the in flight pull requests, more specifically, a check if an image pull
 // is in flight after a pull attempt has started, but before the first receiver
 // is registered.
-func (r *Registry) handleRequest(ctx context.Context, image string) <-chan response {
+// Pulls with different credentials are never de-duplicated,
+// so packages can not get hold of images they have no access to.
+func (r *Registry) handleRequest(
+	ctx context.Context, image, credentialsKey string, craneOpts []crane.Option,
+) <-chan response {
 	r.inFlightLock.Lock()
 	defer r.inFlightLock.Unlock()
 
-	if _, inFlight := r.inFlight[image]; !inFlight {
+	key := image
+	if len(credentialsKey) > 0 {
+		key += "#" + credentialsKey
+	}
+
+	if _, inFlight := r.inFlight[key]; !inFlight {
 		go func(ctx context.Context, image string) {
-			rawPkg, err := r.pullImage(ctx, image, crane.Insecure)
+			rawPkg, err := r.pull(ctx, image, craneOpts)
 
-			r.handleResponse(image, response{
+			r.handleResponse(key, response{
 				RawPackage: rawPkg,
 				Err:        err,
 			})
@@ -94,11 +186,74 @@ func (r *Registry) handleRequest(ctx context.Context, image string) <-chan respo
 	// is never blocked by a receiver.
 	recv := make(chan response, 1)
 
-	r.inFlight[image] = append(r.inFlight[image], recv)
+	r.inFlight[key] = append(r.inFlight[key], recv)
 
 	return recv
 }
 
+// pull verifies the image signature, if a verification policy is set,
+// and then pulls the image by the verified digest.
+// Keyless policies are refused, because the signing time of keyless signatures is not verified.
+// With an image cache, the digest is always resolved first to look up the cache.
+// Resolving happens with the credentials of the pull, so cached contents
+// are only handed out to pulls with access to the image.
+func (r *Registry) pull(
+	ctx context.Context, image string, craneOpts []crane.Option,
+) (*packagetypes.RawPackage, error) {
+	craneOpts = append([]crane.Option{crane.Insecure}, craneOpts...)
+
+	var digest string
+	switch {
+	case r.verificationPolicy.Keyless():
+		return nil, fmt.Errorf("verifying signature: %w", packagesignature.ErrKeylessUnsupported)
+
+	case !r.verificationPolicy.Empty():
+		verified, err := r.verifyImage(ctx, image, r.verificationPolicy, craneOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("verifying signature: %w", err)
+		}
+		digest = verified.Digest
+
+	case r.imageCache != nil:
+		var err error
+		digest, err = r.resolveDigest(image, craneOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("resolving digest: %w", err)
+		}
+
+	default:
+		return r.pullImage(ctx, image, craneOpts...)
+	}
+
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	return r.pullDigest(ctx, ref.Context().Digest(digest).String(), digest, craneOpts)
+}
+
+// pullDigest pulls an image by digest through the image cache, if one is set.
+func (r *Registry) pullDigest(
+	ctx context.Context, image, digest string, craneOpts []crane.Option,
+) (*packagetypes.RawPackage, error) {
+	if r.imageCache == nil {
+		return r.pullImage(ctx, image, craneOpts...)
+	}
+	if rawPkg, ok := r.imageCache.Get(digest); ok {
+		return rawPkg, nil
+	}
+
+	rawPkg, err := r.pullImage(ctx, image, craneOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.imageCache.Put(digest, rawPkg); err != nil {
+		// The cache is an optimization, pulls still succeed without it.
+		logr.FromContextOrDiscard(ctx).Error(err, "caching package image", "image", image)
+	}
+	return rawPkg, nil
+}
+
 // handleResponse broadcasts a response to all receivers listening
 // for a given image's pull request and then deletes the image's
 // entry allowing new requests to trigger a fresh pull. These
@@ -106,11 +261,11 @@ func (r *Registry) handleRequest(ctx context.Context, image string) <-chan respo
 // writes, more specifically, the registration of a new receiver
 // after broadcast has occurred, but before the image entry is
 // deleted.
-func (r *Registry) handleResponse(image string, res response) {
+func (r *Registry) handleResponse(key string, res response) {
 	r.inFlightLock.Lock()
 	defer r.inFlightLock.Unlock()
 
-	for _, recv := range r.inFlight[image] {
+	for _, recv := range r.inFlight[key] {
 		var rawPkg *packagetypes.RawPackage
 		if res.RawPackage != nil {
 			// DeepCopy to ensure clients can work concurrently on the returned files map.
@@ -122,5 +277,5 @@ func (r *Registry) handleResponse(image string, res response) {
 		}
 	}
 
-	delete(r.inFlight, image)
+	delete(r.inFlight, key)
 }
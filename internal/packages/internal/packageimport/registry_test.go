@@ -2,6 +2,8 @@ package packageimport
 
 import (
 	"context"
+	"crypto"
+	"errors"
 	"reflect"
 	"sync"
 	"testing"
@@ -10,7 +12,9 @@ import (
 	"github.com/google/go-containerregistry/pkg/crane"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"package-operator.run/internal/packages/internal/packagesignature"
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
 
@@ -106,6 +110,95 @@ func TestRegistry_DelayedRequests(t *testing.T) {
 	}
 }
 
+func TestRegistry_Verification(t *testing.T) {
+	t.Parallel()
+
+	const digest = "sha256:4ec2f57b7ee5e9a2e4b8da6e6c2e8b4fd2a4ecb9f0ce3b5e0cf9c4d8b8e6e4b2"
+
+	policy := &packagesignature.Policy{Keys: []crypto.PublicKey{"key"}}
+	r := NewRegistry(nil, WithVerificationPolicy{Policy: policy})
+
+	ipm := &imagePullerMock{}
+	r.pullImage = ipm.Pull
+	ipm.
+		On("Pull", mock.Anything, mock.Anything, mock.Anything).
+		Return(&packagetypes.RawPackage{}, nil)
+
+	r.verifyImage = func(
+		_ context.Context, ref string, p *packagesignature.Policy, _ ...crane.Option,
+	) (*packagesignature.Verified, error) {
+		assert.Equal(t, "quay.io/test123", ref)
+		assert.Same(t, policy, p)
+		return &packagesignature.Verified{Digest: digest}, nil
+	}
+
+	_, err := r.Pull(context.Background(), "quay.io/test123")
+	require.NoError(t, err)
+	ipm.AssertCalled(t, "Pull", mock.Anything, "quay.io/test123@"+digest, mock.Anything)
+
+	r.verifyImage = func(
+		context.Context, string, *packagesignature.Policy, ...crane.Option,
+	) (*packagesignature.Verified, error) {
+		return nil, packagesignature.ErrNoSignatures
+	}
+
+	_, err = r.Pull(context.Background(), "quay.io/test123")
+	require.ErrorIs(t, err, packagesignature.ErrNoSignatures)
+}
+
+func TestRegistry_VerificationKeyless(t *testing.T) {
+	t.Parallel()
+
+	policy := &packagesignature.Policy{
+		Identities: []packagesignature.Identity{{Issuer: "issuer", Subject: "subject"}},
+	}
+	r := NewRegistry(nil, WithVerificationPolicy{Policy: policy})
+
+	ipm := &imagePullerMock{}
+	r.pullImage = ipm.Pull
+
+	_, err := r.Pull(context.Background(), "quay.io/test123")
+	require.ErrorIs(t, err, packagesignature.ErrKeylessUnsupported)
+	ipm.AssertNotCalled(t, "Pull", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRegistry_ImageCache(t *testing.T) {
+	t.Parallel()
+
+	const digest = "sha256:4ec2f57b7ee5e9a2e4b8da6e6c2e8b4fd2a4ecb9f0ce3b5e0cf9c4d8b8e6e4b2"
+
+	cache, err := NewImageCache(t.TempDir(), 1<<20, nil)
+	require.NoError(t, err)
+	r := NewRegistry(nil, WithImageCache{Cache: cache})
+
+	ipm := &imagePullerMock{}
+	r.pullImage = ipm.Pull
+	ipm.
+		On("Pull", mock.Anything, mock.Anything, mock.Anything).
+		Return(&packagetypes.RawPackage{Files: packagetypes.Files{"test": []byte("test")}}, nil)
+	r.resolveDigest = func(string, ...crane.Option) (string, error) {
+		return digest, nil
+	}
+
+	ctx := context.Background()
+	for _, image := range []string{"quay.io/test123:v1", "quay.io/test123:latest"} {
+		rawPkg, err := r.Pull(ctx, image)
+		require.NoError(t, err)
+		assert.Equal(t, packagetypes.Files{"test": []byte("test")}, rawPkg.Files)
+	}
+	ipm.AssertNumberOfCalls(t, "Pull", 1)
+	ipm.AssertCalled(t, "Pull", mock.Anything, "quay.io/test123@"+digest, mock.Anything)
+
+	// Images are not served from the cache, when access to them can not be verified.
+	r.resolveDigest = func(string, ...crane.Option) (string, error) {
+		return "", errTest
+	}
+	_, err = r.Pull(ctx, "quay.io/test123:v1")
+	require.ErrorIs(t, err, errTest)
+}
+
+var errTest = errors.New("test")
+
 type imagePullerMock struct {
 	mock.Mock
 }
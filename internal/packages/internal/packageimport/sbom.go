@@ -0,0 +1,72 @@
+package packageimport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// SBOMReference points to an SBOM attached to a package image.
+type SBOMReference struct {
+	Format packagetypes.SBOMFormat
+	// Digest of the SBOM artifact manifest.
+	Digest string
+}
+
+// FindSBOMs lists all SBOMs attached to the given image as OCI referrers.
+func FindSBOMs(ctx context.Context, ref string, opts ...crane.Option) ([]SBOMReference, error) {
+	opts = append(opts, crane.WithContext(ctx))
+	o := crane.GetOptions(opts...)
+
+	r, err := name.ParseReference(ref, o.Name...)
+	if err != nil {
+		return nil, fmt.Errorf("parsing reference %s: %w", ref, err)
+	}
+
+	subject, err := remote.Head(r, o.Remote...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", ref, err)
+	}
+
+	referrers, err := remote.Referrers(r.Context().Digest(subject.Digest.String()), o.Remote...)
+	if err != nil {
+		return nil, fmt.Errorf("listing referrers of %s: %w", ref, err)
+	}
+	manifest, err := referrers.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("listing referrers of %s: %w", ref, err)
+	}
+
+	var sboms []SBOMReference
+	for _, desc := range manifest.Manifests {
+		format, ok := packagetypes.SBOMFormatFromArtifactType(desc.ArtifactType)
+		if !ok {
+			continue
+		}
+		sboms = append(sboms, SBOMReference{Format: format, Digest: desc.Digest.String()})
+	}
+
+	return sboms, nil
+}
+
+// FindSBOMs lists all SBOMs attached to the given image, applying registry host overrides.
+func (r *Registry) FindSBOMs(ctx context.Context, image string, opts ...PullOption) ([]SBOMReference, error) {
+	var cfg PullConfig
+	cfg.Option(opts...)
+
+	image, err := r.applyOverride(image)
+	if err != nil {
+		return nil, err
+	}
+	craneOpts, err := cfg.craneOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return FindSBOMs(ctx, image, craneOpts...)
+}
@@ -0,0 +1,49 @@
+package packageimport
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// ResolveDigest returns the digest the given image reference currently points to,
+// applying registry host overrides.
+func (r *Registry) ResolveDigest(ctx context.Context, image string, opts ...PullOption) (string, error) {
+	craneOpts, image, err := r.lookupOptions(ctx, image, opts)
+	if err != nil {
+		return "", err
+	}
+	return r.resolveDigest(image, craneOpts...)
+}
+
+// ListTags lists all tags of the repository of the given image,
+// applying registry host overrides.
+func (r *Registry) ListTags(ctx context.Context, image string, opts ...PullOption) ([]string, error) {
+	craneOpts, image, err := r.lookupOptions(ctx, image, opts)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	return r.listTags(ref.Context().String(), craneOpts...)
+}
+
+func (r *Registry) lookupOptions(
+	ctx context.Context, image string, opts []PullOption,
+) ([]crane.Option, string, error) {
+	var cfg PullConfig
+	cfg.Option(opts...)
+
+	image, err := r.applyOverride(image)
+	if err != nil {
+		return nil, "", err
+	}
+	craneOpts, err := cfg.craneOptions()
+	if err != nil {
+		return nil, "", err
+	}
+	return append([]crane.Option{crane.Insecure, crane.WithContext(ctx)}, craneOpts...), image, nil
+}
@@ -0,0 +1,42 @@
+package packageimport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_ListTags(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(map[string]string{"quay.io": "mirror.example.com"})
+	var listed string
+	r.listTags = func(repository string, _ ...crane.Option) ([]string, error) {
+		listed = repository
+		return []string{"v1.0.0", "v1.1.0"}, nil
+	}
+
+	tags, err := r.ListTags(context.Background(), "quay.io/org/package:v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.0", "v1.1.0"}, tags)
+	assert.Equal(t, "mirror.example.com/org/package", listed)
+}
+
+func TestRegistry_ResolveDigest(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(map[string]string{"quay.io": "mirror.example.com"})
+	var resolved string
+	r.resolveDigest = func(ref string, _ ...crane.Option) (string, error) {
+		resolved = ref
+		return "sha256:1234", nil
+	}
+
+	digest, err := r.ResolveDigest(context.Background(), "quay.io/org/package:latest")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:1234", digest)
+	assert.Equal(t, "mirror.example.com/org/package:latest", resolved)
+}
@@ -0,0 +1,137 @@
+package packagekickstart
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/packages/internal/packagekickstart/presets"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+const (
+	helmHookAnnotation             = "helm.sh/hook"
+	helmHookWeightAnnotation       = "helm.sh/hook-weight"
+	helmHookDeletePolicyAnnotation = "helm.sh/hook-delete-policy"
+
+	helmManagedByLabel             = "app.kubernetes.io/managed-by"
+	helmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	helmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+)
+
+type ConvertHelmResult struct {
+	KickstartResult
+	// Hook objects without equivalent in a package, that have been dropped.
+	// e.g. test, delete and rollback hooks.
+	DroppedHooks []string
+}
+
+// ConvertHelm kickstarts a package from the output of `helm template`.
+// Install and upgrade hooks are sorted into phases before and after all other objects,
+// all other hooks are dropped.
+func ConvertHelm(
+	ctx context.Context, pkgName string,
+	objects []unstructured.Unstructured,
+	paramFlags []string,
+) (
+	*packagetypes.RawPackage, ConvertHelmResult, error,
+) {
+	res := ConvertHelmResult{}
+
+	converted := make([]unstructured.Unstructured, 0, len(objects))
+
+	for _, obj := range objects {
+		annotations := obj.GetAnnotations()
+		hooks, isHook := annotations[helmHookAnnotation]
+		if !isHook {
+			converted = append(converted, obj)
+			continue
+		}
+
+		phase, ok := helmHookPhase(hooks)
+		if !ok {
+			res.DroppedHooks = append(res.DroppedHooks,
+				fmt.Sprintf("%s %s (%s)", obj.GetKind(), obj.GetName(), hooks))
+			continue
+		}
+
+		delete(annotations, helmHookAnnotation)
+		delete(annotations, helmHookWeightAnnotation)
+		delete(annotations, helmHookDeletePolicyAnnotation)
+		annotations[manifestsv1alpha1.PackagePhaseAnnotation] = string(phase)
+		obj.SetAnnotations(annotations)
+
+		converted = append(converted, obj)
+	}
+
+	rawPkg, kres, err := kickstart(ctx, pkgName, converted, paramFlags, func(obj unstructured.Unstructured) string {
+		phase := obj.GetAnnotations()[manifestsv1alpha1.PackagePhaseAnnotation]
+		if phase == string(presets.PhaseHooksPre) || phase == string(presets.PhaseHooksPost) {
+			return phase
+		}
+		return determinePhase(obj)
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	res.KickstartResult = kres
+
+	return rawPkg, res, nil
+}
+
+// AdoptHelmRelease kickstarts a package from the manifest of a deployed Helm release.
+// Helm ownership metadata is dropped and Package Operator is allowed to adopt
+// the existing objects of the release, which have no controller.
+func AdoptHelmRelease(
+	ctx context.Context, pkgName string,
+	objects []unstructured.Unstructured,
+	paramFlags []string,
+) (
+	*packagetypes.RawPackage, ConvertHelmResult, error,
+) {
+	adopted := make([]unstructured.Unstructured, 0, len(objects))
+	for _, obj := range objects {
+		obj := *obj.DeepCopy()
+
+		labels := obj.GetLabels()
+		if labels[helmManagedByLabel] == "Helm" {
+			delete(labels, helmManagedByLabel)
+			obj.SetLabels(labels)
+		}
+
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		delete(annotations, helmReleaseNameAnnotation)
+		delete(annotations, helmReleaseNamespaceAnnotation)
+		annotations[manifestsv1alpha1.PackageCollisionProtectionAnnotation] = string(
+			corev1alpha1.CollisionProtectionIfNoController)
+		obj.SetAnnotations(annotations)
+
+		adopted = append(adopted, obj)
+	}
+	return ConvertHelm(ctx, pkgName, adopted, paramFlags)
+}
+
+// Maps a comma separated list of helm hooks to a phase.
+// Returns false if the hooks have no equivalent.
+func helmHookPhase(hooks string) (presets.Phase, bool) {
+	var isPost bool
+	for _, hook := range strings.Split(hooks, ",") {
+		switch strings.TrimSpace(hook) {
+		case "pre-install", "pre-upgrade":
+			return presets.PhaseHooksPre, true
+		case "post-install", "post-upgrade":
+			isPost = true
+		}
+	}
+	if isPost {
+		return presets.PhaseHooksPost, true
+	}
+	return "", false
+}
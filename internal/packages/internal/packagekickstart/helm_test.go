@@ -0,0 +1,125 @@
+package packagekickstart
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pkg.package-operator.run/cardboard/kubeutils/kubemanifests"
+	"sigs.k8s.io/yaml"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+func TestConvertHelm(t *testing.T) {
+	t.Parallel()
+
+	const manifest = `apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+  namespace: my-app
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  namespace: my-app
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-weight: "-5"
+    helm.sh/hook-delete-policy: before-hook-creation
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: notify
+  namespace: my-app
+  annotations:
+    helm.sh/hook: post-install
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: cleanup
+  namespace: my-app
+  annotations:
+    helm.sh/hook: pre-delete
+`
+
+	objects, err := kubemanifests.LoadKubernetesObjectsFromBytes([]byte(manifest))
+	require.NoError(t, err)
+
+	rawPkg, res, err := ConvertHelm(context.Background(), "my-pkg", objects, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, res.ObjectCount)
+	assert.Equal(t, []string{"Pod cleanup (pre-delete)"}, res.DroppedHooks)
+
+	job := rawPkg.Files["hooks-pre/migrate.job.yaml"]
+	if assert.NotEmpty(t, job) {
+		assert.NotContains(t, string(job), "helm.sh/hook")
+		assert.Contains(t, string(job), "package-operator.run/phase: hooks-pre")
+	}
+	assert.NotEmpty(t, rawPkg.Files["hooks-post/notify.configmap.yaml"])
+	assert.NotEmpty(t, rawPkg.Files["deploy/db.statefulset.yaml"])
+
+	pkgManifest := &manifestsv1alpha1.PackageManifest{}
+	require.NoError(t, yaml.Unmarshal(rawPkg.Files["manifest.yaml"], pkgManifest))
+
+	phases := make([]string, len(pkgManifest.Spec.Phases))
+	for i, phase := range pkgManifest.Spec.Phases {
+		phases[i] = phase.Name
+	}
+	assert.Equal(t, []string{"hooks-pre", "namespaces", "deploy", "hooks-post"}, phases)
+	assert.Len(t, pkgManifest.Spec.AvailabilityProbes, 2)
+}
+
+func TestAdoptHelmRelease(t *testing.T) {
+	t.Parallel()
+
+	const manifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: my-app
+  labels:
+    app.kubernetes.io/managed-by: Helm
+    app.kubernetes.io/name: web
+  annotations:
+    meta.helm.sh/release-name: web
+    meta.helm.sh/release-namespace: my-app
+`
+
+	objects, err := kubemanifests.LoadKubernetesObjectsFromBytes([]byte(manifest))
+	require.NoError(t, err)
+
+	rawPkg, res, err := AdoptHelmRelease(context.Background(), "my-pkg", objects, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.ObjectCount)
+
+	deploy := string(rawPkg.Files["deploy/web.deployment.yaml"])
+	assert.Contains(t, deploy, "package-operator.run/collision-protection: IfNoController")
+	assert.Contains(t, deploy, "app.kubernetes.io/name: web")
+	assert.NotContains(t, deploy, "app.kubernetes.io/managed-by")
+	assert.NotContains(t, deploy, "meta.helm.sh")
+
+	// The input objects are left untouched.
+	assert.Equal(t, "Helm", objects[0].GetLabels()["app.kubernetes.io/managed-by"])
+}
+
+func TestHelmHookPhase(t *testing.T) {
+	t.Parallel()
+
+	for hooks, expected := range map[string]string{
+		"pre-install":               "hooks-pre",
+		"pre-upgrade, post-delete":  "hooks-pre",
+		"post-install,post-upgrade": "hooks-post",
+		"test":                      "",
+		"pre-delete,pre-rollback":   "",
+	} {
+		phase, ok := helmHookPhase(hooks)
+		assert.Equal(t, expected != "", ok, hooks)
+		assert.Equal(t, expected, string(phase), hooks)
+	}
+}
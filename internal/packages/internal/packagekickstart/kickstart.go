@@ -28,9 +28,24 @@ type KickstartResult struct {
 }
 
 func Kickstart(
+	ctx context.Context, pkgName string,
+	objects []unstructured.Unstructured,
+	paramFlags []string,
+) (
+	*packagetypes.RawPackage, KickstartResult, error,
+) {
+	return kickstart(ctx, pkgName, objects, paramFlags, determinePhase)
+}
+
+func determinePhase(obj unstructured.Unstructured) string {
+	return presets.DeterminePhase(obj.GroupVersionKind().GroupKind())
+}
+
+func kickstart(
 	_ context.Context, pkgName string,
 	objects []unstructured.Unstructured,
 	paramFlags []string,
+	phaseFn func(obj unstructured.Unstructured) string,
 ) (
 	*packagetypes.RawPackage, KickstartResult, error,
 ) {
@@ -58,7 +73,7 @@ func Kickstart(
 	)
 	for _, obj := range objects {
 		gk := obj.GroupVersionKind().GroupKind()
-		phase := presets.DeterminePhase(gk)
+		phase := phaseFn(obj)
 
 		namespacedName, err := parseObjectMeta(obj)
 		if err != nil {
@@ -27,10 +27,15 @@ const (
 	PhasePublish    Phase = "publish"
 	// Anything else that is not explicitly sorted into a phase.
 	PhaseOther Phase = "other"
+	// Objects converted from Helm pre-install/pre-upgrade hooks.
+	PhaseHooksPre Phase = "hooks-pre"
+	// Objects converted from Helm post-install/post-upgrade hooks.
+	PhaseHooksPost Phase = "hooks-post"
 )
 
 // Well known phases ordered.
 var OrderedPhases = []Phase{
+	PhaseHooksPre,
 	PhaseNamespaces,
 	PhasePolicies,
 	PhaseRBAC,
@@ -39,6 +44,7 @@ var OrderedPhases = []Phase{
 	PhaseDeploy,
 	PhasePublish,
 	PhaseOther,
+	PhaseHooksPost,
 }
 
 var (
@@ -42,7 +42,8 @@ var gkProbes = map[schema.GroupKind][]corev1alpha1.Probe{
 	{
 		Kind: "StatefulSet", Group: "apps",
 	}: {
-		availableProbe,
+		// StatefulSets don't report an Available condition.
+		replicasReadyProbe,
 		replicasUpdatedProbe,
 	},
 	{
@@ -77,10 +78,7 @@ var gkProbes = map[schema.GroupKind][]corev1alpha1.Probe{
 		Group: "batch",
 	}: {
 		{
-			Condition: &corev1alpha1.ProbeConditionSpec{
-				Type:   "Complete",
-				Status: string(metav1.ConditionTrue),
-			},
+			JobCompletion: &corev1alpha1.ProbeJobCompletionSpec{},
 		},
 	},
 	{
@@ -126,6 +124,14 @@ var availableProbe = corev1alpha1.Probe{
 	},
 }
 
+// Checks if all replicas are ready.
+var replicasReadyProbe = corev1alpha1.Probe{
+	FieldsEqual: &corev1alpha1.ProbeFieldsEqualSpec{
+		FieldA: ".status.readyReplicas",
+		FieldB: ".status.replicas",
+	},
+}
+
 // Checks if all replicas have been updated.
 // Works for StatefulSets, Deployments and ReplicaSets.
 var replicasUpdatedProbe = corev1alpha1.Probe{
@@ -0,0 +1,159 @@
+package packagekickstart
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+var (
+	ErrScaffoldMissingScopes  = errors.New("at least one scope is required")
+	ErrScaffoldMissingPhases  = errors.New("at least one phase is required")
+	ErrScaffoldInvalidScope   = errors.New("invalid scope")
+	ErrScaffoldDuplicatePhase = errors.New("duplicate phase")
+)
+
+// ScaffoldOptions configures the package generated by Scaffold.
+type ScaffoldOptions struct {
+	// Scopes the package can be installed in.
+	Scopes []manifestsv1alpha1.PackageManifestScope
+	// Ordered list of phase names.
+	// The example object is placed into the last phase.
+	Phases []string
+}
+
+const (
+	scaffoldGitignore = `# Package images exported via "kubectl package build --output".
+*.tar
+*.tar.gz
+`
+
+	scaffoldExampleObject = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: "{{ .package.metadata.name }}-example"
+  namespace: "{{ .package.metadata.namespace | default "default" }}"
+  annotations:
+    package-operator.run/phase: %q
+data:
+  message: "{{ .config.message }}"
+`
+)
+
+// Scaffold generates a minimal, valid package with the given name
+// containing a PackageManifest, an example object and test templates.
+func Scaffold(pkgName string, opts ScaffoldOptions) (*packagetypes.RawPackage, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	phases := make([]manifestsv1alpha1.PackageManifestPhase, len(opts.Phases))
+	for i, phase := range opts.Phases {
+		phases[i] = manifestsv1alpha1.PackageManifestPhase{Name: phase}
+	}
+
+	var testCases []manifestsv1alpha1.PackageManifestTestCaseTemplate
+	if slices.Contains(opts.Scopes, manifestsv1alpha1.PackageManifestScopeNamespaced) {
+		testCases = append(testCases, manifestsv1alpha1.PackageManifestTestCaseTemplate{
+			Name: "namespaced",
+			Context: manifestsv1alpha1.TemplateContext{
+				Package: manifestsv1alpha1.TemplateContextPackage{
+					TemplateContextObjectMeta: manifestsv1alpha1.TemplateContextObjectMeta{
+						Name:      "test",
+						Namespace: "test",
+					},
+				},
+			},
+		})
+	}
+	if slices.Contains(opts.Scopes, manifestsv1alpha1.PackageManifestScopeCluster) {
+		testCases = append(testCases, manifestsv1alpha1.PackageManifestTestCaseTemplate{
+			Name: "cluster",
+			Context: manifestsv1alpha1.TemplateContext{
+				Package: manifestsv1alpha1.TemplateContextPackage{
+					TemplateContextObjectMeta: manifestsv1alpha1.TemplateContextObjectMeta{
+						Name: "test",
+					},
+				},
+			},
+		})
+	}
+
+	manifest := &manifestsv1alpha1.PackageManifest{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PackageManifest",
+			APIVersion: "manifests.package-operator.run/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: pkgName,
+		},
+		Spec: manifestsv1alpha1.PackageManifestSpec{
+			Scopes: opts.Scopes,
+			Phases: phases,
+			Config: manifestsv1alpha1.PackageManifestSpecConfig{
+				OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"message": {
+							Type:        "string",
+							Description: "Message written into the example ConfigMap.",
+							Default:     &apiextensionsv1.JSON{Raw: []byte(`"Hello from package-operator!"`)},
+						},
+					},
+				},
+			},
+		},
+		Test: manifestsv1alpha1.PackageManifestTest{
+			Template: testCases,
+		},
+	}
+
+	b, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling PackageManifest YAML: %w", err)
+	}
+
+	lastPhase := opts.Phases[len(opts.Phases)-1]
+
+	return &packagetypes.RawPackage{
+		Files: packagetypes.Files{
+			packagetypes.PackageManifestFilename + ".yaml": b,
+			".gitignore": []byte(scaffoldGitignore),
+			filepath.Join(lastPhase, "example.configmap.yaml.gotmpl"): []byte(
+				fmt.Sprintf(scaffoldExampleObject, lastPhase)),
+		},
+	}, nil
+}
+
+func (o ScaffoldOptions) validate() error {
+	if len(o.Scopes) == 0 {
+		return ErrScaffoldMissingScopes
+	}
+	for _, scope := range o.Scopes {
+		if scope != manifestsv1alpha1.PackageManifestScopeCluster &&
+			scope != manifestsv1alpha1.PackageManifestScopeNamespaced {
+			return fmt.Errorf("%w: %q", ErrScaffoldInvalidScope, scope)
+		}
+	}
+
+	if len(o.Phases) == 0 {
+		return ErrScaffoldMissingPhases
+	}
+	seen := map[string]struct{}{}
+	for _, phase := range o.Phases {
+		if _, ok := seen[phase]; ok {
+			return fmt.Errorf("%w: %q", ErrScaffoldDuplicatePhase, phase)
+		}
+		seen[phase] = struct{}{}
+	}
+
+	return nil
+}
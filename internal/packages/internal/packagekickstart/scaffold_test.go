@@ -0,0 +1,72 @@
+package packagekickstart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+func TestScaffold(t *testing.T) {
+	t.Parallel()
+
+	rawPkg, err := Scaffold("my-pkg", ScaffoldOptions{
+		Scopes: []manifestsv1alpha1.PackageManifestScope{
+			manifestsv1alpha1.PackageManifestScopeNamespaced,
+		},
+		Phases: []string{"rbac", "deploy"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, rawPkg.Files, 3)
+	assert.NotEmpty(t, rawPkg.Files[".gitignore"])
+	assert.Contains(t, string(rawPkg.Files["deploy/example.configmap.yaml.gotmpl"]),
+		`package-operator.run/phase: "deploy"`)
+
+	manifest := &manifestsv1alpha1.PackageManifest{}
+	require.NoError(t, yaml.Unmarshal(rawPkg.Files["manifest.yaml"], manifest))
+	assert.Equal(t, "my-pkg", manifest.Name)
+	assert.Equal(t, []manifestsv1alpha1.PackageManifestPhase{
+		{Name: "rbac"}, {Name: "deploy"},
+	}, manifest.Spec.Phases)
+	if assert.Len(t, manifest.Test.Template, 1) {
+		assert.Equal(t, "namespaced", manifest.Test.Template[0].Name)
+	}
+}
+
+func TestScaffold_Invalid(t *testing.T) {
+	t.Parallel()
+
+	cluster := []manifestsv1alpha1.PackageManifestScope{manifestsv1alpha1.PackageManifestScopeCluster}
+
+	for name, tc := range map[string]struct {
+		opts ScaffoldOptions
+		err  error
+	}{
+		"no scopes": {
+			opts: ScaffoldOptions{Phases: []string{"deploy"}},
+			err:  ErrScaffoldMissingScopes,
+		},
+		"invalid scope": {
+			opts: ScaffoldOptions{Scopes: []manifestsv1alpha1.PackageManifestScope{"Galaxy"}, Phases: []string{"deploy"}},
+			err:  ErrScaffoldInvalidScope,
+		},
+		"no phases": {
+			opts: ScaffoldOptions{Scopes: cluster},
+			err:  ErrScaffoldMissingPhases,
+		},
+		"duplicate phase": {
+			opts: ScaffoldOptions{Scopes: cluster, Phases: []string{"deploy", "deploy"}},
+			err:  ErrScaffoldDuplicatePhase,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Scaffold("my-pkg", tc.opts)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -28,12 +28,17 @@ func AdmitPackageConfiguration(
 	ctx context.Context, configuration map[string]any,
 	manifest *manifests.PackageManifest, fldPath *field.Path,
 ) (field.ErrorList, error) {
+	var unknownFieldErrs field.ErrorList
+
 	if manifest.Spec.Config.OpenAPIV3Schema == nil {
 		// Prune all configuration fields
 		for k := range configuration {
 			delete(configuration, k)
+			if manifest.Spec.Rendering.Strict {
+				unknownFieldErrs = append(unknownFieldErrs, unknownConfigFieldError(fldPath, k))
+			}
 		}
-		return nil, nil
+		return unknownFieldErrs, nil
 	}
 
 	s, err := schema.NewStructural(manifest.Spec.Config.OpenAPIV3Schema)
@@ -42,7 +47,12 @@ func AdmitPackageConfiguration(
 	}
 
 	// remove fields not part of the schema.
-	pruning.Prune(configuration, s, true)
+	pruned := pruning.PruneWithOptions(configuration, s, true, schema.UnknownFieldPathOptions{
+		TrackUnknownFieldPaths: manifest.Spec.Rendering.Strict,
+	})
+	for _, p := range pruned {
+		unknownFieldErrs = append(unknownFieldErrs, unknownConfigFieldError(fldPath, p))
+	}
 
 	// inject default values from schema.
 	defaulting.Default(configuration, s)
@@ -53,5 +63,10 @@ func AdmitPackageConfiguration(
 	if err != nil {
 		return nil, err
 	}
-	return ferrs, nil
+	return append(unknownFieldErrs, ferrs...), nil
+}
+
+// Strict rendering reports fields that would otherwise be pruned silently.
+func unknownConfigFieldError(fldPath *field.Path, path string) *field.Error {
+	return field.Forbidden(fldPath.Child(path), "unknown field, not declared in the config schema")
 }
@@ -157,6 +157,39 @@ func TestAdmitPackageConfiguration_Prune(t *testing.T) {
 	require.Equal(t, expectedOutputConfig, inputCfg)
 }
 
+func TestAdmitPackageConfiguration_Strict(t *testing.T) {
+	t.Parallel()
+
+	for name, schema := range map[string]*apiextensions.JSONSchemaProps{
+		"schema": {
+			Type: OpenapiV3TypeObject,
+			Properties: map[string]apiextensions.JSONSchemaProps{
+				"chicken": {Type: "string"},
+			},
+		},
+		"no schema": nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inputCfg := map[string]any{"banana": "🍌"}
+			man := &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					Config:    manifests.PackageManifestSpecConfig{OpenAPIV3Schema: schema},
+					Rendering: manifests.PackageManifestRendering{Strict: true},
+				},
+			}
+			elist, err := AdmitPackageConfiguration(context.Background(), inputCfg, man, field.NewPath("spec", "config"))
+			require.NoError(t, err)
+			require.Len(t, elist, 1)
+			assert.Equal(t,
+				"spec.config.banana: Forbidden: unknown field, not declared in the config schema",
+				elist[0].Error())
+			assert.Empty(t, inputCfg)
+		})
+	}
+}
+
 func TestAdmitPackageConfiguration_Default(t *testing.T) {
 	t.Parallel()
 
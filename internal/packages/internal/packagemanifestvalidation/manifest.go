@@ -4,13 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"text/template"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/utils/strings/slices"
 	"pkg.package-operator.run/semver"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/apis/manifests"
 )
 
@@ -29,6 +35,9 @@ func ValidatePackageManifest(ctx context.Context, obj *manifests.PackageManifest
 			field.Required(spec.Child("scopes"), ""))
 	}
 
+	allErrs = append(allErrs, validateClusterScopedKinds(
+		spec.Child("clusterScopedKinds"), obj.Spec.Scopes, obj.Spec.ClusterScopedKinds)...)
+
 	if len(obj.Spec.Phases) == 0 {
 		allErrs = append(allErrs,
 			field.Required(spec.Child("phases"), ""))
@@ -43,6 +52,9 @@ func ValidatePackageManifest(ctx context.Context, obj *manifests.PackageManifest
 		phaseNames[phase.Name] = struct{}{}
 	}
 
+	allErrs = append(allErrs, validatePhaseDefaults(spec, obj.Spec, phaseNames)...)
+	allErrs = append(allErrs, validateNamespaces(spec.Child("namespaces"), obj.Spec)...)
+
 	specProbes := field.NewPath("spec").Child("availabilityProbes")
 	for i, probe := range obj.Spec.AvailabilityProbes {
 		if len(probe.Probes) == 0 {
@@ -51,6 +63,9 @@ func ValidatePackageManifest(ctx context.Context, obj *manifests.PackageManifest
 		}
 	}
 
+	allErrs = append(allErrs, validateConditionMappings(
+		field.NewPath("spec").Child("conditionMappings"), obj.Spec.ConditionMappings)...)
+
 	specImages := field.NewPath("spec").Child("images")
 	existingNames := []string{}
 	for i, image := range obj.Spec.Images {
@@ -76,6 +91,14 @@ func ValidatePackageManifest(ctx context.Context, obj *manifests.PackageManifest
 	allErrs = append(allErrs, validateConstraints(
 		field.NewPath("spec").Child("constraints"), obj.Spec.Constraints)...)
 
+	// Carry over
+	allErrs = append(allErrs, validateCarryOvers(
+		field.NewPath("spec").Child("carryOver"), obj.Spec.CarryOver)...)
+
+	// Monitoring
+	allErrs = append(allErrs, validateMonitoring(
+		field.NewPath("spec").Child("monitoring"), obj.Spec.Monitoring)...)
+
 	configErrors := validatePackageManifestConfig(ctx, &obj.Spec.Config, spec.Child("config"))
 	allErrs = append(allErrs, configErrors...)
 
@@ -114,6 +137,214 @@ func ValidatePackageManifest(ctx context.Context, obj *manifests.PackageManifest
 	return allErrs, nil
 }
 
+// Same as the validation pattern of ConditionMapping.DestinationType in the ObjectSet API.
+var conditionMappingDestinationTypeRegexp = regexp.MustCompile(
+	`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\/([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+
+func validateConditionMappings(
+	path *field.Path, mappings []manifests.PackageManifestConditionMapping,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, m := range mappings {
+		mpath := path.Index(i)
+		if m.Selector.Kind == nil {
+			allErrs = append(allErrs, field.Required(mpath.Child("selector").Child("kind"), ""))
+		}
+		if m.Selector.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(m.Selector.Selector); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(mpath.Child("selector").Child("selector"), m.Selector.Selector, err.Error()))
+			}
+		}
+		if len(m.SourceType) < 1 {
+			allErrs = append(allErrs, field.Required(mpath.Child("sourceType"), ""))
+		}
+		switch {
+		case len(m.DestinationType) < 1:
+			allErrs = append(allErrs, field.Required(mpath.Child("destinationType"), ""))
+		case !conditionMappingDestinationTypeRegexp.MatchString(m.DestinationType):
+			allErrs = append(allErrs, field.Invalid(mpath.Child("destinationType"), m.DestinationType,
+				"must be a prefixed condition type, e.g. my-prefix/Available"))
+		}
+		if _, err := template.New("").Parse(m.Message); err != nil {
+			allErrs = append(allErrs, field.Invalid(mpath.Child("message"), m.Message, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateClusterScopedKinds(
+	path *field.Path, scopes []manifests.PackageManifestScope, kinds []metav1.GroupKind,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(kinds) == 0 {
+		return allErrs
+	}
+
+	var namespaced bool
+	for _, scope := range scopes {
+		if scope == manifests.PackageManifestScopeNamespaced {
+			namespaced = true
+		}
+	}
+	if !namespaced {
+		allErrs = append(allErrs, field.Forbidden(path, "only allowed for packages supporting the Namespaced scope"))
+	}
+
+	existing := map[metav1.GroupKind]struct{}{}
+	for i, gk := range kinds {
+		if len(gk.Kind) < 1 {
+			allErrs = append(allErrs, field.Required(path.Index(i).Child("kind"), ""))
+			continue
+		}
+		if _, ok := existing[gk]; ok {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i), gk.String()))
+		}
+		existing[gk] = struct{}{}
+	}
+	return allErrs
+}
+
+func validatePhaseDefaults(
+	spec *field.Path, manifestSpec manifests.PackageManifestSpec, phaseNames map[string]struct{},
+) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(manifestSpec.DefaultPhase) > 0 {
+		if _, ok := phaseNames[manifestSpec.DefaultPhase]; !ok {
+			allErrs = append(allErrs, field.NotFound(spec.Child("defaultPhase"), manifestSpec.DefaultPhase))
+		}
+	}
+
+	existingDirectories := []string{}
+	for i, dp := range manifestSpec.DirectoryPhases {
+		dpath := spec.Child("directoryPhases").Index(i)
+		dir := filepath.Clean(dp.Directory)
+		switch {
+		case len(dp.Directory) < 1:
+			allErrs = append(allErrs, field.Required(dpath.Child("directory"), ""))
+		case filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, "../"):
+			allErrs = append(allErrs, field.Invalid(dpath.Child("directory"), dp.Directory,
+				"must be relative to the package root"))
+		case slices.Contains(existingDirectories, dir):
+			allErrs = append(allErrs, field.Invalid(dpath.Child("directory"), dp.Directory, "must be unique"))
+		default:
+			existingDirectories = append(existingDirectories, dir)
+		}
+
+		if len(dp.Phase) < 1 {
+			allErrs = append(allErrs, field.Required(dpath.Child("phase"), ""))
+		} else if _, ok := phaseNames[dp.Phase]; !ok {
+			allErrs = append(allErrs, field.NotFound(dpath.Child("phase"), dp.Phase))
+		}
+	}
+	return allErrs
+}
+
+func validateNamespaces(path *field.Path, spec manifests.PackageManifestSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(spec.Namespaces) == 0 {
+		return allErrs
+	}
+
+	var cluster bool
+	for _, scope := range spec.Scopes {
+		if scope == manifests.PackageManifestScopeCluster {
+			cluster = true
+		}
+	}
+	if !cluster {
+		allErrs = append(allErrs, field.Forbidden(path, "only allowed for packages supporting the Cluster scope"))
+	}
+	for i, phase := range spec.Phases {
+		if phase.Name == manifests.PackageNamespacesPhase {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("phases").Index(i).Child("name"),
+				phase.Name, "is reserved for spec.namespaces"))
+		}
+	}
+
+	existingNames := []string{}
+	for i, ns := range spec.Namespaces {
+		npath := path.Index(i)
+		switch {
+		case len(ns.Name) < 1:
+			allErrs = append(allErrs, field.Required(npath.Child("name"), ""))
+		case slices.Contains(existingNames, ns.Name):
+			allErrs = append(allErrs, field.Invalid(npath.Child("name"), ns.Name, "must be unique"))
+		default:
+			existingNames = append(existingNames, ns.Name)
+			if _, err := template.New("").Parse(ns.Name); err != nil {
+				allErrs = append(allErrs, field.Invalid(npath.Child("name"), ns.Name, err.Error()))
+			}
+		}
+		for k, v := range ns.Labels {
+			if _, err := template.New("").Parse(v); err != nil {
+				allErrs = append(allErrs, field.Invalid(npath.Child("labels").Key(k), v, err.Error()))
+			}
+		}
+		for k, v := range ns.Annotations {
+			if _, err := template.New("").Parse(v); err != nil {
+				allErrs = append(allErrs, field.Invalid(npath.Child("annotations").Key(k), v, err.Error()))
+			}
+		}
+		switch ns.CleanupPolicy {
+		case "", corev1alpha1.ObjectDeletionPolicyDelete, corev1alpha1.ObjectDeletionPolicyOrphan:
+		default:
+			allErrs = append(allErrs, field.NotSupported(npath.Child("cleanupPolicy"), ns.CleanupPolicy,
+				[]corev1alpha1.ObjectDeletionPolicy{
+					corev1alpha1.ObjectDeletionPolicyDelete, corev1alpha1.ObjectDeletionPolicyOrphan,
+				}))
+		}
+	}
+	return allErrs
+}
+
+func validateCarryOvers(path *field.Path, carryOvers []manifests.PackageManifestCarryOver) field.ErrorList {
+	var allErrs field.ErrorList
+	existingNames := []string{}
+	for i, co := range carryOvers {
+		cpath := path.Index(i)
+		switch {
+		case len(co.Name) < 1:
+			allErrs = append(allErrs, field.Required(cpath.Child("name"), ""))
+		case slices.Contains(existingNames, co.Name):
+			allErrs = append(allErrs, field.Invalid(cpath.Child("name"), co.Name, "must be unique"))
+		default:
+			existingNames = append(existingNames, co.Name)
+		}
+
+		if len(co.APIVersion) < 1 {
+			allErrs = append(allErrs, field.Required(cpath.Child("apiVersion"), ""))
+		}
+		if len(co.Kind) < 1 {
+			allErrs = append(allErrs, field.Required(cpath.Child("kind"), ""))
+		}
+		if len(co.ObjectName) < 1 {
+			allErrs = append(allErrs, field.Required(cpath.Child("objectName"), ""))
+		}
+		if len(co.Path) < 1 {
+			allErrs = append(allErrs, field.Required(cpath.Child("path"), ""))
+		} else if err := jsonpath.New("").Parse(co.Path); err != nil {
+			allErrs = append(allErrs, field.Invalid(cpath.Child("path"), co.Path, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateMonitoring(path *field.Path, monitoring *manifests.PackageManifestMonitoring) field.ErrorList {
+	if monitoring == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if len(monitoring.Port) < 1 {
+		allErrs = append(allErrs, field.Required(path.Child("port"), "name of the port serving metrics"))
+	}
+	if len(monitoring.Path) > 0 && !strings.HasPrefix(monitoring.Path, "/") {
+		allErrs = append(allErrs, field.Invalid(path.Child("path"), monitoring.Path, "must start with /"))
+	}
+	return allErrs
+}
+
 func validateConstraints(path *field.Path, constraints []manifests.PackageManifestConstraint) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, constraint := range constraints {
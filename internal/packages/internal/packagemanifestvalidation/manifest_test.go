@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	"package-operator.run/internal/apis/manifests"
 )
 
@@ -91,6 +93,143 @@ func TestValidatePackageManifest(t *testing.T) {
 				"test.template[0].context.config.banana: Required value",
 			},
 		},
+		{
+			name: "invalid condition mapping",
+			packageManifest: &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					ConditionMappings: []manifests.PackageManifestConditionMapping{
+						{
+							Selector: corev1alpha1.ProbeSelector{
+								Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "Deployment"},
+							},
+							SourceType:      "Available",
+							DestinationType: "my-prefix/Available",
+							Message:         "{{.object.metadata.name}}: {{.condition.message}}",
+						},
+						{
+							DestinationType: "Available",
+							Message:         "{{.condition.message",
+						},
+					},
+				},
+			},
+			expectedErrors: []string{
+				"metadata.name: Required value",
+				"spec.scopes: Required value",
+				"spec.phases: Required value",
+				"spec.conditionMappings[1].selector.kind: Required value",
+				"spec.conditionMappings[1].sourceType: Required value",
+				`spec.conditionMappings[1].destinationType: Invalid value: "Available": ` +
+					"must be a prefixed condition type, e.g. my-prefix/Available",
+				`spec.conditionMappings[1].message: Invalid value: "{{.condition.message": ` +
+					"template: :1: unclosed action",
+			},
+		},
+		{
+			name: "invalid carry over",
+			packageManifest: &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					CarryOver: []manifests.PackageManifestCarryOver{
+						{
+							Name: "password", APIVersion: "v1", Kind: "Secret",
+							ObjectName: "credentials", Path: "{.data.password}",
+						},
+						{Name: "password"},
+					},
+				},
+			},
+			expectedErrors: []string{
+				"metadata.name: Required value",
+				"spec.scopes: Required value",
+				"spec.phases: Required value",
+				`spec.carryOver[1].name: Invalid value: "password": must be unique`,
+				"spec.carryOver[1].apiVersion: Required value",
+				"spec.carryOver[1].kind: Required value",
+				"spec.carryOver[1].objectName: Required value",
+				"spec.carryOver[1].path: Required value",
+			},
+		},
+		{
+			name: "invalid monitoring",
+			packageManifest: &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					Monitoring: &manifests.PackageManifestMonitoring{Path: "metrics"},
+				},
+			},
+			expectedErrors: []string{
+				"metadata.name: Required value",
+				"spec.scopes: Required value",
+				"spec.phases: Required value",
+				"spec.monitoring.port: Required value: name of the port serving metrics",
+				`spec.monitoring.path: Invalid value: "metrics": must start with /`,
+			},
+		},
+		{
+			name: "invalid phase defaults",
+			packageManifest: &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					Phases:       []manifests.PackageManifestPhase{{Name: "crds"}, {Name: "deploy"}},
+					DefaultPhase: "missing",
+					DirectoryPhases: []manifests.PackageManifestDirectoryPhase{
+						{Directory: "crds", Phase: "crds"},
+						{Directory: "crds/", Phase: "deploy"},
+						{Directory: "../other", Phase: "deploy"},
+						{Phase: "missing"},
+					},
+				},
+			},
+			expectedErrors: []string{
+				"metadata.name: Required value",
+				"spec.scopes: Required value",
+				`spec.defaultPhase: Not found: "missing"`,
+				`spec.directoryPhases[1].directory: Invalid value: "crds/": must be unique`,
+				`spec.directoryPhases[2].directory: Invalid value: "../other": must be relative to the package root`,
+				"spec.directoryPhases[3].directory: Required value",
+				`spec.directoryPhases[3].phase: Not found: "missing"`,
+			},
+		},
+		{
+			name: "invalid cluster scoped kinds",
+			packageManifest: &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					Scopes: []manifests.PackageManifestScope{manifests.PackageManifestScopeCluster},
+					ClusterScopedKinds: []metav1.GroupKind{
+						{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+						{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+						{Group: "rbac.authorization.k8s.io"},
+					},
+				},
+			},
+			expectedErrors: []string{
+				"metadata.name: Required value",
+				"spec.clusterScopedKinds: Forbidden: only allowed for packages supporting the Namespaced scope",
+				`spec.clusterScopedKinds[1]: Duplicate value: "ClusterRole.rbac.authorization.k8s.io"`,
+				"spec.clusterScopedKinds[2].kind: Required value",
+				"spec.phases: Required value",
+			},
+		},
+		{
+			name: "invalid namespaces",
+			packageManifest: &manifests.PackageManifest{
+				Spec: manifests.PackageManifestSpec{
+					Scopes: []manifests.PackageManifestScope{manifests.PackageManifestScopeNamespaced},
+					Phases: []manifests.PackageManifestPhase{{Name: manifests.PackageNamespacesPhase}},
+					Namespaces: []manifests.PackageManifestNamespace{
+						{Name: "test", CleanupPolicy: "Keep"},
+						{Name: "test"},
+						{},
+					},
+				},
+			},
+			expectedErrors: []string{
+				"metadata.name: Required value",
+				"spec.namespaces: Forbidden: only allowed for packages supporting the Cluster scope",
+				`spec.phases[0].name: Invalid value: "namespaces": is reserved for spec.namespaces`,
+				`spec.namespaces[0].cleanupPolicy: Unsupported value: "Keep": supported values: "Delete", "Orphan"`,
+				`spec.namespaces[1].name: Invalid value: "test": must be unique`,
+				"spec.namespaces[2].name: Required value",
+			},
+		},
 		{
 			name: "empty image",
 			packageManifest: &manifests.PackageManifest{
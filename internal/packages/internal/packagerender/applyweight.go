@@ -0,0 +1,24 @@
+package packagerender
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+// ApplyWeightFromAnnotations reads the weight ordering the object within its phase from its annotations.
+// Returns 0 for objects without the annotation.
+func ApplyWeightFromAnnotations(obj *unstructured.Unstructured) (int32, error) {
+	v, ok := obj.GetAnnotations()[manifestsv1alpha1.PackageApplyWeightAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	weight, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", manifestsv1alpha1.PackageApplyWeightAnnotation, err)
+	}
+	return int32(weight), nil
+}
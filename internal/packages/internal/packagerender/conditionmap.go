@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
 )
 
 type conditionMapParseError struct {
@@ -19,6 +22,52 @@ func (e conditionMapParseError) Error() string {
 	return e.Message + fmt.Sprintf(" in line %d", e.LineNumber)
 }
 
+// Returns the condition mappings of the object from its annotation
+// and all PackageManifest condition mappings selecting it.
+func conditionMappingsForObject(
+	obj *unstructured.Unstructured, manifestMappings []manifests.PackageManifestConditionMapping,
+) ([]corev1alpha1.ConditionMapping, error) {
+	mappings, err := parseConditionMapAnnotation(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range manifestMappings {
+		matches, err := selectorMatches(m.Selector, obj)
+		if err != nil {
+			return nil, fmt.Errorf("condition mapping %s: %w", m.DestinationType, err)
+		}
+		if !matches {
+			continue
+		}
+		mappings = append(mappings, corev1alpha1.ConditionMapping{
+			SourceType:      m.SourceType,
+			DestinationType: m.DestinationType,
+			Message:         m.Message,
+		})
+	}
+	return mappings, nil
+}
+
+func selectorMatches(selector corev1alpha1.ProbeSelector, obj *unstructured.Unstructured) (bool, error) {
+	if selector.Kind != nil {
+		gk := obj.GroupVersionKind().GroupKind()
+		if gk.Group != selector.Kind.Group || gk.Kind != selector.Kind.Kind {
+			return false, nil
+		}
+	}
+	if selector.Selector != nil {
+		s, err := metav1.LabelSelectorAsSelector(selector.Selector)
+		if err != nil {
+			return false, err
+		}
+		if !s.Matches(labels.Set(obj.GetLabels())) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func parseConditionMapAnnotation(obj *unstructured.Unstructured) ([]corev1alpha1.ConditionMapping, error) {
 	conditionMapAnnotation, ok := obj.GetAnnotations()[manifestsv1alpha1.PackageConditionMapAnnotation]
 	if !ok {
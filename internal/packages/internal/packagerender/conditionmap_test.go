@@ -5,10 +5,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
 )
 
 func Test_parseConditionMap(t *testing.T) {
@@ -106,3 +108,58 @@ func TestParseConditionMap_error(t *testing.T) {
 		})
 	}
 }
+
+func Test_conditionMappingsForObject(t *testing.T) {
+	t.Parallel()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+	obj.SetLabels(map[string]string{"app": "db"})
+	obj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackageConditionMapAnnotation: "Progressing => my-prefix/Progressing",
+	})
+
+	mappings, err := conditionMappingsForObject(obj, []manifests.PackageManifestConditionMapping{
+		{
+			Selector: corev1alpha1.ProbeSelector{
+				Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "Deployment"},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+			SourceType:      "Available",
+			DestinationType: "my-prefix/DatabaseAvailable",
+			Message:         "{{.condition.message}}",
+		},
+		{
+			Selector: corev1alpha1.ProbeSelector{
+				Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "StatefulSet"},
+			},
+			SourceType:      "Available",
+			DestinationType: "my-prefix/StatefulSetAvailable",
+		},
+		{
+			Selector: corev1alpha1.ProbeSelector{
+				Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "Deployment"},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "web"},
+				},
+			},
+			SourceType:      "Available",
+			DestinationType: "my-prefix/WebAvailable",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []corev1alpha1.ConditionMapping{
+		{
+			SourceType:      "Progressing",
+			DestinationType: "my-prefix/Progressing",
+		},
+		{
+			SourceType:      "Available",
+			DestinationType: "my-prefix/DatabaseAvailable",
+			Message:         "{{.condition.message}}",
+		},
+	}, mappings)
+}
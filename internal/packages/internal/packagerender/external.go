@@ -0,0 +1,78 @@
+package packagerender
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+var (
+	ErrExternalAnnotationMissing = errors.New(
+		"requires " + manifestsv1alpha1.PackageExternalAnnotation + ": \"True\"")
+	ErrExternalMissingPolicyInvalid = errors.New("must be one of Block, Warn")
+)
+
+var externalAnnotations = []string{
+	manifestsv1alpha1.PackageExternalAnnotation,
+	manifestsv1alpha1.PackageExternalTimeoutAnnotation,
+	manifestsv1alpha1.PackageExternalMissingPolicyAnnotation,
+	manifestsv1alpha1.PackageExternalProbesAnnotation,
+}
+
+// ExternalFromAnnotations reads the external object settings from the annotations of the given object.
+// Returns false for objects that are not marked as external.
+func ExternalFromAnnotations(
+	obj *unstructured.Unstructured,
+) (*corev1alpha1.ObjectSetObjectExternal, bool, error) {
+	annotations := obj.GetAnnotations()
+
+	var isExternal bool
+	if v, ok := annotations[manifestsv1alpha1.PackageExternalAnnotation]; ok {
+		var err error
+		if isExternal, err = strconv.ParseBool(v); err != nil {
+			return nil, false, fmt.Errorf("%s: %w", manifestsv1alpha1.PackageExternalAnnotation, err)
+		}
+	}
+	if !isExternal {
+		for _, a := range externalAnnotations[1:] {
+			if _, ok := annotations[a]; ok {
+				return nil, false, fmt.Errorf("%s: %w", a, ErrExternalAnnotationMissing)
+			}
+		}
+		return nil, false, nil
+	}
+
+	external := &corev1alpha1.ObjectSetObjectExternal{
+		MissingPolicy: corev1alpha1.ExternalObjectMissingPolicyBlock,
+	}
+	if v, ok := annotations[manifestsv1alpha1.PackageExternalTimeoutAnnotation]; ok {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, false, fmt.Errorf("%s: %w", manifestsv1alpha1.PackageExternalTimeoutAnnotation, err)
+		}
+		external.Timeout = &metav1.Duration{Duration: timeout}
+	}
+	if v, ok := annotations[manifestsv1alpha1.PackageExternalMissingPolicyAnnotation]; ok {
+		switch policy := corev1alpha1.ExternalObjectMissingPolicy(v); policy {
+		case corev1alpha1.ExternalObjectMissingPolicyBlock, corev1alpha1.ExternalObjectMissingPolicyWarn:
+			external.MissingPolicy = policy
+		default:
+			return nil, false, fmt.Errorf("%s: %w", manifestsv1alpha1.PackageExternalMissingPolicyAnnotation,
+				ErrExternalMissingPolicyInvalid)
+		}
+	}
+	if v, ok := annotations[manifestsv1alpha1.PackageExternalProbesAnnotation]; ok {
+		if err := yaml.UnmarshalStrict([]byte(v), &external.Probes); err != nil {
+			return nil, false, fmt.Errorf("%s: %w", manifestsv1alpha1.PackageExternalProbesAnnotation, err)
+		}
+	}
+	return external, true, nil
+}
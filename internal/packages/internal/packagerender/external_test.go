@@ -0,0 +1,90 @@
+package packagerender
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+)
+
+func TestExternalFromAnnotations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *corev1alpha1.ObjectSetObjectExternal
+		expectedErr error
+	}{
+		{
+			name: "not external",
+		},
+		{
+			name: "defaults",
+			annotations: map[string]string{
+				manifestsv1alpha1.PackageExternalAnnotation: "True",
+			},
+			expected: &corev1alpha1.ObjectSetObjectExternal{
+				MissingPolicy: corev1alpha1.ExternalObjectMissingPolicyBlock,
+			},
+		},
+		{
+			name: "all options",
+			annotations: map[string]string{
+				manifestsv1alpha1.PackageExternalAnnotation:              "True",
+				manifestsv1alpha1.PackageExternalTimeoutAnnotation:       "10m",
+				manifestsv1alpha1.PackageExternalMissingPolicyAnnotation: "Warn",
+				manifestsv1alpha1.PackageExternalProbesAnnotation: `- condition:
+    type: Ready
+    status: "True"`,
+			},
+			expected: &corev1alpha1.ObjectSetObjectExternal{
+				Timeout:       &metav1.Duration{Duration: 10 * time.Minute},
+				MissingPolicy: corev1alpha1.ExternalObjectMissingPolicyWarn,
+				Probes: []corev1alpha1.Probe{{
+					Condition: &corev1alpha1.ProbeConditionSpec{Type: "Ready", Status: "True"},
+				}},
+			},
+		},
+		{
+			name: "options without external",
+			annotations: map[string]string{
+				manifestsv1alpha1.PackageExternalTimeoutAnnotation: "10m",
+			},
+			expectedErr: ErrExternalAnnotationMissing,
+		},
+		{
+			name: "invalid missing policy",
+			annotations: map[string]string{
+				manifestsv1alpha1.PackageExternalAnnotation:              "True",
+				manifestsv1alpha1.PackageExternalMissingPolicyAnnotation: "Ignore",
+			},
+			expectedErr: ErrExternalMissingPolicyInvalid,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := &unstructured.Unstructured{}
+			obj.SetAnnotations(test.annotations)
+
+			external, ok, err := ExternalFromAnnotations(obj)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected != nil, ok)
+			assert.Equal(t, test.expected, external)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package packagerender
+
+import (
+	"sort"
+	"text/template"
+
+	"package-operator.run/internal/packages/internal/packagetypes"
+	"package-operator.run/internal/transform"
+)
+
+// Name of the template function evaluating CEL expressions.
+const celTemplateFunctionName = "cel"
+
+// Functions available in all package templates.
+// The cel function is added separately, because it depends on the render context.
+func templateFuncMaps(templ *template.Template, files packagetypes.Files) []template.FuncMap {
+	return []template.FuncMap{
+		transform.SprigFuncs(templ),
+		transform.FileFuncs(files),
+	}
+}
+
+// TemplateFunction describes a function available in package templates.
+type TemplateFunction struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+const sprigFunctionDescription = "Sprig function, see https://masterminds.github.io/sprig/"
+
+// Descriptions of the functions Package Operator adds on top of Sprig.
+var templateFunctionDescriptions = map[string]string{
+	"include": "Executes the named template with the given data and returns the result as string, " +
+		"so it can be piped into other functions. Named templates can be defined in any template, " +
+		"in files of the _helpers folder or in template files prefixed with an underscore, " +
+		"e.g. templates/_labels.gotmpl. Helper files are not rendered themselves.",
+	"b64decMap":     "Decodes all base64 encoded string values of a map.",
+	"toYAML":        "Marshals the given value into YAML.",
+	"fromYAML":      "Unmarshals the given YAML string or bytes.",
+	"toYaml":        "Alias of toYAML, as used by Helm.",
+	"fromYaml":      "Alias of fromYAML, as used by Helm.",
+	"fromYamlArray": "Unmarshals the given YAML string or bytes, which must contain an array.",
+	"truncateName": "Shortens a name to at most the given number of characters, " +
+		"replacing the cut off part with a hash of the full name so shortened names stay unique, " +
+		`e.g. {{ printf "%s-%s" .package.metadata.name .package.configHash | truncateName 63 }}.`,
+	"checksum": "Returns the SHA256 checksum of any value, only changing with the value, " +
+		"e.g. as pod template annotation rolling pods when the configuration they consume changes: " +
+		"checksum/database: {{ checksum .config.database }}. " +
+		"Checksums of top-level configuration keys are also available via .package.configChecksums.",
+	"getFile":     "Returns the content of the package file at the given path.",
+	"getFileGlob": "Returns a map of path to content of all package files matching the given glob pattern.",
+	celTemplateFunctionName: "Evaluates a boolean CEL expression with access to the template context " +
+		"and the named conditions of the PackageManifest.",
+}
+
+// TemplateFunctions lists all functions available in package templates, sorted by name.
+func TemplateFunctions() []TemplateFunction {
+	names := map[string]struct{}{celTemplateFunctionName: {}}
+	for _, funcs := range templateFuncMaps(template.New(""), nil) {
+		for name := range funcs {
+			names[name] = struct{}{}
+		}
+	}
+
+	functions := make([]TemplateFunction, 0, len(names))
+	for name := range names {
+		desc, ok := templateFunctionDescriptions[name]
+		if !ok {
+			desc = sprigFunctionDescription
+		}
+		functions = append(functions, TemplateFunction{Name: name, Description: desc})
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+
+	return functions
+}
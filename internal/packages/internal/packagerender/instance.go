@@ -21,14 +21,19 @@ func RenderPackageInstance(
 	if err := RenderTemplates(ctx, pkg, tmplCtx); err != nil {
 		return nil, err
 	}
+	namespaces, err := RenderNamespaces(pkg, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
 	objects, err := RenderObjectsWithFilter(ctx, pkg, tmplCtx, objValidator)
 	if err != nil {
 		return nil, err
 	}
+	objects = RenderMonitoring(pkg, tmplCtx, objects)
 	pkgInst := &packagetypes.PackageInstance{
 		Manifest:     pkg.Manifest,
 		ManifestLock: pkg.ManifestLock,
-		Objects:      objects,
+		Objects:      append(namespaces, objects...),
 	}
 	return pkgInst, nil
 }
@@ -0,0 +1,158 @@
+package packagerender
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// Path metrics are served at, when the PackageManifest does not specify one.
+const defaultMetricsPath = "/metrics"
+
+// Annotations understood by the commonly used Prometheus scrape configs.
+const (
+	prometheusScrapeAnnotation = "prometheus.io/scrape"
+	prometheusPortAnnotation   = "prometheus.io/port"
+	prometheusPathAnnotation   = "prometheus.io/path"
+)
+
+var (
+	deploymentGK     = schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	serviceGK        = schema.GroupKind{Kind: "Service"}
+	serviceMonitorGV = schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
+)
+
+// Adds Prometheus scrape annotations to the Deployments and Services exposing the metrics port
+// declared in the PackageManifest and generates ServiceMonitors for these Services,
+// when requested and the Prometheus Operator API is present.
+// Annotations set by the package itself take precedence.
+func RenderMonitoring(
+	pkg *packagetypes.Package, tmplCtx packagetypes.PackageRenderContext,
+	objects []unstructured.Unstructured,
+) []unstructured.Unstructured {
+	monitoring := pkg.Manifest.Spec.Monitoring
+	if monitoring == nil {
+		return objects
+	}
+	path := monitoring.Path
+	if len(path) == 0 {
+		path = defaultMetricsPath
+	}
+	generateServiceMonitors := monitoring.ServiceMonitors && tmplCtx.Environment.PrometheusOperator != nil
+
+	var serviceMonitors []unstructured.Unstructured
+	for i := range objects {
+		obj := &objects[i]
+		switch obj.GroupVersionKind().GroupKind() {
+		case deploymentGK:
+			annotateMetricsDeployment(obj, monitoring.Port, path)
+		case serviceGK:
+			if annotateMetricsService(obj, monitoring.Port, path) && generateServiceMonitors {
+				serviceMonitors = append(serviceMonitors, renderServiceMonitor(obj, monitoring, path))
+			}
+		}
+	}
+	return append(objects, serviceMonitors...)
+}
+
+// Annotates the Pod template of a Deployment with a container port of the given name.
+func annotateMetricsDeployment(obj *unstructured.Unstructured, portName, path string) {
+	containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	for _, container := range containers {
+		c, ok := container.(map[string]any)
+		if !ok {
+			continue
+		}
+		port, ok := namedPort(c["ports"], portName, "containerPort")
+		if !ok {
+			continue
+		}
+
+		annotations, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "annotations")
+		_ = unstructured.SetNestedStringMap(obj.Object,
+			labels.Merge(scrapeAnnotations(port, path), annotations),
+			"spec", "template", "metadata", "annotations")
+		return
+	}
+}
+
+// Annotates and labels a Service with a port of the given name.
+// Returns true when the Service exposes the port.
+func annotateMetricsService(obj *unstructured.Unstructured, portName, path string) bool {
+	ports, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "ports")
+	port, ok := namedPort(ports, portName, "port")
+	if !ok {
+		return false
+	}
+
+	obj.SetAnnotations(labels.Merge(scrapeAnnotations(port, path), obj.GetAnnotations()))
+	obj.SetLabels(labels.Merge(obj.GetLabels(), map[string]string{
+		manifestsv1alpha1.PackageMetricsServiceLabel: obj.GetName(),
+	}))
+	return true
+}
+
+// Renders a ServiceMonitor scraping the metrics port of the given Service,
+// reconciled in the same phase as the Service.
+func renderServiceMonitor(
+	svc *unstructured.Unstructured, monitoring *manifests.PackageManifestMonitoring, path string,
+) unstructured.Unstructured {
+	sm := unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"selector": map[string]any{
+				"matchLabels": map[string]any{
+					manifestsv1alpha1.PackageMetricsServiceLabel: svc.GetName(),
+				},
+			},
+			"endpoints": []any{
+				map[string]any{
+					"port": monitoring.Port,
+					"path": path,
+				},
+			},
+		},
+	}}
+	sm.SetGroupVersionKind(serviceMonitorGV.WithKind("ServiceMonitor"))
+	sm.SetName(svc.GetName())
+	sm.SetNamespace(svc.GetNamespace())
+	sm.SetLabels(svc.GetLabels())
+	if phase, ok := svc.GetAnnotations()[manifestsv1alpha1.PackagePhaseAnnotation]; ok {
+		sm.SetAnnotations(map[string]string{manifestsv1alpha1.PackagePhaseAnnotation: phase})
+	}
+	return sm
+}
+
+// Returns the number of the port with the given name from a list of container or Service ports.
+func namedPort(ports any, name, numberField string) (string, bool) {
+	list, ok := ports.([]any)
+	if !ok {
+		return "", false
+	}
+	for _, p := range list {
+		port, ok := p.(map[string]any)
+		if !ok || port["name"] != name {
+			continue
+		}
+		switch number := port[numberField].(type) {
+		case int64:
+			return strconv.FormatInt(number, 10), true
+		case float64:
+			return strconv.FormatInt(int64(number), 10), true
+		}
+	}
+	return "", false
+}
+
+func scrapeAnnotations(port, path string) map[string]string {
+	return map[string]string{
+		prometheusScrapeAnnotation: "true",
+		prometheusPortAnnotation:   port,
+		prometheusPathAnnotation:   path,
+	}
+}
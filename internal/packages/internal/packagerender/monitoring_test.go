@@ -0,0 +1,133 @@
+package packagerender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+const monitoringTestObjects = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: operator
+spec:
+  template:
+    metadata:
+      annotations:
+        prometheus.io/path: /custom
+    spec:
+      containers:
+      - name: operator
+        ports:
+        - name: metrics
+          containerPort: 8080
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: operator-metrics
+  namespace: operator-system
+  annotations:
+    package-operator.run/phase: deploy
+spec:
+  ports:
+  - name: metrics
+    port: 8443
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: operator-webhook
+spec:
+  ports:
+  - name: https
+    port: 443
+`
+
+func TestRenderMonitoring(t *testing.T) {
+	t.Parallel()
+
+	newObjects := func(t *testing.T) []unstructured.Unstructured {
+		t.Helper()
+		var objects []unstructured.Unstructured
+		for _, doc := range packagetypes.SplitYAMLDocuments([]byte(monitoringTestObjects)) {
+			obj := unstructured.Unstructured{}
+			require.NoError(t, yaml.Unmarshal(doc, &obj))
+			objects = append(objects, obj)
+		}
+		return objects
+	}
+	pkg := &packagetypes.Package{
+		Manifest: &manifests.PackageManifest{
+			Spec: manifests.PackageManifestSpec{
+				Monitoring: &manifests.PackageManifestMonitoring{
+					Port:            "metrics",
+					ServiceMonitors: true,
+				},
+			},
+		},
+	}
+
+	t.Run("without Prometheus Operator", func(t *testing.T) {
+		t.Parallel()
+
+		objects := RenderMonitoring(pkg, packagetypes.PackageRenderContext{}, newObjects(t))
+		require.Len(t, objects, 3)
+
+		annotations, _, _ := unstructured.NestedStringMap(
+			objects[0].Object, "spec", "template", "metadata", "annotations")
+		assert.Equal(t, map[string]string{
+			"prometheus.io/scrape": "true",
+			"prometheus.io/port":   "8080",
+			"prometheus.io/path":   "/custom",
+		}, annotations)
+
+		assert.Equal(t, map[string]string{
+			manifestsv1alpha1.PackagePhaseAnnotation: "deploy",
+			"prometheus.io/scrape":                   "true",
+			"prometheus.io/port":                     "8443",
+			"prometheus.io/path":                     "/metrics",
+		}, objects[1].GetAnnotations())
+		assert.Equal(t, map[string]string{
+			manifestsv1alpha1.PackageMetricsServiceLabel: "operator-metrics",
+		}, objects[1].GetLabels())
+
+		assert.Empty(t, objects[2].GetAnnotations())
+		assert.Empty(t, objects[2].GetLabels())
+	})
+
+	t.Run("with Prometheus Operator", func(t *testing.T) {
+		t.Parallel()
+
+		tmplCtx := packagetypes.PackageRenderContext{
+			Environment: manifests.PackageEnvironment{
+				PrometheusOperator: &manifests.PackageEnvironmentPrometheusOperator{},
+			},
+		}
+		objects := RenderMonitoring(pkg, tmplCtx, newObjects(t))
+		require.Len(t, objects, 4)
+
+		sm := objects[3]
+		assert.Equal(t, "monitoring.coreos.com/v1", sm.GetAPIVersion())
+		assert.Equal(t, "ServiceMonitor", sm.GetKind())
+		assert.Equal(t, "operator-metrics", sm.GetName())
+		assert.Equal(t, "operator-system", sm.GetNamespace())
+		assert.Equal(t, map[string]string{
+			manifestsv1alpha1.PackagePhaseAnnotation: "deploy",
+		}, sm.GetAnnotations())
+		selector, _, _ := unstructured.NestedStringMap(sm.Object, "spec", "selector", "matchLabels")
+		assert.Equal(t, map[string]string{
+			manifestsv1alpha1.PackageMetricsServiceLabel: "operator-metrics",
+		}, selector)
+		endpoints, _, _ := unstructured.NestedSlice(sm.Object, "spec", "endpoints")
+		assert.Equal(t, []any{map[string]any{"port": "metrics", "path": "/metrics"}}, endpoints)
+	})
+}
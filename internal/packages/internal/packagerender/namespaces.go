@@ -0,0 +1,103 @@
+package packagerender
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// Renders the namespaces declared in the PackageManifest into Namespace objects
+// assigned to the reserved namespaces phase.
+// Namespaces are only rendered when the package is installed into the Cluster scope.
+func RenderNamespaces(
+	pkg *packagetypes.Package, tmplCtx packagetypes.PackageRenderContext,
+) ([]unstructured.Unstructured, error) {
+	if len(pkg.Manifest.Spec.Namespaces) == 0 || len(tmplCtx.Package.Namespace) != 0 {
+		return nil, nil
+	}
+
+	tctx, err := templateContext(tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]unstructured.Unstructured, 0, len(pkg.Manifest.Spec.Namespaces))
+	for i, ns := range pkg.Manifest.Spec.Namespaces {
+		obj, err := renderNamespace(pkg, tctx, ns)
+		if err != nil {
+			return nil, fmt.Errorf("spec.namespaces[%d]: %w", i, err)
+		}
+		obj.SetLabels(labels.Merge(obj.GetLabels(), commonLabels(pkg.Manifest, tmplCtx.Package.Name)))
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+func renderNamespace(
+	pkg *packagetypes.Package, tctx map[string]any, ns manifests.PackageManifestNamespace,
+) (unstructured.Unstructured, error) {
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Namespace")
+
+	name, err := executeNamespaceTemplate(pkg, tctx, "name", ns.Name)
+	if err != nil {
+		return obj, err
+	}
+	obj.SetName(name)
+
+	nsLabels := make(map[string]string, len(ns.Labels))
+	for k, v := range ns.Labels {
+		if nsLabels[k], err = executeNamespaceTemplate(pkg, tctx, "labels."+k, v); err != nil {
+			return obj, err
+		}
+	}
+	obj.SetLabels(nsLabels)
+
+	annotations := map[string]string{
+		manifestsv1alpha1.PackagePhaseAnnotation: manifests.PackageNamespacesPhase,
+	}
+	for k, v := range ns.Annotations {
+		if annotations[k], err = executeNamespaceTemplate(pkg, tctx, "annotations."+k, v); err != nil {
+			return obj, err
+		}
+	}
+	if ns.CleanupPolicy == corev1alpha1.ObjectDeletionPolicyOrphan {
+		annotations[manifestsv1alpha1.PackageDeletionPolicyAnnotation] = string(ns.CleanupPolicy)
+	}
+	obj.SetAnnotations(annotations)
+	return obj, nil
+}
+
+func executeNamespaceTemplate(
+	pkg *packagetypes.Package, tctx map[string]any, field, text string,
+) (string, error) {
+	templ := template.New(field).Option("missingkey=error")
+	for _, funcs := range templateFuncMaps(templ, pkg.Files) {
+		templ = templ.Funcs(funcs)
+	}
+	if _, err := templ.Parse(text); err != nil {
+		return "", fmt.Errorf("parsing %s: %w", field, err)
+	}
+
+	var buf bytes.Buffer
+	if err := templ.Execute(&buf, tctx); err != nil {
+		return "", fmt.Errorf("executing %s: %w", field, err)
+	}
+	if pkg.Manifest.Spec.Rendering.Strict && bytes.Contains(buf.Bytes(), []byte(templateNoValue)) {
+		return "", packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonTemplateNoValue,
+			Details: "strict rendering is enabled, check for typos in keys and for null values",
+			Path:    field,
+		}
+	}
+	return buf.String(), nil
+}
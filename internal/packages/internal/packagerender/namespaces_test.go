@@ -0,0 +1,105 @@
+package packagerender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+func TestRenderNamespaces(t *testing.T) {
+	t.Parallel()
+
+	pkg := &packagetypes.Package{
+		Manifest: &manifests.PackageManifest{
+			Spec: manifests.PackageManifestSpec{
+				Phases: []manifests.PackageManifestPhase{{Name: "deploy"}},
+				Namespaces: []manifests.PackageManifestNamespace{
+					{
+						Name:        "{{.package.metadata.name}}-system",
+						Labels:      map[string]string{"team": "{{.config.team}}"},
+						Annotations: map[string]string{"owner": "{{.config.team}}@example.com"},
+					},
+					{
+						Name:          "{{.package.metadata.name}}-data",
+						CleanupPolicy: corev1alpha1.ObjectDeletionPolicyOrphan,
+					},
+				},
+			},
+		},
+	}
+	pkg.Manifest.Name = "my-pkg"
+	tmplCtx := packagetypes.PackageRenderContext{
+		Package: manifests.TemplateContextPackage{
+			TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{Name: "test"},
+		},
+		Config: map[string]any{"team": "blue"},
+	}
+
+	namespaces, err := RenderNamespaces(pkg, tmplCtx)
+	require.NoError(t, err)
+	require.Len(t, namespaces, 2)
+
+	assert.Equal(t, "test-system", namespaces[0].GetName())
+	assert.Equal(t, map[string]string{
+		"team":                         "blue",
+		manifests.PackageLabel:         "my-pkg",
+		manifests.PackageInstanceLabel: "test",
+	}, namespaces[0].GetLabels())
+	assert.Equal(t, map[string]string{
+		"owner":                                  "blue@example.com",
+		manifestsv1alpha1.PackagePhaseAnnotation: manifests.PackageNamespacesPhase,
+	}, namespaces[0].GetAnnotations())
+
+	assert.Equal(t, "test-data", namespaces[1].GetName())
+	assert.Equal(t, "Orphan",
+		namespaces[1].GetAnnotations()[manifestsv1alpha1.PackageDeletionPolicyAnnotation])
+
+	// namespaces end up in their own phase before all other phases.
+	deployment := unstructured.Unstructured{}
+	deployment.SetAPIVersion("apps/v1")
+	deployment.SetKind("Deployment")
+	deployment.SetName("test")
+	deployment.SetNamespace("test-system")
+	deployment.SetAnnotations(map[string]string{manifestsv1alpha1.PackagePhaseAnnotation: "deploy"})
+
+	spec := RenderObjectSetTemplateSpec(&packagetypes.PackageInstance{
+		Manifest: pkg.Manifest,
+		Objects:  append(namespaces, deployment),
+	})
+	require.Len(t, spec.Phases, 2)
+	assert.Equal(t, manifests.PackageNamespacesPhase, spec.Phases[0].Name)
+	assert.Equal(t, []string{
+		"/v1, Kind=Namespace /test-system",
+		"/v1, Kind=Namespace /test-data",
+	}, objectsToKindNameString(spec.Phases[0].Objects))
+	assert.Equal(t, corev1alpha1.ObjectDeletionPolicyOrphan, spec.Phases[0].Objects[1].DeletionPolicy)
+	assert.Equal(t, "deploy", spec.Phases[1].Name)
+}
+
+func TestRenderNamespaces_Namespaced(t *testing.T) {
+	t.Parallel()
+
+	pkg := &packagetypes.Package{
+		Manifest: &manifests.PackageManifest{
+			Spec: manifests.PackageManifestSpec{
+				Namespaces: []manifests.PackageManifestNamespace{{Name: "test"}},
+			},
+		},
+	}
+	tmplCtx := packagetypes.PackageRenderContext{
+		Package: manifests.TemplateContextPackage{
+			TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{Name: "test", Namespace: "test"},
+		},
+	}
+
+	namespaces, err := RenderNamespaces(pkg, tmplCtx)
+	require.NoError(t, err)
+	assert.Empty(t, namespaces)
+}
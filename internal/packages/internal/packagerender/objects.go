@@ -1,12 +1,15 @@
 package packagerender
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/bmatcuk/doublestar"
 
@@ -21,7 +24,7 @@ import (
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
 
-// Renders all .yml and .yaml files into Kubernetes Objects.
+// Renders all .yml, .yaml and .json files into Kubernetes Objects.
 func RenderObjects(
 	ctx context.Context, pkg *packagetypes.Package,
 	tmplCtx packagetypes.PackageRenderContext,
@@ -35,8 +38,8 @@ func RenderObjects(
 		switch {
 		case strings.HasPrefix(filepath.Base(path), "_"):
 			// skip template helper files.
-		case !packagetypes.IsYAMLFile(path):
-			// skip non YAML files
+		case !packagetypes.IsYAMLFile(path) && !packagetypes.IsJSONFile(path):
+			// skip non YAML or JSON files
 		default:
 			objects, err := parseObjects(pkg.Manifest, tmplCtx, path, content)
 			if err != nil {
@@ -117,6 +120,9 @@ func RenderObjectsWithFilter(
 	return objects, nil
 }
 
+// Parses all objects of a file.
+// YAML files may contain multiple documents, JSON files may contain a single object,
+// an array of objects or a List object, which is expanded into its items.
 func parseObjects(
 	manifest *manifests.PackageManifest,
 	tmplCtx packagetypes.PackageRenderContext,
@@ -127,28 +133,118 @@ func parseObjects(
 ) {
 	objects = []unstructured.Unstructured{}
 
-	// Split for every included yaml document.
-	for idx, yamlDocument := range packagetypes.SplitYAMLDocuments(content) {
+	// Objects without phase annotation are assigned to the phase inferred from their path.
+	phase := inferPhase(manifest, path)
+
+	reason := packagetypes.ViolationReasonInvalidYAML
+	var documents [][]byte
+	var lines []int
+	if packagetypes.IsJSONFile(path) {
+		reason = packagetypes.ViolationReasonInvalidJSON
+		if documents, lines, err = splitJSONDocuments(path, content); err != nil {
+			return nil, err
+		}
+	} else {
+		// Split for every included yaml document.
+		documents, lines = packagetypes.SplitYAMLDocumentsWithLines(content)
+	}
+
+	for idx, document := range documents {
 		obj := unstructured.Unstructured{}
-		if err = yaml.Unmarshal(yamlDocument, &obj); err != nil {
+		if err = yaml.Unmarshal(document, &obj); err != nil {
 			err = packagetypes.ViolationError{
-				Reason:  packagetypes.ViolationReasonInvalidYAML,
+				Reason:  reason,
 				Details: err.Error(),
 				Path:    path,
 				Index:   ptr.To(idx),
-				Subject: string(yamlDocument),
+				Line:    ptr.To(lines[idx]),
+				Subject: string(document),
 			}
 			return
 		}
+		if len(obj.Object) == 0 {
+			continue
+		}
 
-		if len(obj.Object) != 0 {
-			obj.SetLabels(labels.Merge(obj.GetLabels(), commonLabels(manifest, tmplCtx.Package.Name)))
-			objects = append(objects, obj)
+		items := []unstructured.Unstructured{obj}
+		if packagetypes.IsJSONFile(path) && obj.IsList() {
+			list, listErr := obj.ToList()
+			if listErr != nil {
+				return nil, packagetypes.ViolationError{
+					Reason:  reason,
+					Details: listErr.Error(),
+					Path:    path,
+					Index:   ptr.To(idx),
+					Line:    ptr.To(lines[idx]),
+				}
+			}
+			items = list.Items
+		}
+		for _, item := range items {
+			item.SetLabels(labels.Merge(item.GetLabels(), commonLabels(manifest, tmplCtx.Package.Name)))
+			if len(phase) > 0 && len(item.GetAnnotations()[v1alpha1.PackagePhaseAnnotation]) == 0 {
+				item.SetAnnotations(labels.Merge(item.GetAnnotations(), map[string]string{
+					v1alpha1.PackagePhaseAnnotation: phase,
+				}))
+			}
+			objects = append(objects, item)
 		}
 	}
 	return objects, nil
 }
 
+// Splits a JSON array into its elements and returns the line number each element starts at.
+// Any other JSON value is returned as a single document.
+func splitJSONDocuments(path string, content []byte) (documents [][]byte, lines []int, err error) {
+	start := len(content) - len(bytes.TrimLeftFunc(content, unicode.IsSpace))
+	if !bytes.HasPrefix(content[start:], []byte("[")) {
+		return [][]byte{content}, []int{packagetypes.LineAt(content, start)}, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(content))
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, invalidJSONError(path, content, start, err)
+	}
+	for dec.More() {
+		// Skip the separator to the previous element.
+		offset := int(dec.InputOffset())
+		offset += len(content[offset:]) - len(bytes.TrimLeft(content[offset:], " \t\r\n,"))
+
+		var document json.RawMessage
+		if err := dec.Decode(&document); err != nil {
+			return nil, nil, invalidJSONError(path, content, offset, err)
+		}
+		documents = append(documents, document)
+		lines = append(lines, packagetypes.LineAt(content, offset))
+	}
+	return documents, lines, nil
+}
+
+func invalidJSONError(path string, content []byte, offset int, err error) error {
+	return packagetypes.ViolationError{
+		Reason:  packagetypes.ViolationReasonInvalidJSON,
+		Details: err.Error(),
+		Path:    path,
+		Line:    ptr.To(packagetypes.LineAt(content, offset)),
+	}
+}
+
+// Returns the phase of objects without phase annotation in the file at filePath.
+// The directory phase with the longest matching directory wins over the default phase.
+func inferPhase(manifest *manifests.PackageManifest, filePath string) string {
+	phase := manifest.Spec.DefaultPhase
+	dir := filepath.Dir(filePath)
+	longest := -1
+	for _, dp := range manifest.Spec.DirectoryPhases {
+		d := filepath.Clean(dp.Directory)
+		matches := d == "." || dir == d || strings.HasPrefix(dir, d+"/")
+		if matches && len(d) > longest {
+			phase, longest = dp.Phase, len(d)
+		}
+	}
+	return phase
+}
+
 func commonLabels(manifest *manifests.PackageManifest, packageName string) map[string]string {
 	return map[string]string{
 		manifests.PackageLabel:         manifest.Name,
@@ -335,3 +335,120 @@ func TestIsExcluded(t *testing.T) {
 		})
 	}
 }
+
+func TestParseObjects_json(t *testing.T) {
+	t.Parallel()
+
+	manifest := &manifests.PackageManifest{}
+	manifest.Name = "test"
+	tmplCtx := packagetypes.PackageRenderContext{}
+
+	for _, tc := range []struct {
+		name    string
+		content string
+		names   []string
+	}{
+		{
+			name:    "object",
+			content: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`,
+			names:   []string{"a"},
+		},
+		{
+			name: "array",
+			content: `[
+  {"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}},
+  {"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}
+]`,
+			names: []string{"a", "b"},
+		},
+		{
+			name: "list",
+			content: `{"apiVersion":"v1","kind":"List","items":[
+  {"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}},
+  {"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}
+]}`,
+			names: []string{"a", "b"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			objects, err := parseObjects(manifest, tmplCtx, "objects.json", []byte(tc.content))
+			require.NoError(t, err)
+
+			names := make([]string, len(objects))
+			for i, obj := range objects {
+				names[i] = obj.GetName()
+				assert.Equal(t, "test", obj.GetLabels()[manifests.PackageLabel])
+			}
+			assert.Equal(t, tc.names, names)
+		})
+	}
+}
+
+func TestParseObjects_invalidLine(t *testing.T) {
+	t.Parallel()
+
+	manifest := &manifests.PackageManifest{}
+
+	_, err := parseObjects(manifest, packagetypes.PackageRenderContext{}, "objects.json", []byte(`[
+  {"apiVersion":"v1","kind":"ConfigMap"},
+  {"apiVersion":"v1","kind":
+]`))
+	var verr packagetypes.ViolationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, packagetypes.ViolationReasonInvalidJSON, verr.Reason)
+	assert.Equal(t, 3, *verr.Line)
+
+	_, err = parseObjects(manifest, packagetypes.PackageRenderContext{}, "objects.yaml", []byte(
+		"apiVersion: v1\nkind: ConfigMap\n---\napiVersion: v1\nkind: [\n"))
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, packagetypes.ViolationReasonInvalidYAML, verr.Reason)
+	assert.Equal(t, 4, *verr.Line)
+	assert.Equal(t, 1, *verr.Index)
+}
+
+func TestInferPhase(t *testing.T) {
+	t.Parallel()
+
+	manifest := &manifests.PackageManifest{
+		Spec: manifests.PackageManifestSpec{
+			DefaultPhase: "deploy",
+			DirectoryPhases: []manifests.PackageManifestDirectoryPhase{
+				{Directory: "crds", Phase: "crds"},
+				{Directory: "crds/webhooks/", Phase: "webhooks"},
+			},
+		},
+	}
+
+	for path, phase := range map[string]string{
+		"deployment.yaml":            "deploy",
+		"crds/a.yaml":                "crds",
+		"crds/extra/b.yaml":          "crds",
+		"crds/webhooks/c.yaml":       "webhooks",
+		"crds-backup/d.yaml":         "deploy",
+		"other/crds/e.yaml":          "deploy",
+		"crds/webhooks/more/f.yaml":  "webhooks",
+		"crds/webhooks-extra/g.yaml": "crds",
+	} {
+		assert.Equal(t, phase, inferPhase(manifest, path), path)
+	}
+
+	objects, err := parseObjects(manifest, packagetypes.PackageRenderContext{}, "crds/a.yaml", []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: inferred
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: annotated
+  annotations:
+    package-operator.run/phase: deploy
+`))
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "crds", objects[0].GetAnnotations()[v1alpha1.PackagePhaseAnnotation])
+	assert.Equal(t, "deploy", objects[1].GetAnnotations()[v1alpha1.PackagePhaseAnnotation])
+}
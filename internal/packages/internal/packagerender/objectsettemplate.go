@@ -15,10 +15,18 @@ import (
 func RenderObjectSetTemplateSpec(
 	pkgInstance *packagetypes.PackageInstance,
 ) (templateSpec corev1alpha1.ObjectSetTemplateSpec) {
-	collector := newPhaseCollector(pkgInstance.Manifest.Spec.Phases...)
-	collector.AddObjects(pkgInstance.Objects...)
+	phases := pkgInstance.Manifest.Spec.Phases
+	if len(pkgInstance.Manifest.Spec.Namespaces) > 0 {
+		// Namespaces have to exist before any namespaced object of the package is created.
+		phases = append([]manifests.PackageManifestPhase{
+			{Name: manifests.PackageNamespacesPhase},
+		}, phases...)
+	}
+	collector := newPhaseCollector(phases...)
+	collector.AddObjects(pkgInstance.Manifest.Spec.ConditionMappings, pkgInstance.Objects...)
 
 	templateSpec.AvailabilityProbes = pkgInstance.Manifest.Spec.AvailabilityProbes
+	templateSpec.ClusterScopedKinds = pkgInstance.Manifest.Spec.ClusterScopedKinds
 	templateSpec.Phases = append(templateSpec.Phases, collector.Collect()...)
 	return
 }
@@ -46,15 +54,25 @@ type phaseCollectorEntry struct {
 	Phase corev1alpha1.ObjectSetTemplatePhase
 }
 
-func (c phaseCollector) AddObjects(objs ...unstructured.Unstructured) {
+func (c phaseCollector) AddObjects(
+	conditionMappings []manifests.PackageManifestConditionMapping, objs ...unstructured.Unstructured,
+) {
 	for i, object := range objs {
 		annotations := object.GetAnnotations()
 		phaseAnnotation := annotations[manifestsv1alpha1.PackagePhaseAnnotation]
 		collisionProtectionAnnotation := annotations[manifestsv1alpha1.PackageCollisionProtectionAnnotation]
+		deletionPolicyAnnotation := annotations[manifestsv1alpha1.PackageDeletionPolicyAnnotation]
+		fieldConflictPolicyAnnotation := annotations[manifestsv1alpha1.PackageFieldConflictPolicyAnnotation]
 		delete(annotations, manifestsv1alpha1.PackagePhaseAnnotation)
 		delete(annotations, manifestsv1alpha1.PackageConditionMapAnnotation)
 		delete(annotations, manifestsv1alpha1.PackageCollisionProtectionAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageDeletionPolicyAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageFieldConflictPolicyAnnotation)
 		delete(annotations, manifestsv1alpha1.PackageCELConditionAnnotation)
+		delete(annotations, manifestsv1alpha1.PackageApplyWeightAnnotation)
+		for _, a := range externalAnnotations {
+			delete(annotations, a)
+		}
 		if len(annotations) == 0 {
 			// This is important!
 			// When submitted to the API server empty maps will be dropped.
@@ -65,7 +83,15 @@ func (c phaseCollector) AddObjects(objs ...unstructured.Unstructured) {
 		}
 
 		// Any error should have been detected by the validation stage.
-		conditionMapping, err := parseConditionMapAnnotation(&objs[i])
+		conditionMapping, err := conditionMappingsForObject(&objs[i], conditionMappings)
+		if err != nil {
+			panic(err)
+		}
+		external, _, err := ExternalFromAnnotations(&objs[i])
+		if err != nil {
+			panic(err)
+		}
+		applyWeight, err := ApplyWeightFromAnnotations(&objs[i])
 		if err != nil {
 			panic(err)
 		}
@@ -76,6 +102,10 @@ func (c phaseCollector) AddObjects(objs ...unstructured.Unstructured) {
 			Object:              object,
 			ConditionMappings:   conditionMapping,
 			CollisionProtection: corev1alpha1.CollisionProtection(collisionProtectionAnnotation),
+			External:            external,
+			DeletionPolicy:      corev1alpha1.ObjectDeletionPolicy(deletionPolicyAnnotation),
+			FieldConflictPolicy: corev1alpha1.FieldConflictPolicy(fieldConflictPolicyAnnotation),
+			ApplyWeight:         applyWeight,
 		}
 
 		c.addObjects(phaseAnnotation, objSetObj)
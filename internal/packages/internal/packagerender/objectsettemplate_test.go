@@ -8,8 +8,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"package-operator.run/apis/core/v1alpha1"
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
 	"package-operator.run/internal/packages/internal/packageimport"
 	"package-operator.run/internal/packages/internal/packagestructure"
 	"package-operator.run/internal/packages/internal/packagetypes"
@@ -46,6 +49,42 @@ func TestTemplateSpecFromPackage(t *testing.T) {
 	}, objectsToKindNameString(spec.Phases[0].Objects))
 }
 
+func TestPhaseCollector_fieldConflictPolicy(t *testing.T) {
+	t.Parallel()
+
+	obj := unstructured.Unstructured{}
+	obj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackagePhaseAnnotation:               "deploy",
+		manifestsv1alpha1.PackageFieldConflictPolicyAnnotation: string(v1alpha1.FieldConflictPolicyReport),
+	})
+
+	collector := newPhaseCollector(manifests.PackageManifestPhase{Name: "deploy"})
+	collector.AddObjects(nil, obj)
+	phases := collector.Collect()
+	require.Len(t, phases, 1)
+	require.Len(t, phases[0].Objects, 1)
+	assert.Equal(t, v1alpha1.FieldConflictPolicyReport, phases[0].Objects[0].FieldConflictPolicy)
+	assert.Empty(t, phases[0].Objects[0].Object.GetAnnotations())
+}
+
+func TestPhaseCollector_applyWeight(t *testing.T) {
+	t.Parallel()
+
+	obj := unstructured.Unstructured{}
+	obj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackagePhaseAnnotation:       "deploy",
+		manifestsv1alpha1.PackageApplyWeightAnnotation: "-5",
+	})
+
+	collector := newPhaseCollector(manifests.PackageManifestPhase{Name: "deploy"})
+	collector.AddObjects(nil, obj)
+	phases := collector.Collect()
+	require.Len(t, phases, 1)
+	require.Len(t, phases[0].Objects, 1)
+	assert.Equal(t, int32(-5), phases[0].Objects[0].ApplyWeight)
+	assert.Empty(t, phases[0].Objects[0].Object.GetAnnotations())
+}
+
 func objectsToKindNameString(objects []v1alpha1.ObjectSetObject) []string {
 	out := make([]string, len(objects))
 	for i, obj := range objects {
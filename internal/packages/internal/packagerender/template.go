@@ -12,11 +12,13 @@ import (
 	"package-operator.run/internal/packages/internal/packagerender/celctx"
 
 	"package-operator.run/internal/packages/internal/packagetypes"
-	"package-operator.run/internal/transform"
 )
 
 var errConstructingCelContext = errors.New("constructing CEL context")
 
+// Rendered by text/template for values that resolve to nothing.
+const templateNoValue = "<no value>"
+
 // Runs a go-template transformer on all .gotmpl files.
 func RenderTemplates(_ context.Context, pkg *packagetypes.Package, tmplCtx packagetypes.PackageRenderContext) error {
 	tctx, err := templateContext(tmplCtx)
@@ -25,8 +27,9 @@ func RenderTemplates(_ context.Context, pkg *packagetypes.Package, tmplCtx packa
 	}
 
 	templ := template.New("pkg").Option("missingkey=error")
-	templ = templ.Funcs(transform.SprigFuncs(templ)).
-		Funcs(transform.FileFuncs(pkg.Files))
+	for _, funcs := range templateFuncMaps(templ, pkg.Files) {
+		templ = templ.Funcs(funcs)
+	}
 
 	celFn, err := celTemplateFunction(pkg.Manifest.Spec.Filters.Conditions, tmplCtx)
 	if err != nil {
@@ -36,7 +39,7 @@ func RenderTemplates(_ context.Context, pkg *packagetypes.Package, tmplCtx packa
 
 	// gather all templates to allow cross-file declarations and reuse of helpers.
 	for path, content := range pkg.Files {
-		if !packagetypes.IsTemplateFile(path) {
+		if !packagetypes.IsTemplateFile(path) && !packagetypes.IsHelperFile(path) {
 			// Not a template file, skip.
 			continue
 		}
@@ -48,8 +51,8 @@ func RenderTemplates(_ context.Context, pkg *packagetypes.Package, tmplCtx packa
 	}
 
 	for path := range pkg.Files {
-		if !packagetypes.IsTemplateFile(path) {
-			// Not a template file, skip.
+		if !packagetypes.IsTemplateFile(path) || packagetypes.IsHelperFile(path) {
+			// Not a template file or only defining helpers, skip.
 			continue
 		}
 
@@ -58,10 +61,25 @@ func RenderTemplates(_ context.Context, pkg *packagetypes.Package, tmplCtx packa
 			return fmt.Errorf("executing template from %s with context %+v: %w", path, tctx, err)
 		}
 
+		if pkg.Manifest.Spec.Rendering.Strict && bytes.Contains(buf.Bytes(), []byte(templateNoValue)) {
+			return packagetypes.ViolationError{
+				Reason:  packagetypes.ViolationReasonTemplateNoValue,
+				Details: "strict rendering is enabled, check for typos in keys and for null values",
+				Path:    path,
+			}
+		}
+
 		// save back to file map without the template suffix
 		pkg.Files[packagetypes.StripTemplateSuffix(path)] = buf.Bytes()
 	}
 
+	// helpers have been included where needed and must not end up in the rendered package.
+	for path := range pkg.Files {
+		if packagetypes.IsHelperFile(path) {
+			delete(pkg.Files, path)
+		}
+	}
+
 	return nil
 }
 
@@ -94,6 +112,10 @@ func workaroundnovalue(actualCtx map[string]any) {
 	if metadata["labels"] == nil {
 		metadata["labels"] = map[string]string{}
 	}
+	// Allows templates to check for carried over values, even when there are none.
+	if actualCtx["previous"] == nil {
+		actualCtx["previous"] = map[string]any{}
+	}
 }
 
 func celTemplateFunction(
@@ -108,7 +130,7 @@ func celTemplateFunction(
 	}
 
 	return template.FuncMap{
-		"cel": func(expression string) (bool, error) {
+		celTemplateFunctionName: func(expression string) (bool, error) {
 			return cc.Evaluate(expression)
 		},
 	}, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Masterminds/sprig/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -71,6 +72,111 @@ func TestRenderTemplates(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("helpers", func(t *testing.T) {
+		t.Parallel()
+
+		tmplCtx := packagetypes.PackageRenderContext{
+			Package: manifests.TemplateContextPackage{
+				TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{
+					Name: "test",
+				},
+			},
+		}
+
+		fm := packagetypes.Files{
+			"_helpers/labels.tpl": []byte(
+				`{{- define "labels" -}}app: {{ . }}{{- end -}}` + "\n" + `{{ fail "must not be executed" }}`,
+			),
+			"test.yaml.gotmpl": []byte(
+				`{{- range list "a" "b" }}{{ include "labels" . | nindent 0 }}{{ end }}`,
+			),
+		}
+		pkg := &packagetypes.Package{
+			Files:    fm,
+			Manifest: &manifests.PackageManifest{},
+		}
+
+		ctx := context.Background()
+		err := RenderTemplates(ctx, pkg, tmplCtx)
+		require.NoError(t, err)
+
+		assert.Equal(t, "\napp: a\napp: b", string(fm["test.yaml"]))
+		assert.NotContains(t, fm, "_helpers/labels.tpl")
+		assert.NotContains(t, fm, "_helpers/labels")
+	})
+
+	t.Run("underscore prefixed partials", func(t *testing.T) {
+		t.Parallel()
+
+		tmplCtx := packagetypes.PackageRenderContext{
+			Package: manifests.TemplateContextPackage{
+				TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{
+					Name: "test",
+				},
+			},
+		}
+
+		fm := packagetypes.Files{
+			"templates/_labels.gotmpl": []byte(
+				`{{- define "labels" -}}app: {{ .package.metadata.name }}{{- end -}}` + "\n" + `{{ .banana }}`,
+			),
+			"deploy/a.yaml.gotmpl": []byte(`labels: {{ include "labels" . | nindent 2 }}`),
+			"deploy/b.yaml.gotmpl": []byte(`labels: {{ include "labels" . | nindent 2 }}`),
+		}
+		pkg := &packagetypes.Package{
+			Files:    fm,
+			Manifest: &manifests.PackageManifest{},
+		}
+
+		ctx := context.Background()
+		err := RenderTemplates(ctx, pkg, tmplCtx)
+		require.NoError(t, err)
+
+		assert.Equal(t, "labels: \n  app: test", string(fm["deploy/a.yaml"]))
+		assert.Equal(t, "labels: \n  app: test", string(fm["deploy/b.yaml"]))
+		assert.NotContains(t, fm, "templates/_labels.gotmpl")
+		assert.NotContains(t, fm, "templates/_labels")
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		t.Parallel()
+
+		tmplCtx := packagetypes.PackageRenderContext{
+			Config: map[string]any{"replicas": nil},
+		}
+
+		for name, template := range map[string]string{
+			"index of missing key": `{{ index .config "replicsa" }}`,
+			"null value":           `{{ .config.replicas }}`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				lax := &packagetypes.Package{
+					Files:    packagetypes.Files{"test.yaml.gotmpl": []byte(template)},
+					Manifest: &manifests.PackageManifest{},
+				}
+				require.NoError(t, RenderTemplates(context.Background(), lax, tmplCtx))
+				assert.Equal(t, "<no value>", string(lax.Files["test.yaml"]))
+
+				strict := &packagetypes.Package{
+					Files: packagetypes.Files{"test.yaml.gotmpl": []byte(template)},
+					Manifest: &manifests.PackageManifest{
+						Spec: manifests.PackageManifestSpec{
+							Rendering: manifests.PackageManifestRendering{Strict: true},
+						},
+					},
+				}
+				err := RenderTemplates(context.Background(), strict, tmplCtx)
+
+				var verr packagetypes.ViolationError
+				require.ErrorAs(t, err, &verr)
+				assert.Equal(t, packagetypes.ViolationReasonTemplateNoValue, verr.Reason)
+				assert.Equal(t, "test.yaml.gotmpl", verr.Path)
+			})
+		}
+	})
+
 	t.Run("execution template error", func(t *testing.T) {
 		t.Parallel()
 
@@ -175,3 +281,22 @@ func TestRenderTemplates_CelFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateFunctions(t *testing.T) {
+	t.Parallel()
+
+	sprigFuncs := sprig.FuncMap()
+	names := map[string]struct{}{}
+	for _, fn := range TemplateFunctions() {
+		names[fn.Name] = struct{}{}
+
+		if _, ok := sprigFuncs[fn.Name]; !ok {
+			assert.NotEqual(t, sprigFunctionDescription, fn.Description,
+				"function %q is not part of sprig and needs a description", fn.Name)
+		}
+	}
+
+	for _, name := range []string{"cel", "getFile", "getFileGlob", "include", "toYAML", "b64decMap", "upper"} {
+		assert.Contains(t, names, name)
+	}
+}
@@ -0,0 +1,175 @@
+package packagerepository
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+)
+
+// Signature of the repository file, stored next to it in repository images.
+const signatureFilePathInRepo = filePathInRepo + SignatureFileSuffix
+
+// SignatureFileSuffix is appended to the path of a repository file to store its signature.
+const SignatureFileSuffix = ".sig"
+
+var (
+	// ErrInvalidSignature is returned when a repository signature does not match its content.
+	ErrInvalidSignature = errors.New("invalid repository signature")
+	// ErrUnsupportedKey is returned for keys that are neither ECDSA, ED25519 nor RSA.
+	ErrUnsupportedKey = errors.New("unsupported key")
+)
+
+// LoadSigningKey reads a PEM encoded, unencrypted PKCS8, EC or PKCS1 private key.
+func LoadSigningKey(path string) (crypto.Signer, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var key any
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedKey, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+
+	return signer, nil
+}
+
+// LoadVerificationKey reads a PEM encoded PKIX public key.
+func LoadVerificationKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedKey, err)
+	}
+
+	return key, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM data found in %s", ErrUnsupportedKey, path)
+	}
+
+	return block, nil
+}
+
+// Sign returns the base64 encoded signature of the given repository file content.
+func Sign(data []byte, key crypto.Signer) ([]byte, error) {
+	var (
+		sig []byte
+		err error
+	)
+
+	switch key.Public().(type) {
+	case ed25519.PublicKey:
+		sig, err = key.Sign(rand.Reader, data, crypto.Hash(0))
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		sig, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key.Public())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("signing repository: %w", err)
+	}
+
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(sig)))
+	base64.StdEncoding.Encode(out, sig)
+
+	return out, nil
+}
+
+// Verify checks the base64 encoded signature of the given repository file content.
+func Verify(data, signature []byte, key crypto.PublicKey) error {
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+
+	digest := sha256.Sum256(data)
+
+	var valid bool
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, sig)
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// SaveSignedRepositoryToOCI packs an exported repository file and its signature into an image.
+// The file is stored as-is, so the signature stays valid.
+// The signature file is omitted if the signature is empty.
+func SaveSignedRepositoryToOCI(
+	_ context.Context, data, signature []byte,
+) (containerregistrypkgv1.Image, error) {
+	files := map[string][]byte{filePathInRepo: data}
+	if len(signature) > 0 {
+		files[signatureFilePathInRepo] = signature
+	}
+
+	layer, err := crane.Layer(files)
+	if err != nil {
+		return nil, fmt.Errorf("create image layer: %w", err)
+	}
+
+	image, err := mutate.AppendLayers(empty.Image, layer)
+	if err != nil {
+		return nil, fmt.Errorf("add layer to image: %w", err)
+	}
+
+	image, err = mutate.Canonical(image)
+	if err != nil {
+		return nil, fmt.Errorf("make image canonical: %w", err)
+	}
+
+	return image, nil
+}
@@ -0,0 +1,110 @@
+package packagerepository
+
+import (
+	"archive/tar"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for name, key := range map[string]crypto.Signer{
+		"ecdsa":   ecKey,
+		"ed25519": edKey,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			privPath := filepath.Join(dir, "key.pem")
+			pubPath := filepath.Join(dir, "key.pub")
+			writeTestKeyPair(t, key, privPath, pubPath)
+
+			signer, err := LoadSigningKey(privPath)
+			require.NoError(t, err)
+			pub, err := LoadVerificationKey(pubPath)
+			require.NoError(t, err)
+
+			data := []byte("---\nkind: Repository\n")
+			sig, err := Sign(data, signer)
+			require.NoError(t, err)
+
+			require.NoError(t, Verify(data, sig, pub))
+			require.ErrorIs(t, Verify([]byte("tampered"), sig, pub), ErrInvalidSignature)
+		})
+	}
+}
+
+func TestLoadSigningKey_Invalid(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, []byte("nope"), 0o600))
+
+	_, err := LoadSigningKey(path)
+	require.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func TestSaveSignedRepositoryToOCI(t *testing.T) {
+	t.Parallel()
+
+	image, err := SaveSignedRepositoryToOCI(context.Background(), []byte("data"), []byte("sig"))
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	reader := mutate.Extract(image)
+	defer reader.Close()
+
+	tr := tar.NewReader(reader)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(data)
+	}
+
+	assert.Equal(t, map[string]string{
+		filePathInRepo:          "data",
+		signatureFilePathInRepo: "sig",
+	}, files)
+}
+
+func writeTestKeyPair(t *testing.T, key crypto.Signer, privPath, pubPath string) {
+	t.Helper()
+
+	priv, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	pub, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(privPath,
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: priv}), 0o600))
+	require.NoError(t, os.WriteFile(pubPath,
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub}), 0o600))
+}
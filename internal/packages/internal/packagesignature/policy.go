@@ -0,0 +1,90 @@
+package packagesignature
+
+import (
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"package-operator.run/internal/packages/internal/packagerepository"
+)
+
+// Identity is a trusted keyless signer.
+type Identity struct {
+	// OIDC issuer, e.g. https://accounts.google.com.
+	Issuer string
+	// Certificate subject, e.g. an email address or workflow URI.
+	Subject string
+}
+
+// Policy decides which image signatures are trusted.
+// A signature is trusted if it was created by one of the keys,
+// or by one of the identities with a certificate issued by the roots.
+type Policy struct {
+	Keys       []crypto.PublicKey
+	Identities []Identity
+	Roots      *x509.CertPool
+}
+
+// Empty returns true if the policy does not trust any signature.
+func (p *Policy) Empty() bool {
+	return p == nil || len(p.Keys) == 0 && len(p.Identities) == 0
+}
+
+// Keyless returns true if the policy trusts keyless signers.
+func (p *Policy) Keyless() bool {
+	return p != nil && len(p.Identities) > 0
+}
+
+func (p *Policy) trusts(issuer, subject string) bool {
+	for _, id := range p.Identities {
+		if id.Issuer == issuer && id.Subject == subject {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PolicyConfig references the files and identities a Policy is loaded from.
+type PolicyConfig struct {
+	// Paths to PEM encoded public keys.
+	KeyFiles []string
+	// Path to PEM encoded certificate roots for keyless signatures.
+	RootsFile string
+	// Trusted keyless signers.
+	Identities []Identity
+}
+
+// LoadPolicy reads the keys and certificate roots referenced by the config.
+// The returned policy is empty, if the config does not reference any key or identity.
+func LoadPolicy(cfg PolicyConfig) (*Policy, error) {
+	policy := &Policy{Identities: cfg.Identities}
+
+	for _, path := range cfg.KeyFiles {
+		key, err := packagerepository.LoadVerificationKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading verification key %s: %w", path, err)
+		}
+		policy.Keys = append(policy.Keys, key)
+	}
+
+	if len(cfg.Identities) == 0 {
+		return policy, nil
+	}
+	if cfg.RootsFile == "" {
+		return nil, ErrMissingRoots
+	}
+
+	roots, err := os.ReadFile(cfg.RootsFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading certificate roots: %w", err)
+	}
+
+	policy.Roots = x509.NewCertPool()
+	if !policy.Roots.AppendCertsFromPEM(roots) {
+		return nil, fmt.Errorf("%w: no certificates found in %s", ErrMissingRoots, cfg.RootsFile)
+	}
+
+	return policy, nil
+}
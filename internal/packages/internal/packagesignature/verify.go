@@ -0,0 +1,262 @@
+package packagesignature
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistrypkgv1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"package-operator.run/internal/packages/internal/packagerepository"
+)
+
+// Cosign signature storage conventions.
+const (
+	// SignatureTagSuffix is appended to the image digest to form the tag holding its signatures.
+	SignatureTagSuffix = ".sig"
+	// SimpleSigningMediaType is the media type of signature payload layers.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	signatureAnnotation   = "dev.cosignproject.cosign/signature"
+	certificateAnnotation = "dev.sigstore.cosign/certificate"
+	chainAnnotation       = "dev.sigstore.cosign/chain"
+)
+
+// Fulcio certificate extensions carrying the OIDC issuer.
+var (
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+var (
+	// ErrNoSignatures is returned when no signature could be found for an image.
+	ErrNoSignatures = errors.New("no signatures found")
+	// ErrNoValidSignature is returned when none of the signatures of an image satisfies the policy.
+	ErrNoValidSignature = errors.New("no valid signature found")
+	// ErrEmptyPolicy is returned when verifying against a policy without keys and identities.
+	ErrEmptyPolicy = errors.New("verification policy has neither keys nor identities")
+	// ErrMissingRoots is returned when keyless identities are trusted without certificate roots.
+	ErrMissingRoots = errors.New("keyless verification requires certificate roots")
+	// ErrKeylessUnsupported is returned when keyless identities are trusted,
+	// where signatures have to be verified at a trusted signing time.
+	ErrKeylessUnsupported = errors.New(
+		"keyless verification is not supported, signing times are not verified by a transparency log or timestamp")
+)
+
+// Verified describes the signature that satisfied the verification policy.
+type Verified struct {
+	// Digest of the verified image.
+	Digest string
+	// Subject of the signature, either "key" or the identity from the signing certificate.
+	Subject string
+}
+
+// Verify resolves the digest of the given image reference and checks its cosign signatures against the policy.
+// Keyless signatures are checked against the certificate roots and identities of the policy,
+// at the time their certificate was issued. Neither transparency log inclusion nor signed timestamps are checked,
+// so keyless signatures may have been created with the key of an expired certificate.
+// Callers that can't accept this have to refuse keyless policies, see Policy.Keyless.
+func Verify(ctx context.Context, ref string, policy *Policy, opts ...crane.Option) (*Verified, error) {
+	if policy.Empty() {
+		return nil, ErrEmptyPolicy
+	}
+
+	opts = append(opts, crane.WithContext(ctx))
+
+	parsedRef, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("parsing reference: %w", err)
+	}
+
+	digest, err := crane.Digest(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving digest: %w", err)
+	}
+
+	sigTag, err := SignatureTag(parsedRef.Context(), digest)
+	if err != nil {
+		return nil, err
+	}
+
+	sigImage, err := crane.Pull(sigTag.String(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s: %w", ErrNoSignatures, digest, err)
+	}
+
+	return verifySignatureImage(sigImage, digest, policy)
+}
+
+// SignatureTag returns the tag cosign stores the signatures of the given image digest at.
+func SignatureTag(repo name.Repository, digest string) (name.Tag, error) {
+	hash, err := containerregistrypkgv1.NewHash(digest)
+	if err != nil {
+		return name.Tag{}, fmt.Errorf("parsing digest: %w", err)
+	}
+
+	return repo.Tag(hash.Algorithm + "-" + hash.Hex + SignatureTagSuffix), nil
+}
+
+func verifySignatureImage(sigImage containerregistrypkgv1.Image, digest string, policy *Policy) (*Verified, error) {
+	manifest, err := sigImage.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("reading signature manifest: %w", err)
+	}
+
+	var errs []error
+	for _, desc := range manifest.Layers {
+		if desc.MediaType != SimpleSigningMediaType {
+			continue
+		}
+
+		payload, err := layerContent(sigImage, desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+
+		subject, err := verifySignature(payload, desc.Annotations, digest, policy)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		return &Verified{Digest: digest, Subject: subject}, nil
+	}
+
+	if len(errs) == 0 {
+		return nil, fmt.Errorf("%w for %s", ErrNoSignatures, digest)
+	}
+
+	return nil, fmt.Errorf("%w for %s: %w", ErrNoValidSignature, digest, errors.Join(errs...))
+}
+
+func layerContent(img containerregistrypkgv1.Image, digest containerregistrypkgv1.Hash) ([]byte, error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, fmt.Errorf("getting signature layer: %w", err)
+	}
+
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return nil, fmt.Errorf("reading signature layer: %w", err)
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+// simpleSigningPayload is the subset of the cosign simple signing format that is checked.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// Verifies a single signature and returns its subject.
+func verifySignature(payload []byte, annotations map[string]string, digest string, policy *Policy) (string, error) {
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return "", fmt.Errorf("decoding payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return "", fmt.Errorf("%w: payload is for %s", packagerepository.ErrInvalidSignature,
+			p.Critical.Image.DockerManifestDigest)
+	}
+
+	sig := []byte(annotations[signatureAnnotation])
+
+	if certPEM, ok := annotations[certificateAnnotation]; ok {
+		return verifyKeyless(payload, sig, certPEM, annotations[chainAnnotation], policy)
+	}
+
+	for _, key := range policy.Keys {
+		if err := packagerepository.Verify(payload, sig, key); err == nil {
+			return "key", nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: not signed by any trusted key", packagerepository.ErrInvalidSignature)
+}
+
+func verifyKeyless(payload, sig []byte, certPEM, chainPEM string, policy *Policy) (string, error) {
+	if len(policy.Identities) == 0 || policy.Roots == nil {
+		return "", fmt.Errorf("%w: keyless signatures are not trusted", packagerepository.ErrInvalidSignature)
+	}
+
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return "", err
+	}
+
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM([]byte(chainPEM))
+
+	// Signing certificates are short lived, so they are checked at the time they were issued.
+	// Without a verified signing time, this does not prove that the signature was created within their validity.
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         policy.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   cert.NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return "", fmt.Errorf("%w: verifying certificate: %w", packagerepository.ErrInvalidSignature, err)
+	}
+
+	subject, issuer := certificateIdentity(cert)
+	if !policy.trusts(issuer, subject) {
+		return "", fmt.Errorf("%w: untrusted identity %q from issuer %q",
+			packagerepository.ErrInvalidSignature, subject, issuer)
+	}
+
+	if err := packagerepository.Verify(payload, sig, cert.PublicKey); err != nil {
+		return "", err
+	}
+
+	return subject, nil
+}
+
+func parseCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM data in certificate", packagerepository.ErrInvalidSignature)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parsing certificate: %w", packagerepository.ErrInvalidSignature, err)
+	}
+
+	return cert, nil
+}
+
+// Returns the subject and OIDC issuer of a Fulcio signing certificate.
+func certificateIdentity(cert *x509.Certificate) (subject, issuer string) {
+	switch {
+	case len(cert.EmailAddresses) > 0:
+		subject = cert.EmailAddresses[0]
+	case len(cert.URIs) > 0:
+		subject = cert.URIs[0].String()
+	}
+
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var v string
+			if _, err := asn1.Unmarshal(ext.Value, &v); err == nil {
+				return subject, v
+			}
+		case ext.Id.Equal(oidIssuerV1):
+			issuer = string(ext.Value)
+		}
+	}
+
+	return subject, issuer
+}
@@ -0,0 +1,210 @@
+package packagesignature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"package-operator.run/internal/packages/internal/packagerepository"
+	"package-operator.run/internal/testutil"
+)
+
+const (
+	testRef    = "example.com/package:v1"
+	testIssuer = "https://issuer.example.com"
+	testEmail  = "release@example.com"
+)
+
+//nolint:paralleltest
+func TestVerify_Key(t *testing.T) {
+	reg := testutil.NewInMemoryRegistry()
+	digest := pushTestPackage(t, reg)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pushTestSignature(t, reg, digest, key, nil)
+
+	ctx := context.Background()
+	verified, err := Verify(ctx, testRef, &Policy{Keys: []crypto.PublicKey{key.Public()}}, reg.CraneOpt)
+	require.NoError(t, err)
+	assert.Equal(t, digest, verified.Digest)
+	assert.Equal(t, "key", verified.Subject)
+
+	_, err = Verify(ctx, testRef, &Policy{Keys: []crypto.PublicKey{otherKey.Public()}}, reg.CraneOpt)
+	require.ErrorIs(t, err, ErrNoValidSignature)
+}
+
+//nolint:paralleltest
+func TestVerify_Keyless(t *testing.T) {
+	reg := testutil.NewInMemoryRegistry()
+	digest := pushTestPackage(t, reg)
+
+	caCert, caKey := newTestCA(t)
+	leafKey, leafPEM := newTestLeaf(t, caCert, caKey, testEmail)
+	pushTestSignature(t, reg, digest, leafKey, map[string]string{certificateAnnotation: leafPEM})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+
+	ctx := context.Background()
+	verified, err := Verify(ctx, testRef, &Policy{
+		Identities: []Identity{{Issuer: testIssuer, Subject: testEmail}},
+		Roots:      roots,
+	}, reg.CraneOpt)
+	require.NoError(t, err)
+	assert.Equal(t, testEmail, verified.Subject)
+
+	_, err = Verify(ctx, testRef, &Policy{
+		Identities: []Identity{{Issuer: testIssuer, Subject: "someone@example.com"}},
+		Roots:      roots,
+	}, reg.CraneOpt)
+	require.ErrorIs(t, err, ErrNoValidSignature)
+
+	_, err = Verify(ctx, testRef, &Policy{
+		Identities: []Identity{{Issuer: testIssuer, Subject: testEmail}},
+		Roots:      x509.NewCertPool(),
+	}, reg.CraneOpt)
+	require.ErrorIs(t, err, ErrNoValidSignature)
+}
+
+//nolint:paralleltest
+func TestVerify_Unsigned(t *testing.T) {
+	reg := testutil.NewInMemoryRegistry()
+	pushTestPackage(t, reg)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = Verify(context.Background(), testRef, &Policy{Keys: []crypto.PublicKey{key.Public()}}, reg.CraneOpt)
+	require.ErrorIs(t, err, ErrNoSignatures)
+}
+
+func TestVerify_EmptyPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := Verify(context.Background(), testRef, &Policy{})
+	require.ErrorIs(t, err, ErrEmptyPolicy)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := LoadPolicy(PolicyConfig{})
+	require.NoError(t, err)
+	assert.True(t, policy.Empty())
+
+	_, err = LoadPolicy(PolicyConfig{Identities: []Identity{{Issuer: testIssuer, Subject: testEmail}}})
+	require.ErrorIs(t, err, ErrMissingRoots)
+}
+
+func pushTestPackage(t *testing.T, reg *testutil.InMemoryRegistry) string {
+	t.Helper()
+
+	img := testutil.BuildImage(t, map[string][]byte{"package/manifest.yaml": []byte("kind: PackageManifest")})
+	require.NoError(t, crane.Push(img, testRef, reg.CraneOpt))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	return digest.String()
+}
+
+func pushTestSignature(
+	t *testing.T, reg *testutil.InMemoryRegistry, digest string,
+	key crypto.Signer, annotations map[string]string,
+) {
+	t.Helper()
+
+	payload := []byte(fmt.Sprintf(
+		`{"critical":{"identity":{"docker-reference":"example.com/package"},`+
+			`"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`,
+		digest))
+	sig, err := packagerepository.Sign(payload, key)
+	require.NoError(t, err)
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[signatureAnnotation] = string(sig)
+
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, SimpleSigningMediaType),
+		Annotations: annotations,
+	})
+	require.NoError(t, err)
+
+	sigTag, err := SignatureTag(name.MustParseReference(testRef).Context(), digest)
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(img, sigTag.String(), reg.CraneOpt))
+}
+
+func newTestCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
+
+func newTestLeaf(
+	t *testing.T, ca *x509.Certificate, caKey crypto.Signer, email string,
+) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	issuer, err := asn1.Marshal(testIssuer)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       time.Now().Add(-time.Minute),
+		NotAfter:        time.Now().Add(10 * time.Minute),
+		EmailAddresses:  []string{email},
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, key.Public(), caKey)
+	require.NoError(t, err)
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
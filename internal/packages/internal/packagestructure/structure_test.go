@@ -1,4 +1,4 @@
-package packagestructure
+package packagestructure_test
 
 import (
 	"context"
@@ -10,12 +10,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"package-operator.run/internal/packages/internal/packageimport"
+	"package-operator.run/internal/packages/internal/packagestructure"
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
 
 func TestStructuralLoader_LoadComponent(t *testing.T) {
 	t.Parallel()
-	sl := NewStructuralLoader(scheme)
+	sl := packagestructure.DefaultStructuralLoader
 
 	t.Run("components-disabled", func(t *testing.T) {
 		t.Parallel()
@@ -81,7 +82,7 @@ func TestStructuralLoader_LoadComponent(t *testing.T) {
 
 func TestStructuralLoader_Load(t *testing.T) {
 	t.Parallel()
-	sl := NewStructuralLoader(scheme)
+	sl := packagestructure.DefaultStructuralLoader
 
 	t.Run("base", func(t *testing.T) {
 		t.Parallel()
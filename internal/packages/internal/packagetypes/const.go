@@ -9,4 +9,15 @@ const (
 	PackageManifestLockFilename = "manifest.lock"
 	// Name of the components folder for multi-components.
 	ComponentsFolder = "components"
+	// Name of the folder containing helper templates.
+	// Files in this folder only define named templates to include from other templates
+	// and are not rendered into package files themselves.
+	HelpersFolder = "_helpers"
+
+	// PackageArtifactType identifies packages stored as OCI artifact, instead of container image.
+	// Conveyed via the config media type, like ORAS does for registries without OCI 1.1 support.
+	PackageArtifactType = "application/vnd.package-operator.package"
+	// PackageArtifactLayerMediaType is the media type of the gzipped tar holding the package files
+	// of package artifacts. Files are stored at the root of the tar, without OCIPathPrefix.
+	PackageArtifactLayerMediaType = "application/vnd.package-operator.package.content.v1.tar+gzip"
 )
@@ -0,0 +1,41 @@
+package packagetypes
+
+// SBOMFormat names a supported software bill of materials format.
+type SBOMFormat string
+
+const (
+	SBOMFormatSPDX      SBOMFormat = "spdx"
+	SBOMFormatCycloneDX SBOMFormat = "cyclonedx"
+)
+
+// ArtifactType returns the OCI artifact type SBOMs of this format are attached with.
+// Returns an empty string for unknown formats.
+func (f SBOMFormat) ArtifactType() string {
+	switch f {
+	case SBOMFormatSPDX:
+		return SBOMArtifactTypeSPDX
+	case SBOMFormatCycloneDX:
+		return SBOMArtifactTypeCycloneDX
+	}
+
+	return ""
+}
+
+const (
+	// OCI artifact type of SPDX JSON documents.
+	SBOMArtifactTypeSPDX = "application/spdx+json"
+	// OCI artifact type of CycloneDX JSON documents.
+	SBOMArtifactTypeCycloneDX = "application/vnd.cyclonedx+json"
+)
+
+// SBOMFormatFromArtifactType maps an OCI artifact type back to its SBOM format.
+func SBOMFormatFromArtifactType(artifactType string) (SBOMFormat, bool) {
+	switch artifactType {
+	case SBOMArtifactTypeSPDX:
+		return SBOMFormatSPDX, true
+	case SBOMArtifactTypeCycloneDX:
+		return SBOMFormatCycloneDX, true
+	}
+
+	return "", false
+}
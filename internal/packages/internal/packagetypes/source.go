@@ -0,0 +1,9 @@
+package packagetypes
+
+import "embed"
+
+// Source contains the Go source of the package types,
+// so the template context can be documented from its definition.
+//
+//go:embed types.go
+var Source embed.FS
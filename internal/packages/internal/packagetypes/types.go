@@ -43,10 +43,18 @@ type PackageInstance struct {
 
 // PackageRenderContext contains all data that is needed to render a Package into a PackageInstance.
 type PackageRenderContext struct {
-	Package     manifests.TemplateContextPackage `json:"package"`
-	Config      map[string]any                   `json:"config"`
-	Images      map[string]string                `json:"images"`
-	Environment manifests.PackageEnvironment     `json:"environment"`
+	// Package object.
+	Package manifests.TemplateContextPackage `json:"package"`
+	// Configuration as presented via the (Cluster)Package API after admission.
+	Config map[string]any `json:"config"`
+	// Images declared in the PackageManifest, resolved to their digest
+	// and mapped by their name.
+	Images map[string]string `json:"images"`
+	// Environment specific information.
+	Environment manifests.PackageEnvironment `json:"environment"`
+	// Values carried over from the currently deployed revision,
+	// as declared in the PackageManifest, mapped by their name.
+	Previous map[string]any `json:"previous"`
 }
 
 // RawPackage right after import.
@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"unicode"
+
+	"package-operator.run/internal/utils"
 )
 
 // templateFilenameSuffix is the files suffix for all go template files that need pre-processing.
@@ -18,6 +21,18 @@ func IsTemplateFile(path string) bool { return strings.HasSuffix(path, templateF
 // StripTemplateSuffix removes a [TemplateFileSuffix] suffix from a string if present.
 func StripTemplateSuffix(path string) string { return strings.TrimSuffix(path, templateFilenameSuffix) }
 
+// helperFilenamePrefix marks template files that only define named templates, e.g. templates/_labels.gotmpl.
+const helperFilenamePrefix = "_"
+
+// IsHelperFile returns true if the given path is located in the [HelpersFolder]
+// or is a template file with a name prefixed by an underscore.
+// Helper files define named templates that can be included from all other templates,
+// but are not rendered into package files themselves.
+func IsHelperFile(path string) bool {
+	return strings.HasPrefix(path, HelpersFolder+"/") ||
+		(IsTemplateFile(path) && strings.HasPrefix(filepath.Base(path), helperFilenamePrefix))
+}
+
 // IsYAMLFile return true if the given fileName is suffixed by .yml or .yaml.
 func IsYAMLFile(fileName string) bool {
 	switch filepath.Ext(fileName) {
@@ -28,17 +43,64 @@ func IsYAMLFile(fileName string) bool {
 	}
 }
 
+// IsJSONFile return true if the given fileName is suffixed by .json.
+func IsJSONFile(fileName string) bool { return filepath.Ext(fileName) == ".json" }
+
 var splitYAMLDocumentsRegEx = regexp.MustCompile(`(?m)^---$`)
 
 // Splits a YAML file into multiple documents.
 func SplitYAMLDocuments(file []byte) (docs [][]byte) {
-	for _, yamlDocument := range splitYAMLDocumentsRegEx.Split(string(bytes.Trim(file, "---\n")), -1) {
+	docs, _ = SplitYAMLDocumentsWithLines(file)
+	return docs
+}
+
+// Splits a YAML file into multiple documents, like [SplitYAMLDocuments],
+// and additionally returns the line number each document starts at.
+func SplitYAMLDocumentsWithLines(file []byte) (docs [][]byte, lines []int) {
+	offset := len(file) - len(bytes.TrimLeft(file, "---\n"))
+	trimmed := string(bytes.Trim(file, "---\n"))
+
+	var start int
+	bounds := append(splitYAMLDocumentsRegEx.FindAllStringIndex(trimmed, -1), []int{len(trimmed), len(trimmed)})
+	for _, bound := range bounds {
+		yamlDocument := trimmed[start:bound[0]]
+		leadingSpace := len(yamlDocument) - len(strings.TrimLeftFunc(yamlDocument, unicode.IsSpace))
 		docs = append(docs, bytes.TrimSpace([]byte(yamlDocument)))
+		lines = append(lines, LineAt(file, offset+start+leadingSpace))
+		start = bound[1]
 	}
-	return docs
+	return docs, lines
+}
+
+// LineAt returns the line number of the given byte offset within content, starting at 1.
+func LineAt(content []byte, offset int) int {
+	return bytes.Count(content[:offset], []byte("\n")) + 1
 }
 
 // Joins multiple YAML documents together.
 func JoinYAMLDocuments(documents [][]byte) []byte {
 	return append(bytes.Join(documents, []byte("\n---\n")), []byte("\n")...)
 }
+
+// ConfigHash returns a short hash of the given package configuration.
+// The hash is stable across reconciles and only changes with the configuration.
+func ConfigHash(config map[string]any) string {
+	if len(config) == 0 {
+		// nil and empty configuration are the same for packages.
+		config = nil
+	}
+	return utils.ComputeFNV32Hash(config, nil)
+}
+
+// ConfigChecksums returns the SHA256 checksum of each top-level key of the given package configuration.
+// Checksums match the checksum template function applied to the value of the key.
+func ConfigChecksums(config map[string]any) map[string]string {
+	if len(config) == 0 {
+		return nil
+	}
+	checksums := make(map[string]string, len(config))
+	for key, value := range config {
+		checksums[key] = utils.ComputeSHA256Hash(value, nil)
+	}
+	return checksums
+}
@@ -54,3 +54,70 @@ func TestIsTemplateFile(t *testing.T) {
 		})
 	}
 }
+
+func TestIsHelperFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path string
+		out  bool
+	}{
+		{path: "_helpers/labels.gotmpl", out: true},
+		{path: "_helpers/sub/labels.tpl", out: true},
+		{path: "_helpers.yaml.gotmpl", out: true},
+		{path: "templates/_labels.gotmpl", out: true},
+		{path: "templates/labels_.gotmpl", out: false},
+		{path: "_labels.yaml", out: false},
+		{path: "deploy/_helpers/labels.tpl", out: false},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.path, func(t *testing.T) {
+			t.Parallel()
+
+			out := IsHelperFile(test.path)
+			assert.Equal(t, test.out, out)
+		})
+	}
+}
+
+func TestConfigHash(t *testing.T) {
+	t.Parallel()
+
+	config := map[string]any{"replicas": float64(3), "labels": map[string]any{"a": "1", "b": "2"}}
+	hash := ConfigHash(config)
+
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, hash, ConfigHash(map[string]any{
+		"labels": map[string]any{"b": "2", "a": "1"}, "replicas": float64(3),
+	}), "must not depend on key order")
+	assert.NotEqual(t, hash, ConfigHash(map[string]any{"replicas": float64(4)}))
+	assert.Equal(t, ConfigHash(nil), ConfigHash(map[string]any{}))
+}
+
+func TestConfigChecksums(t *testing.T) {
+	t.Parallel()
+
+	checksums := ConfigChecksums(map[string]any{
+		"database": map[string]any{"host": "db", "port": float64(5432)},
+		"replicas": float64(3),
+	})
+	assert.Len(t, checksums, 2)
+	assert.Equal(t, checksums["database"], ConfigChecksums(map[string]any{
+		"database": map[string]any{"port": float64(5432), "host": "db"},
+		"replicas": float64(4),
+	})["database"], "must only change with the value of the key")
+	assert.NotEqual(t, checksums["replicas"], ConfigChecksums(map[string]any{"replicas": float64(4)})["replicas"])
+	assert.Nil(t, ConfigChecksums(nil))
+}
+
+func TestSplitYAMLDocumentsWithLines(t *testing.T) {
+	t.Parallel()
+
+	file := []byte("---\na: 1\n---\n\nb: 2\nc: 3\n---\nd: 4\n")
+	docs, lines := SplitYAMLDocumentsWithLines(file)
+	assert.Equal(t, [][]byte{[]byte("a: 1"), []byte("b: 2\nc: 3"), []byte("d: 4")}, docs)
+	assert.Equal(t, []int{2, 5, 8}, lines)
+	assert.Equal(t, docs, SplitYAMLDocuments(file))
+}
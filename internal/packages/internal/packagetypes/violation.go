@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/errorclass"
 )
 
 // ViolationError describes the reason why and which part of a package is violating sanitation checks.
@@ -14,9 +15,18 @@ type ViolationError struct {
 	Path      string          // Path shows which file path in the package is responsible for this error.
 	Component string          // Component indicates which component the error is associated with
 	Index     *int            // Index is the index of the YAML document within Path.
+	Line      *int            // Line is the line within Path the document starts at.
 	Subject   string          // Complete subject producing the error, may be the whole yaml file, a single document, etc.
 }
 
+// ErrorClass implements errorclass.Of.
+func (v ViolationError) ErrorClass() errorclass.Class {
+	if v.Reason == ViolationReasonTemplateNoValue {
+		return errorclass.Template
+	}
+	return errorclass.Config
+}
+
 func (v ViolationError) Error() string {
 	// Set reason to unknown if it is not set.
 	if v.Reason == "" {
@@ -29,6 +39,9 @@ func (v ViolationError) Error() string {
 	// Attach path to message if set.
 	if v.Path != "" {
 		msg += " in " + v.Path
+		if v.Line != nil {
+			msg += fmt.Sprintf(":%d", *v.Line)
+		}
 		if v.Index != nil {
 			msg += fmt.Sprintf(" idx %d", *v.Index)
 		}
@@ -63,6 +76,7 @@ const (
 	ViolationReasonPackageManifestLockInvalid    ViolationReason = "PackageManifestLock invalid"
 	ViolationReasonPackageManifestLockDuplicated ViolationReason = "PackageManifestLock present multiple times"
 	ViolationReasonInvalidYAML                   ViolationReason = "Invalid YAML"
+	ViolationReasonInvalidJSON                   ViolationReason = "Invalid JSON"
 	ViolationReasonMissingPhaseAnnotation        ViolationReason = "Missing " + manifests.PackagePhaseAnnotation + " Annotation" //nolint: lll
 	ViolationReasonPhaseNotFound                 ViolationReason = "Phase name not found in manifest"                            //nolint: lll
 	ViolationReasonMissingGVK                    ViolationReason = "GroupVersionKind not set"
@@ -74,13 +88,18 @@ const (
 	ViolationReasonComponentNotFound             ViolationReason = "Component not found"
 	ViolationReasonInvalidComponentPath          ViolationReason = "Invalid component path"
 	ViolationReasonUnknown                       ViolationReason = "Unknown reason"
+	ViolationReasonTemplateNoValue               ViolationReason = "Template rendered <no value>"
+	ViolationReasonUnknownConfigField            ViolationReason = "Unknown config field"
 	ViolationReasonNestedMultiComponentPkg       ViolationReason = "Nesting multi-component packages not allowed"
 	ViolationReasonInvalidFileInComponentsDir    ViolationReason = "The components directory may only contain folders and dot files" //nolint: lll
 	ViolationReasonKubeconform                   ViolationReason = "Kubeconform rejected schema"
+	ViolationReasonLintRule                      ViolationReason = "Lint rule violated"
 	ViolationReasonLockfileMissing               ViolationReason = "Missing image in manifest.lock.yaml, but using PackageManifest.spec.images. Try running: kubectl package update" //nolint: lll
 	ViolationReasonImageMissingInLockfile        ViolationReason = "Image specified in manifest but missing from lockfile. Try running: kubectl package update"                      //nolint: lll
 	ViolationReasonImageDifferentToLockfile      ViolationReason = "Image specified in manifest does not match with lockfile. Try running: kubectl package update"                   //nolint: lll
 	ViolationReasonInvalidCELExpression          ViolationReason = "The CEL expression in " + manifests.PackageCELConditionAnnotation + " annotation is invalid."                    //nolint: lll
+	ViolationReasonInvalidExternalAnnotations    ViolationReason = "External object annotations invalid"
+	ViolationReasonInvalidApplyWeightAnnotation  ViolationReason = "Apply weight annotation invalid"
 )
 
 var ErrEmptyPackage = ViolationError{
@@ -52,3 +52,10 @@ func TestViolationErrorDetailPathSubject(t *testing.T) {
 	}
 	require.EqualError(t, v, "cheese reason in a/b: zoom 200x\nyaml: test")
 }
+
+func TestViolationErrorPathLine(t *testing.T) {
+	t.Parallel()
+
+	v := ViolationError{Reason: ViolationReason("cheese reason"), Path: "a/b", Line: ptr.To(12), Index: ptr.To(4)}
+	require.EqualError(t, v, "cheese reason in a/b:12 idx 4")
+}
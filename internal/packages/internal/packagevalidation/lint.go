@@ -0,0 +1,573 @@
+package packagevalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagemanifestvalidation"
+	"package-operator.run/internal/packages/internal/packagerender"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+// LintConfigFilename is the name of the lint configuration file in the package root.
+const LintConfigFilename = ".package-lint.yaml"
+
+// LintSeverity decides whether a lint finding fails validation.
+type LintSeverity string
+
+const (
+	// Findings fail validation.
+	LintSeverityError LintSeverity = "error"
+	// Findings are reported but don't fail validation.
+	LintSeverityWarning LintSeverity = "warning"
+)
+
+// Names of built-in lint rules.
+const (
+	LintRuleMissingPhaseAnnotation = "missing-phase-annotation"
+	LintRuleUnknownPhase           = "unknown-phase"
+	LintRuleProbeMatchesNoObjects  = "probe-matches-no-objects"
+	LintRuleImageNotInManifest     = "image-not-in-manifest"
+)
+
+var (
+	ErrLintConfigInvalid = errors.New("invalid lint config")
+	ErrLintRuleInvalid   = errors.New("invalid lint rule")
+)
+
+// LintConfig is read from the .package-lint.yaml file in the package root.
+type LintConfig struct {
+	// Names of rules to disable.
+	Disable []string `json:"disable,omitempty"`
+	// Overrides the severity of rules by name.
+	Severity map[string]LintSeverity `json:"severity,omitempty"`
+	// User-supplied CEL rules.
+	Rules []LintCELRule `json:"rules,omitempty"`
+}
+
+// LintCELRule is a user-supplied rule evaluating a CEL expression against every object.
+type LintCELRule struct {
+	// Unique name of the rule.
+	Name string `json:"name"`
+	// Message reported when the rule is violated.
+	Message string `json:"message"`
+	// Defaults to "error".
+	Severity LintSeverity `json:"severity,omitempty"`
+	// Only check objects of the given group and kind.
+	// All objects are checked if unset.
+	Match *LintMatch `json:"match,omitempty"`
+	// CEL expression that must return true for valid objects.
+	// The object is available as `self`.
+	Rule string `json:"rule"`
+}
+
+// LintMatch selects objects by API group and kind.
+type LintMatch struct {
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind"`
+}
+
+// LoadLintConfig reads a lint config from the given file.
+// Returns an empty config if the file does not exist.
+func LoadLintConfig(path string) (LintConfig, error) {
+	var cfg LintConfig
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("reading lint config: %w", err)
+	}
+
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("%w: %w", ErrLintConfigInvalid, err)
+	}
+
+	return cfg, nil
+}
+
+// LintFinding describes a single rule violation.
+type LintFinding struct {
+	Path    string
+	Index   *int
+	Message string
+}
+
+// LintRule checks the rendered objects of a package.
+type LintRule interface {
+	Name() string
+	DefaultSeverity() LintSeverity
+	Lint(
+		ctx context.Context,
+		manifest *manifests.PackageManifest,
+		objects map[string][]unstructured.Unstructured,
+	) ([]LintFinding, error)
+}
+
+// DefaultLintRules is the list of built-in lint rules.
+var DefaultLintRules = []LintRule{
+	&lintMissingPhaseAnnotationRule{},
+	&lintUnknownPhaseRule{},
+	&lintProbeMatchesNoObjectsRule{},
+	&lintImageNotInManifestRule{},
+}
+
+// Runs lint rules against all objects of a package
+// rendered for each template test case.
+type LintValidator struct {
+	rules      []LintRule
+	severities map[string]LintSeverity
+	warn       func(error)
+}
+
+// Creates a new LintValidator running the built-in rules and rules from the given config.
+// Findings with warning severity are passed to warn and don't fail validation.
+func NewLintValidator(cfg LintConfig, warn func(error)) (*LintValidator, error) {
+	rules := slices.Clone(DefaultLintRules)
+	for _, ruleCfg := range cfg.Rules {
+		rule, err := newLintCELRule(ruleCfg)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	known := map[string]struct{}{}
+	for _, rule := range rules {
+		if _, ok := known[rule.Name()]; ok {
+			return nil, fmt.Errorf("%w: duplicate rule name %q", ErrLintConfigInvalid, rule.Name())
+		}
+		known[rule.Name()] = struct{}{}
+	}
+
+	for _, name := range cfg.Disable {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("%w: cannot disable unknown rule %q", ErrLintConfigInvalid, name)
+		}
+	}
+	rules = slices.DeleteFunc(rules, func(r LintRule) bool {
+		return slices.Contains(cfg.Disable, r.Name())
+	})
+
+	severities := map[string]LintSeverity{}
+	for _, rule := range rules {
+		severities[rule.Name()] = rule.DefaultSeverity()
+	}
+	for name, severity := range cfg.Severity {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("%w: severity set for unknown rule %q", ErrLintConfigInvalid, name)
+		}
+		if err := validateLintSeverity(severity); err != nil {
+			return nil, err
+		}
+		severities[name] = severity
+	}
+
+	if warn == nil {
+		warn = func(error) {}
+	}
+
+	return &LintValidator{
+		rules:      rules,
+		severities: severities,
+		warn:       warn,
+	}, nil
+}
+
+func (v *LintValidator) ValidatePackage(ctx context.Context, pkg *packagetypes.Package) error {
+	return packagetypes.ValidateEachComponent(ctx, pkg, v.doValidatePackage)
+}
+
+func (v *LintValidator) doValidatePackage(
+	ctx context.Context, pkg *packagetypes.Package, isComponent bool,
+) error {
+	tmplCtxs, err := lintRenderContexts(ctx, pkg.Manifest)
+	if err != nil {
+		return err
+	}
+
+	component := ""
+	if isComponent {
+		component = pkg.Manifest.Name
+	}
+
+	// The same finding is usually reported for every render context.
+	reported := map[string]struct{}{}
+
+	var errs []error
+	for _, tmplCtx := range tmplCtxs {
+		pkg := pkg.DeepCopy()
+		if err := packagerender.RenderTemplates(ctx, pkg, tmplCtx); err != nil {
+			return err
+		}
+		objects, err := packagerender.RenderObjects(ctx, pkg, tmplCtx, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range v.rules {
+			findings, err := rule.Lint(ctx, pkg.Manifest, objects)
+			if err != nil {
+				return fmt.Errorf("running lint rule %q: %w", rule.Name(), err)
+			}
+
+			for _, f := range findings {
+				verr := packagetypes.ViolationError{
+					Reason:    packagetypes.ViolationReasonLintRule,
+					Details:   fmt.Sprintf("%s: %s", rule.Name(), f.Message),
+					Path:      f.Path,
+					Index:     f.Index,
+					Component: component,
+				}
+				if _, ok := reported[verr.Error()]; ok {
+					continue
+				}
+				reported[verr.Error()] = struct{}{}
+
+				if v.severities[rule.Name()] == LintSeverityWarning {
+					v.warn(verr)
+					continue
+				}
+				errs = append(errs, verr)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Returns a render context for every template test case,
+// or a single context using the configuration defaults if there are none.
+// Images are rendered as declared in the manifest.
+func lintRenderContexts(
+	ctx context.Context, manifest *manifests.PackageManifest,
+) ([]packagetypes.PackageRenderContext, error) {
+	images := map[string]string{}
+	for _, img := range manifest.Spec.Images {
+		images[img.Name] = img.Image
+	}
+
+	testCases := manifest.Test.Template
+	if len(testCases) == 0 {
+		testCases = []manifests.PackageManifestTestCaseTemplate{{}}
+	}
+
+	tmplCtxs := make([]packagetypes.PackageRenderContext, 0, len(testCases))
+	for _, testCase := range testCases {
+		configuration := map[string]any{}
+		if testCase.Context.Config != nil {
+			if err := json.Unmarshal(testCase.Context.Config.Raw, &configuration); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := packagemanifestvalidation.AdmitPackageConfiguration(
+			ctx, configuration, manifest, nil); err != nil {
+			return nil, err
+		}
+
+		pkgCtx := testCase.Context.Package
+		pkgCtx.ConfigHash = packagetypes.ConfigHash(configuration)
+		pkgCtx.ConfigChecksums = packagetypes.ConfigChecksums(configuration)
+
+		tmplCtxs = append(tmplCtxs, packagetypes.PackageRenderContext{
+			Package:     pkgCtx,
+			Config:      configuration,
+			Images:      images,
+			Environment: testCase.Context.Environment,
+		})
+	}
+	return tmplCtxs, nil
+}
+
+func validateLintSeverity(severity LintSeverity) error {
+	switch severity {
+	case LintSeverityError, LintSeverityWarning:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown severity %q", ErrLintConfigInvalid, severity)
+	}
+}
+
+// Iterates over objects in a stable order.
+func eachLintObject(
+	objects map[string][]unstructured.Unstructured,
+	fn func(path string, index int, obj unstructured.Unstructured) error,
+) error {
+	paths := make([]string, 0, len(objects))
+	for path := range objects {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		for i, obj := range objects[path] {
+			if err := fn(path, i, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Reports objects without phase annotation.
+type lintMissingPhaseAnnotationRule struct{}
+
+func (r *lintMissingPhaseAnnotationRule) Name() string { return LintRuleMissingPhaseAnnotation }
+
+func (r *lintMissingPhaseAnnotationRule) DefaultSeverity() LintSeverity { return LintSeverityError }
+
+func (r *lintMissingPhaseAnnotationRule) Lint(
+	_ context.Context, _ *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) (findings []LintFinding, err error) {
+	err = eachLintObject(objects, func(path string, index int, obj unstructured.Unstructured) error {
+		if len(obj.GetAnnotations()[manifests.PackagePhaseAnnotation]) == 0 {
+			findings = append(findings, LintFinding{
+				Path:  path,
+				Index: ptr.To(index),
+				Message: fmt.Sprintf("%s %s has no %s annotation",
+					obj.GetKind(), obj.GetName(), manifests.PackagePhaseAnnotation),
+			})
+		}
+		return nil
+	})
+	return findings, err
+}
+
+// Reports objects referencing phases not declared in the manifest.
+type lintUnknownPhaseRule struct{}
+
+func (r *lintUnknownPhaseRule) Name() string { return LintRuleUnknownPhase }
+
+func (r *lintUnknownPhaseRule) DefaultSeverity() LintSeverity { return LintSeverityError }
+
+func (r *lintUnknownPhaseRule) Lint(
+	_ context.Context, manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) (findings []LintFinding, err error) {
+	phases := map[string]struct{}{}
+	for _, phase := range manifest.Spec.Phases {
+		phases[phase.Name] = struct{}{}
+	}
+
+	err = eachLintObject(objects, func(path string, index int, obj unstructured.Unstructured) error {
+		phase := obj.GetAnnotations()[manifests.PackagePhaseAnnotation]
+		if len(phase) == 0 {
+			return nil
+		}
+		if _, ok := phases[phase]; !ok {
+			findings = append(findings, LintFinding{
+				Path:    path,
+				Index:   ptr.To(index),
+				Message: fmt.Sprintf("%s %s references undeclared phase %q", obj.GetKind(), obj.GetName(), phase),
+			})
+		}
+		return nil
+	})
+	return findings, err
+}
+
+// Reports availability probes that don't select any object.
+type lintProbeMatchesNoObjectsRule struct{}
+
+func (r *lintProbeMatchesNoObjectsRule) Name() string { return LintRuleProbeMatchesNoObjects }
+
+func (r *lintProbeMatchesNoObjectsRule) DefaultSeverity() LintSeverity { return LintSeverityWarning }
+
+func (r *lintProbeMatchesNoObjectsRule) Lint(
+	_ context.Context, manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) ([]LintFinding, error) {
+	var findings []LintFinding
+	for i, probe := range manifest.Spec.AvailabilityProbes {
+		kind := probe.Selector.Kind
+		if kind == nil {
+			continue
+		}
+
+		selector := labels.Everything()
+		if probe.Selector.Selector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(probe.Selector.Selector)
+			if err != nil {
+				return nil, fmt.Errorf("availability probe %d: %w", i, err)
+			}
+		}
+
+		var matched bool
+		for _, objs := range objects {
+			matched = slices.ContainsFunc(objs, func(obj unstructured.Unstructured) bool {
+				gk := obj.GroupVersionKind().GroupKind()
+				return gk.Group == kind.Group && gk.Kind == kind.Kind &&
+					selector.Matches(labels.Set(obj.GetLabels()))
+			})
+			if matched {
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		msg := fmt.Sprintf("availability probe %d for %s.%s matches no objects", i, kind.Kind, kind.Group)
+		if kind.Group == "" {
+			msg = fmt.Sprintf("availability probe %d for %s matches no objects", i, kind.Kind)
+		}
+		findings = append(findings, LintFinding{
+			Path:    packagetypes.PackageManifestFilename + ".yaml",
+			Message: msg,
+		})
+	}
+	return findings, nil
+}
+
+// Reports container images that are not listed in the manifest images.
+type lintImageNotInManifestRule struct{}
+
+func (r *lintImageNotInManifestRule) Name() string { return LintRuleImageNotInManifest }
+
+func (r *lintImageNotInManifestRule) DefaultSeverity() LintSeverity { return LintSeverityWarning }
+
+// Paths to pod specs in well-known workload objects.
+var lintPodSpecPaths = [][]string{
+	{"spec"},
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+func (r *lintImageNotInManifestRule) Lint(
+	_ context.Context, manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) (findings []LintFinding, err error) {
+	images := map[string]struct{}{}
+	for _, img := range manifest.Spec.Images {
+		images[img.Image] = struct{}{}
+	}
+
+	err = eachLintObject(objects, func(path string, index int, obj unstructured.Unstructured) error {
+		for _, image := range lintContainerImages(obj) {
+			if _, ok := images[image]; ok {
+				continue
+			}
+			findings = append(findings, LintFinding{
+				Path:  path,
+				Index: ptr.To(index),
+				Message: fmt.Sprintf("%s %s uses image %q, which is not listed in the PackageManifest images",
+					obj.GetKind(), obj.GetName(), image),
+			})
+		}
+		return nil
+	})
+	return findings, err
+}
+
+func lintContainerImages(obj unstructured.Unstructured) []string {
+	var images []string
+	for _, specPath := range lintPodSpecPaths {
+		for _, field := range []string{"initContainers", "containers"} {
+			containers, _, _ := unstructured.NestedSlice(obj.Object, append(slices.Clone(specPath), field)...)
+			for _, c := range containers {
+				container, ok := c.(map[string]any)
+				if !ok {
+					continue
+				}
+				if image, ok := container["image"].(string); ok && image != "" {
+					images = append(images, image)
+				}
+			}
+		}
+	}
+	return images
+}
+
+// Evaluates a user-supplied CEL expression against objects.
+type lintCELRule struct {
+	cfg     LintCELRule
+	program cel.Program
+}
+
+func newLintCELRule(cfg LintCELRule) (*lintCELRule, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("%w: name must not be empty", ErrLintRuleInvalid)
+	}
+	if cfg.Severity == "" {
+		cfg.Severity = LintSeverityError
+	}
+	if err := validateLintSeverity(cfg.Severity); err != nil {
+		return nil, fmt.Errorf("rule %q: %w", cfg.Name, err)
+	}
+
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(cfg.Rule)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("%w: rule %q: %w", ErrLintRuleInvalid, cfg.Name, iss.Err())
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("%w: rule %q must return a bool", ErrLintRuleInvalid, cfg.Name)
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rule %q: %w", ErrLintRuleInvalid, cfg.Name, err)
+	}
+
+	return &lintCELRule{cfg: cfg, program: program}, nil
+}
+
+func (r *lintCELRule) Name() string { return r.cfg.Name }
+
+func (r *lintCELRule) DefaultSeverity() LintSeverity { return r.cfg.Severity }
+
+func (r *lintCELRule) Lint(
+	_ context.Context, _ *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) (findings []LintFinding, err error) {
+	err = eachLintObject(objects, func(path string, index int, obj unstructured.Unstructured) error {
+		if m := r.cfg.Match; m != nil {
+			gk := obj.GroupVersionKind().GroupKind()
+			if gk.Group != m.Group || gk.Kind != m.Kind {
+				return nil
+			}
+		}
+
+		val, _, err := r.program.Eval(map[string]any{"self": obj.Object})
+		if err != nil {
+			// e.g. accessing fields that are not set on this object.
+			findings = append(findings, LintFinding{
+				Path:    path,
+				Index:   ptr.To(index),
+				Message: fmt.Sprintf("%s %s: evaluating rule: %s", obj.GetKind(), obj.GetName(), err),
+			})
+			return nil
+		}
+		ok, isBool := val.Value().(bool)
+		if !isBool {
+			return fmt.Errorf("%w: rule must return a bool, got %T", ErrLintRuleInvalid, val.Value())
+		}
+		if !ok {
+			findings = append(findings, LintFinding{
+				Path:    path,
+				Index:   ptr.To(index),
+				Message: fmt.Sprintf("%s %s: %s", obj.GetKind(), obj.GetName(), r.cfg.Message),
+			})
+		}
+		return nil
+	})
+	return findings, err
+}
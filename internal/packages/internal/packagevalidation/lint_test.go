@@ -0,0 +1,180 @@
+package packagevalidation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagetypes"
+)
+
+const lintTestDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+  annotations:
+    package-operator.run/phase: deploy
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: '{{ .images.app }}'
+      - name: sidecar
+        image: quay.io/untracked/sidecar:v1
+`
+
+const lintTestConfigMaps = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: no-phase
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unknown-phase
+  annotations:
+    package-operator.run/phase: banana
+`
+
+func newLintTestPackage() *packagetypes.Package {
+	return &packagetypes.Package{
+		Manifest: &manifests.PackageManifest{
+			Spec: manifests.PackageManifestSpec{
+				Phases: []manifests.PackageManifestPhase{{Name: "deploy"}},
+				Images: []manifests.PackageManifestImage{
+					{Name: "app", Image: "quay.io/test/app:v1"},
+				},
+				AvailabilityProbes: []corev1alpha1.ObjectSetProbe{
+					{Selector: corev1alpha1.ProbeSelector{
+						Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "Deployment"},
+					}},
+					{Selector: corev1alpha1.ProbeSelector{
+						Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "StatefulSet"},
+					}},
+				},
+			},
+			Test: manifests.PackageManifestTest{
+				Template: []manifests.PackageManifestTestCaseTemplate{
+					{Name: "a"}, {Name: "b"},
+				},
+			},
+		},
+		Files: packagetypes.Files{
+			"deployment.yaml.gotmpl": []byte(lintTestDeployment),
+			"configmaps.yaml":        []byte(lintTestConfigMaps),
+		},
+	}
+}
+
+func TestLintValidator(t *testing.T) {
+	t.Parallel()
+
+	var warnings []string
+
+	v, err := NewLintValidator(LintConfig{}, func(warning error) {
+		warnings = append(warnings, warning.Error())
+	})
+	require.NoError(t, err)
+
+	err = v.ValidatePackage(context.Background(), newLintTestPackage())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing-phase-annotation: ConfigMap no-phase")
+	assert.Contains(t, err.Error(), `unknown-phase: ConfigMap unknown-phase references undeclared phase "banana"`)
+
+	// Reported once, even though there are two test cases.
+	if assert.Len(t, warnings, 2) {
+		assert.Contains(t, warnings[0], "probe-matches-no-objects: availability probe 1 for StatefulSet.apps")
+		assert.Contains(t, warnings[1], `image-not-in-manifest: Deployment test uses image "quay.io/untracked/sidecar:v1"`)
+	}
+}
+
+func TestLintValidator_Config(t *testing.T) {
+	t.Parallel()
+
+	var warnings []string
+
+	v, err := NewLintValidator(LintConfig{
+		Disable: []string{LintRuleProbeMatchesNoObjects, LintRuleImageNotInManifest},
+		Severity: map[string]LintSeverity{
+			LintRuleMissingPhaseAnnotation: LintSeverityWarning,
+			LintRuleUnknownPhase:           LintSeverityWarning,
+		},
+		Rules: []LintCELRule{
+			{
+				Name:    "require-app-label",
+				Message: "must have an app label",
+				Match:   &LintMatch{Kind: "ConfigMap"},
+				Rule:    `has(self.metadata.labels) && "app" in self.metadata.labels`,
+			},
+		},
+	}, func(warning error) {
+		warnings = append(warnings, warning.Error())
+	})
+	require.NoError(t, err)
+
+	err = v.ValidatePackage(context.Background(), newLintTestPackage())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "require-app-label: ConfigMap no-phase: must have an app label")
+	assert.Contains(t, err.Error(), "require-app-label: ConfigMap unknown-phase: must have an app label")
+	assert.NotContains(t, err.Error(), "Deployment")
+	assert.Len(t, warnings, 2)
+}
+
+func TestNewLintValidator_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for name, cfg := range map[string]LintConfig{
+		"disable unknown":  {Disable: []string{"banana"}},
+		"severity unknown": {Severity: map[string]LintSeverity{"banana": LintSeverityError}},
+		"invalid severity": {Severity: map[string]LintSeverity{LintRuleUnknownPhase: "fatal"}},
+		"duplicate name":   {Rules: []LintCELRule{{Name: LintRuleUnknownPhase, Rule: "true"}}},
+		"invalid cel":      {Rules: []LintCELRule{{Name: "x", Rule: "self.("}}},
+		"non-bool cel":     {Rules: []LintCELRule{{Name: "x", Rule: "1 + 1"}}},
+		"empty name":       {Rules: []LintCELRule{{Rule: "true"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewLintValidator(cfg, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLoadLintConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cfg, err := LoadLintConfig(filepath.Join(dir, LintConfigFilename))
+	require.NoError(t, err)
+	assert.Equal(t, LintConfig{}, cfg)
+
+	path := filepath.Join(dir, LintConfigFilename)
+	require.NoError(t, os.WriteFile(path, []byte(`disable:
+- image-not-in-manifest
+rules:
+- name: no-latest
+  message: must not use latest
+  severity: warning
+  rule: 'true'
+`), os.ModePerm))
+
+	cfg, err = LoadLintConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{LintRuleImageNotInManifest}, cfg.Disable)
+	if assert.Len(t, cfg.Rules, 1) {
+		assert.Equal(t, LintSeverityWarning, cfg.Rules[0].Severity)
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte(`unknown: field`), os.ModePerm))
+	_, err = LoadLintConfig(path)
+	require.ErrorIs(t, err, ErrLintConfigInvalid)
+}
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"package-operator.run/internal/apis/manifests"
+	"package-operator.run/internal/packages/internal/packagerender"
 	"package-operator.run/internal/packages/internal/packagetypes"
 )
 
@@ -19,6 +20,7 @@ import (
 var DefaultObjectValidators = ObjectValidatorList{
 	&ObjectDuplicateValidator{}, &ObjectGVKValidator{},
 	&ObjectLabelsValidator{}, &ObjectPhaseAnnotationValidator{},
+	&ObjectExternalAnnotationValidator{}, &ObjectApplyWeightAnnotationValidator{},
 }
 
 // ObjectValidatorList runs a list of validators and joins all errors.
@@ -192,3 +194,59 @@ func (*ObjectLabelsValidator) validate(
 	}
 	return nil
 }
+
+// Validates the annotations of objects created outside of Package Operator.
+type ObjectExternalAnnotationValidator struct{}
+
+var _ packagetypes.ObjectValidator = (*ObjectExternalAnnotationValidator)(nil)
+
+func (v *ObjectExternalAnnotationValidator) ValidateObjects(
+	ctx context.Context,
+	manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) error {
+	return ValidateEachObject(ctx, manifest, objects, v.validate)
+}
+
+func (*ObjectExternalAnnotationValidator) validate(
+	_ context.Context, path string, index int,
+	obj unstructured.Unstructured, _ *manifests.PackageManifest,
+) error {
+	if _, _, err := packagerender.ExternalFromAnnotations(&obj); err != nil {
+		return packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonInvalidExternalAnnotations,
+			Details: err.Error(),
+			Path:    path,
+			Index:   ptr.To(index),
+		}
+	}
+	return nil
+}
+
+// Validates the annotation ordering objects within their phase.
+type ObjectApplyWeightAnnotationValidator struct{}
+
+var _ packagetypes.ObjectValidator = (*ObjectApplyWeightAnnotationValidator)(nil)
+
+func (v *ObjectApplyWeightAnnotationValidator) ValidateObjects(
+	ctx context.Context,
+	manifest *manifests.PackageManifest,
+	objects map[string][]unstructured.Unstructured,
+) error {
+	return ValidateEachObject(ctx, manifest, objects, v.validate)
+}
+
+func (*ObjectApplyWeightAnnotationValidator) validate(
+	_ context.Context, path string, index int,
+	obj unstructured.Unstructured, _ *manifests.PackageManifest,
+) error {
+	if _, err := packagerender.ApplyWeightFromAnnotations(&obj); err != nil {
+		return packagetypes.ViolationError{
+			Reason:  packagetypes.ViolationReasonInvalidApplyWeightAnnotation,
+			Details: err.Error(),
+			Path:    path,
+			Index:   ptr.To(index),
+		}
+	}
+	return nil
+}
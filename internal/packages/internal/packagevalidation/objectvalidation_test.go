@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	manifestsv1alpha1 "package-operator.run/apis/manifests/v1alpha1"
 	"package-operator.run/internal/apis/manifests"
 )
 
@@ -101,3 +102,52 @@ func TestObjectLabelsValidator(t *testing.T) {
 	errString := `Labels invalid in test.yaml idx 1: metadata.labels: Invalid value: "/123": prefix part must be non-empty`
 	require.EqualError(t, err, errString)
 }
+
+func TestObjectExternalAnnotationValidator(t *testing.T) {
+	t.Parallel()
+
+	oev := &ObjectExternalAnnotationValidator{}
+
+	failObj := unstructured.Unstructured{}
+	failObj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackageExternalAnnotation:              "True",
+		manifestsv1alpha1.PackageExternalMissingPolicyAnnotation: "Ignore",
+	})
+
+	ctx := context.Background()
+	manifest := &manifests.PackageManifest{}
+	err := oev.ValidateObjects(
+		ctx, manifest,
+		map[string][]unstructured.Unstructured{
+			"test.yaml": {{}, failObj},
+		})
+	errString := `External object annotations invalid in test.yaml idx 1: ` +
+		`package-operator.run/external-missing-policy: must be one of Block, Warn`
+	require.EqualError(t, err, errString)
+}
+
+func TestObjectApplyWeightAnnotationValidator(t *testing.T) {
+	t.Parallel()
+
+	oav := &ObjectApplyWeightAnnotationValidator{}
+
+	okObj := unstructured.Unstructured{}
+	okObj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackageApplyWeightAnnotation: "-10",
+	})
+	failObj := unstructured.Unstructured{}
+	failObj.SetAnnotations(map[string]string{
+		manifestsv1alpha1.PackageApplyWeightAnnotation: "first",
+	})
+
+	ctx := context.Background()
+	manifest := &manifests.PackageManifest{}
+	err := oav.ValidateObjects(
+		ctx, manifest,
+		map[string][]unstructured.Unstructured{
+			"test.yaml": {{}, okObj, failObj},
+		})
+	errString := `Apply weight annotation invalid in test.yaml idx 2: ` +
+		`package-operator.run/apply-weight: strconv.ParseInt: parsing "first": invalid syntax`
+	require.EqualError(t, err, errString)
+}
@@ -25,15 +25,33 @@ const testFixturesFolderName = ".test-fixtures"
 type TemplateTestValidator struct {
 	// Path to a folder containing the test fixtures for the package.
 	packageBaseFolderPath string
+	// Regenerate all test fixtures instead of comparing against them.
+	updateFixtures bool
+}
+
+// Configures a TemplateTestValidator.
+type TemplateTestValidatorOption func(v *TemplateTestValidator)
+
+// Regenerates the test fixtures of all template test cases instead of comparing against them.
+// Fixtures of test cases no longer present in the manifest are removed.
+func WithUpdateFixtures(update bool) TemplateTestValidatorOption {
+	return func(v *TemplateTestValidator) {
+		v.updateFixtures = update
+	}
 }
 
 // Creates a new TemplateTestValidator instance.
 func NewTemplateTestValidator(
 	packageBaseFolderPath string,
+	opts ...TemplateTestValidatorOption,
 ) *TemplateTestValidator {
-	return &TemplateTestValidator{
+	v := &TemplateTestValidator{
 		packageBaseFolderPath: packageBaseFolderPath,
 	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
 }
 
 func (v TemplateTestValidator) ValidatePackage(
@@ -57,6 +75,12 @@ func (v TemplateTestValidator) doValidatePackage(
 		subDir = filepath.Join("components", pkg.Manifest.Name)
 	}
 
+	if v.updateFixtures {
+		if err := v.removeStaleFixtures(ctx, pkg, subDir); err != nil {
+			return err
+		}
+	}
+
 	for _, templateTestCase := range pkg.Manifest.Test.Template {
 		log.Info("running template test case", "name", templateTestCase.Name)
 		if err := v.runTestCase(ctx, pkg, templateTestCase, kcV, subDir); err != nil {
@@ -91,9 +115,13 @@ func (v TemplateTestValidator) runTestCase(
 		}
 	}
 
-	if _, err := packagemanifestvalidation.AdmitPackageConfiguration(ctx, configuration, pkg.Manifest, nil); err != nil {
+	ferrs, err := packagemanifestvalidation.AdmitPackageConfiguration(ctx, configuration, pkg.Manifest, nil)
+	if err != nil {
 		return err
 	}
+	if pkg.Manifest.Spec.Rendering.Strict && len(ferrs) > 0 {
+		return fmt.Errorf("config of template test %s: %w", testCase.Name, ferrs.ToAggregate())
+	}
 
 	tmplCtx := packagetypes.PackageRenderContext{
 		Package:     testCase.Context.Package,
@@ -101,6 +129,8 @@ func (v TemplateTestValidator) runTestCase(
 		Images:      generateStaticImages(pkg.Manifest),
 		Environment: testCase.Context.Environment,
 	}
+	tmplCtx.Package.ConfigHash = packagetypes.ConfigHash(configuration)
+	tmplCtx.Package.ConfigChecksums = packagetypes.ConfigChecksums(configuration)
 	if err := packagerender.RenderTemplates(ctx, pkg, tmplCtx); err != nil {
 		return err
 	}
@@ -114,6 +144,13 @@ func (v TemplateTestValidator) runTestCase(
 	testFixturePath := filepath.Join(
 		v.packageBaseFolderPath, subDir,
 		testFixturesFolderName, testCase.Name)
+	if v.updateFixtures {
+		log.Info("updating fixture for test case", "name", testCase.Name)
+		if err := os.RemoveAll(testFixturePath); err != nil {
+			return err
+		}
+		return renderTemplateFiles(testFixturePath, pkg.Files, pathFilteredIndex)
+	}
 	_, err = os.Stat(testFixturePath)
 	if errors.Is(err, os.ErrNotExist) {
 		// no fixtures generated
@@ -183,6 +220,38 @@ func (v TemplateTestValidator) runTestCase(
 	return errors.Join(violations...)
 }
 
+// Removes fixtures of test cases that are no longer part of the manifest.
+func (v TemplateTestValidator) removeStaleFixtures(
+	ctx context.Context, pkg *packagetypes.Package, subDir string,
+) error {
+	log := logr.FromContextOrDiscard(ctx)
+
+	fixturesPath := filepath.Join(v.packageBaseFolderPath, subDir, testFixturesFolderName)
+	entries, err := os.ReadDir(fixturesPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	testCases := map[string]struct{}{}
+	for _, testCase := range pkg.Manifest.Test.Template {
+		testCases[testCase.Name] = struct{}{}
+	}
+
+	for _, entry := range entries {
+		if _, ok := testCases[entry.Name()]; ok {
+			continue
+		}
+		log.Info("removing fixture of unknown test case", "name", entry.Name())
+		if err := os.RemoveAll(filepath.Join(fixturesPath, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func renderTemplateFiles(
 	folder string,
 	fileMap packagetypes.Files,
@@ -181,6 +181,59 @@ File mismatch against fixture in file.yaml: Testcase "t1"
 	require.Equal(t, expectedErr, err.Error())
 }
 
+func TestTemplateTestValidator_updateFixtures(t *testing.T) {
+	t.Parallel()
+	validatorPath := t.TempDir()
+
+	fixturesPath := filepath.Join(validatorPath, testFixturesFolderName)
+	require.NoError(t, os.MkdirAll(filepath.Join(fixturesPath, "t1"), os.ModePerm))
+	require.NoError(t, os.MkdirAll(filepath.Join(fixturesPath, "removed"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(fixturesPath, "t1", "banana.yaml"), []byte("xxx"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(fixturesPath, "t1", "file.yaml"), []byte("xxx\n"), os.ModePerm))
+
+	pkg := &packagetypes.Package{
+		Manifest: &manifests.PackageManifest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-pkg",
+			},
+			Spec: manifests.PackageManifestSpec{
+				Phases: []manifests.PackageManifestPhase{
+					{Name: "tesxx"},
+				},
+			},
+			Test: manifests.PackageManifestTest{
+				Template: []manifests.PackageManifestTestCaseTemplate{
+					{
+						Name: "t1",
+						Context: manifests.TemplateContext{
+							Package: manifests.TemplateContextPackage{
+								TemplateContextObjectMeta: manifests.TemplateContextObjectMeta{
+									Name: "pkg-name",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Files: packagetypes.Files{
+			"file.yaml.gotmpl": []byte(testFile1Content),
+		},
+	}
+
+	ctx := logr.NewContext(context.Background(), testr.New(t))
+	require.NoError(t, NewTemplateTestValidator(validatorPath, WithUpdateFixtures(true)).ValidatePackage(ctx, pkg))
+
+	assert.NoDirExists(t, filepath.Join(fixturesPath, "removed"))
+	assert.NoFileExists(t, filepath.Join(fixturesPath, "t1", "banana.yaml"))
+	fixture, err := os.ReadFile(filepath.Join(fixturesPath, "t1", "file.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(fixture), "property: pkg-name")
+
+	// Fixtures match after the update.
+	require.NoError(t, NewTemplateTestValidator(validatorPath).ValidatePackage(ctx, pkg))
+}
+
 func Test_generateStaticImages(t *testing.T) {
 	t.Parallel()
 	manifest := &manifests.PackageManifest{
@@ -191,7 +191,7 @@ func TestPackageStaticFilesWithoutTestCasesValidator(t *testing.T) {
 				},
 			},
 			err: `loading package from files: Invalid YAML ` +
-				`in test.yaml idx 0: error unmarshaling JSON: ` +
+				`in test.yaml:1 idx 0: error unmarshaling JSON: ` +
 				`while decoding JSON: Object 'Kind' is missing in '{"test":"xxx"}'` +
 				"\ntest: xxx",
 		},
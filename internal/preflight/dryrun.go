@@ -13,15 +13,24 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"package-operator.run/internal/constants"
 )
 
+// ApplyFunc patches an object using server-side apply.
+type ApplyFunc func(
+	ctx context.Context, writer client.Writer,
+	obj client.Object, patch client.Patch, opts ...client.PatchOption,
+) error
+
 type DryRun struct {
 	client client.Writer
+	apply  ApplyFunc
 }
 
-func NewDryRun(client client.Writer) *DryRun { return &DryRun{client: client} }
+// NewDryRun returns a DryRun check applying objects through the given apply function,
+// so dry-runs are sent exactly like the real apply.
+func NewDryRun(client client.Writer, apply ApplyFunc) *DryRun {
+	return &DryRun{client: client, apply: apply}
+}
 
 func (p *DryRun) Check(ctx context.Context, _, obj client.Object) (violations []Violation, err error) {
 	defer addPositionToViolations(ctx, obj, &violations)
@@ -33,7 +42,7 @@ func (p *DryRun) Check(ctx context.Context, _, obj client.Object) (violations []
 
 	patch := client.RawPatch(types.ApplyPatchType, objectPatch)
 	dst := obj.DeepCopyObject().(*unstructured.Unstructured)
-	err = p.client.Patch(ctx, dst, patch, client.FieldOwner(constants.FieldOwner), client.ForceOwnership, client.DryRunAll)
+	err = p.apply(ctx, p.client, dst, patch, client.ForceOwnership, client.DryRunAll)
 
 	if apimachineryerrors.IsNotFound(err) {
 		err = p.client.Create(ctx, obj.DeepCopyObject().(client.Object), client.DryRunAll)
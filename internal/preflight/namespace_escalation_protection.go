@@ -2,22 +2,31 @@ package preflight
 
 import (
 	"context"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 )
 
 // Prevents namespace escalation from users specifying cluster-scoped resources or
 // resources in other namespaces in non-cluster-scoped APIs.
 type NamespaceEscalation struct {
 	restMapper meta.RESTMapper
+	// Cluster-scoped kinds the cluster admin allows namespaced owners to contain.
+	allowedClusterScopedKinds []schema.GroupKind
 }
 
 var _ checker = (*NamespaceEscalation)(nil)
 
-func NewNamespaceEscalation(restMapper meta.RESTMapper) *NamespaceEscalation {
+func NewNamespaceEscalation(
+	restMapper meta.RESTMapper, allowedClusterScopedKinds []schema.GroupKind,
+) *NamespaceEscalation {
 	return &NamespaceEscalation{
-		restMapper: restMapper,
+		restMapper:                restMapper,
+		allowedClusterScopedKinds: allowedClusterScopedKinds,
 	}
 }
 
@@ -60,10 +69,32 @@ func (p *NamespaceEscalation) Check(
 		return violations, err
 	}
 
-	if mapping.Scope != meta.RESTScopeNamespace {
+	if mapping.Scope != meta.RESTScopeNamespace &&
+		!IsClusterScopedKindAllowed(owner, gvk.GroupKind(), p.allowedClusterScopedKinds) {
 		violations = append(violations, Violation{
 			Error: "Must be namespaced scoped when part of an non-cluster-scoped API.",
 		})
 	}
 	return
 }
+
+// IsClusterScopedKindAllowed returns true, when the namespaced owner declares
+// that it may contain cluster-scoped objects of the given kind
+// and the kind is allowed by the cluster admin.
+// The webhook rejects declarations of other kinds early,
+// but owners created while the webhook was unavailable or differently configured are only caught here.
+func IsClusterScopedKindAllowed(owner client.Object, gk schema.GroupKind, allowed []schema.GroupKind) bool {
+	if !slices.Contains(allowed, gk) {
+		return false
+	}
+	objectSet, ok := owner.(*corev1alpha1.ObjectSet)
+	if !ok {
+		return false
+	}
+	for _, allowed := range objectSet.Spec.ClusterScopedKinds {
+		if allowed.Group == gk.Group && allowed.Kind == gk.Kind {
+			return true
+		}
+	}
+	return false
+}
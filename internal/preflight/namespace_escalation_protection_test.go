@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -69,7 +70,7 @@ func TestNamespaceEscalation(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			rm := &restmappermock.RestMapperMock{}
-			ne := NewNamespaceEscalation(rm)
+			ne := NewNamespaceEscalation(rm, nil)
 
 			v, err := ne.Check(test.ctx, test.owner, test.obj)
 			require.NoError(t, err)
@@ -81,7 +82,7 @@ func TestNamespaceEscalation(t *testing.T) {
 func TestNamespaceEscalation_restMapper(t *testing.T) {
 	t.Parallel()
 	rm := &restmappermock.RestMapperMock{}
-	ne := NewNamespaceEscalation(rm)
+	ne := NewNamespaceEscalation(rm, nil)
 
 	owner := &unstructured.Unstructured{}
 	owner.SetNamespace("test-ns")
@@ -110,3 +111,69 @@ func TestNamespaceEscalation_restMapper(t *testing.T) {
 		},
 	}, v)
 }
+
+func TestNamespaceEscalation_ClusterScopedKinds(t *testing.T) {
+	t.Parallel()
+
+	owner := &corev1alpha1.ObjectSet{}
+	owner.SetName("test")
+	owner.SetNamespace("test-ns")
+	owner.Spec.ClusterScopedKinds = []metav1.GroupKind{
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"},
+	}
+	allowed := []schema.GroupKind{
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+		{Group: "rbac.authorization.k8s.io", Kind: "RoleBinding"},
+	}
+
+	tests := []struct {
+		name               string
+		kind               string
+		expectedViolations []Violation
+	}{
+		{
+			name: "allowed",
+			kind: "ClusterRole",
+		},
+		{
+			name: "not allowed by cluster admin",
+			kind: "ClusterRoleBinding",
+			expectedViolations: []Violation{
+				{
+					Position: "ClusterRoleBinding /test",
+					Error:    "Must be namespaced scoped when part of an non-cluster-scoped API.",
+				},
+			},
+		},
+		{
+			name: "not declared by owner",
+			kind: "RoleBinding",
+			expectedViolations: []Violation{
+				{
+					Position: "RoleBinding /test",
+					Error:    "Must be namespaced scoped when part of an non-cluster-scoped API.",
+				},
+			},
+		},
+	}
+	for i := range tests {
+		test := tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			rm := &restmappermock.RestMapperMock{}
+			rm.On("RESTMapping").Return(&meta.RESTMapping{Scope: meta.RESTScopeRoot}, nil)
+			ne := NewNamespaceEscalation(rm, allowed)
+
+			obj := &unstructured.Unstructured{}
+			obj.SetName("test")
+			obj.SetAPIVersion("rbac.authorization.k8s.io/v1")
+			obj.SetKind(test.kind)
+
+			v, err := ne.Check(context.Background(), owner, obj)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedViolations, v)
+		})
+	}
+}
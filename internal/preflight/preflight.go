@@ -10,12 +10,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/errorclass"
 )
 
 type Error struct {
 	Violations []Violation
 }
 
+// ErrorClass implements errorclass.Of.
+func (e *Error) ErrorClass() errorclass.Class {
+	return errorclass.Config
+}
+
 func (e *Error) Error() string {
 	vs := make([]string, len(e.Violations))
 	for i, v := range e.Violations {
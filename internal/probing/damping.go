@@ -0,0 +1,176 @@
+package probing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/pkg/probing"
+)
+
+// Results of objects that have not been probed for this long are forgotten.
+const dampingStateTTL = time.Hour
+
+// Damping remembers probe results of objects across evaluations,
+// to apply the failure and success thresholds and damping windows of ObjectSetProbes.
+type Damping struct {
+	clock clock.PassiveClock
+
+	lock   sync.Mutex
+	states map[dampingKey]*dampingState
+}
+
+type dampingKey struct {
+	owner  types.UID
+	probe  int
+	object types.UID
+}
+
+type dampingState struct {
+	// Result reported for the object.
+	failing bool
+	// Consecutive evaluations with the same outcome.
+	successes, failures int32
+	// Start of the current streak of failed evaluations.
+	failingSince time.Time
+	lastProbed   time.Time
+}
+
+func NewDamping() *Damping {
+	return &Damping{
+		clock:  clock.RealClock{},
+		states: map[dampingKey]*dampingState{},
+	}
+}
+
+// Parse works like probing.Parse from the public probing package,
+// but applies the thresholds and damping windows of the probes to the objects of owner.
+func (d *Damping) Parse(
+	ctx context.Context, owner types.UID, packageProbes []corev1alpha1.ObjectSetProbe,
+) (probing.Prober, error) {
+	d.gc()
+
+	return parse(ctx, packageProbes,
+		func(index int, pkgProbe corev1alpha1.ObjectSetProbe, probe probing.Prober) probing.Prober {
+			p := &dampedProbe{
+				Prober:           probe,
+				damping:          d,
+				owner:            owner,
+				index:            index,
+				failureThreshold: max(pkgProbe.FailureThreshold, 1),
+				successThreshold: max(pkgProbe.SuccessThreshold, 1),
+			}
+			if pkgProbe.DampingWindow != nil {
+				p.window = pkgProbe.DampingWindow.Duration
+			}
+			if p.failureThreshold == 1 && p.successThreshold == 1 && p.window <= 0 {
+				// Nothing to remember.
+				return probe
+			}
+			return p
+		})
+}
+
+// Pending returns true while an object of owner has a result
+// held back by a threshold or damping window.
+func (d *Damping) Pending(owner types.UID) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for key, s := range d.states {
+		if key.owner != owner {
+			continue
+		}
+		if s.failing && s.successes > 0 || !s.failing && s.failures > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Forget drops all results remembered for the objects of owner.
+func (d *Damping) Forget(owner types.UID) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for key := range d.states {
+		if key.owner == owner {
+			delete(d.states, key)
+		}
+	}
+}
+
+func (d *Damping) gc() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	now := d.clock.Now()
+	for key, s := range d.states {
+		if now.Sub(s.lastProbed) > dampingStateTTL {
+			delete(d.states, key)
+		}
+	}
+}
+
+// Reports a changed probe outcome only after it persisted.
+// Objects evaluated for the first time report their outcome directly.
+type dampedProbe struct {
+	probing.Prober
+	damping *Damping
+	owner   types.UID
+	index   int
+
+	failureThreshold int32
+	successThreshold int32
+	window           time.Duration
+}
+
+func (p *dampedProbe) Probe(obj *unstructured.Unstructured) (success bool, message string) {
+	success, message = p.Prober.Probe(obj)
+
+	d := p.damping
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	now := d.clock.Now()
+	key := dampingKey{owner: p.owner, probe: p.index, object: obj.GetUID()}
+	s, ok := d.states[key]
+	if !ok {
+		s = &dampingState{failing: !success}
+		d.states[key] = s
+	}
+	s.lastProbed = now
+
+	if success {
+		s.failures = 0
+		s.failingSince = time.Time{}
+		s.successes++
+		if s.failing && s.successes >= p.successThreshold {
+			s.failing = false
+		}
+	} else {
+		s.successes = 0
+		s.failures++
+		if s.failingSince.IsZero() {
+			s.failingSince = now
+		}
+		if !s.failing && s.failures >= p.failureThreshold && now.Sub(s.failingSince) >= p.window {
+			s.failing = true
+		}
+	}
+
+	switch {
+	case s.failing && success:
+		return false, fmt.Sprintf("recovering, passed %d of %d consecutive evaluations",
+			s.successes, p.successThreshold)
+	case s.failing:
+		return false, message
+	}
+	return true, ""
+}
@@ -0,0 +1,106 @@
+package probing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestDamping(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktesting.NewFakeClock(time.Now())
+	d := NewDamping()
+	d.clock = clock
+
+	probes := []corev1alpha1.ObjectSetProbe{{
+		Selector: corev1alpha1.ProbeSelector{
+			Kind: &corev1alpha1.PackageProbeKindSpec{Group: "apps", Kind: "Deployment"},
+		},
+		Probes: []corev1alpha1.Probe{{
+			Condition: &corev1alpha1.ProbeConditionSpec{Type: "Available", Status: "True"},
+		}},
+		FailureThreshold: 2,
+		SuccessThreshold: 2,
+		DampingWindow:    &metav1.Duration{Duration: time.Minute},
+	}}
+	probe, err := d.Parse(context.Background(), "owner", probes)
+	require.NoError(t, err)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+	obj.SetUID("obj")
+	setAvailable := func(status string) {
+		_ = unstructured.SetNestedSlice(obj.Object, []any{
+			map[string]any{"type": "Available", "status": status},
+		}, "status", "conditions")
+	}
+
+	// First evaluation is reported directly.
+	setAvailable("True")
+	success, _ := probe.Probe(obj)
+	assert.True(t, success)
+	assert.False(t, d.Pending("owner"))
+
+	// Failures are held back by the threshold and damping window.
+	setAvailable("False")
+	success, _ = probe.Probe(obj)
+	assert.True(t, success)
+	assert.True(t, d.Pending("owner"))
+	success, _ = probe.Probe(obj)
+	assert.True(t, success, "damping window not passed")
+
+	clock.Step(time.Minute)
+	success, msg := probe.Probe(obj)
+	assert.False(t, success)
+	assert.NotEmpty(t, msg)
+	assert.False(t, d.Pending("owner"))
+
+	// Recovery is held back by the success threshold.
+	setAvailable("True")
+	success, msg = probe.Probe(obj)
+	assert.False(t, success)
+	assert.Equal(t, "recovering, passed 1 of 2 consecutive evaluations", msg)
+	assert.True(t, d.Pending("owner"))
+	success, _ = probe.Probe(obj)
+	assert.True(t, success)
+
+	// A single failure does not flip the result.
+	setAvailable("False")
+	success, _ = probe.Probe(obj)
+	assert.True(t, success)
+	setAvailable("True")
+	success, _ = probe.Probe(obj)
+	assert.True(t, success)
+	assert.False(t, d.Pending("owner"))
+
+	d.Forget("owner")
+	assert.Empty(t, d.states)
+}
+
+func TestDamping_noThresholds(t *testing.T) {
+	t.Parallel()
+
+	d := NewDamping()
+	probes := []corev1alpha1.ObjectSetProbe{{
+		Probes: []corev1alpha1.Probe{{
+			Condition: &corev1alpha1.ProbeConditionSpec{Type: "Available", Status: "True"},
+		}},
+		FailureThreshold: 1,
+	}}
+	probe, err := d.Parse(context.Background(), "owner", probes)
+	require.NoError(t, err)
+
+	success, _ := probe.Probe(&unstructured.Unstructured{Object: map[string]any{}})
+	assert.False(t, success)
+	assert.Empty(t, d.states)
+}
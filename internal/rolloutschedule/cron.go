@@ -0,0 +1,147 @@
+package rolloutschedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidCron is returned for cron expressions that can not be parsed.
+	ErrInvalidCron    = errors.New("invalid cron expression")
+	errCronValue      = errors.New("invalid value")
+	errCronOutOfRange = errors.New("out of range")
+)
+
+// Searching for the next match gives up after this many years,
+// e.g. for expressions like "0 0 31 2 *" that never match.
+const maxSearchYears = 5
+
+// cronExpression matches points in time with a resolution of minutes.
+// Each field is a bit set of the values it matches.
+type cronExpression struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// Day of month and day of week are OR-ed, if both are restricted.
+	dayOfMonthStar, dayOfWeekStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is accepted as alias for Sunday.
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Parses the standard 5 field cron format "minute hour day-of-month month day-of-week".
+// Fields support "*", single values, ranges "1-5", lists "1,3" and steps "*/15" or "0-30/10".
+func parseCron(expr string) (cronExpression, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return cronExpression{}, fmt.Errorf(
+			"%w: %q must have %d fields, has %d", ErrInvalidCron, expr, len(cronFields), len(parts))
+	}
+
+	sets := make([]uint64, len(cronFields))
+	for i, part := range parts {
+		set, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return cronExpression{}, fmt.Errorf("%w: %s: %w", ErrInvalidCron, cronFields[i].name, err)
+		}
+		sets[i] = set
+	}
+
+	// Sunday may be given as 0 or 7.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+	return cronExpression{
+		minute:         sets[0],
+		hour:           sets[1],
+		dayOfMonth:     sets[2],
+		month:          sets[3],
+		dayOfWeek:      sets[4],
+		dayOfMonthStar: strings.HasPrefix(parts[2], "*"),
+		dayOfWeekStar:  strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+func parseCronField(field string, f cronField) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 {
+				return 0, fmt.Errorf("%w: step %q", errCronValue, stepStr)
+			}
+		}
+
+		start, end := f.min, f.max
+		if rng != "*" {
+			startStr, endStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if start, err = strconv.Atoi(startStr); err != nil {
+				return 0, fmt.Errorf("%w: %q", errCronValue, startStr)
+			}
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(endStr); err != nil {
+					return 0, fmt.Errorf("%w: %q", errCronValue, endStr)
+				}
+			} else if hasStep {
+				// "5/15" means every 15 starting at 5.
+				end = f.max
+			}
+		}
+		if start < f.min || end > f.max || start > end {
+			return 0, fmt.Errorf("%w: %q, allowed %d-%d", errCronOutOfRange, item, f.min, f.max)
+		}
+		for v := start; v <= end; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+func (c cronExpression) matchesDay(t time.Time) bool {
+	dom := c.dayOfMonth&(1<<uint(t.Day())) != 0
+	dow := c.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if c.dayOfMonthStar || c.dayOfWeekStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Returns the first minute strictly after t matching the expression, evaluated in the location of t.
+// Returns false if there is no match within the next years.
+func (c cronExpression) next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + maxSearchYears
+
+	for t.Year() <= limit {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
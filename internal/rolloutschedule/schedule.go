@@ -0,0 +1,97 @@
+// Package rolloutschedule evaluates maintenance windows restricting when new revisions may be rolled out.
+package rolloutschedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	// Embeds the time zone database, so time zones also resolve in minimal container images.
+	_ "time/tzdata"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+var (
+	// ErrInvalidSchedule is returned for rollout schedules that can not be evaluated.
+	ErrInvalidSchedule = errors.New("invalid rollout schedule")
+	errNoWindows       = errors.New("at least one window is required")
+	errNonPositive     = errors.New("must be greater than zero")
+	errNeverMatches    = errors.New("never matches")
+)
+
+// Schedule is a parsed RolloutSchedule.
+type Schedule struct {
+	location *time.Location
+	windows  []window
+}
+
+type window struct {
+	start    cronExpression
+	duration time.Duration
+}
+
+// New parses the given RolloutSchedule.
+func New(schedule *corev1alpha1.RolloutSchedule) (*Schedule, error) {
+	s := &Schedule{location: time.UTC}
+	if len(schedule.TimeZone) != 0 {
+		loc, err := time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("%w: timeZone: %w", ErrInvalidSchedule, err)
+		}
+		s.location = loc
+	}
+
+	if len(schedule.Windows) == 0 {
+		return nil, fmt.Errorf("%w: windows: %w", ErrInvalidSchedule, errNoWindows)
+	}
+	for i, w := range schedule.Windows {
+		start, err := parseCron(w.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("%w: windows[%d].schedule: %w", ErrInvalidSchedule, i, err)
+		}
+		// Rejects dates that do not exist, like the 30th of February.
+		// The search from a leap year also finds the 29th of February.
+		if _, ok := start.next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)); !ok {
+			return nil, fmt.Errorf("%w: windows[%d].schedule: %w", ErrInvalidSchedule, i, errNeverMatches)
+		}
+		if w.Duration.Duration <= 0 {
+			return nil, fmt.Errorf("%w: windows[%d].duration: %w", ErrInvalidSchedule, i, errNonPositive)
+		}
+		s.windows = append(s.windows, window{start: start, duration: w.Duration.Duration})
+	}
+	return s, nil
+}
+
+// IsOpen returns true if t lies within any of the windows.
+func (s *Schedule) IsOpen(t time.Time) bool {
+	t = t.In(s.location)
+	for _, w := range s.windows {
+		// Find the latest window start that could still include t.
+		start, ok := w.start.next(t.Add(-w.duration))
+		if ok && !start.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns the time the schedule opens next.
+// Returns t itself when a window is currently open and false if no window will ever open.
+func (s *Schedule) NextOpen(t time.Time) (time.Time, bool) {
+	if s.IsOpen(t) {
+		return t, true
+	}
+
+	var (
+		next  time.Time
+		found bool
+	)
+	for _, w := range s.windows {
+		start, ok := w.start.next(t.In(s.location))
+		if ok && (!found || start.Before(next)) {
+			next, found = start, true
+		}
+	}
+	return next, found
+}
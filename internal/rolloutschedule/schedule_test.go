@@ -0,0 +1,169 @@
+package rolloutschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestNew_invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		schedule corev1alpha1.RolloutSchedule
+		errMsg   string
+	}{
+		{
+			name: "unknown time zone",
+			schedule: corev1alpha1.RolloutSchedule{
+				TimeZone: "Mars/Olympus_Mons",
+				Windows:  []corev1alpha1.RolloutWindow{{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Hour}}},
+			},
+			errMsg: "timeZone",
+		},
+		{
+			name:     "no windows",
+			schedule: corev1alpha1.RolloutSchedule{},
+			errMsg:   "windows: at least one window is required",
+		},
+		{
+			name: "too few fields",
+			schedule: corev1alpha1.RolloutSchedule{
+				Windows: []corev1alpha1.RolloutWindow{{Schedule: "0 22 * *", Duration: metav1.Duration{Duration: time.Hour}}},
+			},
+			errMsg: "windows[0].schedule",
+		},
+		{
+			name: "out of range",
+			schedule: corev1alpha1.RolloutSchedule{
+				Windows: []corev1alpha1.RolloutWindow{{Schedule: "0 24 * * *", Duration: metav1.Duration{Duration: time.Hour}}},
+			},
+			errMsg: "hour: out of range",
+		},
+		{
+			name: "invalid step",
+			schedule: corev1alpha1.RolloutSchedule{
+				Windows: []corev1alpha1.RolloutWindow{{Schedule: "*/0 * * * *", Duration: metav1.Duration{Duration: time.Hour}}},
+			},
+			errMsg: "minute: invalid value",
+		},
+		{
+			name: "never matches",
+			schedule: corev1alpha1.RolloutSchedule{
+				Windows: []corev1alpha1.RolloutWindow{{Schedule: "0 0 31 2 *", Duration: metav1.Duration{Duration: time.Hour}}},
+			},
+			errMsg: "windows[0].schedule: never matches",
+		},
+		{
+			name: "zero duration",
+			schedule: corev1alpha1.RolloutSchedule{
+				Windows: []corev1alpha1.RolloutWindow{{Schedule: "0 22 * * *"}},
+			},
+			errMsg: "windows[0].duration",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(&test.schedule)
+			require.ErrorIs(t, err, ErrInvalidSchedule)
+			assert.Contains(t, err.Error(), test.errMsg)
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+
+	// Weekdays from 22:00 to 02:00 in Berlin.
+	s, err := New(&corev1alpha1.RolloutSchedule{
+		TimeZone: "Europe/Berlin",
+		Windows: []corev1alpha1.RolloutWindow{
+			{Schedule: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		},
+	})
+	require.NoError(t, err)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		open     bool
+		nextOpen time.Time
+	}{
+		{
+			name:     "monday afternoon",
+			now:      time.Date(2024, 6, 3, 15, 0, 0, 0, berlin),
+			nextOpen: time.Date(2024, 6, 3, 22, 0, 0, 0, berlin),
+		},
+		{
+			name:     "window start",
+			now:      time.Date(2024, 6, 3, 22, 0, 0, 0, berlin),
+			open:     true,
+			nextOpen: time.Date(2024, 6, 3, 22, 0, 0, 0, berlin),
+		},
+		{
+			name:     "past midnight, in UTC",
+			now:      time.Date(2024, 6, 3, 23, 30, 0, 0, time.UTC),
+			open:     true,
+			nextOpen: time.Date(2024, 6, 3, 23, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "window end",
+			now:      time.Date(2024, 6, 4, 2, 0, 0, 0, berlin),
+			nextOpen: time.Date(2024, 6, 4, 22, 0, 0, 0, berlin),
+		},
+		{
+			name:     "friday night into saturday",
+			now:      time.Date(2024, 6, 8, 1, 0, 0, 0, berlin),
+			open:     true,
+			nextOpen: time.Date(2024, 6, 8, 1, 0, 0, 0, berlin),
+		},
+		{
+			name:     "weekend",
+			now:      time.Date(2024, 6, 8, 12, 0, 0, 0, berlin),
+			nextOpen: time.Date(2024, 6, 10, 22, 0, 0, 0, berlin),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.open, s.IsOpen(test.now))
+			next, ok := s.NextOpen(test.now)
+			require.True(t, ok)
+			assert.True(t, test.nextOpen.Equal(next), "expected %s, got %s", test.nextOpen, next)
+		})
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	t.Parallel()
+
+	c, err := parseCron("0,30 */6 1-7 * 0")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1|1<<30), c.minute)
+	assert.Equal(t, uint64(1|1<<6|1<<12|1<<18), c.hour)
+
+	// Day of month OR day of week, when both are restricted:
+	// the first week of the month and every sunday.
+	next, ok := c.next(time.Date(2024, 6, 7, 23, 59, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC), next)
+
+	// Sunday as 7.
+	c, err = parseCron("0 0 * * 7")
+	require.NoError(t, err)
+	next, ok = c.next(time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC), next)
+}
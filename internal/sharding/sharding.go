@@ -0,0 +1,67 @@
+// Package sharding spreads the reconciliation of objects
+// across multiple active manager replicas.
+package sharding
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ErrInvalidShard is returned for shard indexes outside of the shard count.
+var ErrInvalidShard = errors.New("invalid shard")
+
+// Shard identifies the subset of objects reconciled by a manager replica.
+// Objects are assigned to shards by hashing their namespace and name.
+type Shard struct {
+	// Index of this shard, starting at 0.
+	Index int
+	// Total number of shards, sharding is disabled for values <= 1.
+	Count int
+}
+
+// Validate checks that the shard index is within the shard count.
+func (s Shard) Validate() error {
+	if !s.Enabled() {
+		return nil
+	}
+	if s.Index < 0 || s.Index >= s.Count {
+		return fmt.Errorf("%w: index %d must be between 0 and %d", ErrInvalidShard, s.Index, s.Count-1)
+	}
+	return nil
+}
+
+// Enabled returns true if objects are spread across multiple shards.
+func (s Shard) Enabled() bool {
+	return s.Count > 1
+}
+
+// IsPrimary returns true for the shard that also runs all controllers that are not sharded.
+// Without sharding the only replica is always primary, regardless of its index.
+func (s Shard) IsPrimary() bool {
+	return !s.Enabled() || s.Index == 0
+}
+
+// Owns returns true if the given object is reconciled by this shard.
+func (s Shard) Owns(obj client.Object) bool {
+	if !s.Enabled() {
+		return true
+	}
+	return ForObject(obj, s.Count) == s.Index
+}
+
+// Predicate filters events for objects not owned by this shard.
+func (s Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(s.Owns)
+}
+
+// ForObject returns the index of the shard the object belongs to.
+func ForObject(obj client.Object, count int) int {
+	h := fnv.New32a()
+	// hash.Hash never returns errors.
+	_, _ = h.Write([]byte(obj.GetNamespace() + "/" + obj.GetName()))
+	return int(h.Sum32() % uint32(count)) //nolint:gosec
+}
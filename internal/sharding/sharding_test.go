@@ -0,0 +1,59 @@
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestShard_Owns(t *testing.T) {
+	t.Parallel()
+
+	shards := []Shard{
+		{Index: 0, Count: 3},
+		{Index: 1, Count: 3},
+		{Index: 2, Count: 3},
+	}
+	for i := 0; i < 50; i++ {
+		obj := &corev1alpha1.ObjectSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("test-%d", i),
+				Namespace: "test",
+			},
+		}
+
+		var owners int
+		for _, s := range shards {
+			if s.Owns(obj) {
+				owners++
+			}
+		}
+		assert.Equal(t, 1, owners, "object must be owned by exactly one shard")
+		assert.True(t, Shard{}.Owns(obj), "disabled sharding owns every object")
+	}
+}
+
+func TestShard_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Shard{}.Validate())
+	require.NoError(t, Shard{Index: 1, Count: 2}.Validate())
+	require.ErrorIs(t, Shard{Index: 2, Count: 2}.Validate(), ErrInvalidShard)
+	require.ErrorIs(t, Shard{Index: -1, Count: 2}.Validate(), ErrInvalidShard)
+}
+
+func TestShard_IsPrimary(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Shard{}.IsPrimary())
+	assert.True(t, Shard{Index: 0, Count: 2}.IsPrimary())
+	assert.False(t, Shard{Index: 1, Count: 2}.IsPrimary())
+	// A stray index must not drop the unsharded controllers while sharding is disabled.
+	assert.True(t, Shard{Index: 1, Count: 1}.IsPrimary())
+	assert.True(t, Shard{Index: 3}.IsPrimary())
+}
@@ -33,3 +33,11 @@ func (m *PhaseReconcilerMock) TeardownPhase(
 	args := m.Called(ctx, owner, phase)
 	return args.Bool(0), args.Error(1)
 }
+
+func (m *PhaseReconcilerMock) PreviewPhase(
+	ctx context.Context, owner controllers.PhaseObjectOwner,
+	phase corev1alpha1.ObjectSetTemplatePhase,
+) error {
+	args := m.Called(ctx, owner, phase)
+	return args.Error(0)
+}
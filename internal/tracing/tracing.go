@@ -0,0 +1,126 @@
+// Package tracing instruments Package Operator with OpenTelemetry spans.
+//
+// Trace context is handed from one controller to the next via an annotation
+// on the objects they create or change, so a rollout can be followed from the
+// Package via the ObjectDeployment down to its ObjectSets.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	tracerName = "package-operator.run"
+
+	// TraceParentAnnotation holds the W3C traceparent of the span that last changed an object.
+	TraceParentAnnotation = "package-operator.run/traceparent"
+
+	traceParentKey = "traceparent"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Setup installs a global tracer provider that exports spans via OTLP/gRPC to the given endpoint.
+// The returned function flushes outstanding spans and must be called on shutdown.
+func Setup(
+	ctx context.Context, serviceName, endpoint string, insecure bool,
+) (shutdown func(context.Context) error, err error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a new span as child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartReconcile starts a span for a reconciliation of obj.
+// The span is linked to the span that last changed obj, if recorded in the TraceParentAnnotation.
+func StartReconcile(ctx context.Context, kind string, obj client.Object) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithAttributes(
+			attribute.String("namespace", obj.GetNamespace()),
+			attribute.String("name", obj.GetName()),
+		),
+	}
+	if link, ok := linkFromAnnotation(obj); ok {
+		opts = append(opts, trace.WithLinks(link))
+	}
+
+	return otel.Tracer(tracerName).Start(ctx, kind+".Reconcile", opts...)
+}
+
+// End records err on the span, if not nil, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectAnnotation records the span context of ctx in the TraceParentAnnotation of obj,
+// so reconcilers of obj can link their spans to it.
+func InjectAnnotation(ctx context.Context, obj client.Object) {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	traceParent := carrier.Get(traceParentKey)
+	if len(traceParent) == 0 {
+		// No sampled span in context.
+		return
+	}
+
+	// Copy annotations, the map might be shared with other objects.
+	annotations := maps.Clone(obj.GetAnnotations())
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[TraceParentAnnotation] = traceParent
+	obj.SetAnnotations(annotations)
+}
+
+func linkFromAnnotation(obj client.Object) (trace.Link, bool) {
+	traceParent, ok := obj.GetAnnotations()[TraceParentAnnotation]
+	if !ok {
+		return trace.Link{}, false
+	}
+
+	carrier := propagation.MapCarrier{traceParentKey: traceParent}
+	sc := trace.SpanContextFromContext(propagator.Extract(context.Background(), carrier))
+	if !sc.IsValid() {
+		return trace.Link{}, false
+	}
+
+	return trace.Link{SpanContext: sc}, true
+}
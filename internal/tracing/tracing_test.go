@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+var errTest = errors.New("test")
+
+func TestInjectAnnotation_StartReconcile(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)).Tracer("test")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	annotations := map[string]string{"test": "test"}
+	obj := &corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: annotations},
+	}
+	InjectAnnotation(ctx, obj)
+	parent.End()
+
+	assert.Contains(t, obj.Annotations[TraceParentAnnotation], parent.SpanContext().TraceID().String())
+	assert.NotContains(t, annotations, TraceParentAnnotation, "must not modify shared annotations")
+
+	link, ok := linkFromAnnotation(obj)
+	require.True(t, ok)
+	assert.Equal(t, parent.SpanContext().TraceID(), link.SpanContext.TraceID())
+	assert.Equal(t, parent.SpanContext().SpanID(), link.SpanContext.SpanID())
+}
+
+func TestInjectAnnotation_noSpan(t *testing.T) {
+	t.Parallel()
+
+	obj := &corev1alpha1.ObjectSet{}
+	InjectAnnotation(context.Background(), obj)
+	assert.Empty(t, obj.Annotations)
+}
+
+func TestLinkFromAnnotation_invalid(t *testing.T) {
+	t.Parallel()
+
+	obj := &corev1alpha1.ObjectSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{TraceParentAnnotation: "banana"},
+		},
+	}
+	_, ok := linkFromAnnotation(obj)
+	assert.False(t, ok)
+}
+
+func TestEnd(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)).Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "test")
+	End(span, errTest)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "test", spans[0].Status.Description)
+}
@@ -4,11 +4,14 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
 	"sigs.k8s.io/yaml"
+
+	"package-operator.run/internal/utils"
 )
 
 // allow all sprig functions except dates, random, crypto, os, network and filepath.
@@ -249,6 +252,12 @@ func SprigFuncs(t *template.Template) template.FuncMap {
 
 	allowedFuncs["toYAML"] = toYAML
 	allowedFuncs["fromYAML"] = fromYAML
+	// Spelling used by Helm, to ease porting charts.
+	allowedFuncs["toYaml"] = toYAML
+	allowedFuncs["fromYaml"] = fromYAML
+	allowedFuncs["fromYamlArray"] = fromYAMLArray
+	allowedFuncs["truncateName"] = truncateName
+	allowedFuncs["checksum"] = checksum
 	return allowedFuncs
 }
 
@@ -297,3 +306,53 @@ func fromYAML(y any) (out any, err error) {
 
 	return out, yaml.Unmarshal(b, &out)
 }
+
+func fromYAMLArray(y any) ([]any, error) {
+	out, err := fromYAML(y)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return []any{}, nil
+	}
+
+	arr, ok := out.([]any)
+	if !ok {
+		return nil, fmt.Errorf(
+			"fromYamlArray requires a YAML array as input: %w", ErrInvalidType)
+	}
+
+	return arr, nil
+}
+
+var ErrInvalidLimit = errors.New("invalid limit")
+
+// Length of the hex encoded fnv32a hash appended to truncated names.
+const truncateNameHashLength = 8
+
+// Shortens name to at most limit characters.
+// Names exceeding the limit are cut and suffixed with a hash of the full name,
+// so that distinct names remain distinct after shortening.
+func truncateName(limit int, name string) (string, error) {
+	if len(name) <= limit {
+		return name, nil
+	}
+
+	// At least one character of the name is kept in front of "-<hash>".
+	if minLimit := truncateNameHashLength + 2; limit < minLimit {
+		return "", fmt.Errorf("truncateName requires a limit of at least %d: %w", minLimit, ErrInvalidLimit)
+	}
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(name))
+
+	prefix := strings.TrimRight(name[:limit-truncateNameHashLength-1], "-.")
+
+	return fmt.Sprintf("%s-%0*x", prefix, truncateNameHashLength, hasher.Sum32()), nil
+}
+
+// Returns the SHA256 checksum of any value.
+// Maps are hashed with sorted keys, so the checksum only changes with the value.
+func checksum(value any) string {
+	return utils.ComputeSHA256Hash(value, nil)
+}
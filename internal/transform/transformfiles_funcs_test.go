@@ -3,6 +3,7 @@ package transform
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 	"text/template"
 
@@ -72,7 +73,7 @@ func TestSprigAllowedFuncs(t *testing.T) {
 	tmpl := template.New("xxx")
 	actual := SprigFuncs(tmpl)
 
-	require.Len(t, actual, len(allowedFuncNames)+4)
+	require.Len(t, actual, len(allowedFuncNames)+9)
 
 	for key := range allowedFuncNames {
 		require.Contains(t, actual, key)
@@ -179,3 +180,116 @@ func Test_fromYAML(t *testing.T) {
 		require.ErrorIs(t, err, ErrInvalidType)
 	})
 }
+
+func Test_fromYAMLArray(t *testing.T) {
+	t.Parallel()
+
+	t.Run("array", func(t *testing.T) {
+		t.Parallel()
+
+		y, err := fromYAMLArray("- a\n- b")
+		require.NoError(t, err)
+		assert.Equal(t, []any{"a", "b"}, y)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		y, err := fromYAMLArray("")
+		require.NoError(t, err)
+		assert.Equal(t, []any{}, y)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := fromYAMLArray("t: 2")
+		require.ErrorIs(t, err, ErrInvalidType)
+	})
+}
+
+func Test_truncateName(t *testing.T) {
+	t.Parallel()
+
+	name, err := truncateName(63, "short")
+	require.NoError(t, err)
+	assert.Equal(t, "short", name)
+
+	long := strings.Repeat("a", 50)
+	truncated, err := truncateName(40, long)
+	require.NoError(t, err)
+	assert.Len(t, truncated, 40)
+	assert.True(t, strings.HasPrefix(truncated, strings.Repeat("a", 31)+"-"))
+
+	// names only differing after the limit must not collide.
+	other, err := truncateName(40, long+"b")
+	require.NoError(t, err)
+	assert.NotEqual(t, truncated, other)
+
+	// separators are not left dangling in front of the hash.
+	dangling, err := truncateName(13, "abc-efghijklmn")
+	require.NoError(t, err)
+	assert.Regexp(t, `^abc-[0-9a-f]{8}$`, dangling)
+
+	_, err = truncateName(9, long)
+	require.ErrorIs(t, err, ErrInvalidLimit)
+}
+
+func Test_checksum(t *testing.T) {
+	t.Parallel()
+
+	sum := checksum(map[string]any{"host": "db", "port": float64(5432)})
+	assert.Len(t, sum, 64)
+	assert.Equal(t, sum, checksum(map[string]any{"port": float64(5432), "host": "db"}),
+		"must not depend on key order")
+	assert.NotEqual(t, sum, checksum(map[string]any{"host": "db", "port": float64(5433)}))
+}
+
+// Functions commonly used in Helm charts.
+func TestHelmFuncs(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		template string
+		data     any
+		expected string
+	}{
+		"toYaml nindent": {
+			template: "labels:{{ toYaml . | nindent 2 }}",
+			data:     map[string]string{"app": "test"},
+			expected: "labels:\n  app: test",
+		},
+		"fromYaml": {
+			template: `{{ (fromYaml "a: b").a }}`,
+			expected: "b",
+		},
+		"default": {
+			template: `{{ .missing | default "fallback" }}`,
+			data:     map[string]any{"missing": nil},
+			expected: "fallback",
+		},
+		"b64": {
+			template: `{{ "test" | b64enc }} {{ "dGVzdA==" | b64dec }}`,
+			expected: "dGVzdA== test",
+		},
+		"sha256sum": {
+			template: `{{ "test" | sha256sum }}`,
+			expected: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		},
+		"merge": {
+			template: `{{ $m := merge (dict "a" 1) (dict "a" 2 "b" 3) }}{{ $m.a }} {{ $m.b }}`,
+			expected: "1 3",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := TemplateWithSprigFuncs(tc.template)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, tmpl.Execute(&buf, tc.data))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
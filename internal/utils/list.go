@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Default number of objects requested per page when listing objects from the API server.
+const DefaultListPageSize int64 = 500
+
+// ListPages lists objects in pages of pageSize objects,
+// following continue tokens until pageFn was called for every page.
+// A pageSize of 0 requests all objects at once.
+//
+// Readers backed by an informer cache truncate results to the page size
+// without returning a continue token, so only pass a pageSize > 0
+// when the reader talks to the API server directly.
+func ListPages[T client.ObjectList](
+	ctx context.Context, reader client.Reader,
+	newList func() T, pageSize int64,
+	pageFn func(page T) error,
+	opts ...client.ListOption,
+) error {
+	var continueToken string
+	for {
+		pageOpts := append([]client.ListOption{}, opts...)
+		if pageSize > 0 {
+			pageOpts = append(pageOpts, client.Limit(pageSize), client.Continue(continueToken))
+		}
+
+		// Use a fresh list for every page, so items of previous pages are not overridden while decoding.
+		page := newList()
+		if err := reader.List(ctx, page, pageOpts...); err != nil {
+			return err
+		}
+		if err := pageFn(page); err != nil {
+			return err
+		}
+
+		continueToken = page.GetContinue()
+		if pageSize == 0 || len(continueToken) == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"package-operator.run/internal/testutil"
+)
+
+func TestListPages(t *testing.T) {
+	t.Parallel()
+
+	c := testutil.NewClient()
+	c.
+		On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(opts []client.ListOption) bool {
+			listOpts := &client.ListOptions{}
+			listOpts.ApplyOptions(opts)
+			return listOpts.Limit == 1 && listOpts.Continue == ""
+		})).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1.ConfigMapList)
+			list.Items = []corev1.ConfigMap{{}}
+			list.Continue = "next"
+		}).
+		Return(nil).Once()
+	c.
+		On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(opts []client.ListOption) bool {
+			listOpts := &client.ListOptions{}
+			listOpts.ApplyOptions(opts)
+			return listOpts.Limit == 1 && listOpts.Continue == "next"
+		})).
+		Run(func(args mock.Arguments) {
+			list := args.Get(1).(*corev1.ConfigMapList)
+			list.Items = []corev1.ConfigMap{{}}
+		}).
+		Return(nil).Once()
+
+	var pages, items int
+	err := ListPages(context.Background(), c,
+		func() *corev1.ConfigMapList { return &corev1.ConfigMapList{} }, 1,
+		func(page *corev1.ConfigMapList) error {
+			pages++
+			items += len(page.Items)
+			return nil
+		}, client.InNamespace("test"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, pages)
+	assert.Equal(t, 2, items)
+	c.AssertExpectations(t)
+}
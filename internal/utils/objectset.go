@@ -1,6 +1,14 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
 )
 
@@ -11,3 +19,60 @@ func GetObjectsFromPhases(phases []corev1alpha1.ObjectSetTemplatePhase) []corev1
 	}
 	return result
 }
+
+// ComputeObjectsChecksum returns the hex encoded SHA-256 checksum of the JSON representation of the given objects.
+// Unlike ComputeSHA256Hash, the checksum only depends on the serialized content.
+// Objects are normalized the way the kube-apiserver stores them,
+// so the checksum stays stable after the objects round-tripped through the kube-apiserver.
+func ComputeObjectsChecksum(objects []corev1alpha1.ObjectSetObject) (string, error) {
+	normalized := make([]corev1alpha1.ObjectSetObject, len(objects))
+	for i := range objects {
+		obj, err := normalizeStoredObject(objects[i])
+		if err != nil {
+			return "", err
+		}
+		normalized[i] = obj
+	}
+	b, err := json.Marshal(normalized)
+	if err != nil {
+		return "", fmt.Errorf("marshaling objects for checksum: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Returns a copy of the object as stored by the kube-apiserver.
+// CRD defaults are applied to omitted fields and the metadata of the embedded object
+// is coerced into ObjectMeta, dropping a zero creationTimestamp,
+// like the kube-apiserver does for all embedded resources.
+// TestComputeObjectsChecksum_crdDefaults fails when the defaults here drift from the CRD.
+func normalizeStoredObject(in corev1alpha1.ObjectSetObject) (corev1alpha1.ObjectSetObject, error) {
+	out := *in.DeepCopy()
+	if len(out.CollisionProtection) == 0 {
+		out.CollisionProtection = corev1alpha1.CollisionProtectionPrevent
+	}
+	if len(out.DeletionPolicy) == 0 {
+		out.DeletionPolicy = corev1alpha1.ObjectDeletionPolicyDelete
+	}
+	if out.External != nil && len(out.External.MissingPolicy) == 0 {
+		out.External.MissingPolicy = corev1alpha1.ExternalObjectMissingPolicyBlock
+	}
+
+	metadata, ok := out.Object.Object["metadata"].(map[string]any)
+	if !ok {
+		return out, nil
+	}
+	objectMeta := &metav1.ObjectMeta{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(metadata, objectMeta); err != nil {
+		return out, fmt.Errorf("converting metadata for checksum: %w", err)
+	}
+	coerced, err := runtime.DefaultUnstructuredConverter.ToUnstructured(objectMeta)
+	if err != nil {
+		return out, fmt.Errorf("converting metadata for checksum: %w", err)
+	}
+	if objectMeta.CreationTimestamp.IsZero() {
+		delete(coerced, "creationTimestamp")
+	}
+	out.Object.Object["metadata"] = coerced
+	return out, nil
+}
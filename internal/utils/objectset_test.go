@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/objectmeta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestComputeObjectsChecksum(t *testing.T) {
+	t.Parallel()
+
+	objects := []corev1alpha1.ObjectSetObject{
+		{Object: unstructured.Unstructured{Object: map[string]any{
+			"kind": "ConfigMap", "metadata": map[string]any{"name": "a"},
+		}}},
+		{Object: unstructured.Unstructured{Object: map[string]any{
+			"kind": "ConfigMap", "metadata": map[string]any{"name": "b"},
+		}}},
+	}
+
+	checksum, err := ComputeObjectsChecksum(objects)
+	require.NoError(t, err)
+	assert.Len(t, checksum, 64)
+
+	again, err := ComputeObjectsChecksum(objects)
+	require.NoError(t, err)
+	assert.Equal(t, checksum, again)
+
+	truncated, err := ComputeObjectsChecksum(objects[:1])
+	require.NoError(t, err)
+	assert.NotEqual(t, checksum, truncated)
+}
+
+func TestComputeObjectsChecksum_storedObjects(t *testing.T) {
+	t.Parallel()
+
+	created := []corev1alpha1.ObjectSetObject{
+		{Object: unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1", "kind": "ConfigMap",
+			"metadata": map[string]any{"name": "a", "labels": map[string]any{"app": "a"}},
+		}}},
+		{
+			Object: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1", "kind": "Secret", "metadata": map[string]any{"name": "b"},
+			}},
+			External: &corev1alpha1.ObjectSetObjectExternal{},
+		},
+	}
+	// As returned by the kube-apiserver, with CRD defaults and coerced metadata.
+	stored := []corev1alpha1.ObjectSetObject{
+		{
+			Object: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1", "kind": "ConfigMap",
+				"metadata": map[string]any{"name": "a", "labels": map[string]any{"app": "a"}},
+			}},
+			CollisionProtection: corev1alpha1.CollisionProtectionPrevent,
+			DeletionPolicy:      corev1alpha1.ObjectDeletionPolicyDelete,
+		},
+		{
+			Object: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1", "kind": "Secret",
+				"metadata": map[string]any{"name": "b"},
+			}},
+			CollisionProtection: corev1alpha1.CollisionProtectionPrevent,
+			DeletionPolicy:      corev1alpha1.ObjectDeletionPolicyDelete,
+			External: &corev1alpha1.ObjectSetObjectExternal{
+				MissingPolicy: corev1alpha1.ExternalObjectMissingPolicyBlock,
+			},
+		},
+	}
+
+	createdChecksum, err := ComputeObjectsChecksum(created)
+	require.NoError(t, err)
+	storedChecksum, err := ComputeObjectsChecksum(stored)
+	require.NoError(t, err)
+	assert.Equal(t, createdChecksum, storedChecksum)
+
+	// Normalization must not modify the given objects.
+	assert.Empty(t, created[0].CollisionProtection)
+	assert.NotContains(t, created[0].Object.Object["metadata"], "creationTimestamp")
+}
+
+// Stores objects through the ObjectSlice CRD schema, like the kube-apiserver does,
+// to catch defaults added to the API but not to normalizeStoredObject.
+func TestComputeObjectsChecksum_crdDefaults(t *testing.T) {
+	t.Parallel()
+
+	crdYAML, err := os.ReadFile("../../config/crds/package-operator.run_objectslices.yaml")
+	require.NoError(t, err)
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, yaml.Unmarshal(crdYAML, crd))
+	require.Len(t, crd.Spec.Versions, 1)
+
+	objectSchema := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["objects"].Items.Schema
+	internalSchema := &apiextensions.JSONSchemaProps{}
+	require.NoError(t, apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(
+		objectSchema, internalSchema, nil))
+	structural, err := structuralschema.NewStructural(internalSchema)
+	require.NoError(t, err)
+
+	objects := []corev1alpha1.ObjectSetObject{
+		{Object: unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1", "kind": "ConfigMap",
+			"metadata": map[string]any{"name": "a", "labels": map[string]any{"app": "a"}},
+		}}},
+		{
+			Object: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1", "kind": "Secret", "metadata": map[string]any{"name": "b"},
+			}},
+			External: &corev1alpha1.ObjectSetObjectExternal{
+				Probes: []corev1alpha1.Probe{
+					{Condition: &corev1alpha1.ProbeConditionSpec{Type: "Ready"}},
+				},
+			},
+		},
+	}
+	for _, obj := range objects {
+		normalized, err := normalizeStoredObject(obj)
+		require.NoError(t, err)
+
+		// Defaults only apply to fields omitted in the JSON sent to the kube-apiserver.
+		stored := toJSONMap(t, &obj)
+		defaulting.Default(stored, structural)
+		require.Nil(t, objectmeta.Coerce(nil, stored, structural, false, false))
+
+		assert.Equal(t, stored, toJSONMap(t, &normalized), obj.String())
+	}
+}
+
+func toJSONMap(t *testing.T, obj any) map[string]any {
+	t.Helper()
+
+	b, err := json.Marshal(obj)
+	require.NoError(t, err)
+	m := map[string]any{}
+	require.NoError(t, json.Unmarshal(b, &m))
+	return m
+}
@@ -0,0 +1,114 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ErrEmptyConversionRequest is returned for ConversionReviews without request.
+var ErrEmptyConversionRequest = errors.New("conversion review contains no request")
+
+// ConversionWebhookHandler converts objects between API versions,
+// using the conversion functions registered in the scheme.
+type ConversionWebhookHandler struct {
+	log    logr.Logger
+	scheme *runtime.Scheme
+}
+
+func NewConversionWebhookHandler(log logr.Logger, scheme *runtime.Scheme) *ConversionWebhookHandler {
+	return &ConversionWebhookHandler{
+		log:    log,
+		scheme: scheme,
+	}
+}
+
+func (wh *ConversionWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := &apiextensionsv1.ConversionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		wh.log.Error(err, "decoding conversion request")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		wh.log.Error(ErrEmptyConversionRequest, "decoding conversion request")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	review.Response = wh.convert(review.Request)
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		wh.log.Error(err, "encoding conversion response")
+	}
+}
+
+func (wh *ConversionWebhookHandler) convert(
+	req *apiextensionsv1.ConversionRequest,
+) *apiextensionsv1.ConversionResponse {
+	resp := &apiextensionsv1.ConversionResponse{UID: req.UID}
+
+	desiredGV, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = conversionFailure(err)
+		return resp
+	}
+
+	for _, raw := range req.Objects {
+		converted, err := wh.convertObject(raw.Raw, desiredGV)
+		if err != nil {
+			wh.log.Error(err, "converting object", "desiredAPIVersion", req.DesiredAPIVersion)
+			resp.Result = conversionFailure(err)
+			resp.ConvertedObjects = nil
+			return resp
+		}
+		resp.ConvertedObjects = append(resp.ConvertedObjects, runtime.RawExtension{Object: converted})
+	}
+	resp.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return resp
+}
+
+func (wh *ConversionWebhookHandler) convertObject(raw []byte, desiredGV schema.GroupVersion) (runtime.Object, error) {
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, typeMeta); err != nil {
+		return nil, fmt.Errorf("decoding type information: %w", err)
+	}
+	srcGVK := typeMeta.GroupVersionKind()
+
+	src, err := wh.scheme.New(srcGVK)
+	if err != nil {
+		return nil, fmt.Errorf("creating %s: %w", srcGVK, err)
+	}
+	if err := json.Unmarshal(raw, src); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", srcGVK, err)
+	}
+	if srcGVK.GroupVersion() == desiredGV {
+		return src, nil
+	}
+
+	dstGVK := desiredGV.WithKind(srcGVK.Kind)
+	dst, err := wh.scheme.New(dstGVK)
+	if err != nil {
+		return nil, fmt.Errorf("creating %s: %w", dstGVK, err)
+	}
+	if err := wh.scheme.Convert(src, dst, nil); err != nil {
+		return nil, fmt.Errorf("converting %s to %s: %w", srcGVK, dstGVK, err)
+	}
+	dst.GetObjectKind().SetGroupVersionKind(dstGVK)
+	return dst, nil
+}
+
+func conversionFailure(err error) metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
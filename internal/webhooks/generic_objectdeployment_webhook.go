@@ -0,0 +1,162 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+type objectDeployments interface {
+	corev1alpha1.ObjectDeployment |
+		corev1alpha1.ClusterObjectDeployment
+}
+
+type GenericObjectDeploymentWebhookHandler[T objectDeployments] struct {
+	decoder admission.Decoder
+	log     logr.Logger
+	client  client.Client
+	// Cluster-scoped kinds namespaced ObjectDeployments may contain.
+	allowedClusterScopedKinds []schema.GroupKind
+}
+
+func NewObjectDeploymentWebhookHandler(
+	log logr.Logger,
+	client client.Client,
+	allowedClusterScopedKinds []schema.GroupKind,
+) *GenericObjectDeploymentWebhookHandler[corev1alpha1.ObjectDeployment] {
+	return &GenericObjectDeploymentWebhookHandler[corev1alpha1.ObjectDeployment]{
+		decoder:                   admission.NewDecoder(client.Scheme()),
+		log:                       log,
+		client:                    client,
+		allowedClusterScopedKinds: allowedClusterScopedKinds,
+	}
+}
+
+func NewClusterObjectDeploymentWebhookHandler(
+	log logr.Logger,
+	client client.Client,
+) *GenericObjectDeploymentWebhookHandler[corev1alpha1.ClusterObjectDeployment] {
+	return &GenericObjectDeploymentWebhookHandler[corev1alpha1.ClusterObjectDeployment]{
+		decoder: admission.NewDecoder(client.Scheme()),
+		log:     log,
+		client:  client,
+	}
+}
+
+func (wh *GenericObjectDeploymentWebhookHandler[T]) newObjectDeployment() *T {
+	return new(T)
+}
+
+func (wh *GenericObjectDeploymentWebhookHandler[T]) decode(req admission.Request) (*T, error) {
+	obj := wh.newObjectDeployment()
+	if req.Operation == admissionv1.Operation(admissionv1beta1.Delete) {
+		return obj, nil
+	}
+	if err := wh.decoder.Decode(
+		req, any(obj).(client.Object)); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (wh *GenericObjectDeploymentWebhookHandler[T]) Handle(
+	ctx context.Context, req admission.Request,
+) admission.Response {
+	obj, err := wh.decode(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	switch req.Operation {
+	case admissionv1.Operation(admissionv1beta1.Create):
+		return wh.validate(ctx, obj, nil)
+	case admissionv1.Operation(admissionv1beta1.Update):
+		oldObj := wh.newObjectDeployment()
+		if err := wh.decoder.DecodeRaw(
+			req.OldObject, any(oldObj).(runtime.Object)); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		return wh.validate(ctx, obj, oldObj)
+	default:
+		return admission.Allowed("operation allowed")
+	}
+}
+
+// Validates the rollout schedule and the template of the ObjectDeployment, if it was created or changed.
+func (wh *GenericObjectDeploymentWebhookHandler[T]) validate(
+	ctx context.Context, obj, oldObj *T,
+) admission.Response {
+	allErrs := validateRolloutSchedule(objectDeploymentRolloutSchedule(obj), field.NewPath("spec", "rolloutSchedule"))
+
+	template := objectDeploymentTemplateSpec(obj)
+	if oldObj == nil || !equality.Semantic.DeepEqual(template, objectDeploymentTemplateSpec(oldObj)) {
+		templateErrs, err := wh.validateTemplate(ctx, obj, template)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		allErrs = append(allErrs, templateErrs...)
+	}
+
+	if len(allErrs) > 0 {
+		return admission.Denied(allErrs.ToAggregate().Error())
+	}
+	return admission.Allowed("operation allowed")
+}
+
+func (wh *GenericObjectDeploymentWebhookHandler[T]) validateTemplate(
+	ctx context.Context, obj *T, template corev1alpha1.ObjectSetTemplateSpec,
+) (field.ErrorList, error) {
+	templatePath := field.NewPath("spec", "template", "spec")
+	allErrs := validateObjectSetTemplateSpec(template, templatePath)
+
+	clientObj := any(obj).(client.Object)
+	if len(clientObj.GetNamespace()) > 0 {
+		allErrs = append(allErrs, validateClusterScopedKinds(
+			template, wh.allowedClusterScopedKinds, templatePath)...)
+	}
+	sliceErrs, err := validateSliceReferences(
+		ctx, wh.client, objectDeploymentSliceKind(obj), clientObj.GetNamespace(),
+		template, templatePath)
+	if err != nil {
+		return nil, err
+	}
+	return append(allErrs, sliceErrs...), nil
+}
+
+func objectDeploymentTemplateSpec[T objectDeployments](obj *T) corev1alpha1.ObjectSetTemplateSpec {
+	switch v := any(obj).(type) {
+	case *corev1alpha1.ClusterObjectDeployment:
+		return v.Spec.Template.Spec
+	case *corev1alpha1.ObjectDeployment:
+		return v.Spec.Template.Spec
+	}
+	return corev1alpha1.ObjectSetTemplateSpec{}
+}
+
+func objectDeploymentRolloutSchedule[T objectDeployments](obj *T) *corev1alpha1.RolloutSchedule {
+	switch v := any(obj).(type) {
+	case *corev1alpha1.ClusterObjectDeployment:
+		return v.Spec.RolloutSchedule
+	case *corev1alpha1.ObjectDeployment:
+		return v.Spec.RolloutSchedule
+	}
+	return nil
+}
+
+func objectDeploymentSliceKind[T objectDeployments](obj *T) string {
+	if _, ok := any(obj).(*corev1alpha1.ClusterObjectDeployment); ok {
+		return "ClusterObjectSlice"
+	}
+	return "ObjectSlice"
+}
@@ -9,6 +9,7 @@ import (
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -25,16 +26,20 @@ type GenericObjectSetWebhookHandler[T objectSets] struct {
 	decoder admission.Decoder
 	log     logr.Logger
 	client  client.Client
+	// Cluster-scoped kinds namespaced ObjectSets may contain.
+	allowedClusterScopedKinds []schema.GroupKind
 }
 
 func NewObjectSetWebhookHandler(
 	log logr.Logger,
 	client client.Client,
+	allowedClusterScopedKinds []schema.GroupKind,
 ) *GenericObjectSetWebhookHandler[corev1alpha1.ObjectSet] {
 	return &GenericObjectSetWebhookHandler[corev1alpha1.ObjectSet]{
-		decoder: admission.NewDecoder(client.Scheme()),
-		log:     log,
-		client:  client,
+		decoder:                   admission.NewDecoder(client.Scheme()),
+		log:                       log,
+		client:                    client,
+		allowedClusterScopedKinds: allowedClusterScopedKinds,
 	}
 }
 
@@ -65,7 +70,7 @@ func (wh *GenericObjectSetWebhookHandler[T]) decode(req admission.Request) (*T,
 	return obj, nil
 }
 
-func (wh *GenericObjectSetWebhookHandler[T]) Handle(_ context.Context, req admission.Request) admission.Response {
+func (wh *GenericObjectSetWebhookHandler[T]) Handle(ctx context.Context, req admission.Request) admission.Response {
 	obj, err := wh.decode(req)
 	if err != nil {
 		return admission.Errored(http.StatusBadRequest, err)
@@ -79,11 +84,42 @@ func (wh *GenericObjectSetWebhookHandler[T]) Handle(_ context.Context, req admis
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 		return wh.validateUpdate(obj, oldObj)
+	case admissionv1.Operation(admissionv1beta1.Create):
+		return wh.validateCreate(ctx, obj)
 	default:
 		return admission.Allowed("operation allowed")
 	}
 }
 
+func (wh *GenericObjectSetWebhookHandler[T]) validateCreate(
+	ctx context.Context, obj *T,
+) admission.Response {
+	fields := objectSetImmutableFields(obj)
+	specFields := field.NewPath("spec")
+	allErrs := validateObjectSetTemplateSpec(fields.ObjectSetTemplateSpec, specFields)
+
+	clientObj := any(obj).(client.Object)
+	if len(clientObj.GetNamespace()) > 0 {
+		allErrs = append(allErrs, validateClusterScopedKinds(
+			fields.ObjectSetTemplateSpec, wh.allowedClusterScopedKinds, specFields)...)
+	}
+
+	// Only checked on create, because slices of archived ObjectSets
+	// may already be garbage collected when they are updated.
+	sliceErrs, err := validateSliceReferences(
+		ctx, wh.client, objectSetSliceKind(obj), clientObj.GetNamespace(),
+		fields.ObjectSetTemplateSpec, specFields)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	allErrs = append(allErrs, sliceErrs...)
+
+	if len(allErrs) > 0 {
+		return admission.Denied(allErrs.ToAggregate().Error())
+	}
+	return admission.Allowed("operation allowed")
+}
+
 func (wh *GenericObjectSetWebhookHandler[T]) validateUpdate(
 	obj, oldObj *T,
 ) admission.Response {
@@ -104,15 +140,15 @@ func validateGenericObjectSetImmutability[T objectSets](obj, oldObj *T) error {
 		newFields.ObjectSetTemplateSpec,
 		oldFields.ObjectSetTemplateSpec) {
 		allErrs = append(allErrs,
-			field.Invalid(specFields.Child("phases"), "", "is immutable"))
+			field.Invalid(specFields.Child("phases"), "", objectSetImmutableDetail))
 		allErrs = append(allErrs,
-			field.Invalid(specFields.Child("availabilityProbes"), "", "is immutable"))
+			field.Invalid(specFields.Child("availabilityProbes"), "", objectSetImmutableDetail))
 	}
 
 	if !equality.Semantic.DeepEqual(
 		newFields.Previous, oldFields.Previous) {
 		allErrs = append(allErrs,
-			field.Invalid(specFields.Child("previous"), "", "is immutable"))
+			field.Invalid(specFields.Child("previous"), "", objectSetImmutableDetail))
 	}
 
 	if len(allErrs) == 0 {
@@ -121,6 +157,9 @@ func validateGenericObjectSetImmutability[T objectSets](obj, oldObj *T) error {
 	return allErrs.ToAggregate()
 }
 
+const objectSetImmutableDetail = "is immutable, " +
+	"create a new ObjectSet revision or change the ObjectDeployment template instead"
+
 type genericImmutableFields struct {
 	Previous                           []corev1alpha1.PreviousRevisionReference `json:"previous,omitempty"`
 	corev1alpha1.ObjectSetTemplateSpec `json:",inline"`
@@ -146,3 +185,10 @@ func objectSetImmutableFields[T objectSets](obj *T) genericImmutableFields {
 		ObjectSetTemplateSpec: *template,
 	}
 }
+
+func objectSetSliceKind[T objectSets](obj *T) string {
+	if _, ok := any(obj).(*corev1alpha1.ClusterObjectSet); ok {
+		return "ClusterObjectSlice"
+	}
+	return "ObjectSlice"
+}
@@ -0,0 +1,95 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+type objectSlices interface {
+	corev1alpha1.ObjectSlice |
+		corev1alpha1.ClusterObjectSlice
+}
+
+type GenericObjectSliceWebhookHandler[T objectSlices] struct {
+	decoder admission.Decoder
+	log     logr.Logger
+	client  client.Client
+}
+
+func NewObjectSliceWebhookHandler(
+	log logr.Logger,
+	client client.Client,
+) *GenericObjectSliceWebhookHandler[corev1alpha1.ObjectSlice] {
+	return &GenericObjectSliceWebhookHandler[corev1alpha1.ObjectSlice]{
+		decoder: admission.NewDecoder(client.Scheme()),
+		log:     log,
+		client:  client,
+	}
+}
+
+func NewClusterObjectSliceWebhookHandler(
+	log logr.Logger,
+	client client.Client,
+) *GenericObjectSliceWebhookHandler[corev1alpha1.ClusterObjectSlice] {
+	return &GenericObjectSliceWebhookHandler[corev1alpha1.ClusterObjectSlice]{
+		decoder: admission.NewDecoder(client.Scheme()),
+		log:     log,
+		client:  client,
+	}
+}
+
+func (wh *GenericObjectSliceWebhookHandler[T]) newObjectSlice() *T {
+	return new(T)
+}
+
+func (wh *GenericObjectSliceWebhookHandler[T]) decode(req admission.Request) (*T, error) {
+	obj := wh.newObjectSlice()
+	if req.Operation == admissionv1.Operation(admissionv1beta1.Delete) {
+		return obj, nil
+	}
+	if err := wh.decoder.Decode(
+		req, any(obj).(client.Object)); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (wh *GenericObjectSliceWebhookHandler[T]) Handle(
+	_ context.Context, req admission.Request,
+) admission.Response {
+	obj, err := wh.decode(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	switch req.Operation {
+	case admissionv1.Operation(admissionv1beta1.Create):
+		// Objects are immutable, so they only have to be checked on create.
+		allErrs := validateObjectSetObjects(objectSliceObjects(obj), field.NewPath("objects"))
+		if len(allErrs) > 0 {
+			return admission.Denied(allErrs.ToAggregate().Error())
+		}
+		return admission.Allowed("operation allowed")
+	default:
+		return admission.Allowed("operation allowed")
+	}
+}
+
+func objectSliceObjects[T objectSlices](obj *T) []corev1alpha1.ObjectSetObject {
+	switch v := any(obj).(type) {
+	case *corev1alpha1.ClusterObjectSlice:
+		return v.Objects
+	case *corev1alpha1.ObjectSlice:
+		return v.Objects
+	}
+	return nil
+}
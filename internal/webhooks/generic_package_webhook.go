@@ -0,0 +1,180 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"pkg.package-operator.run/semver"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+type packages interface {
+	corev1alpha1.Package |
+		corev1alpha1.ClusterPackage
+}
+
+type GenericPackageWebhookHandler[T packages] struct {
+	decoder admission.Decoder
+	log     logr.Logger
+	client  client.Client
+}
+
+func NewPackageWebhookHandler(
+	log logr.Logger,
+	client client.Client,
+) *GenericPackageWebhookHandler[corev1alpha1.Package] {
+	return &GenericPackageWebhookHandler[corev1alpha1.Package]{
+		decoder: admission.NewDecoder(client.Scheme()),
+		log:     log,
+		client:  client,
+	}
+}
+
+func NewClusterPackageWebhookHandler(
+	log logr.Logger,
+	client client.Client,
+) *GenericPackageWebhookHandler[corev1alpha1.ClusterPackage] {
+	return &GenericPackageWebhookHandler[corev1alpha1.ClusterPackage]{
+		decoder: admission.NewDecoder(client.Scheme()),
+		log:     log,
+		client:  client,
+	}
+}
+
+func (wh *GenericPackageWebhookHandler[T]) newPackage() *T {
+	return new(T)
+}
+
+func (wh *GenericPackageWebhookHandler[T]) decode(req admission.Request) (*T, error) {
+	obj := wh.newPackage()
+	if req.Operation == admissionv1.Operation(admissionv1beta1.Delete) {
+		return obj, nil
+	}
+	if err := wh.decoder.Decode(
+		req, any(obj).(client.Object)); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (wh *GenericPackageWebhookHandler[T]) Handle(
+	_ context.Context, req admission.Request,
+) admission.Response {
+	obj, err := wh.decode(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	switch req.Operation {
+	case admissionv1.Operation(admissionv1beta1.Create):
+		return wh.validate(obj, nil)
+	case admissionv1.Operation(admissionv1beta1.Update):
+		oldObj := wh.newPackage()
+		if err := wh.decoder.DecodeRaw(
+			req.OldObject, any(oldObj).(runtime.Object)); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		return wh.validate(obj, oldObj)
+	case admissionv1.Operation(admissionv1beta1.Delete):
+		oldObj := wh.newPackage()
+		if err := wh.decoder.DecodeRaw(
+			req.OldObject, any(oldObj).(runtime.Object)); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		return wh.validateDelete(oldObj)
+	default:
+		return admission.Allowed("operation allowed")
+	}
+}
+
+// Validates the spec of the Package, if it was created or changed.
+func (wh *GenericPackageWebhookHandler[T]) validate(obj, oldObj *T) admission.Response {
+	spec := packageSpec(obj)
+	if oldObj != nil && equality.Semantic.DeepEqual(spec, packageSpec(oldObj)) {
+		return admission.Allowed("operation allowed")
+	}
+
+	if allErrs := validatePackageSpec(spec, field.NewPath("spec")); len(allErrs) > 0 {
+		return admission.Denied(allErrs.ToAggregate().Error())
+	}
+	return admission.Allowed("operation allowed")
+}
+
+// Denies the deletion of packages protected from deletion.
+func (wh *GenericPackageWebhookHandler[T]) validateDelete(obj *T) admission.Response {
+	if packageSpec(obj).DeletionProtection {
+		return admission.Denied(
+			"package is protected from deletion, set .spec.deletionProtection to false to uninstall it " +
+				"after checking .status.teardown for the objects deleted with it")
+	}
+	return admission.Allowed("operation allowed")
+}
+
+func validatePackageSpec(spec corev1alpha1.PackageSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if _, err := name.ParseReference(spec.Image); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("image"), spec.Image,
+			"must be an image reference like quay.io/org/package:v1.0.0 or registry/package@sha256:<digest>"))
+	}
+
+	if spec.Config != nil && len(spec.Config.Raw) > 0 {
+		config := map[string]any{}
+		if err := json.Unmarshal(spec.Config.Raw, &config); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("config"), string(spec.Config.Raw),
+				"must be an object with the configuration properties defined by the package manifest"))
+		}
+	}
+
+	allErrs = append(allErrs, validateRolloutSchedule(spec.RolloutSchedule, fldPath.Child("rolloutSchedule"))...)
+	allErrs = append(allErrs, validateUpdatePolicy(spec.UpdatePolicy, fldPath.Child("updatePolicy"))...)
+	return allErrs
+}
+
+// Shortest interval packages may be checked for new images, to not hammer registries.
+const minUpdateInterval = time.Minute
+
+func validateUpdatePolicy(policy *corev1alpha1.PackageUpdatePolicy, fldPath *field.Path) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	switch policy.Strategy {
+	case corev1alpha1.PackageUpdateStrategySemVer:
+		if _, err := semver.NewConstraint(policy.SemVerRange); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("semVerRange"), policy.SemVerRange, err.Error()))
+		}
+	case corev1alpha1.PackageUpdateStrategyDigest:
+		if len(policy.SemVerRange) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("semVerRange"), "only allowed with the SemVer strategy"))
+		}
+	}
+	if policy.Interval != nil && policy.Interval.Duration < minUpdateInterval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), policy.Interval.Duration.String(),
+			"must be at least "+minUpdateInterval.String()))
+	}
+	return allErrs
+}
+
+func packageSpec[T packages](obj *T) corev1alpha1.PackageSpec {
+	switch v := any(obj).(type) {
+	case *corev1alpha1.ClusterPackage:
+		return v.Spec
+	case *corev1alpha1.Package:
+		return v.Spec
+	}
+	return corev1alpha1.PackageSpec{}
+}
@@ -0,0 +1,71 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// ObjectTemplateDefaultingWebhookHandler sets defaults
+// that can not be expressed as structural defaults of the CRD.
+type ObjectTemplateDefaultingWebhookHandler struct {
+	decoder admission.Decoder
+	log     logr.Logger
+	client  client.Client
+}
+
+func NewObjectTemplateDefaultingWebhookHandler(
+	log logr.Logger,
+	client client.Client,
+) *ObjectTemplateDefaultingWebhookHandler {
+	return &ObjectTemplateDefaultingWebhookHandler{
+		decoder: admission.NewDecoder(client.Scheme()),
+		log:     log,
+		client:  client,
+	}
+}
+
+func (wh *ObjectTemplateDefaultingWebhookHandler) Handle(
+	_ context.Context, req admission.Request,
+) admission.Response {
+	switch req.Operation {
+	case admissionv1.Operation(admissionv1beta1.Create),
+		admissionv1.Operation(admissionv1beta1.Update):
+	default:
+		return admission.Allowed("operation allowed")
+	}
+
+	obj := &corev1alpha1.ObjectTemplate{}
+	if err := wh.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	namespace := obj.Namespace
+	if len(namespace) == 0 {
+		namespace = req.Namespace
+	}
+	defaultObjectTemplateSpec(&obj.Spec, namespace)
+
+	defaulted, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, defaulted)
+}
+
+// Sources without namespace are looked up in the namespace of the ObjectTemplate.
+func defaultObjectTemplateSpec(spec *corev1alpha1.ObjectTemplateSpec, namespace string) {
+	for i := range spec.Sources {
+		if len(spec.Sources[i].Namespace) == 0 {
+			spec.Sources[i].Namespace = namespace
+		}
+	}
+}
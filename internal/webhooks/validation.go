@@ -0,0 +1,112 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+	"package-operator.run/internal/rolloutschedule"
+)
+
+// Objects are stored in ObjectSlices of at most 1 MiB, see chunking of the Package controller.
+// Larger objects can never be stored, as etcd limits object size to 1.5 MiB.
+const maxInlineObjectBytes = 1024 * 1024
+
+// Validates phases and inline objects of an ObjectSet template.
+func validateObjectSetTemplateSpec(
+	spec corev1alpha1.ObjectSetTemplateSpec, fldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	phaseNames := sets.New[string]()
+	for i, phase := range spec.Phases {
+		phasePath := fldPath.Child("phases").Index(i)
+		if phaseNames.Has(phase.Name) {
+			allErrs = append(allErrs, field.Duplicate(phasePath.Child("name"), phase.Name))
+		}
+		phaseNames.Insert(phase.Name)
+
+		allErrs = append(allErrs, validateObjectSetObjects(phase.Objects, phasePath.Child("objects"))...)
+	}
+	return allErrs
+}
+
+// Rejects objects too large to ever be stored.
+func validateObjectSetObjects(objects []corev1alpha1.ObjectSetObject, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, obj := range objects {
+		b, err := json.Marshal(obj.Object)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("object"), obj.String(), err.Error()))
+			continue
+		}
+		if len(b) > maxInlineObjectBytes {
+			allErrs = append(allErrs, field.Invalid(
+				fldPath.Index(i).Child("object"), obj.String(),
+				fmt.Sprintf("object has %d bytes, exceeding the limit of %d bytes; "+
+					"split large data into multiple objects", len(b), maxInlineObjectBytes)))
+		}
+	}
+	return allErrs
+}
+
+// Rejects cluster-scoped kinds of namespaced ObjectSets that are not allowed by the cluster admin.
+func validateClusterScopedKinds(
+	spec corev1alpha1.ObjectSetTemplateSpec, allowed []schema.GroupKind, fldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, gk := range spec.ClusterScopedKinds {
+		if !slices.Contains(allowed, schema.GroupKind{Group: gk.Group, Kind: gk.Kind}) {
+			allErrs = append(allErrs, field.Forbidden(
+				fldPath.Child("clusterScopedKinds").Index(i),
+				fmt.Sprintf("%s is not allowed in namespaced objects by the cluster admin", gk.String())))
+		}
+	}
+	return allErrs
+}
+
+// Ensures all ObjectSlices referenced by phases exist.
+// ObjectSlices have to be created before the objects referencing them.
+func validateSliceReferences(
+	ctx context.Context, c client.Reader, sliceKind, namespace string,
+	spec corev1alpha1.ObjectSetTemplateSpec, fldPath *field.Path,
+) (field.ErrorList, error) {
+	var allErrs field.ErrorList
+	for i, phase := range spec.Phases {
+		for j, slice := range phase.Slices {
+			meta := &metav1.PartialObjectMetadata{}
+			meta.SetGroupVersionKind(corev1alpha1.GroupVersion.WithKind(sliceKind))
+			err := c.Get(ctx, client.ObjectKey{Name: slice, Namespace: namespace}, meta)
+			if errors.IsNotFound(err) {
+				allErrs = append(allErrs, field.NotFound(
+					fldPath.Child("phases").Index(i).Child("slices").Index(j),
+					fmt.Sprintf("%s %q, create the %s before referencing it", sliceKind, slice, sliceKind)))
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("getting %s: %w", sliceKind, err)
+			}
+		}
+	}
+	return allErrs, nil
+}
+
+// Ensures the rollout schedule can be evaluated, so new revisions are not held back forever.
+func validateRolloutSchedule(schedule *corev1alpha1.RolloutSchedule, fldPath *field.Path) field.ErrorList {
+	if schedule == nil {
+		return nil
+	}
+	if _, err := rolloutschedule.New(schedule); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, schedule, err.Error())}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package phasecontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyErrorReason tells why the API server refused to apply an object.
+type ApplyErrorReason string
+
+const (
+	ApplyErrorReasonConflict                 ApplyErrorReason = "conflict"
+	ApplyErrorReasonFieldConflict            ApplyErrorReason = "fields owned by other field managers"
+	ApplyErrorReasonAdmissionWebhookRejected ApplyErrorReason = "rejected by admission webhook"
+	ApplyErrorReasonInvalid                  ApplyErrorReason = "invalid"
+	ApplyErrorReasonForbidden                ApplyErrorReason = "forbidden"
+)
+
+// Apply patches the given object using server-side apply with the given field owner,
+// unless another client.FieldOwner is passed.
+// Optimistic-lock conflicts are retried a few times before giving up,
+// conflicts with other field managers are not, as they persist until ownership is forced.
+// Errors the API server returns are classified into an *ApplyError.
+func Apply(
+	ctx context.Context, writer client.Writer, fieldOwner string,
+	obj client.Object, patch client.Patch, opts ...client.PatchOption,
+) error {
+	opts = append([]client.PatchOption{client.FieldOwner(fieldOwner)}, opts...)
+	err := retry.OnError(retry.DefaultRetry, isOptimisticLockConflict, func() error {
+		return writer.Patch(ctx, obj, patch, opts...)
+	})
+	return ClassifyApplyError(obj, err)
+}
+
+// Returns true for conflicts that are not caused by other field managers,
+// which are classified as ApplyErrorReasonConflict.
+func isOptimisticLockConflict(err error) bool {
+	return apimachineryerrors.IsConflict(err) && len(FieldManagerConflicts(err)) == 0
+}
+
+// ClassifyApplyError wraps errors returned when applying an object into an *ApplyError.
+// Errors that can not be classified are returned unchanged.
+func ClassifyApplyError(obj client.Object, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var reason ApplyErrorReason
+	switch {
+	case isAdmissionWebhookDenial(err):
+		reason = ApplyErrorReasonAdmissionWebhookRejected
+	case apimachineryerrors.IsInvalid(err):
+		reason = ApplyErrorReasonInvalid
+	case apimachineryerrors.IsForbidden(err):
+		reason = ApplyErrorReasonForbidden
+	case len(FieldManagerConflicts(err)) > 0:
+		reason = ApplyErrorReasonFieldConflict
+	case apimachineryerrors.IsConflict(err):
+		reason = ApplyErrorReasonConflict
+	default:
+		return err
+	}
+
+	return &ApplyError{
+		ObjectGVK: obj.GetObjectKind().GroupVersionKind(),
+		ObjectKey: client.ObjectKeyFromObject(obj),
+		Reason:    reason,
+		Err:       err,
+	}
+}
+
+// The API server prefixes messages of admission webhook denials with "admission webhook".
+func isAdmissionWebhookDenial(err error) bool {
+	var statusErr apimachineryerrors.APIStatus
+	return errors.As(err, &statusErr) &&
+		strings.HasPrefix(statusErr.Status().Message, "admission webhook ")
+}
+
+// FieldManagerConflicts returns the causes of a server-side apply error,
+// that fields are owned by other field managers.
+func FieldManagerConflicts(err error) []metav1.StatusCause {
+	var statusErr apimachineryerrors.APIStatus
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return nil
+	}
+	var conflicts []metav1.StatusCause
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type == metav1.CauseTypeFieldManagerConflict {
+			conflicts = append(conflicts, cause)
+		}
+	}
+	return conflicts
+}
+
+// ApplyError is returned when the API server refused to apply an object.
+type ApplyError struct {
+	ObjectGVK schema.GroupVersionKind
+	ObjectKey client.ObjectKey
+	Reason    ApplyErrorReason
+	Err       error
+}
+
+func (e *ApplyError) Error() string {
+	return fmt.Sprintf("%s %s %s: %v", e.ObjectGVK, e.ObjectKey, e.Reason, e.Err)
+}
+
+func (e *ApplyError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,103 @@
+package phasecontroller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Records patch options and returns the given errors in order, nil once they are used up.
+type patchWriter struct {
+	client.Writer
+	errs  []error
+	calls int
+	opts  []client.PatchOption
+}
+
+func (w *patchWriter) Patch(_ context.Context, _ client.Object, _ client.Patch, opts ...client.PatchOption) error {
+	w.calls++
+	w.opts = opts
+	if len(w.errs) == 0 {
+		return nil
+	}
+	err := w.errs[0]
+	w.errs = w.errs[1:]
+	return err
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	conflictErr := apimachineryerrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "test", errors.New("test"))
+	fieldManagerConflictErr := &apimachineryerrors.StatusError{ErrStatus: metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   409,
+		Reason: metav1.StatusReasonConflict,
+		Details: &metav1.StatusDetails{
+			Causes: []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: `conflict with "kubectl-edit" using v1`,
+				Field:   ".data.key",
+			}},
+		},
+	}}
+
+	tests := []struct {
+		name           string
+		errs           []error
+		expectedCalls  int
+		expectedReason ApplyErrorReason
+	}{
+		{
+			name:          "applies",
+			expectedCalls: 1,
+		},
+		{
+			name:          "retries conflicts",
+			errs:          []error{conflictErr},
+			expectedCalls: 2,
+		},
+		{
+			name:           "gives up on conflicts",
+			errs:           []error{conflictErr, conflictErr, conflictErr, conflictErr, conflictErr},
+			expectedCalls:  retry.DefaultRetry.Steps,
+			expectedReason: ApplyErrorReasonConflict,
+		},
+		{
+			name:           "does not retry field manager conflicts",
+			errs:           []error{fieldManagerConflictErr},
+			expectedCalls:  1,
+			expectedReason: ApplyErrorReasonFieldConflict,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &patchWriter{errs: test.errs}
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})
+
+			err := Apply(context.Background(), w, "test", obj, client.Apply, client.ForceOwnership)
+			assert.Equal(t, test.expectedCalls, w.calls)
+			assert.Equal(t, []client.PatchOption{client.FieldOwner("test"), client.ForceOwnership}, w.opts)
+			if len(test.expectedReason) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var applyErr *ApplyError
+			require.ErrorAs(t, err, &applyErr)
+			assert.Equal(t, test.expectedReason, applyErr.Reason)
+			assert.Equal(t, "/v1, Kind=ConfigMap", applyErr.ObjectGVK.String())
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Package phasecontroller contains the contracts between Package Operator
+// and phase controllers reconciling ObjectSetPhases outside of Package Operator.
+//
+// ObjectSets hand phases with a class set to an ObjectSetPhase object.
+// A phase controller implementing that class is expected to:
+//   - only reconcile ObjectSetPhases carrying its class in the
+//     corev1alpha1.ObjectSetPhaseClassLabel label,
+//   - report a heartbeat in .status.lastHeartbeatTime at least every HeartbeatInterval,
+//     ObjectSets report the phase controller as unavailable after HeartbeatTimeout,
+//   - set the ObjectSetPhase as controller in the OwnersAnnotation of every object it reconciles,
+//     see SetControllerReference,
+//   - evaluate .spec.availabilityProbes with probing.Parse against the objects it controls,
+//   - report the Available condition via SetAvailable or SetProbeFailure
+//     and the objects it controls in .status.controllerOf,
+//   - stop changing objects while .spec.paused is set,
+//   - delete the objects it controls when the ObjectSetPhase is deleted,
+//     unless the ObjectSetPhase carries the OrphanFinalizer.
+package phasecontroller
@@ -0,0 +1,27 @@
+package phasecontroller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Interval in which phase controllers report a heartbeat on the ObjectSetPhases they reconcile.
+	HeartbeatInterval = 30 * time.Second
+	// Phase controllers are considered unavailable, when no heartbeat was reported for this long.
+	HeartbeatTimeout = 4 * HeartbeatInterval
+)
+
+// IsHeartbeatExpired returns true if the phase controller of an ObjectSetPhase did not report a heartbeat in time.
+// ObjectSetPhases that never received a heartbeat are measured from their creation.
+func IsHeartbeatExpired(objectSetPhase metav1.Object, lastHeartbeat *metav1.Time, now time.Time) bool {
+	last := objectSetPhase.GetCreationTimestamp().Time
+	if lastHeartbeat != nil && lastHeartbeat.After(last) {
+		last = lastHeartbeat.Time
+	}
+	if last.IsZero() {
+		return false
+	}
+	return now.Sub(last) > HeartbeatTimeout
+}
@@ -0,0 +1,68 @@
+package phasecontroller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestIsHeartbeatExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	expired := metav1.NewTime(now.Add(-HeartbeatTimeout - time.Second))
+	recent := metav1.NewTime(now.Add(-HeartbeatInterval))
+
+	tests := []struct {
+		name          string
+		creation      metav1.Time
+		lastHeartbeat *metav1.Time
+		expected      bool
+	}{
+		{
+			name:     "new without heartbeat",
+			creation: recent,
+			expected: false,
+		},
+		{
+			name:     "old without heartbeat",
+			creation: expired,
+			expected: true,
+		},
+		{
+			name:          "recent heartbeat",
+			creation:      expired,
+			lastHeartbeat: &recent,
+			expected:      false,
+		},
+		{
+			name:          "expired heartbeat",
+			creation:      expired,
+			lastHeartbeat: &expired,
+			expected:      true,
+		},
+		{
+			name:     "unknown creation",
+			expected: false,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			phase := &corev1alpha1.ObjectSetPhase{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: test.creation,
+				},
+			}
+			assert.Equal(t, test.expected, IsHeartbeatExpired(phase, test.lastHeartbeat, now))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package phasecontroller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// OwnersAnnotation holds the owners of an object as JSON list of OwnerReferences.
+// Unlike .metadata.ownerReferences, these references may cross namespaces.
+const OwnersAnnotation = "package-operator.run/owners"
+
+// OrphanFinalizer is set on ObjectSetPhases deleted with the orphan cascade option.
+// Objects controlled by such an ObjectSetPhase must not be deleted.
+const OrphanFinalizer = "orphan"
+
+// ErrAlreadyControlled is returned when an object is already controlled by another owner.
+var ErrAlreadyControlled = errors.New("object is already controlled by another owner")
+
+// OwnerReference is a single entry of the OwnersAnnotation.
+type OwnerReference struct {
+	// API version of the referent.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the referent.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+	// Name of the referent.
+	// More info: http://kubernetes.io/docs/user-guide/identifiers#names
+	Name string `json:"name"`
+	// Name of the referent.
+	// More info: http://kubernetes.io/docs/user-guide/identifiers#namespaces
+	Namespace string `json:"namespace"`
+	// UID of the referent.
+	// More info: http://kubernetes.io/docs/user-guide/identifiers#uids
+	UID types.UID `json:"uid"`
+	// If true, this reference struct points to the managing controller.
+	// +optional
+	Controller *bool `json:"controller,omitempty"`
+}
+
+// NewOwnerReference returns a reference to the given owner of the given kind.
+func NewOwnerReference(owner metav1.Object, gvk schema.GroupVersionKind) OwnerReference {
+	return OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       owner.GetName(),
+		Namespace:  owner.GetNamespace(),
+		UID:        owner.GetUID(),
+	}
+}
+
+// IsController returns true if the reference points to the managing controller.
+func (r *OwnerReference) IsController() bool {
+	return r.Controller != nil && *r.Controller
+}
+
+// Returns true if both references point to the same object.
+// Only group, kind and name are compared, like Package Operator itself does.
+func (r *OwnerReference) refersTo(other OwnerReference) bool {
+	gv, err := schema.ParseGroupVersion(r.APIVersion)
+	if err != nil {
+		return false
+	}
+	otherGV, err := schema.ParseGroupVersion(other.APIVersion)
+	if err != nil {
+		return false
+	}
+	return gv.Group == otherGV.Group && r.Kind == other.Kind && r.Name == other.Name
+}
+
+// GetOwnerReferences returns the owners listed in the OwnersAnnotation of obj.
+func GetOwnerReferences(obj metav1.Object) ([]OwnerReference, error) {
+	annotation := obj.GetAnnotations()[OwnersAnnotation]
+	if len(annotation) == 0 {
+		return nil, nil
+	}
+
+	var ownerRefs []OwnerReference
+	if err := json.Unmarshal([]byte(annotation), &ownerRefs); err != nil {
+		return nil, fmt.Errorf("parsing %s annotation: %w", OwnersAnnotation, err)
+	}
+	return ownerRefs, nil
+}
+
+// SetOwnerReferences replaces the OwnersAnnotation of obj with the given owners.
+func SetOwnerReferences(obj metav1.Object, ownerRefs []OwnerReference) {
+	j, err := json.Marshal(ownerRefs)
+	if err != nil {
+		// Marshalling a list of plain structs can't fail.
+		panic(err)
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[OwnersAnnotation] = string(j)
+	obj.SetAnnotations(annotations)
+}
+
+// SetControllerReference adds owner as controller to the OwnersAnnotation of obj.
+// Returns ErrAlreadyControlled, if another owner already controls obj.
+func SetControllerReference(owner OwnerReference, obj metav1.Object) error {
+	ownerRefs, err := GetOwnerReferences(obj)
+	if err != nil {
+		return err
+	}
+
+	index := -1
+	for i := range ownerRefs {
+		if ownerRefs[i].refersTo(owner) {
+			index = i
+			continue
+		}
+		if ownerRefs[i].IsController() {
+			return fmt.Errorf("%w: %s %s", ErrAlreadyControlled, ownerRefs[i].Kind, ownerRefs[i].Name)
+		}
+	}
+
+	controller := true
+	owner.Controller = &controller
+	if index == -1 {
+		ownerRefs = append(ownerRefs, owner)
+	} else {
+		ownerRefs[index] = owner
+	}
+	SetOwnerReferences(obj, ownerRefs)
+	return nil
+}
+
+// IsController returns true if owner is listed as controller in the OwnersAnnotation of obj.
+func IsController(owner OwnerReference, obj metav1.Object) bool {
+	ownerRefs, err := GetOwnerReferences(obj)
+	if err != nil {
+		return false
+	}
+	for i := range ownerRefs {
+		if ownerRefs[i].refersTo(owner) && ownerRefs[i].IsController() {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveOwner removes owner from the OwnersAnnotation of obj.
+func RemoveOwner(owner OwnerReference, obj metav1.Object) error {
+	ownerRefs, err := GetOwnerReferences(obj)
+	if err != nil {
+		return err
+	}
+	for i := range ownerRefs {
+		if ownerRefs[i].refersTo(owner) {
+			SetOwnerReferences(obj, append(ownerRefs[:i], ownerRefs[i+1:]...))
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package phasecontroller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestOwnership(t *testing.T) {
+	t.Parallel()
+
+	phase := &corev1alpha1.ObjectSetPhase{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: "test", UID: "1234"},
+	}
+	owner := NewOwnerReference(phase, corev1alpha1.GroupVersion.WithKind("ObjectSetPhase"))
+	otherPhase := &corev1alpha1.ObjectSetPhase{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-2", Namespace: "test", UID: "5678"},
+	}
+	otherOwner := NewOwnerReference(otherPhase, corev1alpha1.GroupVersion.WithKind("ObjectSetPhase"))
+
+	obj := &metav1.ObjectMeta{}
+	assert.False(t, IsController(owner, obj))
+
+	require.NoError(t, SetControllerReference(owner, obj))
+	assert.True(t, IsController(owner, obj))
+	assert.JSONEq(t,
+		`[{"apiVersion":"package-operator.run/v1alpha1","kind":"ObjectSetPhase",`+
+			`"name":"test-1","namespace":"test","uid":"1234","controller":true}]`,
+		obj.Annotations[OwnersAnnotation])
+
+	// Setting the controller again is a no-op.
+	require.NoError(t, SetControllerReference(owner, obj))
+	ownerRefs, err := GetOwnerReferences(obj)
+	require.NoError(t, err)
+	assert.Len(t, ownerRefs, 1)
+
+	err = SetControllerReference(otherOwner, obj)
+	require.ErrorIs(t, err, ErrAlreadyControlled)
+	assert.False(t, IsController(otherOwner, obj))
+
+	require.NoError(t, RemoveOwner(owner, obj))
+	assert.False(t, IsController(owner, obj))
+	require.NoError(t, SetControllerReference(otherOwner, obj))
+	assert.True(t, IsController(otherOwner, obj))
+}
+
+func TestGetOwnerReferences_invalid(t *testing.T) {
+	t.Parallel()
+
+	obj := &metav1.ObjectMeta{
+		Annotations: map[string]string{OwnersAnnotation: "{"},
+	}
+	_, err := GetOwnerReferences(obj)
+	require.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package phasecontroller
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+// SetAvailable reports that all objects of the ObjectSetPhase pass their probes.
+func SetAvailable(conditions *[]metav1.Condition, generation int64) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               corev1alpha1.ObjectSetPhaseAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Available",
+		Message:            "Object is available and passes all probes.",
+		ObservedGeneration: generation,
+	})
+}
+
+// SetProbeFailure reports that objects of the ObjectSetPhase fail their probes,
+// message should list the failing objects and probes.
+func SetProbeFailure(conditions *[]metav1.Condition, generation int64, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               corev1alpha1.ObjectSetPhaseAvailable,
+		Status:             metav1.ConditionFalse,
+		Reason:             "ProbeFailure",
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
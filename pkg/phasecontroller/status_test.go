@@ -0,0 +1,31 @@
+package phasecontroller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
+)
+
+func TestSetAvailable(t *testing.T) {
+	t.Parallel()
+
+	var conditions []metav1.Condition
+	SetProbeFailure(&conditions, 1, "Deployment test: not ready")
+	cond := meta.FindStatusCondition(conditions, corev1alpha1.ObjectSetPhaseAvailable)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "ProbeFailure", cond.Reason)
+	assert.Equal(t, "Deployment test: not ready", cond.Message)
+	assert.Equal(t, int64(1), cond.ObservedGeneration)
+
+	SetAvailable(&conditions, 2)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+	assert.Equal(t, "Available", conditions[0].Reason)
+	assert.Equal(t, int64(2), conditions[0].ObservedGeneration)
+}
@@ -0,0 +1,48 @@
+package probing
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var jobGK = schema.GroupKind{Group: "batch", Kind: "Job"}
+
+// JobCompletionProbe checks if a batch/v1 Job completed successfully.
+// Objects that are not Jobs pass the probe.
+type JobCompletionProbe struct{}
+
+var _ Prober = (*JobCompletionProbe)(nil)
+
+// Probe executes the probe.
+func (jp *JobCompletionProbe) Probe(obj *unstructured.Unstructured) (success bool, message string) {
+	if obj.GroupVersionKind().GroupKind() != jobGK {
+		return true, ""
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	var complete bool
+	for _, condI := range conditions {
+		cond, ok := condI.(map[string]any)
+		if !ok || cond["status"] != "True" {
+			continue
+		}
+
+		switch cond["type"] {
+		case "Failed":
+			message := "job failed"
+			if reason, ok := cond["reason"].(string); ok && len(reason) > 0 {
+				message += " (" + reason + ")"
+			}
+			if msg, ok := cond["message"].(string); ok && len(msg) > 0 {
+				message += ": " + msg
+			}
+			return false, message
+		case "Complete":
+			complete = true
+		}
+	}
+	if !complete {
+		return false, "job not complete"
+	}
+	return true, ""
+}
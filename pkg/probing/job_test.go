@@ -0,0 +1,71 @@
+package probing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestJobCompletion(t *testing.T) {
+	t.Parallel()
+
+	job := func(conditions ...any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"status": map[string]any{
+				"conditions": conditions,
+			},
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		obj      *unstructured.Unstructured
+		succeeds bool
+		message  string
+	}{
+		{
+			name:     "complete",
+			obj:      job(map[string]any{"type": "Complete", "status": "True"}),
+			succeeds: true,
+		},
+		{
+			name: "failed",
+			obj: job(
+				map[string]any{"type": "Complete", "status": "False"},
+				map[string]any{
+					"type":    "Failed",
+					"status":  "True",
+					"reason":  "BackoffLimitExceeded",
+					"message": "Job has reached the specified backoff limit",
+				},
+			),
+			message: "job failed (BackoffLimitExceeded): Job has reached the specified backoff limit",
+		},
+		{
+			name:    "running",
+			obj:     job(),
+			message: "job not complete",
+		},
+		{
+			name: "not a job",
+			obj: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+			}},
+			succeeds: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &JobCompletionProbe{}
+			s, m := p.Probe(test.obj)
+			assert.Equal(t, test.succeeds, s)
+			assert.Equal(t, test.message, m)
+		})
+	}
+}
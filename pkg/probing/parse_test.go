@@ -9,7 +9,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	corev1alpha1 "package-operator.run/apis/core/v1alpha1"
-	"package-operator.run/pkg/probing"
 )
 
 func TestParse(t *testing.T) {
@@ -30,12 +29,12 @@ func TestParse(t *testing.T) {
 
 	p, err := Parse(ctx, osp)
 	require.NoError(t, err)
-	require.IsType(t, probing.And{}, p)
+	require.IsType(t, And{}, p)
 
 	if assert.Len(t, p, 1) {
-		list := p.(probing.And)
-		require.IsType(t, &probing.GroupKindSelector{}, list[0])
-		ks := list[0].(*probing.GroupKindSelector)
+		list := p.(And)
+		require.IsType(t, &GroupKindSelector{}, list[0])
+		ks := list[0].(*GroupKindSelector)
 		assert.Equal(t, kind, ks.Kind)
 		assert.Equal(t, group, ks.Group)
 	}
@@ -56,10 +55,10 @@ func TestParseSelector(t *testing.T) {
 		},
 	}, nil)
 	require.NoError(t, err)
-	require.IsType(t, &probing.LabelSelector{}, p)
+	require.IsType(t, &LabelSelector{}, p)
 
-	ss := p.(*probing.LabelSelector)
-	require.IsType(t, &probing.GroupKindSelector{}, ss.Prober)
+	ss := p.(*LabelSelector)
+	require.IsType(t, &GroupKindSelector{}, ss.Prober)
 }
 
 func TestParseProbes(t *testing.T) {
@@ -82,28 +81,32 @@ func TestParseProbes(t *testing.T) {
 			Rule:    `self.metadata.name == "test"`,
 		},
 	}
+	jcp := corev1alpha1.Probe{
+		JobCompletion: &corev1alpha1.ProbeJobCompletionSpec{},
+	}
 	emptyConfigProbe := corev1alpha1.Probe{}
 
 	p, err := ParseProbes(context.Background(), []corev1alpha1.Probe{
-		fep, cp, cel, emptyConfigProbe,
+		fep, cp, cel, jcp, emptyConfigProbe,
 	})
 	require.NoError(t, err)
 	// everything should be wrapped
-	require.IsType(t, &probing.ObservedGenerationProbe{}, p)
+	require.IsType(t, &ObservedGenerationProbe{}, p)
 
-	ogProbe := p.(*probing.ObservedGenerationProbe)
+	ogProbe := p.(*ObservedGenerationProbe)
 	nested := ogProbe.Prober
-	require.IsType(t, probing.And{}, nested)
+	require.IsType(t, And{}, nested)
 
-	if assert.Len(t, nested, 3) {
-		nestedList := nested.(probing.And)
-		assert.Equal(t, &probing.FieldsEqualProbe{
+	if assert.Len(t, nested, 4) {
+		nestedList := nested.(And)
+		assert.Equal(t, &FieldsEqualProbe{
 			FieldA: "asdf",
 			FieldB: "jkl;",
 		}, nestedList[0])
-		assert.Equal(t, &probing.ConditionProbe{
+		assert.Equal(t, &ConditionProbe{
 			Type:   "asdf",
 			Status: "asdf",
 		}, nestedList[1])
+		assert.Equal(t, &JobCompletionProbe{}, nestedList[3])
 	}
 }